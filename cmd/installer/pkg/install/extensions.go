@@ -23,5 +23,10 @@ func (i *Installer) installExtensions() error {
 		Quirks:            quirks.New(i.options.Version),
 	}
 
-	return builder.Build()
+	if err := builder.Build(); err != nil {
+		return err
+	}
+
+	// kernel arguments required by the extensions
+	return i.cmdline.AppendAll(builder.KernelArgs())
 }
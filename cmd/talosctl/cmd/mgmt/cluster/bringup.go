@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	taloscluster "github.com/siderolabs/talos/pkg/cluster"
+	"github.com/siderolabs/talos/pkg/cluster/check"
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/access"
+)
+
+// bringUpCheckpointFile is the name of the bring-up checkpoint file in the cluster state directory.
+const bringUpCheckpointFile = "bringup.yaml"
+
+func bringUpCheckpointPath() string {
+	return filepath.Join(stateDir, clusterName, bringUpCheckpointFile)
+}
+
+// newBringUpCheckpoint builds the bring-up plan of the created cluster.
+func newBringUpCheckpoint(request provision.ClusterRequest, out io.Writer) (*taloscluster.BringUpCheckpoint, error) {
+	checkpoint := &taloscluster.BringUpCheckpoint{
+		ClusterName:   request.Name,
+		BootstrapEtcd: !withInitNode,
+		WaitHealthy:   clusterWait,
+	}
+
+	if !applyConfigEnabled {
+		return checkpoint, nil
+	}
+
+	for _, node := range request.Nodes {
+		cfgBytes, err := node.Config.Bytes()
+		if err != nil {
+			return nil, err
+		}
+
+		checkpoint.ApplyConfigNodes = append(checkpoint.ApplyConfigNodes, taloscluster.BringUpNode{
+			Name:     node.Name,
+			Endpoint: taloscluster.ApplyConfigEndpoint(node, request.SiderolinkRequest, out),
+			Config:   string(cfgBytes),
+		})
+	}
+
+	return checkpoint, nil
+}
+
+// runBringUp performs (or resumes) the cluster bring-up, and merges the kubeconfig once the cluster is healthy.
+func runBringUp(ctx context.Context, clusterAccess *access.Adapter, checkpoint *taloscluster.BringUpCheckpoint,
+	checks []check.ClusterCheck, waitTimeout time.Duration, mergeKubeconfigEnabled bool,
+) error {
+	bringUp := taloscluster.BringUp{
+		Bootstrapper: clusterAccess,
+		WaitHealthy: func(ctx context.Context, _ io.Writer) error {
+			checkCtx, checkCtxCancel := context.WithTimeout(ctx, waitTimeout)
+			defer checkCtxCancel()
+
+			return check.Wait(checkCtx, clusterAccess, checks, check.StderrReporter())
+		},
+		ConfigApplied:  taloscluster.ConfigAppliedAuthenticated(clusterAccess),
+		CheckpointPath: bringUpCheckpointPath(),
+	}
+
+	if err := bringUp.Run(ctx, checkpoint, os.Stdout); err != nil {
+		return err
+	}
+
+	if !checkpoint.WaitHealthy || !mergeKubeconfigEnabled {
+		return nil
+	}
+
+	return mergeKubeconfig(ctx, clusterAccess)
+}
@@ -37,8 +37,10 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/firewallpatch"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/topology"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/mgmt/helpers"
 	"github.com/siderolabs/talos/pkg/cli"
+	taloscluster "github.com/siderolabs/talos/pkg/cluster"
 	"github.com/siderolabs/talos/pkg/cluster/check"
 	"github.com/siderolabs/talos/pkg/images"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
@@ -81,11 +83,14 @@ const (
 	clusterDiskPreallocateFlag   = "disk-preallocate"
 	clusterDisksFlag             = "user-disk"
 	clusterDiskSizeFlag          = "disk"
+	disksFlag                    = "disks"
+	extraDisksFlag               = "extra-disks"
 	useVIPFlag                   = "use-vip"
 	bootloaderEnabledFlag        = "with-bootloader"
 	controlPlanePortFlag         = "control-plane-port"
 	firewallFlag                 = "with-firewall"
 	tpm2EnabledFlag              = "with-tpm2"
+	topologyFlag                 = "topology"
 
 	// The following flags are the gen options - the options that are only used in machine configuration (i.e., not during the qemu/docker provisioning).
 	// They are not applicable when no machine configuration is generated, hence mutually exclusive with the --input-dir flag.
@@ -144,6 +149,7 @@ var (
 	extraDisks                int
 	extraDiskSize             int
 	extraDisksDrivers         []string
+	disksSpec                 []string
 	targetArch                string
 	clusterWait               bool
 	clusterWaitTimeout        time.Duration
@@ -188,6 +194,7 @@ var (
 	withFirewall              string
 	withUUIDHostnames         bool
 	withSiderolinkAgent       agentFlag
+	topologyPath              string
 )
 
 // createCmd represents the cluster up command.
@@ -297,6 +304,11 @@ func create(ctx context.Context) error {
 		return err
 	}
 
+	clusterTopology, err := loadTopology()
+	if err != nil {
+		return err
+	}
+
 	if controlplanes < 1 {
 		return errors.New("number of controlplanes can't be less than 1")
 	}
@@ -484,7 +496,18 @@ func create(ctx context.Context) error {
 		provisionOptions = append(provisionOptions, provision.WithDockerPorts(portList))
 	}
 
-	disks, err := getDisks()
+	var disks []*provision.Disk
+
+	if len(disksSpec) > 0 {
+		if provisionerName == docker {
+			return errors.New("disks flag is not supported with docker provisioner")
+		}
+
+		disks, err = parseDisksSpec(disksSpec)
+	} else {
+		disks, err = getDisks()
+	}
+
 	if err != nil {
 		return err
 	}
@@ -514,6 +537,10 @@ func create(ctx context.Context) error {
 
 		genOptions = append(genOptions, provisioner.GenOptions(request.Network)...)
 
+		if installDisk := systemDiskPath(disks[0].Driver); len(disksSpec) > 0 && installDisk != "" {
+			genOptions = append(genOptions, generate.WithInstallDisk(installDisk))
+		}
+
 		if customCNIUrl != "" {
 			genOptions = append(genOptions, generate.WithClusterCNIConfig(&v1alpha1.CNIConfig{
 				CNIName: constants.CustomCNI,
@@ -521,7 +548,7 @@ func create(ctx context.Context) error {
 			}))
 		}
 
-		if len(disks) > 1 {
+		if len(clusterDisks) > 0 {
 			// convert provision disks to machine disks
 			machineDisks := make([]*v1alpha1.MachineDisk, len(disks)-1)
 			for i, disk := range disks[1:] {
@@ -843,13 +870,27 @@ func create(ctx context.Context) error {
 			return err
 		}
 
+		spec := nodeSpec{
+			name:     nodeName(clusterName, "controlplane", i+1, nodeUUID),
+			memory:   controlPlaneMemory,
+			nanoCPUs: controlPlaneNanoCPUs,
+			disks:    disks,
+		}
+
+		if clusterTopology != nil {
+			spec, err = spec.withTopologyNode(clusterTopology.ControlPlanes()[i])
+			if err != nil {
+				return err
+			}
+		}
+
 		nodeReq := provision.NodeRequest{
-			Name:                nodeName(clusterName, "controlplane", i+1, nodeUUID),
+			Name:                spec.name,
 			Type:                machine.TypeControlPlane,
 			IPs:                 nodeIPs,
-			Memory:              controlPlaneMemory,
-			NanoCPUs:            controlPlaneNanoCPUs,
-			Disks:               disks,
+			Memory:              spec.memory,
+			NanoCPUs:            spec.nanoCPUs,
+			Disks:               spec.disks,
 			SkipInjectingConfig: skipInjectingConfig,
 			BadRTC:              badRTC,
 			ExtraKernelArgs:     extraKernelArgs,
@@ -863,6 +904,10 @@ func create(ctx context.Context) error {
 			cfg = configBundle.ControlPlane()
 		}
 
+		if cfg, err = spec.patchConfig(cfg); err != nil {
+			return err
+		}
+
 		if wireguardConfigBundle != nil {
 			cfg, err = wireguardConfigBundle.PatchConfig(nodeIPs[0], cfg)
 			if err != nil {
@@ -890,7 +935,28 @@ func create(ctx context.Context) error {
 	}
 
 	for i := 1; i <= workers; i++ {
-		cfg := configBundle.Worker()
+		nodeUUID := uuid.New()
+
+		spec := nodeSpec{
+			name:     nodeName(clusterName, "worker", i, nodeUUID),
+			memory:   workerMemory,
+			nanoCPUs: workerNanoCPUs,
+			disks:    disks,
+		}
+
+		if clusterTopology != nil {
+			spec, err = spec.withTopologyNode(clusterTopology.Workers()[i-1])
+			if err != nil {
+				return err
+			}
+		}
+
+		var cfg config.Provider
+
+		cfg, err = spec.patchConfig(configBundle.Worker())
+		if err != nil {
+			return err
+		}
 
 		nodeIPs := make([]netip.Addr, len(cidrs))
 		for j := range nodeIPs {
@@ -904,8 +970,6 @@ func create(ctx context.Context) error {
 			}
 		}
 
-		nodeUUID := uuid.New()
-
 		err = slb.DefineIPv6ForUUID(nodeUUID)
 		if err != nil {
 			return err
@@ -913,12 +977,12 @@ func create(ctx context.Context) error {
 
 		request.Nodes = append(request.Nodes,
 			provision.NodeRequest{
-				Name:                nodeName(clusterName, "worker", i, nodeUUID),
+				Name:                spec.name,
 				Type:                machine.TypeWorker,
 				IPs:                 nodeIPs,
-				Memory:              workerMemory,
-				NanoCPUs:            workerNanoCPUs,
-				Disks:               disks,
+				Memory:              spec.memory,
+				NanoCPUs:            spec.nanoCPUs,
+				Disks:               spec.disks,
 				Config:              cfg,
 				SkipInjectingConfig: skipInjectingConfig,
 				BadRTC:              badRTC,
@@ -934,6 +998,12 @@ func create(ctx context.Context) error {
 		return err
 	}
 
+	if clusterTopology != nil {
+		if err = saveTopology(clusterTopology); err != nil {
+			return err
+		}
+	}
+
 	// No talosconfig in the bundle - skip the operations below
 	if bundleTalosconfig == nil {
 		return nil
@@ -947,14 +1017,12 @@ func create(ctx context.Context) error {
 	clusterAccess := access.NewAdapter(cluster, provisionOptions...)
 	defer clusterAccess.Close() //nolint:errcheck
 
-	if applyConfigEnabled {
-		err = clusterAccess.ApplyConfig(ctx, request.Nodes, request.SiderolinkRequest, os.Stdout)
-		if err != nil {
-			return err
-		}
+	checkpoint, err := newBringUpCheckpoint(request, os.Stdout)
+	if err != nil {
+		return err
 	}
 
-	if err = postCreate(ctx, clusterAccess); err != nil {
+	if err = postCreate(ctx, clusterAccess, configBundle.ControlPlane(), checkpoint); err != nil {
 		if crashdumpOnFailure {
 			provisioner.CrashDump(ctx, cluster, os.Stderr)
 		}
@@ -965,48 +1033,158 @@ func create(ctx context.Context) error {
 	return showCluster(cluster)
 }
 
-func nodeName(clusterName, role string, index int, uuid uuid.UUID) string {
-	if withUUIDHostnames {
-		return fmt.Sprintf("machine-%s", uuid)
+// loadTopology loads the topology file (if set) and applies the network settings and the node counts from it.
+//
+//nolint:nilnil
+func loadTopology() (*topology.Topology, error) {
+	if topologyPath == "" {
+		return nil, nil
 	}
 
-	return fmt.Sprintf("%s-%s-%d", clusterName, role, index)
+	clusterTopology, err := topology.Load(topologyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if clusterTopology.Network.CIDR != "" {
+		networkCIDR = clusterTopology.Network.CIDR
+	}
+
+	if clusterTopology.Network.MTU != 0 {
+		networkMTU = clusterTopology.Network.MTU
+	}
+
+	if clusterTopology.Network.IPv4 != nil {
+		networkIPv4 = *clusterTopology.Network.IPv4
+	}
+
+	if clusterTopology.Network.IPv6 != nil {
+		networkIPv6 = *clusterTopology.Network.IPv6
+	}
+
+	if len(clusterTopology.Network.Nameservers) > 0 {
+		nameservers = clusterTopology.Network.Nameservers
+	}
+
+	controlplanes = len(clusterTopology.ControlPlanes())
+	workers = len(clusterTopology.Workers())
+	withInitNode = clusterTopology.HasInitNode()
+
+	return clusterTopology, nil
 }
 
-func postCreate(ctx context.Context, clusterAccess *access.Adapter) error {
-	if !withInitNode {
-		if err := clusterAccess.Bootstrap(ctx, os.Stdout); err != nil {
-			return fmt.Errorf("bootstrap error: %w", err)
-		}
+// saveTopology stores the topology with the cluster state, so that the cluster can be re-created from it.
+func saveTopology(clusterTopology *topology.Topology) error {
+	data, err := clusterTopology.Marshal()
+	if err != nil {
+		return fmt.Errorf("error marshaling topology: %w", err)
 	}
 
-	if !clusterWait {
-		return nil
+	clusterStateDir := filepath.Join(stateDir, clusterName)
+
+	if err = os.MkdirAll(clusterStateDir, 0o755); err != nil {
+		return fmt.Errorf("error creating cluster state directory: %w", err)
 	}
 
-	// Run cluster readiness checks
-	checkCtx, checkCtxCancel := context.WithTimeout(ctx, clusterWaitTimeout)
-	defer checkCtxCancel()
+	return os.WriteFile(filepath.Join(clusterStateDir, topology.FileName), data, 0o644)
+}
 
-	checks := check.DefaultClusterChecks()
+// nodeSpec is the name, resources and config patches of a node.
+type nodeSpec struct {
+	name     string
+	memory   int64
+	nanoCPUs int64
+	disks    []*provision.Disk
+	patches  []configpatcher.Patch
+}
 
-	if skipK8sNodeReadinessCheck {
-		checks = slices.Concat(check.PreBootSequenceChecks(), check.K8sComponentsReadinessChecks())
+// withTopologyNode overrides the node spec with the settings of the topology node.
+func (spec nodeSpec) withTopologyNode(node topology.Node) (nodeSpec, error) {
+	var err error
+
+	if node.Name != "" {
+		spec.name = node.Name
 	}
 
-	checks = append(checks, check.ExtraClusterChecks()...)
+	if node.CPUs != "" {
+		spec.nanoCPUs, err = parseCPUShare(node.CPUs)
+		if err != nil {
+			return spec, fmt.Errorf("error parsing cpus of the topology node %q: %w", spec.name, err)
+		}
+	}
 
-	if err := check.Wait(checkCtx, clusterAccess, checks, check.StderrReporter()); err != nil {
-		return err
+	if node.Memory != 0 {
+		spec.memory = int64(node.Memory) * 1024 * 1024
 	}
 
-	if !skipKubeconfig {
-		if err := mergeKubeconfig(ctx, clusterAccess); err != nil {
-			return err
+	if len(node.Disks) > 0 {
+		if provisionerName == docker {
+			return spec, errors.New("topology node disks are not supported with docker provisioner")
+		}
+
+		spec.disks, err = parseDisksSpec(node.Disks)
+		if err != nil {
+			return spec, fmt.Errorf("error parsing disks of the topology node %q: %w", spec.name, err)
+		}
+
+		if installDisk := systemDiskPath(spec.disks[0].Driver); installDisk != "" {
+			var patch configpatcher.Patch
+
+			patch, err = configpatcher.LoadPatch([]byte(fmt.Sprintf(`[{"op": "add", "path": "/machine/install/disk", "value": %q}]`, installDisk)))
+			if err != nil {
+				return spec, err
+			}
+
+			spec.patches = append(spec.patches, patch)
 		}
 	}
 
-	return nil
+	for _, configPatch := range node.ConfigPatches {
+		var patch configpatcher.Patch
+
+		patch, err = configpatcher.LoadPatch([]byte(configPatch))
+		if err != nil {
+			return spec, fmt.Errorf("error parsing config patch of the topology node %q: %w", spec.name, err)
+		}
+
+		spec.patches = append(spec.patches, patch)
+	}
+
+	return spec, nil
+}
+
+// patchConfig applies the node config patches to the machine configuration.
+func (spec nodeSpec) patchConfig(cfg config.Provider) (config.Provider, error) {
+	if len(spec.patches) == 0 || cfg == nil {
+		return cfg, nil
+	}
+
+	out, err := configpatcher.Apply(configpatcher.WithConfig(cfg), spec.patches)
+	if err != nil {
+		return nil, fmt.Errorf("error patching config of the node %q: %w", spec.name, err)
+	}
+
+	return out.Config()
+}
+
+func nodeName(clusterName, role string, index int, uuid uuid.UUID) string {
+	if withUUIDHostnames {
+		return fmt.Sprintf("machine-%s", uuid)
+	}
+
+	return fmt.Sprintf("%s-%s-%d", clusterName, role, index)
+}
+
+func postCreate(ctx context.Context, clusterAccess *access.Adapter, controlPlaneCfg config.Provider, checkpoint *taloscluster.BringUpCheckpoint) error {
+	checks := check.ClusterChecksForCNI(controlPlaneCfg.Cluster().Network().CNI().Readiness())
+
+	if skipK8sNodeReadinessCheck {
+		checks = slices.Concat(check.PreBootSequenceChecks(), check.K8sComponentsReadinessChecks())
+	}
+
+	checks = append(checks, check.ExtraClusterChecks()...)
+
+	return runBringUp(ctx, clusterAccess, checkpoint, checks, clusterWaitTimeout, !skipKubeconfig)
 }
 
 func saveConfig(talosConfigObj *clientconfig.Config) (err error) {
@@ -1150,6 +1328,52 @@ func getDisks() ([]*provision.Disk, error) {
 	return disks, nil
 }
 
+// parseDisksSpec parses the disk topology in format <driver1>:<size1>[,<driver2>:<size2>...].
+//
+// The first disk is the system disk, the rest are left blank.
+func parseDisksSpec(spec []string) ([]*provision.Disk, error) {
+	disks := make([]*provision.Disk, 0, len(spec))
+
+	for _, disk := range spec {
+		driver, size, ok := strings.Cut(disk, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid disk spec %q: expected <driver>:<size>", disk)
+		}
+
+		if !slices.Contains(diskDrivers, driver) {
+			return nil, fmt.Errorf("invalid disk spec %q: unsupported driver %q, supported drivers: %s", disk, driver, strings.Join(diskDrivers, ", "))
+		}
+
+		diskSize, err := humanize.ParseBytes(size)
+		if err != nil {
+			return nil, fmt.Errorf("invalid disk spec %q: failed to parse size: %w", disk, err)
+		}
+
+		disks = append(disks, &provision.Disk{
+			Size:            diskSize,
+			SkipPreallocate: !clusterDiskPreallocate,
+			Driver:          driver,
+		})
+	}
+
+	return disks, nil
+}
+
+// diskDrivers are the disk drivers supported by the QEMU provisioner.
+var diskDrivers = []string{"virtio", "ide", "ahci", "scsi", "nvme"}
+
+// systemDiskPath returns the device path of the system disk for the disk driver.
+func systemDiskPath(driver string) string {
+	switch driver {
+	case "nvme":
+		return "/dev/nvme0n1"
+	case "ide", "ahci", "scsi":
+		return "/dev/sda"
+	default:
+		return ""
+	}
+}
+
 func init() {
 	createCmd.Flags().StringVar(
 		&talosconfig,
@@ -1194,8 +1418,10 @@ func init() {
 	createCmd.Flags().IntVar(&clusterDiskSize, clusterDiskSizeFlag, 6*1024, "default limit on disk size in MB (each VM)")
 	createCmd.Flags().BoolVar(&clusterDiskPreallocate, clusterDiskPreallocateFlag, true, "whether disk space should be preallocated")
 	createCmd.Flags().StringSliceVar(&clusterDisks, clusterDisksFlag, []string{}, "list of disks to create for each VM in format: <mount_point1>:<size1>:<mount_point2>:<size2>")
-	createCmd.Flags().IntVar(&extraDisks, "extra-disks", 0, "number of extra disks to create for each worker VM")
+	createCmd.Flags().IntVar(&extraDisks, extraDisksFlag, 0, "number of extra disks to create for each worker VM")
 	createCmd.Flags().StringSliceVar(&extraDisksDrivers, "extra-disks-drivers", nil, "driver for each extra disk (virtio, ide, ahci, scsi, nvme)")
+	createCmd.Flags().StringSliceVar(&disksSpec, disksFlag, nil,
+		"list of disks to create for each VM in format: <driver1>:<size1>[,<driver2>:<size2>...], the first disk is the system disk (e.g. 'nvme:10GiB,virtio:5GiB')")
 	createCmd.Flags().IntVar(&extraDiskSize, "extra-disks-size", 5*1024, "default limit on disk size in MB (each VM)")
 	createCmd.Flags().StringVar(&targetArch, "arch", stdruntime.GOARCH, "cluster architecture")
 	createCmd.Flags().BoolVar(&clusterWait, "wait", true, "wait for the cluster to be ready before returning")
@@ -1250,8 +1476,17 @@ func init() {
 	createCmd.Flags().IntVar(&bandwidth, "with-network-bandwidth", 0, "specify bandwidth restriction (in kbps) on the bridge interface when creating a qemu cluster")
 	createCmd.Flags().StringVar(&withFirewall, firewallFlag, "", "inject firewall rules into the cluster, value is default policy - accept/block (QEMU only)")
 	createCmd.Flags().BoolVar(&withUUIDHostnames, "with-uuid-hostnames", false, "use machine UUIDs as default hostnames (QEMU only)")
+	createCmd.Flags().StringVar(&topologyPath, topologyFlag, "", "path to the cluster topology file (nodes, roles, resources, network, and config patches per node)")
 	createCmd.Flags().Var(&withSiderolinkAgent, "with-siderolink", "enables the use of siderolink agent as configuration apply mechanism. `true` or `wireguard` enables the agent, `tunnel` enables the agent with grpc tunneling") //nolint:lll
 
+	createCmd.MarkFlagsMutuallyExclusive(disksFlag, clusterDiskSizeFlag)
+	createCmd.MarkFlagsMutuallyExclusive(disksFlag, clusterDisksFlag)
+	createCmd.MarkFlagsMutuallyExclusive(disksFlag, extraDisksFlag)
+
+	for _, flag := range []string{"workers", "masters", "controlplanes", "with-init-node"} {
+		createCmd.MarkFlagsMutuallyExclusive(topologyFlag, flag)
+	}
+
 	createCmd.MarkFlagsMutuallyExclusive(inputDirFlag, nodeInstallImageFlag)
 	createCmd.MarkFlagsMutuallyExclusive(inputDirFlag, configDebugFlag)
 	createCmd.MarkFlagsMutuallyExclusive(inputDirFlag, dnsDomainFlag)
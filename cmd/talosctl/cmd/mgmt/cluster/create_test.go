@@ -0,0 +1,145 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster //nolint:testpackage // to test unexported function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDisksSpec(t *testing.T) {
+	t.Parallel()
+
+	type disk struct {
+		driver string
+		size   uint64
+	}
+
+	for _, test := range []struct {
+		name string
+		spec []string
+
+		expected      []disk
+		expectedError string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "system and extra disks",
+			spec: []string{"nvme:10GiB", "virtio:5GB", "scsi:1024"},
+
+			expected: []disk{
+				{driver: "nvme", size: 10 * 1024 * 1024 * 1024},
+				{driver: "virtio", size: 5 * 1000 * 1000 * 1000},
+				{driver: "scsi", size: 1024},
+			},
+		},
+		{
+			name: "all drivers",
+			spec: []string{"virtio:1GiB", "ide:1GiB", "ahci:1GiB", "scsi:1GiB", "nvme:1GiB"},
+
+			expected: []disk{
+				{driver: "virtio", size: 1024 * 1024 * 1024},
+				{driver: "ide", size: 1024 * 1024 * 1024},
+				{driver: "ahci", size: 1024 * 1024 * 1024},
+				{driver: "scsi", size: 1024 * 1024 * 1024},
+				{driver: "nvme", size: 1024 * 1024 * 1024},
+			},
+		},
+		{
+			name: "no size",
+			spec: []string{"nvme"},
+
+			expectedError: `invalid disk spec "nvme": expected <driver>:<size>`,
+		},
+		{
+			name: "empty spec",
+			spec: []string{""},
+
+			expectedError: `invalid disk spec "": expected <driver>:<size>`,
+		},
+		{
+			name: "unknown driver",
+			spec: []string{"virtio:10GiB", "floppy:1MiB"},
+
+			expectedError: `invalid disk spec "floppy:1MiB": unsupported driver "floppy", supported drivers: virtio, ide, ahci, scsi, nvme`,
+		},
+		{
+			name: "driver case",
+			spec: []string{"NVMe:10GiB"},
+
+			expectedError: `unsupported driver "NVMe"`,
+		},
+		{
+			name: "bad size",
+			spec: []string{"virtio:big"},
+
+			expectedError: `invalid disk spec "virtio:big": failed to parse size`,
+		},
+		{
+			name: "empty size",
+			spec: []string{"virtio:"},
+
+			expectedError: `invalid disk spec "virtio:": failed to parse size`,
+		},
+		{
+			name: "extra field",
+			spec: []string{"virtio:10GiB:5GiB"},
+
+			expectedError: `invalid disk spec "virtio:10GiB:5GiB": failed to parse size`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			disks, err := parseDisksSpec(test.spec)
+
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, disks, len(test.expected))
+
+			for i, expected := range test.expected {
+				assert.Equal(t, expected.driver, disks[i].Driver)
+				assert.Equal(t, expected.size, disks[i].Size)
+				assert.Equal(t, !clusterDiskPreallocate, disks[i].SkipPreallocate)
+			}
+		})
+	}
+}
+
+func TestSystemDiskPath(t *testing.T) {
+	t.Parallel()
+
+	expected := map[string]string{
+		// virtio system disk keeps the default install disk of the generated config
+		"virtio": "",
+		"ide":    "/dev/sda",
+		"ahci":   "/dev/sda",
+		"scsi":   "/dev/sda",
+		"nvme":   "/dev/nvme0n1",
+		"":       "",
+	}
+
+	// every supported driver is covered
+	for _, driver := range diskDrivers {
+		assert.Contains(t, expected, driver)
+	}
+
+	for driver, path := range expected {
+		t.Run(driver, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, path, systemDiskPath(driver))
+		})
+	}
+}
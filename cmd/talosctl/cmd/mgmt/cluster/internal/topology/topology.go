@@ -0,0 +1,290 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package topology implements the declarative topology file for the local clusters.
+package topology
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Node roles.
+const (
+	RoleInit         = "init"
+	RoleControlPlane = "controlplane"
+	RoleWorker       = "worker"
+)
+
+// FileName is the name of the topology file stored in the cluster state directory.
+const FileName = "topology.yaml"
+
+// Topology describes the nodes of the local cluster, their roles, resources, and config patches.
+type Topology struct {
+	Network Network `yaml:"network,omitempty"`
+	Nodes   []Node  `yaml:"nodes"`
+}
+
+// Network describes the cluster network.
+//
+// Empty fields keep the values of the command line flags.
+type Network struct {
+	CIDR        string   `yaml:"cidr,omitempty"`
+	MTU         int      `yaml:"mtu,omitempty"`
+	IPv4        *bool    `yaml:"ipv4,omitempty"`
+	IPv6        *bool    `yaml:"ipv6,omitempty"`
+	Nameservers []string `yaml:"nameservers,omitempty"`
+}
+
+// Node describes a node or a group of identical nodes.
+//
+// Empty resource fields keep the values of the command line flags.
+type Node struct {
+	// Name of the node, the nodes of the group get the index suffix; defaults to the generated name.
+	Name string `yaml:"name,omitempty"`
+	// Role is one of init, controlplane or worker.
+	Role string `yaml:"role"`
+	// Count of the nodes in the group, defaults to 1.
+	Count int `yaml:"count,omitempty"`
+	// CPUs is the share of CPUs as fraction.
+	CPUs string `yaml:"cpus,omitempty"`
+	// Memory is the memory limit in MiB.
+	Memory int `yaml:"memory,omitempty"`
+	// Disks in the format <driver>:<size>, the first disk is the system disk.
+	Disks []string `yaml:"disks,omitempty"`
+	// ConfigPatches are applied to the machine configuration of the node.
+	ConfigPatches []ConfigPatch `yaml:"configPatches,omitempty"`
+}
+
+// ConfigPatch is a machine configuration patch.
+//
+// Patch is either inline YAML (strategic merge or JSON patch), or a path to the patch file prefixed with '@'.
+// Relative paths are resolved against the directory of the topology file.
+type ConfigPatch string
+
+// UnmarshalYAML implements yaml.Unmarshaler interface.
+//
+// Patches can be specified both as YAML documents and as strings.
+func (patch *ConfigPatch) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*patch = ConfigPatch(node.Value)
+
+		return nil
+	}
+
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(node); err != nil {
+		return err
+	}
+
+	*patch = ConfigPatch(buf.String())
+
+	return nil
+}
+
+// Load the topology from the file.
+//
+// Patch files referenced in the topology are inlined, so that the topology is self-contained.
+func Load(path string) (*Topology, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading topology file: %w", err)
+	}
+
+	topology, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("error loading topology file %q: %w", path, err)
+	}
+
+	if err = topology.inlinePatches(filepath.Dir(path)); err != nil {
+		return nil, fmt.Errorf("error loading topology file %q: %w", path, err)
+	}
+
+	return topology, nil
+}
+
+// Parse the topology from the YAML document.
+func Parse(data []byte) (*Topology, error) {
+	var topology Topology
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	if err := dec.Decode(&topology); err != nil {
+		return nil, fmt.Errorf("error decoding topology: %w", err)
+	}
+
+	if err := topology.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &topology, nil
+}
+
+// Validate the topology.
+//
+//nolint:gocyclo
+func (topology *Topology) Validate() error {
+	var errs []error
+
+	if topology.Network.CIDR != "" {
+		if prefix, err := netip.ParsePrefix(topology.Network.CIDR); err != nil || !prefix.Addr().Is4() {
+			errs = append(errs, fmt.Errorf("network CIDR %q is not a valid IPv4 CIDR", topology.Network.CIDR))
+		}
+	}
+
+	if topology.Network.MTU < 0 {
+		errs = append(errs, fmt.Errorf("network MTU %d is invalid", topology.Network.MTU))
+	}
+
+	for _, nameserver := range topology.Network.Nameservers {
+		if _, err := netip.ParseAddr(nameserver); err != nil {
+			errs = append(errs, fmt.Errorf("nameserver %q is not a valid IP address", nameserver))
+		}
+	}
+
+	var (
+		controlPlanes, initNodes int
+		names                    = map[string]struct{}{}
+	)
+
+	for i, node := range topology.Nodes {
+		id := fmt.Sprintf("node %d", i+1)
+		if node.Name != "" {
+			id = fmt.Sprintf("node %q", node.Name)
+
+			if _, ok := names[node.Name]; ok {
+				errs = append(errs, fmt.Errorf("%s: duplicate name", id))
+			}
+
+			names[node.Name] = struct{}{}
+		}
+
+		switch node.Role {
+		case RoleInit:
+			initNodes += node.count()
+			controlPlanes += node.count()
+		case RoleControlPlane:
+			controlPlanes += node.count()
+		case RoleWorker:
+		default:
+			errs = append(errs, fmt.Errorf("%s: unknown role %q, expected one of %s, %s, %s", id, node.Role, RoleInit, RoleControlPlane, RoleWorker))
+		}
+
+		if node.Count < 0 {
+			errs = append(errs, fmt.Errorf("%s: count can't be negative", id))
+		}
+
+		if node.Memory < 0 {
+			errs = append(errs, fmt.Errorf("%s: memory can't be negative", id))
+		}
+	}
+
+	if controlPlanes == 0 {
+		errs = append(errs, errors.New("topology should have at least one controlplane node"))
+	}
+
+	if initNodes > 1 {
+		errs = append(errs, errors.New("topology can have at most one init node"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// ControlPlanes returns the list of the controlplane nodes (the init node goes first), with the groups expanded.
+func (topology *Topology) ControlPlanes() []Node {
+	return append(topology.expand(RoleInit), topology.expand(RoleControlPlane)...)
+}
+
+// Workers returns the list of the worker nodes, with the groups expanded.
+func (topology *Topology) Workers() []Node {
+	return topology.expand(RoleWorker)
+}
+
+// HasInitNode returns true if the topology has the init node.
+func (topology *Topology) HasInitNode() bool {
+	return len(topology.expand(RoleInit)) > 0
+}
+
+// Marshal the topology to YAML.
+func (topology *Topology) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(topology); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (topology *Topology) expand(role string) []Node {
+	var nodes []Node
+
+	for _, node := range topology.Nodes {
+		if node.Role != role {
+			continue
+		}
+
+		count := node.count()
+
+		for i := range count {
+			expanded := node
+			expanded.Count = 1
+
+			if node.Name != "" && count > 1 {
+				expanded.Name = fmt.Sprintf("%s-%d", node.Name, i+1)
+			}
+
+			nodes = append(nodes, expanded)
+		}
+	}
+
+	return nodes
+}
+
+func (topology *Topology) inlinePatches(baseDir string) error {
+	for i := range topology.Nodes {
+		for j, patch := range topology.Nodes[i].ConfigPatches {
+			path, ok := strings.CutPrefix(string(patch), "@")
+			if !ok {
+				continue
+			}
+
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(baseDir, path)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("error reading config patch: %w", err)
+			}
+
+			topology.Nodes[i].ConfigPatches[j] = ConfigPatch(data)
+		}
+	}
+
+	return nil
+}
+
+func (node Node) count() int {
+	if node.Count == 0 {
+		return 1
+	}
+
+	return node.Count
+}
@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package topology_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/topology"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "worker.yaml"), []byte("machine:\n  nodeLabels:\n    role: storage\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "topology.yaml"), []byte(`network:
+  cidr: 10.6.0.0/24
+  mtu: 1450
+nodes:
+  - role: init
+    cpus: "4"
+    memory: 4096
+  - role: controlplane
+    count: 2
+  - name: storage
+    role: worker
+    count: 2
+    disks:
+      - virtio:10GiB
+      - nvme:20GiB
+    configPatches:
+      - "@worker.yaml"
+      - machine:
+          sysctls:
+            vm.nr_hugepages: "64"
+`), 0o644))
+
+	clusterTopology, err := topology.Load(filepath.Join(dir, "topology.yaml"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "10.6.0.0/24", clusterTopology.Network.CIDR)
+	assert.Equal(t, 1450, clusterTopology.Network.MTU)
+	assert.True(t, clusterTopology.HasInitNode())
+
+	controlPlanes := clusterTopology.ControlPlanes()
+	require.Len(t, controlPlanes, 3)
+	assert.Equal(t, topology.RoleInit, controlPlanes[0].Role)
+	assert.Equal(t, 4096, controlPlanes[0].Memory)
+	assert.Equal(t, topology.RoleControlPlane, controlPlanes[2].Role)
+
+	workers := clusterTopology.Workers()
+	require.Len(t, workers, 2)
+	assert.Equal(t, "storage-1", workers[0].Name)
+	assert.Equal(t, "storage-2", workers[1].Name)
+	assert.Equal(t, []string{"virtio:10GiB", "nvme:20GiB"}, workers[1].Disks)
+
+	// patch files are inlined
+	require.Len(t, workers[0].ConfigPatches, 2)
+	assert.Equal(t, topology.ConfigPatch("machine:\n  nodeLabels:\n    role: storage\n"), workers[0].ConfigPatches[0])
+	assert.Equal(t, topology.ConfigPatch("machine:\n  sysctls:\n    vm.nr_hugepages: \"64\"\n"), workers[0].ConfigPatches[1])
+
+	// stored topology is self-contained
+	data, err := clusterTopology.Marshal()
+	require.NoError(t, err)
+
+	reloaded, err := topology.Parse(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, clusterTopology, reloaded)
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		topology string
+		err      string
+	}{
+		{
+			name: "no controlplanes",
+			topology: `nodes:
+  - role: worker
+`,
+			err: "topology should have at least one controlplane node",
+		},
+		{
+			name: "unknown role",
+			topology: `nodes:
+  - role: controlplane
+  - name: lb
+    role: loadbalancer
+`,
+			err: `node "lb": unknown role "loadbalancer", expected one of init, controlplane, worker`,
+		},
+		{
+			name: "multiple init nodes",
+			topology: `nodes:
+  - role: init
+    count: 2
+`,
+			err: "topology can have at most one init node",
+		},
+		{
+			name: "invalid network",
+			topology: `network:
+  cidr: fd00::/64
+nodes:
+  - role: controlplane
+`,
+			err: `network CIDR "fd00::/64" is not a valid IPv4 CIDR`,
+		},
+		{
+			name: "unknown field",
+			topology: `nodes:
+  - role: controlplane
+    gpus: 1
+`,
+			err: "error decoding topology: yaml: unmarshal errors:\n  line 3: field gpus not found in type topology.Node",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := topology.Parse([]byte(test.topology))
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/cli"
+	taloscluster "github.com/siderolabs/talos/pkg/cluster"
+	"github.com/siderolabs/talos/pkg/cluster/check"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	configres "github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/access"
+	"github.com/siderolabs/talos/pkg/provision/providers"
+)
+
+var resumeCmdFlags struct {
+	talosconfig               string
+	waitTimeout               time.Duration
+	skipKubeconfig            bool
+	skipK8sNodeReadinessCheck bool
+}
+
+// resumeCmd represents the cluster resume command.
+var resumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resumes the interrupted bring-up of a local cluster",
+	Long: `Resumes the bring-up of a local cluster created with 'talosctl cluster create' from the recorded checkpoint.
+
+The steps completed before the interruption (applying the configs to each node, bootstrapping etcd,
+waiting for the cluster to be healthy) are skipped, and the bring-up continues from the first incomplete step.
+The current context of the talosconfig is used to access the cluster.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cli.WithContext(context.Background(), resume)
+	},
+}
+
+func resume(ctx context.Context) error {
+	checkpoint, err := taloscluster.LoadBringUpCheckpoint(bringUpCheckpointPath())
+	if err != nil {
+		return err
+	}
+
+	if checkpoint == nil {
+		return fmt.Errorf("no bring-up checkpoint found for the cluster %q", clusterName)
+	}
+
+	if checkpoint.Done() {
+		fmt.Fprintf(os.Stderr, "bring-up of the cluster %q is already completed\n", clusterName)
+
+		return nil
+	}
+
+	provisioner, err := providers.Factory(ctx, provisionerName)
+	if err != nil {
+		return err
+	}
+
+	defer provisioner.Close() //nolint:errcheck
+
+	cluster, err := provisioner.Reflect(ctx, clusterName, stateDir)
+	if err != nil {
+		return err
+	}
+
+	talosConfig, err := clientconfig.Open(resumeCmdFlags.talosconfig)
+	if err != nil {
+		return fmt.Errorf("error opening talos config: %w", err)
+	}
+
+	clusterAccess := access.NewAdapter(cluster, provision.WithTalosConfig(talosConfig))
+	defer clusterAccess.Close() //nolint:errcheck
+
+	var checks []check.ClusterCheck
+
+	if checkpoint.WaitHealthy && !checkpoint.Completed(taloscluster.BringUpStepWaitHealthy) {
+		if checks, err = resumeClusterChecks(ctx, clusterAccess); err != nil {
+			return err
+		}
+	}
+
+	return runBringUp(ctx, clusterAccess, checkpoint, checks, resumeCmdFlags.waitTimeout, !resumeCmdFlags.skipKubeconfig)
+}
+
+// resumeClusterChecks builds the cluster readiness checks for the CNI readiness settings of the running control plane node.
+func resumeClusterChecks(ctx context.Context, clusterAccess *access.Adapter) ([]check.ClusterCheck, error) {
+	if resumeCmdFlags.skipK8sNodeReadinessCheck {
+		return slices.Concat(check.PreBootSequenceChecks(), check.K8sComponentsReadinessChecks(), check.ExtraClusterChecks()), nil
+	}
+
+	controlPlaneNodes := clusterAccess.NodesByType(machine.TypeControlPlane)
+	if len(controlPlaneNodes) == 0 {
+		controlPlaneNodes = clusterAccess.NodesByType(machine.TypeInit)
+	}
+
+	if len(controlPlaneNodes) == 0 {
+		return nil, errors.New("no control plane nodes found")
+	}
+
+	c, err := clusterAccess.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := safe.StateGetByID[*configres.MachineConfig](client.WithNode(ctx, controlPlaneNodes[0].IPs[0].String()), c.COSI, configres.V1Alpha1ID)
+	if err != nil {
+		return nil, fmt.Errorf("error reading machine config: %w", err)
+	}
+
+	return append(check.ClusterChecksForCNI(cfg.Config().Cluster().Network().CNI().Readiness()), check.ExtraClusterChecks()...), nil
+}
+
+func init() {
+	resumeCmd.Flags().StringVar(
+		&resumeCmdFlags.talosconfig,
+		"talosconfig",
+		"",
+		fmt.Sprintf("The path to the Talos configuration file. Defaults to '%s' env variable if set, otherwise '%s' and '%s' in order.",
+			constants.TalosConfigEnvVar,
+			filepath.Join("$HOME", constants.TalosDir, constants.TalosconfigFilename),
+			filepath.Join(constants.ServiceAccountMountPath, constants.TalosconfigFilename),
+		),
+	)
+	resumeCmd.Flags().DurationVar(&resumeCmdFlags.waitTimeout, "wait-timeout", 20*time.Minute, "timeout to wait for the cluster to be ready")
+	resumeCmd.Flags().BoolVar(&resumeCmdFlags.skipKubeconfig, "skip-kubeconfig", false, "skip merging kubeconfig from the created cluster")
+	resumeCmd.Flags().BoolVar(&resumeCmdFlags.skipK8sNodeReadinessCheck, "skip-k8s-node-readiness-check", false, "skip k8s node readiness checks")
+
+	Cmd.AddCommand(resumeCmd)
+}
@@ -53,13 +53,14 @@ type configOutputPaths struct {
 }
 
 var genConfigCmdFlags struct {
-	additionalSANs    []string
-	configVersion     string
-	dnsDomain         string
-	kubernetesVersion string
-	talosVersion      string
-	installDisk       string
-	installImage      string
+	additionalSANs      []string
+	additionalEndpoints []string
+	configVersion       string
+	dnsDomain           string
+	kubernetesVersion   string
+	talosVersion        string
+	installDisk         string
+	installImage        string
 
 	// outputDir is a hidden flag kept for backwards compatibility
 	outputDir string
@@ -76,6 +77,31 @@ var genConfigCmdFlags struct {
 	withClusterDiscovery    bool
 	withKubeSpan            bool
 	withSecrets             string
+	secretsReference        string
+	fromSpec                string
+	workerPools             string
+	imagesManifest          string
+}
+
+// specConflictingFlags are the config generation flags which can't be used with --from-spec.
+var specConflictingFlags = []string{
+	"install-disk",
+	"install-image",
+	"additional-sans",
+	"additional-endpoints",
+	"dns-domain",
+	"talos-version",
+	"kubernetes-version",
+	"config-patch",
+	"config-patch-control-plane",
+	"config-patch-worker",
+	"registry-mirror",
+	"persist",
+	"with-cluster-discovery",
+	"with-kubespan",
+	"with-secrets",
+	"secrets-reference",
+	"images-manifest",
 }
 
 // NewConfigCmd builds the config generation subcommand with the given name.
@@ -86,9 +112,28 @@ func NewConfigCmd(name string) *cobra.Command {
 		Long: `The cluster endpoint is the URL for the Kubernetes API. If you decide to use
 a control plane node, common in a single node control plane setup, use port 6443 as
 this is the port that the API server binds to on every control plane node. For an HA
-setup, usually involving a load balancer, use the IP and port of the load balancer.`,
-		Args: cobra.ExactArgs(2),
+setup, usually involving a load balancer, use the IP and port of the load balancer.
+
+With --from-spec, the cluster name, endpoint and generation options are read from
+the config bundle spec file (the same format consumed by the bootstrap providers).`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if genConfigCmdFlags.fromSpec != "" {
+				return cobra.NoArgs(cmd, args)
+			}
+
+			return cobra.ExactArgs(2)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if genConfigCmdFlags.fromSpec != "" {
+				for _, flag := range specConflictingFlags {
+					if cmd.Flags().Changed(flag) {
+						return fmt.Errorf("flag --%s can't be used with --from-spec", flag)
+					}
+				}
+
+				return writeConfigFromSpec(genConfigCmdFlags.fromSpec)
+			}
+
 			err := validateClusterEndpoint(args[1])
 			if err != nil {
 				return err
@@ -96,7 +141,7 @@ setup, usually involving a load balancer, use the IP and port of the load balanc
 
 			switch genConfigCmdFlags.configVersion {
 			case "v1alpha1":
-				return writeConfig(args)
+				return writeConfig(cmd, args)
 			default:
 				return fmt.Errorf("unknown config version: %q", genConfigCmdFlags.configVersion)
 			}
@@ -174,7 +219,7 @@ func GenerateConfigBundle(genOptions []generate.Option,
 }
 
 //nolint:gocyclo
-func writeConfig(args []string) error {
+func writeConfig(cmd *cobra.Command, args []string) error {
 	if err := validateFlags(); err != nil {
 		return err
 	}
@@ -225,29 +270,54 @@ func writeConfig(args []string) error {
 		genOptions = append(genOptions, generate.WithSecretsBundle(secretsBundle))
 	}
 
+	if genConfigCmdFlags.secretsReference != "" {
+		// the secrets omitted from the machine config should be provided when the config is applied
+		if genConfigCmdFlags.withSecrets == "" {
+			return errors.New("flag --secrets-reference requires the secrets bundle to be provided with --with-secrets")
+		}
+
+		genOptions = append(genOptions, generate.WithSecretsReference(genConfigCmdFlags.secretsReference))
+	}
+
 	genOptions = append(genOptions,
 		generate.WithInstallDisk(genConfigCmdFlags.installDisk),
 		generate.WithInstallImage(genConfigCmdFlags.installImage),
 		generate.WithAdditionalSubjectAltNames(genConfigCmdFlags.additionalSANs),
+		generate.WithAdditionalControlPlaneEndpoints(genConfigCmdFlags.additionalEndpoints),
 		generate.WithDNSDomain(genConfigCmdFlags.dnsDomain),
 		generate.WithPersist(genConfigCmdFlags.persistConfig),
 		generate.WithClusterDiscovery(genConfigCmdFlags.withClusterDiscovery),
 	)
 
-	commentsFlags := encoder.CommentsDisabled
-	if genConfigCmdFlags.withDocs {
-		commentsFlags |= encoder.CommentsDocs
-	}
+	kubernetesVersion := genConfigCmdFlags.kubernetesVersion
 
-	if genConfigCmdFlags.withExamples {
-		commentsFlags |= encoder.CommentsExamples
+	if genConfigCmdFlags.imagesManifest != "" {
+		var manifest *images.Manifest
+
+		manifest, err = images.LoadManifest(genConfigCmdFlags.imagesManifest)
+		if err != nil {
+			return fmt.Errorf("failed to load images manifest: %w", err)
+		}
+
+		if cmd.Flags().Changed("kubernetes-version") && strings.TrimPrefix(kubernetesVersion, "v") != strings.TrimPrefix(manifest.KubernetesVersion, "v") {
+			return fmt.Errorf("kubernetes version %q doesn't match the images manifest version %q", kubernetesVersion, manifest.KubernetesVersion)
+		}
+
+		kubernetesVersion = manifest.KubernetesVersion
+
+		genOptions = append(genOptions, manifest.GenerateOptions()...)
+
+		// the manifest pins the installer image unless it is set explicitly
+		if cmd.Flags().Changed("install-image") {
+			genOptions = append(genOptions, generate.WithInstallImage(genConfigCmdFlags.installImage))
+		}
 	}
 
 	configBundle, err := GenerateConfigBundle(
 		genOptions,
 		args[0],
 		args[1],
-		genConfigCmdFlags.kubernetesVersion,
+		kubernetesVersion,
 		genConfigCmdFlags.configPatch,
 		genConfigCmdFlags.configPatchControlPlane,
 		genConfigCmdFlags.configPatchWorker)
@@ -255,7 +325,59 @@ func writeConfig(args []string) error {
 		return err
 	}
 
-	return writeConfigBundle(configBundle, paths, commentsFlags)
+	return writeConfigBundle(configBundle, paths, commentsFlags())
+}
+
+func writeConfigFromSpec(specPath string) error {
+	if err := validateFlags(); err != nil {
+		return err
+	}
+
+	paths, err := outputPaths()
+	if err != nil {
+		return err
+	}
+
+	spec, err := bundle.LoadSpec(specPath)
+	if err != nil {
+		return fmt.Errorf("failed to load bundle spec: %w", err)
+	}
+
+	if err = validateClusterEndpoint(spec.Endpoint); err != nil {
+		return err
+	}
+
+	configBundleOpts, err := spec.BundleOptions(
+		bundle.FileSecretsResolver(filepath.Dir(specPath)),
+		generate.WithInstallDisk(genConfigCmdFlags.installDisk),
+		generate.WithInstallImage(genConfigCmdFlags.installImage),
+		generate.WithPersist(genConfigCmdFlags.persistConfig),
+		generate.WithClusterDiscovery(genConfigCmdFlags.withClusterDiscovery),
+	)
+	if err != nil {
+		return err
+	}
+
+	configBundle, err := bundle.NewBundle(configBundleOpts...)
+	if err != nil {
+		return fmt.Errorf("failed to generate config bundle: %w", err)
+	}
+
+	return writeConfigBundle(configBundle, paths, commentsFlags())
+}
+
+func commentsFlags() encoder.CommentsFlags {
+	flags := encoder.CommentsDisabled
+
+	if genConfigCmdFlags.withDocs {
+		flags |= encoder.CommentsDocs
+	}
+
+	if genConfigCmdFlags.withExamples {
+		flags |= encoder.CommentsExamples
+	}
+
+	return flags
 }
 
 func validateFlags() error {
@@ -275,6 +397,10 @@ func validateFlags() error {
 		genConfigCmdFlags.output = genConfigCmdFlags.outputDir
 	}
 
+	if genConfigCmdFlags.workerPools != "" && genConfigCmdFlags.output == stdoutOutput {
+		return errors.New("can't use worker-pools with stdout")
+	}
+
 	var err error
 
 	for _, outputType := range genConfigCmdFlags.outputTypes {
@@ -311,6 +437,10 @@ func writeConfigBundle(configBundle *bundle.Bundle, outputPaths configOutputPath
 		if err = writeToDestination(data, outputPaths.worker, 0o644); err != nil {
 			return err
 		}
+
+		if err = writeWorkerPools(configBundle, outputPaths, commentsFlags); err != nil {
+			return err
+		}
 	}
 
 	if _, ok := outputTypesSet[talosconfigOutputType]; ok {
@@ -327,6 +457,43 @@ func writeConfigBundle(configBundle *bundle.Bundle, outputPaths configOutputPath
 	return nil
 }
 
+// writeWorkerPools writes the worker config for each machine pool next to the worker config as worker-<pool name>.yaml.
+func writeWorkerPools(configBundle *bundle.Bundle, outputPaths configOutputPaths, commentsFlags encoder.CommentsFlags) error {
+	if genConfigCmdFlags.workerPools == "" {
+		return nil
+	}
+
+	pools, err := generate.LoadMachinePools(genConfigCmdFlags.workerPools)
+	if err != nil {
+		return fmt.Errorf("failed to load worker pools: %w", err)
+	}
+
+	for _, pool := range pools {
+		var (
+			cfg  config.Provider
+			data []byte
+		)
+
+		cfg, err = pool.Apply(configBundle.Worker())
+		if err != nil {
+			return err
+		}
+
+		data, err = cfg.EncodeBytes(encoder.WithComments(commentsFlags))
+		if err != nil {
+			return err
+		}
+
+		destination := filepath.Join(filepath.Dir(outputPaths.worker), machine.TypeWorker.String()+"-"+pool.Name+yamlExt)
+
+		if err = writeToDestination(data, destination, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func writeToDestination(data []byte, destination string, permissions os.FileMode) error {
 	if destination == stdoutOutput {
 		_, err := os.Stdout.Write(data)
@@ -431,6 +598,8 @@ func init() {
 	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.installDisk, "install-disk", "/dev/sda", "the disk to install to")
 	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.installImage, "install-image", helpers.DefaultImage(images.DefaultInstallerImageRepository), "the image used to perform an installation")
 	genConfigCmd.Flags().StringSliceVar(&genConfigCmdFlags.additionalSANs, "additional-sans", []string{}, "additional Subject-Alt-Names for the APIServer certificate")
+	genConfigCmd.Flags().StringSliceVar(&genConfigCmdFlags.additionalEndpoints, "additional-endpoints", []string{},
+		"additional control plane endpoints (DNS names or IPs) added to the certificate SANs and talosconfig endpoints")
 	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.dnsDomain, "dns-domain", "cluster.local", "the dns domain to use for cluster")
 	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.configVersion, "version", "v1alpha1", "the desired machine config version to generate")
 	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.talosVersion, "talos-version", "", "the desired Talos version to generate config for (backwards compatibility, e.g. v0.8)")
@@ -445,6 +614,11 @@ func init() {
 	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withClusterDiscovery, "with-cluster-discovery", "", true, "enable cluster discovery feature")
 	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withKubeSpan, "with-kubespan", "", false, "enable KubeSpan feature")
 	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.withSecrets, "with-secrets", "", "use a secrets file generated using 'gen secrets'")
+	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.secretsReference, "secrets-reference", "",
+		"omit the private keys and the shared secrets from the machine configs, referencing the secrets bundle with the given ID instead")
+	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.fromSpec, "from-spec", "", "generate configs from the config bundle spec file")
+	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.imagesManifest, "images-manifest", "", "pin the images to the digests from the air-gap manifest generated using 'image manifest'")
+	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.workerPools, "worker-pools", "", "generate an additional worker config for each machine pool defined in the YAML file")
 
 	genConfigCmd.Flags().StringSliceVarP(&genConfigCmdFlags.outputTypes, "output-types", "t", allOutputTypes, fmt.Sprintf("types of outputs to be generated. valid types are: %q", allOutputTypes))
 	genConfigCmd.Flags().StringVarP(&genConfigCmdFlags.output, "output", "o", "",
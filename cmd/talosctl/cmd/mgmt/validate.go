@@ -5,18 +5,21 @@
 package mgmt
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/validation"
 )
 
 var (
-	validateConfigArg string
+	validateConfigArg []string
 	validateModeArg   string
 	validateStrictArg bool
 )
@@ -25,12 +28,14 @@ var (
 var validateCmd = &cobra.Command{
 	Use:   "validate",
 	Short: "Validate config",
-	Long:  ``,
-	Args:  cobra.NoArgs,
+	Long: `Validate the machine configuration.
+
+If multiple config files are given, the configs are also checked for cross-node consistency:
+the cluster secrets, the control plane endpoint and the cluster network settings should match.`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		cfg, err := configloader.NewFromFile(validateConfigArg)
-		if err != nil {
-			return err
+		if len(validateConfigArg) == 0 {
+			return errors.New("at least one config file should be specified")
 		}
 
 		mode, err := runtime.ParseMode(validateModeArg)
@@ -43,22 +48,39 @@ var validateCmd = &cobra.Command{
 			opts = append(opts, validation.WithStrict())
 		}
 
-		warnings, err := cfg.Validate(mode, opts...)
-		for _, w := range warnings {
-			cli.Warning("%s", w)
+		nodes := make([]cluster.NodeConfig, 0, len(validateConfigArg))
+
+		for _, path := range validateConfigArg {
+			cfg, err := configloader.NewFromFile(path)
+			if err != nil {
+				return err
+			}
+
+			warnings, err := cfg.Validate(mode, opts...)
+			for _, w := range warnings {
+				cli.Warning("%s: %s", path, w)
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+
+			nodes = append(nodes, cluster.NodeConfig{Name: path, Config: cfg})
 		}
-		if err != nil {
-			return err
+
+		if len(nodes) > 1 {
+			if err = cluster.ValidateConfigs(nodes); err != nil {
+				return fmt.Errorf("cross-node validation failed:\n%w", err)
+			}
 		}
 
-		fmt.Printf("%s is valid for %s mode\n", validateConfigArg, validateModeArg)
+		fmt.Printf("%s is valid for %s mode\n", strings.Join(validateConfigArg, ", "), validateModeArg)
 
 		return nil
 	},
 }
 
 func init() {
-	validateCmd.Flags().StringVarP(&validateConfigArg, "config", "c", "", "the path of the config file")
+	validateCmd.Flags().StringSliceVarP(&validateConfigArg, "config", "c", nil, "the path of the config file, specify multiple times to validate configs of all cluster nodes for consistency")
 	validateCmd.Flags().StringVarP(
 		&validateModeArg,
 		"mode",
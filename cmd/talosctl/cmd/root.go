@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt"
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos"
 	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
@@ -47,6 +49,7 @@ func Execute() error {
 	rootCmd.PersistentFlags().StringVar(&talos.GlobalArgs.CmdContext, "context", "", "Context to be used in command")
 	rootCmd.PersistentFlags().StringSliceVarP(&talos.GlobalArgs.Nodes, "nodes", "n", []string{}, "target the specified nodes")
 	rootCmd.PersistentFlags().StringSliceVarP(&talos.GlobalArgs.Endpoints, "endpoints", "e", []string{}, "override default endpoints in Talos configuration")
+	rootCmd.PersistentFlags().IntVar(&talos.GlobalArgs.Parallel, "parallel", 0, "maximum number of nodes to run the command against concurrently (0 means no limit)")
 	cli.Should(rootCmd.RegisterFlagCompletionFunc("context", talos.CompleteConfigContext))
 	cli.Should(rootCmd.RegisterFlagCompletionFunc("nodes", talos.CompleteNodes))
 	rootCmd.PersistentFlags().StringVar(&talos.GlobalArgs.Cluster, "cluster", "", "Cluster to connect to if a proxy endpoint is used.")
@@ -55,6 +58,13 @@ func Execute() error {
 	if err != nil && !common.SuppressErrors {
 		fmt.Fprintln(os.Stderr, err.Error())
 
+		if clockSkew := client.ClockSkew(err); clockSkew != nil {
+			fmt.Fprintln(os.Stderr)
+			fmt.Fprintf(os.Stderr, "hint: the node clock differs from the local clock by approximately %s, "+
+				"check the time synchronization on both sides or regenerate the client certificate\n",
+				clockSkew.Skew(time.Now()).Round(time.Second))
+		}
+
 		errorString := err.Error()
 		// TODO: this is a nightmare, but arg-flag related validation returns simple `fmt.Errorf`, no way to distinguish
 		//       these errors
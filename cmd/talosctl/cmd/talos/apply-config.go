@@ -28,8 +28,12 @@ var applyConfigCmdFlags struct {
 	certFingerprints []string
 	patches          []string
 	filename         string
+	secretsBundle    string
+	cniPresets       string
 	insecure         bool
 	dryRun           bool
+	tryConfirm       bool
+	edit             bool
 	configTryTimeout time.Duration
 }
 
@@ -93,6 +97,27 @@ var applyConfigCmd = &cobra.Command{
 			return errors.New("no filename supplied for configuration")
 		}
 
+		var secretsBundle []byte
+
+		if applyConfigCmdFlags.secretsBundle != "" {
+			secretsBundle, err = os.ReadFile(applyConfigCmdFlags.secretsBundle)
+			if err != nil {
+				return fmt.Errorf("failed to read secrets bundle from %q: %w", applyConfigCmdFlags.secretsBundle, err)
+			}
+		}
+
+		if applyConfigCmdFlags.edit && applyConfigCmdFlags.Mode.Mode != helpers.InteractiveMode {
+			return errors.New("--edit is only supported in interactive mode")
+		}
+
+		if applyConfigCmdFlags.cniPresets != "" && applyConfigCmdFlags.Mode.Mode != helpers.InteractiveMode {
+			return errors.New("--cni-presets is only supported in interactive mode")
+		}
+
+		if applyConfigCmdFlags.tryConfirm && applyConfigCmdFlags.Mode.Mode != machineapi.ApplyConfigurationRequest_TRY {
+			return errors.New("--confirm is only supported in try mode")
+		}
+
 		withClient := func(f func(context.Context, *client.Client) error) error {
 			if applyConfigCmdFlags.insecure {
 				return WithClientMaintenance(applyConfigCmdFlags.certFingerprints, f)
@@ -106,12 +131,28 @@ var applyConfigCmd = &cobra.Command{
 				install := installer.NewInstaller()
 				node := GlobalArgs.Nodes[0]
 
+				connOpts := []installer.Option{
+					installer.WithDryRun(applyConfigCmdFlags.dryRun),
+				}
+
+				if applyConfigCmdFlags.edit {
+					connOpts = append(connOpts, installer.WithEditConfig(cfgBytes))
+				}
+
+				if applyConfigCmdFlags.cniPresets != "" {
+					presets, err := installer.LoadCNIPresets(ctx, applyConfigCmdFlags.cniPresets)
+					if err != nil {
+						return err
+					}
+
+					connOpts = append(connOpts, installer.WithCNIPresets(presets))
+				}
+
 				if len(GlobalArgs.Endpoints) > 0 {
 					return WithClientNoNodes(func(bootstrapCtx context.Context, bootstrapClient *client.Client) error {
-						opts := []installer.Option{
+						opts := append([]installer.Option{
 							installer.WithBootstrapNode(bootstrapCtx, bootstrapClient, GlobalArgs.Endpoints[0]),
-							installer.WithDryRun(applyConfigCmdFlags.dryRun),
-						}
+						}, connOpts...)
 
 						conn, err := installer.NewConnection(
 							ctx,
@@ -131,7 +172,7 @@ var applyConfigCmd = &cobra.Command{
 					ctx,
 					c,
 					node,
-					installer.WithDryRun(applyConfigCmdFlags.dryRun),
+					connOpts...,
 				)
 				if err != nil {
 					return err
@@ -140,11 +181,15 @@ var applyConfigCmd = &cobra.Command{
 				return install.Run(conn)
 			}
 
+			// the rollback timer of the try mode is started on the nodes before the response is received
+			appliedAt := time.Now()
+
 			resp, err := c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
 				Data:           cfgBytes,
 				Mode:           applyConfigCmdFlags.Mode.Mode,
 				DryRun:         applyConfigCmdFlags.dryRun,
 				TryModeTimeout: durationpb.New(applyConfigCmdFlags.configTryTimeout),
+				SecretsBundle:  secretsBundle,
 			})
 			if err != nil {
 				return fmt.Errorf("error applying new configuration: %s", err)
@@ -152,17 +197,81 @@ var applyConfigCmd = &cobra.Command{
 
 			helpers.PrintApplyResults(resp)
 
+			if applyConfigCmdFlags.tryConfirm && !applyConfigCmdFlags.dryRun {
+				return confirmTryConfig(ctx, c, cfgBytes, appliedAt)
+			}
+
 			return nil
 		})
 	},
 }
 
+// tryConfirmMargin is the time reserved to confirm the config applied in try mode before the nodes roll it back.
+const tryConfirmMargin = 10 * time.Second
+
+// confirmTryConfig asks the user to keep the config applied in try mode.
+//
+// If the user confirms before the try mode timeout and the nodes are still reachable,
+// the config is applied again in no-reboot mode which persists it and cancels the rollback.
+// Otherwise, the nodes roll back to the previous config automatically.
+//
+// The prompt deadline is counted from the moment the config was applied, and the confirmation
+// is aborted if it can't reach the nodes before the rollback, so that the config is never persisted after the rollback.
+func confirmTryConfig(ctx context.Context, c *client.Client, cfgBytes []byte, appliedAt time.Time) error {
+	rollbackAt := appliedAt.Add(applyConfigCmdFlags.configTryTimeout)
+	promptDeadline := rollbackAt.Add(-tryConfirmMargin)
+
+	remaining := time.Until(promptDeadline)
+	if remaining <= 0 {
+		fmt.Fprintln(os.Stderr, "The timeout is too short to confirm the configuration, it will be reverted")
+
+		return nil
+	}
+
+	prompt := fmt.Sprintf("Keep the new configuration? It will be reverted automatically in %s", remaining.Round(time.Second))
+
+	if !helpers.ConfirmWithTimeout(prompt, remaining) {
+		fmt.Fprintln(os.Stderr, "The configuration was not confirmed and will be reverted")
+
+		return nil
+	}
+
+	// the confirmation should reach the nodes before the rollback
+	confirmCtx, confirmCancel := context.WithDeadline(ctx, rollbackAt.Add(-tryConfirmMargin/2))
+	defer confirmCancel()
+
+	// verify that the nodes are still reachable with the new config
+	checkCtx, checkCancel := context.WithTimeout(confirmCtx, 10*time.Second)
+	defer checkCancel()
+
+	if _, err := c.Version(checkCtx); err != nil {
+		return fmt.Errorf("nodes are not reachable, the configuration will be reverted: %w", err)
+	}
+
+	resp, err := c.ApplyConfiguration(confirmCtx, &machineapi.ApplyConfigurationRequest{
+		Data: cfgBytes,
+		Mode: machineapi.ApplyConfigurationRequest_NO_REBOOT,
+	})
+	if err != nil {
+		return fmt.Errorf("error confirming the configuration, it will be reverted: %w", err)
+	}
+
+	helpers.PrintApplyResults(resp)
+
+	return nil
+}
+
 func init() {
 	applyConfigCmd.Flags().StringVarP(&applyConfigCmdFlags.filename, "file", "f", "", "the filename of the updated configuration")
+	applyConfigCmd.Flags().StringVar(&applyConfigCmdFlags.secretsBundle, "secrets-bundle", "",
+		"the secrets file generated using 'gen secrets' to resolve the secrets of the configuration generated with --secrets-reference")
 	applyConfigCmd.Flags().BoolVarP(&applyConfigCmdFlags.insecure, "insecure", "i", false, "apply the config using the insecure (encrypted with no auth) maintenance service")
 	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.dryRun, "dry-run", false, "check how the config change will be applied in dry-run mode")
 	applyConfigCmd.Flags().StringSliceVar(&applyConfigCmdFlags.certFingerprints, "cert-fingerprint", nil, "list of server certificate fingeprints to accept (defaults to no check)")
 	applyConfigCmd.Flags().StringSliceVarP(&applyConfigCmdFlags.patches, "config-patch", "p", nil, "the list of config patches to apply to the local config file before sending it to the node")
+	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.edit, "edit", false, "in interactive mode, edit the current config of the node (or the config loaded with --file) instead of generating a new one")
+	applyConfigCmd.Flags().StringVar(&applyConfigCmdFlags.cniPresets, "cni-presets", "", "in interactive mode, the file or URL of the YAML document with the extra CNI presets")
+	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.tryConfirm, "confirm", false, "interactively confirm the config applied in try mode, the config is reverted if not confirmed before the timeout")
 	applyConfigCmd.Flags().DurationVar(&applyConfigCmdFlags.configTryTimeout, "timeout", constants.ConfigTryTimeout, "the config will be rolled back after specified timeout (if try mode is selected)")
 	helpers.AddModeFlags(&applyConfigCmdFlags.Mode, applyConfigCmd)
 	addCommand(applyConfigCmd)
@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/maps"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/attestation"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+var attestCmdFlags struct {
+	nonce string
+}
+
+// attestCmd represents the attest command.
+var attestCmd = &cobra.Command{
+	Use:   "attest",
+	Short: "Fetch and verify the TPM quote of the node PCRs",
+	Long: `Fetch the TPM quote of the node PCRs qualified with the nonce and verify it.
+
+The quote covers the firmware and boot loader measurements, the UKI PCR and the PCR with the kernel command line
+and the machine configuration measured during the boot.
+The command verifies the quote signature and the nonce, the PCR values should be compared against the known good values,
+and the attestation key should be pinned by the attestation service.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var nonce []byte
+
+		if attestCmdFlags.nonce != "" {
+			var err error
+
+			nonce, err = hex.DecodeString(attestCmdFlags.nonce)
+			if err != nil {
+				return fmt.Errorf("error decoding nonce: %w", err)
+			}
+		} else {
+			nonce = make([]byte, 32)
+
+			if _, err := rand.Read(nonce); err != nil {
+				return fmt.Errorf("error generating nonce: %w", err)
+			}
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.Attest(ctx, nonce, grpc.Peer(&remotePeer))
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureAttestation, err)
+
+				if resp == nil {
+					return fmt.Errorf("error getting TPM quote: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tPCR\tVALUE")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			var verifyErrs error
+
+			attestationKeys := map[string]string{}
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				if msg.Metadata != nil && msg.Metadata.Error != "" {
+					continue
+				}
+
+				if !bytes.Equal(msg.Nonce, nonce) {
+					verifyErrs = multierror.Append(verifyErrs, fmt.Errorf("%s: quote doesn't match the requested nonce", node))
+
+					continue
+				}
+
+				quote, err := attestation.Verify(&runtime.AttestationStatusSpec{
+					Nonce:          msg.Nonce,
+					Quote:          msg.Quote,
+					Signature:      msg.Signature,
+					AttestationKey: msg.AttestationKey,
+					PCRValues:      msg.PcrValues,
+					EventLogDigest: msg.EventLogDigest,
+				})
+				if err != nil {
+					verifyErrs = multierror.Append(verifyErrs, fmt.Errorf("%s: %w", node, err))
+
+					continue
+				}
+
+				keyDigest := sha256.Sum256(msg.AttestationKey)
+				attestationKeys[node] = hex.EncodeToString(keyDigest[:])
+
+				pcrs := maps.Keys(quote.PCRs)
+				slices.Sort(pcrs)
+
+				for _, pcr := range pcrs {
+					fmt.Fprintf(w, "%s\t%d\t%s\n", node, pcr, quote.PCRs[pcr])
+				}
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			if len(attestationKeys) > 0 {
+				fmt.Println()
+
+				w = tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+				fmt.Fprintln(w, "NODE\tATTESTATION KEY SHA256")
+
+				nodes := maps.Keys(attestationKeys)
+				slices.Sort(nodes)
+
+				for _, node := range nodes {
+					fmt.Fprintf(w, "%s\t%s\n", node, attestationKeys[node])
+				}
+
+				if err = w.Flush(); err != nil {
+					return err
+				}
+			}
+
+			if err = helpers.CheckErrors(resp.Messages...); err != nil {
+				return err
+			}
+
+			if verifyErrs != nil {
+				return errors.Join(errors.New("TPM quote verification failed"), verifyErrs)
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	attestCmd.Flags().StringVar(&attestCmdFlags.nonce, "nonce", "", "hex encoded nonce to qualify the quote with (random by default)")
+	addCommand(attestCmd)
+}
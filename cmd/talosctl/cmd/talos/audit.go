@@ -0,0 +1,136 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	auditapi "github.com/siderolabs/talos/pkg/machinery/api/audit"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var auditCmdFlags struct {
+	follow bool
+	tail   int32
+}
+
+// auditCmd represents the audit command.
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Show the Talos API audit log",
+	Long: `Show the most recent Talos API calls recorded in the audit log.
+
+Each record contains the identity (client certificate common name) and the address of the caller,
+the API method called, the result of the call and its duration.`,
+	Example: `  talosctl audit
+  talosctl audit --tail 20
+  talosctl audit --follow --tail 0`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if auditCmdFlags.follow {
+				return auditWatch(ctx, c)
+			}
+
+			return auditList(ctx, c)
+		})
+	},
+}
+
+func auditList(ctx context.Context, c *client.Client) error {
+	var remotePeer peer.Peer
+
+	resp, err := c.Audit.List(ctx, auditCmdFlags.tail, grpc.Peer(&remotePeer))
+	if err != nil {
+		err = c.ExplainUnsupported(ctx, client.FeatureAuditLog, err)
+
+		if resp == nil {
+			return fmt.Errorf("error listing audit records: %w", err)
+		}
+
+		cli.Warning("%s", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	auditHeader(w)
+
+	defaultNode := client.AddrFromPeer(&remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, record := range msg.Records {
+			auditRecord(w, node, record)
+		}
+	}
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	return helpers.CheckErrors(resp.Messages...)
+}
+
+func auditWatch(ctx context.Context, c *client.Client) error {
+	// the error returned by the stream doesn't tell whether the node supports the API
+	if err := c.RequireFeature(ctx, client.FeatureAuditLog); err != nil {
+		return err
+	}
+
+	stream, err := c.Audit.Watch(ctx, auditCmdFlags.tail)
+	if err != nil {
+		return fmt.Errorf("error watching audit records: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	auditHeader(w)
+
+	return helpers.ReadGRPCStream(stream, func(msg *auditapi.WatchResponse, node string, multipleNodes bool) error {
+		auditRecord(w, node, msg.Record)
+
+		return w.Flush()
+	})
+}
+
+func auditHeader(w io.Writer) {
+	fmt.Fprintln(w, "NODE\tID\tTIME\tIDENTITY\tPEER\tMETHOD\tCODE\tDURATION\tERROR")
+}
+
+func auditRecord(w io.Writer, node string, record *auditapi.Record) {
+	fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+		node,
+		record.Id,
+		record.Timestamp.AsTime().Local().Format(time.RFC3339),
+		record.Identity,
+		record.Peer,
+		record.Method,
+		codes.Code(record.Code),
+		record.Duration.AsDuration().Truncate(time.Microsecond),
+		record.Error,
+	)
+}
+
+func init() {
+	auditCmd.Flags().BoolVarP(&auditCmdFlags.follow, "follow", "f", false, "stream the new audit records as they are recorded")
+	auditCmd.Flags().Int32Var(&auditCmdFlags.tail, "tail", -1, "number of the most recent records to show, -1 to show all the records kept")
+
+	addCommand(auditCmd)
+}
@@ -446,6 +446,56 @@ var configNewCmd = &cobra.Command{
 	},
 }
 
+// configRefreshCmdFlags represents the `config refresh` command flags.
+var configRefreshCmdFlags struct {
+	crtTTL time.Duration
+}
+
+// configRefreshCmd represents the `config refresh` command.
+var configRefreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Short: "Renew the client certificate of the current context",
+	Long: `Renew the client certificate of the current context.
+
+The renewed certificate has the same roles as the current one.
+The CA certificate, the client certificate and the key of the current context are replaced in the client configuration file.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "talosconfig"); err != nil {
+				return err
+			}
+
+			config, err := openConfigAndContext(GlobalArgs.CmdContext)
+			if err != nil {
+				return err
+			}
+
+			contextData, err := getContextData(config)
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.RenewClientCertificate(ctx, configRefreshCmdFlags.crtTTL)
+			if err != nil {
+				return c.ExplainUnsupported(ctx, client.FeatureCertificateRenewal, err)
+			}
+
+			if l := len(resp.Messages); l != 1 {
+				panic(fmt.Sprintf("expected 1 message, got %d", l))
+			}
+
+			msg := resp.Messages[0]
+
+			contextData.CA = base64.StdEncoding.EncodeToString(msg.Ca)
+			contextData.Crt = base64.StdEncoding.EncodeToString(msg.Crt)
+			contextData.Key = base64.StdEncoding.EncodeToString(msg.Key)
+
+			return config.Save(GlobalArgs.Talosconfig)
+		})
+	},
+}
+
 // configNewCmd represents the `config info` command output template.
 var configInfoCmdTemplate = template.Must(template.New("configInfoCmdTemplate").
 	Funcs(template.FuncMap{"join": strings.Join}).
@@ -602,6 +652,7 @@ func init() {
 		configGetContextsCmd,
 		configMergeCmd,
 		configNewCmd,
+		configRefreshCmd,
 		configInfoCmd,
 	)
 
@@ -620,6 +671,8 @@ func init() {
 	configNewCmd.Flags().StringSliceVar(&configNewCmdFlags.roles, "roles", role.MakeSet(role.Admin).Strings(), "roles")
 	configNewCmd.Flags().DurationVar(&configNewCmdFlags.crtTTL, "crt-ttl", constants.TalosAPIDefaultCertificateValidityDuration, "certificate TTL")
 
+	configRefreshCmd.Flags().DurationVar(&configRefreshCmdFlags.crtTTL, "crt-ttl", 24*time.Hour, fmt.Sprintf("certificate TTL (at most %s)", constants.APIClientCertificateMaxTTL))
+
 	configInfoCmd.Flags().StringVarP(&configInfoCmdFlags.output, "output", "o", "text", "output format (json|yaml|text). Default text.")
 
 	addCommand(configCmd)
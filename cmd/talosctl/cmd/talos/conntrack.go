@@ -0,0 +1,212 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+type conntrackFilterFlags struct {
+	ipv4            bool
+	ipv6            bool
+	protocol        string
+	source          string
+	destination     string
+	sourcePort      uint16
+	destinationPort uint16
+}
+
+func (flags *conntrackFilterFlags) register(cmd *cobra.Command, action string) {
+	cmd.Flags().BoolVarP(&flags.ipv4, "ipv4", "4", false, action+" only IPv4 entries")
+	cmd.Flags().BoolVarP(&flags.ipv6, "ipv6", "6", false, action+" only IPv6 entries")
+	cmd.Flags().StringVarP(&flags.protocol, "protocol", "p", "", "layer 4 protocol (tcp, udp, icmp, icmpv6)")
+	cmd.Flags().StringVar(&flags.source, "source", "", "source address or CIDR")
+	cmd.Flags().StringVar(&flags.destination, "destination", "", "destination address or CIDR")
+	cmd.Flags().Uint16Var(&flags.sourcePort, "source-port", 0, "source port (requires --protocol)")
+	cmd.Flags().Uint16Var(&flags.destinationPort, "destination-port", 0, "destination port (requires --protocol)")
+	cmd.MarkFlagsMutuallyExclusive("ipv4", "ipv6")
+}
+
+func (flags *conntrackFilterFlags) family() machine.ConntrackFlushRequest_Family {
+	switch {
+	case flags.ipv4:
+		return machine.ConntrackFlushRequest_INET4
+	case flags.ipv6:
+		return machine.ConntrackFlushRequest_INET6
+	default:
+		return machine.ConntrackFlushRequest_ALL
+	}
+}
+
+var (
+	conntrackFlushCmdFlags conntrackFilterFlags
+	conntrackListCmdFlags  conntrackFilterFlags
+)
+
+var conntrackCmd = &cobra.Command{
+	Use:   "conntrack",
+	Short: "Inspect and manage the connection tracking table",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+}
+
+var conntrackFlushCmd = &cobra.Command{
+	Use:   "flush",
+	Short: "Delete connection tracking entries matching the filter",
+	Long: `Delete connection tracking entries matching the filter.
+
+At least one of the protocol, address or port filters should be specified.
+Source and destination filters match the original direction of the connection.`,
+	Example: `  talosctl conntrack flush --protocol udp --destination-port 53
+  talosctl conntrack flush --source 10.0.0.0/8 -4`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &machine.ConntrackFlushRequest{
+			Family:          conntrackFlushCmdFlags.family(),
+			Protocol:        conntrackFlushCmdFlags.protocol,
+			Source:          conntrackFlushCmdFlags.source,
+			Destination:     conntrackFlushCmdFlags.destination,
+			SourcePort:      uint32(conntrackFlushCmdFlags.sourcePort),
+			DestinationPort: uint32(conntrackFlushCmdFlags.destinationPort),
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.ConntrackFlush(ctx, req, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error flushing conntrack entries: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tDELETED")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				fmt.Fprintf(w, "%s\t%d\n", node, msg.Deleted)
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	},
+}
+
+var conntrackListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List connection tracking entries",
+	Long: `List connection tracking entries, optionally matching the filter.
+
+Source and destination filters match the original direction of the connection.
+Reply direction addresses differ from the original direction for NATed connections.`,
+	Example: `  talosctl conntrack list
+  talosctl conntrack list --protocol tcp --destination-port 6443
+  talosctl conntrack list --source 10.244.0.0/16 -4`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &machine.ConntrackListRequest{
+			Family:          conntrackListCmdFlags.family(),
+			Protocol:        conntrackListCmdFlags.protocol,
+			Source:          conntrackListCmdFlags.source,
+			Destination:     conntrackListCmdFlags.destination,
+			SourcePort:      uint32(conntrackListCmdFlags.sourcePort),
+			DestinationPort: uint32(conntrackListCmdFlags.destinationPort),
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.ConntrackList(ctx, req, grpc.Peer(&remotePeer))
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureConntrackList, err)
+
+				if resp == nil {
+					return fmt.Errorf("error listing conntrack entries: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tPROTO\tSTATE\tSOURCE\tDESTINATION\tREPLY SOURCE\tREPLY DESTINATION\tTIMEOUT")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, entry := range msg.Entries {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+						node,
+						entry.Protocol,
+						entry.State,
+						conntrackEndpoint(entry.Source, entry.SourcePort),
+						conntrackEndpoint(entry.Destination, entry.DestinationPort),
+						conntrackEndpoint(entry.ReplySource, entry.ReplySourcePort),
+						conntrackEndpoint(entry.ReplyDestination, entry.ReplyDestinationPort),
+						time.Duration(entry.Timeout)*time.Second,
+					)
+				}
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	},
+}
+
+func conntrackEndpoint(addr string, port uint32) string {
+	if port == 0 {
+		return addr
+	}
+
+	return net.JoinHostPort(addr, strconv.FormatUint(uint64(port), 10))
+}
+
+func init() {
+	conntrackFlushCmdFlags.register(conntrackFlushCmd, "flush")
+	conntrackListCmdFlags.register(conntrackListCmd, "list")
+
+	conntrackCmd.AddCommand(conntrackFlushCmd)
+	conntrackCmd.AddCommand(conntrackListCmd)
+	addCommand(conntrackCmd)
+}
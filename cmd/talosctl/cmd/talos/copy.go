@@ -10,6 +10,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -17,11 +19,18 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
 
+var cpCmdFlags struct {
+	redact bool
+	mode   string
+	uid    uint32
+	gid    uint32
+}
+
 // cpCmd represents the cp command.
 var cpCmd = &cobra.Command{
 	Use:     "copy <src-path> -|<local-path>",
 	Aliases: []string{"cp"},
-	Short:   "Copy data out from the node",
+	Short:   "Copy data out from the node or a file to the node",
 	Long: `Creates an .tar.gz archive at the node starting at <src-path> and
 streams it back to the client.
 
@@ -29,7 +38,12 @@ If '-' is given for <local-path>, archive is written to stdout.
 Otherwise archive is extracted to <local-path> which should be an empty directory or
 talosctl creates a directory if <local-path> doesn't exist. Command doesn't preserve
 ownership and access mode for the files in extract mode, while  streamed .tar archive
-captures ownership and permission bits.`,
+captures ownership and permission bits.
+
+If the destination path is prefixed with ':' (talosctl copy <local-path>|- :<dst-path>),
+the local file (or stdin for '-') is uploaded to <dst-path> on the node instead.
+Uploaded files should be under /var/user or the extra mounts under /var/mnt,
+the file mode and ownership are set with --mode, --uid and --gid.`,
 	Args: cobra.ExactArgs(2),
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		switch len(args) {
@@ -47,7 +61,11 @@ captures ownership and permission bits.`,
 				return err
 			}
 
-			r, err := c.Copy(ctx, args[0])
+			if dstPath, ok := strings.CutPrefix(args[1], ":"); ok {
+				return copyToNode(ctx, c, args[0], dstPath)
+			}
+
+			r, err := c.Copy(ctx, args[0], client.WithRedact(cpCmdFlags.redact))
 			if err != nil {
 				return fmt.Errorf("error copying: %w", err)
 			}
@@ -80,6 +98,41 @@ captures ownership and permission bits.`,
 	},
 }
 
+func copyToNode(ctx context.Context, c *client.Client, localPath, dstPath string) error {
+	mode, err := strconv.ParseUint(cpCmdFlags.mode, 8, 32)
+	if err != nil {
+		return fmt.Errorf("invalid file mode %q: %w", cpCmdFlags.mode, err)
+	}
+
+	var r io.Reader = os.Stdin
+
+	if localPath != "-" {
+		var f *os.File
+
+		f, err = os.Open(localPath)
+		if err != nil {
+			return err
+		}
+
+		defer f.Close() //nolint:errcheck
+
+		r = f
+	}
+
+	if _, err = c.UserFileWrite(ctx, dstPath, r,
+		client.WithUserFileMode(os.FileMode(mode)),
+		client.WithUserFileOwner(cpCmdFlags.uid, cpCmdFlags.gid),
+	); err != nil {
+		return fmt.Errorf("error copying to the node: %w", c.ExplainUnsupported(ctx, client.FeatureUserFiles, err))
+	}
+
+	return nil
+}
+
 func init() {
+	cpCmd.Flags().BoolVar(&cpCmdFlags.redact, "redact", false, "redact secrets (private keys, tokens, etc.) in the file contents on the node")
+	cpCmd.Flags().StringVar(&cpCmdFlags.mode, "mode", "0644", "permission bits of the file uploaded to the node (octal)")
+	cpCmd.Flags().Uint32Var(&cpCmdFlags.uid, "uid", 0, "owner user ID of the file uploaded to the node")
+	cpCmd.Flags().Uint32Var(&cpCmdFlags.gid, "gid", 0, "owner group ID of the file uploaded to the node")
 	addCommand(cpCmd)
 }
@@ -22,7 +22,10 @@ var dashboardCmdFlags struct {
 var dashboardCmd = &cobra.Command{
 	Use:   "dashboard",
 	Short: "Cluster dashboard with node overview, logs and real-time metrics",
-	Long: `Provide a text-based UI to navigate node overview, logs and real-time metrics.
+	Long: `Provide a text-based UI to navigate node overview, logs, real-time metrics and the cluster overview.
+
+The cluster screen shows the resource usage, service health and etcd status of all nodes,
+and the machine events streamed from the nodes.
 
 Keyboard shortcuts:
 
@@ -34,13 +37,16 @@ Keyboard shortcuts:
  - &lt;C-u&gt; - scroll logs/process list half page up
  - &lt;C-f&gt; - scroll logs/process list one page down
  - &lt;C-b&gt; - scroll logs/process list one page up
+ - F1, F2, F3 - switch between the summary, monitor and cluster screens
+ - r - reboot the selected node (cluster screen)
+ - u - upgrade the selected node (cluster screen)
 `,
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			return dashboard.Run(ctx, c,
 				dashboard.WithInterval(dashboardCmdFlags.interval),
-				dashboard.WithScreens(dashboard.ScreenSummary, dashboard.ScreenMonitor),
+				dashboard.WithScreens(dashboard.ScreenSummary, dashboard.ScreenMonitor, dashboard.ScreenCluster),
 				dashboard.WithAllowExitKeys(true),
 			)
 		})
@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var diskBenchmarkCmdFlags struct {
+	path     string
+	duration time.Duration
+	fileSize string
+}
+
+var diskBenchmarkCmd = &cobra.Command{
+	Use:   "disk-benchmark",
+	Short: "Measure the fsync latency and the I/O performance of the disk",
+	Long: `Measure the fsync latency and the sequential and random I/O performance of the disk with a temporary file.
+
+The fsync test mimics the etcd write-ahead log (small writes each followed by fdatasync),
+and the disk is reported as suitable for etcd if the 99th percentile of the fsync latency is below 10ms.
+Run the command against a node before making it a control plane node to verify that its disk meets the etcd latency requirements.
+
+The benchmark runs in the directory on the EPHEMERAL volume (/var) by default, and the result is stored as the DiskBenchmark resource.`,
+	Example: `talosctl disk-benchmark --nodes 172.20.0.5 --duration 30s`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var fileSize uint64
+
+		if diskBenchmarkCmdFlags.fileSize != "" {
+			var err error
+
+			fileSize, err = humanize.ParseBytes(diskBenchmarkCmdFlags.fileSize)
+			if err != nil {
+				return fmt.Errorf("error parsing file size: %w", err)
+			}
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			req := &machine.BenchmarkDiskRequest{
+				Path:     diskBenchmarkCmdFlags.path,
+				FileSize: fileSize,
+			}
+
+			if diskBenchmarkCmdFlags.duration != 0 {
+				req.Duration = durationpb.New(diskBenchmarkCmdFlags.duration)
+			}
+
+			resp, err := c.BenchmarkDisk(ctx, req, grpc.Peer(&remotePeer))
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureDiskBenchmark, err)
+
+				if resp == nil {
+					return fmt.Errorf("error benchmarking disk: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tPATH\tFSYNC P50\tFSYNC P99\tFSYNC MAX\tSEQ WRITE\tRAND WRITE IOPS\tRAND READ IOPS\tETCD SUITABLE")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s/s\t%d\t%d\t%v\n",
+					node,
+					msg.Path,
+					msg.FsyncLatencyP50.AsDuration().Round(time.Microsecond),
+					msg.FsyncLatencyP99.AsDuration().Round(time.Microsecond),
+					msg.FsyncLatencyMax.AsDuration().Round(time.Microsecond),
+					humanize.Bytes(msg.SequentialWriteBytesPerSecond),
+					msg.RandomWriteIops,
+					msg.RandomReadIops,
+					msg.EtcdSuitable,
+				)
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	},
+}
+
+func init() {
+	diskBenchmarkCmd.Flags().StringVar(&diskBenchmarkCmdFlags.path, "path", "", "directory to run the benchmark in (defaults to /var on the node)")
+	diskBenchmarkCmd.Flags().DurationVar(&diskBenchmarkCmdFlags.duration, "duration", 0, "total duration of the benchmark (defaults to 20s on the node, at most 2m)")
+	diskBenchmarkCmd.Flags().StringVar(&diskBenchmarkCmdFlags.fileSize, "file-size", "", "size of the file for the sequential and random I/O tests (defaults to 256 MiB on the node)")
+	addCommand(diskBenchmarkCmd)
+}
@@ -0,0 +1,152 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var disksEncryptionCmd = &cobra.Command{
+	Use:   "encryption",
+	Short: "Manage the disk encryption of the system volumes",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+}
+
+var disksEncryptionStatusCmd = &cobra.Command{
+	Use:   "status [<volume>...]",
+	Short: "Show the encryption status and the key slots of the system volumes",
+	Long: `Show the encryption status and the key slots of the system volumes (STATE and EPHEMERAL by default).
+
+The key slots which are enrolled in the encryption header but not configured in the machine configuration
+are removed when the volume is opened on the next boot, the configured key slots which are not enrolled are added.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.EncryptionStatus(ctx, &machine.EncryptionStatusRequest{
+				Volumes: args,
+			}, grpc.Peer(&remotePeer))
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureDiskEncryption, err)
+
+				if resp == nil {
+					return fmt.Errorf("error getting encryption status: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tVOLUME\tPROVIDER\tPHASE\tSLOT\tTYPE\tTOKEN\tENROLLED")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			placeholder := func(in string) string {
+				if in == "" {
+					return "-"
+				}
+
+				return in
+			}
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, volume := range msg.Volumes {
+					if len(volume.KeySlots) == 0 {
+						fmt.Fprintf(w, "%s\t%s\t%s\t%s\t-\t-\t-\t-\n", node, volume.Volume, volume.Provider, volume.Phase)
+
+						continue
+					}
+
+					for _, keySlot := range volume.KeySlots {
+						fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t%v\n",
+							node,
+							volume.Volume,
+							volume.Provider,
+							volume.Phase,
+							keySlot.Slot,
+							placeholder(keySlot.Type),
+							placeholder(keySlot.TokenType),
+							keySlot.Enrolled,
+						)
+					}
+				}
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	},
+}
+
+var disksEncryptionRotateKeyCmdFlags struct {
+	slot int
+}
+
+var disksEncryptionRotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key <volume>",
+	Short: "Replace the key in the key slot of the encrypted volume",
+	Long: `Replace the key in the key slot of the encrypted volume with a new key generated from the machine configuration of the slot.
+
+The KMS and TPM keys are replaced with new random keys.
+The static and node ID keys are enrolled again from the current machine configuration,
+e.g. to apply the changed static passphrase without a reboot.`,
+	Example: `  talosctl disks encryption rotate-key STATE --slot 0`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.EncryptionRotateKey(ctx, &machine.EncryptionRotateKeyRequest{
+				Volume: args[0],
+				Slot:   int32(disksEncryptionRotateKeyCmdFlags.slot),
+			})
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureDiskEncryption, err)
+
+				if resp == nil {
+					return fmt.Errorf("error rotating encryption key: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			if err = helpers.CheckErrors(resp.Messages...); err != nil {
+				return err
+			}
+
+			fmt.Printf("rotated the key in slot %d of volume %s\n", disksEncryptionRotateKeyCmdFlags.slot, args[0])
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	disksEncryptionRotateKeyCmd.Flags().IntVar(&disksEncryptionRotateKeyCmdFlags.slot, "slot", 0, "key slot to rotate the key in")
+	cli.Should(disksEncryptionRotateKeyCmd.MarkFlagRequired("slot"))
+
+	disksEncryptionCmd.AddCommand(disksEncryptionStatusCmd, disksEncryptionRotateKeyCmd)
+	disksCmd.AddCommand(disksEncryptionCmd)
+}
@@ -0,0 +1,36 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/internal/pkg/tui/doctor"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// doctorCmd represents the doctor command.
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Interactive troubleshooting wizard",
+	Long: `Run a guided text-based UI walking through the common failure diagnoses.
+
+The wizard checks the talosconfig client certificate, the Talos API connectivity and the etcd health
+of each node, and suggests fixes with the links to the documentation.
+
+The command fails if any of the checks failed.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			return doctor.New(c, GlobalArgs.Nodes, doctor.DefaultChecks()).Run(ctx)
+		})
+	},
+}
+
+func init() {
+	addCommand(doctorCmd)
+}
@@ -17,6 +17,8 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"gopkg.in/yaml.v3"
 	"k8s.io/kubectl/pkg/cmd/util/editor"
@@ -39,11 +41,6 @@ var editCmdFlags struct {
 
 //nolint:gocyclo
 func editFn(c *client.Client) func(context.Context, string, resource.Resource, error) error {
-	var (
-		path      string
-		lastError string
-	)
-
 	edit := editor.NewDefaultEditor([]string{
 		"TALOS_EDITOR",
 		"EDITOR",
@@ -65,6 +62,11 @@ func editFn(c *client.Client) func(context.Context, string, resource.Resource, e
 			return err
 		}
 
+		var (
+			path      string
+			lastError string
+		)
+
 		edited := body
 
 		for {
@@ -100,12 +102,17 @@ func editFn(c *client.Client) func(context.Context, string, resource.Resource, e
 
 			editedDiff := edited
 
+			prevPath := path
+
 			edited, path, err = edit.LaunchTempFile(fmt.Sprintf("%s-%s-edit-", mc.Metadata().Type(), id), ".yaml", &buf)
 			if err != nil {
 				return err
 			}
 
-			defer os.Remove(path) //nolint:errcheck
+			// the previous copy is superseded by the new one
+			if prevPath != "" {
+				os.Remove(prevPath) //nolint:errcheck
+			}
 
 			edited = stripEditingComment(edited)
 
@@ -131,16 +138,32 @@ func editFn(c *client.Client) func(context.Context, string, resource.Resource, e
 				break
 			}
 
+			// validate the config server-side with a dry-run first, and re-open the editor on validation errors
 			resp, err := c.ApplyConfiguration(ctx, &machine.ApplyConfigurationRequest{
 				Data:           edited,
 				Mode:           editCmdFlags.Mode.Mode,
-				DryRun:         editCmdFlags.dryRun,
+				DryRun:         true,
 				TryModeTimeout: durationpb.New(editCmdFlags.configTryTimeout),
 			})
 			if err != nil {
-				lastError = err.Error()
+				if status.Code(err) == codes.InvalidArgument {
+					lastError = status.Convert(err).Message()
 
-				continue
+					continue
+				}
+
+				return fmt.Errorf("%s: failed to validate the configuration, a copy of your changes has been stored to %q: %w", node, path, err)
+			}
+
+			if !editCmdFlags.dryRun {
+				resp, err = c.ApplyConfiguration(ctx, &machine.ApplyConfigurationRequest{
+					Data:           edited,
+					Mode:           editCmdFlags.Mode.Mode,
+					TryModeTimeout: durationpb.New(editCmdFlags.configTryTimeout),
+				})
+				if err != nil {
+					return fmt.Errorf("%s: failed to apply the configuration, a copy of your changes has been stored to %q: %w", node, path, err)
+				}
 			}
 
 			helpers.PrintApplyResults(resp)
@@ -148,6 +171,10 @@ func editFn(c *client.Client) func(context.Context, string, resource.Resource, e
 			break
 		}
 
+		if path != "" {
+			os.Remove(path) //nolint:errcheck
+		}
+
 		return nil
 	}
 }
@@ -183,7 +210,12 @@ you can retrieve via the command line tools.
 
 It will open the editor defined by your TALOS_EDITOR,
 or EDITOR environment variables, or fall back to 'vi' for Linux
-or 'notepad' for Windows.`,
+or 'notepad' for Windows.
+
+When editing the machine configuration, the edited configuration is validated
+by the node before it is applied: if the validation fails, the editor is re-opened
+with the validation errors at the top of the file. The configuration is applied
+using the mode selected with the --mode flag (e.g. --mode=no-reboot).`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			if err := helpers.ClientVersionCheck(ctx, c); err != nil {
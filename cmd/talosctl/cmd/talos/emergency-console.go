@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var emergencyConsoleCmdFlags struct {
+	reason string
+}
+
+// emergencyConsoleCmd represents the emergency-console command.
+var emergencyConsoleCmd = &cobra.Command{
+	Use:   "emergency-console",
+	Short: "Open an emergency diagnostic shell on the node",
+	Long: `Opens an interactive ("break-glass") shell in a throwaway restricted container on the node.
+
+The shell runs a busybox image in the host network and PID namespaces with a read-only root filesystem
+and a minimal set of capabilities. The feature should be enabled in the machine configuration
+(.machine.features.emergencyConsole), and every session (the reason, input and output) is recorded
+to the audit log on the node.
+
+This command is intended for the rare incidents where debugging with the Talos API is not enough.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if emergencyConsoleCmdFlags.reason == "" {
+			return errors.New("--reason is required to open the emergency console")
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "emergency-console"); err != nil {
+				return err
+			}
+
+			return emergencyConsole(ctx, c)
+		})
+	},
+}
+
+//nolint:gocyclo
+func emergencyConsole(ctx context.Context, c *client.Client) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stdinFd := int(os.Stdin.Fd())
+	stdoutFd := int(os.Stdout.Fd())
+
+	var rows, cols uint32
+
+	if term.IsTerminal(stdoutFd) {
+		if width, height, err := term.GetSize(stdoutFd); err == nil {
+			rows, cols = uint32(height), uint32(width) //nolint:gosec
+		}
+	}
+
+	stream, err := c.EmergencyConsole(ctx, emergencyConsoleCmdFlags.reason, rows, cols)
+	if err != nil {
+		return fmt.Errorf("error opening emergency console: %w", err)
+	}
+
+	if term.IsTerminal(stdinFd) {
+		oldState, err := term.MakeRaw(stdinFd)
+		if err != nil {
+			return fmt.Errorf("error setting terminal to raw mode: %w", err)
+		}
+
+		defer term.Restore(stdinFd, oldState) //nolint:errcheck
+	}
+
+	inputCh := make(chan []byte)
+
+	go func() {
+		defer close(inputCh)
+
+		buf := make([]byte, 1024)
+
+		for {
+			n, err := os.Stdin.Read(buf)
+			if n > 0 {
+				select {
+				case inputCh <- append([]byte(nil), buf[:n]...):
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	// the terminal size is polled, as there is no portable way to watch for the window size changes
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		input := inputCh
+
+		for {
+			req := &machine.EmergencyConsoleRequest{}
+
+			select {
+			case <-ctx.Done():
+				return
+			case data, ok := <-input:
+				if !ok {
+					stream.CloseSend() //nolint:errcheck
+
+					input = nil
+
+					continue
+				}
+
+				req.Stdin = data
+			case <-ticker.C:
+				if !term.IsTerminal(stdoutFd) {
+					continue
+				}
+
+				width, height, err := term.GetSize(stdoutFd)
+				if err != nil || (uint32(height) == rows && uint32(width) == cols) { //nolint:gosec
+					continue
+				}
+
+				rows, cols = uint32(height), uint32(width) //nolint:gosec
+				req.Rows, req.Cols = rows, cols
+			}
+
+			if err := stream.Send(req); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if resp.GetSessionId() != "" {
+			fmt.Fprintf(os.Stderr, "emergency console session %s, the session is recorded\r\n", resp.GetSessionId())
+		}
+
+		if len(resp.GetOutput()) > 0 {
+			if _, err = os.Stdout.Write(resp.GetOutput()); err != nil {
+				return err
+			}
+		}
+
+		if resp.GetExited() {
+			if resp.GetExitCode() != 0 {
+				return fmt.Errorf("shell exited with code %d", resp.GetExitCode())
+			}
+
+			return nil
+		}
+	}
+}
+
+func init() {
+	emergencyConsoleCmd.Flags().StringVar(&emergencyConsoleCmdFlags.reason, "reason", "", "reason for opening the emergency console, recorded in the audit log")
+	addCommand(emergencyConsoleCmd)
+}
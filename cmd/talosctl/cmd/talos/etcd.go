@@ -5,15 +5,20 @@
 package talos
 
 import (
+	"cmp"
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/dustin/go-humanize"
+	"github.com/siderolabs/gen/maps"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/spf13/cobra"
 	snapshot "go.etcd.io/etcd/etcdutl/v3/snapshot"
@@ -316,6 +321,197 @@ var etcdStatusCmd = &cobra.Command{
 	},
 }
 
+var etcdConsistencyCmd = &cobra.Command{
+	Use:   "consistency",
+	Short: "Check the etcd cluster members for data divergence",
+	Long: `Compares the key-value store hashes and revisions of all etcd cluster members.
+
+The hashes are computed at the same revision on each member, so that the members with the different hash
+have the diverged data. The command fails if any member is found to be divergent.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			response, err := c.EtcdConsistencyCheck(ctx)
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureEtcdConsistencyCheck, err)
+
+				if response == nil {
+					return fmt.Errorf("error checking consistency: %w", err)
+				}
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			node := ""
+			pattern := "%s\t%s\t%d\t%d\t%08x\t%d\t%v\t%s\n"
+			header := "MEMBER\tHOSTNAME\tREVISION\tLAG\tHASH\tCOMPACT REVISION\tDIVERGENT\tERROR"
+
+			var divergent bool
+
+			for i, message := range response.Messages {
+				if message.Metadata != nil && message.Metadata.Hostname != "" {
+					node = message.Metadata.Hostname
+				}
+
+				if i == 0 {
+					if node != "" {
+						header = "NODE\t" + header
+						pattern = "%s\t" + pattern
+					}
+
+					fmt.Fprintln(w, header)
+				}
+
+				for _, member := range message.GetMembers() {
+					divergent = divergent || member.GetDivergent()
+
+					args := []any{
+						etcdresource.FormatMemberID(member.GetMemberId()),
+						member.GetHostname(),
+						member.GetRevision(),
+						member.GetRevisionLag(),
+						member.GetHash(),
+						member.GetCompactRevision(),
+						member.GetDivergent(),
+						member.GetError(),
+					}
+					if node != "" {
+						args = append([]any{node}, args...)
+					}
+
+					fmt.Fprintf(w, pattern, args...)
+				}
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			if divergent {
+				return errors.New("etcd data divergence detected")
+			}
+
+			return nil
+		})
+	},
+}
+
+var etcdLatencyCmdFlags struct {
+	samples int
+	stat    string
+}
+
+var etcdLatencyCmd = &cobra.Command{
+	Use:   "latency",
+	Short: "Measure the request latency between the etcd cluster members",
+	Long: `Measures the latency of the requests from each node to every etcd cluster member and renders it as a matrix.
+
+Each row of the matrix is the member the requests are sent from (the node the command is run against),
+and each column is the member the requests are sent to.
+Run the command against all control plane nodes to get the full matrix, e.g. to find a member which is slow to reach from the other ones.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var statFn func(*machine.EtcdMemberLatencyStats) time.Duration
+
+		switch etcdLatencyCmdFlags.stat {
+		case "min":
+			statFn = func(stats *machine.EtcdMemberLatencyStats) time.Duration { return stats.GetMin().AsDuration() }
+		case "avg":
+			statFn = func(stats *machine.EtcdMemberLatencyStats) time.Duration { return stats.GetAvg().AsDuration() }
+		case "max":
+			statFn = func(stats *machine.EtcdMemberLatencyStats) time.Duration { return stats.GetMax().AsDuration() }
+		default:
+			return fmt.Errorf("unsupported stat %q, expected one of: min, avg, max", etcdLatencyCmdFlags.stat)
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			response, err := c.EtcdMemberLatency(ctx, &machine.EtcdMemberLatencyRequest{
+				Samples: int32(etcdLatencyCmdFlags.samples),
+			})
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureEtcdMemberLatency, err)
+
+				if response == nil {
+					return fmt.Errorf("error measuring etcd member latency: %w", err)
+				}
+				cli.Warning("%s", err)
+			}
+
+			// collect all the members seen by any node, so that the matrix has the same columns for each row
+			memberNames := map[uint64]string{}
+
+			for _, message := range response.Messages {
+				for _, member := range message.GetMembers() {
+					memberNames[member.GetMemberId()] = member.GetHostname()
+				}
+			}
+
+			memberIDs := maps.Keys(memberNames)
+			slices.SortFunc(memberIDs, func(a, b uint64) int {
+				return cmp.Or(cmp.Compare(memberNames[a], memberNames[b]), cmp.Compare(a, b))
+			})
+
+			messages := slices.Clone(response.Messages)
+			slices.SortFunc(messages, func(a, b *machine.EtcdMemberLatency) int {
+				return cmp.Or(cmp.Compare(a.GetHostname(), b.GetHostname()), cmp.Compare(a.GetMemberId(), b.GetMemberId()))
+			})
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+			header := []string{"FROM \\ TO"}
+
+			for _, id := range memberIDs {
+				header = append(header, memberNames[id])
+			}
+
+			fmt.Fprintln(w, strings.Join(header, "\t"))
+
+			var memberErrors []string
+
+			for _, message := range messages {
+				row := []string{message.GetHostname()}
+
+				stats := xslices.ToMap(message.GetMembers(), func(stats *machine.EtcdMemberLatencyStats) (uint64, *machine.EtcdMemberLatencyStats) {
+					return stats.GetMemberId(), stats
+				})
+
+				for _, id := range memberIDs {
+					member, ok := stats[id]
+
+					switch {
+					case !ok:
+						row = append(row, "-")
+					case member.GetError() != "":
+						row = append(row, "ERR")
+
+						memberErrors = append(memberErrors, fmt.Sprintf("%s -> %s: %s", message.GetHostname(), member.GetHostname(), member.GetError()))
+					default:
+						row = append(row, statFn(member).Round(time.Microsecond).String())
+					}
+				}
+
+				fmt.Fprintln(w, strings.Join(row, "\t"))
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			if len(memberErrors) > 0 {
+				fmt.Println()
+
+				for _, memberErr := range memberErrors {
+					fmt.Println(memberErr)
+				}
+
+				return errors.New("failed to measure latency to some etcd members")
+			}
+
+			return nil
+		})
+	},
+}
+
 var etcdSnapshotCmd = &cobra.Command{
 	Use:   "snapshot <path>",
 	Short: "Stream snapshot of the etcd node to the path.",
@@ -386,6 +582,9 @@ var etcdSnapshotCmd = &cobra.Command{
 }
 
 func init() {
+	etcdLatencyCmd.Flags().IntVar(&etcdLatencyCmdFlags.samples, "samples", 0, "number of the requests sent to each member (defaults to 5 on the node)")
+	etcdLatencyCmd.Flags().StringVar(&etcdLatencyCmdFlags.stat, "stat", "avg", "latency statistic to render in the matrix (min, avg, max)")
+
 	etcdAlarmCmd.AddCommand(
 		etcdAlarmListCmd,
 		etcdAlarmDisarmCmd,
@@ -393,8 +592,10 @@ func init() {
 
 	etcdCmd.AddCommand(
 		etcdAlarmCmd,
+		etcdConsistencyCmd,
 		etcdDefragCmd,
 		etcdForfeitLeadershipCmd,
+		etcdLatencyCmd,
 		etcdLeaveCmd,
 		etcdMemberListCmd,
 		etcdMemberRemoveCmd,
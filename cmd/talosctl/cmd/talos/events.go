@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
@@ -24,8 +26,9 @@ import (
 var eventsCmdFlags struct {
 	tailEvents   int32
 	tailDuration time.Duration
-	tailID       string
+	since        string
 	actorID      string
+	types        []string
 }
 
 // eventsCmd represents the events command.
@@ -48,14 +51,30 @@ var eventsCmd = &cobra.Command{
 				opts = append(opts, client.WithTailDuration(eventsCmdFlags.tailDuration))
 			}
 
-			if eventsCmdFlags.tailID != "" {
-				opts = append(opts, client.WithTailID(eventsCmdFlags.tailID))
+			since, tailID := parseSince(eventsCmdFlags.since, time.Now())
+			types := xslices.Map(eventsCmdFlags.types, eventType)
+
+			// the nodes running older Talos versions ignore the filters, so the events are filtered on the client side
+			clientFilter := (len(types) > 0 || !since.IsZero()) && !eventsFiltersSupported(ctx, c)
+
+			switch {
+			case tailID != "":
+				opts = append(opts, client.WithTailID(tailID))
+			case since.IsZero():
+			case clientFilter:
+				opts = append(opts, client.WithTailDuration(time.Since(since)))
+			default:
+				opts = append(opts, client.WithSince(since))
 			}
 
 			if eventsCmdFlags.actorID != "" {
 				opts = append(opts, client.WithActorID(eventsCmdFlags.actorID))
 			}
 
+			if len(types) > 0 && !clientFilter {
+				opts = append(opts, client.WithTypes(types...))
+			}
+
 			events, err := c.Events(ctx, opts...)
 			if err != nil {
 				return err
@@ -73,6 +92,12 @@ var eventsCmd = &cobra.Command{
 					return err
 				}
 
+				if clientFilter && len(types) > 0 && !slices.ContainsFunc(types, func(typ string) bool {
+					return event.TypeURL == typ || strings.TrimPrefix(event.TypeURL, "talos/runtime/") == typ
+				}) {
+					return nil
+				}
+
 				var args []any
 
 				switch msg := event.Payload.(type) {
@@ -107,6 +132,16 @@ var eventsCmd = &cobra.Command{
 							),
 						),
 					}
+				case *machine.PodShutdownEvent:
+					args = []any{"pods", fmt.Sprintf("running: %d %s", msg.GetRunningPods(), strings.Join(msg.GetPods(), ","))}
+				case *machine.FilesystemTrimEvent:
+					if msg.GetError() != "" {
+						args = []any{msg.GetMountpoint(), "error: " + msg.GetError()}
+					} else {
+						args = []any{msg.GetMountpoint(), fmt.Sprintf("trimmed: %d bytes", msg.GetTrimmedBytes())}
+					}
+				case *machine.FeatureFlagChangeEvent:
+					args = []any{msg.GetName(), fmt.Sprintf("enabled: %v", msg.GetEnabled())}
 				}
 
 				args = append([]any{event.Node, event.ID, event.TypeURL, event.ActorID}, args...)
@@ -118,10 +153,62 @@ var eventsCmd = &cobra.Command{
 	},
 }
 
+// parseSince parses the --since flag value, which is either a duration (events for the past duration interval),
+// an RFC3339 timestamp or an event ID.
+func parseSince(since string, now time.Time) (time.Time, string) {
+	if since == "" {
+		return time.Time{}, ""
+	}
+
+	if dur, err := time.ParseDuration(since); err == nil {
+		return now.Add(-dur), ""
+	}
+
+	if timestamp, err := time.Parse(time.RFC3339, since); err == nil {
+		return timestamp, ""
+	}
+
+	return time.Time{}, since
+}
+
+// eventsFiltersSupported checks whether all the nodes support the events filters, warning about the nodes which don't.
+func eventsFiltersSupported(ctx context.Context, c *client.Client) bool {
+	nodes, err := c.Capabilities(ctx)
+	if err != nil {
+		// the error will be reported by the Events call
+		return true
+	}
+
+	for _, node := range nodes {
+		if !node.Supports(client.FeatureEventsFilters) {
+			cli.Warning("%s, filtering the events on the client side", &client.UnsupportedFeatureError{
+				Feature: client.FeatureEventsFilters,
+				Node:    node.Node,
+				Version: node.Version,
+			})
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// eventType converts the event type to the full protobuf message name, short names are looked up in the machine API.
+func eventType(typ string) string {
+	if strings.Contains(typ, ".") || strings.Contains(typ, "/") {
+		return typ
+	}
+
+	return "machine." + typ
+}
+
 func init() {
 	addCommand(eventsCmd)
 	eventsCmd.Flags().Int32Var(&eventsCmdFlags.tailEvents, "tail", 0, "show specified number of past events (use -1 to show full history, default is to show no history)")
 	eventsCmd.Flags().DurationVar(&eventsCmdFlags.tailDuration, "duration", 0, "show events for the past duration interval (one second resolution, default is to show no history)")
-	eventsCmd.Flags().StringVar(&eventsCmdFlags.tailID, "since", "", "show events after the specified event ID (default is to show no history)")
+	eventsCmd.Flags().StringVar(&eventsCmdFlags.since, "since", "",
+		"show events after the specified event ID, or since the specified duration ago (e.g. 1h) or RFC3339 timestamp (default is to show no history)")
 	eventsCmd.Flags().StringVar(&eventsCmdFlags.actorID, "actor-id", "", "filter events by the specified actor ID (default is no filter)")
+	eventsCmd.Flags().StringSliceVar(&eventsCmdFlags.types, "type", nil, "filter events by the specified event types, e.g. SequenceEvent or machine.ServiceStateEvent (default is no filter)")
 }
@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var featureFlagsCmd = &cobra.Command{
+	Use:   "feature-flags",
+	Short: "Manage the feature flags of the machine configuration",
+	Long: `Manage the boolean feature flags of the machine configuration (.machine.features).
+
+The flags are named by the path relative to .machine.features, e.g. kubePrism.enabled.`,
+	Args: cobra.NoArgs,
+}
+
+var featureFlagsListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the feature flags",
+	Long: `List the feature flags of the machine configuration.
+
+The MUTABLE column shows whether the flag can be toggled without a reboot.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.FeatureFlagList(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureFeatureFlags, err)
+
+				if resp == nil {
+					return fmt.Errorf("error listing feature flags: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tNAME\tENABLED\tMUTABLE")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, flag := range msg.Flags {
+					fmt.Fprintf(w, "%s\t%s\t%t\t%t\n", node, flag.Name, flag.Enabled, flag.Mutable)
+				}
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	},
+}
+
+var featureFlagsSetCmd = &cobra.Command{
+	Use:   "set <name> <true|false>",
+	Short: "Toggle the feature flag",
+	Long: `Toggle the feature flag without a reboot.
+
+The change is persisted by patching the machine configuration.
+Only the flags which can be applied without a reboot can be toggled (see the MUTABLE column of the list command),
+use 'talosctl apply-config' or 'talosctl patch machineconfig' to change the other flags.`,
+	Example: `  talosctl feature-flags set kubePrism.enabled false`,
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		enabled, err := strconv.ParseBool(args[1])
+		if err != nil {
+			return fmt.Errorf("error parsing feature flag value: %w", err)
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.FeatureFlagSet(ctx, args[0], enabled, grpc.Peer(&remotePeer))
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureFeatureFlags, err)
+
+				if resp == nil {
+					return fmt.Errorf("error setting feature flag: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tNAME\tENABLED\tCHANGED")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%t\t%t\n", node, msg.Flag.GetName(), msg.Flag.GetEnabled(), !msg.Unchanged)
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	},
+}
+
+func init() {
+	featureFlagsCmd.AddCommand(featureFlagsListCmd)
+	featureFlagsCmd.AddCommand(featureFlagsSetCmd)
+	addCommand(featureFlagsCmd)
+}
@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var fstrimCmdFlags struct {
+	includeEncrypted bool
+}
+
+var fstrimCmd = &cobra.Command{
+	Use:   "fstrim",
+	Short: "Discard the unused blocks of the mounted filesystems",
+	Long: `Discard the unused blocks of the mounted filesystems on the node.
+
+Talos trims the mounted filesystems weekly, this command triggers the trim immediately.
+The filesystems on the encrypted volumes are skipped unless --include-encrypted is set,
+as discarding the unused blocks reveals which blocks of the encrypted volume are in use.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.FilesystemTrim(ctx, &machine.FilesystemTrimRequest{
+				IncludeEncrypted: fstrimCmdFlags.includeEncrypted,
+			}, grpc.Peer(&remotePeer))
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureFilesystemTrim, err)
+
+				if resp == nil {
+					return fmt.Errorf("error trimming filesystems: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tMOUNTPOINT\tDEVICE\tENCRYPTED\tTRIMMED\tERROR")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, filesystem := range msg.Filesystems {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%v\t%s\t%s\n",
+						node,
+						filesystem.Mountpoint,
+						filesystem.Device,
+						filesystem.Encrypted,
+						humanize.Bytes(filesystem.TrimmedBytes),
+						filesystem.Error,
+					)
+				}
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	},
+}
+
+func init() {
+	fstrimCmd.Flags().BoolVar(&fstrimCmdFlags.includeEncrypted, "include-encrypted", false, "trim the filesystems on the encrypted volumes as well")
+	addCommand(fstrimCmd)
+}
@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// generatedFilesCmd represents the generated-files command.
+var generatedFilesCmd = &cobra.Command{
+	Use:   "generated-files -|<local-path>",
+	Short: "Download a snapshot of the files generated by Talos",
+	Long: `Downloads a .tar.gz snapshot of the files generated by Talos on the node:
+/etc files (resolv.conf, hosts, CRI configuration, etc.) and the kubelet configuration.
+Secrets (private keys, tokens, etc.) are redacted in the file contents.
+
+If '-' is given for <local-path>, archive is written to stdout.
+Otherwise archive is extracted to <local-path> which should be an empty directory or
+talosctl creates a directory if <local-path> doesn't exist.
+
+Snapshots of different nodes can be compared with 'diff -r' to audit the differences.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "generated-files"); err != nil {
+				return err
+			}
+
+			r, err := c.GeneratedFiles(ctx)
+			if err != nil {
+				return fmt.Errorf("error getting generated files: %w", err)
+			}
+
+			localPath := args[0]
+
+			if localPath == "-" {
+				_, err = io.Copy(os.Stdout, r)
+
+				return err
+			}
+
+			localPath = filepath.Clean(localPath)
+
+			fi, err := os.Stat(localPath)
+			if err == nil && !fi.IsDir() {
+				return fmt.Errorf("local path %q should be a directory", args[0])
+			}
+
+			if err != nil {
+				if !os.IsNotExist(err) {
+					return fmt.Errorf("failed to stat local path: %w", err)
+				}
+
+				if err = os.MkdirAll(localPath, 0o777); err != nil {
+					return fmt.Errorf("error creating local path %q: %w", localPath, err)
+				}
+			}
+
+			return helpers.ExtractTarGz(localPath, r)
+		})
+	},
+}
+
+func init() {
+	addCommand(generatedFilesCmd)
+}
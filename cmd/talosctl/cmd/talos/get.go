@@ -6,15 +6,16 @@ package talos
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
-	"github.com/hashicorp/go-multierror"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc/metadata"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos/output"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
@@ -28,7 +29,10 @@ var getCmdFlags struct {
 
 	namespace string
 	output    string
+	selector  string
+	sort      string
 	watch     bool
+	diff      bool
 }
 
 // getCmd represents the get (resources) command.
@@ -39,7 +43,8 @@ var getCmd = &cobra.Command{
 	Short:      "Get a specific resource or list of resources (use 'talosctl get rd' to see all available resource types).",
 	Long: `Similar to 'kubectl get', 'talosctl get' returns a set of resources from the OS.
 To get a list of all available resource definitions, issue 'talosctl get rd'`,
-	Example: "",
+	Example: `  talosctl get members
+  talosctl get -w --diff addresses`,
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		switch len(args) {
 		case 0:
@@ -52,6 +57,18 @@ To get a list of all available resource definitions, issue 'talosctl get rd'`,
 	},
 	Args: cobra.RangeArgs(1, 2),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if getCmdFlags.sort != "" && getCmdFlags.watch {
+			return errors.New("--sort is not supported with --watch")
+		}
+
+		if getCmdFlags.diff {
+			if cmd.Flags().Changed("output") && getCmdFlags.output != "diff" {
+				return errors.New("--diff can't be combined with --output")
+			}
+
+			getCmdFlags.output = "diff"
+		}
+
 		if getCmdFlags.insecure {
 			return WithClientMaintenance(nil, getResources(args))
 		}
@@ -60,6 +77,27 @@ To get a list of all available resource definitions, issue 'talosctl get rd'`,
 	},
 }
 
+// parseListSort parses the --sort flag value, the "-" prefix requests the descending order.
+func parseListSort(sortBy string) (client.ListSortField, bool, error) {
+	field, descending := strings.CutPrefix(sortBy, "-")
+
+	if !slices.Contains(client.ListSortFields, client.ListSortField(field)) {
+		return "", false, fmt.Errorf("unsupported sort field %q, supported fields: %s", field, strings.Join(getSortFields(), ", "))
+	}
+
+	return client.ListSortField(field), descending, nil
+}
+
+func getSortFields() []string {
+	fields := make([]string, 0, len(client.ListSortFields))
+
+	for _, field := range client.ListSortFields {
+		fields = append(fields, string(field))
+	}
+
+	return fields
+}
+
 //nolint:gocyclo,cyclop
 func getResources(args []string) func(ctx context.Context, c *client.Client) error {
 	return func(ctx context.Context, c *client.Client) error {
@@ -67,11 +105,26 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 			return err
 		}
 
+		if getCmdFlags.output == "diff" && !getCmdFlags.watch {
+			return errors.New("diff output is only supported with --watch")
+		}
+
 		out, err := output.NewWriter(getCmdFlags.output)
 		if err != nil {
 			return err
 		}
 
+		labelQuery, err := helpers.ParseLabelSelector(getCmdFlags.selector)
+		if err != nil {
+			return err
+		}
+
+		var labelMatcher resource.LabelQuery
+
+		for _, opt := range labelQuery {
+			opt(&labelMatcher)
+		}
+
 		resourceType := args[0]
 
 		var resourceID string
@@ -83,13 +136,7 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 		defer out.Flush() //nolint:errcheck
 
 		if getCmdFlags.watch { // get -w <type> OR get -w <type> <id>
-			md, _ := metadata.FromOutgoingContext(ctx)
-			nodes := md.Get("nodes")
-
-			if len(nodes) == 0 {
-				// use "current" node
-				nodes = []string{""}
-			}
+			nodes := helpers.Nodes(ctx)
 
 			// fetch the RD from the first node (it doesn't matter which one to use, so we'll use the first one)
 			rd, err := c.ResolveResourceKind(client.WithNode(ctx, nodes[0]), &getCmdFlags.namespace, resourceType)
@@ -117,12 +164,20 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 				watchCh := make(chan state.Event)
 
 				if resourceID == "" {
+					watchOpts := []state.WatchKindOption{
+						state.WithBootstrapContents(true),
+						state.WithWatchKindUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
+					}
+
+					if len(labelQuery) > 0 {
+						watchOpts = append(watchOpts, state.WatchWithLabelQuery(labelQuery...))
+					}
+
 					err = c.COSI.WatchKind(
 						nodeCtx,
 						resource.NewMetadata(getCmdFlags.namespace, resourceType, "", resource.VersionUndefined),
 						watchCh,
-						state.WithBootstrapContents(true),
-						state.WithWatchKindUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
+						watchOpts...,
 					)
 				} else {
 					err = c.COSI.Watch(
@@ -170,7 +225,19 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 					continue
 				}
 
-				if err = out.WriteResource(nev.node, nev.ev.Resource, nev.ev.Type); err != nil {
+				// single resource watch doesn't support label queries
+				if resourceID != "" && len(labelQuery) > 0 && !labelMatcher.Matches(*nev.ev.Resource.Metadata().Labels()) {
+					continue
+				}
+
+				// diff output uses the previous version of the resource sent with the update event
+				if diffOut, ok := out.(*output.Diff); ok {
+					err = diffOut.WriteChange(nev.node, nev.ev.Old, nev.ev.Resource, nev.ev.Type)
+				} else {
+					err = out.WriteResource(nev.node, nev.ev.Resource, nev.ev.Type)
+				}
+
+				if err != nil {
 					return err
 				}
 
@@ -182,13 +249,22 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 			}
 		}
 
-		var multiErr *multierror.Error
+		if getCmdFlags.sort != "" {
+			field, descending, err := parseListSort(getCmdFlags.sort)
+			if err != nil {
+				return err
+			}
+
+			ctx = client.WithListSort(ctx, field, descending)
+		}
+
+		nodeErrs := map[string]error{}
 
 		// get <type>
 		// get <type> <id>
 		callbackResource := func(parentCtx context.Context, hostname string, r resource.Resource, callError error) error {
 			if callError != nil {
-				multiErr = multierror.Append(multiErr, callError)
+				nodeErrs[hostname] = callError
 
 				return nil
 			}
@@ -200,12 +276,28 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 			return out.WriteHeader(definition, false)
 		}
 
-		helperErr := helpers.ForEachResource(ctx, c, callbackRD, callbackResource, getCmdFlags.namespace, args...)
+		helperErr := helpers.ForEachResourceParallel(ctx, c, GlobalArgs.Parallel, labelQuery, callbackRD, callbackResource, getCmdFlags.namespace, args...)
 		if helperErr != nil {
 			return helperErr
 		}
 
-		return multiErr.ErrorOrNil()
+		if len(nodeErrs) > 0 {
+			nodesErr := &helpers.NodesError{
+				Errors: nodeErrs,
+				Total:  len(helpers.Nodes(ctx)),
+			}
+
+			// machine-readable output carries the errors along with the resources
+			if jsonl, ok := out.(*output.JSONLines); ok {
+				if err = jsonl.WriteError(nodesErr); err != nil {
+					return err
+				}
+			}
+
+			return nodesErr
+		}
+
+		return nil
 	}
 }
 
@@ -316,8 +408,12 @@ func CompleteNodes(*cobra.Command, []string, string) ([]string, cobra.ShellCompD
 
 func init() {
 	getCmd.Flags().StringVar(&getCmdFlags.namespace, "namespace", "", "resource namespace (default is to use default namespace per resource)")
-	getCmd.Flags().StringVarP(&getCmdFlags.output, "output", "o", "table", "output mode (json, table, yaml, jsonpath)")
+	getCmd.Flags().StringVarP(&getCmdFlags.output, "output", "o", "table", "output mode (json, jsonl, table, yaml, jsonpath, go-template, diff)")
+	getCmd.Flags().StringVarP(&getCmdFlags.selector, "selector", "l", "", "label selector to filter resources on (e.g. 'key=value,!other'), supports '=', '==', '!=' and (non-)existence terms")
 	getCmd.Flags().BoolVarP(&getCmdFlags.watch, "watch", "w", false, "watch resource changes")
+	getCmd.Flags().StringVar(&getCmdFlags.sort, "sort", "",
+		fmt.Sprintf("sort resources on the server by the metadata field (%s), prefix with '-' for descending order", strings.Join(getSortFields(), ", ")))
+	getCmd.Flags().BoolVar(&getCmdFlags.diff, "diff", false, "with --watch, print the changes of the resources as colorized YAML diffs (same as --output diff)")
 	getCmd.Flags().BoolVarP(&getCmdFlags.insecure, "insecure", "i", false, "get resources using the insecure (encrypted with no auth) maintenance service")
 	cli.Should(getCmd.RegisterFlagCompletionFunc("output", output.CompleteOutputArg))
 	addCommand(getCmd)
@@ -6,9 +6,11 @@ package talos
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -20,9 +22,11 @@ import (
 	"github.com/siderolabs/talos/pkg/cluster/check"
 	"github.com/siderolabs/talos/pkg/cluster/hydrophone"
 	clusterapi "github.com/siderolabs/talos/pkg/machinery/api/cluster"
+	healthapi "github.com/siderolabs/talos/pkg/machinery/api/health"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	clusterres "github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 )
 
 type clusterNodes struct {
@@ -85,15 +89,35 @@ var healthCmdFlags struct {
 	forceEndpoint      string
 	runOnServer        bool
 	runE2E             bool
+	output             string
+	checks             []string
 }
 
 // healthCmd represents the health command.
 var healthCmd = &cobra.Command{
 	Use:   "health",
 	Short: "Check cluster health",
-	Long:  ``,
-	Args:  cobra.NoArgs,
+	Long: `Check cluster health.
+
+By default (--output text), the cluster-wide readiness checks are run until the cluster is healthy or the timeout is reached.
+
+With --output json, the health checks of the node components (etcd quorum, kubelet, CRI, time sync, disk space, certificate expiry)
+are run once on each of the target nodes, and the result of each check is printed as a JSON object per line,
+with the remediation hint for the failed checks and warnings.
+The command fails if any of the checks failed.`,
+	Example: `  talosctl health
+  talosctl -n 172.20.0.2,172.20.0.3 health --output json
+  talosctl -n 172.20.0.2 health --output json --checks etcd,disk-space`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		switch healthCmdFlags.output {
+		case "text":
+		case "json":
+			return WithClient(healthNodeChecks)
+		default:
+			return fmt.Errorf("unsupported output mode %q", healthCmdFlags.output)
+		}
+
 		err := healthCmdFlags.clusterState.InitNodeInfos()
 		if err != nil {
 			return err
@@ -147,7 +171,14 @@ func healthOnClient(ctx context.Context, c *client.Client) error {
 	checkCtx, checkCtxCancel := context.WithTimeout(ctx, healthCmdFlags.clusterWaitTimeout)
 	defer checkCtxCancel()
 
-	return check.Wait(checkCtx, &state, append(check.DefaultClusterChecks(), check.ExtraClusterChecks()...), check.StderrReporter())
+	checks := check.DefaultClusterChecks()
+
+	// adjust the checks for the CNI readiness configuration, if the machine configuration is available
+	if mc, err := safe.StateGetByID[*config.MachineConfig](ctx, c.COSI, config.V1Alpha1ID); err == nil && mc.Config().Cluster() != nil {
+		checks = check.ClusterChecksForCNI(mc.Config().Cluster().Network().CNI().Readiness())
+	}
+
+	return check.Wait(checkCtx, &state, append(checks, check.ExtraClusterChecks()...), check.StderrReporter())
 }
 
 func healthOnServer(ctx context.Context, c *client.Client) error {
@@ -191,6 +222,56 @@ func healthOnServer(ctx context.Context, c *client.Client) error {
 	}
 }
 
+type healthCheckResult struct {
+	Node        string `json:"node"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	Message     string `json:"message"`
+	Remediation string `json:"remediation,omitempty"`
+	Duration    string `json:"duration"`
+}
+
+func healthNodeChecks(ctx context.Context, c *client.Client) error {
+	// the error returned by the stream doesn't tell whether the node supports the API
+	if err := c.RequireFeature(ctx, client.FeatureHealthChecks); err != nil {
+		return err
+	}
+
+	stream, err := c.Health.Check(ctx, healthCmdFlags.checks)
+	if err != nil {
+		return fmt.Errorf("error running health checks: %w", err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+
+	var failed int
+
+	if err = helpers.ReadGRPCStream(stream, func(msg *healthapi.CheckResult, node string, multipleNodes bool) error {
+		if msg.Status == healthapi.CheckResult_FAIL {
+			failed++
+		}
+
+		return enc.Encode(healthCheckResult{
+			Node:        node,
+			Name:        msg.Name,
+			Description: msg.Description,
+			Status:      strings.ToLower(msg.Status.String()),
+			Message:     msg.Message,
+			Remediation: msg.Remediation,
+			Duration:    msg.Duration.AsDuration().String(),
+		})
+	}); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d health check(s) failed", failed)
+	}
+
+	return nil
+}
+
 func runE2E() error {
 	return WithClient(func(ctx context.Context, c *client.Client) error {
 		clientProvider := &cluster.ConfigClientProvider{
@@ -223,6 +304,8 @@ func init() {
 	healthCmd.Flags().StringVar(&healthCmdFlags.forceEndpoint, "k8s-endpoint", "", "use endpoint instead of kubeconfig default")
 	healthCmd.Flags().BoolVar(&healthCmdFlags.runOnServer, "server", true, "run server-side check")
 	healthCmd.Flags().BoolVar(&healthCmdFlags.runE2E, "run-e2e", false, "run Kubernetes e2e test")
+	healthCmd.Flags().StringVarP(&healthCmdFlags.output, "output", "o", "text", "output mode (text: wait for the cluster readiness checks, json: run the node health checks once and print the results as JSON object per line)")
+	healthCmd.Flags().StringSliceVar(&healthCmdFlags.checks, "checks", nil, "node health checks to run with --output json (default is to run all the checks)")
 }
 
 func buildClusterInfo(clusterState clusterNodes) (cluster.Info, error) {
@@ -12,15 +12,21 @@ import (
 	"time"
 
 	"github.com/dustin/go-humanize"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/images"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/version"
 )
 
 type imageCmdFlagsType struct {
@@ -149,6 +155,52 @@ var imageDefaultCmd = &cobra.Command{
 	},
 }
 
+var imageManifestCmdFlags struct {
+	talosVersion      string
+	kubernetesVersion string
+	output            string
+}
+
+// imageManifestCmd represents the image manifest command.
+var imageManifestCmd = &cobra.Command{
+	Use:   "manifest",
+	Short: "Generate the air-gap manifest of the default images pinned to the digests",
+	Long: `Generate the air-gap manifest of the default images pinned to the digests.
+
+The default images for the Talos and Kubernetes versions are resolved to the digests in the registries.
+The manifest (YAML output) can be used to generate the machine configuration with the digest-pinned images
+with 'talosctl gen config --images-manifest'.
+The list output contains the digest-pinned image references, one per line, to be mirrored to the private registry.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if imageManifestCmdFlags.output != "yaml" && imageManifestCmdFlags.output != "list" {
+			return fmt.Errorf("unsupported output format %q", imageManifestCmdFlags.output)
+		}
+
+		return cli.WithContext(context.Background(), func(ctx context.Context) error {
+			manifest, err := images.NewManifest(ctx, imageManifestCmdFlags.talosVersion, imageManifestCmdFlags.kubernetesVersion,
+				remote.WithAuthFromKeychain(authn.DefaultKeychain),
+			)
+			if err != nil {
+				return err
+			}
+
+			if imageManifestCmdFlags.output == "list" {
+				for _, image := range manifest.Images {
+					fmt.Println(image.Pinned())
+				}
+
+				return nil
+			}
+
+			encoder := yaml.NewEncoder(os.Stdout)
+			encoder.SetIndent(2)
+
+			return encoder.Encode(manifest)
+		})
+	},
+}
+
 func init() {
 	imageCmd.PersistentFlags().StringVar(&imageCmdFlags.namespace, "namespace", "cri", "namespace to use: `system` (etcd and kubelet images) or `cri` for all Kubernetes workloads")
 	addCommand(imageCmd)
@@ -156,4 +208,9 @@ func init() {
 	imageCmd.AddCommand(imageDefaultCmd)
 	imageCmd.AddCommand(imageListCmd)
 	imageCmd.AddCommand(imagePullCmd)
+	imageCmd.AddCommand(imageManifestCmd)
+
+	imageManifestCmd.Flags().StringVar(&imageManifestCmdFlags.talosVersion, "talos-version", version.Tag, "Talos version of the installer image")
+	imageManifestCmd.Flags().StringVar(&imageManifestCmdFlags.kubernetesVersion, "kubernetes-version", constants.DefaultKubernetesVersion, "Kubernetes version of the Kubernetes images")
+	imageManifestCmd.Flags().StringVarP(&imageManifestCmdFlags.output, "output", "o", "yaml", "output format (yaml|list)")
 }
@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// integrityCmd represents the integrity command.
+var integrityCmd = &cobra.Command{
+	Use:   "integrity",
+	Short: "Verify the integrity of the rootfs image",
+	Long: `Verify the integrity of the rootfs image against the checksum manifest shipped with the initramfs.
+
+The whole rootfs image is read back from the node, so the command might take a while.
+The command fails if the rootfs image doesn't match the manifest on any of the nodes.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.RootfsIntegrity(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureRootfsIntegrity, err)
+
+				if resp == nil {
+					return fmt.Errorf("error verifying rootfs integrity: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tSTATUS\tDEVICE\tEXPECTED\tACTUAL\tREASON")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			var mismatched bool
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				if msg.Status == machine.RootfsIntegrity_MISMATCH {
+					mismatched = true
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", node, msg.Status, msg.Device, msg.ExpectedSha256, msg.ActualSha256, msg.Reason)
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			if err = helpers.CheckErrors(resp.Messages...); err != nil {
+				return err
+			}
+
+			if mismatched {
+				return errors.New("rootfs integrity verification failed")
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	addCommand(integrityCmd)
+}
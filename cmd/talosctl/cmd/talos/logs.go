@@ -7,17 +7,24 @@ package talos
 import (
 	"bufio"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"regexp"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/siderolabs/gen/xslices"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
@@ -30,6 +37,14 @@ var (
 	tailLines int32
 )
 
+var logsCmdFlags struct {
+	output string
+	level  string
+	grep   string
+	since  string
+	until  string
+}
+
 var logsCmd = &cobra.Command{
 	Use:   "logs <service name>",
 	Short: "Retrieve logs for a service",
@@ -47,6 +62,17 @@ var logsCmd = &cobra.Command{
 		return mergeSuggestions(getServiceFromNode(), getContainersFromNode(kubernetesFlag), getLogsContainers()), cobra.ShellCompDirectiveNoFileComp
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		switch logsCmdFlags.output {
+		case "text", "json":
+		default:
+			return fmt.Errorf("unsupported output format: %q", logsCmdFlags.output)
+		}
+
+		filter, err := logsFilter(time.Now())
+		if err != nil {
+			return err
+		}
+
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			var (
 				namespace string
@@ -61,51 +87,240 @@ var logsCmd = &cobra.Command{
 				driver = common.ContainerDriver_CONTAINERD
 			}
 
-			stream, err := c.Logs(ctx, namespace, driver, args[0], follow, tailLines)
-			if err != nil {
-				return fmt.Errorf("error fetching logs: %s", err)
+			if filter != nil {
+				filter.Namespace = namespace
+				filter.Driver = driver
+				filter.Id = args[0]
 			}
 
-			defaultNode := client.RemotePeer(stream.Context())
+			if follow && GlobalArgs.Parallel > 0 && GlobalArgs.Parallel < len(helpers.Nodes(ctx)) {
+				return errors.New("--follow requires --parallel to be either 0 or at least the number of nodes")
+			}
 
-			respCh, errCh := newLineSlicer(stream)
+			out := &logsWriter{
+				w:    os.Stdout,
+				errW: os.Stderr,
+				json: logsCmdFlags.output == "json",
+			}
 
-			var gotErrors bool
+			return helpers.ForEachNode(ctx, GlobalArgs.Parallel, func(ctx context.Context, node string) error {
+				if filter != nil {
+					return logRecords(ctx, c, node, filter, out)
+				}
 
-			for data := range respCh {
-				if data.Metadata != nil && data.Metadata.Error != "" {
-					_, err = fmt.Fprintf(os.Stderr, "ERROR: %s\n", data.Metadata.Error)
-					if err != nil {
-						return err
+				stream, err := c.Logs(ctx, namespace, driver, args[0], follow, tailLines)
+				if err != nil {
+					return fmt.Errorf("error fetching logs: %w", err)
+				}
+
+				defaultNode := node
+				if defaultNode == "" {
+					defaultNode = client.RemotePeer(stream.Context())
+				}
+
+				respCh, errCh := newLineSlicer(stream)
+
+				var nodeErr error
+
+				for data := range respCh {
+					if data.Metadata != nil && data.Metadata.Error != "" {
+						if err = out.WriteError(data.Metadata.Error); err != nil {
+							return err
+						}
+
+						nodeErr = errors.New(data.Metadata.Error)
+
+						continue
 					}
 
-					gotErrors = true
+					msgNode := defaultNode
+					if data.Metadata != nil && data.Metadata.Hostname != "" {
+						msgNode = data.Metadata.Hostname
+					}
 
-					continue
+					if err = out.WriteLine(msgNode, data.Bytes); err != nil {
+						return err
+					}
 				}
 
-				node := defaultNode
-				if data.Metadata != nil && data.Metadata.Hostname != "" {
-					node = data.Metadata.Hostname
+				if err = <-errCh; err != nil {
+					return fmt.Errorf("error getting logs: %w", err)
 				}
 
-				_, err = fmt.Printf("%s: %s\n", node, data.Bytes)
-				if err != nil {
-					return err
-				}
-			}
+				return nodeErr
+			})
+		})
+	},
+}
+
+// logsFilter builds the log records request from the filter flags.
+//
+// If no filter flags are set, logsFilter returns nil, and the raw logs are fetched.
+func logsFilter(now time.Time) (*machine.LogRecordsRequest, error) {
+	if logsCmdFlags.level == "" && logsCmdFlags.grep == "" && logsCmdFlags.since == "" && logsCmdFlags.until == "" {
+		return nil, nil
+	}
+
+	req := &machine.LogRecordsRequest{
+		Follow:    follow,
+		TailLines: tailLines,
+		Pattern:   logsCmdFlags.grep,
+	}
+
+	if logsCmdFlags.level != "" {
+		level, ok := machine.LogRecord_Level_value[strings.ToUpper(logsCmdFlags.level)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported log level: %q", logsCmdFlags.level)
+		}
+
+		req.MinLevel = machine.LogRecord_Level(level)
+	}
+
+	if logsCmdFlags.grep != "" {
+		if _, err := regexp.Compile(logsCmdFlags.grep); err != nil {
+			return nil, fmt.Errorf("invalid --grep pattern: %w", err)
+		}
+	}
+
+	for _, flag := range []struct {
+		name  string
+		value string
+		dest  **timestamppb.Timestamp
+	}{
+		{"since", logsCmdFlags.since, &req.Since},
+		{"until", logsCmdFlags.until, &req.Until},
+	} {
+		if flag.value == "" {
+			continue
+		}
+
+		t, err := parseLogsTime(flag.value, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s value: %w", flag.name, err)
+		}
+
+		*flag.dest = timestamppb.New(t)
+	}
 
-			if err = <-errCh; err != nil {
-				return fmt.Errorf("error getting logs: %v", err)
+	return req, nil
+}
+
+// parseLogsTime parses the time flag value, which is either a duration (the time the duration ago) or an RFC3339 timestamp.
+func parseLogsTime(value string, now time.Time) (time.Time, error) {
+	if dur, err := time.ParseDuration(value); err == nil {
+		return now.Add(-dur), nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+// logRecords streams the log records filtered on the node.
+func logRecords(ctx context.Context, c *client.Client, node string, req *machine.LogRecordsRequest, out *logsWriter) error {
+	stream, err := c.LogRecords(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error fetching logs: %w", err)
+	}
+
+	defaultNode := node
+	if defaultNode == "" {
+		defaultNode = client.RemotePeer(stream.Context())
+	}
+
+	var nodeErr error
+
+	for {
+		record, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF || client.StatusCode(err) == codes.Canceled {
+				return nodeErr
 			}
 
-			if gotErrors {
-				os.Exit(1)
+			return fmt.Errorf("error getting logs: %w", c.ExplainUnsupported(ctx, client.FeatureLogRecords, err))
+		}
+
+		if record.Metadata != nil && record.Metadata.Error != "" {
+			if err = out.WriteError(record.Metadata.Error); err != nil {
+				return err
 			}
 
-			return nil
+			nodeErr = errors.New(record.Metadata.Error)
+
+			continue
+		}
+
+		msgNode := defaultNode
+		if record.Metadata != nil && record.Metadata.Hostname != "" {
+			msgNode = record.Metadata.Hostname
+		}
+
+		if err = out.WriteRecord(msgNode, record); err != nil {
+			return err
+		}
+	}
+}
+
+// logsWriter writes log lines from multiple nodes, either prefixed with the node name or as JSON lines.
+type logsWriter struct {
+	mu   sync.Mutex
+	w    io.Writer
+	errW io.Writer
+	json bool
+}
+
+type logsLine struct {
+	Node    string `json:"node"`
+	Message string `json:"message"`
+}
+
+func (lw *logsWriter) WriteLine(node string, line []byte) error {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	if lw.json {
+		return json.NewEncoder(lw.w).Encode(logsLine{Node: node, Message: string(line)})
+	}
+
+	_, err := fmt.Fprintf(lw.w, "%s: %s\n", node, line)
+
+	return err
+}
+
+type logsRecord struct {
+	Node      string            `json:"node"`
+	Timestamp time.Time         `json:"timestamp"`
+	Facility  string            `json:"facility"`
+	Level     string            `json:"level"`
+	Message   string            `json:"message"`
+	Fields    map[string]string `json:"fields,omitempty"`
+}
+
+func (lw *logsWriter) WriteRecord(node string, record *machine.LogRecord) error {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	if lw.json {
+		return json.NewEncoder(lw.w).Encode(logsRecord{
+			Node:      node,
+			Timestamp: record.Timestamp.AsTime(),
+			Facility:  record.Facility,
+			Level:     strings.ToLower(record.Level.String()),
+			Message:   record.Message,
+			Fields:    record.Fields,
 		})
-	},
+	}
+
+	_, err := fmt.Fprintf(lw.w, "%s: %s %s %s\n", node, record.Timestamp.AsTime().Format(time.RFC3339Nano), record.Level, record.Message)
+
+	return err
+}
+
+func (lw *logsWriter) WriteError(msg string) error {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	_, err := fmt.Fprintf(lw.errW, "ERROR: %s\n", msg)
+
+	return err
 }
 
 // lineSlicer splits random chunks of bytes coming from nodes into a stream
@@ -232,6 +447,11 @@ func init() {
 	logsCmd.Flags().BoolVarP(&kubernetesFlag, "kubernetes", "k", false, "use the k8s.io containerd namespace")
 	logsCmd.Flags().BoolVarP(&follow, "follow", "f", false, "specify if the logs should be streamed")
 	logsCmd.Flags().Int32VarP(&tailLines, "tail", "", -1, "lines of log file to display (default is to show from the beginning)")
+	logsCmd.Flags().StringVarP(&logsCmdFlags.output, "output", "o", "text", "output mode (text: lines prefixed with the node, json: JSON object per line)")
+	logsCmd.Flags().StringVar(&logsCmdFlags.level, "level", "", "show only the log records with the level at or above the specified one (debug, info, warn, error, fatal)")
+	logsCmd.Flags().StringVar(&logsCmdFlags.grep, "grep", "", "show only the log records with the message matching the regular expression")
+	logsCmd.Flags().StringVar(&logsCmdFlags.since, "since", "", "show only the log records since the specified duration ago (e.g. 1h) or RFC3339 timestamp")
+	logsCmd.Flags().StringVar(&logsCmdFlags.until, "until", "", "show only the log records before the specified duration ago (e.g. 10m) or RFC3339 timestamp")
 
 	logsCmd.Flags().BoolP("use-cri", "c", false, "use the CRI driver")
 	logsCmd.Flags().MarkHidden("use-cri") //nolint:errcheck
@@ -22,6 +22,10 @@ import (
 
 var verbose bool
 
+var memoryCmdFlags struct {
+	output string
+}
+
 // memoryCmd represents the processes command.
 var memoryCmd = &cobra.Command{
 	Use:     "memory",
@@ -30,10 +34,19 @@ var memoryCmd = &cobra.Command{
 	Long:    ``,
 	Args:    cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if memoryCmdFlags.output != "table" && !helpers.IsRecordOutput(memoryCmdFlags.output) {
+			return fmt.Errorf("unsupported output format: %q", memoryCmdFlags.output)
+		}
+
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			var remotePeer peer.Peer
 
 			resp, err := c.Memory(ctx, grpc.Peer(&remotePeer))
+
+			if helpers.IsRecordOutput(memoryCmdFlags.output) {
+				return writeMessagesRecords(memoryCmdFlags.output, &remotePeer, resp.GetMessages(), err)
+			}
+
 			if err != nil {
 				if resp == nil {
 					return fmt.Errorf("error getting memory stats: %s", err)
@@ -150,5 +163,6 @@ func verboseRender(remotePeer *peer.Peer, resp *machineapi.MemoryResponse) {
 
 func init() {
 	memoryCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "display extended memory statistics")
+	memoryCmd.Flags().StringVarP(&memoryCmdFlags.output, "output", "o", "table", "output mode (table, jsonl, jsonpath, go-template)")
 	addCommand(memoryCmd)
 }
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var modparamCmd = &cobra.Command{
+	Use:   "modparam",
+	Short: "Manage the parameters of the loaded kernel modules",
+	Long: `Manage the parameters of the loaded kernel modules.
+
+The current parameters of the loaded kernel modules are available as KernelModuleStatus resources:
+
+  talosctl get modparams nf_conntrack -o yaml`,
+	Args: cobra.NoArgs,
+}
+
+var modparamSetCmd = &cobra.Command{
+	Use:   "set <module> <parameter> <value>",
+	Short: "Change a parameter of the loaded kernel module",
+	Long: `Change a parameter of the loaded kernel module without a reboot.
+
+Only the parameters which are writable at runtime can be changed.
+The change is not persisted across reboots, use the .machine.kernel.modules machine configuration
+to make it persistent: the parameters from the machine configuration are applied at runtime as well.`,
+	Example: `  talosctl modparam set nf_conntrack hashsize 262144`,
+	Args:    cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.KernelModuleParameterSet(ctx, args[0], args[1], args[2], grpc.Peer(&remotePeer))
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureKernelModuleParams, err)
+
+				if resp == nil {
+					return fmt.Errorf("error setting kernel module parameter: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tMODULE\tPARAMETER\tPREVIOUS\tVALUE")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", node, args[0], args[1], msg.PreviousValue, msg.Value)
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	},
+}
+
+func init() {
+	modparamCmd.AddCommand(modparamSetCmd)
+	addCommand(modparamCmd)
+}
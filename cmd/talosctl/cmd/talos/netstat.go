@@ -8,7 +8,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 	"text/tabwriter"
@@ -23,24 +25,27 @@ import (
 )
 
 var netstatCmdFlags struct {
-	verbose   bool
-	extend    bool
-	pid       bool
-	timers    bool
-	listening bool
-	all       bool
-	pods      bool
-	tcp       bool
-	udp       bool
-	udplite   bool
-	raw       bool
-	ipv4      bool
-	ipv6      bool
+	verbose    bool
+	extend     bool
+	pid        bool
+	timers     bool
+	listening  bool
+	all        bool
+	pods       bool
+	containers bool
+	tcp        bool
+	udp        bool
+	udplite    bool
+	raw        bool
+	ipv4       bool
+	ipv6       bool
+	summary    bool
 }
 
 type netstat struct {
-	client        *client.Client
-	NodeNetNSPods map[string]map[string]string
+	client           *client.Client
+	NodeNetNSPods    map[string]map[string]string
+	NodeContainerIDs map[string]map[string]string
 }
 
 // netstatCmd represents the netstat command.
@@ -53,7 +58,10 @@ var netstatCmd = &cobra.Command{
 You can pass an optional argument to view a specific pod's connections.
 To do this, format the argument as "namespace/pod".
 Note that only pods with a pod network namespace are allowed.
-If you don't pass an argument, the command will show host connections.`,
+If you don't pass an argument, the command will show host connections.
+
+With --summary, the command shows the listening sockets and the number of connections in each state
+for the host and all pod network namespaces (or only the specified pod).`,
 	Args: cobra.MaximumNArgs(1),
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) > 0 {
@@ -87,6 +95,12 @@ If you don't pass an argument, the command will show host connections.`,
 		return podList, cobra.ShellCompDirectiveNoFileComp
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if netstatCmdFlags.summary {
+			return WithClient(func(ctx context.Context, c *client.Client) error {
+				return socketStatistics(ctx, c, args)
+			})
+		}
+
 		req := netstatFlagsToRequest()
 
 		return WithClient(func(ctx context.Context, c *client.Client) (err error) {
@@ -101,8 +115,9 @@ If you don't pass an argument, the command will show host connections.`,
 			}
 
 			n.NodeNetNSPods = make(map[string]map[string]string)
+			n.NodeContainerIDs = make(map[string]map[string]string)
 
-			if findThePod || netstatCmdFlags.pods {
+			if findThePod || netstatCmdFlags.pods || netstatCmdFlags.containers {
 				err = n.getPodNetNsFromNode(ctx)
 				if err != nil {
 					return err
@@ -144,7 +159,7 @@ If you don't pass an argument, the command will show host connections.`,
 func netstatFlagsToRequest() *machine.NetstatRequest {
 	req := machine.NetstatRequest{
 		Feature: &machine.NetstatRequest_Feature{
-			Pid: netstatCmdFlags.pid,
+			Pid: netstatCmdFlags.pid || netstatCmdFlags.containers,
 		},
 		L4Proto: &machine.NetstatRequest_L4Proto{
 			Tcp:      netstatCmdFlags.tcp,
@@ -216,6 +231,14 @@ func (n *netstat) getPodNetNsFromNode(ctx context.Context) (err error) {
 
 	for _, msg := range resp.Messages {
 		for _, p := range msg.Containers {
+			if p.Id != p.PodId && p.InternalId != "" {
+				if n.NodeContainerIDs[msg.Metadata.Hostname] == nil {
+					n.NodeContainerIDs[msg.Metadata.Hostname] = make(map[string]string)
+				}
+
+				n.NodeContainerIDs[msg.Metadata.Hostname][p.InternalId] = p.Id
+			}
+
 			if p.NetworkNamespace == "" {
 				continue
 			}
@@ -332,6 +355,12 @@ func (n *netstat) printNetstat(response *machine.NetstatResponse) error {
 				}
 			}
 
+			if netstatCmdFlags.containers {
+				args = append(args, []any{
+					n.socketOwner(node, record.Process.GetCgroup()),
+				}...)
+			}
+
 			if netstatCmdFlags.timers {
 				timerwhen := strconv.FormatFloat(float64(record.Timerwhen)/100, 'f', 2, 64)
 
@@ -377,6 +406,10 @@ func netstatSummaryLabels() (labels string) {
 		labels += "\t" + "Pod"
 	}
 
+	if netstatCmdFlags.containers {
+		labels += "\t" + "Container"
+	}
+
 	if netstatCmdFlags.timers {
 		labels += "\t" + "Timer"
 	}
@@ -384,6 +417,121 @@ func netstatSummaryLabels() (labels string) {
 	return labels
 }
 
+// socketOwner returns the Kubernetes container or the Talos service owning the socket based on the process cgroup.
+func (n *netstat) socketOwner(node, cgroup string) string {
+	if cgroup == "" {
+		return "-"
+	}
+
+	if strings.HasPrefix(cgroup, "/kubepods") {
+		// cgroupfs driver uses container ID as the cgroup name, systemd driver uses cri-containerd-<ID>.scope
+		containerID := strings.TrimSuffix(strings.TrimPrefix(path.Base(cgroup), "cri-containerd-"), ".scope")
+
+		if id, ok := n.NodeContainerIDs[node][containerID]; ok {
+			return id
+		}
+
+		return "-"
+	}
+
+	switch {
+	case cgroup == constants.CgroupInit:
+		return "machined"
+	case strings.HasPrefix(cgroup, constants.CgroupSystem+"/"), strings.HasPrefix(cgroup, constants.CgroupPodRuntimeRoot+"/"):
+		return path.Base(cgroup)
+	default:
+		return "-"
+	}
+}
+
+func socketStatistics(ctx context.Context, c *client.Client, args []string) error {
+	resp, err := c.SocketStatistics(ctx)
+	if err != nil {
+		err = c.ExplainUnsupported(ctx, client.FeatureSocketStatistics, err)
+
+		if resp == nil {
+			return err
+		}
+
+		cli.Warning("%s", err)
+	}
+
+	var netNSStats []*machine.NetNSSocketStatistics
+
+	nodes := map[*machine.NetNSSocketStatistics]string{}
+
+	for _, msg := range resp.Messages {
+		for _, ns := range msg.Netns {
+			if len(args) > 0 && ns.Pod != strings.ToLower(args[0]) {
+				continue
+			}
+
+			netNSStats = append(netNSStats, ns)
+			nodes[ns] = msg.GetMetadata().GetHostname()
+		}
+	}
+
+	if len(args) > 0 && len(netNSStats) == 0 {
+		return fmt.Errorf("pod %s not found", args[0])
+	}
+
+	netNSName := func(ns *machine.NetNSSocketStatistics) string {
+		if ns.Netns == "" {
+			return "host"
+		}
+
+		return ns.Netns
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NODE\tNETNS\tPOD\tPROTO\tLOCAL ADDRESS\tPID/PROGRAM")
+
+	for _, ns := range netNSStats {
+		for _, listener := range ns.Listeners {
+			program := "-"
+			if listener.Pid != 0 {
+				program = fmt.Sprintf("%d/%s", listener.Pid, listener.Process)
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				nodes[ns],
+				netNSName(ns),
+				dashIfEmpty(ns.Pod),
+				listener.L4Proto,
+				net.JoinHostPort(listener.Ip, strconv.FormatUint(uint64(listener.Port), 10)),
+				program,
+			)
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "NODE\tNETNS\tPOD\tPROTO\tSTATE\tCOUNT")
+
+	for _, ns := range netNSStats {
+		for _, summary := range ns.Connections {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\n",
+				nodes[ns],
+				netNSName(ns),
+				dashIfEmpty(ns.Pod),
+				summary.L4Proto,
+				summary.State,
+				summary.Count,
+			)
+		}
+	}
+
+	return w.Flush()
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
+
 func wildcardIfZero(num uint32) string {
 	if num == 0 {
 		return "*"
@@ -401,12 +549,14 @@ func init() {
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.listening, "listening", "l", false, "display listening server sockets")
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.all, "all", "a", false, "display all sockets states (default: connected)")
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.pods, "pods", "k", false, "show sockets used by Kubernetes pods")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.containers, "containers", "c", false, "show Kubernetes container or Talos service owning the socket")
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.tcp, "tcp", "t", false, "display only TCP sockets")
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.udp, "udp", "u", false, "display only UDP sockets")
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.udplite, "udplite", "U", false, "display only UDPLite sockets")
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.raw, "raw", "w", false, "display only RAW sockets")
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.ipv4, "ipv4", "4", false, "display only ipv4 sockets")
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.ipv6, "ipv6", "6", false, "display only ipv6 sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.summary, "summary", "s", false, "show listening sockets and connection counts per network namespace (host and pods)")
 
 	addCommand(netstatCmd)
 }
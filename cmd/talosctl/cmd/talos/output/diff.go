@@ -0,0 +1,176 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/fatih/color"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+// Diff outputs unified YAML diffs between the successive versions of the resources.
+//
+// Diff output is only useful with the watch mode: the first version of each resource
+// is printed as an addition, and the destroyed resources are printed as removals.
+// The added and removed lines are colorized if the output is a terminal.
+type Diff struct {
+	writer io.Writer
+
+	// last seen YAML representation of the resources, keyed by the node and the resource pointer
+	last map[string]string
+}
+
+// NewDiff initializes Diff resource output.
+func NewDiff(writer io.Writer) *Diff {
+	return &Diff{
+		writer: writer,
+		last:   map[string]string{},
+	}
+}
+
+// WriteHeader implements output.Writer interface.
+func (d *Diff) WriteHeader(definition *meta.ResourceDefinition, withEvents bool) error {
+	return nil
+}
+
+// WriteResource implements output.Writer interface.
+func (d *Diff) WriteResource(node string, r resource.Resource, event state.EventType) error {
+	return d.WriteChange(node, nil, r, event)
+}
+
+// WriteChange writes the diff between the old and the new version of the resource.
+//
+// If old is nil (e.g. the watch event doesn't carry the previous version), the diff is built
+// against the last version of the resource seen by the writer.
+func (d *Diff) WriteChange(node string, old, r resource.Resource, event state.EventType) error {
+	key := node + "/" + r.Metadata().Namespace() + "/" + r.Metadata().Type() + "/" + r.Metadata().ID()
+	name := fmt.Sprintf("%s/%s/%s", node, r.Metadata().Type(), r.Metadata().ID())
+
+	var (
+		current string
+		err     error
+	)
+
+	if event != state.Destroyed {
+		if current, err = marshalDiffYAML(r); err != nil {
+			return err
+		}
+	}
+
+	previous, seen := d.last[key]
+
+	switch {
+	case old != nil:
+		if previous, err = marshalDiffYAML(old); err != nil {
+			return err
+		}
+
+		seen = true
+	case !seen && event == state.Destroyed:
+		// the resource was created before the watch started, so the destroyed version is the last one
+		if previous, err = marshalDiffYAML(r); err != nil {
+			return err
+		}
+
+		seen = true
+	}
+
+	if event == state.Destroyed {
+		delete(d.last, key)
+	} else {
+		d.last[key] = current
+	}
+
+	from, to := name, name
+
+	if !seen {
+		from = "/dev/null"
+	}
+
+	if event == state.Destroyed {
+		to = "/dev/null"
+	}
+
+	edits := myers.ComputeEdits(span.URIFromPath(name), previous, current)
+	diff := gotextdiff.ToUnified(from, to, previous, edits)
+
+	if len(diff.Hunks) == 0 {
+		return nil
+	}
+
+	_, err = fmt.Fprintf(d.writer, "%s\n%s", color.YellowString("%s %s@%s", eventLabel(event), name, r.Metadata().Version()), colorizeDiff(fmt.Sprint(diff)))
+
+	return err
+}
+
+// Flush implements output.Writer interface.
+func (d *Diff) Flush() error {
+	return nil
+}
+
+func marshalDiffYAML(r resource.Resource) (string, error) {
+	if r.Metadata().Type() == config.MachineConfigType {
+		r = &mcYamlRepr{r}
+	}
+
+	out, err := resource.MarshalYAML(r)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := yaml.Marshal(out)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func colorizeDiff(diff string) string {
+	if color.NoColor {
+		return diff
+	}
+
+	lines := strings.Split(diff, "\n")
+
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+			lines[i] = color.New(color.Bold).Sprint(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = color.CyanString("%s", line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = color.GreenString("%s", line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = color.RedString("%s", line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func eventLabel(event state.EventType) string {
+	switch event { //nolint:exhaustive
+	case state.Created:
+		return "# created"
+	case state.Updated:
+		return "# updated"
+	case state.Destroyed:
+		return "# destroyed"
+	default:
+		return "#"
+	}
+}
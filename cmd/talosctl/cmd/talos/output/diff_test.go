@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos/output"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+func TestDiffWriteResource(t *testing.T) {
+	node := "123.123.123.123"
+
+	var buf bytes.Buffer
+
+	testObj := output.NewDiff(&buf)
+
+	processorResource := hardware.NewProcessorInfo("myCPU")
+	processorResource.TypedSpec().CoreCount = 2
+
+	// the first version is printed as an addition
+	require.NoError(t, testObj.WriteResource(node, processorResource, state.Created))
+
+	assert.Contains(t, buf.String(), "# created 123.123.123.123/Processors.hardware.talos.dev/myCPU@")
+	assert.Contains(t, buf.String(), "--- /dev/null\n")
+	assert.Contains(t, buf.String(), "+    coreCount: 2\n")
+
+	buf.Reset()
+
+	// no changes, no output
+	require.NoError(t, testObj.WriteResource(node, processorResource, state.Updated))
+	assert.Empty(t, buf.String())
+
+	processorResource.TypedSpec().CoreCount = 4
+
+	require.NoError(t, testObj.WriteResource(node, processorResource, state.Updated))
+
+	assert.Contains(t, buf.String(), "# updated ")
+	assert.Contains(t, buf.String(), "-    coreCount: 2\n+    coreCount: 4\n")
+	assert.NotContains(t, buf.String(), "/dev/null")
+
+	buf.Reset()
+
+	require.NoError(t, testObj.WriteResource(node, processorResource, state.Destroyed))
+
+	assert.Contains(t, buf.String(), "# destroyed ")
+	assert.Contains(t, buf.String(), "+++ /dev/null\n")
+	assert.Contains(t, buf.String(), "-    coreCount: 4\n")
+}
+
+func TestDiffWriteChange(t *testing.T) {
+	node := "123.123.123.123"
+
+	var buf bytes.Buffer
+
+	testObj := output.NewDiff(&buf)
+
+	oldResource := hardware.NewProcessorInfo("myCPU")
+	oldResource.TypedSpec().CoreCount = 2
+
+	newResource := hardware.NewProcessorInfo("myCPU")
+	newResource.TypedSpec().CoreCount = 4
+
+	// the previous version from the event is used even if the writer hasn't seen the resource
+	require.NoError(t, testObj.WriteChange(node, oldResource, newResource, state.Updated))
+
+	assert.Contains(t, buf.String(), "# updated 123.123.123.123/Processors.hardware.talos.dev/myCPU@")
+	assert.Contains(t, buf.String(), "-    coreCount: 2\n+    coreCount: 4\n")
+	assert.NotContains(t, buf.String(), "/dev/null")
+
+	buf.Reset()
+
+	// the resource destroyed without the previous version is printed as a removal
+	require.NoError(t, output.NewDiff(&buf).WriteChange(node, nil, newResource, state.Destroyed))
+
+	assert.Contains(t, buf.String(), "# destroyed ")
+	assert.Contains(t, buf.String(), "+++ /dev/null\n")
+	assert.Contains(t, buf.String(), "-    coreCount: 4\n")
+}
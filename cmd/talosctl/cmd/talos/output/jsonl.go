@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output
+
+import (
+	"io"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+)
+
+// JSONLines outputs resources as a JSON object per line.
+//
+// Each line carries the node and the resource as the result, errors are written with the same record format.
+// JSONLines reuses the JSON output to encode the resources.
+type JSONLines struct {
+	JSON
+
+	out *helpers.JSONLines
+}
+
+// NewJSONLines initializes JSON lines resource output.
+func NewJSONLines(writer io.Writer) *JSONLines {
+	return &JSONLines{
+		out: helpers.NewJSONLines(writer),
+	}
+}
+
+// WriteResource implements output.Writer interface.
+func (j *JSONLines) WriteResource(node string, r resource.Resource, event state.EventType) error {
+	data, err := j.prepareEncodableData(node, r, event)
+	if err != nil {
+		return err
+	}
+
+	return j.out.WriteResult(node, data)
+}
+
+// WriteError writes the error records.
+func (j *JSONLines) WriteError(err error) error {
+	return j.out.WriteError(err)
+}
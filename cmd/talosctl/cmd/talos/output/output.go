@@ -8,13 +8,13 @@ package output
 import (
 	"fmt"
 	"os"
-	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/spf13/cobra"
-	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/outputtemplate"
 )
 
 // Writer interface.
@@ -35,16 +35,17 @@ func NewWriter(format string) (Writer, error) {
 		return NewYAML(writer), nil
 	case format == "json":
 		return NewJSON(writer), nil
-	case strings.HasPrefix(format, "jsonpath="):
-		path := format[len("jsonpath="):]
-
-		jp := jsonpath.New("talos")
-
-		if err := jp.Parse(path); err != nil {
-			return nil, fmt.Errorf("error parsing jsonpath: %w", err)
+	case format == "jsonl":
+		return NewJSONLines(writer), nil
+	case format == "diff":
+		return NewDiff(writer), nil
+	case outputtemplate.IsTemplate(format):
+		template, err := outputtemplate.Parse(format)
+		if err != nil {
+			return nil, err
 		}
 
-		return NewJSONPath(writer, jp), nil
+		return NewTemplate(writer, template), nil
 	default:
 		return nil, fmt.Errorf("output format %q is not supported", format)
 	}
@@ -52,5 +53,5 @@ func NewWriter(format string) (Writer, error) {
 
 // CompleteOutputArg represents tab completion for `--output` argument.
 func CompleteOutputArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	return []string{"json", "table", "yaml", "jsonpath"}, cobra.ShellCompDirectiveNoFileComp
+	return []string{"json", "jsonl", "table", "yaml", "jsonpath", "go-template", "diff"}, cobra.ShellCompDirectiveNoFileComp
 }
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output
+
+import (
+	"io"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/state"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/outputtemplate"
+)
+
+// Template outputs resources rendered with the JSONPath expression or the Go template.
+type Template struct {
+	template outputtemplate.Template
+	json     *JSON
+	writer   io.Writer
+}
+
+// NewTemplate initializes template resource output.
+func NewTemplate(writer io.Writer, template outputtemplate.Template) *Template {
+	return &Template{
+		template: template,
+		json:     NewJSON(writer),
+		writer:   writer,
+	}
+}
+
+// NewJSONPath initializes JSONPath resource output.
+func NewJSONPath(writer io.Writer, jsonPath *jsonpath.JSONPath) *Template {
+	return NewTemplate(writer, outputtemplate.NewJSONPath(jsonPath))
+}
+
+// WriteHeader implements output.Writer interface.
+func (t *Template) WriteHeader(definition *meta.ResourceDefinition, withEvents bool) error {
+	return t.json.WriteHeader(definition, withEvents)
+}
+
+// WriteResource implements output.Writer interface.
+func (t *Template) WriteResource(node string, r resource.Resource, event state.EventType) error {
+	data, err := t.json.prepareEncodableData(node, r, event)
+	if err != nil {
+		return err
+	}
+
+	return t.template.Execute(t.writer, data)
+}
+
+// Flush implements output.Writer interface.
+func (t *Template) Flush() error {
+	return nil
+}
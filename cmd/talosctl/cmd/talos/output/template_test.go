@@ -13,6 +13,7 @@ import (
 	"k8s.io/client-go/util/jsonpath"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos/output"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/outputtemplate"
 	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 )
 
@@ -61,4 +62,22 @@ func TestWriteResource(t *testing.T) {
 
 		assert.Equal(t, expectedMetadata, buf.String())
 	})
+	t.Run("renders go templates", func(tt *testing.T) {
+		var buf bytes.Buffer
+
+		// given
+		processorResource := hardware.NewProcessorInfo("myCPU")
+		processorResource.TypedSpec().CoreCount = 2
+		template, err := outputtemplate.Parse(`go-template={{ .node }} {{ .metadata.id }}: {{ .spec.coreCount }}`)
+		assert.Nil(t, err)
+
+		// when
+		testObj := output.NewTemplate(&buf, template)
+		err = testObj.WriteResource(node, processorResource, event)
+
+		// then
+		assert.Nil(t, err)
+
+		assert.Equal(t, node+" myCPU: 2\n", buf.String())
+	})
 }
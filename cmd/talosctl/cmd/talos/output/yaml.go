@@ -14,6 +14,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	yaml "gopkg.in/yaml.v3"
 
+	"github.com/siderolabs/talos/pkg/machinery/resources"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 )
 
@@ -44,7 +45,7 @@ func (y *YAML) WriteResource(node string, r resource.Resource, event state.Event
 		r = &mcYamlRepr{r}
 	}
 
-	out, err := resource.MarshalYAML(r)
+	out, err := resources.MarshalYAMLNode(r)
 	if err != nil {
 		return err
 	}
@@ -55,6 +56,7 @@ func (y *YAML) WriteResource(node string, r resource.Resource, event state.Event
 
 	y.needDashes = true
 
+	fmt.Fprintln(y.writer, resources.YAMLSchemaHeader)
 	fmt.Fprintf(y.writer, "node: %s\n", node)
 
 	if y.withEvents {
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -32,6 +33,7 @@ var patchCmdFlags struct {
 	patch            []string
 	patchFile        string
 	dryRun           bool
+	serverSide       bool
 	configTryTimeout time.Duration
 }
 
@@ -88,6 +90,60 @@ func patchFn(c *client.Client, patches []configpatcher.Patch) func(context.Conte
 	}
 }
 
+// patchServerSide sends the patches to the nodes, so that the patches are applied against the current machine config on the node.
+func patchServerSide(ctx context.Context, c *client.Client, args ...string) error {
+	if len(args) > 1 && args[1] != config.V1Alpha1ID {
+		return fmt.Errorf("unsupported resource ID for server-side patch: %s", args[1])
+	}
+
+	patches := make([][]byte, 0, len(patchCmdFlags.patch))
+
+	for _, patch := range patchCmdFlags.patch {
+		if filename, ok := strings.CutPrefix(patch, "@"); ok {
+			contents, err := os.ReadFile(filename)
+			if err != nil {
+				return err
+			}
+
+			patches = append(patches, contents)
+
+			continue
+		}
+
+		patches = append(patches, []byte(patch))
+	}
+
+	for _, node := range GlobalArgs.Nodes {
+		nodeCtx := client.WithNodes(ctx, node)
+		namespace := patchCmdFlags.namespace
+
+		rd, err := c.ResolveResourceKind(nodeCtx, &namespace, args[0])
+		if err != nil {
+			return fmt.Errorf("%s: %w", node, err)
+		}
+
+		if rd.TypedSpec().Type != config.MachineConfigType {
+			return fmt.Errorf("server-side patch is only supported for %s", config.MachineConfigType)
+		}
+
+		resp, err := c.ApplyConfiguration(nodeCtx, &machine.ApplyConfigurationRequest{
+			Patches:        patches,
+			Mode:           patchCmdFlags.Mode.Mode,
+			DryRun:         patchCmdFlags.dryRun,
+			TryModeTimeout: durationpb.New(patchCmdFlags.configTryTimeout),
+		})
+		if err != nil {
+			return fmt.Errorf("%s: error patching machine config: %w", node, err)
+		}
+
+		fmt.Fprintf(os.Stderr, "patched %s/%s at the node %s\n", config.MachineConfigType, config.V1Alpha1ID, node)
+
+		helpers.PrintApplyResults(resp)
+	}
+
+	return nil
+}
+
 // patchCmd represents the edit command.
 var patchCmd = &cobra.Command{
 	Use:   "patch <type> [<id>]",
@@ -112,6 +168,10 @@ var patchCmd = &cobra.Command{
 				return err
 			}
 
+			if patchCmdFlags.serverSide {
+				return patchServerSide(ctx, c, args...)
+			}
+
 			for _, node := range GlobalArgs.Nodes {
 				nodeCtx := client.WithNodes(ctx, node)
 				if err := helpers.ForEachResource(nodeCtx, c, nil, patchFn(c, patches), patchCmdFlags.namespace, args...); err != nil {
@@ -129,6 +189,7 @@ func init() {
 	patchCmd.Flags().StringVar(&patchCmdFlags.patchFile, "patch-file", "", "a file containing a patch to be applied to the resource.")
 	patchCmd.Flags().StringArrayVarP(&patchCmdFlags.patch, "patch", "p", nil, "the patch to be applied to the resource file, use @file to read a patch from file.")
 	patchCmd.Flags().BoolVar(&patchCmdFlags.dryRun, "dry-run", false, "print the change summary and patch preview without applying the changes")
+	patchCmd.Flags().BoolVar(&patchCmdFlags.serverSide, "server-side", false, "send the patches to the node to be applied against the current machine config (machineconfig only)")
 	patchCmd.Flags().DurationVar(&patchCmdFlags.configTryTimeout, "timeout", constants.ConfigTryTimeout, "the config will be rolled back after specified timeout (if try mode is selected)")
 	helpers.AddModeFlags(&patchCmdFlags.Mode, patchCmd)
 	addCommand(patchCmd)
@@ -31,6 +31,7 @@ var pcapCmdFlags struct {
 	snaplen   int
 	output    string
 	bpfFilter string
+	filter    string
 	duration  time.Duration
 }
 
@@ -53,7 +54,15 @@ Output can be piped to tcpdump:
 
     talosctl pcap -i eth0 -o - | tcpdump -vvv -r -
 
-BPF filter can be applied, but it has to compiled to BPF instructions first using tcpdump.
+Filter expression can be applied, it is compiled to BPF on the node. Filter supports a subset of
+tcpdump syntax: protocols (ip, ip6, arp, tcp, udp, sctp, icmp, icmp6), host, net and port primitives
+with optional src/dst qualifiers, combined with and/or/not and parentheses:
+
+    talosctl pcap -i eth0 -f 'port 6443'
+
+    talosctl pcap -i eth0 -f 'tcp and not port 50000' -o capture.pcap
+
+For more complex filters, raw BPF filter can be applied, but it has to compiled to BPF instructions first using tcpdump.
 Correct link type should be specified for the tcpdump: EN10MB for Ethernet links and RAW
 for e.g. Wireguard tunnels:
 
@@ -81,6 +90,7 @@ e.g. by excluding packets with the port 50000.
 			req := machine.PacketCaptureRequest{
 				Interface:   pcapCmdFlags.iface,
 				Promiscuous: pcapCmdFlags.promisc,
+				Filter:      pcapCmdFlags.filter,
 			}
 
 			var err error
@@ -202,8 +212,10 @@ func init() {
 	pcapCmd.Flags().IntVarP(&pcapCmdFlags.snaplen, "snaplen", "s", 4096, "maximum packet size to capture")
 	pcapCmd.Flags().StringVarP(&pcapCmdFlags.output, "output", "o", "", "if not set, decode packets to stdout; if set write raw pcap data to a file, use '-' for stdout")
 	pcapCmd.Flags().StringVar(&pcapCmdFlags.bpfFilter, "bpf-filter", "", "bpf filter to apply, tcpdump -dd format")
+	pcapCmd.Flags().StringVarP(&pcapCmdFlags.filter, "filter", "f", "", "filter expression to apply, tcpdump syntax subset")
 	pcapCmd.Flags().DurationVar(&pcapCmdFlags.duration, "duration", 0, "duration of the capture")
 	pcapCmd.Flags().MarkDeprecated("snaplen", "support of snap length is removed") //nolint:errcheck
+	pcapCmd.MarkFlagsMutuallyExclusive("filter", "bpf-filter")
 
 	addCommand(pcapCmd)
 }
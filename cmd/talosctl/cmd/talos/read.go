@@ -16,6 +16,10 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
 
+var readCmdFlags struct {
+	redact bool
+}
+
 // readCmd represents the read command.
 var readCmd = &cobra.Command{
 	Use:     "read <path>",
@@ -36,7 +40,7 @@ var readCmd = &cobra.Command{
 				return err
 			}
 
-			r, err := c.Read(ctx, args[0])
+			r, err := c.Read(ctx, args[0], client.WithRedact(readCmdFlags.redact))
 			if err != nil {
 				return fmt.Errorf("error reading file: %w", err)
 			}
@@ -54,5 +58,6 @@ var readCmd = &cobra.Command{
 }
 
 func init() {
+	readCmd.Flags().BoolVar(&readCmdFlags.redact, "redact", false, "redact secrets (private keys, tokens, etc.) in the file contents on the node")
 	addCommand(readCmd)
 }
@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var replaceControlPlaneCmdFlags struct {
+	oldNode        string
+	newNode        string
+	checkpointPath string
+	joinTimeout    time.Duration
+}
+
+// replaceControlPlaneCmd represents the replace-controlplane command.
+var replaceControlPlaneCmd = &cobra.Command{
+	Use:   "replace-controlplane",
+	Short: "Replace a control plane node with a new node",
+	Long: `Replace a control plane node with a new node running in maintenance mode.
+
+The node specified with --nodes should be a healthy control plane node which stays in the cluster.
+The replacement captures the machine config of the old node, resets the old node, removes its etcd member,
+applies the captured config to the new node and waits for the new node to join etcd.
+
+The progress is recorded in the checkpoint file, if the replacement fails, it can be resumed by running the command again.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if len(GlobalArgs.Nodes) != 1 {
+				return errors.New("command \"replace-controlplane\" requires exactly one healthy control plane node specified with --nodes")
+			}
+
+			if replaceControlPlaneCmdFlags.oldNode == "" || replaceControlPlaneCmdFlags.newNode == "" {
+				return errors.New("both --old and --new should be specified")
+			}
+
+			replacer := cluster.ControlPlaneReplacer{
+				Client:         c,
+				ViaNode:        GlobalArgs.Nodes[0],
+				OldNode:        replaceControlPlaneCmdFlags.oldNode,
+				NewNode:        replaceControlPlaneCmdFlags.newNode,
+				CheckpointPath: replaceControlPlaneCmdFlags.checkpointPath,
+				JoinTimeout:    replaceControlPlaneCmdFlags.joinTimeout,
+			}
+
+			if err := replacer.Run(ctx, os.Stderr); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stderr, "control plane node %q was replaced with %q, checkpoint %q can be removed\n",
+				replaceControlPlaneCmdFlags.oldNode, replaceControlPlaneCmdFlags.newNode, replaceControlPlaneCmdFlags.checkpointPath)
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	replaceControlPlaneCmd.Flags().StringVar(&replaceControlPlaneCmdFlags.oldNode, "old", "", "address of the control plane node being replaced")
+	replaceControlPlaneCmd.Flags().StringVar(&replaceControlPlaneCmdFlags.newNode, "new", "", "address of the replacement node running in maintenance mode")
+	replaceControlPlaneCmd.Flags().StringVar(&replaceControlPlaneCmdFlags.checkpointPath, "checkpoint", "replace-controlplane.yaml", "path to the checkpoint file to record the progress to")
+	replaceControlPlaneCmd.Flags().DurationVar(&replaceControlPlaneCmdFlags.joinTimeout, "join-timeout", 15*time.Minute, "timeout for the new node to join etcd")
+	addCommand(replaceControlPlaneCmd)
+}
@@ -73,6 +73,7 @@ var resetCmdFlags struct {
 	graceful           bool
 	reboot             bool
 	insecure           bool
+	force              bool
 	wipeMode           WipeMode
 	userDisksToWipe    []string
 	systemLabelsToWipe []string
@@ -164,6 +165,7 @@ func buildResetRequest() *machineapi.ResetRequest {
 	return &machineapi.ResetRequest{
 		Graceful:               resetCmdFlags.graceful,
 		Reboot:                 resetCmdFlags.reboot,
+		Force:                  resetCmdFlags.force,
 		UserDisksToWipe:        resetCmdFlags.userDisksToWipe,
 		Mode:                   machineapi.ResetRequest_WipeMode(resetCmdFlags.wipeMode),
 		SystemPartitionsToWipe: systemPartitionsToWipe,
@@ -186,6 +188,7 @@ func resetGetActorID(ctx context.Context, c *client.Client, req *machineapi.Rese
 func init() {
 	resetCmd.Flags().BoolVar(&resetCmdFlags.graceful, "graceful", true, "if true, attempt to cordon/drain node and leave etcd (if applicable)")
 	resetCmd.Flags().BoolVar(&resetCmdFlags.reboot, "reboot", false, "if true, reboot the node after resetting instead of shutting down")
+	resetCmd.Flags().BoolVar(&resetCmdFlags.force, "force", false, "if true, skip the check that the etcd quorum is kept once the control plane node is reset")
 	resetCmd.Flags().BoolVar(&resetCmdFlags.insecure, "insecure", false, "reset using the insecure (encrypted with no auth) maintenance service")
 	resetCmd.Flags().Var(&resetCmdFlags.wipeMode, "wipe-mode", "disk reset mode")
 	resetCmd.Flags().StringSliceVar(&resetCmdFlags.userDisksToWipe, "user-disks-to-wipe", nil, "if set, wipes defined devices in the list")
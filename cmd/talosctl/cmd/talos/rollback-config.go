@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+var rollbackConfigCmdFlags struct {
+	helpers.Mode
+
+	dryRun bool
+}
+
+// rollbackConfigCmd represents the rollback-config command.
+var rollbackConfigCmd = &cobra.Command{
+	Use:   "rollback-config",
+	Short: "Roll back the machine configuration to the previous version",
+	Long: `Each time the machine configuration is applied, the node keeps the previous version of it.
+This command applies the previous configuration back, and the current configuration becomes the previous one.
+
+Staged configurations (--mode=staged) are rolled back automatically if the node doesn't become ready after the reboot.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "rollback-config"); err != nil {
+				return err
+			}
+
+			r, err := c.Read(ctx, constants.ConfigPreviousPath)
+			if err != nil {
+				return fmt.Errorf("error reading previous configuration: %w", err)
+			}
+
+			defer r.Close() //nolint:errcheck
+
+			cfgBytes, err := io.ReadAll(r)
+			if err != nil {
+				return fmt.Errorf("error reading previous configuration: %w", err)
+			}
+
+			if err = r.Close(); err != nil {
+				return fmt.Errorf("error reading previous configuration: %w", err)
+			}
+
+			resp, err := c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
+				Data:   cfgBytes,
+				Mode:   rollbackConfigCmdFlags.Mode.Mode,
+				DryRun: rollbackConfigCmdFlags.dryRun,
+			})
+			if err != nil {
+				return fmt.Errorf("error applying previous configuration: %w", err)
+			}
+
+			helpers.PrintApplyResults(resp)
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	rollbackConfigCmd.Flags().BoolVar(&rollbackConfigCmdFlags.dryRun, "dry-run", false, "check how the config change will be applied in dry-run mode")
+	helpers.AddModeFlags(&rollbackConfigCmdFlags.Mode, rollbackConfigCmd)
+	addCommand(rollbackConfigCmd)
+}
@@ -6,8 +6,10 @@ package talos
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
 	"slices"
 	"strings"
 
@@ -16,8 +18,10 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/global"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
 	_ "github.com/siderolabs/talos/pkg/grpc/codec" // register codec
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
@@ -57,6 +61,28 @@ func addCommand(cmd *cobra.Command) {
 	Commands = append(Commands, cmd)
 }
 
+// writeMessagesRecords writes the messages of the API response along with the call error as records
+// in the output format accepted by helpers.IsRecordOutput.
+func writeMessagesRecords[M interface {
+	proto.Message
+	GetMetadata() *common.Metadata
+}](format string, remotePeer *peer.Peer, messages []M, err error) error {
+	out, outErr := helpers.NewRecordOutput(os.Stdout, format)
+	if outErr != nil {
+		return outErr
+	}
+
+	if writeErr := helpers.WriteMessages(out, client.AddrFromPeer(remotePeer), messages); writeErr != nil {
+		return writeErr
+	}
+
+	if writeErr := out.WriteError(err); writeErr != nil {
+		return writeErr
+	}
+
+	return errors.Join(err, helpers.CheckErrors(messages...))
+}
+
 // completePathFromNode represents tab complete options for `ls` and `ls *` commands.
 func completePathFromNode(inputPath string) []string {
 	pathToSearch := inputPath
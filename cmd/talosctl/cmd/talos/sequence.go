@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var sequenceCmd = &cobra.Command{
+	Use:   "sequence",
+	Short: "Inspect and cancel the running sequences (upgrade, reboot, reset)",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+}
+
+var sequenceListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the sequences running on the nodes",
+	Long: `List the sequences (e.g. upgrade, reboot or reset) running on the nodes with the phase being run.
+
+The CANCELABLE column shows whether the sequence can be canceled in the current phase.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.SequenceList(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureSequences, err)
+
+				if resp == nil {
+					return fmt.Errorf("error listing sequences: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tSEQUENCE\tSTARTED\tPHASE\tCANCELABLE\tACTOR ID")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, seq := range msg.Sequences {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s (%d/%d)\t%v\t%s\n",
+						node,
+						seq.Sequence,
+						humanize.Time(seq.Started.AsTime()),
+						seq.Phase,
+						seq.PhaseNumber,
+						seq.TotalPhases,
+						seq.Cancelable,
+						seq.ActorId,
+					)
+				}
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	},
+}
+
+var sequenceCancelCmd = &cobra.Command{
+	Use:   "cancel <sequence>",
+	Short: "Cancel the running sequence",
+	Long: `Cancel the running sequence (e.g. upgrade, reboot or reset) on the nodes.
+
+The sequence can be canceled only before the node state is changed, i.e. while it runs the lifecycle hooks
+or drains the node. If the node drain is interrupted, the node stays cordoned and should be uncordoned manually.`,
+	Example: `  talosctl sequence cancel upgrade -n 172.20.0.2,172.20.0.3`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.SequenceCancel(ctx, args[0], grpc.Peer(&remotePeer))
+			if err != nil {
+				err = c.ExplainUnsupported(ctx, client.FeatureSequences, err)
+
+				if resp == nil {
+					return fmt.Errorf("error canceling sequence: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				fmt.Printf("%s: %s sequence canceled\n", node, args[0])
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	},
+}
+
+func init() {
+	sequenceCmd.AddCommand(sequenceListCmd, sequenceCancelCmd)
+	addCommand(sequenceCmd)
+}
@@ -6,19 +6,31 @@ package talos
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
-	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/formatters"
 )
 
+var serviceCmdFlags struct {
+	output string
+}
+
 // serviceCmd represents the service command.
 var serviceCmd = &cobra.Command{
 	Use:     "service [<id> [start|stop|restart|status]]",
@@ -50,6 +62,12 @@ With actions 'start', 'stop', 'restart', service state is updated respectively.`
 			action = args[1]
 		}
 
+		switch {
+		case serviceCmdFlags.output == "table", serviceCmdFlags.output == "json", helpers.IsRecordOutput(serviceCmdFlags.output):
+		default:
+			return fmt.Errorf("unsupported output format: %q", serviceCmdFlags.output)
+		}
+
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			switch action {
 			case "status":
@@ -59,11 +77,11 @@ With actions 'start', 'stop', 'restart', service state is updated respectively.`
 
 				return serviceInfo(ctx, c, serviceID)
 			case "start":
-				return serviceStart(ctx, c, serviceID)
+				return serviceAction[*machine.ServiceStart](ctx, c, serviceID, c.ServiceStart)
 			case "stop":
-				return serviceStop(ctx, c, serviceID)
+				return serviceAction[*machine.ServiceStop](ctx, c, serviceID, c.ServiceStop)
 			case "restart":
-				return serviceRestart(ctx, c, serviceID)
+				return serviceAction[*machine.ServiceRestart](ctx, c, serviceID, c.ServiceRestart)
 			default:
 				return fmt.Errorf("unsupported service action: %q", action)
 			}
@@ -71,151 +89,224 @@ With actions 'start', 'stop', 'restart', service state is updated respectively.`
 	},
 }
 
-func serviceList(ctx context.Context, c *client.Client) error {
-	var remotePeer peer.Peer
+// nodeMessage is a message returned from a node.
+type nodeMessage[M any] struct {
+	node    string
+	message M
+}
 
-	resp, err := c.ServiceList(ctx, grpc.Peer(&remotePeer))
-	if err != nil {
-		if resp == nil {
-			return fmt.Errorf("error listing services: %w", err)
+// fanOutMessages runs the call against each node and returns the messages from all nodes sorted by node.
+//
+// The call is run with the concurrency limit set by the global flags.
+func fanOutMessages[M interface{ GetMetadata() *common.Metadata }](
+	ctx context.Context,
+	call func(ctx context.Context, callOptions ...grpc.CallOption) ([]M, error),
+) ([]nodeMessage[M], error) {
+	var (
+		mu     sync.Mutex
+		result []nodeMessage[M]
+	)
+
+	err := helpers.ForEachNode(ctx, GlobalArgs.Parallel, func(ctx context.Context, node string) error {
+		var remotePeer peer.Peer
+
+		messages, err := call(ctx, grpc.Peer(&remotePeer))
+
+		defaultNode := node
+		if defaultNode == "" {
+			defaultNode = client.AddrFromPeer(&remotePeer)
 		}
 
-		cli.Warning("%s", err)
-	}
+		mu.Lock()
+		defer mu.Unlock()
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-	fmt.Fprintln(w, "NODE\tSERVICE\tSTATE\tHEALTH\tLAST CHANGE\tLAST EVENT")
+		for _, msg := range messages {
+			msgNode := defaultNode
 
-	defaultNode := client.AddrFromPeer(&remotePeer)
+			if hostname := msg.GetMetadata().GetHostname(); hostname != "" {
+				msgNode = hostname
+			}
 
-	for _, msg := range resp.Messages {
-		for _, s := range msg.Services {
-			svc := formatters.ServiceInfoWrapper{ServiceInfo: s}
+			result = append(result, nodeMessage[M]{node: msgNode, message: msg})
+		}
 
-			node := defaultNode
+		return err
+	})
 
-			if msg.Metadata != nil {
-				node = msg.Metadata.Hostname
-			}
+	slices.SortStableFunc(result, func(a, b nodeMessage[M]) int {
+		return strings.Compare(a.node, b.node)
+	})
 
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s ago\t%s\n", node, svc.Id, svc.State, svc.HealthStatus(), svc.LastUpdated(), svc.LastEvent())
+	return result, err
+}
+
+// writeServiceJSON writes the messages along with the errors as aggregated JSON output.
+func writeServiceJSON[M proto.Message](messages []nodeMessage[M], err error) error {
+	results := make([]helpers.NodeResult, 0, len(messages))
+
+	for _, msg := range messages {
+		data, marshalErr := protojson.Marshal(msg.message)
+		if marshalErr != nil {
+			return marshalErr
 		}
+
+		results = append(results, helpers.NodeResult{
+			Node:   msg.node,
+			Result: data,
+		})
+	}
+
+	if writeErr := helpers.WriteNodeResultsJSON(os.Stdout, results, err); writeErr != nil {
+		return writeErr
 	}
 
-	return w.Flush()
+	return err
 }
 
-func serviceInfo(ctx context.Context, c *client.Client, id string) error {
-	var remotePeer peer.Peer
+// writeServiceRecords writes the messages along with the errors as records
+// in the output format accepted by helpers.IsRecordOutput.
+func writeServiceRecords[M proto.Message](messages []nodeMessage[M], err error) error {
+	out, outErr := helpers.NewRecordOutput(os.Stdout, serviceCmdFlags.output)
+	if outErr != nil {
+		return outErr
+	}
 
-	services, err := c.ServiceInfo(ctx, id, grpc.Peer(&remotePeer))
-	if err != nil {
-		if services == nil {
-			return fmt.Errorf("error listing services: %w", err)
+	for _, msg := range messages {
+		if writeErr := out.WriteResult(msg.node, msg.message); writeErr != nil {
+			return writeErr
 		}
-
-		cli.Warning("%s", err)
 	}
 
-	defaultNode := client.AddrFromPeer(&remotePeer)
-
-	if len(services) == 0 {
-		return fmt.Errorf("service %q is not registered on any nodes", id)
+	if writeErr := out.WriteError(err); writeErr != nil {
+		return writeErr
 	}
 
-	return formatters.RenderServicesInfo(services, os.Stdout, defaultNode, true)
+	return err
 }
 
-func serviceStart(ctx context.Context, c *client.Client, id string) error {
-	var remotePeer peer.Peer
+func serviceList(ctx context.Context, c *client.Client) error {
+	messages, err := fanOutMessages(ctx, func(ctx context.Context, callOptions ...grpc.CallOption) ([]*machine.ServiceList, error) {
+		resp, err := c.ServiceList(ctx, callOptions...)
 
-	resp, err := c.ServiceStart(ctx, id, grpc.Peer(&remotePeer))
-	if err != nil {
-		if resp == nil {
-			return fmt.Errorf("error starting service: %w", err)
-		}
+		return resp.GetMessages(), err
+	})
 
-		cli.Warning("%s", err)
+	switch {
+	case serviceCmdFlags.output == "json":
+		return writeServiceJSON(messages, err)
+	case helpers.IsRecordOutput(serviceCmdFlags.output):
+		return writeServiceRecords(messages, err)
 	}
 
-	defaultNode := client.AddrFromPeer(&remotePeer)
+	if err != nil && len(messages) == 0 {
+		return fmt.Errorf("error listing services: %w", err)
+	}
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-	fmt.Fprintln(w, "NODE\tRESPONSE")
+	fmt.Fprintln(w, "NODE\tSERVICE\tSTATE\tHEALTH\tLAST CHANGE\tLAST EVENT")
 
-	for _, msg := range resp.Messages {
-		node := defaultNode
+	for _, msg := range messages {
+		for _, s := range msg.message.Services {
+			svc := formatters.ServiceInfoWrapper{ServiceInfo: s}
 
-		if msg.Metadata != nil {
-			node = msg.Metadata.Hostname
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s ago\t%s\n", msg.node, svc.Id, svc.State, svc.HealthStatus(), svc.LastUpdated(), svc.LastEvent())
 		}
-
-		fmt.Fprintf(w, "%s\t%s\n", node, msg.Resp)
 	}
 
-	return w.Flush()
+	return errors.Join(w.Flush(), err)
 }
 
-func serviceStop(ctx context.Context, c *client.Client, id string) error {
-	var remotePeer peer.Peer
-
-	resp, err := c.ServiceStop(ctx, id, grpc.Peer(&remotePeer))
-	if err != nil {
-		if resp == nil {
-			return fmt.Errorf("error starting service: %w", err)
+func serviceInfo(ctx context.Context, c *client.Client, id string) error {
+	messages, err := fanOutMessages(ctx, func(ctx context.Context, callOptions ...grpc.CallOption) ([]*machine.ServiceList, error) {
+		resp, err := c.ServiceList(ctx, callOptions...)
+
+		// filter out other services, so that the response matches the ServiceInfo API
+		for _, msg := range resp.GetMessages() {
+			msg.Services = slices.DeleteFunc(msg.Services, func(svc *machine.ServiceInfo) bool {
+				return svc.Id != id
+			})
 		}
 
-		cli.Warning("%s", err)
-	}
+		return resp.GetMessages(), err
+	})
 
-	defaultNode := client.AddrFromPeer(&remotePeer)
+	messages = slices.DeleteFunc(messages, func(msg nodeMessage[*machine.ServiceList]) bool {
+		return len(msg.message.Services) == 0
+	})
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-	fmt.Fprintln(w, "NODE\tRESPONSE")
+	switch {
+	case serviceCmdFlags.output == "json":
+		return writeServiceJSON(messages, err)
+	case helpers.IsRecordOutput(serviceCmdFlags.output):
+		return writeServiceRecords(messages, err)
+	}
 
-	for _, msg := range resp.Messages {
-		node := defaultNode
+	if err != nil && len(messages) == 0 {
+		return fmt.Errorf("error listing services: %w", err)
+	}
 
-		if msg.Metadata != nil {
-			node = msg.Metadata.Hostname
-		}
+	if len(messages) == 0 {
+		return fmt.Errorf("service %q is not registered on any nodes", id)
+	}
 
-		fmt.Fprintf(w, "%s\t%s\n", node, msg.Resp)
+	services := make([]client.ServiceInfo, 0, len(messages))
+
+	for _, msg := range messages {
+		services = append(services, client.ServiceInfo{
+			Metadata: &common.Metadata{Hostname: msg.node},
+			Service:  msg.message.Services[0],
+		})
 	}
 
-	return w.Flush()
+	return errors.Join(formatters.RenderServicesInfo(services, os.Stdout, "", true), err)
 }
 
-func serviceRestart(ctx context.Context, c *client.Client, id string) error {
-	var remotePeer peer.Peer
+// serviceActionMessage is implemented by the responses of service start/stop/restart.
+type serviceActionMessage interface {
+	proto.Message
+	GetMetadata() *common.Metadata
+	GetResp() string
+}
 
-	resp, err := c.ServiceRestart(ctx, id, grpc.Peer(&remotePeer))
-	if err != nil {
-		if resp == nil {
-			return fmt.Errorf("error starting service: %w", err)
-		}
+// serviceActionResponse is implemented by the responses of service start/stop/restart APIs.
+type serviceActionResponse[M serviceActionMessage] interface {
+	GetMessages() []M
+}
 
-		cli.Warning("%s", err)
+func serviceAction[M serviceActionMessage, R serviceActionResponse[M]](
+	ctx context.Context,
+	c *client.Client,
+	id string,
+	action func(ctx context.Context, id string, callOptions ...grpc.CallOption) (R, error),
+) error {
+	messages, err := fanOutMessages(ctx, func(ctx context.Context, callOptions ...grpc.CallOption) ([]M, error) {
+		resp, err := action(ctx, id, callOptions...)
+
+		return resp.GetMessages(), err
+	})
+
+	switch {
+	case serviceCmdFlags.output == "json":
+		return writeServiceJSON(messages, err)
+	case helpers.IsRecordOutput(serviceCmdFlags.output):
+		return writeServiceRecords(messages, err)
 	}
 
-	defaultNode := client.AddrFromPeer(&remotePeer)
+	if err != nil && len(messages) == 0 {
+		return fmt.Errorf("error updating service state: %w", err)
+	}
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 	fmt.Fprintln(w, "NODE\tRESPONSE")
 
-	for _, msg := range resp.Messages {
-		node := defaultNode
-
-		if msg.Metadata != nil {
-			node = msg.Metadata.Hostname
-		}
-
-		fmt.Fprintf(w, "%s\t%s\n", node, msg.Resp)
+	for _, msg := range messages {
+		fmt.Fprintf(w, "%s\t%s\n", msg.node, msg.message.GetResp())
 	}
 
-	return w.Flush()
+	return errors.Join(w.Flush(), err)
 }
 
 func init() {
+	serviceCmd.Flags().StringVarP(&serviceCmdFlags.output, "output", "o", "table", "output mode (table, json, jsonl, jsonpath, go-template)")
 	addCommand(serviceCmd)
 }
@@ -74,7 +74,7 @@ func shutdownGetActorID(ctx context.Context, c *client.Client) (string, error) {
 }
 
 func init() {
-	shutdownCmd.Flags().BoolVar(&shutdownCmdFlags.force, "force", false, "if true, force a node to shutdown without a cordon/drain")
+	shutdownCmd.Flags().BoolVar(&shutdownCmdFlags.force, "force", false, "if true, force a node to shutdown without a cordon/drain and the etcd quorum check")
 	shutdownCmdFlags.addTrackActionFlags(shutdownCmd)
 	addCommand(shutdownCmd)
 }
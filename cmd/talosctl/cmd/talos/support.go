@@ -5,16 +5,21 @@
 package talos
 
 import (
+	"archive/zip"
 	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/meta/spec"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/fatih/color"
 	"github.com/gosuri/uiprogress"
@@ -29,12 +34,14 @@ import (
 
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	clusterresource "github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	"github.com/siderolabs/talos/pkg/redact"
 )
 
 var supportCmdFlags struct {
 	output     string
 	numWorkers int
 	verbose    bool
+	redact     bool
 }
 
 // supportCmd represents the support command.
@@ -59,6 +66,9 @@ var supportCmd = &cobra.Command{
 - For the cluster:
 
 	- Kubernetes nodes and kube-system pods manifests.
+
+Secrets (private keys, tokens, passwords, etc.) are redacted from the collected data by default,
+so that the bundle can be attached to the public issues. Redaction can be disabled with --redact=false.
 `,
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -117,8 +127,21 @@ func collectData(dest *os.File, progress chan bundle.Progress) error {
 			fmt.Fprintf(os.Stderr, "Failed to create kubernetes client %s\n", err)
 		}
 
+		archiveOption := bundle.WithArchiveOutput(dest)
+		if supportCmdFlags.redact {
+			resourceSensitivity, err := getResourceSensitivity(ctx, c)
+			if err != nil {
+				return err
+			}
+
+			archiveOption = bundle.WithArchive(&redactedArchive{
+				zw:                  zip.NewWriter(dest),
+				resourceSensitivity: resourceSensitivity,
+			})
+		}
+
 		opts := []bundle.Option{
-			bundle.WithArchiveOutput(dest),
+			archiveOption,
 			bundle.WithKubernetesClient(clientset),
 			bundle.WithTalosClient(c),
 			bundle.WithNodes(GlobalArgs.Nodes...),
@@ -141,6 +164,67 @@ func collectData(dest *os.File, progress chan bundle.Progress) error {
 	})
 }
 
+// redactedArchive writes the support bundle files to the zip archive with the secrets redacted.
+//
+// The resource dumps are redacted according to the sensitivity of their resource definitions,
+// the text-based redaction is only used for the rest of the files (logs, etc.).
+type redactedArchive struct {
+	mu sync.Mutex
+	zw *zip.Writer
+
+	// resourceSensitivity maps the paths of the resource dumps in the archive to the sensitivity of the resource definition.
+	resourceSensitivity map[string]spec.Sensitivity
+}
+
+// Write implements bundle.Archive interface.
+func (a *redactedArchive) Write(path string, contents []byte) error {
+	if sensitivity, ok := a.resourceSensitivity[path]; ok {
+		var err error
+
+		if contents, err = redact.Resources(contents, sensitivity); err != nil {
+			return fmt.Errorf("error redacting %q: %w", path, err)
+		}
+	} else {
+		contents = redact.Bytes(contents)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	w, err := a.zw.Create(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(contents)
+
+	return err
+}
+
+// getResourceSensitivity returns the sensitivity of the resource definitions of each node,
+// keyed by the path of the resource dump in the support bundle (<node>/resources/<definition>.yaml).
+func getResourceSensitivity(ctx context.Context, c *client.Client) (map[string]spec.Sensitivity, error) {
+	sensitivity := map[string]spec.Sensitivity{}
+
+	for _, node := range GlobalArgs.Nodes {
+		rds, err := safe.StateListAll[*meta.ResourceDefinition](client.WithNode(ctx, node), c.COSI)
+		if err != nil {
+			return nil, fmt.Errorf("error listing resource definitions on %q: %w", node, err)
+		}
+
+		rds.ForEach(func(rd *meta.ResourceDefinition) {
+			sensitivity[filepath.Join(node, "resources", rd.Metadata().ID()+".yaml")] = rd.TypedSpec().Sensitivity
+		})
+	}
+
+	return sensitivity, nil
+}
+
+// Close implements bundle.Archive interface.
+func (a *redactedArchive) Close() error {
+	return a.zw.Close()
+}
+
 func getKubernetesClient(ctx context.Context, c *client.Client) (*k8s.Clientset, error) {
 	if len(GlobalArgs.Endpoints) == 0 {
 		fmt.Fprintln(os.Stderr, "No endpoints set for the cluster, the command might not be able to get kubeconfig")
@@ -332,4 +416,5 @@ func init() {
 	supportCmd.Flags().StringVarP(&supportCmdFlags.output, "output", "O", "", "output file to write support archive to")
 	supportCmd.Flags().IntVarP(&supportCmdFlags.numWorkers, "num-workers", "w", 1, "number of workers per node")
 	supportCmd.Flags().BoolVarP(&supportCmdFlags.verbose, "verbose", "v", false, "verbose output")
+	supportCmd.Flags().BoolVar(&supportCmdFlags.redact, "redact", true, "redact secrets (private keys, tokens, etc.) from the collected data")
 }
@@ -0,0 +1,341 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+	"github.com/ryanuber/columnize"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"google.golang.org/grpc/codes"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var topCmdFlags struct {
+	interval   time.Duration
+	sort       string
+	cgroups    bool
+	cgroupPath string
+	depth      uint32
+}
+
+// topCmd represents the top command.
+var topCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Show live CPU, memory and IO usage of the processes or cgroups",
+	Long: `The top command streams the process stats (or the cgroup stats with --cgroups) from the node
+and shows the CPU, memory and IO usage over the sampling interval.
+
+CPU usage is shown in percent of a single CPU, IO usage is shown in bytes read and written per second.
+Press 'c', 'm' or 'i' to sort by CPU, memory or IO usage, 'q' to quit.`,
+	Example: `  talosctl top -n 172.20.0.2
+  talosctl top -n 172.20.0.2 --cgroups --cgroup-path /system --depth 1`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains([]string{"cpu", "mem", "io"}, topCmdFlags.sort) {
+			return fmt.Errorf("invalid sort column %q, expected one of cpu, mem, io", topCmdFlags.sort)
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "top"); err != nil {
+				return err
+			}
+
+			if err := c.RequireFeature(ctx, client.FeatureStatsStreaming); err != nil {
+				return err
+			}
+
+			return topUI(ctx, c)
+		})
+	},
+}
+
+func init() {
+	topCmd.Flags().DurationVar(&topCmdFlags.interval, "interval", time.Second, "sampling interval")
+	topCmd.Flags().StringVarP(&topCmdFlags.sort, "sort", "s", "cpu", "column to sort output by [cpu|mem|io]")
+	topCmd.Flags().BoolVar(&topCmdFlags.cgroups, "cgroups", false, "show the cgroups usage instead of the processes")
+	topCmd.Flags().StringVar(&topCmdFlags.cgroupPath, "cgroup-path", "/", "path of the cgroup to show (with --cgroups)")
+	topCmd.Flags().Uint32Var(&topCmdFlags.depth, "depth", 2, "depth of the cgroup tree to show (with --cgroups)")
+	addCommand(topCmd)
+}
+
+// usageRow is the resource usage of a process or a cgroup over the sampling interval.
+type usageRow struct {
+	// id is the PID of the process or the cgroup path.
+	id string
+	// detail is the command of the process or the number of the cgroup processes.
+	detail string
+
+	// cpu is the CPU usage in percent of a single CPU.
+	cpu    float64
+	memory uint64
+	// ioRead and ioWrite are in bytes per second.
+	ioRead  float64
+	ioWrite float64
+}
+
+// rate returns the counter increase per second, the counter might be reset (e.g. PID reuse).
+func rate(current, previous, elapsed float64) float64 {
+	if elapsed <= 0 || current < previous {
+		return 0
+	}
+
+	return (current - previous) / elapsed
+}
+
+// processUsage computes the processes usage out of two consecutive samples, previous sample might be nil.
+func processUsage(previous, current *machineapi.ProcessesSample) []usageRow {
+	previousProcs := map[int32]*machineapi.ProcessInfo{}
+
+	var elapsed float64
+
+	if previous != nil {
+		elapsed = current.GetTimestamp().AsTime().Sub(previous.GetTimestamp().AsTime()).Seconds()
+
+		for _, proc := range previous.Processes {
+			previousProcs[proc.Pid] = proc
+		}
+	}
+
+	rows := make([]usageRow, 0, len(current.Processes))
+
+	for _, proc := range current.Processes {
+		command := proc.Args
+		if command == "" {
+			command = proc.Command
+		}
+
+		row := usageRow{
+			id:     strconv.Itoa(int(proc.Pid)),
+			detail: command,
+			memory: proc.ResidentMemory,
+		}
+
+		if prev, ok := previousProcs[proc.Pid]; ok {
+			row.cpu = rate(proc.CpuTime, prev.CpuTime, elapsed) * 100
+			row.ioRead = rate(float64(proc.IoReadBytes), float64(prev.IoReadBytes), elapsed)
+			row.ioWrite = rate(float64(proc.IoWriteBytes), float64(prev.IoWriteBytes), elapsed)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// cgroupUsage computes the cgroups usage out of two consecutive samples, previous sample might be nil.
+func cgroupUsage(previous, current *machineapi.CgroupStats) []usageRow {
+	previousCgroups := map[string]*machineapi.CgroupStat{}
+
+	var elapsed float64
+
+	if previous != nil {
+		elapsed = current.GetTimestamp().AsTime().Sub(previous.GetTimestamp().AsTime()).Seconds()
+
+		for _, cgroup := range previous.Cgroups {
+			previousCgroups[cgroup.Path] = cgroup
+		}
+	}
+
+	rows := make([]usageRow, 0, len(current.Cgroups))
+
+	for _, cgroup := range current.Cgroups {
+		row := usageRow{
+			id:     cgroup.Path,
+			detail: strconv.FormatUint(cgroup.PidsCurrent, 10),
+			memory: cgroup.MemoryCurrent,
+		}
+
+		if prev, ok := previousCgroups[cgroup.Path]; ok {
+			row.cpu = rate(float64(cgroup.CpuUsageUsec), float64(prev.CpuUsageUsec), elapsed) / 1e4 // usec/sec -> percent
+			row.ioRead = rate(float64(cgroup.IoReadBytes), float64(prev.IoReadBytes), elapsed)
+			row.ioWrite = rate(float64(cgroup.IoWriteBytes), float64(prev.IoWriteBytes), elapsed)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// renderUsage sorts the usage rows by the column and renders them as a table.
+func renderUsage(rows []usageRow, sortColumn string, cgroups bool) string {
+	rows = slices.Clone(rows)
+
+	slices.SortStableFunc(rows, func(a, b usageRow) int {
+		switch sortColumn {
+		case "mem":
+			return -cmp.Compare(a.memory, b.memory)
+		case "io":
+			return -cmp.Compare(a.ioRead+a.ioWrite, b.ioRead+b.ioWrite)
+		default:
+			return -cmp.Compare(a.cpu, b.cpu)
+		}
+	})
+
+	lines := make([]string, 0, len(rows)+1)
+
+	if cgroups {
+		lines = append(lines, "CGROUP | CPU% | MEMORY | READ/s | WRITE/s | PIDS")
+	} else {
+		lines = append(lines, "PID | CPU% | RSS | READ/s | WRITE/s | COMMAND")
+	}
+
+	for _, row := range rows {
+		// filter out non-printable characters
+		detail := strings.Map(func(r rune) rune {
+			if r < 32 || r > 126 {
+				return ' '
+			}
+
+			return r
+		}, row.detail)
+
+		lines = append(lines, fmt.Sprintf("%s | %.1f | %s | %s | %s | %s",
+			row.id,
+			row.cpu,
+			humanize.IBytes(row.memory),
+			humanize.IBytes(uint64(row.ioRead)),
+			humanize.IBytes(uint64(row.ioWrite)),
+			detail,
+		))
+	}
+
+	return columnize.SimpleFormat(lines)
+}
+
+// streamUsage receives the samples from the node and sends the computed usage to the channel.
+func streamUsage(ctx context.Context, c *client.Client, usageCh chan<- []usageRow) error {
+	send := func(rows []usageRow) error {
+		select {
+		case usageCh <- rows:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if topCmdFlags.cgroups {
+		stream, err := c.CgroupStats(ctx, topCmdFlags.cgroupPath, topCmdFlags.depth, topCmdFlags.interval)
+		if err != nil {
+			return err
+		}
+
+		var previous *machineapi.CgroupStats
+
+		for {
+			current, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+
+			if err = send(cgroupUsage(previous, current)); err != nil {
+				return err
+			}
+
+			previous = current
+		}
+	}
+
+	stream, err := c.ProcessesStream(ctx, topCmdFlags.interval)
+	if err != nil {
+		return err
+	}
+
+	var previous *machineapi.ProcessesSample
+
+	for {
+		current, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		if err = send(processUsage(previous, current)); err != nil {
+			return err
+		}
+
+		previous = current
+	}
+}
+
+func topUI(ctx context.Context, c *client.Client) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	usageCh := make(chan []usageRow)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- streamUsage(ctx, c, usageCh)
+	}()
+
+	if err := ui.Init(); err != nil {
+		return fmt.Errorf("failed to initialize termui: %w", err)
+	}
+
+	defer ui.Close()
+
+	l := widgets.NewParagraph()
+	l.Border = false
+	l.WrapText = false
+
+	var rows []usageRow
+
+	draw := func() {
+		w, h, err := term.GetSize(0)
+		cli.Should(err)
+
+		l.SetRect(0, 0, w, h)
+		l.Text = renderUsage(rows, topCmdFlags.sort, topCmdFlags.cgroups)
+
+		ui.Render(l)
+	}
+
+	uiEvents := ui.PollEvents()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errCh:
+			if err == nil || errors.Is(err, io.EOF) || errors.Is(err, context.Canceled) || client.StatusCode(err) == codes.Canceled {
+				return nil
+			}
+
+			return fmt.Errorf("error streaming stats: %w", err)
+		case e := <-uiEvents:
+			switch e.ID {
+			case "q", "<C-c>":
+				return nil
+			case "c":
+				topCmdFlags.sort = "cpu"
+			case "m", "r":
+				topCmdFlags.sort = "mem"
+			case "i":
+				topCmdFlags.sort = "io"
+			}
+
+			draw()
+		case rows = <-usageCh:
+			draw()
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos //nolint:testpackage // to test unexported function
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func TestProcessUsage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	previous := &machineapi.ProcessesSample{
+		Timestamp: timestamppb.New(now),
+		Processes: []*machineapi.ProcessInfo{
+			{Pid: 1, Command: "init", CpuTime: 10, ResidentMemory: 1024, IoReadBytes: 1000, IoWriteBytes: 2000},
+			{Pid: 2, Command: "gone", CpuTime: 5},
+		},
+	}
+
+	current := &machineapi.ProcessesSample{
+		Timestamp: timestamppb.New(now.Add(2 * time.Second)),
+		Processes: []*machineapi.ProcessInfo{
+			{Pid: 1, Command: "init", CpuTime: 11, ResidentMemory: 2048, IoReadBytes: 5000, IoWriteBytes: 2000},
+			{Pid: 3, Command: "new", Args: "new --flag", CpuTime: 1},
+		},
+	}
+
+	assert.Equal(t, []usageRow{
+		{id: "1", detail: "init", cpu: 50, memory: 2048, ioRead: 2000},
+		{id: "3", detail: "new --flag"},
+	}, processUsage(previous, current))
+
+	// the first sample has no usage rates
+	assert.Equal(t, []usageRow{
+		{id: "1", detail: "init", memory: 1024},
+		{id: "2", detail: "gone"},
+	}, processUsage(nil, previous))
+}
+
+func TestCgroupUsage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	previous := &machineapi.CgroupStats{
+		Timestamp: timestamppb.New(now),
+		Cgroups: []*machineapi.CgroupStat{
+			{Path: "/system", CpuUsageUsec: 1_000_000, MemoryCurrent: 4096, IoWriteBytes: 100, PidsCurrent: 3},
+		},
+	}
+
+	current := &machineapi.CgroupStats{
+		Timestamp: timestamppb.New(now.Add(time.Second)),
+		Cgroups: []*machineapi.CgroupStat{
+			{Path: "/system", CpuUsageUsec: 1_250_000, MemoryCurrent: 8192, IoWriteBytes: 1100, PidsCurrent: 4},
+		},
+	}
+
+	assert.Equal(t, []usageRow{
+		{id: "/system", detail: "4", cpu: 25, memory: 8192, ioWrite: 1000},
+	}, cgroupUsage(previous, current))
+}
+
+func TestRenderUsage(t *testing.T) {
+	t.Parallel()
+
+	rows := []usageRow{
+		{id: "1", detail: "init", cpu: 1, memory: 4096},
+		{id: "2", detail: "etcd", cpu: 50, memory: 1024, ioWrite: 2048},
+	}
+
+	lines := strings.Split(strings.TrimSpace(renderUsage(rows, "cpu", false)), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"PID", "CPU%", "RSS", "READ/s", "WRITE/s", "COMMAND"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"2", "50.0", "1.0", "KiB", "0", "B", "2.0", "KiB", "etcd"}, strings.Fields(lines[1]))
+
+	lines = strings.Split(strings.TrimSpace(renderUsage(rows, "mem", false)), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, "1", strings.Fields(lines[1])[0])
+}
@@ -14,6 +14,7 @@ import (
 	"text/tabwriter"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/siderolabs/gen/maps"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/spf13/cobra"
@@ -31,12 +32,15 @@ import (
 
 var upgradeCmdFlags struct {
 	trackableActionCmdFlags
-	upgradeImage string
-	rebootMode   string
-	preserve     bool
-	stage        bool
-	force        bool
-	insecure     bool
+	upgradeImage  string
+	rebootMode    string
+	pullRateLimit string
+	preserve      bool
+	stage         bool
+	force         bool
+	insecure      bool
+
+	gracefulPodShutdown bool
 }
 
 // upgradeCmd represents the processes command.
@@ -67,6 +71,16 @@ var upgradeCmd = &cobra.Command{
 			client.WithUpgradePreserve(upgradeCmdFlags.preserve),
 			client.WithUpgradeStage(upgradeCmdFlags.stage),
 			client.WithUpgradeForce(upgradeCmdFlags.force),
+			client.WithUpgradeGracefulPodShutdown(upgradeCmdFlags.gracefulPodShutdown),
+		}
+
+		if upgradeCmdFlags.pullRateLimit != "" {
+			pullRateLimit, err := humanize.ParseBytes(upgradeCmdFlags.pullRateLimit)
+			if err != nil {
+				return fmt.Errorf("invalid pull rate limit %q: %w", upgradeCmdFlags.pullRateLimit, err)
+			}
+
+			opts = append(opts, client.WithUpgradePullRateLimit(pullRateLimit))
 		}
 
 		if !upgradeCmdFlags.wait {
@@ -111,6 +125,8 @@ func runUpgradeNoWait(opts []client.UpgradeOption) error {
 
 		defaultNode := client.AddrFromPeer(&remotePeer)
 
+		var verifiedSignatures []string
+
 		for _, msg := range resp.Messages {
 			node := defaultNode
 
@@ -119,6 +135,27 @@ func runUpgradeNoWait(opts []client.UpgradeOption) error {
 			}
 
 			fmt.Fprintf(w, "%s\t%s\t%s\t\n", node, msg.Ack, time.Now())
+
+			for _, signature := range msg.VerifiedSignatures {
+				verifiedSignatures = append(verifiedSignatures, fmt.Sprintf("%s\t%s\t%s\n", node, signature.Path, signature.Signer))
+			}
+		}
+
+		if err = w.Flush(); err != nil {
+			return err
+		}
+
+		if len(verifiedSignatures) == 0 {
+			return nil
+		}
+
+		fmt.Println()
+
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "NODE\tVERIFIED ASSET\tSIGNER")
+
+		for _, line := range verifiedSignatures {
+			fmt.Fprint(w, line)
 		}
 
 		return w.Flush()
@@ -159,9 +196,13 @@ func init() {
 		fmt.Sprintf("select the reboot mode during upgrade. Mode %q bypasses kexec. Valid values are: %q.",
 			strings.ToLower(machine.UpgradeRequest_POWERCYCLE.String()),
 			rebootModes))
+	upgradeCmd.Flags().StringVar(&upgradeCmdFlags.pullRateLimit, "pull-rate-limit", "",
+		"limit the bandwidth used to pull the installer image, per second (e.g. 10MB), overrides the machine configuration")
 	upgradeCmd.Flags().BoolVarP(&upgradeCmdFlags.preserve, "preserve", "p", false, "preserve data")
 	upgradeCmd.Flags().BoolVarP(&upgradeCmdFlags.stage, "stage", "s", false, "stage the upgrade to perform it after a reboot")
 	upgradeCmd.Flags().BoolVarP(&upgradeCmdFlags.force, "force", "f", false, "force the upgrade (skip checks on etcd health and members, might lead to data loss)")
+	upgradeCmd.Flags().BoolVar(&upgradeCmdFlags.gracefulPodShutdown, "graceful-pod-shutdown", false,
+		"trigger kubelet graceful shutdown and wait for the pods to terminate before rebooting")
 	upgradeCmd.Flags().BoolVar(&upgradeCmdFlags.insecure, "insecure", false, "upgrade using the insecure (encrypted with no auth) maintenance service")
 	upgradeCmdFlags.addTrackActionFlags(upgradeCmd)
 
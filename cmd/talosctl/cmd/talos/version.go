@@ -7,6 +7,7 @@ package talos
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -14,6 +15,7 @@ import (
 	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/version"
@@ -25,6 +27,7 @@ var versionCmdFlags struct {
 	shortVersion bool
 	json         bool
 	insecure     bool
+	output       string
 }
 
 // versionCmd represents the `talosctl version` command.
@@ -34,7 +37,22 @@ var versionCmd = &cobra.Command{
 	Long:  ``,
 	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if !versionCmdFlags.json {
+		switch {
+		case versionCmdFlags.output == "text":
+		case helpers.IsRecordOutput(versionCmdFlags.output):
+			if versionCmdFlags.clientOnly {
+				out, err := helpers.NewRecordOutput(os.Stdout, versionCmdFlags.output)
+				if err != nil {
+					return err
+				}
+
+				return out.WriteResult("", version.NewVersion())
+			}
+		default:
+			return fmt.Errorf("unsupported output format: %q", versionCmdFlags.output)
+		}
+
+		if !versionCmdFlags.json && versionCmdFlags.output == "text" {
 			fmt.Println("Client:")
 			if versionCmdFlags.shortVersion {
 				version.PrintShortVersion()
@@ -62,6 +80,11 @@ func cmdVersion(ctx context.Context, c *client.Client) error {
 	var remotePeer peer.Peer
 
 	resp, err := c.Version(ctx, grpc.Peer(&remotePeer))
+
+	if helpers.IsRecordOutput(versionCmdFlags.output) {
+		return writeMessagesRecords(versionCmdFlags.output, &remotePeer, resp.GetMessages(), err)
+	}
+
 	if err != nil {
 		if resp == nil {
 			return fmt.Errorf("error getting version: %s", err)
@@ -109,6 +132,7 @@ func init() {
 	versionCmd.Flags().BoolVar(&versionCmdFlags.shortVersion, "short", false, "Print the short version")
 	versionCmd.Flags().BoolVar(&versionCmdFlags.clientOnly, "client", false, "Print client version only")
 	versionCmd.Flags().BoolVarP(&versionCmdFlags.insecure, "insecure", "i", false, "use Talos maintenance mode API")
+	versionCmd.Flags().StringVarP(&versionCmdFlags.output, "output", "o", "text", "output mode (text, jsonl, jsonpath, go-template)")
 
 	// TODO remove when https://github.com/siderolabs/talos/issues/907 is implemented
 	versionCmd.Flags().BoolVar(&versionCmdFlags.json, "json", false, "")
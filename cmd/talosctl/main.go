@@ -6,6 +6,7 @@
 package main
 
 import (
+	"errors"
 	"os"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd"
@@ -13,6 +14,12 @@ import (
 
 func main() {
 	if err := cmd.Execute(); err != nil {
+		var exitCodeErr interface{ ExitCode() int }
+
+		if errors.As(err, &exitCodeErr) {
+			os.Exit(exitCodeErr.ExitCode())
+		}
+
 		os.Exit(1)
 	}
 }
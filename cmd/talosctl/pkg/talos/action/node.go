@@ -301,6 +301,12 @@ func (a *nodeTracker) handleEvent(event client.Event) error {
 			Message: fmt.Sprintf("service: %v message: %v healthy: %v", msg.GetService(), msg.GetMessage(), msg.GetHealth().GetHealthy()),
 			Status:  reporter.StatusRunning,
 		})
+
+	case *machineapi.PodShutdownEvent:
+		a.update(reporter.Update{
+			Message: fmt.Sprintf("waiting for pods to shut down: %d running", msg.GetRunningPods()),
+			Status:  reporter.StatusRunning,
+		})
 	}
 
 	return nil
@@ -26,6 +26,7 @@ type Args struct {
 	Cluster     string
 	Nodes       []string
 	Endpoints   []string
+	Parallel    int
 }
 
 // NodeList returns the list of nodes to run the command against.
@@ -7,6 +7,7 @@ package helpers
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 var okays = []string{"y", "yes"}
@@ -28,3 +29,21 @@ func Confirm(prompt string) bool {
 
 	return false
 }
+
+// ConfirmWithTimeout is like Confirm, but returns false if the user doesn't answer within the timeout.
+func ConfirmWithTimeout(prompt string, timeout time.Duration) bool {
+	answerCh := make(chan bool, 1)
+
+	go func() {
+		answerCh <- Confirm(prompt)
+	}()
+
+	select {
+	case answer := <-answerCh:
+		return answer
+	case <-time.After(timeout):
+		fmt.Println()
+
+		return false
+	}
+}
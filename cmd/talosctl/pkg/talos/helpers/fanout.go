@@ -0,0 +1,185 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+	"github.com/gertd/go-pluralize"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// Exit codes of the commands which run against multiple nodes.
+const (
+	// ExitCodeFailure is returned when the command failed on all nodes.
+	ExitCodeFailure = 1
+	// ExitCodePartialFailure is returned when the command failed on some of the nodes.
+	ExitCodePartialFailure = 2
+)
+
+// NodesError aggregates errors of the command run against multiple nodes.
+type NodesError struct {
+	// Errors is a map of node to the error returned by the node.
+	Errors map[string]error
+	// Total is the number of nodes the command was run against.
+	Total int
+}
+
+// Error implements error interface.
+func (e *NodesError) Error() string {
+	nodes := make([]string, 0, len(e.Errors))
+
+	for node := range e.Errors {
+		nodes = append(nodes, node)
+	}
+
+	slices.Sort(nodes)
+
+	lines := make([]string, 0, len(nodes))
+
+	for _, node := range nodes {
+		if node == "" {
+			lines = append(lines, fmt.Sprintf(" %s", e.Errors[node]))
+
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf(" %s: %s", node, e.Errors[node]))
+	}
+
+	count := pluralize.NewClient().Pluralize("node", len(nodes), true)
+
+	return color.RedString(fmt.Sprintf("command failed on %s of %d:\n%s", count, e.Total, strings.Join(lines, "\n")))
+}
+
+// Unwrap implements errors.Unwrap interface.
+func (e *NodesError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// ExitCode returns the exit code of the command.
+func (e *NodesError) ExitCode() int {
+	if len(e.Errors) < e.Total {
+		return ExitCodePartialFailure
+	}
+
+	return ExitCodeFailure
+}
+
+// Nodes returns the list of nodes set on the request context.
+//
+// If no nodes are set, a single empty node is returned which stands for the "current" node.
+func Nodes(ctx context.Context) []string {
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	nodes := md.Get("nodes")
+	if len(nodes) == 0 {
+		nodes = []string{""}
+	}
+
+	return nodes
+}
+
+// ForEachNode runs the function for each node set on the request context with at most concurrency calls in flight.
+//
+// If concurrency is not positive, all nodes are called at once.
+// Each call gets a context targeting a single node; for the "current" node (no nodes set) the original context is passed.
+// Errors are aggregated per node into *NodesError.
+func ForEachNode(ctx context.Context, concurrency int, fn func(ctx context.Context, node string) error) error {
+	nodes := Nodes(ctx)
+
+	var (
+		mu       sync.Mutex
+		nodeErrs = map[string]error{}
+	)
+
+	var eg errgroup.Group
+
+	if concurrency > 0 {
+		eg.SetLimit(concurrency)
+	}
+
+	for _, node := range nodes {
+		eg.Go(func() error {
+			nodeCtx := ctx
+
+			if node != "" {
+				nodeCtx = client.WithNodes(ctx, node)
+			}
+
+			if err := fn(nodeCtx, node); err != nil {
+				mu.Lock()
+				nodeErrs[node] = err
+				mu.Unlock()
+			}
+
+			return nil
+		})
+	}
+
+	eg.Wait() //nolint:errcheck
+
+	if len(nodeErrs) == 0 {
+		return nil
+	}
+
+	return &NodesError{
+		Errors: nodeErrs,
+		Total:  len(nodes),
+	}
+}
+
+// NodeResult is the result of the command run against a single node in the aggregated JSON output.
+type NodeResult struct {
+	Node   string          `json:"node"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// WriteNodeResultsJSON writes the results of the command run against multiple nodes as a single JSON document.
+//
+// Per-node errors from *NodesError are included into the output, the results are sorted by node.
+func WriteNodeResultsJSON(w io.Writer, results []NodeResult, err error) error {
+	if results == nil {
+		results = []NodeResult{}
+	}
+
+	var nodesErr *NodesError
+
+	if errors.As(err, &nodesErr) {
+		for node, nodeErr := range nodesErr.Errors {
+			results = append(results, NodeResult{
+				Node:  node,
+				Error: nodeErr.Error(),
+			})
+		}
+	}
+
+	slices.SortStableFunc(results, func(a, b NodeResult) int {
+		return strings.Compare(a.Node, b.Node)
+	})
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(results)
+}
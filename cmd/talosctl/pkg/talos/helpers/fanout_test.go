@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func TestForEachNode(t *testing.T) {
+	t.Parallel()
+
+	ctx := client.WithNodes(context.Background(), "a", "b", "c", "d")
+
+	var inFlight, maxInFlight atomic.Int32
+
+	err := helpers.ForEachNode(ctx, 2, func(ctx context.Context, node string) error {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			prev := maxInFlight.Load()
+			if current <= prev || maxInFlight.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+
+		assert.Equal(t, []string{node}, helpers.Nodes(ctx))
+
+		time.Sleep(10 * time.Millisecond)
+
+		if node == "b" {
+			return errors.New("failed")
+		}
+
+		return nil
+	})
+
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+
+	var nodesErr *helpers.NodesError
+
+	require.ErrorAs(t, err, &nodesErr)
+	assert.Equal(t, 4, nodesErr.Total)
+	assert.Len(t, nodesErr.Errors, 1)
+	assert.EqualError(t, nodesErr.Errors["b"], "failed")
+	assert.Equal(t, helpers.ExitCodePartialFailure, nodesErr.ExitCode())
+}
+
+func TestForEachNodeAllFailed(t *testing.T) {
+	t.Parallel()
+
+	ctx := client.WithNodes(context.Background(), "a", "b")
+
+	err := helpers.ForEachNode(ctx, 0, func(ctx context.Context, node string) error {
+		return errors.New("failed")
+	})
+
+	var nodesErr *helpers.NodesError
+
+	require.ErrorAs(t, err, &nodesErr)
+	assert.Equal(t, helpers.ExitCodeFailure, nodesErr.ExitCode())
+}
+
+func TestForEachNodeCurrent(t *testing.T) {
+	t.Parallel()
+
+	var called []string
+
+	assert.NoError(t, helpers.ForEachNode(context.Background(), 1, func(ctx context.Context, node string) error {
+		called = append(called, node)
+
+		return nil
+	}))
+
+	assert.Equal(t, []string{""}, called)
+}
+
+func TestWriteNodeResultsJSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	err := &helpers.NodesError{
+		Errors: map[string]error{"a": errors.New("failed")},
+		Total:  2,
+	}
+
+	require.NoError(t, helpers.WriteNodeResultsJSON(&buf, []helpers.NodeResult{{Node: "b", Result: []byte(`{"foo":"bar"}`)}}, err))
+
+	assert.JSONEq(t, `[{"node":"a","error":"failed"},{"node":"b","result":{"foo":"bar"}}]`, buf.String())
+}
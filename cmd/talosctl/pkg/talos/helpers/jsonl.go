@@ -0,0 +1,193 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/outputtemplate"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// OutputJSONLines is the name of the machine-readable output mode shared by the commands.
+const OutputJSONLines = "jsonl"
+
+// IsRecordOutput returns true if the output format is rendered record by record:
+// JSON lines, JSONPath expression (`jsonpath=`) or Go template (`go-template=`).
+func IsRecordOutput(format string) bool {
+	return format == OutputJSONLines || outputtemplate.IsTemplate(format)
+}
+
+// NewRecordOutput creates the renderer for the output format accepted by IsRecordOutput.
+func NewRecordOutput(w io.Writer, format string) (*JSONLines, error) {
+	if format == OutputJSONLines {
+		return NewJSONLines(w), nil
+	}
+
+	template, err := outputtemplate.Parse(format)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTemplateLines(w, template), nil
+}
+
+// JSONLines renders the machine-readable output of the commands as a JSON object per line.
+//
+// Each line is a NodeResult record which carries the node and either the result or the error,
+// so that the output of the commands run against multiple nodes can be processed without scraping the tables.
+// JSONLines is safe for concurrent use.
+type JSONLines struct {
+	mu     sync.Mutex
+	encode func(record NodeResult) error
+}
+
+// NewJSONLines creates a new JSON lines renderer.
+func NewJSONLines(w io.Writer) *JSONLines {
+	enc := json.NewEncoder(w)
+
+	return &JSONLines{
+		encode: func(record NodeResult) error {
+			return enc.Encode(record)
+		},
+	}
+}
+
+// NewTemplateLines creates a renderer which renders each NodeResult record with the output template instead of JSON.
+//
+// The template is executed against the same object as the JSON lines output line, e.g. `{.result.version.tag}`.
+func NewTemplateLines(w io.Writer, template outputtemplate.Template) *JSONLines {
+	return &JSONLines{
+		encode: func(record NodeResult) error {
+			data, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+
+			var obj map[string]any
+
+			if err = json.Unmarshal(data, &obj); err != nil {
+				return err
+			}
+
+			return template.Execute(w, obj)
+		},
+	}
+}
+
+// WriteResult writes a record with the result for the node.
+//
+// Protobuf messages are encoded with protojson, other values are encoded with encoding/json.
+func (j *JSONLines) WriteResult(node string, result any) error {
+	var (
+		data []byte
+		err  error
+	)
+
+	if msg, ok := result.(proto.Message); ok {
+		data, err = protojson.Marshal(msg)
+	} else {
+		data, err = json.Marshal(result)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return j.write(NodeResult{
+		Node:   node,
+		Result: data,
+	})
+}
+
+// WriteError writes the error records.
+//
+// Per-node errors (*NodesError or *client.NodeError returned by the client) are written as a record per node sorted by node,
+// other errors are written as a single record without the node.
+func (j *JSONLines) WriteError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var records []NodeResult
+
+	var nodesErr *NodesError
+
+	if errors.As(err, &nodesErr) {
+		for node, nodeErr := range nodesErr.Errors {
+			records = append(records, NodeResult{Node: node, Error: nodeErr.Error()})
+		}
+	} else {
+		for _, nodeErr := range client.NodeErrors(err) {
+			records = append(records, NodeResult{Node: nodeErr.Node, Error: nodeErr.Err.Error()})
+		}
+	}
+
+	if len(records) == 0 {
+		return j.write(NodeResult{Error: err.Error()})
+	}
+
+	slices.SortStableFunc(records, func(a, b NodeResult) int {
+		return strings.Compare(a.Node, b.Node)
+	})
+
+	for _, record := range records {
+		if writeErr := j.write(record); writeErr != nil {
+			return writeErr
+		}
+	}
+
+	return nil
+}
+
+func (j *JSONLines) write(record NodeResult) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.encode(record)
+}
+
+// WriteMessages writes the records for the messages of the API response.
+//
+// The node is taken from the message metadata, defaultNode is used if the metadata is not set.
+// Messages which carry an error in the metadata are written as error records.
+func WriteMessages[M interface {
+	proto.Message
+	GetMetadata() *common.Metadata
+}](j *JSONLines, defaultNode string, messages []M) error {
+	for _, msg := range messages {
+		node := defaultNode
+
+		if hostname := msg.GetMetadata().GetHostname(); hostname != "" {
+			node = hostname
+		}
+
+		var err error
+
+		if msgErr := msg.GetMetadata().GetError(); msgErr != "" {
+			err = j.write(NodeResult{
+				Node:  node,
+				Error: msgErr,
+			})
+		} else {
+			err = j.WriteResult(node, msg)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func TestJSONLines(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	out := helpers.NewJSONLines(&buf)
+
+	require.NoError(t, helpers.WriteMessages(out, "10.5.0.2", []*machine.Version{
+		{
+			Version: &machine.VersionInfo{Tag: "v1.9.0"},
+		},
+		{
+			Metadata: &common.Metadata{Hostname: "10.5.0.3"},
+			Version:  &machine.VersionInfo{Tag: "v1.9.1"},
+		},
+		{
+			Metadata: &common.Metadata{Hostname: "10.5.0.4", Error: "unavailable"},
+		},
+	}))
+
+	require.NoError(t, out.WriteResult("10.5.0.5", map[string]string{"foo": "bar"}))
+
+	require.NoError(t, out.WriteError(&helpers.NodesError{
+		Errors: map[string]error{
+			"10.5.0.7": errors.New("failed"),
+			"10.5.0.6": errors.New("timeout"),
+		},
+		Total: 2,
+	}))
+
+	require.NoError(t, out.WriteError(multierror.Append(nil,
+		&client.NodeError{Node: "10.5.0.9", Err: errors.New("denied")},
+		&client.NodeError{Node: "10.5.0.8", Err: errors.New("unreachable")},
+	)))
+
+	require.NoError(t, out.WriteError(errors.New("generic")))
+
+	assert.Equal(t, strings.Join([]string{
+		`{"node":"10.5.0.2","result":{"version":{"tag":"v1.9.0"}}}`,
+		`{"node":"10.5.0.3","result":{"metadata":{"hostname":"10.5.0.3"},"version":{"tag":"v1.9.1"}}}`,
+		`{"node":"10.5.0.4","error":"unavailable"}`,
+		`{"node":"10.5.0.5","result":{"foo":"bar"}}`,
+		`{"node":"10.5.0.6","error":"timeout"}`,
+		`{"node":"10.5.0.7","error":"failed"}`,
+		`{"node":"10.5.0.8","error":"unreachable"}`,
+		`{"node":"10.5.0.9","error":"denied"}`,
+		`{"node":"","error":"generic"}`,
+	}, "\n")+"\n", buf.String())
+}
+
+func TestTemplateLines(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	out, err := helpers.NewRecordOutput(&buf, "jsonpath={.result.version.tag}")
+	require.NoError(t, err)
+
+	require.NoError(t, helpers.WriteMessages(out, "10.5.0.2", []*machine.Version{
+		{
+			Version: &machine.VersionInfo{Tag: "v1.9.0"},
+		},
+	}))
+
+	out, err = helpers.NewRecordOutput(&buf, `go-template={{ .node }}: {{ with .error }}{{ . }}{{ else }}{{ .result.foo }}{{ end }}`)
+	require.NoError(t, err)
+
+	require.NoError(t, out.WriteResult("10.5.0.5", map[string]string{"foo": "bar"}))
+	require.NoError(t, out.WriteError(errors.New("unavailable")))
+
+	assert.Equal(t, strings.Join([]string{
+		`v1.9.0`,
+		`10.5.0.5: bar`,
+		`: unavailable`,
+	}, "\n")+"\n", buf.String())
+
+	_, err = helpers.NewRecordOutput(&buf, "jsonpath={.node")
+	require.Error(t, err)
+}
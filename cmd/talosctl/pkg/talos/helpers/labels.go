@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+)
+
+// ParseLabelSelector parses a kubectl-like label selector into the label query.
+//
+// The selector is a comma-separated list of the terms (combined with AND):
+// `key` (label exists), `!key` (label doesn't exist), `key=value` (or `key==value`) and `key!=value`.
+func ParseLabelSelector(selector string) ([]resource.LabelQueryOption, error) {
+	var query []resource.LabelQueryOption
+
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+
+		if term == "" {
+			continue
+		}
+
+		var (
+			key, value string
+			opt        resource.LabelQueryOption
+		)
+
+		switch {
+		case strings.Contains(term, "!="):
+			key, value, _ = strings.Cut(term, "!=")
+			opt = resource.LabelEqual(strings.TrimSpace(key), strings.TrimSpace(value), resource.NotMatches)
+		case strings.Contains(term, "=="):
+			key, value, _ = strings.Cut(term, "==")
+			opt = resource.LabelEqual(strings.TrimSpace(key), strings.TrimSpace(value))
+		case strings.Contains(term, "="):
+			key, value, _ = strings.Cut(term, "=")
+			opt = resource.LabelEqual(strings.TrimSpace(key), strings.TrimSpace(value))
+		case strings.HasPrefix(term, "!"):
+			key = strings.TrimPrefix(term, "!")
+			opt = resource.LabelExists(strings.TrimSpace(key), resource.NotMatches)
+		default:
+			key = term
+			opt = resource.LabelExists(key)
+		}
+
+		if strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid label selector term %q: label key is empty", term)
+		}
+
+		query = append(query, opt)
+	}
+
+	return query, nil
+}
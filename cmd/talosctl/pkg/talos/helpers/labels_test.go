@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	labels := resource.Labels{}
+	labels.Set("talos.dev/role", "control-plane")
+	labels.Set("talos.dev/managed", "")
+
+	for _, test := range []struct {
+		name     string
+		selector string
+
+		expectedMatch bool
+		expectedError string
+	}{
+		{
+			name:          "empty",
+			selector:      "",
+			expectedMatch: true,
+		},
+		{
+			name:          "exists",
+			selector:      "talos.dev/managed",
+			expectedMatch: true,
+		},
+		{
+			name:          "not exists",
+			selector:      "!talos.dev/managed",
+			expectedMatch: false,
+		},
+		{
+			name:          "equal",
+			selector:      "talos.dev/role=control-plane",
+			expectedMatch: true,
+		},
+		{
+			name:          "double equal",
+			selector:      "talos.dev/role==worker",
+			expectedMatch: false,
+		},
+		{
+			name:          "not equal",
+			selector:      "talos.dev/role!=worker",
+			expectedMatch: true,
+		},
+		{
+			name:          "multiple terms",
+			selector:      "talos.dev/role=control-plane, !talos.dev/foo",
+			expectedMatch: true,
+		},
+		{
+			name:          "empty key",
+			selector:      "=foo",
+			expectedError: `invalid label selector term "=foo": label key is empty`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := helpers.ParseLabelSelector(test.selector)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			var labelQuery resource.LabelQuery
+
+			for _, opt := range query {
+				opt(&labelQuery)
+			}
+
+			assert.Equal(t, test.expectedMatch, labelQuery.Matches(labels))
+		})
+	}
+}
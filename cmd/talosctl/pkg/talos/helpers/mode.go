@@ -105,5 +105,35 @@ func PrintApplyResults(resp *machine.ApplyConfigurationResponse) {
 		if m.ModeDetails != "" {
 			fmt.Fprintln(os.Stderr, m.ModeDetails)
 		}
+
+		printChanges(m)
+	}
+}
+
+// printChanges prints the structured summary of the config changes returned in dry-run mode.
+func printChanges(m *machine.ApplyConfiguration) {
+	if len(m.GetAddedPaths())+len(m.GetRemovedPaths())+len(m.GetChangedPaths()) == 0 {
+		return
+	}
+
+	fmt.Fprintln(os.Stderr, "Changed fields:")
+
+	for _, change := range []struct {
+		sign  string
+		paths []string
+	}{
+		{"+", m.GetAddedPaths()},
+		{"-", m.GetRemovedPaths()},
+		{"~", m.GetChangedPaths()},
+	} {
+		for _, path := range change.paths {
+			fmt.Fprintf(os.Stderr, "  %s %s\n", change.sign, path)
+		}
+	}
+
+	if m.GetRebootRequired() {
+		fmt.Fprintln(os.Stderr, "Applying the changes requires a reboot.")
+	} else {
+		fmt.Fprintln(os.Stderr, "The changes can be applied without a reboot.")
 	}
 }
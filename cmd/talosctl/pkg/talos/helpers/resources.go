@@ -11,20 +11,35 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/state"
-	"google.golang.org/grpc/metadata"
 
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
 
 // ForEachResource gets resources from the controller runtime and runs a callback for each resource.
-//
-//nolint:gocyclo
 func ForEachResource(ctx context.Context,
 	c *client.Client,
 	callbackRD func(rd *meta.ResourceDefinition) error,
 	callback func(ctx context.Context, hostname string, r resource.Resource, callError error) error,
 	namespace string,
 	args ...string,
+) error {
+	return ForEachResourceParallel(ctx, c, 1, nil, callbackRD, callback, namespace, args...)
+}
+
+// ForEachResourceParallel is like ForEachResource, but fetches resources from at most concurrency nodes at once.
+//
+// If concurrency is not positive, all nodes are queried at once.
+// If the label query is not empty, only the matching resources are returned.
+// The callback is always invoked sequentially: first for the failed nodes, then for the resources sorted by node and ID
+// (or by node and the requested field, if the context requests sorting on the server, see client.WithListSort).
+func ForEachResourceParallel(ctx context.Context,
+	c *client.Client,
+	concurrency int,
+	labelQuery []resource.LabelQueryOption,
+	callbackRD func(rd *meta.ResourceDefinition) error,
+	callback func(ctx context.Context, hostname string, r resource.Resource, callError error) error,
+	namespace string,
+	args ...string,
 ) error {
 	if len(args) == 0 {
 		return errors.New("not enough arguments: at least 1 is expected")
@@ -38,12 +53,7 @@ func ForEachResource(ctx context.Context,
 		resourceID = args[1]
 	}
 
-	md, _ := metadata.FromOutgoingContext(ctx)
-	nodes := md.Get("nodes")
-
-	if len(nodes) == 0 {
-		nodes = []string{""}
-	}
+	nodes := Nodes(ctx)
 
 	// fetch the RD from the first node (it doesn't matter which one to use, so we'll use the first one)
 	rd, err := c.ResolveResourceKind(client.WithNode(ctx, nodes[0]), &namespace, resourceType)
@@ -59,44 +69,24 @@ func ForEachResource(ctx context.Context,
 
 	resourceType = rd.TypedSpec().Type
 
-	for _, node := range nodes {
-		var nodeCtx context.Context
-
-		if node == "" {
-			nodeCtx = ctx
-		} else {
-			nodeCtx = client.WithNode(ctx, node)
+	resources, listErr := c.ListNodeResources(
+		ctx,
+		nodes,
+		resource.NewMetadata(namespace, resourceType, resourceID, resource.VersionUndefined),
+		concurrency,
+		labelQuery,
+		state.WithSkipProtobufUnmarshal(),
+	)
+
+	for _, nodeErr := range client.NodeErrors(listErr) {
+		if err = callback(ctx, nodeErr.Node, nil, nodeErr.Err); err != nil {
+			return err
 		}
+	}
 
-		if resourceID != "" {
-			r, callErr := c.COSI.Get(
-				nodeCtx,
-				resource.NewMetadata(namespace, rd.TypedSpec().Type, resourceID, resource.VersionUndefined),
-				state.WithGetUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
-			)
-
-			if err = callback(ctx, node, r, callErr); err != nil {
-				return err
-			}
-		} else {
-			items, callErr := c.COSI.List(
-				nodeCtx,
-				resource.NewMetadata(namespace, resourceType, "", resource.VersionUndefined),
-				state.WithListUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
-			)
-			if callErr != nil {
-				if err = callback(ctx, node, nil, callErr); err != nil {
-					return err
-				}
-
-				continue
-			}
-
-			for _, r := range items.Items {
-				if err = callback(ctx, node, r, nil); err != nil {
-					return err
-				}
-			}
+	for _, r := range resources {
+		if err = callback(ctx, r.Node, r.Resource, nil); err != nil {
+			return err
 		}
 	}
 
@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package outputtemplate implements the JSONPath and Go template output formats shared by the commands.
+package outputtemplate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+
+	k8stemplate "k8s.io/client-go/third_party/forked/golang/template"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Output format prefixes.
+const (
+	JSONPathPrefix   = "jsonpath="
+	GoTemplatePrefix = "go-template="
+)
+
+// Template renders the data with the JSONPath expression or the Go template.
+type Template interface {
+	Execute(w io.Writer, data any) error
+}
+
+// IsTemplate returns true if the output format is a template one.
+func IsTemplate(format string) bool {
+	return strings.HasPrefix(format, JSONPathPrefix) || strings.HasPrefix(format, GoTemplatePrefix)
+}
+
+// Parse parses the template from the output format: `jsonpath=<expression>` or `go-template=<template>`.
+func Parse(format string) (Template, error) {
+	switch {
+	case strings.HasPrefix(format, JSONPathPrefix):
+		jp := jsonpath.New("talos")
+
+		if err := jp.Parse(format[len(JSONPathPrefix):]); err != nil {
+			return nil, fmt.Errorf("error parsing jsonpath: %w", err)
+		}
+
+		return NewJSONPath(jp), nil
+	case strings.HasPrefix(format, GoTemplatePrefix):
+		tmpl, err := template.New("talos").Funcs(funcs).Parse(format[len(GoTemplatePrefix):])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing go-template: %w", err)
+		}
+
+		return &goTemplate{tmpl: tmpl}, nil
+	default:
+		return nil, fmt.Errorf("output format %q is not a template", format)
+	}
+}
+
+// JSONPath renders the data with the JSONPath expression.
+//
+// Each result is printed on a separate line: maps, arrays, slices and structs are printed as JSON,
+// scalar values are printed as plain text.
+type JSONPath struct {
+	jsonPath *jsonpath.JSONPath
+}
+
+// NewJSONPath initializes JSONPath template.
+func NewJSONPath(jsonPath *jsonpath.JSONPath) *JSONPath {
+	return &JSONPath{
+		jsonPath: jsonPath,
+	}
+}
+
+// Execute implements Template interface.
+func (j *JSONPath) Execute(w io.Writer, data any) error {
+	results, err := j.jsonPath.FindResults(data)
+	if err != nil {
+		return fmt.Errorf("error finding result for jsonpath: %w", err)
+	}
+
+	for _, resultGroup := range results {
+		for _, result := range resultGroup {
+			if err = printResult(w, result); err != nil {
+				return fmt.Errorf("error generating jsonpath results: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// printResult prints a reflect.Value as JSON if it's a map, array, slice or struct.
+// But if it's just a 'scalar' type it prints it as a mere string.
+func printResult(wr io.Writer, result reflect.Value) error {
+	kind := result.Kind()
+	if kind == reflect.Interface {
+		kind = result.Elem().Kind()
+	}
+
+	outputJSON := kind == reflect.Map ||
+		kind == reflect.Array ||
+		kind == reflect.Slice ||
+		kind == reflect.Struct
+
+	var text []byte
+
+	var err error
+
+	if outputJSON {
+		text, err = json.MarshalIndent(result.Interface(), "", "    ")
+		if err != nil {
+			return err
+		}
+	} else {
+		text, err = valueToText(result)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	text = append(text, '\n')
+
+	if _, err = wr.Write(text); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// valueToText translates reflect value to corresponding text.
+func valueToText(v reflect.Value) ([]byte, error) {
+	iface, ok := k8stemplate.PrintableValue(v)
+	if !ok {
+		return nil, fmt.Errorf("can't translate type %s to text", v.Type())
+	}
+
+	var buffer bytes.Buffer
+
+	fmt.Fprint(&buffer, iface)
+
+	return buffer.Bytes(), nil
+}
+
+// funcs are the extra functions available in the Go templates.
+var funcs = template.FuncMap{
+	"join": strings.Join,
+	"json": func(v any) (string, error) {
+		out, err := json.Marshal(v)
+
+		return string(out), err
+	},
+}
+
+type goTemplate struct {
+	tmpl *template.Template
+}
+
+// Execute implements Template interface.
+//
+// The output is terminated with a newline, so that the outputs for the multiple objects are printed on separate lines.
+func (t *goTemplate) Execute(w io.Writer, data any) error {
+	var buf bytes.Buffer
+
+	if err := t.tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("error executing go-template: %w", err)
+	}
+
+	if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
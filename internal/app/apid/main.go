@@ -7,6 +7,7 @@ package apid
 
 import (
 	"context"
+	stdtls "crypto/tls"
 	"crypto/x509"
 	"errors"
 	"flag"
@@ -15,6 +16,7 @@ import (
 	"os/signal"
 	"regexp"
 	"slices"
+	"strings"
 	"syscall"
 	"time"
 
@@ -30,9 +32,12 @@ import (
 
 	apidbackend "github.com/siderolabs/talos/internal/app/apid/pkg/backend"
 	"github.com/siderolabs/talos/internal/app/apid/pkg/director"
+	"github.com/siderolabs/talos/internal/app/apid/pkg/oidc"
 	"github.com/siderolabs/talos/internal/app/apid/pkg/provider"
 	"github.com/siderolabs/talos/pkg/grpc/factory"
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/clockskew"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/deadline"
 	"github.com/siderolabs/talos/pkg/grpc/proxy/backend"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/startup"
@@ -66,6 +71,30 @@ func apidMain() error {
 
 	rbacEnabled := flag.Bool("enable-rbac", false, "enable RBAC for Talos API")
 	extKeyUsageCheckEnabled := flag.Bool("enable-ext-key-usage-check", false, "enable check for client certificate ext key usage")
+	unaryDefaultDeadline := flag.Duration("unary-default-deadline", 0, "deadline applied to unary calls without a deadline (0 means no deadline)")
+	unaryMaxDeadline := flag.Duration("unary-max-deadline", 0, "maximum deadline of unary calls (0 means no maximum)")
+	streamingDefaultDeadline := flag.Duration("streaming-default-deadline", 0, "deadline applied to streaming calls without a deadline (0 means no deadline)")
+	streamingMaxDeadline := flag.Duration("streaming-max-deadline", 0, "maximum deadline of streaming calls (0 means no maximum)")
+	clockSkewTolerance := flag.Duration("clock-skew-tolerance", 0, "allowed difference between the server time and the client certificate validity bounds")
+	oidcIssuerURL := flag.String("oidc-issuer-url", "", "OIDC issuer URL, enables authentication of the clients without a client certificate with bearer tokens")
+	oidcAudience := flag.String("oidc-audience", "", "audience of the OIDC tokens")
+	oidcGroupsClaim := flag.String("oidc-groups-claim", "groups", "name of the OIDC token claim which lists the groups")
+
+	oidcGroupRoles := map[string][]string{}
+
+	flag.Func("oidc-group-roles", "roles granted to the OIDC group in the form group=role1,role2 (can be repeated)", func(value string) error {
+		// group names might contain '=' (e.g. LDAP DNs), while roles never do
+		idx := strings.LastIndex(value, "=")
+		if idx <= 0 || idx == len(value)-1 {
+			return fmt.Errorf("invalid OIDC group roles %q", value)
+		}
+
+		group, roles := value[:idx], value[idx+1:]
+
+		oidcGroupRoles[group] = append(oidcGroupRoles[group], strings.Split(roles, ",")...)
+
+		return nil
+	})
 
 	flag.Parse()
 
@@ -91,8 +120,46 @@ func apidMain() error {
 		return fmt.Errorf("failed to create OS-level TLS configuration: %w", err)
 	}
 
-	if *extKeyUsageCheckEnabled {
-		serverTLSConfig.VerifyPeerCertificate = verifyExtKeyUsage
+	var tokenVerifier authz.TokenVerifier
+
+	if *oidcIssuerURL != "" {
+		tokenVerifier = oidc.NewVerifier(oidc.Config{
+			IssuerURL:   *oidcIssuerURL,
+			Audience:    *oidcAudience,
+			GroupsClaim: *oidcGroupsClaim,
+			GroupRoles:  oidcGroupRoles,
+		}, nil)
+	}
+
+	// the client certificate time validity is enforced by the clock skew interceptors,
+	// so that the clients get an actionable error instead of a TLS handshake failure
+	serverTLSConfig.ClientAuth = stdtls.RequireAnyClientCert
+
+	// the clients without a client certificate are authenticated with the bearer token by the injector
+	if tokenVerifier != nil {
+		serverTLSConfig.ClientAuth = stdtls.RequestClientCert
+	}
+
+	serverTLSConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 && tokenVerifier != nil {
+			return nil
+		}
+
+		roots, err := tlsConfig.CACertPool()
+		if err != nil {
+			return fmt.Errorf("failed to get client CA: %w", err)
+		}
+
+		verifiedChains, err := clockskew.VerifyChain(rawCerts, roots, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if *extKeyUsageCheckEnabled {
+			return verifyExtKeyUsage(rawCerts, verifiedChains)
+		}
+
+		return nil
 	}
 
 	clientTLSConfig, err := tlsConfig.ClientConfig()
@@ -122,19 +189,26 @@ func apidMain() error {
 
 	// all existing streaming methods
 	for _, methodName := range []string{
+		"/machine.MachineService/CgroupStats",
 		"/machine.MachineService/Copy",
 		"/machine.MachineService/DiskUsage",
 		"/machine.MachineService/Dmesg",
+		"/machine.MachineService/EmergencyConsole",
 		"/machine.MachineService/EtcdSnapshot",
 		"/machine.MachineService/Events",
+		"/machine.MachineService/GeneratedFiles",
 		"/machine.MachineService/ImageList",
 		"/machine.MachineService/Kubeconfig",
 		"/machine.MachineService/List",
+		"/machine.MachineService/LogRecords",
 		"/machine.MachineService/Logs",
 		"/machine.MachineService/PacketCapture",
 		"/machine.MachineService/Read",
+		"/machine.MachineService/UserFileRead",
 		"/os.OSService/Dmesg",
 		"/cluster.ClusterService/HealthCheck",
+		"/audit.AuditService/Watch",
+		"/health.HealthService/Check",
 	} {
 		router.RegisterStreamedRegex("^" + regexp.QuoteMeta(methodName) + "$")
 	}
@@ -142,6 +216,19 @@ func apidMain() error {
 	// register future pattern: method should have suffix "Stream"
 	router.RegisterStreamedRegex("Stream$")
 
+	// proxied calls are handled by the stream interceptor, so streamed detector is used to pick the limits
+	deadlines := &deadline.Enforcer{
+		Unary: deadline.Limits{
+			Default: *unaryDefaultDeadline,
+			Max:     *unaryMaxDeadline,
+		},
+		Streaming: deadline.Limits{
+			Default: *streamingDefaultDeadline,
+			Max:     *streamingMaxDeadline,
+		},
+		IsStreaming: router.StreamedDetector,
+	}
+
 	networkListener, err := factory.NewListener(
 		factory.Port(constants.ApidPort),
 	)
@@ -164,7 +251,12 @@ func apidMain() error {
 		}
 
 		injector := &authz.Injector{
-			Mode: mode,
+			Mode:          mode,
+			TokenVerifier: tokenVerifier,
+		}
+
+		clockSkew := &clockskew.Enforcer{
+			Tolerance: *clockSkewTolerance,
 		}
 
 		if debug.Enabled {
@@ -187,8 +279,12 @@ func apidMain() error {
 				),
 				grpc.MaxRecvMsgSize(constants.GRPCMaxMessageSize),
 			),
+			factory.WithUnaryInterceptor(clockSkew.UnaryInterceptor()),
+			factory.WithStreamInterceptor(clockSkew.StreamInterceptor()),
 			factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
 			factory.WithStreamInterceptor(injector.StreamInterceptor()),
+			factory.WithUnaryInterceptor(deadlines.UnaryInterceptor()),
+			factory.WithStreamInterceptor(deadlines.StreamInterceptor()),
 		)
 	}()
 
@@ -216,6 +312,8 @@ func apidMain() error {
 			),
 			factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
 			factory.WithStreamInterceptor(injector.StreamInterceptor()),
+			factory.WithUnaryInterceptor(deadlines.UnaryInterceptor()),
+			factory.WithStreamInterceptor(deadlines.StreamInterceptor()),
 		)
 	}()
 
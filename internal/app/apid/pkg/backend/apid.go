@@ -18,11 +18,11 @@ import (
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protowire"
 
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/proto"
 )
@@ -71,6 +71,7 @@ func (a *APID) GetConnection(ctx context.Context, fullMethodName string) (contex
 	md = md.Copy()
 
 	authz.SetMetadata(md, authz.GetRoles(ctx))
+	authz.SetCallerMetadata(md, authz.GetCaller(ctx))
 
 	if authority := md[":authority"]; len(authority) > 0 {
 		md.Set("proxyfrom", authority...)
@@ -82,6 +83,9 @@ func (a *APID) GetConnection(ctx context.Context, fullMethodName string) (contex
 	delete(md, "nodes")
 	delete(md, "node")
 
+	// the client is already authenticated, don't pass the bearer token to other nodes
+	md.Delete(constants.APIAuthorizationMetadataKey)
+
 	outCtx := metadata.NewOutgoingContext(ctx, md)
 
 	a.mu.Lock()
@@ -241,7 +245,7 @@ func (a *APID) BuildError(streaming bool, err error) ([]byte, error) {
 		Metadata: &common.Metadata{
 			Hostname: a.target,
 			Error:    err.Error(),
-			Status:   status.Convert(err).Proto(),
+			Status:   client.NodeErrorStatus(a.target, err).Proto(),
 		},
 	}
 
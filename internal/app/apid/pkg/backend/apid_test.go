@@ -14,20 +14,25 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	protobuf "google.golang.org/protobuf/proto" //nolint:depguard
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
 
 	"github.com/siderolabs/talos/internal/app/apid/pkg/backend"
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/api/audit"
 	"github.com/siderolabs/talos/pkg/machinery/api/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/health"
 	"github.com/siderolabs/talos/pkg/machinery/api/inspect"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/api/security"
 	"github.com/siderolabs/talos/pkg/machinery/api/storage"
 	"github.com/siderolabs/talos/pkg/machinery/api/time"
+	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/proto"
 	"github.com/siderolabs/talos/pkg/machinery/role"
@@ -190,6 +195,26 @@ func (suite *APIDSuite) TestBuildErrorUnary() {
 	suite.Assert().Equal("some error", reply.Messages[0].Metadata.Error)
 }
 
+func (suite *APIDSuite) TestBuildErrorDetails() {
+	resp, err := suite.b.BuildError(false, status.Error(codes.Unavailable, "connection refused"))
+	suite.Require().NoError(err)
+
+	var reply common.DataResponse
+	err = proto.Unmarshal(resp, &reply)
+	suite.Require().NoError(err)
+
+	_, err = client.FilterMessages(&reply, nil)
+	suite.Require().Error(err)
+
+	nodeErrors := client.NodeErrors(err)
+	suite.Require().Len(nodeErrors, 1)
+
+	suite.Assert().Equal(suite.b.String(), nodeErrors[0].Node)
+	suite.Assert().Equal(codes.Unavailable, nodeErrors[0].Code())
+	suite.Assert().True(nodeErrors[0].Retryable())
+	suite.Assert().ErrorIs(err, client.ErrNodeUnreachable)
+}
+
 func (suite *APIDSuite) TestBuildErrorStreaming() {
 	resp, err := suite.b.BuildError(true, errors.New("some error"))
 	suite.Require().NoError(err)
@@ -209,8 +234,10 @@ func TestAPIDSuite(t *testing.T) {
 
 func TestAPIIdiosyncrasies(t *testing.T) {
 	for _, services := range []protoreflect.ServiceDescriptors{
+		audit.File_audit_audit_proto.Services(),
 		common.File_common_common_proto.Services(),
 		cluster.File_cluster_cluster_proto.Services(),
+		health.File_health_health_proto.Services(),
 		inspect.File_inspect_inspect_proto.Services(),
 		machine.File_machine_machine_proto.Services(),
 		// security.File_security_security_proto.Services() is different
@@ -358,8 +385,10 @@ func TestDeprecatedAPIs(t *testing.T) {
 	require.NoError(t, err)
 
 	for _, file := range []protoreflect.FileDescriptor{
+		audit.File_audit_audit_proto,
 		common.File_common_common_proto,
 		cluster.File_cluster_cluster_proto,
+		health.File_health_health_proto,
 		inspect.File_inspect_inspect_proto,
 		machine.File_machine_machine_proto,
 		security.File_security_security_proto,
@@ -0,0 +1,332 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package oidc verifies the OIDC ID tokens presented by the Talos API clients instead of the client certificates.
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+const (
+	// keysRefreshInterval limits how often the signing keys are refetched when the token is signed with an unknown key.
+	keysRefreshInterval = time.Minute
+
+	// fetchTimeout limits the duration of the requests to the OIDC provider.
+	fetchTimeout = 10 * time.Second
+
+	// leeway is the allowed clock skew when validating the token times.
+	leeway = time.Minute
+)
+
+// Config of the Verifier.
+type Config struct {
+	// IssuerURL of the OIDC provider, it should match the `iss` claim of the tokens.
+	IssuerURL string
+	// Audience should be present in the `aud` claim of the tokens.
+	Audience string
+	// GroupsClaim is the name of the claim which lists the groups of the user.
+	GroupsClaim string
+	// GroupRoles maps the groups to the Talos API roles.
+	GroupRoles map[string][]string
+}
+
+// Verifier verifies the ID tokens issued by the OIDC provider and maps them to the Talos API roles.
+//
+// The signing keys of the provider are discovered and fetched on demand, and refetched when the provider rotates them.
+type Verifier struct {
+	config Config
+	client *http.Client
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	keysFetched time.Time
+}
+
+// NewVerifier creates a new Verifier.
+func NewVerifier(config Config, client *http.Client) *Verifier {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Verifier{
+		config: config,
+		client: client,
+	}
+}
+
+// Verify the token and return the identity of the user and the roles granted to the user.
+func (v *Verifier) Verify(ctx context.Context, token string) (string, role.Set, error) {
+	claims := jwt.MapClaims{}
+
+	_, err := jwt.ParseWithClaims(token, claims,
+		func(t *jwt.Token) (any, error) {
+			kid, _ := t.Header["kid"].(string) //nolint:errcheck
+
+			return v.key(ctx, kid)
+		},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(v.config.IssuerURL),
+		jwt.WithAudience(v.config.Audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	)
+	if err != nil {
+		return "", role.Zero, err
+	}
+
+	var roleNames []string
+
+	for _, group := range stringsClaim(claims[v.config.GroupsClaim]) {
+		roleNames = append(roleNames, v.config.GroupRoles[group]...)
+	}
+
+	if len(roleNames) == 0 {
+		return "", role.Zero, errors.New("token groups are not mapped to any roles")
+	}
+
+	roles, _ := role.Parse(roleNames)
+
+	return identity(claims), roles, nil
+}
+
+// identity returns the most human-readable identifier of the user present in the token.
+func identity(claims jwt.MapClaims) string {
+	for _, claim := range []string{"email", "preferred_username", "sub"} {
+		if value, ok := claims[claim].(string); ok && value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// stringsClaim returns the value of the claim which is either a string or a list of strings.
+func stringsClaim(value any) []string {
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case []any:
+		result := make([]string, 0, len(value))
+
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+
+		return result
+	}
+
+	return nil
+}
+
+// key returns the public key of the provider with the given ID.
+//
+// If the key ID is empty, the only key of the provider is returned.
+func (v *Verifier) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if key, ok := lookupKey(v.keys, kid); ok {
+		return key, nil
+	}
+
+	if time.Since(v.keysFetched) < keysRefreshInterval {
+		return nil, fmt.Errorf("signing key %q not found", kid)
+	}
+
+	keys, err := v.fetchKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching OIDC provider signing keys: %w", err)
+	}
+
+	v.keys = keys
+	v.keysFetched = time.Now()
+
+	if key, ok := lookupKey(v.keys, kid); ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("signing key %q not found", kid)
+}
+
+func lookupKey(keys map[string]crypto.PublicKey, kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+
+	key, ok := keys[kid]
+
+	return key, ok
+}
+
+type providerMetadata struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	KeyID   string `json:"kid"`
+	KeyType string `json:"kty"`
+	Use     string `json:"use"`
+
+	// RSA keys
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC keys
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+func (v *Verifier) fetchKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	var metadata providerMetadata
+
+	if err := v.fetchJSON(ctx, strings.TrimSuffix(v.config.IssuerURL, "/")+"/.well-known/openid-configuration", &metadata); err != nil {
+		return nil, err
+	}
+
+	if metadata.Issuer != v.config.IssuerURL {
+		return nil, fmt.Errorf("issuer %q of the provider doesn't match the configured issuer %q", metadata.Issuer, v.config.IssuerURL)
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	if err := v.fetchJSON(ctx, metadata.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing key %q: %w", jwk.KeyID, err)
+		}
+
+		if key != nil {
+			keys[jwk.KeyID] = key
+		}
+	}
+
+	return keys, nil
+}
+
+func (v *Verifier) fetchJSON(ctx context.Context, url string, dest any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d fetching %q", resp.StatusCode, url)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, dest)
+}
+
+// publicKey returns the public key described by the JWK, unsupported key types are skipped.
+func (jwk jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+
+		if !e.IsInt64() {
+			return nil, errors.New("invalid RSA exponent")
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+
+		switch jwk.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Curve)
+		}
+
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		if !curve.IsOnCurve(x, y) { //nolint:staticcheck
+			return nil, errors.New("point is not on the curve")
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,174 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package oidc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/apid/pkg/oidc"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+type provider struct {
+	server *httptest.Server
+	key    *ecdsa.PrivateKey
+}
+
+func newProvider(t *testing.T) *provider {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	p := &provider{key: key}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{ //nolint:errcheck
+			"issuer":   p.server.URL,
+			"jwks_uri": p.server.URL + "/keys",
+		})
+	})
+
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{ //nolint:errcheck
+			"keys": []map[string]string{
+				{
+					"kid": "key1",
+					"kty": "EC",
+					"use": "sig",
+					"crv": "P-256",
+					"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+					"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+				},
+			},
+		})
+	})
+
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+
+	return p
+}
+
+func (p *provider) token(t *testing.T, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = kid
+
+	signed, err := token.SignedString(p.key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(t)
+
+	verifier := oidc.NewVerifier(oidc.Config{
+		IssuerURL:   p.server.URL,
+		Audience:    "talos",
+		GroupsClaim: "groups",
+		GroupRoles: map[string][]string{
+			"admins":  {"os:admin"},
+			"readers": {"os:reader"},
+			"backup":  {"os:etcd:backup"},
+		},
+	}, p.server.Client())
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":    p.server.URL,
+			"aud":    "talos",
+			"sub":    "1234",
+			"email":  "user@example.com",
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"groups": []string{"readers", "backup", "unknown"},
+		}
+	}
+
+	ctx := context.Background()
+
+	identity, roles, err := verifier.Verify(ctx, p.token(t, "key1", validClaims()))
+	require.NoError(t, err)
+
+	assert.Equal(t, "user@example.com", identity)
+	assert.Equal(t, role.MakeSet(role.Reader, role.EtcdBackup).Strings(), roles.Strings())
+
+	for _, test := range []struct {
+		name   string
+		kid    string
+		modify func(jwt.MapClaims)
+	}{
+		{
+			name:   "wrong issuer",
+			kid:    "key1",
+			modify: func(c jwt.MapClaims) { c["iss"] = "https://example.com" },
+		},
+		{
+			name:   "wrong audience",
+			kid:    "key1",
+			modify: func(c jwt.MapClaims) { c["aud"] = "kubernetes" },
+		},
+		{
+			name:   "expired",
+			kid:    "key1",
+			modify: func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+		},
+		{
+			name:   "no expiration",
+			kid:    "key1",
+			modify: func(c jwt.MapClaims) { delete(c, "exp") },
+		},
+		{
+			name:   "no roles",
+			kid:    "key1",
+			modify: func(c jwt.MapClaims) { c["groups"] = "unknown" },
+		},
+		{
+			name:   "unknown key",
+			kid:    "key2",
+			modify: func(jwt.MapClaims) {},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			claims := validClaims()
+			test.modify(claims)
+
+			_, _, err := verifier.Verify(ctx, p.token(t, test.kid, claims))
+			assert.Error(t, err)
+		})
+	}
+
+	// token signed with another key
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, validClaims())
+	token.Header["kid"] = "key1"
+
+	signed, err := token.SignedString(otherKey)
+	require.NoError(t, err)
+
+	_, _, err = verifier.Verify(ctx, signed)
+	assert.Error(t, err)
+}
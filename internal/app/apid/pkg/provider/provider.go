@@ -113,6 +113,11 @@ func (tlsConfig *TLSConfig) ServerConfig() (*stdlibtls.Config, error) {
 	)
 }
 
+// CACertPool returns the current pool of the CAs which are trusted to issue client certificates.
+func (tlsConfig *TLSConfig) CACertPool() (*stdx509.CertPool, error) {
+	return tlsConfig.certificateProvider.GetCACertPool()
+}
+
 // ClientConfig generates client-side tls.Config.
 func (tlsConfig *TLSConfig) ClientConfig() (*stdlibtls.Config, error) {
 	if !tlsConfig.certificateProvider.HasClientCertificate() {
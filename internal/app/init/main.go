@@ -85,6 +85,11 @@ func run() (err error) {
 		return err
 	}
 
+	// Bind mount the rootfs checksum manifest if needed.
+	if err = bindMountRootfsChecksum(); err != nil {
+		return err
+	}
+
 	// Switch into the new rootfs.
 	log.Println("entering the rootfs")
 
@@ -224,6 +229,22 @@ func bindMountExtra() error {
 	return unix.Mount(constants.SDStubDynamicInitrdPath, filepath.Join(constants.NewRoot, constants.SDStubDynamicInitrdPath), "", unix.MS_BIND|unix.MS_RDONLY, "")
 }
 
+func bindMountRootfsChecksum() error {
+	checksumPath := "/" + constants.RootfsChecksumAsset
+
+	if _, err := os.Stat(checksumPath); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	log.Printf("bind mounting %s", checksumPath)
+
+	return unix.Mount(checksumPath, filepath.Join(constants.NewRoot, constants.RootfsChecksumFile), "", unix.MS_BIND|unix.MS_RDONLY, "")
+}
+
 func cpuInfo() {
 	log.Printf("CPU: %s, %d core(s), %d thread(s) per core", cpuid.CPU.BrandName, cpuid.CPU.PhysicalCores, cpuid.CPU.ThreadsPerCore)
 
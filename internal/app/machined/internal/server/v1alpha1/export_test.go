@@ -0,0 +1,31 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"os"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+)
+
+// EmergencyConsoleAuditEvent is exported for testing.
+type EmergencyConsoleAuditEvent = emergencyConsoleAuditEvent
+
+// EmergencyConsoleAudit is exported for testing.
+type EmergencyConsoleAudit = emergencyConsoleAudit
+
+// NewEmergencyConsoleAuditFile creates an emergency console audit log writing to the file for testing.
+func NewEmergencyConsoleAuditFile(f *os.File, sessionID string) *EmergencyConsoleAudit {
+	return newEmergencyConsoleAuditFile(f, sessionID)
+}
+
+// FeatureFlagPatch is exported for testing.
+var FeatureFlagPatch = featureFlagPatch
+
+// PatchConfiguration is exported for testing.
+func (s *Server) PatchConfiguration(in *machine.ApplyConfigurationRequest) (config.Provider, error) {
+	return s.patchConfiguration(in)
+}
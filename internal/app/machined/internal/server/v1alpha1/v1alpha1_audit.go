@@ -0,0 +1,45 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/audit"
+	auditapi "github.com/siderolabs/talos/pkg/machinery/api/audit"
+)
+
+// AuditServer implements AuditService API.
+type AuditServer struct {
+	auditapi.UnimplementedAuditServiceServer
+
+	log *audit.Log
+}
+
+// List implements audit.AuditService interface.
+func (s *AuditServer) List(ctx context.Context, in *auditapi.ListRequest) (*auditapi.ListResponse, error) {
+	return &auditapi.ListResponse{
+		Messages: []*auditapi.Records{
+			{
+				Records: s.log.List(int(in.Tail)),
+			},
+		},
+	}, nil
+}
+
+// Watch implements audit.AuditService interface.
+func (s *AuditServer) Watch(in *auditapi.WatchRequest, srv auditapi.AuditService_WatchServer) error {
+	err := s.log.Watch(srv.Context(), int(in.Tail), func(record *auditapi.Record) error {
+		return srv.Send(&auditapi.WatchResponse{
+			Record: record,
+		})
+	})
+	if err != nil && srv.Context().Err() != nil {
+		// client went away
+		return nil
+	}
+
+	return err
+}
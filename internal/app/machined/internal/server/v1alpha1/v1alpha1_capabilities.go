@@ -0,0 +1,33 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"slices"
+
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+// Capabilities implements the machine.MachineServer interface.
+func (s *Server) Capabilities(ctx context.Context, in *machine.CapabilitiesRequest) (*machine.CapabilitiesResponse, error) {
+	features := xslices.Map(client.SupportedFeatures(), func(feature client.Feature) string { return string(feature) })
+
+	return &machine.CapabilitiesResponse{
+		Messages: []*machine.Capabilities{
+			{
+				Version:  version.Tag,
+				Features: features,
+				UnsupportedFeatures: xslices.Filter(in.Features, func(feature string) bool {
+					return !slices.Contains(features, feature)
+				}),
+			},
+		},
+	}, nil
+}
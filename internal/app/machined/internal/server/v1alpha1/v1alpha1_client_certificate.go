@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// RenewClientCertificate implements the machine.MachineServer interface.
+func (s *Server) RenewClientCertificate(ctx context.Context, in *machine.RenewClientCertificateRequest) (*machine.RenewClientCertificateResponse, error) {
+	if s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeWorker {
+		return nil, status.Error(codes.FailedPrecondition, "client certificate can't be renewed on worker nodes")
+	}
+
+	// only the certificate can be renewed, the clients authenticated with the bearer token can't get one
+	caller := authz.GetCaller(ctx)
+	if caller.AuthSource != authz.AuthSourceCertificate {
+		return nil, status.Error(codes.PermissionDenied, "client certificate can only be renewed by the clients which present a client certificate")
+	}
+
+	crtTTL := in.GetCrtTtl().AsDuration()
+	if crtTTL <= 0 || crtTTL > constants.APIClientCertificateMaxTTL {
+		return nil, status.Errorf(codes.InvalidArgument, "crt_ttl should be positive and not exceed %s", constants.APIClientCertificateMaxTTL)
+	}
+
+	now := time.Now()
+
+	// the renewed certificate never outlives the certificate of the caller, so renewals can't extend the access
+	if !caller.CertificateNotAfter.IsZero() {
+		remaining := caller.CertificateNotAfter.Sub(now)
+		if remaining <= 0 {
+			return nil, status.Error(codes.PermissionDenied, "client certificate has already expired")
+		}
+
+		crtTTL = min(crtTTL, remaining)
+	}
+
+	// the renewed certificate never grants more than the certificate of the caller
+	roles := authz.GetRoles(ctx)
+	if len(roles.Strings()) == 0 || roles.Includes(role.Impersonator) {
+		return nil, status.Error(codes.PermissionDenied, "client certificate can't be renewed for the caller")
+	}
+
+	secretsBundle := secrets.NewBundleFromConfig(secrets.NewFixedClock(now), s.Controller.Runtime().Config())
+
+	cert, err := secretsBundle.GenerateTalosAPIClientCertificateWithTTL(roles, crtTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.RenewClientCertificateResponse{
+		Messages: []*machine.RenewClientCertificate{
+			{
+				Ca:  secretsBundle.Certs.OS.Crt,
+				Crt: cert.Crt,
+				Key: cert.Key,
+			},
+		},
+	}, nil
+}
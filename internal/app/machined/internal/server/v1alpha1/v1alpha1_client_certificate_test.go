@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	runtime "github.com/siderolabs/talos/internal/app/machined/internal/server/v1alpha1"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+func TestRenewClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	input, err := generate.NewInput("test-cluster", "https://localhost:6443", "")
+	require.NoError(t, err)
+
+	cfg, err := input.Config(machinetype.TypeControlPlane)
+	require.NoError(t, err)
+
+	server := &runtime.Server{
+		Controller: &mockController{
+			runtime: &mockRuntime{config: cfg},
+		},
+	}
+
+	// x509 certificates have the second precision
+	now := time.Now().Truncate(time.Second)
+
+	for _, test := range []struct {
+		name   string
+		caller authz.Caller
+		crtTTL time.Duration
+
+		expectedCode codes.Code
+		expectedTTL  time.Duration
+	}{
+		{
+			name:   "client certificate",
+			caller: authz.Caller{Identity: "admin", AuthSource: authz.AuthSourceCertificate, CertificateNotAfter: now.Add(365 * 24 * time.Hour)},
+			crtTTL: 24 * time.Hour,
+
+			expectedTTL: 24 * time.Hour,
+		},
+		{
+			name:   "client certificate expiring soon",
+			caller: authz.Caller{Identity: "admin", AuthSource: authz.AuthSourceCertificate, CertificateNotAfter: now.Add(time.Hour)},
+			crtTTL: 24 * time.Hour,
+
+			expectedTTL: time.Hour,
+		},
+		{
+			name:   "expired client certificate",
+			caller: authz.Caller{Identity: "admin", AuthSource: authz.AuthSourceCertificate, CertificateNotAfter: now.Add(-time.Minute)},
+			crtTTL: 24 * time.Hour,
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:   "bearer token",
+			caller: authz.Caller{Identity: "user@example.com", AuthSource: authz.AuthSourceToken},
+			crtTTL: 24 * time.Hour,
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:   "unknown caller",
+			crtTTL: 24 * time.Hour,
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:   "ttl too long",
+			caller: authz.Caller{Identity: "admin", AuthSource: authz.AuthSourceCertificate, CertificateNotAfter: now.Add(365 * 24 * time.Hour)},
+			crtTTL: 30 * 24 * time.Hour,
+
+			expectedCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := authz.ContextWithCaller(authz.ContextWithRoles(context.Background(), role.MakeSet(role.Admin)), test.caller)
+
+			resp, err := server.RenewClientCertificate(ctx, &machine.RenewClientCertificateRequest{
+				CrtTtl: durationpb.New(test.crtTTL),
+			})
+
+			if test.expectedCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedCode, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Messages, 1)
+
+			block, _ := pem.Decode(resp.Messages[0].Crt)
+			require.NotNil(t, block)
+
+			cert, err := x509.ParseCertificate(block.Bytes)
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{string(role.Admin)}, cert.Subject.Organization)
+			assert.InDelta(t, test.expectedTTL, cert.NotAfter.Sub(cert.NotBefore), float64(time.Minute))
+			assert.False(t, cert.NotAfter.After(test.caller.CertificateNotAfter))
+		})
+	}
+}
+
+func TestRenewClientCertificateNoExtension(t *testing.T) {
+	t.Parallel()
+
+	input, err := generate.NewInput("test-cluster", "https://localhost:6443", "")
+	require.NoError(t, err)
+
+	cfg, err := input.Config(machinetype.TypeControlPlane)
+	require.NoError(t, err)
+
+	server := &runtime.Server{
+		Controller: &mockController{
+			runtime: &mockRuntime{config: cfg},
+		},
+	}
+
+	notAfter := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+
+	// each renewed certificate is used to renew the next one, the expiration never moves past the original one
+	for range 3 {
+		ctx := authz.ContextWithCaller(
+			authz.ContextWithRoles(context.Background(), role.MakeSet(role.Admin)),
+			authz.Caller{Identity: "admin", AuthSource: authz.AuthSourceCertificate, CertificateNotAfter: notAfter},
+		)
+
+		resp, err := server.RenewClientCertificate(ctx, &machine.RenewClientCertificateRequest{
+			CrtTtl: durationpb.New(24 * time.Hour),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Messages, 1)
+
+		block, _ := pem.Decode(resp.Messages[0].Crt)
+		require.NotNil(t, block)
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+
+		assert.False(t, cert.NotAfter.After(notAfter), "renewed certificate expires at %s, after %s", cert.NotAfter, notAfter)
+
+		notAfter = cert.NotAfter
+	}
+}
@@ -75,7 +75,13 @@ func (s *Server) HealthCheck(in *clusterapi.HealthCheckRequest, srv clusterapi.C
 		return err
 	}
 
-	return check.Wait(checkCtx, &state, append(check.DefaultClusterChecks(), check.ExtraClusterChecks()...), &healthReporter{srv: srv})
+	checks := check.DefaultClusterChecks()
+
+	if cfg := r.Config(); cfg != nil && cfg.Cluster() != nil {
+		checks = check.ClusterChecksForCNI(cfg.Cluster().Network().CNI().Readiness())
+	}
+
+	return check.Wait(checkCtx, &state, append(checks, check.ExtraClusterChecks()...), &healthReporter{srv: srv})
 }
 
 type healthReporter struct {
@@ -0,0 +1,243 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// ConntrackFlush implements the machine.MachineServer interface.
+func (s *Server) ConntrackFlush(ctx context.Context, req *machine.ConntrackFlushRequest) (*machine.ConntrackFlushResponse, error) {
+	filter, err := conntrackFilter(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if filter == nil {
+		return nil, status.Error(codes.InvalidArgument, "at least one filter should be specified")
+	}
+
+	families, err := conntrackFamilies(req.Family)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted uint
+
+	for _, family := range families {
+		n, err := netlink.ConntrackDeleteFilters(netlink.ConntrackTable, family, filter)
+		deleted += n
+
+		if err != nil {
+			return nil, fmt.Errorf("error flushing conntrack entries: %w", err)
+		}
+	}
+
+	return &machine.ConntrackFlushResponse{
+		Messages: []*machine.ConntrackFlush{
+			{
+				Deleted: uint32(deleted),
+			},
+		},
+	}, nil
+}
+
+// ConntrackList implements the machine.MachineServer interface.
+func (s *Server) ConntrackList(ctx context.Context, req *machine.ConntrackListRequest) (*machine.ConntrackListResponse, error) {
+	filter, err := conntrackFilter(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	families, err := conntrackFamilies(req.Family)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*machine.ConntrackEntry
+
+	for _, family := range families {
+		flows, err := netlink.ConntrackTableList(netlink.ConntrackTable, family)
+		if err != nil {
+			return nil, fmt.Errorf("error listing conntrack entries: %w", err)
+		}
+
+		for _, flow := range flows {
+			if filter != nil && !filter.MatchConntrackFlow(flow) {
+				continue
+			}
+
+			entries = append(entries, conntrackEntry(flow))
+		}
+	}
+
+	return &machine.ConntrackListResponse{
+		Messages: []*machine.ConntrackList{
+			{
+				Entries: entries,
+			},
+		},
+	}, nil
+}
+
+func conntrackFamilies(family machine.ConntrackFlushRequest_Family) ([]netlink.InetFamily, error) {
+	switch family {
+	case machine.ConntrackFlushRequest_ALL:
+		return []netlink.InetFamily{unix.AF_INET, unix.AF_INET6}, nil
+	case machine.ConntrackFlushRequest_INET4:
+		return []netlink.InetFamily{unix.AF_INET}, nil
+	case machine.ConntrackFlushRequest_INET6:
+		return []netlink.InetFamily{unix.AF_INET6}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported family %s", family)
+	}
+}
+
+// conntrackTCPStates maps the conntrack TCP state to the name, as in /proc/net/nf_conntrack.
+var conntrackTCPStates = []string{
+	"NONE",
+	"SYN_SENT",
+	"SYN_RECV",
+	"ESTABLISHED",
+	"FIN_WAIT",
+	"CLOSE_WAIT",
+	"LAST_ACK",
+	"TIME_WAIT",
+	"CLOSE",
+	"SYN_SENT2",
+}
+
+func conntrackEntry(flow *netlink.ConntrackFlow) *machine.ConntrackEntry {
+	entry := &machine.ConntrackEntry{
+		Protocol:             nethelpers.Protocol(flow.Forward.Protocol).String(),
+		Source:               flow.Forward.SrcIP.String(),
+		Destination:          flow.Forward.DstIP.String(),
+		SourcePort:           uint32(flow.Forward.SrcPort),
+		DestinationPort:      uint32(flow.Forward.DstPort),
+		ReplySource:          flow.Reverse.SrcIP.String(),
+		ReplyDestination:     flow.Reverse.DstIP.String(),
+		ReplySourcePort:      uint32(flow.Reverse.SrcPort),
+		ReplyDestinationPort: uint32(flow.Reverse.DstPort),
+		Timeout:              flow.TimeOut,
+		Mark:                 flow.Mark,
+		Zone:                 uint32(flow.Zone),
+	}
+
+	if tcp, ok := flow.ProtoInfo.(*netlink.ProtoInfoTCP); ok && int(tcp.State) < len(conntrackTCPStates) {
+		entry.State = conntrackTCPStates[tcp.State]
+	}
+
+	return entry
+}
+
+// conntrackFilterRequest is the common part of the conntrack requests which select the entries.
+type conntrackFilterRequest interface {
+	GetProtocol() string
+	GetSource() string
+	GetDestination() string
+	GetSourcePort() uint32
+	GetDestinationPort() uint32
+}
+
+// conntrackFilter builds the conntrack filter from the request, it returns nil if no filter is set.
+func conntrackFilter(req conntrackFilterRequest) (*netlink.ConntrackFilter, error) {
+	filter := &netlink.ConntrackFilter{}
+	empty := true
+
+	if req.GetProtocol() != "" {
+		protocol, err := nethelpers.ProtocolString(req.GetProtocol())
+		if err != nil {
+			return nil, fmt.Errorf("invalid protocol %q", req.GetProtocol())
+		}
+
+		if err = filter.AddProtocol(uint8(protocol)); err != nil {
+			return nil, err
+		}
+
+		empty = false
+	}
+
+	for _, addr := range []struct {
+		value      string
+		filterType netlink.ConntrackFilterType
+	}{
+		{req.GetSource(), netlink.ConntrackOrigSrcIP},
+		{req.GetDestination(), netlink.ConntrackOrigDstIP},
+	} {
+		if addr.value == "" {
+			continue
+		}
+
+		prefix, err := parseConntrackPrefix(addr.value)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = filter.AddIPNet(addr.filterType, &net.IPNet{
+			IP:   prefix.Addr().AsSlice(),
+			Mask: net.CIDRMask(prefix.Bits(), prefix.Addr().BitLen()),
+		}); err != nil {
+			return nil, err
+		}
+
+		empty = false
+	}
+
+	for _, port := range []struct {
+		value      uint32
+		filterType netlink.ConntrackFilterType
+	}{
+		{req.GetSourcePort(), netlink.ConntrackOrigSrcPort},
+		{req.GetDestinationPort(), netlink.ConntrackOrigDstPort},
+	} {
+		if port.value == 0 {
+			continue
+		}
+
+		if port.value > 65535 {
+			return nil, fmt.Errorf("invalid port %d", port.value)
+		}
+
+		if req.GetProtocol() == "" {
+			return nil, fmt.Errorf("port filter requires protocol to be set")
+		}
+
+		if err := filter.AddPort(port.filterType, uint16(port.value)); err != nil {
+			return nil, err
+		}
+
+		empty = false
+	}
+
+	if empty {
+		return nil, nil //nolint:nilnil
+	}
+
+	return filter, nil
+}
+
+func parseConntrackPrefix(s string) (netip.Prefix, error) {
+	if prefix, err := netip.ParsePrefix(s); err == nil {
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid address %q", s)
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
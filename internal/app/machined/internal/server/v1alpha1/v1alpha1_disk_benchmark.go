@@ -0,0 +1,163 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/internal/pkg/diskbench"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// Limits of the disk benchmark, so that it doesn't hog the disk for too long or fill it up.
+const (
+	maxDiskBenchmarkDuration = 2 * time.Minute
+	maxDiskBenchmarkFileSize = 4 * 1024 * 1024 * 1024
+)
+
+// BenchmarkDisk implements the machine.MachineServer interface.
+func (s *Server) BenchmarkDisk(ctx context.Context, in *machine.BenchmarkDiskRequest) (*machine.BenchmarkDiskResponse, error) {
+	path := in.GetPath()
+	if path == "" {
+		path = constants.EphemeralMountPoint
+	}
+
+	path = filepath.Clean(path)
+
+	if path != constants.EphemeralMountPoint && !strings.HasPrefix(path, constants.EphemeralMountPoint+"/") {
+		return nil, status.Errorf(codes.InvalidArgument, "path should be under %s", constants.EphemeralMountPoint)
+	}
+
+	st, err := os.Stat(path)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to stat %q: %s", path, err)
+	}
+
+	if !st.IsDir() {
+		return nil, status.Errorf(codes.InvalidArgument, "%q is not a directory", path)
+	}
+
+	duration := in.GetDuration().AsDuration()
+
+	switch {
+	case duration == 0:
+		duration = diskbench.DefaultDuration
+	case duration < 0 || duration > maxDiskBenchmarkDuration:
+		return nil, status.Errorf(codes.InvalidArgument, "duration should be in range (0, %s]", maxDiskBenchmarkDuration)
+	}
+
+	fileSize := in.GetFileSize()
+
+	switch {
+	case fileSize == 0:
+		fileSize = diskbench.DefaultFileSize
+	case fileSize > maxDiskBenchmarkFileSize:
+		return nil, status.Errorf(codes.InvalidArgument, "file size should be at most %d bytes", maxDiskBenchmarkFileSize)
+	}
+
+	var statfs unix.Statfs_t
+
+	if err = unix.Statfs(path, &statfs); err != nil {
+		return nil, fmt.Errorf("failed to get filesystem stats: %w", err)
+	}
+
+	// keep the free space for the workloads running on the node
+	if statfs.Bavail*uint64(statfs.Bsize) < 2*fileSize {
+		return nil, status.Errorf(codes.FailedPrecondition, "not enough free space in %q for the benchmark file of %d bytes", path, fileSize)
+	}
+
+	if !s.diskBenchmarkMu.TryLock() {
+		return nil, status.Error(codes.FailedPrecondition, "disk benchmark is already running")
+	}
+
+	defer s.diskBenchmarkMu.Unlock()
+
+	measuredAt := time.Now()
+
+	result, err := diskbench.Run(ctx, diskbench.Options{
+		Dir:      path,
+		Duration: duration,
+		FileSize: int64(fileSize),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("disk benchmark failed: %w", err)
+	}
+
+	spec := runtime.DiskBenchmarkSpec{
+		Path:                          path,
+		MeasuredAt:                    measuredAt,
+		Duration:                      duration,
+		DirectIO:                      result.DirectIO,
+		FsyncCount:                    uint64(result.FsyncCount),
+		FsyncLatencyP50:               result.FsyncP50,
+		FsyncLatencyP99:               result.FsyncP99,
+		FsyncLatencyMax:               result.FsyncMax,
+		SequentialWriteBytesPerSecond: result.SequentialWriteBytesPerSecond,
+		SequentialWriteIOPS:           result.SequentialWriteIOPS,
+		RandomWriteIOPS:               result.RandomWriteIOPS,
+		RandomReadIOPS:                result.RandomReadIOPS,
+		EtcdSuitable:                  result.EtcdSuitable(),
+	}
+
+	if err = s.updateDiskBenchmark(ctx, spec); err != nil {
+		return nil, err
+	}
+
+	return &machine.BenchmarkDiskResponse{
+		Messages: []*machine.BenchmarkDisk{
+			{
+				Path:                          spec.Path,
+				Duration:                      durationpb.New(spec.Duration),
+				DirectIo:                      spec.DirectIO,
+				FsyncCount:                    spec.FsyncCount,
+				FsyncLatencyP50:               durationpb.New(spec.FsyncLatencyP50),
+				FsyncLatencyP99:               durationpb.New(spec.FsyncLatencyP99),
+				FsyncLatencyMax:               durationpb.New(spec.FsyncLatencyMax),
+				SequentialWriteBytesPerSecond: spec.SequentialWriteBytesPerSecond,
+				SequentialWriteIops:           spec.SequentialWriteIOPS,
+				RandomWriteIops:               spec.RandomWriteIOPS,
+				RandomReadIops:                spec.RandomReadIOPS,
+				EtcdSuitable:                  spec.EtcdSuitable,
+			},
+		},
+	}, nil
+}
+
+// updateDiskBenchmark records the benchmark result as the resource, replacing the previous result for the same path.
+func (s *Server) updateDiskBenchmark(ctx context.Context, spec runtime.DiskBenchmarkSpec) error {
+	resources := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	_, err := safe.StateUpdateWithConflicts(ctx, resources, runtime.NewDiskBenchmark(spec.Path).Metadata(), func(benchmark *runtime.DiskBenchmark) error {
+		*benchmark.TypedSpec() = spec
+
+		return nil
+	})
+	if state.IsNotFoundError(err) {
+		benchmark := runtime.NewDiskBenchmark(spec.Path)
+		*benchmark.TypedSpec() = spec
+
+		err = resources.Create(ctx, benchmark)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error updating disk benchmark result: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,387 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	containerdapi "github.com/containerd/containerd/v2/client"
+	"github.com/containerd/containerd/v2/contrib/seccomp"
+	"github.com/containerd/containerd/v2/pkg/cio"
+	"github.com/containerd/containerd/v2/pkg/namespaces"
+	"github.com/containerd/containerd/v2/pkg/oci"
+	"github.com/google/uuid"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// emergencyConsoleCapabilities is the list of capabilities granted to the emergency console shell.
+//
+// The shell runs as a non-root user in the host network namespace, so the capabilities are limited to network diagnostics.
+// The shell doesn't share the host PID namespace, as the host processes would expose the host root filesystem via /proc/<pid>/root.
+var emergencyConsoleCapabilities = []string{
+	"CAP_NET_RAW",
+}
+
+// emergencyConsoleCleanupTimeout is the timeout to clean up the session container.
+const emergencyConsoleCleanupTimeout = 30 * time.Second
+
+// EmergencyConsole implements the machine.MachineServer interface.
+//
+//nolint:gocyclo,cyclop
+func (s *Server) EmergencyConsole(srv machine.MachineService_EmergencyConsoleServer) error {
+	cfg := s.Controller.Runtime().Config()
+	if cfg == nil || cfg.Machine() == nil || !cfg.Machine().Features().EmergencyConsoleEnabled() {
+		return status.Error(codes.FailedPrecondition, "emergency console is not enabled in the machine configuration")
+	}
+
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+
+	reason := strings.TrimSpace(req.GetReason())
+	if reason == "" {
+		return status.Error(codes.InvalidArgument, "reason is required to open the emergency console")
+	}
+
+	ctx, ctxCancel := context.WithTimeout(srv.Context(), constants.EmergencyConsoleMaxDuration)
+	defer ctxCancel()
+
+	sessionID := uuid.New().String()
+
+	audit, err := newEmergencyConsoleAudit(sessionID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error creating audit log: %s", err)
+	}
+
+	defer audit.Close() //nolint:errcheck
+
+	if err = audit.Record(emergencyConsoleAuditEvent{
+		Type:   "start",
+		Reason: reason,
+		Roles:  authz.GetRoles(ctx).Strings(),
+	}); err != nil {
+		return status.Errorf(codes.Internal, "error writing audit log: %s", err)
+	}
+
+	log.Printf("emergency console session %s opened, reason: %q", sessionID, reason)
+
+	exitCode, err := s.runEmergencyConsole(ctx, srv, req, sessionID, audit)
+
+	exitEvent := emergencyConsoleAuditEvent{
+		Type:     "exit",
+		ExitCode: exitCode,
+	}
+
+	if err != nil {
+		exitEvent.Error = err.Error()
+	}
+
+	if auditErr := audit.Record(exitEvent); auditErr != nil {
+		log.Printf("emergency console session %s: error writing audit log: %s", sessionID, auditErr)
+	}
+
+	log.Printf("emergency console session %s closed, exit code %d", sessionID, exitCode)
+
+	if err != nil {
+		return err
+	}
+
+	return srv.Send(&machine.EmergencyConsoleResponse{
+		Exited:   true,
+		ExitCode: exitCode,
+	})
+}
+
+//nolint:gocyclo,cyclop
+func (s *Server) runEmergencyConsole(
+	ctx context.Context,
+	srv machine.MachineService_EmergencyConsoleServer,
+	req *machine.EmergencyConsoleRequest,
+	sessionID string,
+	audit *emergencyConsoleAudit,
+) (int32, error) {
+	client, err := containerdapi.New(constants.SystemContainerdAddress)
+	if err != nil {
+		return -1, status.Errorf(codes.Unavailable, "error connecting to containerd: %s", err)
+	}
+	//nolint:errcheck
+	defer client.Close()
+
+	ctx = namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
+
+	// the session container is removed even if the client goes away
+	cleanupCtx, cleanupCancel := context.WithTimeout(context.WithoutCancel(ctx), emergencyConsoleCleanupTimeout)
+	defer cleanupCancel()
+
+	img, err := image.Pull(ctx, s.Controller.Runtime().Config().Machine().Registries(), client, constants.EmergencyConsoleImage, image.WithSkipIfAlreadyPulled())
+	if err != nil {
+		return -1, fmt.Errorf("error pulling emergency console image: %w", err)
+	}
+
+	containerID := "emergency-console-" + sessionID
+
+	container, err := client.NewContainer(ctx, containerID,
+		containerdapi.WithImage(img),
+		containerdapi.WithNewSnapshotView(containerID, img),
+		containerdapi.WithNewSpec(
+			oci.WithImageConfig(img),
+			oci.WithProcessArgs("/bin/sh"),
+			oci.WithTTY,
+			oci.WithRootFSReadonly(),
+			oci.WithHostNamespace(specs.NetworkNamespace),
+			oci.WithUIDGID(constants.EmergencyConsoleUserID, constants.EmergencyConsoleUserID),
+			oci.WithHostHostsFile,
+			oci.WithHostResolvconf,
+			oci.WithMounts([]specs.Mount{
+				{
+					Type:        "tmpfs",
+					Source:      "tmpfs",
+					Destination: "/tmp",
+					Options:     []string{"nosuid", "nodev", "noexec", "size=16m"},
+				},
+			}),
+			oci.WithCapabilities(emergencyConsoleCapabilities),
+			oci.WithAmbientCapabilities(emergencyConsoleCapabilities),
+			oci.WithNoNewPrivileges,
+			seccomp.WithDefaultProfile(), // add seccomp profile last, as it depends on process capabilities
+		),
+	)
+	if err != nil {
+		return -1, fmt.Errorf("error creating emergency console container: %w", err)
+	}
+
+	defer container.Delete(cleanupCtx, containerdapi.WithSnapshotCleanup) //nolint:errcheck
+
+	stdinR, stdinW := io.Pipe()
+	outputR, outputW := io.Pipe()
+
+	defer stdinW.Close() //nolint:errcheck
+
+	task, err := container.NewTask(ctx, cio.NewCreator(cio.WithStreams(stdinR, outputW, nil), cio.WithTerminal))
+	if err != nil {
+		return -1, fmt.Errorf("error creating emergency console task: %w", err)
+	}
+
+	defer task.Delete(cleanupCtx, containerdapi.WithProcessKill) //nolint:errcheck
+
+	exitCh, err := task.Wait(ctx)
+	if err != nil {
+		return -1, fmt.Errorf("error waiting for emergency console task: %w", err)
+	}
+
+	if err = srv.Send(&machine.EmergencyConsoleResponse{
+		SessionId: sessionID,
+	}); err != nil {
+		return -1, err
+	}
+
+	if err = task.Start(ctx); err != nil {
+		return -1, fmt.Errorf("error starting emergency console task: %w", err)
+	}
+
+	outputDone := make(chan error, 1)
+
+	go func() {
+		outputDone <- emergencyConsoleOutput(outputR, srv, audit)
+	}()
+
+	inputDone := make(chan error, 1)
+
+	go func() {
+		inputDone <- emergencyConsoleInput(ctx, req, srv, task, stdinW, audit)
+	}()
+
+	var exitStatus containerdapi.ExitStatus
+
+	select {
+	case exitStatus = <-exitCh:
+	case err = <-inputDone:
+		// the client went away, kill the shell
+		if killErr := task.Kill(cleanupCtx, syscall.SIGKILL); killErr != nil {
+			log.Printf("emergency console session %s: error killing the shell: %s", sessionID, killErr)
+		}
+
+		exitStatus = <-exitCh
+	case <-ctx.Done():
+		if killErr := task.Kill(cleanupCtx, syscall.SIGKILL); killErr != nil {
+			log.Printf("emergency console session %s: error killing the shell: %s", sessionID, killErr)
+		}
+
+		exitStatus = <-exitCh
+		err = status.Error(codes.DeadlineExceeded, "emergency console session timed out")
+	}
+
+	// flush the remaining output before reporting the exit
+	task.IO().Wait()
+	outputW.Close() //nolint:errcheck
+
+	if outputErr := <-outputDone; outputErr != nil && err == nil {
+		err = outputErr
+	}
+
+	if err != nil {
+		return -1, err
+	}
+
+	return int32(exitStatus.ExitCode()), nil //nolint:gosec
+}
+
+func emergencyConsoleInput(
+	ctx context.Context,
+	req *machine.EmergencyConsoleRequest,
+	srv machine.MachineService_EmergencyConsoleServer,
+	task containerdapi.Task,
+	stdin io.WriteCloser,
+	audit *emergencyConsoleAudit,
+) error {
+	for {
+		if req.GetRows() > 0 && req.GetCols() > 0 {
+			if err := task.Resize(ctx, req.GetCols(), req.GetRows()); err != nil {
+				return fmt.Errorf("error resizing terminal: %w", err)
+			}
+		}
+
+		if len(req.GetStdin()) > 0 {
+			if err := audit.Record(emergencyConsoleAuditEvent{
+				Type: "input",
+				Data: req.GetStdin(),
+			}); err != nil {
+				return err
+			}
+
+			if _, err := stdin.Write(req.GetStdin()); err != nil {
+				return err
+			}
+		}
+
+		var err error
+
+		req, err = srv.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				// no more input, the shell exits on its own
+				stdin.Close() //nolint:errcheck
+
+				<-ctx.Done()
+			}
+
+			return err
+		}
+	}
+}
+
+func emergencyConsoleOutput(r io.Reader, srv machine.MachineService_EmergencyConsoleServer, audit *emergencyConsoleAudit) error {
+	buf := make([]byte, 4096)
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			data := append([]byte(nil), buf[:n]...)
+
+			if auditErr := audit.Record(emergencyConsoleAuditEvent{
+				Type: "output",
+				Data: data,
+			}); auditErr != nil {
+				return auditErr
+			}
+
+			if sendErr := srv.Send(&machine.EmergencyConsoleResponse{
+				Output: data,
+			}); sendErr != nil {
+				return sendErr
+			}
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
+
+// emergencyConsoleAuditEvent is a single record in the emergency console audit log.
+type emergencyConsoleAuditEvent struct {
+	Time     time.Time `json:"time"`
+	Session  string    `json:"session"`
+	Type     string    `json:"type"`
+	Reason   string    `json:"reason,omitempty"`
+	Roles    []string  `json:"roles,omitempty"`
+	Data     []byte    `json:"data,omitempty"`
+	ExitCode int32     `json:"exitCode,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// emergencyConsoleAudit records the emergency console session as JSON lines, one file per session.
+type emergencyConsoleAudit struct {
+	mu        sync.Mutex
+	f         *os.File
+	enc       *json.Encoder
+	sessionID string
+}
+
+func newEmergencyConsoleAudit(sessionID string) (*emergencyConsoleAudit, error) {
+	if err := os.MkdirAll(constants.EmergencyConsoleAuditLogDir, 0o700); err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(constants.EmergencyConsoleAuditLogDir, fmt.Sprintf("%s-%s.jsonl", time.Now().UTC().Format("20060102T150405Z"), sessionID))
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return newEmergencyConsoleAuditFile(f, sessionID), nil
+}
+
+func newEmergencyConsoleAuditFile(f *os.File, sessionID string) *emergencyConsoleAudit {
+	return &emergencyConsoleAudit{
+		f:         f,
+		enc:       json.NewEncoder(f),
+		sessionID: sessionID,
+	}
+}
+
+// Record appends the event to the audit log, the log is synced so that the record survives a crash.
+func (audit *emergencyConsoleAudit) Record(event emergencyConsoleAuditEvent) error {
+	audit.mu.Lock()
+	defer audit.mu.Unlock()
+
+	event.Time = time.Now()
+	event.Session = audit.sessionID
+
+	if err := audit.enc.Encode(event); err != nil {
+		return fmt.Errorf("error writing audit log: %w", err)
+	}
+
+	return audit.f.Sync()
+}
+
+// Close the audit log.
+func (audit *emergencyConsoleAudit) Close() error {
+	return audit.f.Close()
+}
@@ -0,0 +1,193 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	runtime "github.com/siderolabs/talos/internal/app/machined/internal/server/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+type mockEmergencyConsoleServer struct {
+	grpc.ServerStream
+
+	requests []*machine.EmergencyConsoleRequest
+	sent     []*machine.EmergencyConsoleResponse
+}
+
+func (srv *mockEmergencyConsoleServer) Context() context.Context {
+	return context.Background()
+}
+
+func (srv *mockEmergencyConsoleServer) Recv() (*machine.EmergencyConsoleRequest, error) {
+	if len(srv.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := srv.requests[0]
+	srv.requests = srv.requests[1:]
+
+	return req, nil
+}
+
+func (srv *mockEmergencyConsoleServer) Send(resp *machine.EmergencyConsoleResponse) error {
+	srv.sent = append(srv.sent, resp)
+
+	return nil
+}
+
+func emergencyConsoleConfig(enabled bool) config.Provider {
+	return container.NewV1Alpha1(&v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineFeatures: &v1alpha1.FeaturesConfig{
+				EmergencyConsole: pointer.To(enabled),
+			},
+		},
+	})
+}
+
+func TestEmergencyConsoleRejected(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		config   config.Provider
+		requests []*machine.EmergencyConsoleRequest
+
+		expectedCode codes.Code
+	}{
+		{
+			name:   "no config",
+			config: nil,
+
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "disabled",
+			config:   emergencyConsoleConfig(false),
+			requests: []*machine.EmergencyConsoleRequest{{Reason: "debugging"}},
+
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "no reason",
+			config:   emergencyConsoleConfig(true),
+			requests: []*machine.EmergencyConsoleRequest{{}},
+
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:     "blank reason",
+			config:   emergencyConsoleConfig(true),
+			requests: []*machine.EmergencyConsoleRequest{{Reason: " \t\n"}},
+
+			expectedCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &runtime.Server{
+				Controller: &mockController{
+					runtime: &mockRuntime{config: test.config},
+				},
+			}
+
+			srv := &mockEmergencyConsoleServer{
+				requests: test.requests,
+			}
+
+			err := server.EmergencyConsole(srv)
+			require.Error(t, err)
+
+			assert.Equal(t, test.expectedCode, status.Code(err))
+			assert.Empty(t, srv.sent)
+		})
+	}
+}
+
+func TestEmergencyConsoleAuditRecord(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(t, err)
+
+	audit := runtime.NewEmergencyConsoleAuditFile(f, "session-1")
+
+	require.NoError(t, audit.Record(runtime.EmergencyConsoleAuditEvent{
+		Type:   "start",
+		Reason: "debugging",
+		Roles:  []string{"os:admin"},
+	}))
+	require.NoError(t, audit.Record(runtime.EmergencyConsoleAuditEvent{
+		Type: "input",
+		Data: []byte("ls\n"),
+	}))
+	require.NoError(t, audit.Record(runtime.EmergencyConsoleAuditEvent{
+		Type:     "exit",
+		ExitCode: 1,
+	}))
+	require.NoError(t, audit.Close())
+
+	in, err := os.Open(path)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, in.Close()) })
+
+	var records []map[string]any
+
+	scanner := bufio.NewScanner(in)
+
+	for scanner.Scan() {
+		var record map[string]any
+
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+
+		assert.NotEmpty(t, record["time"])
+		assert.Equal(t, "session-1", record["session"])
+
+		delete(record, "time")
+		delete(record, "session")
+
+		records = append(records, record)
+	}
+
+	require.NoError(t, scanner.Err())
+
+	assert.Equal(t, []map[string]any{
+		{
+			"type":   "start",
+			"reason": "debugging",
+			"roles":  []any{"os:admin"},
+		},
+		{
+			"type": "input",
+			"data": "bHMK", // base64 of "ls\n"
+		},
+		{
+			"type":     "exit",
+			"exitCode": float64(1),
+		},
+	}, records)
+}
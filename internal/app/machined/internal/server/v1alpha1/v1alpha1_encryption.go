@@ -0,0 +1,293 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	blockdev "github.com/siderolabs/go-blockdevice/v2/block"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption"
+	"github.com/siderolabs/talos/pkg/logging"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+// encryptedVolume is the encrypted system volume with its encryption handler.
+type encryptedVolume struct {
+	status  *block.VolumeStatus
+	config  *block.VolumeConfig
+	handler *encryption.Handler
+}
+
+func (s *Server) getEncryptedVolume(ctx context.Context, volumeID string) (*encryptedVolume, error) {
+	mode := s.Controller.Runtime().State().Platform().Mode()
+
+	if mode.InContainer() {
+		return nil, status.Errorf(codes.FailedPrecondition, "method is not supported in %s mode", mode.String())
+	}
+
+	if volumeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume should be set")
+	}
+
+	resources := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	volumeStatus, err := safe.ReaderGetByID[*block.VolumeStatus](ctx, resources, volumeID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "volume %q not found", volumeID)
+		}
+
+		return nil, err
+	}
+
+	volumeConfig, err := safe.ReaderGetByID[*block.VolumeConfig](ctx, resources, volumeID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "volume %q not found", volumeID)
+		}
+
+		return nil, err
+	}
+
+	if volumeStatus.TypedSpec().EncryptionProvider != block.EncryptionProviderLUKS2 {
+		return nil, status.Errorf(codes.FailedPrecondition, "volume %q is not encrypted", volumeID)
+	}
+
+	getSystemInformation := func(ctx context.Context) (*hardware.SystemInformation, error) {
+		return safe.ReaderGetByID[*hardware.SystemInformation](ctx, resources, hardware.SystemInformationID)
+	}
+
+	handler, err := encryption.NewHandler(volumeConfig.TypedSpec().Encryption, volumeID, getSystemInformation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create encryption handler: %w", err)
+	}
+
+	return &encryptedVolume{
+		status:  volumeStatus,
+		config:  volumeConfig,
+		handler: handler,
+	}, nil
+}
+
+// withLockedDevice runs the key slot operation holding the lock on the volume disk, so that it doesn't race with the volume manager.
+func (volume *encryptedVolume) withLockedDevice(ctx context.Context, f func(path string) error) error {
+	devPath := volume.status.TypedSpec().ParentLocation
+	if devPath == "" {
+		devPath = volume.status.TypedSpec().Location
+	}
+
+	dev, err := blockdev.NewFromPath(devPath, blockdev.OpenForWrite())
+	if err != nil {
+		return fmt.Errorf("error opening disk: %w", err)
+	}
+
+	defer dev.Close() //nolint:errcheck
+
+	if err = dev.RetryLockWithTimeout(ctx, true, 10*time.Second); err != nil {
+		return fmt.Errorf("error locking disk: %w", err)
+	}
+
+	defer dev.Unlock() //nolint:errcheck
+
+	return encryptionError(f(volume.status.TypedSpec().Location))
+}
+
+func encryptionError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, encryption.ErrKeySlotNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, encryption.ErrKeySlotInUse):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, encryption.ErrKeySlotNotConfigured), errors.Is(err, encryption.ErrLastKeySlot):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+// EncryptionStatus implements the machine.MachineServer interface.
+func (s *Server) EncryptionStatus(ctx context.Context, in *machine.EncryptionStatusRequest) (*machine.EncryptionStatusResponse, error) {
+	volumeIDs := in.GetVolumes()
+	if len(volumeIDs) == 0 {
+		volumeIDs = []string{constants.StatePartitionLabel, constants.EphemeralPartitionLabel}
+	}
+
+	resources := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	volumes := make([]*machine.EncryptionVolumeStatus, 0, len(volumeIDs))
+
+	for _, volumeID := range volumeIDs {
+		volumeStatus, err := safe.ReaderGetByID[*block.VolumeStatus](ctx, resources, volumeID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return nil, status.Errorf(codes.NotFound, "volume %q not found", volumeID)
+			}
+
+			return nil, err
+		}
+
+		volume := &machine.EncryptionVolumeStatus{
+			Volume:   volumeID,
+			Provider: volumeStatus.TypedSpec().EncryptionProvider.String(),
+			Phase:    volumeStatus.TypedSpec().Phase.String(),
+			Location: volumeStatus.TypedSpec().Location,
+		}
+
+		volumes = append(volumes, volume)
+
+		if volumeStatus.TypedSpec().EncryptionProvider != block.EncryptionProviderLUKS2 {
+			continue
+		}
+
+		encrypted, err := s.getEncryptedVolume(ctx, volumeID)
+		if err != nil {
+			return nil, err
+		}
+
+		volume.MappedLocation = volumeStatus.TypedSpec().MountLocation
+		volume.Cipher = encrypted.config.TypedSpec().Encryption.Cipher
+
+		keySlots, err := encrypted.handler.KeySlots(ctx, volumeStatus.TypedSpec().Location)
+		if err != nil {
+			return nil, fmt.Errorf("error reading key slots of volume %q: %w", volumeID, err)
+		}
+
+		for _, keySlot := range keySlots {
+			volume.KeySlots = append(volume.KeySlots, &machine.EncryptionKeySlot{
+				Slot:      int32(keySlot.Slot),
+				TokenType: keySlot.TokenType,
+				Enrolled:  true,
+			})
+		}
+
+		for _, key := range encrypted.config.TypedSpec().Encryption.Keys {
+			idx := slices.IndexFunc(volume.KeySlots, func(keySlot *machine.EncryptionKeySlot) bool { return int(keySlot.Slot) == key.Slot })
+			if idx == -1 {
+				volume.KeySlots = append(volume.KeySlots, &machine.EncryptionKeySlot{Slot: int32(key.Slot)})
+				idx = len(volume.KeySlots) - 1
+			}
+
+			volume.KeySlots[idx].Type = key.Type.String()
+		}
+
+		slices.SortFunc(volume.KeySlots, func(a, b *machine.EncryptionKeySlot) int { return int(a.Slot - b.Slot) })
+	}
+
+	return &machine.EncryptionStatusResponse{
+		Messages: []*machine.EncryptionStatus{
+			{
+				Volumes: volumes,
+			},
+		},
+	}, nil
+}
+
+// EncryptionRotateKey implements the machine.MachineServer interface.
+func (s *Server) EncryptionRotateKey(ctx context.Context, in *machine.EncryptionRotateKeyRequest) (*machine.EncryptionRotateKeyResponse, error) {
+	volume, err := s.getEncryptedVolume(ctx, in.GetVolume())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("rotating encryption key in slot %d of volume %q", in.GetSlot(), in.GetVolume())
+
+	if err = volume.withLockedDevice(ctx, func(path string) error {
+		return volume.handler.RotateKey(ctx, logging.Wrap(log.Writer()), path, int(in.GetSlot()))
+	}); err != nil {
+		return nil, err
+	}
+
+	return &machine.EncryptionRotateKeyResponse{
+		Messages: []*machine.EncryptionRotateKey{
+			{},
+		},
+	}, nil
+}
+
+// EncryptionAddKey implements the machine.MachineServer interface.
+func (s *Server) EncryptionAddKey(ctx context.Context, in *machine.EncryptionAddKeyRequest) (*machine.EncryptionAddKeyResponse, error) {
+	key := block.EncryptionKey{
+		Slot: int(in.GetSlot()),
+	}
+
+	var keyTypes int
+
+	if len(in.GetStaticPassphrase()) > 0 {
+		key.Type = block.EncryptionKeyStatic
+		key.StaticPassphrase = in.GetStaticPassphrase()
+		keyTypes++
+	}
+
+	if in.GetNodeId() {
+		key.Type = block.EncryptionKeyNodeID
+		keyTypes++
+	}
+
+	if in.GetKmsEndpoint() != "" {
+		key.Type = block.EncryptionKeyKMS
+		key.KMSEndpoint = in.GetKmsEndpoint()
+		keyTypes++
+	}
+
+	if keyTypes != 1 {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of static passphrase, node ID or KMS endpoint should be set")
+	}
+
+	volume, err := s.getEncryptedVolume(ctx, in.GetVolume())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("adding %s encryption key in slot %d of volume %q", key.Type, key.Slot, in.GetVolume())
+
+	if err = volume.withLockedDevice(ctx, func(path string) error {
+		return volume.handler.AddKey(ctx, logging.Wrap(log.Writer()), path, key)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &machine.EncryptionAddKeyResponse{
+		Messages: []*machine.EncryptionAddKey{
+			{},
+		},
+	}, nil
+}
+
+// EncryptionRemoveKey implements the machine.MachineServer interface.
+func (s *Server) EncryptionRemoveKey(ctx context.Context, in *machine.EncryptionRemoveKeyRequest) (*machine.EncryptionRemoveKeyResponse, error) {
+	volume, err := s.getEncryptedVolume(ctx, in.GetVolume())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("removing encryption key slot %d of volume %q", in.GetSlot(), in.GetVolume())
+
+	if err = volume.withLockedDevice(ctx, func(path string) error {
+		return volume.handler.RemoveKey(ctx, logging.Wrap(log.Writer()), path, int(in.GetSlot()))
+	}); err != nil {
+		return nil, err
+	}
+
+	return &machine.EncryptionRemoveKeyResponse{
+		Messages: []*machine.EncryptionRemoveKey{
+			{},
+		},
+	}, nil
+}
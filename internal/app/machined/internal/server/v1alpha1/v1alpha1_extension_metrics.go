@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/internal/pkg/textfile"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// extensionMetricsLabel is the label identifying the source of the extension metrics.
+const extensionMetricsLabel = "extension"
+
+// ExtensionMetrics implements the machine.MachineServer interface.
+func (s *Server) ExtensionMetrics(ctx context.Context, _ *emptypb.Empty) (*machine.ExtensionMetricsResponse, error) {
+	list, err := safe.StateListAll[*runtime.ExtensionMetrics](ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
+	if err != nil {
+		return nil, fmt.Errorf("error listing extension metrics: %w", err)
+	}
+
+	msg := &machine.ExtensionMetrics{}
+	sources := make(map[string][]*dto.MetricFamily, list.Len())
+
+	for iter := list.Iterator(); iter.Next(); {
+		res := iter.Value()
+
+		if res.TypedSpec().Error != "" {
+			msg.Errors = append(msg.Errors, fmt.Sprintf("%s: %s", res.Metadata().ID(), res.TypedSpec().Error))
+		}
+
+		families, err := textfile.Parse(strings.NewReader(res.TypedSpec().Metrics))
+		if err != nil {
+			msg.Errors = append(msg.Errors, fmt.Sprintf("%s: %s", res.Metadata().ID(), err))
+
+			continue
+		}
+
+		sources[res.Metadata().ID()] = families
+	}
+
+	merged, err := textfile.Merge(sources, extensionMetricsLabel)
+	if err != nil {
+		for _, mergeErr := range unwrapJoined(err) {
+			msg.Errors = append(msg.Errors, mergeErr.Error())
+		}
+	}
+
+	var sb strings.Builder
+
+	if err = textfile.Encode(&sb, merged); err != nil {
+		return nil, fmt.Errorf("error encoding extension metrics: %w", err)
+	}
+
+	msg.Metrics = sb.String()
+
+	return &machine.ExtensionMetricsResponse{
+		Messages: []*machine.ExtensionMetrics{msg},
+	}, nil
+}
+
+func unwrapJoined(err error) []error {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok { //nolint:errorlint
+		return joined.Unwrap()
+	}
+
+	return []error{err}
+}
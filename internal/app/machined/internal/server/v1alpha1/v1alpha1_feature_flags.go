@@ -0,0 +1,218 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/internal/pkg/secretsstore"
+	"github.com/siderolabs/talos/internal/pkg/stagedconfig"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// featureFlag is a boolean feature flag of the machine configuration.
+type featureFlag struct {
+	// name is the path of the flag relative to .machine.features.
+	name    string
+	enabled func(talosconfig.Features) bool
+}
+
+// featureFlags is the list of the feature flags managed with FeatureFlagList and FeatureFlagSet.
+var featureFlags = []featureFlag{
+	{"apidCheckExtKeyUsage", talosconfig.Features.ApidCheckExtKeyUsageEnabled},
+	{"diskQuotaSupport", talosconfig.Features.DiskQuotaSupportEnabled},
+	{"emergencyConsole", talosconfig.Features.EmergencyConsoleEnabled},
+	{"hostDNS.enabled", func(f talosconfig.Features) bool { return f.HostDNS().Enabled() }},
+	{"hostDNS.forwardKubeDNSToHost", func(f talosconfig.Features) bool { return f.HostDNS().ForwardKubeDNSToHost() }},
+	{"hostDNS.resolveMemberNames", func(f talosconfig.Features) bool { return f.HostDNS().ResolveMemberNames() }},
+	{"kubePrism.enabled", func(f talosconfig.Features) bool { return f.KubePrism().Enabled() }},
+	{"kubernetesEvents", talosconfig.Features.KubernetesEventsEnabled},
+	{"kubernetesTalosAPIAccess.enabled", func(f talosconfig.Features) bool { return f.KubernetesTalosAPIAccess().Enabled() }},
+	{"metrics.enabled", func(f talosconfig.Features) bool { return f.Metrics().Enabled() }},
+	{"rbac", talosconfig.Features.RBACEnabled},
+	{"stableHostname", talosconfig.Features.StableHostnameEnabled},
+}
+
+// FeatureFlagList implements the machine.MachineServer interface.
+func (s *Server) FeatureFlagList(ctx context.Context, in *emptypb.Empty) (*machine.FeatureFlagListResponse, error) {
+	features, err := s.currentFeatures()
+	if err != nil {
+		return nil, err
+	}
+
+	flags := make([]*machine.FeatureFlag, 0, len(featureFlags))
+
+	for _, flag := range featureFlags {
+		enabled := flag.enabled(features)
+
+		flags = append(flags, &machine.FeatureFlag{
+			Name:    flag.name,
+			Enabled: enabled,
+			Mutable: s.canToggleFeatureFlag(flag.name, !enabled),
+		})
+	}
+
+	return &machine.FeatureFlagListResponse{
+		Messages: []*machine.FeatureFlags{
+			{
+				Flags: flags,
+			},
+		},
+	}, nil
+}
+
+// FeatureFlagSet implements the machine.MachineServer interface.
+func (s *Server) FeatureFlagSet(ctx context.Context, in *machine.FeatureFlagSetRequest) (*machine.FeatureFlagSetResponse, error) {
+	idx := slices.IndexFunc(featureFlags, func(flag featureFlag) bool { return flag.name == in.GetName() })
+	if idx == -1 {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown feature flag %q", in.GetName())
+	}
+
+	flag := featureFlags[idx]
+
+	features, err := s.currentFeatures()
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.enabled(features) == in.GetEnabled() {
+		return &machine.FeatureFlagSetResponse{
+			Messages: []*machine.FeatureFlagSet{
+				{
+					Flag: &machine.FeatureFlag{
+						Name:    flag.name,
+						Enabled: in.GetEnabled(),
+						Mutable: s.canToggleFeatureFlag(flag.name, !in.GetEnabled()),
+					},
+					Unchanged: true,
+				},
+			},
+		}, nil
+	}
+
+	cfgProvider, err := s.featureFlagConfig(flag.name, in.GetEnabled())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err = cfgProvider.Validate(
+		modeWrapper{
+			Mode:      s.Controller.Runtime().State().Platform().Mode(),
+			installed: s.Controller.Runtime().State().Machine().Installed(),
+		},
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = s.Controller.Runtime().CanApplyImmediate(cfgProvider); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "feature flag %q can't be changed without a reboot: %s", flag.name, err)
+	}
+
+	log.Printf("feature flag set request: %s=%t", flag.name, in.GetEnabled())
+
+	s.Controller.Runtime().CancelConfigRollbackTimeout()
+
+	data, err := cfgProvider.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = stagedconfig.Default().Save(data, 0); err != nil {
+		return nil, err
+	}
+
+	if err = s.updateStagedConfigStatus(ctx, machine.ApplyConfigurationRequest_NO_REBOOT, cfgProvider); err != nil {
+		return nil, err
+	}
+
+	if err = s.Controller.Runtime().SetConfig(cfgProvider); err != nil {
+		return nil, err
+	}
+
+	s.Controller.Runtime().Events().Publish(ctx, &machine.FeatureFlagChangeEvent{
+		Name:    flag.name,
+		Enabled: in.GetEnabled(),
+	})
+
+	return &machine.FeatureFlagSetResponse{
+		Messages: []*machine.FeatureFlagSet{
+			{
+				Flag: &machine.FeatureFlag{
+					Name:    flag.name,
+					Enabled: in.GetEnabled(),
+					Mutable: true,
+				},
+			},
+		},
+	}, nil
+}
+
+func (s *Server) currentFeatures() (talosconfig.Features, error) {
+	cfgProvider := s.Controller.Runtime().Config()
+
+	if cfgProvider == nil || cfgProvider.Machine() == nil {
+		return nil, status.Error(codes.FailedPrecondition, "machine configuration is not available")
+	}
+
+	return cfgProvider.Machine().Features(), nil
+}
+
+// canToggleFeatureFlag checks whether the feature flag can be set to the value without a reboot.
+func (s *Server) canToggleFeatureFlag(name string, enabled bool) bool {
+	cfgProvider, err := s.featureFlagConfig(name, enabled)
+	if err != nil {
+		return false
+	}
+
+	return s.Controller.Runtime().CanApplyImmediate(cfgProvider) == nil
+}
+
+// featureFlagConfig returns the current machine configuration with the feature flag set to the value.
+func (s *Server) featureFlagConfig(name string, enabled bool) (config.Provider, error) {
+	patch, err := featureFlagPatch(name, enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	cfgProvider, err := s.patchConfiguration(&machine.ApplyConfigurationRequest{
+		Patches: [][]byte{patch},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return secretsstore.Default().Resolve(cfgProvider, nil)
+}
+
+// featureFlagPatch builds the strategic merge patch which sets the feature flag.
+//
+// The flag name is the dot-separated path relative to .machine.features, e.g. "kubePrism.enabled".
+func featureFlagPatch(name string, enabled bool) ([]byte, error) {
+	path := append([]string{"machine", "features"}, strings.Split(name, ".")...)
+
+	var value any = enabled
+
+	for i := len(path) - 1; i >= 0; i-- {
+		value = map[string]any{path[i]: value}
+	}
+
+	patch, err := yaml.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling feature flag patch: %w", err)
+	}
+
+	return patch, nil
+}
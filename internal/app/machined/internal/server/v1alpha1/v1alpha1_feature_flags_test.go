@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	runtime "github.com/siderolabs/talos/internal/app/machined/internal/server/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
+func TestFeatureFlagPatch(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		enabled bool
+
+		expected string
+	}{
+		{
+			name:    "rbac",
+			enabled: true,
+
+			expected: "machine:\n    features:\n        rbac: true\n",
+		},
+		{
+			name:    "kubePrism.enabled",
+			enabled: false,
+
+			expected: "machine:\n    features:\n        kubePrism:\n            enabled: false\n",
+		},
+		{
+			name:    "hostDNS.forwardKubeDNSToHost",
+			enabled: true,
+
+			expected: "machine:\n    features:\n        hostDNS:\n            forwardKubeDNSToHost: true\n",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			patch, err := runtime.FeatureFlagPatch(test.name, test.enabled)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, string(patch))
+		})
+	}
+}
+
+func TestFeatureFlagSet(t *testing.T) {
+	t.Parallel()
+
+	input, err := generate.NewInput("test-cluster", "https://localhost:6443", "")
+	require.NoError(t, err)
+
+	cfg, err := input.Config(machinetype.TypeControlPlane)
+	require.NoError(t, err)
+
+	require.True(t, cfg.Machine().Features().RBACEnabled())
+
+	errReboot := errors.New("this config change can't be applied in immediate mode")
+
+	for _, test := range []struct {
+		name              string
+		request           *machine.FeatureFlagSetRequest
+		canApplyImmediate func(config.Provider) error
+
+		expectedCode    codes.Code
+		expectedFlag    *machine.FeatureFlag
+		expectUnchanged bool
+	}{
+		{
+			name:    "unknown flag",
+			request: &machine.FeatureFlagSetRequest{Name: "unknown", Enabled: true},
+
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:    "unchanged",
+			request: &machine.FeatureFlagSetRequest{Name: "rbac", Enabled: true},
+
+			expectedFlag:    &machine.FeatureFlag{Name: "rbac", Enabled: true, Mutable: true},
+			expectUnchanged: true,
+		},
+		{
+			name:    "unchanged immutable",
+			request: &machine.FeatureFlagSetRequest{Name: "rbac", Enabled: true},
+			canApplyImmediate: func(cfg config.Provider) error {
+				if !cfg.Machine().Features().RBACEnabled() {
+					return errReboot
+				}
+
+				return nil
+			},
+
+			expectedFlag:    &machine.FeatureFlag{Name: "rbac", Enabled: true, Mutable: false},
+			expectUnchanged: true,
+		},
+		{
+			name:    "requires reboot",
+			request: &machine.FeatureFlagSetRequest{Name: "rbac", Enabled: false},
+			canApplyImmediate: func(cfg config.Provider) error {
+				if !cfg.Machine().Features().RBACEnabled() {
+					return errReboot
+				}
+
+				return nil
+			},
+
+			expectedCode: codes.FailedPrecondition,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &runtime.Server{
+				Controller: &mockController{
+					runtime: &mockRuntime{
+						config:            cfg,
+						canApplyImmediate: test.canApplyImmediate,
+					},
+				},
+			}
+
+			resp, err := server.FeatureFlagSet(context.Background(), test.request)
+
+			if test.expectedCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedCode, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Messages, 1)
+
+			assert.Equal(t, test.expectUnchanged, resp.Messages[0].Unchanged)
+			assert.Equal(t, test.expectedFlag.Name, resp.Messages[0].Flag.Name)
+			assert.Equal(t, test.expectedFlag.Enabled, resp.Messages[0].Flag.Enabled)
+			assert.Equal(t, test.expectedFlag.Mutable, resp.Messages[0].Flag.Mutable)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/fstrim"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// FilesystemTrim implements the machine.MachineServer interface.
+func (s *Server) FilesystemTrim(ctx context.Context, req *machine.FilesystemTrimRequest) (*machine.FilesystemTrimResponse, error) {
+	mode := s.Controller.Runtime().State().Platform().Mode()
+
+	if mode.InContainer() {
+		return nil, status.Errorf(codes.FailedPrecondition, "method is not supported in %s mode", mode.String())
+	}
+
+	results, err := fstrim.TrimAll(ctx, req.GetIncludeEncrypted())
+	if err != nil {
+		return nil, fmt.Errorf("failed to trim filesystems: %w", err)
+	}
+
+	filesystems := make([]*machine.FilesystemTrimEvent, 0, len(results))
+
+	for _, result := range results {
+		event := result.Event()
+
+		s.Controller.Runtime().Events().Publish(ctx, event)
+
+		filesystems = append(filesystems, event)
+	}
+
+	return &machine.FilesystemTrimResponse{
+		Messages: []*machine.FilesystemTrim{
+			{
+				Filesystems: filesystems,
+			},
+		},
+	}, nil
+}
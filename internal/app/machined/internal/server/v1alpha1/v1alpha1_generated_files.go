@@ -0,0 +1,130 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/pkg/archiver"
+	"github.com/siderolabs/talos/pkg/chunker/stream"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/files"
+	"github.com/siderolabs/talos/pkg/redact"
+)
+
+// generatedFiles is the list of the files generated by Talos outside of the EtcFileSpec resources.
+var generatedFiles = []string{
+	filepath.Join(constants.KubernetesConfigBaseDir, "kubelet.yaml"),
+	constants.KubeletCredentialProviderConfig,
+}
+
+// GeneratedFiles implements the machine.MachineServer interface.
+func (s *Server) GeneratedFiles(_ *emptypb.Empty, obj machine.MachineService_GeneratedFilesServer) error {
+	ctx, ctxCancel := context.WithCancel(obj.Context())
+	defer ctxCancel()
+
+	paths, err := s.generatedFilePaths(ctx)
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		//nolint:errcheck
+		defer pw.Close()
+
+		errCh <- generatedFilesTarGz(ctx, paths, pw)
+	}()
+
+	chunker := stream.NewChunker(ctx, pr)
+	chunkCh := chunker.Read()
+
+	for data := range chunkCh {
+		if err = obj.SendMsg(&common.Data{Bytes: data}); err != nil {
+			ctxCancel()
+		}
+	}
+
+	if archiveErr := <-errCh; archiveErr != nil {
+		return obj.SendMsg(&common.Data{
+			Metadata: &common.Metadata{
+				Error: archiveErr.Error(),
+			},
+		})
+	}
+
+	return nil
+}
+
+// generatedFilePaths returns the sorted list of paths of the generated files.
+func (s *Server) generatedFilePaths(ctx context.Context) ([]string, error) {
+	etcFiles, err := safe.StateListAll[*files.EtcFileSpec](ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
+	if err != nil {
+		return nil, fmt.Errorf("error listing etc files: %w", err)
+	}
+
+	paths := slices.Clone(generatedFiles)
+
+	for iter := etcFiles.Iterator(); iter.Next(); {
+		paths = append(paths, filepath.Join("/etc", iter.Value().Metadata().ID()))
+	}
+
+	slices.Sort(paths)
+
+	return slices.Compact(paths), nil
+}
+
+// generatedFilesTarGz produces .tar.gz archive of the files with the secrets redacted, missing files are skipped.
+func generatedFilesTarGz(ctx context.Context, paths []string, output io.Writer) error {
+	items := make(chan archiver.FileItem)
+
+	go func() {
+		defer close(items)
+
+		for _, path := range paths {
+			fi, err := os.Stat(path)
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			select {
+			case items <- archiver.FileItem{
+				FullPath: path,
+				RelPath:  strings.TrimPrefix(path, "/"),
+				FileInfo: fi,
+				Error:    err,
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	zw := gzip.NewWriter(output)
+	//nolint:errcheck
+	defer zw.Close()
+
+	if err := archiver.Tar(ctx, items, zw, archiver.WithContentsFilter(redact.Bytes)); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/internal/pkg/nodehealth"
+	healthapi "github.com/siderolabs/talos/pkg/machinery/api/health"
+)
+
+// HealthServer implements HealthService API.
+type HealthServer struct {
+	healthapi.UnimplementedHealthServiceServer
+
+	checks    *nodehealth.Registry
+	resources state.State
+}
+
+// List implements health.HealthService interface.
+func (s *HealthServer) List(ctx context.Context, in *healthapi.ListRequest) (*healthapi.ListResponse, error) {
+	return &healthapi.ListResponse{
+		Messages: []*healthapi.List{
+			{
+				Checks: xslices.Map(s.checks.Checks(), func(check nodehealth.Check) *healthapi.CheckInfo {
+					return &healthapi.CheckInfo{
+						Name:        check.Name,
+						Description: check.Description,
+					}
+				}),
+			},
+		},
+	}, nil
+}
+
+// Check implements health.HealthService interface.
+func (s *HealthServer) Check(in *healthapi.CheckRequest, srv healthapi.HealthService_CheckServer) error {
+	checks := s.checks.Checks()
+
+	for _, name := range in.GetChecks() {
+		if !slices.ContainsFunc(checks, func(check nodehealth.Check) bool { return check.Name == name }) {
+			return status.Errorf(codes.InvalidArgument, "unknown check %q", name)
+		}
+	}
+
+	node := nodehealth.Node{
+		Resources: s.resources,
+	}
+
+	return s.checks.Run(srv.Context(), node, in.GetChecks(), func(check nodehealth.Check, result nodehealth.Result, duration time.Duration) error {
+		return srv.Send(&healthapi.CheckResult{
+			Name:        check.Name,
+			Description: check.Description,
+			Status:      checkStatus(result.Status),
+			Message:     result.Message,
+			Remediation: result.Remediation,
+			Duration:    durationpb.New(duration),
+		})
+	})
+}
+
+func checkStatus(s nodehealth.Status) healthapi.CheckResult_Status {
+	switch s {
+	case nodehealth.StatusPass:
+		return healthapi.CheckResult_PASS
+	case nodehealth.StatusWarn:
+		return healthapi.CheckResult_WARN
+	case nodehealth.StatusSkip:
+		return healthapi.CheckResult_SKIP
+	case nodehealth.StatusFail:
+		return healthapi.CheckResult_FAIL
+	default:
+		return healthapi.CheckResult_FAIL
+	}
+}
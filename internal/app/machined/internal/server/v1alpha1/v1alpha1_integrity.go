@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/internal/pkg/integrity"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// RootfsIntegrity implements the machine.MachineServer interface.
+func (s *Server) RootfsIntegrity(ctx context.Context, _ *emptypb.Empty) (*machine.RootfsIntegrityResponse, error) {
+	result, err := integrity.VerifyRootfs(ctx, integrity.DefaultOptions())
+	if err != nil {
+		return nil, fmt.Errorf("error verifying rootfs integrity: %w", err)
+	}
+
+	msg := &machine.RootfsIntegrity{
+		Device:         result.Device,
+		ExpectedSha256: result.Expected,
+		ActualSha256:   result.Actual,
+	}
+
+	switch result.Status {
+	case integrity.StatusVerified:
+		msg.Status = machine.RootfsIntegrity_VERIFIED
+	case integrity.StatusMismatch:
+		msg.Status = machine.RootfsIntegrity_MISMATCH
+	case integrity.StatusUnavailable:
+		msg.Status = machine.RootfsIntegrity_UNAVAILABLE
+	}
+
+	if result.Error != nil {
+		msg.Reason = result.Error.Error()
+	}
+
+	return &machine.RootfsIntegrityResponse{
+		Messages: []*machine.RootfsIntegrity{msg},
+	}, nil
+}
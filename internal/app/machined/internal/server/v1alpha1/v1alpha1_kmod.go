@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/kmodparams"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// KernelModuleParameterSet implements the machine.MachineServer interface.
+func (s *Server) KernelModuleParameterSet(ctx context.Context, req *machine.KernelModuleParameterSetRequest) (*machine.KernelModuleParameterSetResponse, error) {
+	mode := s.Controller.Runtime().State().Platform().Mode()
+
+	if mode.InContainer() {
+		return nil, status.Errorf(codes.FailedPrecondition, "method is not supported in %s mode", mode.String())
+	}
+
+	if req.GetModule() == "" || req.GetParameter() == "" {
+		return nil, status.Error(codes.InvalidArgument, "module and parameter should be set")
+	}
+
+	previous, err := kmodparams.Set(kmodparams.SysfsPath, req.GetModule(), req.GetParameter(), req.GetValue())
+	if err != nil {
+		switch {
+		case errors.Is(err, kmodparams.ErrModuleNotLoaded), errors.Is(err, kmodparams.ErrParameterNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, kmodparams.ErrReadOnly):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			// the kernel rejects invalid values on write
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// read back the value, as the kernel might adjust it
+	current, err := kmodparams.Get(kmodparams.SysfsPath, req.GetModule(), req.GetParameter())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("kernel module parameter %s.%s changed from %q to %q", req.GetModule(), previous.Name, previous.Value, current.Value)
+
+	return &machine.KernelModuleParameterSetResponse{
+		Messages: []*machine.KernelModuleParameterSet{
+			{
+				PreviousValue: previous.Value,
+				Value:         current.Value,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// LogRecords implements the machine.MachineServer interface.
+func (s *Server) LogRecords(req *machine.LogRecordsRequest, srv machine.MachineService_LogRecordsServer) error {
+	filter, err := logRecordFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	service := req.Namespace == constants.SystemContainerdNamespace || req.Id == "kubelet"
+
+	var r io.ReadCloser
+
+	if service {
+		var options []runtime.LogOption
+
+		if req.Follow {
+			options = append(options, runtime.WithFollow())
+		}
+
+		if req.TailLines >= 0 {
+			options = append(options, runtime.WithTailLines(int(req.TailLines)))
+		}
+
+		r, err = s.Controller.Runtime().Logging().ServiceLog(req.Id).Reader(options...)
+	} else {
+		r, err = containerLogReader(srv.Context(), req)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer r.Close()
+
+	facility := "daemon"
+	if !service {
+		facility = "user"
+	}
+
+	return logging.ReadRecords(r, !service, func(e *runtime.LogEvent) error {
+		if !filter.Match(e) {
+			return nil
+		}
+
+		return srv.Send(logRecord(e, facility))
+	})
+}
+
+// containerLogReader opens the container log as a reader.
+func containerLogReader(ctx context.Context, req *machine.LogRecordsRequest) (io.ReadCloser, error) {
+	chunk, file, err := k8slogs(ctx, &machine.LogsRequest{
+		Namespace: req.Namespace,
+		Id:        req.Id,
+		Driver:    req.Driver,
+		Follow:    req.Follow,
+		TailLines: req.TailLines,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		for data := range chunk.Read() {
+			if _, err := pw.Write(data); err != nil {
+				return
+			}
+		}
+
+		pw.Close() //nolint:errcheck
+	}()
+
+	return &chunkReader{PipeReader: pr, file: file}, nil
+}
+
+// chunkReader reads the data from the chunker and closes the underlying file on close.
+type chunkReader struct {
+	*io.PipeReader
+
+	file io.Closer
+}
+
+func (r *chunkReader) Close() error {
+	r.PipeReader.Close() //nolint:errcheck
+
+	return r.file.Close()
+}
+
+func logRecordFilter(req *machine.LogRecordsRequest) (logging.RecordFilter, error) {
+	var filter logging.RecordFilter
+
+	switch req.MinLevel {
+	case machine.LogRecord_DEBUG:
+		filter.MinLevel = zapcore.DebugLevel
+	case machine.LogRecord_INFO:
+		filter.MinLevel = zapcore.InfoLevel
+	case machine.LogRecord_WARN:
+		filter.MinLevel = zapcore.WarnLevel
+	case machine.LogRecord_ERROR:
+		filter.MinLevel = zapcore.ErrorLevel
+	case machine.LogRecord_FATAL:
+		filter.MinLevel = zapcore.DPanicLevel
+	default:
+		return filter, fmt.Errorf("unsupported log level %s", req.MinLevel)
+	}
+
+	if req.Pattern != "" {
+		re, err := regexp.Compile(req.Pattern)
+		if err != nil {
+			return filter, fmt.Errorf("invalid pattern: %w", err)
+		}
+
+		filter.Pattern = re
+	}
+
+	if req.Since != nil {
+		filter.Since = req.Since.AsTime()
+	}
+
+	if req.Until != nil {
+		filter.Until = req.Until.AsTime()
+	}
+
+	return filter, nil
+}
+
+func logRecord(e *runtime.LogEvent, facility string) *machine.LogRecord {
+	record := &machine.LogRecord{
+		Timestamp: timestamppb.New(e.Time),
+		Facility:  facility,
+		Message:   e.Msg,
+	}
+
+	switch {
+	case e.Level <= zapcore.DebugLevel:
+		record.Level = machine.LogRecord_DEBUG
+	case e.Level == zapcore.InfoLevel:
+		record.Level = machine.LogRecord_INFO
+	case e.Level == zapcore.WarnLevel:
+		record.Level = machine.LogRecord_WARN
+	case e.Level == zapcore.ErrorLevel:
+		record.Level = machine.LogRecord_ERROR
+	default:
+		record.Level = machine.LogRecord_FATAL
+	}
+
+	if len(e.Fields) > 0 {
+		record.Fields = make(map[string]string, len(e.Fields))
+
+		for k, v := range e.Fields {
+			record.Fields[k] = fmt.Sprint(v)
+		}
+	}
+
+	return record
+}
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+)
+
+type mockController struct {
+	v1alpha1runtime.Controller
+
+	runtime *mockRuntime
+}
+
+func (c *mockController) Runtime() v1alpha1runtime.Runtime {
+	return c.runtime
+}
+
+type mockRuntime struct {
+	v1alpha1runtime.Runtime
+
+	config            config.Provider
+	canApplyImmediate func(config.Provider) error
+}
+
+func (r *mockRuntime) Config() config.Config {
+	return r.config
+}
+
+func (r *mockRuntime) ConfigContainer() config.Container {
+	return r.config
+}
+
+func (r *mockRuntime) CanApplyImmediate(cfg config.Provider) error {
+	if r.canApplyImmediate == nil {
+		return nil
+	}
+
+	return r.canApplyImmediate(cfg)
+}
+
+func (r *mockRuntime) State() v1alpha1runtime.State {
+	return mockState{}
+}
+
+type mockState struct {
+	v1alpha1runtime.State
+}
+
+func (mockState) Platform() v1alpha1runtime.Platform {
+	return mockPlatform{}
+}
+
+func (mockState) Machine() v1alpha1runtime.MachineState {
+	return mockMachineState{}
+}
+
+type mockPlatform struct {
+	v1alpha1runtime.Platform
+}
+
+func (mockPlatform) Mode() v1alpha1runtime.Mode {
+	return v1alpha1runtime.ModeMetal
+}
+
+type mockMachineState struct {
+	v1alpha1runtime.MachineState
+}
+
+func (mockMachineState) Installed() bool {
+	return true
+}
@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
+)
+
+// maxAttestationNonceSize is the maximum size of the TPM quote qualifying data (the size of the largest digest).
+const maxAttestationNonceSize = 64
+
+// SecurityServer implements SecurityService API.
+//
+// Only the Attest method is served by machined, the Certificate method is served by trustd.
+type SecurityServer struct {
+	securityapi.UnimplementedSecurityServiceServer
+}
+
+// Attest implements the securityapi.SecurityServiceServer interface.
+func (s *SecurityServer) Attest(ctx context.Context, in *securityapi.AttestRequest) (*securityapi.AttestResponse, error) {
+	nonce := in.GetNonce()
+
+	if len(nonce) > maxAttestationNonceSize {
+		return nil, status.Errorf(codes.InvalidArgument, "nonce should not exceed %d bytes", maxAttestationNonceSize)
+	}
+
+	if len(nonce) == 0 {
+		nonce = make([]byte, 32)
+
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, fmt.Errorf("error generating nonce: %w", err)
+		}
+	}
+
+	quote, err := tpm2.Quote(nonce)
+	if err != nil {
+		if os.IsNotExist(err) || strings.Contains(err.Error(), "device is not a TPM 2.0") {
+			return nil, status.Error(codes.FailedPrecondition, "TPM device is not available")
+		}
+
+		return nil, fmt.Errorf("error generating TPM quote: %w", err)
+	}
+
+	return &securityapi.AttestResponse{
+		Messages: []*securityapi.Attestation{
+			{
+				Nonce:          quote.Nonce,
+				Quote:          quote.Quote,
+				Signature:      quote.Signature,
+				AttestationKey: quote.AttestationKey,
+				PcrValues:      quote.PCRValues,
+				EventLogDigest: quote.EventLogDigest,
+			},
+		},
+	}, nil
+}
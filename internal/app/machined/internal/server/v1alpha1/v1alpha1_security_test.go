@@ -0,0 +1,26 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	runtime "github.com/siderolabs/talos/internal/app/machined/internal/server/v1alpha1"
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
+)
+
+func TestAttestNonceTooLong(t *testing.T) {
+	t.Parallel()
+
+	server := &runtime.SecurityServer{}
+
+	_, err := server.Attest(context.Background(), &securityapi.AttestRequest{Nonce: make([]byte, 65)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
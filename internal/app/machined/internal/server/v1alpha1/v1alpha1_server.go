@@ -10,16 +10,19 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
 	"net"
 	"os"
 	"path/filepath"
 	"slices"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -46,7 +49,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
@@ -54,29 +59,39 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
 	"github.com/siderolabs/talos/internal/app/resources"
 	storaged "github.com/siderolabs/talos/internal/app/storaged"
+	"github.com/siderolabs/talos/internal/pkg/cgroups"
 	"github.com/siderolabs/talos/internal/pkg/configuration"
 	"github.com/siderolabs/talos/internal/pkg/containers"
 	taloscontainerd "github.com/siderolabs/talos/internal/pkg/containers/containerd"
 	"github.com/siderolabs/talos/internal/pkg/containers/cri"
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
 	"github.com/siderolabs/talos/internal/pkg/etcd"
 	"github.com/siderolabs/talos/internal/pkg/install"
 	"github.com/siderolabs/talos/internal/pkg/miniprocfs"
+	"github.com/siderolabs/talos/internal/pkg/nodehealth"
 	"github.com/siderolabs/talos/internal/pkg/partition"
 	"github.com/siderolabs/talos/internal/pkg/pcap"
+	"github.com/siderolabs/talos/internal/pkg/secretsstore"
+	"github.com/siderolabs/talos/internal/pkg/stagedconfig"
 	"github.com/siderolabs/talos/pkg/archiver"
 	"github.com/siderolabs/talos/pkg/chunker"
 	"github.com/siderolabs/talos/pkg/chunker/stream"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/audit"
 	"github.com/siderolabs/talos/pkg/kubeconfig"
+	auditapi "github.com/siderolabs/talos/pkg/machinery/api/audit"
 	"github.com/siderolabs/talos/pkg/machinery/api/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	healthapi "github.com/siderolabs/talos/pkg/machinery/api/health"
 	"github.com/siderolabs/talos/pkg/machinery/api/inspect"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
 	"github.com/siderolabs/talos/pkg/machinery/api/storage"
 	timeapi "github.com/siderolabs/talos/pkg/machinery/api/time"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configdiff"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
 	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -88,6 +103,7 @@ import (
 	timeresource "github.com/siderolabs/talos/pkg/machinery/resources/time"
 	"github.com/siderolabs/talos/pkg/machinery/role"
 	"github.com/siderolabs/talos/pkg/machinery/version"
+	"github.com/siderolabs/talos/pkg/redact"
 )
 
 // MinimumEtcdUpgradeLeaseLockSeconds indicates the minimum number of seconds for which we open a lease lock for upgrading Etcd nodes.
@@ -99,6 +115,15 @@ const MinimumEtcdUpgradeLeaseLockSeconds = 60
 // OSPathSeparator is the string version of the os.PathSeparator.
 const OSPathSeparator = string(os.PathSeparator)
 
+// maxEtcdLatencySamples is the maximum number of requests sent to each etcd member to measure the latency.
+const maxEtcdLatencySamples = 100
+
+// Sampling intervals of the stats streaming APIs.
+const (
+	defaultStatsInterval = time.Second
+	minStatsInterval     = 100 * time.Millisecond
+)
+
 // Server implements ClusterService and MachineService APIs
 // and is also responsible for registering ResourceServer and InspectServer.
 type Server struct {
@@ -109,10 +134,19 @@ type Server struct {
 	// breaking the import loop cycle between services/ package and v1alpha1_server.go
 	EtcdBootstrapper func(context.Context, runtime.Runtime, *machine.BootstrapRequest) error
 
+	// AuditLog keeps the most recent API calls recorded by the audit middleware.
+	AuditLog *audit.Log
+
+	// HealthChecks are the node health checks run by the health API.
+	HealthChecks *nodehealth.Registry
+
 	// ShutdownCtx signals that the server is shutting down.
 	ShutdownCtx context.Context //nolint:containedctx
 
 	server *grpc.Server
+
+	// diskBenchmarkMu prevents the concurrent disk benchmarks which would skew each other's results.
+	diskBenchmarkMu sync.Mutex
 }
 
 func (s *Server) checkSupported(feature runtime.ModeCapability) error {
@@ -125,6 +159,53 @@ func (s *Server) checkSupported(feature runtime.ModeCapability) error {
 	return nil
 }
 
+// checkEtcdQuorum refuses the operation if the control plane node going down (or leaving etcd, if leave is set) breaks the etcd quorum.
+//
+// The check is skipped on the nodes which are not etcd members.
+func (s *Server) checkEtcdQuorum(ctx context.Context, operation string, leave bool) error {
+	if s.Controller.Runtime().Config() == nil || s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeWorker {
+		return nil
+	}
+
+	resources := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	member, err := safe.ReaderGetByID[*etcdresource.Member](ctx, resources, etcdresource.LocalMemberID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get local etcd member: %w", err)
+	}
+
+	localMemberID, err := etcdresource.ParseMemberID(member.TypedSpec().MemberID)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, etcd.QuorumCheckTimeout)
+	defer cancel()
+
+	etcdClient, err := etcd.NewClientFromControlPlaneIPs(ctx, resources)
+	if err != nil {
+		return fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer etcdClient.Close()
+
+	if err = etcdClient.ValidateQuorumImpact(ctx, operation, localMemberID, leave); err != nil {
+		// quorum errors carry the gRPC status with the details of the etcd cluster state
+		if status.Code(err) == codes.FailedPrecondition {
+			return err
+		}
+
+		return status.Errorf(codes.FailedPrecondition, "failed to check etcd quorum, use --force to skip the check: %s", err)
+	}
+
+	return nil
+}
+
 func (s *Server) checkControlplane(apiName string) error {
 	switch s.Controller.Runtime().Config().Machine().Type() { //nolint:exhaustive
 	case machinetype.TypeControlPlane:
@@ -142,7 +223,7 @@ func (s *Server) Register(obj *grpc.Server) {
 
 	// wrap resources with access filter
 	resourceState := s.Controller.Runtime().State().V1Alpha2().Resources()
-	resourceState = state.WrapCore(state.Filter(resourceState, resources.AccessPolicy(resourceState)))
+	resourceState = state.WrapCore(resources.WithListSorting(resources.WithConditionalGet(resources.WithoutOwner(state.Filter(resourceState, resources.AccessPolicy(resourceState))))))
 
 	machine.RegisterMachineServiceServer(obj, s)
 	cluster.RegisterClusterServiceServer(obj, s)
@@ -150,6 +231,18 @@ func (s *Server) Register(obj *grpc.Server) {
 	inspect.RegisterInspectServiceServer(obj, &InspectServer{server: s})
 	storage.RegisterStorageServiceServer(obj, &storaged.Server{Controller: s.Controller})
 	timeapi.RegisterTimeServiceServer(obj, &TimeServer{ConfigProvider: s.Controller.Runtime()})
+	securityapi.RegisterSecurityServiceServer(obj, &SecurityServer{})
+
+	if s.AuditLog != nil {
+		auditapi.RegisterAuditServiceServer(obj, &AuditServer{log: s.AuditLog})
+	}
+
+	if s.HealthChecks != nil {
+		healthapi.RegisterHealthServiceServer(obj, &HealthServer{
+			checks:    s.HealthChecks,
+			resources: s.Controller.Runtime().State().V1Alpha2().Resources(),
+		})
+	}
 }
 
 // modeWrapper overrides RequiresInstall() based on actual installed status.
@@ -174,11 +267,34 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		s.Controller.Runtime().CancelConfigRollbackTimeout()
 	}
 
-	cfgProvider, err := configloader.NewFromBytes(in.GetData())
+	var (
+		cfgProvider config.Provider
+		err         error
+	)
+
+	if len(in.GetPatches()) > 0 {
+		cfgProvider, err = s.patchConfiguration(in)
+	} else {
+		cfgProvider, err = configloader.NewFromBytes(in.GetData())
+	}
+
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	var secretsBundle *secrets.Bundle
+
+	if len(in.GetSecretsBundle()) > 0 {
+		if secretsBundle, err = secretsstore.DecodeBundle(in.GetSecretsBundle()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// the secrets omitted from the config are filled from the provided bundle or from the node secrets store
+	if cfgProvider, err = secretsstore.Default().Resolve(cfgProvider, secretsBundle); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	warnings, err := cfgProvider.Validate(
 		modeWrapper{
 			Mode:      s.Controller.Runtime().State().Platform().Mode(),
@@ -224,13 +340,23 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 			return nil, fmt.Errorf("failed to generate diff: %w", err)
 		}
 
+		changes, err := configdiff.Compute(s.Controller.Runtime().ConfigContainer(), cfgProvider)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute config changes: %w", err)
+		}
+
 		return &machine.ApplyConfigurationResponse{
 			Messages: []*machine.ApplyConfiguration{
 				{
-					Mode: in.Mode,
+					Mode:     in.Mode,
+					Warnings: warnings,
 					ModeDetails: fmt.Sprintf(`Dry run summary:
 %s (skipped in dry-run).
 %s`, modeDetails, details),
+					RebootRequired: !changes.Empty() && s.Controller.Runtime().CanApplyImmediate(cfgProvider) != nil,
+					AddedPaths:     changes.Added,
+					RemovedPaths:   changes.Removed,
+					ChangedPaths:   changes.Changed,
 				},
 			},
 		}, nil
@@ -243,8 +369,26 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		return nil, err
 	}
 
+	if secretsBundle != nil {
+		if err = secretsstore.Default().Save(cfgProvider.SecretsReference().SecretsBundleID(), secretsBundle); err != nil {
+			return nil, fmt.Errorf("error saving secrets bundle: %w", err)
+		}
+	}
+
 	if in.Mode != machine.ApplyConfigurationRequest_TRY {
-		if err := os.WriteFile(constants.ConfigPath, cfg, 0o600); err != nil {
+		var rollbackTimeout time.Duration
+
+		if in.Mode == machine.ApplyConfigurationRequest_STAGED {
+			rollbackTimeout = constants.ConfigStagedRollbackTimeout
+
+			modeDetails += fmt.Sprintf("\nThe config is rolled back automatically if the machine doesn't become ready within %s after the reboot", rollbackTimeout)
+		}
+
+		if err := stagedconfig.Default().Save(cfg, rollbackTimeout); err != nil {
+			return nil, err
+		}
+
+		if err := s.updateStagedConfigStatus(ctx, in.Mode, cfgProvider); err != nil {
 			return nil, err
 		}
 	}
@@ -296,6 +440,36 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 	}, nil
 }
 
+// patchConfiguration applies the patches from the request to the current machine configuration.
+func (s *Server) patchConfiguration(in *machine.ApplyConfigurationRequest) (config.Provider, error) {
+	if len(in.GetData()) > 0 {
+		return nil, errors.New("configuration data and patches are mutually exclusive")
+	}
+
+	currentCfg, err := s.Controller.Runtime().ConfigContainer().Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode current configuration: %w", err)
+	}
+
+	patches := make([]configpatcher.Patch, 0, len(in.GetPatches()))
+
+	for _, data := range in.GetPatches() {
+		patch, err := configpatcher.LoadPatch(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load patch: %w", err)
+		}
+
+		patches = append(patches, patch)
+	}
+
+	out, err := configpatcher.Apply(configpatcher.WithBytes(currentCfg), patches)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply patches: %w", err)
+	}
+
+	return out.Config()
+}
+
 func generateDiff(r runtime.Runtime, provider config.Provider) (string, error) {
 	documentsDiff, err := configdiff.DiffToString(r.ConfigContainer(), provider)
 	if err != nil {
@@ -438,6 +612,12 @@ func (s *Server) Shutdown(ctx context.Context, in *machine.ShutdownRequest) (rep
 		return nil, err
 	}
 
+	if !in.GetForce() {
+		if err = s.checkEtcdQuorum(ctx, "shutdown", false); err != nil {
+			return nil, err
+		}
+	}
+
 	shutdownCtx := context.WithValue(context.Background(), runtime.ActorIDCtxKey{}, actorID)
 
 	go func() {
@@ -475,10 +655,27 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (*mach
 
 	log.Printf("validating %q", in.GetImage())
 
-	if err := install.PullAndValidateInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), in.GetImage()); err != nil {
+	if err := install.PullAndValidateInstallerImage(
+		ctx,
+		s.Controller.Runtime().Config().Machine().Registries(),
+		in.GetImage(),
+		image.WithRateLimit(install.PullRateLimitFromUpgradeRequest(s.Controller.Runtime(), in)),
+	); err != nil {
 		return nil, fmt.Errorf("error validating installer image %q: %w", in.GetImage(), err)
 	}
 
+	pinnedImage, verifiedSignatures, err := s.verifyInstallerImage(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	// upgrade to exactly the verified image, even if the tag is moved in the meantime
+	if pinnedImage != "" {
+		log.Printf("pinned verified installer image %q to %q", in.GetImage(), pinnedImage)
+
+		in.Image = pinnedImage
+	}
+
 	if s.Controller.Runtime().Config().Machine().Type() != machinetype.TypeWorker && !in.GetForce() {
 		etcdClient, err := etcd.NewClientFromControlPlaneIPs(ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
 		if err != nil {
@@ -494,6 +691,10 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (*mach
 		// unlock the mutex once the API call is done, as it protects only pre-upgrade checks
 		defer unlocker()
 
+		if err = s.checkEtcdQuorum(ctx, "upgrade", false); err != nil {
+			return nil, err
+		}
+
 		if err = etcdClient.ValidateForUpgrade(ctx, s.Controller.Runtime().Config()); err != nil {
 			return nil, fmt.Errorf("error validating etcd for upgrade: %w", err)
 		}
@@ -546,13 +747,57 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (*mach
 	return &machine.UpgradeResponse{
 		Messages: []*machine.Upgrade{
 			{
-				Ack:     "Upgrade request received",
-				ActorId: actorID,
+				Ack:                "Upgrade request received",
+				ActorId:            actorID,
+				VerifiedSignatures: verifiedSignatures,
 			},
 		},
 	}, nil
 }
 
+// verifyInstallerImage verifies the signatures of the installer image boot assets against the configured signing certificates.
+//
+// The reference of the verified image pinned to the digest is returned, it is empty if no signing certificates are configured.
+//
+// The verification is done by machined (and not by the installer), as the installer comes from the image being verified.
+func (s *Server) verifyInstallerImage(ctx context.Context, in *machine.UpgradeRequest) (string, []*machine.BootAssetSignature, error) {
+	signingCertificates := s.Controller.Runtime().Config().Machine().Install().SigningCertificates()
+	if len(signingCertificates) == 0 {
+		return "", nil, nil
+	}
+
+	certs := make([]*x509.Certificate, 0, len(signingCertificates))
+
+	for _, signingCertificate := range signingCertificates {
+		cert, err := signingCertificate.GetCert()
+		if err != nil {
+			return "", nil, fmt.Errorf("error parsing install signing certificate: %w", err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	log.Printf("verifying boot asset signatures of %q", in.GetImage())
+
+	pinnedImage, verified, err := install.VerifyInstallerImage(
+		ctx,
+		s.Controller.Runtime().Config().Machine().Registries(),
+		in.GetImage(),
+		certs,
+		image.WithRateLimit(install.PullRateLimitFromUpgradeRequest(s.Controller.Runtime(), in)),
+	)
+	if err != nil {
+		return "", nil, status.Errorf(codes.FailedPrecondition, "error verifying installer image %q signatures: %s", in.GetImage(), err)
+	}
+
+	return pinnedImage, xslices.Map(verified, func(asset install.VerifiedAsset) *machine.BootAssetSignature {
+		return &machine.BootAssetSignature{
+			Path:   asset.Path,
+			Signer: asset.Signer.Subject.String(),
+		}
+	}), nil
+}
+
 // ResetOptions implements runtime.ResetOptions interface.
 type ResetOptions struct {
 	*machine.ResetRequest
@@ -648,6 +893,13 @@ func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *ma
 		}
 	}
 
+	if !in.GetForce() {
+		// graceful reset leaves etcd, so the quorum is calculated for the cluster without the node
+		if err = s.checkEtcdQuorum(ctx, "reset", in.GetGraceful()); err != nil {
+			return nil, err
+		}
+	}
+
 	resetCtx := context.WithValue(context.Background(), runtime.ActorIDCtxKey{}, actorID)
 
 	go func() {
@@ -669,6 +921,56 @@ func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *ma
 	return reply, nil
 }
 
+// SequenceList implements the machine.MachineServer interface.
+func (s *Server) SequenceList(ctx context.Context, in *emptypb.Empty) (*machine.SequenceListResponse, error) {
+	sequences := xslices.Map(s.Controller.RunningSequences(), func(status runtime.SequenceStatus) *machine.SequenceStatus {
+		return &machine.SequenceStatus{
+			Sequence:    status.Sequence.String(),
+			ActorId:     status.ActorID,
+			Started:     timestamppb.New(status.Started),
+			Phase:       status.Phase,
+			PhaseNumber: int32(status.PhaseNumber),
+			TotalPhases: int32(status.TotalPhases),
+			Cancelable:  status.Cancelable,
+		}
+	})
+
+	return &machine.SequenceListResponse{
+		Messages: []*machine.SequenceList{
+			{
+				Sequences: sequences,
+			},
+		},
+	}, nil
+}
+
+// SequenceCancel implements the machine.MachineServer interface.
+func (s *Server) SequenceCancel(ctx context.Context, in *machine.SequenceCancelRequest) (*machine.SequenceCancelResponse, error) {
+	seq, err := runtime.ParseSequence(in.GetSequence())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = s.Controller.CancelSequence(seq); err != nil {
+		switch {
+		case errors.Is(err, runtime.ErrSequenceNotRunning):
+			return nil, status.Errorf(codes.NotFound, "%s: %s", seq, err)
+		case errors.Is(err, runtime.ErrSequenceNotCancelable):
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: %s", seq, err)
+		default:
+			return nil, err
+		}
+	}
+
+	log.Printf("%s sequence canceled via API", seq)
+
+	return &machine.SequenceCancelResponse{
+		Messages: []*machine.SequenceCancel{
+			{},
+		},
+	}, nil
+}
+
 // ServiceList returns list of the registered services and their status.
 func (s *Server) ServiceList(ctx context.Context, in *emptypb.Empty) (result *machine.ServiceListResponse, err error) {
 	services := system.Services(s.Controller.Runtime()).List()
@@ -757,7 +1059,13 @@ func (s *Server) Copy(req *machine.CopyRequest, obj machine.MachineService_CopyS
 	go func() {
 		//nolint:errcheck
 		defer pw.Close()
-		errCh <- archiver.TarGz(ctx, path, pw)
+		if !req.Redact {
+			errCh <- archiver.TarGz(ctx, path, pw)
+
+			return
+		}
+
+		errCh <- redactedTarGz(ctx, path, pw)
 	}()
 
 	chunker := stream.NewChunker(ctx, pr)
@@ -782,6 +1090,24 @@ func (s *Server) Copy(req *machine.CopyRequest, obj machine.MachineService_CopyS
 	return nil
 }
 
+// redactedTarGz produces .tar.gz archive of filesystem starting at rootPath with the secrets redacted from the file contents.
+func redactedTarGz(ctx context.Context, rootPath string, output io.Writer) error {
+	paths, err := archiver.Walker(ctx, rootPath, archiver.WithSkipRoot())
+	if err != nil {
+		return err
+	}
+
+	zw := gzip.NewWriter(output)
+	//nolint:errcheck
+	defer zw.Close()
+
+	if err = archiver.Tar(ctx, paths, zw, archiver.WithContentsFilter(redact.Bytes)); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
 // List implements the machine.MachineServer interface.
 //
 //nolint:gocyclo
@@ -1321,7 +1647,14 @@ func (s *Server) Read(in *machine.ReadRequest, srv machine.MachineService_ReadSe
 		ctx, cancel := context.WithCancel(srv.Context())
 		defer cancel()
 
-		chunker := stream.NewChunker(ctx, f)
+		var src stream.Source = f
+
+		if in.Redact {
+			// the file is closed by the deferred call above
+			src = io.NopCloser(redact.NewReader(f))
+		}
+
+		chunker := stream.NewChunker(ctx, src)
 		chunkCh := chunker.Read()
 
 		for data := range chunkCh {
@@ -1372,6 +1705,18 @@ func (s *Server) Events(req *machine.EventsRequest, l machine.MachineService_Eve
 		opts = append(opts, runtime.WithActorID(req.WithActorId))
 	}
 
+	if len(req.WithTypes) > 0 {
+		opts = append(opts, runtime.WithTypes(req.WithTypes...))
+	}
+
+	if req.Since != nil {
+		if err = req.Since.CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "error parsing since: %s", err)
+		}
+
+		opts = append(opts, runtime.WithSince(req.Since.AsTime()))
+	}
+
 	if err := s.Controller.Runtime().Events().Watch(func(events <-chan runtime.EventInfo) {
 		errCh <- func() error {
 			for {
@@ -1604,6 +1949,84 @@ func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_Dme
 
 // Processes implements the machine.MachineServer interface.
 func (s *Server) Processes(ctx context.Context, in *emptypb.Empty) (reply *machine.ProcessesResponse, err error) {
+	processes, err := listProcesses()
+	if err != nil {
+		return nil, err
+	}
+
+	reply = &machine.ProcessesResponse{
+		Messages: []*machine.Process{
+			{
+				Processes: processes,
+			},
+		},
+	}
+
+	return reply, nil
+}
+
+// ProcessesStream implements the machine.MachineServer interface.
+func (s *Server) ProcessesStream(in *machine.ProcessesStreamRequest, srv machine.MachineService_ProcessesStreamServer) error {
+	interval, err := statsInterval(in.GetInterval())
+	if err != nil {
+		return err
+	}
+
+	return s.streamSamples(srv.Context(), interval, func() error {
+		timestamp := time.Now()
+
+		processes, err := listProcesses()
+		if err != nil {
+			return err
+		}
+
+		return srv.Send(&machine.ProcessesSample{
+			Timestamp: timestamppb.New(timestamp),
+			Processes: processes,
+		})
+	})
+}
+
+// CgroupStats implements the machine.MachineServer interface.
+func (s *Server) CgroupStats(in *machine.CgroupStatsRequest, srv machine.MachineService_CgroupStatsServer) error {
+	interval, err := statsInterval(in.GetInterval())
+	if err != nil {
+		return err
+	}
+
+	if _, err = os.Stat(filepath.Join(constants.CgroupMountPath, filepath.Clean("/"+in.GetPath()))); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return status.Errorf(codes.NotFound, "cgroup %q not found", in.GetPath())
+		}
+
+		return err
+	}
+
+	return s.streamSamples(srv.Context(), interval, func() error {
+		timestamp := time.Now()
+
+		stats, err := cgroups.ReadStats(constants.CgroupMountPath, in.GetPath(), int(in.GetDepth()))
+		if err != nil {
+			return err
+		}
+
+		return srv.Send(&machine.CgroupStats{
+			Timestamp: timestamppb.New(timestamp),
+			Cgroups: xslices.Map(stats, func(stat cgroups.Stats) *machine.CgroupStat {
+				return &machine.CgroupStat{
+					Path:          stat.Path,
+					CpuUsageUsec:  stat.CPUUsageUsec,
+					MemoryCurrent: stat.MemoryCurrent,
+					IoReadBytes:   stat.IOReadBytes,
+					IoWriteBytes:  stat.IOWriteBytes,
+					PidsCurrent:   stat.PidsCurrent,
+				}
+			}),
+		})
+	})
+}
+
+func listProcesses() ([]*machine.ProcessInfo, error) {
 	var processes []*machine.ProcessInfo
 
 	procs, err := miniprocfs.NewProcesses()
@@ -1611,6 +2034,8 @@ func (s *Server) Processes(ctx context.Context, in *emptypb.Empty) (reply *machi
 		return nil, err
 	}
 
+	defer procs.Close() //nolint:errcheck
+
 	for {
 		info, err := procs.Next()
 		if err != nil {
@@ -1624,15 +2049,40 @@ func (s *Server) Processes(ctx context.Context, in *emptypb.Empty) (reply *machi
 		processes = append(processes, info)
 	}
 
-	reply = &machine.ProcessesResponse{
-		Messages: []*machine.Process{
-			{
-				Processes: processes,
-			},
-		},
+	return processes, nil
+}
+
+// statsInterval returns the sampling interval of the stats streaming APIs.
+func statsInterval(interval *durationpb.Duration) (time.Duration, error) {
+	if interval == nil {
+		return defaultStatsInterval, nil
 	}
 
-	return reply, nil
+	if d := interval.AsDuration(); d >= minStatsInterval {
+		return d, nil
+	}
+
+	return 0, status.Errorf(codes.InvalidArgument, "interval should be at least %s", minStatsInterval)
+}
+
+// streamSamples takes the samples at the interval until the client goes away or the server shuts down.
+func (s *Server) streamSamples(ctx context.Context, interval time.Duration, sample func() error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := sample(); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.ShutdownCtx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
 }
 
 // Memory implements the machine.MachineServer interface.
@@ -2105,6 +2555,121 @@ func (s *Server) EtcdStatus(ctx context.Context, in *emptypb.Empty) (*machine.Et
 	}, nil
 }
 
+// EtcdConsistencyCheck implements the machine.MachineServer interface.
+func (s *Server) EtcdConsistencyCheck(ctx context.Context, in *emptypb.Empty) (*machine.EtcdConsistencyCheckResponse, error) {
+	if err := s.checkControlplane("etcd consistency check"); err != nil {
+		return nil, err
+	}
+
+	client, err := etcd.NewLocalClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	report, err := client.CheckConsistency(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check etcd consistency: %w", err)
+	}
+
+	members := make([]*machine.EtcdMemberConsistency, 0, len(report.Members))
+
+	for _, member := range report.Members {
+		var memberErr string
+
+		if member.Err != nil {
+			memberErr = member.Err.Error()
+		}
+
+		members = append(members, &machine.EtcdMemberConsistency{
+			MemberId:         member.ID,
+			Hostname:         member.Name,
+			Revision:         member.Revision,
+			RaftAppliedIndex: member.RaftAppliedIndex,
+			Hash:             member.Hash,
+			CompactRevision:  member.CompactRevision,
+			RevisionLag:      member.RevisionLag,
+			Divergent:        member.Divergent,
+			Error:            memberErr,
+		})
+	}
+
+	return &machine.EtcdConsistencyCheckResponse{
+		Messages: []*machine.EtcdConsistencyCheck{
+			{
+				HashRevision: report.HashRevision,
+				Members:      members,
+			},
+		},
+	}, nil
+}
+
+// EtcdMemberLatency implements the machine.MachineServer interface.
+func (s *Server) EtcdMemberLatency(ctx context.Context, in *machine.EtcdMemberLatencyRequest) (*machine.EtcdMemberLatencyResponse, error) {
+	if err := s.checkControlplane("etcd member latency"); err != nil {
+		return nil, err
+	}
+
+	samples := int(in.GetSamples())
+
+	switch {
+	case samples == 0:
+		samples = etcd.DefaultLatencySamples
+	case samples < 0 || samples > maxEtcdLatencySamples:
+		return nil, status.Errorf(codes.InvalidArgument, "samples should be in range [1, %d]", maxEtcdLatencySamples)
+	}
+
+	client, err := etcd.NewLocalClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	memberID, err := client.GetMemberID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get etcd member ID: %w", err)
+	}
+
+	latencies, err := client.MeasureLatency(ctx, samples)
+	if err != nil {
+		return nil, fmt.Errorf("failed to measure etcd member latency: %w", err)
+	}
+
+	reply := &machine.EtcdMemberLatency{
+		MemberId: memberID,
+		Members:  make([]*machine.EtcdMemberLatencyStats, 0, len(latencies)),
+	}
+
+	for _, latency := range latencies {
+		if latency.ID == memberID {
+			reply.Hostname = latency.Name
+		}
+
+		stats := &machine.EtcdMemberLatencyStats{
+			MemberId: latency.ID,
+			Hostname: latency.Name,
+		}
+
+		if latency.Err != nil {
+			stats.Error = latency.Err.Error()
+		} else {
+			stats.Min = durationpb.New(latency.Min)
+			stats.Avg = durationpb.New(latency.Avg)
+			stats.Max = durationpb.New(latency.Max)
+		}
+
+		reply.Members = append(reply.Members, stats)
+	}
+
+	return &machine.EtcdMemberLatencyResponse{
+		Messages: []*machine.EtcdMemberLatency{reply},
+	}, nil
+}
+
 // GenerateClientConfiguration implements the machine.MachineServer interface.
 func (s *Server) GenerateClientConfiguration(ctx context.Context, in *machine.GenerateClientConfigurationRequest) (*machine.GenerateClientConfigurationResponse, error) {
 	if s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeWorker {
@@ -2196,6 +2761,10 @@ func (s *Server) PacketCapture(in *machine.PacketCaptureRequest, srv machine.Mac
 		in.SnapLen = afpacket.DefaultFrameSize
 	}
 
+	if in.Filter != "" && len(in.BpfFilter) > 0 {
+		return status.Error(codes.InvalidArgument, "filter and bpf_filter can't be used together")
+	}
+
 	filter := make([]bpf.RawInstruction, 0, len(in.BpfFilter))
 
 	for _, f := range in.BpfFilter {
@@ -2207,6 +2776,13 @@ func (s *Server) PacketCapture(in *machine.PacketCaptureRequest, srv machine.Mac
 		})
 	}
 
+	if in.Filter != "" {
+		filter, err = pcap.CompileFilter(in.Filter, linkType)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	handle, err := afpacket.NewTPacket(
 		afpacket.OptInterface(in.Interface),
 		afpacket.OptPollTimeout(100*time.Millisecond),
@@ -2396,8 +2972,9 @@ func (s *Server) Netstat(ctx context.Context, req *machine.NetstatRequest) (*mac
 		}
 		if entry.Process != nil {
 			records[i].Process = &machine.ConnectRecord_Process{
-				Pid:  uint32(entry.Process.Pid),
-				Name: entry.Process.Name,
+				Pid:    uint32(entry.Process.Pid),
+				Name:   entry.Process.Name,
+				Cgroup: processCgroup(entry.Process.Pid),
 			}
 		}
 	}
@@ -2412,3 +2989,20 @@ func (s *Server) Netstat(ctx context.Context, req *machine.NetstatRequest) (*mac
 
 	return reply, err
 }
+
+// processCgroup returns the cgroup v2 path of the process, or empty string if it can't be read.
+func processCgroup(pid int) string {
+	contents, err := os.ReadFile(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		return ""
+	}
+
+	// cgroup v2 has a single unified hierarchy entry "0::<path>"
+	for _, line := range strings.Split(string(contents), "\n") {
+		if path, ok := strings.CutPrefix(line, "0::"); ok {
+			return path
+		}
+	}
+
+	return ""
+}
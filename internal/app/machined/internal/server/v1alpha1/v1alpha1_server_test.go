@@ -0,0 +1,122 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	runtime "github.com/siderolabs/talos/internal/app/machined/internal/server/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
+func TestPatchConfiguration(t *testing.T) {
+	t.Parallel()
+
+	input, err := generate.NewInput("test-cluster", "https://localhost:6443", "")
+	require.NoError(t, err)
+
+	cfg, err := input.Config(machinetype.TypeControlPlane)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name    string
+		request *machine.ApplyConfigurationRequest
+
+		expectedError string
+		check         func(t *testing.T, cfg config.Provider)
+	}{
+		{
+			name: "strategic merge patch",
+			request: &machine.ApplyConfigurationRequest{
+				Patches: [][]byte{
+					[]byte("machine:\n  network:\n    hostname: node-1\n"),
+				},
+			},
+
+			check: func(t *testing.T, cfg config.Provider) {
+				assert.Equal(t, "node-1", cfg.Machine().Network().Hostname())
+				assert.Equal(t, "test-cluster", cfg.Cluster().Name())
+			},
+		},
+		{
+			name: "JSON patch",
+			request: &machine.ApplyConfigurationRequest{
+				Patches: [][]byte{
+					[]byte(`[{"op": "add", "path": "/machine/network/hostname", "value": "node-2"}]`),
+				},
+			},
+
+			check: func(t *testing.T, cfg config.Provider) {
+				assert.Equal(t, "node-2", cfg.Machine().Network().Hostname())
+				assert.Equal(t, "test-cluster", cfg.Cluster().Name())
+			},
+		},
+		{
+			name: "multiple patches",
+			request: &machine.ApplyConfigurationRequest{
+				Patches: [][]byte{
+					[]byte("machine:\n  network:\n    hostname: node-1\n"),
+					[]byte(`[{"op": "replace", "path": "/machine/network/hostname", "value": "node-3"}]`),
+				},
+			},
+
+			check: func(t *testing.T, cfg config.Provider) {
+				assert.Equal(t, "node-3", cfg.Machine().Network().Hostname())
+			},
+		},
+		{
+			name: "data and patches",
+			request: &machine.ApplyConfigurationRequest{
+				Data: []byte("version: v1alpha1\n"),
+				Patches: [][]byte{
+					[]byte("machine:\n  network:\n    hostname: node-1\n"),
+				},
+			},
+
+			expectedError: "configuration data and patches are mutually exclusive",
+		},
+		{
+			name: "invalid patch",
+			request: &machine.ApplyConfigurationRequest{
+				Patches: [][]byte{
+					[]byte(`[{"op": "remove", "path": "/machine/nonexistent"}]`),
+				},
+			},
+
+			expectedError: "failed to apply patches",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &runtime.Server{
+				Controller: &mockController{
+					runtime: &mockRuntime{config: cfg},
+				},
+			}
+
+			out, err := server.PatchConfiguration(test.request)
+
+			if test.expectedError != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			test.check(t, out)
+
+			assert.Empty(t, cfg.Machine().Network().Hostname(), "the current configuration should not be modified")
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"cmp"
+	"context"
+	"log"
+	"slices"
+
+	"github.com/nberlee/go-netstat/netstat"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// SocketStatistics implements the machine.MachineServer interface.
+func (s *Server) SocketStatistics(ctx context.Context, _ *emptypb.Empty) (*machine.SocketStatisticsResponse, error) {
+	features := netstat.EnableFeatures{
+		TCP:      true,
+		TCP6:     true,
+		UDP:      true,
+		UDP6:     true,
+		UDPLite:  true,
+		UDPLite6: true,
+		Raw:      true,
+		Raw6:     true,
+		PID:      true,
+		AllNetNs: true,
+	}
+
+	entries, err := netstat.Netstat(ctx, features, func(*netstat.SockTabEntry) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.SocketStatisticsResponse{
+		Messages: []*machine.SocketStatistics{
+			{
+				Netns: socketStatistics(entries, podNetworkNamespaces(ctx)),
+			},
+		},
+	}, nil
+}
+
+// podNetworkNamespaces returns the mapping of the network namespace names to the pods using them.
+//
+// The pods are looked up via the CRI, so the mapping is empty if the CRI is not running (e.g. before the kubelet is started).
+func podNetworkNamespaces(ctx context.Context) map[string]string {
+	inspector, err := getContainerInspector(ctx, constants.K8sContainerdNamespace, common.ContainerDriver_CRI)
+	if err != nil {
+		log.Printf("error getting CRI inspector, pod names will be missing: %s", err)
+
+		return nil
+	}
+
+	//nolint:errcheck
+	defer inspector.Close()
+
+	pods, err := inspector.Pods()
+	if err != nil {
+		log.Printf("error listing pods, some pod names might be missing: %s", err)
+	}
+
+	podNetNS := make(map[string]string, len(pods))
+
+	for _, pod := range pods {
+		for _, container := range pod.Containers {
+			if container.IsPodSandbox && container.NetworkNamespace != "" {
+				podNetNS[container.NetworkNamespace] = pod.Name
+			}
+		}
+	}
+
+	return podNetNS
+}
+
+// socketStatistics groups the sockets by the network namespace, the host network namespace goes first.
+func socketStatistics(entries []netstat.SockTabEntry, podNetNS map[string]string) []*machine.NetNSSocketStatistics {
+	type connectionKey struct {
+		l4proto string
+		state   machine.ConnectRecord_State
+	}
+
+	type netNSStatistics struct {
+		stats       *machine.NetNSSocketStatistics
+		connections map[connectionKey]uint32
+	}
+
+	byNetNS := map[string]*netNSStatistics{}
+
+	for _, entry := range entries {
+		ns, ok := byNetNS[entry.NetNS]
+		if !ok {
+			ns = &netNSStatistics{
+				stats: &machine.NetNSSocketStatistics{
+					Netns: entry.NetNS,
+					Pod:   podNetNS[entry.NetNS],
+				},
+				connections: map[connectionKey]uint32{},
+			}
+
+			byNetNS[entry.NetNS] = ns
+		}
+
+		// same condition as the LISTENING filter of the Netstat API
+		if entry.RemoteEndpoint.IP.IsUnspecified() && entry.RemoteEndpoint.Port == 0 {
+			listener := &machine.SocketListener{
+				L4Proto: entry.Transport,
+				Ip:      entry.LocalEndpoint.IP.String(),
+				Port:    uint32(entry.LocalEndpoint.Port),
+			}
+
+			if entry.Process != nil {
+				listener.Pid = uint32(entry.Process.Pid)
+				listener.Process = entry.Process.Name
+			}
+
+			ns.stats.Listeners = append(ns.stats.Listeners, listener)
+
+			continue
+		}
+
+		ns.connections[connectionKey{l4proto: entry.Transport, state: machine.ConnectRecord_State(entry.State)}]++
+	}
+
+	result := make([]*machine.NetNSSocketStatistics, 0, len(byNetNS))
+
+	for _, ns := range byNetNS {
+		slices.SortFunc(ns.stats.Listeners, func(a, b *machine.SocketListener) int {
+			return cmp.Or(cmp.Compare(a.L4Proto, b.L4Proto), cmp.Compare(a.Port, b.Port), cmp.Compare(a.Ip, b.Ip))
+		})
+
+		for key, count := range ns.connections {
+			ns.stats.Connections = append(ns.stats.Connections, &machine.SocketConnectionSummary{
+				L4Proto: key.l4proto,
+				State:   key.state,
+				Count:   count,
+			})
+		}
+
+		slices.SortFunc(ns.stats.Connections, func(a, b *machine.SocketConnectionSummary) int {
+			return cmp.Or(cmp.Compare(a.L4Proto, b.L4Proto), cmp.Compare(a.State, b.State))
+		})
+
+		result = append(result, ns.stats)
+	}
+
+	// the host network namespace has an empty name, so it is sorted first
+	slices.SortFunc(result, func(a, b *machine.NetNSSocketStatistics) int { return cmp.Compare(a.Netns, b.Netns) })
+
+	return result
+}
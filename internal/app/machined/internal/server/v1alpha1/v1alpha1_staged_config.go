@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configdiff"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// updateStagedConfigStatus records the changes of the staged config which are activated on the next reboot.
+//
+// Persisting the config in any other mode replaces the staged config, so the status is cleared.
+func (s *Server) updateStagedConfigStatus(ctx context.Context, mode machine.ApplyConfigurationRequest_Mode, cfgProvider config.Provider) error {
+	resources := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	var changes configdiff.Changes
+
+	if mode == machine.ApplyConfigurationRequest_STAGED {
+		var err error
+
+		changes, err = configdiff.Compute(s.Controller.Runtime().ConfigContainer(), cfgProvider)
+		if err != nil {
+			return fmt.Errorf("failed to compute config changes: %w", err)
+		}
+	}
+
+	if changes.Empty() {
+		if err := resources.Destroy(ctx, runtime.NewStagedConfigStatus().Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error clearing staged config status: %w", err)
+		}
+
+		return nil
+	}
+
+	spec := runtime.StagedConfigStatusSpec{
+		AddedPaths:   changes.Added,
+		RemovedPaths: changes.Removed,
+		ChangedPaths: changes.Changed,
+	}
+
+	_, err := safe.StateUpdateWithConflicts(ctx, resources, runtime.NewStagedConfigStatus().Metadata(), func(status *runtime.StagedConfigStatus) error {
+		*status.TypedSpec() = spec
+
+		return nil
+	})
+	if state.IsNotFoundError(err) {
+		status := runtime.NewStagedConfigStatus()
+		*status.TypedSpec() = spec
+
+		err = resources.Create(ctx, status)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error updating staged config status: %w", err)
+	}
+
+	return nil
+}
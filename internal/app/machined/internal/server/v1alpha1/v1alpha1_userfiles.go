@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/userfiles"
+	"github.com/siderolabs/talos/pkg/chunker/stream"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// userFilesRoots are the directories which can be accessed with the UserFileWrite and UserFileRead APIs.
+var userFilesRoots = []string{constants.UserFilesPath, constants.UserMountsPath}
+
+// UserFileWrite implements the machine.MachineServer interface.
+func (s *Server) UserFileWrite(srv machine.MachineService_UserFileWriteServer) error {
+	req, err := srv.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "no file path specified")
+		}
+
+		return err
+	}
+
+	path, err := s.userFilePath(srv.Context(), req.GetPath())
+	if err != nil {
+		return err
+	}
+
+	mode := fs.FileMode(req.GetMode())
+
+	switch {
+	case mode == 0:
+		mode = 0o644
+	case mode&^fs.ModePerm != 0:
+		return status.Errorf(codes.InvalidArgument, "file mode %o is not allowed, only the permission bits can be set", req.GetMode())
+	}
+
+	size, err := userfiles.Write(path, &userFileWriteReader{srv: srv, data: req.GetData()}, userfiles.Options{
+		Mode:    mode,
+		UID:     int(req.GetUid()),
+		GID:     int(req.GetGid()),
+		MaxSize: constants.UserFileMaxSize,
+	})
+	if err != nil {
+		if errors.Is(err, userfiles.ErrTooLarge) {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+
+		return err
+	}
+
+	return srv.SendAndClose(&machine.UserFileWriteResponse{
+		Messages: []*machine.UserFileWrite{
+			{
+				Path: path,
+				Size: uint64(size),
+			},
+		},
+	})
+}
+
+// UserFileRead implements the machine.MachineServer interface.
+func (s *Server) UserFileRead(req *machine.UserFileReadRequest, srv machine.MachineService_UserFileReadServer) error {
+	path, err := s.userFilePath(srv.Context(), req.GetPath())
+	if err != nil {
+		return err
+	}
+
+	// the file itself is not resolved, so the symlinks are not followed
+	f, err := os.OpenFile(path, os.O_RDONLY|unix.O_NOFOLLOW, 0)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	st, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if !st.Mode().IsRegular() {
+		return status.Errorf(codes.InvalidArgument, "path %q is not a regular file", path)
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	chunker := stream.NewChunker(ctx, f)
+	chunkCh := chunker.Read()
+
+	for data := range chunkCh {
+		if err = srv.Send(&common.Data{Bytes: data}); err != nil {
+			cancel()
+		}
+	}
+
+	return nil
+}
+
+// userFilePath validates the path of the user file and checks that the EPHEMERAL partition is mounted.
+func (s *Server) userFilePath(ctx context.Context, path string) (string, error) {
+	if path == "" {
+		return "", status.Error(codes.InvalidArgument, "no file path specified")
+	}
+
+	if _, err := safe.ReaderGetByID[*runtimeres.MountStatus](ctx, s.Controller.Runtime().State().V1Alpha2().Resources(), constants.EphemeralPartitionLabel); err != nil {
+		if state.IsNotFoundError(err) {
+			return "", status.Error(codes.FailedPrecondition, "EPHEMERAL partition is not mounted yet")
+		}
+
+		return "", err
+	}
+
+	resolved, err := userfiles.Resolve(userFilesRoots, path)
+	if err != nil {
+		if errors.Is(err, userfiles.ErrOutsideRoots) {
+			return "", status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resolved, nil
+}
+
+// userFileWriteReader reads the file contents from the UserFileWrite stream.
+type userFileWriteReader struct {
+	srv  machine.MachineService_UserFileWriteServer
+	data []byte
+}
+
+func (r *userFileWriteReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		req, err := r.srv.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		r.data = req.GetData()
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+
+	return n, nil
+}
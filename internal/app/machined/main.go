@@ -36,6 +36,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/poweroff"
 	"github.com/siderolabs/talos/internal/app/trustd"
 	"github.com/siderolabs/talos/internal/app/wrapperd"
+	"github.com/siderolabs/talos/internal/pkg/metrics"
 	"github.com/siderolabs/talos/internal/pkg/mount"
 	"github.com/siderolabs/talos/pkg/httpdefaults"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
@@ -169,6 +170,13 @@ func run() error {
 	// Limit GOMAXPROCS.
 	startup.LimitMaxProcs(constants.MachinedMaxProcs)
 
+	// The time since the kernel start (including initramfs) is the first boot stage.
+	var bootTime unix.Timespec
+
+	if err := unix.ClockGettime(unix.CLOCK_BOOTTIME, &bootTime); err == nil {
+		metrics.SetBootStage(metrics.BootStageKernel, time.Duration(bootTime.Nano()))
+	}
+
 	// Set the PATH env var.
 	if err := os.Setenv("PATH", constants.PATH); err != nil {
 		return errors.New("error setting PATH")
@@ -258,6 +266,13 @@ func run() error {
 			&services.APID{},
 		)
 
+		// Confirm or roll back the staged machine config.
+		go func() {
+			if e := c.WatchStagedConfig(ctx); e != nil {
+				log.Printf("WARNING: staged config watch failed: %s", e)
+			}
+		}()
+
 		// Boot the machine.
 		if err = c.Run(ctx, runtime.SequenceBoot, nil); err != nil && !errors.Is(err, context.Canceled) {
 			return err
@@ -113,6 +113,19 @@ func (ctrl *VolumeConfigController) convertEncryption(in cfg.Encryption, out *bl
 		case key.KMS() != nil:
 			out.Encryption.Keys[i].Type = block.EncryptionKeyKMS
 			out.Encryption.Keys[i].KMSEndpoint = key.KMS().Endpoint()
+			out.Encryption.Keys[i].KMSCA = key.KMS().CA()
+			out.Encryption.Keys[i].KMSLockout = key.KMS().Lockout()
+
+			if identity := key.KMS().ClientIdentity(); identity != nil {
+				out.Encryption.Keys[i].KMSClientCertificate = identity.Crt
+				out.Encryption.Keys[i].KMSClientKey = identity.Key
+			}
+
+			if vault := key.KMS().VaultTransit(); vault != nil {
+				out.Encryption.Keys[i].KMSVaultTransitKeyName = vault.KeyName()
+				out.Encryption.Keys[i].KMSVaultTransitMount = vault.MountPath()
+				out.Encryption.Keys[i].KMSVaultAuthMount = vault.AuthMountPath()
+			}
 		case key.TPM() != nil:
 			out.Encryption.Keys[i].Type = block.EncryptionKeyTPM
 			out.Encryption.Keys[i].TPMCheckSecurebootStatusOnEnroll = key.TPM().CheckSecurebootOnEnroll()
@@ -16,10 +16,10 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xslices"
-	"github.com/siderolabs/net"
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
@@ -91,6 +91,11 @@ func (ctrl *LocalAffiliateController) Inputs() []controller.Input {
 			Type:      network.AddressStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.AddressStatusType,
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: k8s.ControlPlaneNamespaceName,
 			Type:      k8s.APIServerConfigType,
@@ -206,6 +211,11 @@ func (ctrl *LocalAffiliateController) Run(ctx context.Context, r controller.Runt
 			return fmt.Errorf("error getting discovered public IP: %w", err)
 		}
 
+		addressStatuses, err := safe.ReaderListAll[*network.AddressStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing addresses: %w", err)
+		}
+
 		// optional resources (kubernetes)
 		apiServerConfig, err := safe.ReaderGetByID[*k8s.APIServerConfig](ctx, r, k8s.APIServerConfigID)
 		if err != nil && !state.IsNotFoundError(err) {
@@ -279,7 +289,7 @@ func (ctrl *LocalAffiliateController) Run(ctx context.Context, r controller.Runt
 
 					// filter endpoints if configured
 					if kubespanConfig.TypedSpec().EndpointFilters != nil {
-						endpointIPs, err = net.FilterIPs(endpointIPs, kubespanConfig.TypedSpec().EndpointFilters)
+						endpointIPs, err = nethelpers.FilterIPs(endpointIPs, kubespanConfig.TypedSpec().EndpointFilters, network.AddressLinkNames(addressStatuses))
 						if err != nil {
 							return fmt.Errorf("error filtering KubeSpan endpoints: %w", err)
 						}
@@ -27,6 +27,7 @@ import (
 	talosruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform"
 	platformerrors "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
+	"github.com/siderolabs/talos/internal/pkg/secretsstore"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
@@ -68,6 +69,7 @@ type AcquireController struct {
 	EventPublisher        talosruntime.Publisher
 	ValidationMode        validation.RuntimeMode
 	ConfigPath            string
+	SecretsStorePath      string
 
 	configSourcesUsed []string
 }
@@ -124,6 +126,10 @@ func (ctrl *AcquireController) Run(ctx context.Context, r controller.Runtime, lo
 		ctrl.ConfigPath = constants.ConfigPath
 	}
 
+	if ctrl.SecretsStorePath == "" {
+		ctrl.SecretsStorePath = constants.SecretsStorePath
+	}
+
 	// start always with loading config from disk
 	var currentState stateMachineFunc = ctrl.stateDisk
 
@@ -340,6 +346,11 @@ func (ctrl *AcquireController) loadFromPlatform(ctx context.Context, logger *zap
 		return nil, fmt.Errorf("failed to load config via platform %s: %w", platformName, err)
 	}
 
+	cfg, err = ctrl.resolveSecrets(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve secrets of config acquired via platform %s: %w", platformName, err)
+	}
+
 	warnings, err := cfg.Validate(ctrl.ValidationMode)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate config acquired via platform %s: %w", platformName, err)
@@ -430,6 +441,11 @@ func (ctrl *AcquireController) loadFromCmdline(logger *zap.Logger) (config.Provi
 		return nil, fmt.Errorf("failed to load config via cmdline %s: %w", constants.KernelParamConfigInline, err)
 	}
 
+	cfg, err = ctrl.resolveSecrets(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve secrets of config acquired via cmdline %s: %w", constants.KernelParamConfigInline, err)
+	}
+
 	warnings, err := cfg.Validate(ctrl.ValidationMode)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate config acquired via cmdline %s: %w", constants.KernelParamConfigInline, err)
@@ -564,3 +580,10 @@ func (ctrl *AcquireController) stateDone(ctx context.Context, r controller.Runti
 func (ctrl *AcquireController) stateFinal(ctx context.Context, r controller.Runtime, logger *zap.Logger) (stateMachineFunc, config.Provider, error) {
 	return nil, nil, nil
 }
+
+// resolveSecrets fills the secrets of the config referencing the secrets bundle from the node secrets store.
+//
+// The config loaded from disk is persisted with the secrets already resolved.
+func (ctrl *AcquireController) resolveSecrets(cfg config.Provider) (config.Provider, error) {
+	return secretsstore.Store{Path: ctrl.SecretsStorePath}.Resolve(cfg, nil)
+}
@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+
+	pkgetcd "github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// ConsistencyController periodically checks the etcd members for data divergence and revision lag.
+type ConsistencyController struct {
+	CheckConsistencyFunc func(ctx context.Context) (*pkgetcd.ConsistencyReport, error)
+	Interval             time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ConsistencyController) Name() string {
+	return "etcd.ConsistencyController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ConsistencyController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some(etcdServiceID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ConsistencyController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: etcd.ConsistencyStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *ConsistencyController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	interval := ctrl.Interval
+	if interval == 0 {
+		interval = constants.EtcdConsistencyCheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		etcdService, err := safe.ReaderGet[*v1alpha1.Service](ctx, r, v1alpha1.NewService(etcdServiceID).Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting etcd service resource: %w", err)
+		}
+
+		if etcdService == nil || etcdService.Metadata().Phase() != resource.PhaseRunning || !etcdService.TypedSpec().Healthy {
+			if err = r.Destroy(ctx, etcd.NewConsistencyStatus(etcd.NamespaceName, etcd.ConsistencyStatusID).Metadata()); err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error destroying etcd consistency status: %w", err)
+			}
+
+			continue
+		}
+
+		report, err := ctrl.checkConsistency(ctx)
+		if err != nil {
+			// etcd might be temporarily unavailable, keep the last known status and retry on the next tick
+			logger.Warn("etcd consistency check failed", zap.Error(err))
+
+			continue
+		}
+
+		for _, member := range report.Divergent() {
+			logger.Error("etcd member data diverged from the rest of the cluster",
+				zap.String("member", member.Name),
+				zap.String("id", etcd.FormatMemberID(member.ID)),
+				zap.Int64("hash_revision", report.HashRevision),
+				zap.Uint32("hash", member.Hash),
+			)
+		}
+
+		for _, member := range report.Lagging() {
+			logger.Warn("etcd member is lagging behind",
+				zap.String("member", member.Name),
+				zap.String("id", etcd.FormatMemberID(member.ID)),
+				zap.Int64("revision_lag", member.RevisionLag),
+			)
+		}
+
+		memberName := func(member pkgetcd.MemberConsistency) string { return member.Name }
+
+		if err = safe.WriterModify(ctx, r, etcd.NewConsistencyStatus(etcd.NamespaceName, etcd.ConsistencyStatusID), func(status *etcd.ConsistencyStatus) error {
+			spec := status.TypedSpec()
+
+			spec.HashRevision = report.HashRevision
+			spec.DivergentMembers = xslices.Map(report.Divergent(), memberName)
+			spec.Divergent = len(spec.DivergentMembers) > 0
+			spec.MaxRevisionLag = report.MaxRevisionLag()
+			spec.LaggingMembers = xslices.Map(report.Lagging(), memberName)
+			spec.UnreachableMembers = xslices.Map(report.Unreachable(), memberName)
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating etcd consistency status: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *ConsistencyController) checkConsistency(ctx context.Context) (*pkgetcd.ConsistencyReport, error) {
+	if ctrl.CheckConsistencyFunc != nil {
+		return ctrl.CheckConsistencyFunc(ctx)
+	}
+
+	client, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	return client.CheckConsistency(ctx)
+}
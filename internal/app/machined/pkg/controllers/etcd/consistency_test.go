@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	etcdctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/etcd"
+	pkgetcd "github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+func TestConsistencySuite(t *testing.T) {
+	t.Parallel()
+
+	ctrl := &etcdctrl.ConsistencyController{
+		Interval: 100 * time.Millisecond,
+		CheckConsistencyFunc: func(context.Context) (*pkgetcd.ConsistencyReport, error) {
+			return &pkgetcd.ConsistencyReport{
+				HashRevision: 100,
+				Members: []pkgetcd.MemberConsistency{
+					{ID: 1, Name: "cp-1", Revision: 2100, Hash: 0xdead},
+					{ID: 2, Name: "cp-2", Revision: 2000, Hash: 0xdead, RevisionLag: 100},
+					{ID: 3, Name: "cp-3", Revision: 100, Hash: 0xbeef, RevisionLag: 2000, Divergent: true},
+					{ID: 4, Name: "cp-4", Err: errors.New("connection refused")},
+				},
+			}, nil
+		},
+	}
+
+	suite.Run(t, &ConsistencySuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(ctrl))
+			},
+		},
+	})
+}
+
+type ConsistencySuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *ConsistencySuite) TestReconcile() {
+	etcdService := v1alpha1.NewService("etcd")
+	etcdService.TypedSpec().Running = true
+	etcdService.TypedSpec().Healthy = true
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), etcdService))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), etcd.ConsistencyStatusID, func(status *etcd.ConsistencyStatus, asrt *assert.Assertions) {
+		spec := status.TypedSpec()
+
+		asrt.EqualValues(100, spec.HashRevision)
+		asrt.True(spec.Divergent)
+		asrt.Equal([]string{"cp-3"}, spec.DivergentMembers)
+		asrt.EqualValues(2000, spec.MaxRevisionLag)
+		asrt.Equal([]string{"cp-3"}, spec.LaggingMembers)
+		asrt.Equal([]string{"cp-4"}, spec.UnreachableMembers)
+	})
+
+	etcdService.TypedSpec().Healthy = false
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), etcdService))
+
+	rtestutils.AssertNoResource[*etcd.ConsistencyStatus](suite.Ctx(), suite.T(), suite.State(), etcd.ConsistencyStatusID)
+}
@@ -15,9 +15,9 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xslices"
-	"github.com/siderolabs/net"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
@@ -51,6 +51,11 @@ func (ctrl *SpecController) Inputs() []controller.Input {
 			Type:      network.NodeAddressType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.AddressStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -137,6 +142,13 @@ func (ctrl *SpecController) Run(ctx context.Context, r controller.Runtime, logge
 			continue
 		}
 
+		addressStatuses, err := safe.ReaderListAll[*network.AddressStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing addresses: %w", err)
+		}
+
+		linkNames := network.AddressLinkNames(addressStatuses)
+
 		advertiseValidSubnets := etcdConfig.TypedSpec().AdvertiseValidSubnets
 
 		if len(advertiseValidSubnets) == 0 {
@@ -171,7 +183,7 @@ func (ctrl *SpecController) Run(ctx context.Context, r controller.Runtime, logge
 		)
 
 		if len(etcdConfig.TypedSpec().AdvertiseValidSubnets) == 0 {
-			advertisedIPs, err = net.FilterIPs(routedAddrs, advertisedCIDRs)
+			advertisedIPs, err = nethelpers.FilterIPs(routedAddrs, advertisedCIDRs, linkNames)
 			if err != nil {
 				return fmt.Errorf("error filtering IPs: %w", err)
 			}
@@ -181,14 +193,14 @@ func (ctrl *SpecController) Run(ctx context.Context, r controller.Runtime, logge
 				advertisedIPs = advertisedIPs[:1]
 			}
 		} else {
-			advertisedIPs, err = net.FilterIPs(currentAddrs, advertisedCIDRs)
+			advertisedIPs, err = nethelpers.FilterIPs(currentAddrs, advertisedCIDRs, linkNames)
 			if err != nil {
 				return fmt.Errorf("error filtering IPs: %w", err)
 			}
 		}
 
 		if len(listenCIDRs) > 0 {
-			listenPeerIPs, err = net.FilterIPs(routedAddrs, listenCIDRs)
+			listenPeerIPs, err = nethelpers.FilterIPs(routedAddrs, listenCIDRs, linkNames)
 			if err != nil {
 				return fmt.Errorf("error filtering IPs: %w", err)
 			}
@@ -18,6 +18,7 @@ import (
 	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
 
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 )
@@ -78,6 +79,15 @@ func NewKubeletConfigController() *KubeletConfigController {
 				kubeletConfig.DisableManifestsDirectory = cfgProvider.Machine().Kubelet().DisableManifestsDirectory()
 				kubeletConfig.EnableFSQuotaMonitoring = cfgProvider.Machine().Features().DiskQuotaSupportEnabled()
 				kubeletConfig.CredentialProviderConfig = cfgProvider.Machine().Kubelet().CredentialProviderConfig()
+				kubeletConfig.ShutdownGracePeriodByPodPriority = xslices.Map(
+					cfgProvider.Machine().Kubelet().ShutdownGracePeriodByPodPriority(),
+					func(period talosconfig.KubeletShutdownGracePeriod) k8s.ShutdownGracePeriodByPodPriority {
+						return k8s.ShutdownGracePeriodByPodPriority{
+							Priority:    period.Priority(),
+							GracePeriod: period.GracePeriod(),
+						}
+					},
+				)
 
 				return nil
 			},
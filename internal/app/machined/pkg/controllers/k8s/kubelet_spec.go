@@ -367,12 +367,26 @@ func NewKubeletConfiguration(cfgSpec *k8s.KubeletConfigSpec, kubeletVersion comp
 
 	extraConfig := cfgSpec.ExtraConfig
 
-	if _, overridden := extraConfig["shutdownGracePeriod"]; !overridden && config.ShutdownGracePeriod.Duration == 0 {
-		config.ShutdownGracePeriod = metav1.Duration{Duration: constants.KubeletShutdownGracePeriod}
+	if len(cfgSpec.ShutdownGracePeriodByPodPriority) > 0 {
+		config.ShutdownGracePeriodByPodPriority = xslices.Map(cfgSpec.ShutdownGracePeriodByPodPriority,
+			func(period k8s.ShutdownGracePeriodByPodPriority) kubeletconfig.ShutdownGracePeriodByPodPriority {
+				return kubeletconfig.ShutdownGracePeriodByPodPriority{
+					Priority:                   period.Priority,
+					ShutdownGracePeriodSeconds: int64(period.GracePeriod / time.Second),
+				}
+			},
+		)
 	}
 
-	if _, overridden := extraConfig["shutdownGracePeriodCriticalPods"]; !overridden && config.ShutdownGracePeriodCriticalPods.Duration == 0 {
-		config.ShutdownGracePeriodCriticalPods = metav1.Duration{Duration: constants.KubeletShutdownGracePeriodCriticalPods}
+	// kubelet doesn't allow setting both grace periods by pod priority and plain grace periods
+	if len(config.ShutdownGracePeriodByPodPriority) == 0 {
+		if _, overridden := extraConfig["shutdownGracePeriod"]; !overridden && config.ShutdownGracePeriod.Duration == 0 {
+			config.ShutdownGracePeriod = metav1.Duration{Duration: constants.KubeletShutdownGracePeriod}
+		}
+
+		if _, overridden := extraConfig["shutdownGracePeriodCriticalPods"]; !overridden && config.ShutdownGracePeriodCriticalPods.Duration == 0 {
+			config.ShutdownGracePeriodCriticalPods = metav1.Duration{Duration: constants.KubeletShutdownGracePeriodCriticalPods}
+		}
 	}
 
 	if config.StreamingConnectionIdleTimeout.Duration == 0 {
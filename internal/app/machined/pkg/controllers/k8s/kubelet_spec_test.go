@@ -443,6 +443,39 @@ func TestNewKubeletConfigurationMerge(t *testing.T) {
 			},
 			machineType: machine.TypeWorker,
 		},
+		{
+			name: "graceful shutdown by pod priority",
+			cfgSpec: &k8s.KubeletConfigSpec{
+				ClusterDNS:    []string{"10.0.0.5"},
+				ClusterDomain: "cluster.local",
+				ShutdownGracePeriodByPodPriority: []k8s.ShutdownGracePeriodByPodPriority{
+					{
+						Priority:    100000,
+						GracePeriod: 10 * time.Second,
+					},
+					{
+						Priority:    0,
+						GracePeriod: time.Minute,
+					},
+				},
+			},
+			kubeletVersion: compatibility.VersionFromImageRef("ghcr.io/siderolabs/kubelet:v1.29.0"),
+			expectedOverrides: func(kc *kubeletconfig.KubeletConfiguration) {
+				kc.ShutdownGracePeriod = metav1.Duration{}
+				kc.ShutdownGracePeriodCriticalPods = metav1.Duration{}
+				kc.ShutdownGracePeriodByPodPriority = []kubeletconfig.ShutdownGracePeriodByPodPriority{
+					{
+						Priority:                   100000,
+						ShutdownGracePeriodSeconds: 10,
+					},
+					{
+						Priority:                   0,
+						ShutdownGracePeriodSeconds: 60,
+					},
+				}
+			},
+			machineType: machine.TypeWorker,
+		},
 		{
 			name: "enable seccomp default",
 			cfgSpec: &k8s.KubeletConfigSpec{
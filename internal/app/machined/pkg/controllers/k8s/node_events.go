@@ -0,0 +1,285 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/channel"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	machinedruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/kubernetes"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// nodeEventsComponent is the name of the component reported as the source of the Kubernetes events.
+const nodeEventsComponent = "talos"
+
+// nodeEventsPublishTimeout is the timeout for publishing a single Kubernetes event.
+const nodeEventsPublishTimeout = 10 * time.Second
+
+// NodeEvent is a machine event which should be mirrored as a Kubernetes Event.
+type NodeEvent struct {
+	// Type is either corev1.EventTypeNormal or corev1.EventTypeWarning.
+	Type    string
+	Reason  string
+	Message string
+}
+
+// NodeEventFromMachineEvent converts the machine event into the Kubernetes event.
+//
+// Only significant events are converted, for other events false is returned.
+func NodeEventFromMachineEvent(event machinedruntime.Event) (NodeEvent, bool) {
+	switch payload := event.Payload.(type) {
+	case *machine.SequenceEvent:
+		switch payload.GetSequence() {
+		case machinedruntime.SequenceNoop.String(),
+			machinedruntime.SequenceBoot.String(),
+			machinedruntime.SequenceInitialize.String(),
+			machinedruntime.SequenceInstall.String():
+			// boot-time sequences run before the node is registered in Kubernetes
+			return NodeEvent{}, false
+		}
+
+		switch {
+		case payload.GetError() != nil:
+			return NodeEvent{
+				Type:    corev1.EventTypeWarning,
+				Reason:  "SequenceFailed",
+				Message: fmt.Sprintf("Talos %s sequence failed: %s", payload.GetSequence(), payload.GetError().GetMessage()),
+			}, true
+		case payload.GetAction() == machine.SequenceEvent_START:
+			return NodeEvent{
+				Type:    corev1.EventTypeNormal,
+				Reason:  "SequenceStarted",
+				Message: fmt.Sprintf("Talos %s sequence started", payload.GetSequence()),
+			}, true
+		case payload.GetAction() == machine.SequenceEvent_STOP:
+			return NodeEvent{
+				Type:    corev1.EventTypeNormal,
+				Reason:  "SequenceFinished",
+				Message: fmt.Sprintf("Talos %s sequence finished", payload.GetSequence()),
+			}, true
+		}
+	case *machine.ServiceStateEvent:
+		if payload.GetAction() == machine.ServiceStateEvent_FAILED {
+			return NodeEvent{
+				Type:    corev1.EventTypeWarning,
+				Reason:  "ServiceFailed",
+				Message: fmt.Sprintf("Talos service %q failed: %s", payload.GetService(), payload.GetMessage()),
+			}, true
+		}
+	case *machine.ConfigLoadErrorEvent:
+		return NodeEvent{
+			Type:    corev1.EventTypeWarning,
+			Reason:  "ConfigLoadFailed",
+			Message: fmt.Sprintf("failed to load machine configuration: %s", payload.GetError()),
+		}, true
+	case *machine.ConfigValidationErrorEvent:
+		return NodeEvent{
+			Type:    corev1.EventTypeWarning,
+			Reason:  "ConfigValidationFailed",
+			Message: fmt.Sprintf("machine configuration validation failed: %s", payload.GetError()),
+		}, true
+	}
+
+	return NodeEvent{}, false
+}
+
+// NodeEventsController mirrors significant machine events as Kubernetes Events attached to the Node object.
+type NodeEventsController struct {
+	V1Alpha1Events machinedruntime.Watcher
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeEventsController) Name() string {
+	return "k8s.NodeEventsController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeEventsController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodenameType,
+			ID:        optional.Some(k8s.NodenameID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeEventsController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *NodeEventsController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		kubernetesClient *kubernetes.Client
+		enabled          bool
+		nodename         string
+		configVersion    string
+	)
+
+	defer func() {
+		if kubernetesClient != nil {
+			kubernetesClient.Close() //nolint:errcheck
+		}
+	}()
+
+	watchCh := make(chan machinedruntime.EventInfo)
+
+	// only the events published after the controller start are mirrored
+	if err := ctrl.V1Alpha1Events.Watch(func(eventCh <-chan machinedruntime.EventInfo) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventCh:
+				if !channel.SendWithContext(ctx, watchCh, event) {
+					return
+				}
+			}
+		}
+	}); err != nil {
+		return fmt.Errorf("error watching events: %w", err)
+	}
+
+	publish := func(event NodeEvent) {
+		if !enabled || nodename == "" {
+			return
+		}
+
+		if kubernetesClient == nil {
+			var err error
+
+			kubernetesClient, err = kubernetes.NewClientFromKubeletKubeconfig()
+			if err != nil {
+				logger.Debug("Kubernetes is not available, dropping event", zap.String("reason", event.Reason), zap.Error(err))
+
+				return
+			}
+		}
+
+		publishCtx, publishCancel := context.WithTimeout(ctx, nodeEventsPublishTimeout)
+		defer publishCancel()
+
+		if _, err := kubernetesClient.CoreV1().Events(corev1.NamespaceDefault).Create(
+			publishCtx,
+			kubernetesEvent(nodename, event, time.Now()),
+			metav1.CreateOptions{},
+		); err != nil {
+			logger.Warn("failed to publish Kubernetes event", zap.String("reason", event.Reason), zap.Error(err))
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-watchCh:
+			if nodeEvent, ok := NodeEventFromMachineEvent(event.Event); ok {
+				publish(nodeEvent)
+			}
+
+			continue
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		nodenameRes, err := safe.ReaderGetByID[*k8s.Nodename](ctx, r, k8s.NodenameID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting nodename: %w", err)
+		}
+
+		enabled = cfg != nil && cfg.Config().Machine() != nil && cfg.Config().Machine().Features().KubernetesEventsEnabled()
+
+		nodename = ""
+
+		if nodenameRes != nil && !nodenameRes.TypedSpec().SkipNodeRegistration {
+			nodename = nodenameRes.TypedSpec().Nodename
+		}
+
+		if !enabled && kubernetesClient != nil {
+			kubernetesClient.Close() //nolint:errcheck
+
+			kubernetesClient = nil
+		}
+
+		if cfg != nil {
+			version := cfg.Metadata().Version().String()
+
+			// the first observed configuration is the one the machine booted with
+			if configVersion != "" && configVersion != version {
+				publish(NodeEvent{
+					Type:    corev1.EventTypeNormal,
+					Reason:  "ConfigChanged",
+					Message: "Talos machine configuration was updated",
+				})
+			}
+
+			configVersion = version
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func kubernetesEvent(nodename string, event NodeEvent, timestamp time.Time) *corev1.Event {
+	eventTime := metav1.NewTime(timestamp)
+
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s.%x", nodename, timestamp.UnixNano()),
+			Namespace: corev1.NamespaceDefault,
+		},
+		// kubelet reports the node events with the node name as the UID, follow the same convention
+		InvolvedObject: corev1.ObjectReference{
+			Kind: "Node",
+			Name: nodename,
+			UID:  types.UID(nodename),
+		},
+		Reason:  event.Reason,
+		Message: event.Message,
+		Type:    event.Type,
+		Source: corev1.EventSource{
+			Component: nodeEventsComponent,
+			Host:      nodename,
+		},
+		FirstTimestamp:      eventTime,
+		LastTimestamp:       eventTime,
+		Count:               1,
+		ReportingController: nodeEventsComponent,
+		ReportingInstance:   nodename,
+	}
+}
@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+func TestNodeEventFromMachineEvent(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		payload  proto.Message
+		expected k8sctrl.NodeEvent
+		ok       bool
+	}{
+		{
+			name: "upgrade start",
+			payload: &machine.SequenceEvent{
+				Sequence: runtime.SequenceUpgrade.String(),
+				Action:   machine.SequenceEvent_START,
+			},
+			expected: k8sctrl.NodeEvent{
+				Type:    corev1.EventTypeNormal,
+				Reason:  "SequenceStarted",
+				Message: "Talos upgrade sequence started",
+			},
+			ok: true,
+		},
+		{
+			name: "reboot failed",
+			payload: &machine.SequenceEvent{
+				Sequence: runtime.SequenceReboot.String(),
+				Action:   machine.SequenceEvent_STOP,
+				Error: &common.Error{
+					Message: "timeout",
+				},
+			},
+			expected: k8sctrl.NodeEvent{
+				Type:    corev1.EventTypeWarning,
+				Reason:  "SequenceFailed",
+				Message: "Talos reboot sequence failed: timeout",
+			},
+			ok: true,
+		},
+		{
+			name: "boot sequence",
+			payload: &machine.SequenceEvent{
+				Sequence: runtime.SequenceBoot.String(),
+				Action:   machine.SequenceEvent_START,
+			},
+		},
+		{
+			name: "service failed",
+			payload: &machine.ServiceStateEvent{
+				Service: "etcd",
+				Action:  machine.ServiceStateEvent_FAILED,
+				Message: "exit code 1",
+			},
+			expected: k8sctrl.NodeEvent{
+				Type:    corev1.EventTypeWarning,
+				Reason:  "ServiceFailed",
+				Message: `Talos service "etcd" failed: exit code 1`,
+			},
+			ok: true,
+		},
+		{
+			name: "service running",
+			payload: &machine.ServiceStateEvent{
+				Service: "etcd",
+				Action:  machine.ServiceStateEvent_RUNNING,
+			},
+		},
+		{
+			name:    "phase",
+			payload: &machine.PhaseEvent{Phase: "sync"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			event, ok := k8sctrl.NodeEventFromMachineEvent(runtime.NewEvent(test.payload, ""))
+
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, event)
+		})
+	}
+}
@@ -15,9 +15,9 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xslices"
-	"github.com/siderolabs/net"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
@@ -45,6 +45,11 @@ func (ctrl *NodeIPController) Inputs() []controller.Input {
 			ID:        optional.Some(network.FilteredNodeAddressID(network.NodeAddressRoutedID, k8s.NodeAddressFilterNoK8s)),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.AddressStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -100,11 +105,16 @@ func (ctrl *NodeIPController) Run(ctx context.Context, r controller.Runtime, log
 
 		addrs := nodeAddrs.TypedSpec().IPs()
 
+		addressStatuses, err := safe.ReaderListAll[*network.AddressStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing addresses: %w", err)
+		}
+
 		cidrs := make([]string, 0, len(cfgSpec.ValidSubnets)+len(cfgSpec.ExcludeSubnets))
 		cidrs = append(cidrs, cfgSpec.ValidSubnets...)
 		cidrs = append(cidrs, xslices.Map(cfgSpec.ExcludeSubnets, func(cidr string) string { return "!" + cidr })...)
 
-		ips, err := net.FilterIPs(addrs, cidrs)
+		ips, err := nethelpers.FilterIPs(addrs, cidrs, network.AddressLinkNames(addressStatuses))
 		if err != nil {
 			return fmt.Errorf("error filtering IPs: %w", err)
 		}
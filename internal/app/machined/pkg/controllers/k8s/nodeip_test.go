@@ -70,6 +70,39 @@ func (suite *NodeIPSuite) TestReconcileDefaultSubnets() {
 	})
 }
 
+func (suite *NodeIPSuite) TestReconcileInterface() {
+	cfg := k8s.NewNodeIPConfig(k8s.NamespaceName, k8s.KubeletID)
+	cfg.TypedSpec().ValidSubnets = []string{"interface:eth1"}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	for _, addr := range []struct {
+		link   string
+		prefix string
+	}{
+		{"eth0", "10.0.0.5/24"},
+		{"eth1", "192.168.1.1/24"},
+	} {
+		status := network.NewAddressStatus(network.NamespaceName, addr.link+"/"+addr.prefix)
+		status.TypedSpec().Address = netip.MustParsePrefix(addr.prefix)
+		status.TypedSpec().LinkName = addr.link
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), status))
+	}
+
+	addresses := network.NewNodeAddress(
+		network.NamespaceName,
+		network.FilteredNodeAddressID(network.NodeAddressRoutedID, k8s.NodeAddressFilterNoK8s),
+	)
+	addresses.TypedSpec().Addresses = []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.5/24"),
+		netip.MustParsePrefix("192.168.1.1/24"),
+	}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), addresses))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{k8s.KubeletID}, func(nodeIP *k8s.NodeIP, asrt *assert.Assertions) {
+		asrt.Equal("[192.168.1.1]", fmt.Sprintf("%s", nodeIP.TypedSpec().Addresses))
+	})
+}
+
 func (suite *NodeIPSuite) TestReconcileNoMatch() {
 	cfg := k8s.NewNodeIPConfig(k8s.NamespaceName, k8s.KubeletID)
 	cfg.TypedSpec().ValidSubnets = []string{"0.0.0.0/0"}
@@ -6,6 +6,8 @@ package k8s
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"net/http"
@@ -20,6 +22,8 @@ import (
 )
 
 // StaticPodServerController renders all static pod definitions as a PodList and serves it as YAML via HTTP.
+//
+// Each rendered static pod manifest is also published as a StaticPodManifestStatus resource.
 type StaticPodServerController struct {
 	podList   []byte
 	podListMu sync.Mutex
@@ -50,6 +54,10 @@ func (ctrl *StaticPodServerController) Outputs() []controller.Output {
 			Type: k8s.StaticPodServerStatusType,
 			Kind: controller.OutputExclusive,
 		},
+		{
+			Type: k8s.StaticPodManifestStatusType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -89,6 +97,10 @@ func (ctrl *StaticPodServerController) Run(ctx context.Context, r controller.Run
 			ctrl.podListMu.Lock()
 			ctrl.podList = staticPodList
 			ctrl.podListMu.Unlock()
+
+			if err = ctrl.updateManifestStatuses(ctx, r); err != nil {
+				return err
+			}
 		}
 
 		r.ResetRestartBackoff()
@@ -147,6 +159,39 @@ func (ctrl *StaticPodServerController) buildPodList(ctx context.Context, r contr
 	return manifestContent, nil
 }
 
+// updateManifestStatuses publishes each rendered static pod manifest with its hash,
+// so that the changes to the static pods can be inspected without access to the kubelet.
+func (ctrl *StaticPodServerController) updateManifestStatuses(ctx context.Context, r controller.Runtime) error {
+	staticPods, err := safe.ReaderListAll[*k8s.StaticPod](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing static pods: %w", err)
+	}
+
+	r.StartTrackingOutputs()
+
+	for iter := staticPods.Iterator(); iter.Next(); {
+		id := iter.Value().Metadata().ID()
+
+		manifest, err := yaml.Marshal(iter.Value().TypedSpec().Pod)
+		if err != nil {
+			return fmt.Errorf("error rendering static pod %q as yaml: %w", id, err)
+		}
+
+		hash := sha256.Sum256(manifest)
+
+		if err = safe.WriterModify(ctx, r, k8s.NewStaticPodManifestStatus(k8s.NamespaceName, id), func(res *k8s.StaticPodManifestStatus) error {
+			res.TypedSpec().Manifest = string(manifest)
+			res.TypedSpec().SHA256 = hex.EncodeToString(hash[:])
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating static pod manifest status %q: %w", id, err)
+		}
+	}
+
+	return safe.CleanupOutputs[*k8s.StaticPodManifestStatus](ctx, r)
+}
+
 func (ctrl *StaticPodServerController) createServer(ctx context.Context, r controller.Runtime, logger *zap.Logger) (func(), <-chan error, error) {
 	mux := http.NewServeMux()
 
@@ -176,6 +176,50 @@ func (suite *StaticPodListSuite) TestServesStaticPodList() {
 	)
 }
 
+func (suite *StaticPodListSuite) TestCreatesStaticPodManifestStatus() {
+	// given
+	testPod := newTestPod("testPod")
+
+	// when
+	suite.Require().NoError(suite.state.Create(suite.ctx, testPod))
+
+	// then
+	expectedManifestStatus := k8s.NewStaticPodManifestStatus(k8s.NamespaceName, "testPod")
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			suite.assertResource(*expectedManifestStatus.Metadata(), func(res resource.Resource) error {
+				spec := res.(*k8s.StaticPodManifestStatus).TypedSpec()
+
+				suite.Assert().Equal("metadata: testPod\nspec: testSpec\n", spec.Manifest)
+				suite.Assert().Equal("90a4805db43b9c5224763ce758d870f3de3a341215baa48486566245effc4453", spec.SHA256)
+
+				return nil
+			},
+			),
+		),
+	)
+
+	// when
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, testPod.Metadata()))
+
+	// then
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+			_, err := suite.state.Get(suite.ctx, expectedManifestStatus.Metadata())
+			if err == nil {
+				return retry.ExpectedErrorf("manifest status still exists")
+			}
+
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return err
+		}),
+	)
+}
+
 func (suite *StaticPodListSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -0,0 +1,300 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubeaccess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/kubeaccess/bridge"
+	"github.com/siderolabs/talos/pkg/grpc/factory"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubeaccess"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// ServiceAccountBridgeController runs the node-local Talos API endpoint authenticated with Kubernetes service account tokens.
+type ServiceAccountBridgeController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *ServiceAccountBridgeController) Name() string {
+	return "kubeaccess.ServiceAccountBridgeController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ServiceAccountBridgeController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      kubeaccess.ConfigType,
+			ID:        optional.Some(kubeaccess.ConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.KubernetesType,
+			ID:        optional.Some(secrets.KubernetesID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.APIType,
+			ID:        optional.Some(secrets.APIID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineTypeType,
+			ID:        optional.Some(config.MachineTypeID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodeIPType,
+			ID:        optional.Some(k8s.KubeletID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ServiceAccountBridgeController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *ServiceAccountBridgeController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var (
+		server         *grpc.Server
+		serverWg       sync.WaitGroup
+		lastKubeconfig string
+		lastAddresses  []netip.Addr
+		reviewer       bridge.TokenReviewer
+		warnedWorker   bool
+	)
+
+	b := bridge.New(logger)
+
+	shutdownServer := func(ctx context.Context) {
+		if server == nil {
+			return
+		}
+
+		shutdownCtx, shutdownCancel := context.WithTimeout(ctx, 5*time.Second)
+		defer shutdownCancel()
+
+		factory.ServerGracefulStop(server, shutdownCtx)
+
+		serverWg.Wait()
+
+		server = nil
+		lastKubeconfig = ""
+		lastAddresses = nil
+		reviewer = nil
+
+		b.Update(nil, nil)
+
+		logger.Info("service account bridge stopped")
+	}
+
+	defer shutdownServer(context.Background())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		kubeaccessConfig, err := safe.ReaderGetByID[*kubeaccess.Config](ctx, r, kubeaccess.ConfigID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching kubeaccess config: %w", err)
+		}
+
+		kubeSecrets, err := safe.ReaderGetByID[*secrets.Kubernetes](ctx, r, secrets.KubernetesID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching kubernetes secrets: %w", err)
+		}
+
+		apiCerts, err := safe.ReaderGetByID[*secrets.API](ctx, r, secrets.APIID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching API certificates: %w", err)
+		}
+
+		machineType, err := safe.ReaderGetByID[*config.MachineType](ctx, r, config.MachineTypeID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching machine type: %w", err)
+		}
+
+		nodeIP, err := safe.ReaderGetByID[*k8s.NodeIP](ctx, r, k8s.KubeletID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching node IPs: %w", err)
+		}
+
+		if kubeaccessConfig == nil || !kubeaccessConfig.TypedSpec().Enabled || len(kubeaccessConfig.TypedSpec().ServiceAccounts) == 0 || machineType == nil {
+			shutdownServer(ctx)
+
+			continue
+		}
+
+		// the token review requires the localhost admin kubeconfig, which is only available on control plane nodes
+		// (the configuration validation rejects the feature on workers, but the machine type might be changed later)
+		if !machineType.MachineType().IsControlPlane() {
+			shutdownServer(ctx)
+
+			if !warnedWorker {
+				logger.Warn("service account bridge is only supported on control plane nodes, not starting", zap.Stringer("machine_type", machineType.MachineType()))
+
+				warnedWorker = true
+			}
+
+			continue
+		}
+
+		warnedWorker = false
+
+		if kubeSecrets == nil || apiCerts == nil || apiCerts.TypedSpec().Server == nil || nodeIP == nil || len(nodeIP.TypedSpec().Addresses) == 0 {
+			shutdownServer(ctx)
+
+			continue
+		}
+
+		// the bridge is reached by the pods via the node IPs, so don't listen on other addresses
+		if addresses := nodeIP.TypedSpec().Addresses; !slices.Equal(addresses, lastAddresses) {
+			shutdownServer(ctx)
+
+			lastAddresses = slices.Clone(addresses)
+		}
+
+		if err = b.UpdateCertificate(apiCerts.TypedSpec().Server); err != nil {
+			return err
+		}
+
+		if kubeconfig := kubeSecrets.TypedSpec().LocalhostAdminKubeconfig; kubeconfig != lastKubeconfig {
+			if reviewer, err = newTokenReviewer(kubeconfig); err != nil {
+				return err
+			}
+
+			lastKubeconfig = kubeconfig
+		}
+
+		b.Update(kubeaccessConfig.TypedSpec(), reviewer)
+
+		if server == nil {
+			tlsConfig, err := b.TLSConfig()
+			if err != nil {
+				return fmt.Errorf("failed to get tls config: %w", err)
+			}
+
+			listeners := make([]net.Listener, 0, len(lastAddresses))
+
+			for _, addr := range lastAddresses {
+				listener, err := net.Listen("tcp", net.JoinHostPort(addr.String(), strconv.Itoa(constants.KubernetesTalosAPIServiceAccountBridgePort)))
+				if err != nil {
+					for _, l := range listeners {
+						l.Close() //nolint:errcheck
+					}
+
+					return fmt.Errorf("failed to listen: %w", err)
+				}
+
+				listeners = append(listeners, listener)
+			}
+
+			server = factory.NewServer(
+				b,
+				factory.WithDefaultLog(),
+				factory.ServerOptions(
+					slices.Concat(
+						[]grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))},
+						b.ServerOptions(),
+					)...,
+				),
+				factory.WithUnaryInterceptor(b.UnaryInterceptor()),
+				factory.WithStreamInterceptor(b.StreamInterceptor()),
+			)
+
+			for _, listener := range listeners {
+				serverWg.Add(1)
+
+				go func() {
+					defer serverWg.Done()
+
+					//nolint:errcheck
+					server.Serve(listener)
+				}()
+			}
+
+			logger.Info("service account bridge started",
+				zap.Int("port", constants.KubernetesTalosAPIServiceAccountBridgePort),
+				zap.Stringers("addresses", lastAddresses),
+			)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// newTokenReviewer builds a bridge.TokenReviewer which verifies the service account tokens via the Kubernetes TokenReview API.
+func newTokenReviewer(kubeconfig string) (bridge.TokenReviewer, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
+	if err != nil {
+		return nil, fmt.Errorf("error loading kubeconfig: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error building Kubernetes client: %w", err)
+	}
+
+	return func(ctx context.Context, token string) (string, error) {
+		review, err := clientset.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+			Spec: authenticationv1.TokenReviewSpec{
+				Token:     token,
+				Audiences: []string{constants.KubernetesTalosAPIServiceAccountAudience},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return "", fmt.Errorf("error reviewing token: %w", err)
+		}
+
+		if review.Status.Error != "" {
+			return "", fmt.Errorf("token review failed: %s", review.Status.Error)
+		}
+
+		if !review.Status.Authenticated {
+			return "", errors.New("token is not authenticated")
+		}
+
+		if !slices.Contains(review.Status.Audiences, constants.KubernetesTalosAPIServiceAccountAudience) {
+			return "", fmt.Errorf("token is not issued for the %q audience", constants.KubernetesTalosAPIServiceAccountAudience)
+		}
+
+		return review.Status.User.Username, nil
+	}, nil
+}
@@ -0,0 +1,240 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package bridge implements the node-local Talos API endpoint authenticated with Kubernetes service account tokens.
+package bridge
+
+import (
+	"context"
+	stdlibtls "crypto/tls"
+	stdx509 "crypto/x509"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"github.com/siderolabs/crypto/tls"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/grpc-proxy/proxy"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/grpc/proxy/backend"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubeaccess"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// serviceAccountPrefix is the prefix of the Kubernetes service account usernames.
+const serviceAccountPrefix = "system:serviceaccount:"
+
+// TokenReviewer verifies the Kubernetes service account token and returns the authenticated username.
+type TokenReviewer func(ctx context.Context, token string) (string, error)
+
+// Bridge proxies the Talos API requests authenticated with Kubernetes service account tokens to the local machined.
+//
+// Only the requests to the local node are accepted, request forwarding to other nodes is not supported.
+type Bridge struct {
+	logger *zap.Logger
+
+	mu       sync.Mutex
+	spec     *kubeaccess.ConfigSpec
+	reviewer TokenReviewer
+
+	serverCert atomic.Pointer[stdlibtls.Certificate]
+
+	backend proxy.Backend
+}
+
+// New creates a new Bridge.
+func New(logger *zap.Logger) *Bridge {
+	return &Bridge{
+		logger:  logger,
+		backend: backend.NewLocal("machined", constants.MachineSocketPath),
+	}
+}
+
+// Update the access configuration and the token reviewer.
+func (b *Bridge) Update(spec *kubeaccess.ConfigSpec, reviewer TokenReviewer) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.spec = spec
+	b.reviewer = reviewer
+}
+
+// UpdateCertificate updates the server certificate.
+func (b *Bridge) UpdateCertificate(serverCert *x509.PEMEncodedCertificateAndKey) error {
+	cert, err := stdlibtls.X509KeyPair(serverCert.Crt, serverCert.Key)
+	if err != nil {
+		return fmt.Errorf("failed to parse server cert and key into a TLS Certificate: %w", err)
+	}
+
+	b.serverCert.Store(&cert)
+
+	return nil
+}
+
+// Register is no-op to implement factory.Registrator interface.
+//
+// Actual proxy handler is installed via grpc.UnknownServiceHandler option.
+func (b *Bridge) Register(*grpc.Server) {}
+
+// ServerOptions returns gRPC server options which install the proxy handler.
+func (b *Bridge) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ForceServerCodec(proxy.Codec()),
+		grpc.UnknownServiceHandler(proxy.TransparentHandler(b.director)),
+		grpc.MaxRecvMsgSize(constants.GRPCMaxMessageSize),
+	}
+}
+
+func (b *Bridge) director(context.Context, string) (proxy.Mode, []proxy.Backend, error) {
+	return proxy.One2One, []proxy.Backend{b.backend}, nil
+}
+
+// TLSConfig generates server-side tls.Config.
+func (b *Bridge) TLSConfig() (*stdlibtls.Config, error) {
+	return tls.New(
+		tls.WithClientAuthType(tls.ServerOnly),
+		tls.WithServerCertificateProvider(b),
+	)
+}
+
+// GetCA implements tls.CertificateProvider interface.
+func (b *Bridge) GetCA() ([]byte, error) {
+	return nil, nil
+}
+
+// GetCACertPool implements tls.CertificateProvider interface.
+func (b *Bridge) GetCACertPool() (*stdx509.CertPool, error) {
+	return nil, nil
+}
+
+// GetCertificate implements tls.CertificateProvider interface.
+func (b *Bridge) GetCertificate(*stdlibtls.ClientHelloInfo) (*stdlibtls.Certificate, error) {
+	return b.serverCert.Load(), nil
+}
+
+// GetClientCertificate implements tls.CertificateProvider interface.
+func (b *Bridge) GetClientCertificate(*stdlibtls.CertificateRequestInfo) (*stdlibtls.Certificate, error) {
+	return nil, nil
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (b *Bridge) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := b.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+func (b *Bridge) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := b.Authenticate(stream.Context())
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx //nolint:fatcontext
+
+		return handler(srv, wrapped)
+	}
+}
+
+// Authenticate verifies the service account token in the request metadata and returns the context with the granted roles.
+//
+// The token and any client-supplied roles are stripped from the metadata passed to machined.
+func (b *Bridge) Authenticate(ctx context.Context) (context.Context, error) {
+	b.mu.Lock()
+	spec, reviewer := b.spec, b.reviewer
+	b.mu.Unlock()
+
+	if spec == nil || reviewer == nil {
+		return nil, status.Error(codes.Unavailable, "service account access is not configured")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	token, ok := bearerToken(md)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing service account bearer token")
+	}
+
+	username, err := reviewer(ctx, token)
+	if err != nil {
+		b.logger.Debug("service account token review failed", zap.Error(err))
+
+		return nil, status.Error(codes.Unauthenticated, "invalid service account token")
+	}
+
+	namespace, name, ok := parseServiceAccount(username)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "%q is not a service account", username)
+	}
+
+	roles, _ := role.Parse(spec.ServiceAccountRoles(namespace, name))
+	if len(roles.Strings()) == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "service account %s/%s is not allowed to access Talos API", namespace, name)
+	}
+
+	if len(md.Get("node")) > 0 || len(md.Get("nodes")) > 0 {
+		return nil, status.Error(codes.PermissionDenied, "request forwarding is not supported")
+	}
+
+	md = md.Copy()
+	md.Delete("authorization")
+	md.Delete(constants.APIAuthzRoleMetadataKey)
+
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	caller := authz.CallerFromConnection(ctx)
+	caller.Identity = username
+	caller.AuthSource = authz.AuthSourceToken
+
+	ctx = authz.ContextWithCaller(ctx, caller)
+
+	return authz.ContextWithRoles(ctx, roles), nil
+}
+
+func bearerToken(md metadata.MD) (string, bool) {
+	values := md.Get("authorization")
+	if len(values) != 1 {
+		return "", false
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
+
+func parseServiceAccount(username string) (namespace, name string, ok bool) {
+	rest, ok := strings.CutPrefix(username, serviceAccountPrefix)
+	if !ok {
+		return "", "", false
+	}
+
+	namespace, name, ok = strings.Cut(rest, ":")
+	if !ok || namespace == "" || name == "" {
+		return "", "", false
+	}
+
+	return namespace, name, true
+}
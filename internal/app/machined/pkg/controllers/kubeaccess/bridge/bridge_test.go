@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bridge_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/kubeaccess/bridge"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubeaccess"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	b := bridge.New(zaptest.NewLogger(t))
+
+	b.Update(&kubeaccess.ConfigSpec{
+		Enabled:                     true,
+		AllowedAPIRoles:             []string{"os:reader", "os:operator"},
+		AllowedKubernetesNamespaces: []string{"kube-system"},
+		ServiceAccounts: []kubeaccess.ServiceAccountRoles{
+			{
+				Namespace: "kube-system",
+				Name:      "upgrader",
+				Roles:     []string{"os:operator"},
+			},
+		},
+	}, func(_ context.Context, token string) (string, error) {
+		switch token {
+		case "upgrader":
+			return "system:serviceaccount:kube-system:upgrader", nil
+		case "other":
+			return "system:serviceaccount:kube-system:other", nil
+		case "user":
+			return "admin", nil
+		default:
+			return "", errors.New("invalid token")
+		}
+	})
+
+	for _, test := range []struct {
+		name string
+		md   metadata.MD
+
+		expectedCode  codes.Code
+		expectedRoles role.Set
+	}{
+		{
+			name:          "valid token",
+			md:            metadata.Pairs("authorization", "Bearer upgrader", constants.APIAuthzRoleMetadataKey, "os:admin"),
+			expectedRoles: role.MakeSet(role.Operator),
+		},
+		{
+			name:         "no token",
+			md:           metadata.MD{},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "invalid token",
+			md:           metadata.Pairs("authorization", "Bearer foo"),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "service account without roles",
+			md:           metadata.Pairs("authorization", "Bearer other"),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "not a service account",
+			md:           metadata.Pairs("authorization", "Bearer user"),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "forwarding",
+			md:           metadata.Pairs("authorization", "Bearer upgrader", "nodes", "10.5.0.2"),
+			expectedCode: codes.PermissionDenied,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, err := b.Authenticate(metadata.NewIncomingContext(context.Background(), test.md))
+
+			if test.expectedCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedCode, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedRoles, authz.GetRoles(ctx))
+			assert.Equal(t, authz.AuthSourceToken, authz.GetCaller(ctx).AuthSource)
+
+			md, _ := metadata.FromIncomingContext(ctx)
+			assert.Empty(t, md.Get("authorization"))
+			assert.Empty(t, md.Get(constants.APIAuthzRoleMetadataKey))
+		})
+	}
+}
@@ -50,6 +50,14 @@ func NewConfigController() *ConfigController {
 					spec.Enabled = c.Machine().Features().KubernetesTalosAPIAccess().Enabled()
 					spec.AllowedAPIRoles = c.Machine().Features().KubernetesTalosAPIAccess().AllowedRoles()
 					spec.AllowedKubernetesNamespaces = c.Machine().Features().KubernetesTalosAPIAccess().AllowedKubernetesNamespaces()
+
+					for _, sa := range c.Machine().Features().KubernetesTalosAPIAccess().ServiceAccounts() {
+						spec.ServiceAccounts = append(spec.ServiceAccounts, kubeaccess.ServiceAccountRoles{
+							Namespace: sa.Namespace(),
+							Name:      sa.Name(),
+							Roles:     sa.Roles(),
+						})
+					}
 				}
 
 				return nil
@@ -36,6 +36,13 @@ func (suite *ConfigSuite) TestReconcileConfig() {
 					AccessEnabled:                     pointer.To(true),
 					AccessAllowedRoles:                []string{"os:admin"},
 					AccessAllowedKubernetesNamespaces: []string{"kube-system"},
+					AccessServiceAccounts: []v1alpha1.KubernetesTalosAPIAccessServiceAccount{
+						{
+							ServiceAccountNamespace: "kube-system",
+							ServiceAccountName:      "upgrader",
+							ServiceAccountRoles:     []string{"os:admin"},
+						},
+					},
 				},
 			},
 		},
@@ -49,6 +56,13 @@ func (suite *ConfigSuite) TestReconcileConfig() {
 		asrt.True(spec.Enabled)
 		asrt.Equal([]string{"os:admin"}, spec.AllowedAPIRoles)
 		asrt.Equal([]string{"kube-system"}, spec.AllowedKubernetesNamespaces)
+		asrt.Equal([]kubeaccess.ServiceAccountRoles{
+			{
+				Namespace: "kube-system",
+				Name:      "upgrader",
+				Roles:     []string{"os:admin"},
+			},
+		}, spec.ServiceAccounts)
 	})
 }
 
@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// ConntrackStatusController reports netfilter connection tracking table usage.
+type ConntrackStatusController struct {
+	// ProcSysPath is the path to /proc/sys, overridden in tests.
+	ProcSysPath string
+	// UpdateInterval is the interval between the updates, defaults to 30s.
+	UpdateInterval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ConntrackStatusController) Name() string {
+	return "network.ConntrackStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ConntrackStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ConntrackStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.ConntrackStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ConntrackStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.ProcSysPath == "" {
+		ctrl.ProcSysPath = "/proc/sys"
+	}
+
+	if ctrl.UpdateInterval == 0 {
+		ctrl.UpdateInterval = 30 * time.Second
+	}
+
+	ticker := time.NewTicker(ctrl.UpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		count, err := ctrl.readValue("nf_conntrack_count")
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// conntrack module is not loaded yet
+				continue
+			}
+
+			return err
+		}
+
+		maxEntries, err := ctrl.readValue("nf_conntrack_max")
+		if err != nil {
+			return err
+		}
+
+		buckets, err := ctrl.readValue("nf_conntrack_buckets")
+		if err != nil {
+			return err
+		}
+
+		if err = safe.WriterModify(ctx, r, network.NewConntrackStatus(network.NamespaceName, network.ConntrackStatusID), func(status *network.ConntrackStatus) error {
+			status.TypedSpec().Count = count
+			status.TypedSpec().Max = maxEntries
+			status.TypedSpec().Buckets = buckets
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating conntrack status: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *ConntrackStatusController) readValue(name string) (uint64, error) {
+	contents, err := os.ReadFile(filepath.Join(ctrl.ProcSysPath, "net", "netfilter", name))
+	if err != nil {
+		return 0, err
+	}
+
+	value, err := strconv.ParseUint(strings.TrimSpace(string(contents)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+
+	return value, nil
+}
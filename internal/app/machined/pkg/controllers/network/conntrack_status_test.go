@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type ConntrackStatusSuite struct {
+	ctest.DefaultSuite
+
+	procSysPath string
+}
+
+func (suite *ConntrackStatusSuite) writeValue(name, value string) {
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.procSysPath, "net", "netfilter", name), []byte(value+"\n"), 0o644))
+}
+
+func (suite *ConntrackStatusSuite) TestStatus() {
+	suite.writeValue("nf_conntrack_count", "42")
+	suite.writeValue("nf_conntrack_max", "262144")
+	suite.writeValue("nf_conntrack_buckets", "65536")
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.ConntrackStatusID},
+		func(status *network.ConntrackStatus, asrt *assert.Assertions) {
+			asrt.EqualValues(42, status.TypedSpec().Count)
+			asrt.EqualValues(262144, status.TypedSpec().Max)
+			asrt.EqualValues(65536, status.TypedSpec().Buckets)
+		},
+	)
+
+	suite.writeValue("nf_conntrack_count", "43")
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.ConntrackStatusID},
+		func(status *network.ConntrackStatus, asrt *assert.Assertions) {
+			asrt.EqualValues(43, status.TypedSpec().Count)
+		},
+	)
+}
+
+func TestConntrackStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &ConntrackStatusSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.procSysPath = t.TempDir()
+
+			suite.Require().NoError(os.MkdirAll(filepath.Join(s.procSysPath, "net", "netfilter"), 0o755))
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.ConntrackStatusController{
+				ProcSysPath:    s.procSysPath,
+				UpdateInterval: 100 * time.Millisecond,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package routeradv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"net"
+	"net/netip"
+	"slices"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv6"
+)
+
+// Router advertisement message constants (RFC 4861).
+const (
+	curHopLimit = 64
+
+	optionSourceLinkLayerAddress = 1
+	optionPrefixInformation      = 3
+	optionMTU                    = 5
+
+	prefixFlagOnLink     = 0x80
+	prefixFlagAutonomous = 0x40
+)
+
+// Spec describes the router advertisements sent on a link.
+type Spec struct {
+	HardwareAddr   net.HardwareAddr
+	MTU            uint32
+	Interval       time.Duration
+	RouterLifetime time.Duration
+	Prefixes       []Prefix
+}
+
+// Prefix is a prefix announced in the router advertisements.
+type Prefix struct {
+	Prefix            netip.Prefix
+	ValidLifetime     time.Duration
+	PreferredLifetime time.Duration
+}
+
+// Equal compares two specs.
+func (spec Spec) Equal(other Spec) bool {
+	return bytes.Equal(spec.HardwareAddr, other.HardwareAddr) &&
+		spec.MTU == other.MTU &&
+		spec.Interval == other.Interval &&
+		spec.RouterLifetime == other.RouterLifetime &&
+		slices.Equal(spec.Prefixes, other.Prefixes)
+}
+
+// Marshal encodes the router advertisement ICMPv6 message with the specified router lifetime.
+//
+// The checksum is left empty, as it is calculated by the kernel for the ICMPv6 sockets.
+func (spec Spec) Marshal(routerLifetime time.Duration) ([]byte, error) {
+	body := make([]byte, 12, 12+8+32*len(spec.Prefixes)+8)
+
+	body[0] = curHopLimit
+	// body[1]: managed & other configuration flags are not set, as there is no DHCPv6 server
+	binary.BigEndian.PutUint16(body[2:4], uint16(min(routerLifetime/time.Second, math.MaxUint16)))
+	// body[4:12]: reachable time and retransmission timer are unspecified
+
+	if len(spec.HardwareAddr) > 0 {
+		body = appendOption(body, optionSourceLinkLayerAddress, spec.HardwareAddr)
+	}
+
+	if spec.MTU > 0 {
+		option := make([]byte, 6)
+		binary.BigEndian.PutUint32(option[2:], spec.MTU)
+
+		body = appendOption(body, optionMTU, option)
+	}
+
+	for _, prefix := range spec.Prefixes {
+		option := make([]byte, 30)
+
+		option[0] = byte(prefix.Prefix.Bits())
+		option[1] = prefixFlagOnLink | prefixFlagAutonomous
+		binary.BigEndian.PutUint32(option[2:6], lifetimeSeconds(prefix.ValidLifetime))
+		binary.BigEndian.PutUint32(option[6:10], lifetimeSeconds(prefix.PreferredLifetime))
+		// option[10:14] is reserved
+
+		addr := prefix.Prefix.Masked().Addr().As16()
+		copy(option[14:], addr[:])
+
+		body = appendOption(body, optionPrefixInformation, option)
+	}
+
+	msg := icmp.Message{
+		Type: ipv6.ICMPTypeRouterAdvertisement,
+		Body: &icmp.RawBody{
+			Data: body,
+		},
+	}
+
+	return msg.Marshal(nil)
+}
+
+// appendOption appends the NDP option padded to the multiple of 8 bytes.
+func appendOption(b []byte, optionType byte, data []byte) []byte {
+	length := (len(data) + 2 + 7) / 8
+
+	b = append(b, optionType, byte(length))
+	b = append(b, data...)
+
+	return append(b, make([]byte, length*8-len(data)-2)...)
+}
+
+// lifetimeSeconds converts the lifetime to seconds, the maximum value means infinity.
+func lifetimeSeconds(lifetime time.Duration) uint32 {
+	return uint32(min(lifetime/time.Second, math.MaxUint32))
+}
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package routeradv_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/routeradv"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	spec := routeradv.Spec{
+		HardwareAddr:   net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01},
+		MTU:            1500,
+		Interval:       200 * time.Second,
+		RouterLifetime: 600 * time.Second,
+		Prefixes: []routeradv.Prefix{
+			{
+				Prefix:            netip.MustParsePrefix("2001:db8:1::1/64"),
+				ValidLifetime:     24 * time.Hour,
+				PreferredLifetime: 4 * time.Hour,
+			},
+		},
+	}
+
+	b, err := spec.Marshal(spec.RouterLifetime)
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte{
+		// type, code, checksum
+		134, 0, 0, 0,
+		// cur hop limit, flags, router lifetime
+		64, 0, 0x02, 0x58,
+		// reachable time, retransmission timer
+		0, 0, 0, 0, 0, 0, 0, 0,
+		// source link-layer address
+		1, 1, 0x02, 0x00, 0x00, 0x00, 0x00, 0x01,
+		// MTU
+		5, 1, 0, 0, 0, 0, 0x05, 0xdc,
+		// prefix information: length, flags
+		3, 4, 64, 0xc0,
+		// valid lifetime (86400), preferred lifetime (14400)
+		0x00, 0x01, 0x51, 0x80, 0x00, 0x00, 0x38, 0x40,
+		// reserved
+		0, 0, 0, 0,
+		// prefix
+		0x20, 0x01, 0x0d, 0xb8, 0x00, 0x01, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	}, b)
+
+	b, err = spec.Marshal(0)
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte{0, 0}, b[6:8])
+}
+
+func TestSpecEqual(t *testing.T) {
+	t.Parallel()
+
+	spec := routeradv.Spec{
+		HardwareAddr: net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01},
+		Interval:     200 * time.Second,
+		Prefixes: []routeradv.Prefix{
+			{
+				Prefix: netip.MustParsePrefix("2001:db8:1::/64"),
+			},
+		},
+	}
+
+	other := spec
+	other.Prefixes = []routeradv.Prefix{
+		{
+			Prefix: netip.MustParsePrefix("2001:db8:1::/64"),
+		},
+	}
+
+	assert.True(t, spec.Equal(other))
+
+	other.Prefixes = []routeradv.Prefix{
+		{
+			Prefix: netip.MustParsePrefix("2001:db8:2::/64"),
+		},
+	}
+
+	assert.False(t, spec.Equal(other))
+}
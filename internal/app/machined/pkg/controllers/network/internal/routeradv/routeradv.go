@@ -0,0 +1,249 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package routeradv implements IPv6 router advertisement sender (RFC 4861).
+package routeradv
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv6"
+)
+
+// Router advertisement timings (RFC 4861).
+const (
+	maxInitialRtrAdvertInterval = 16 * time.Second
+	maxInitialRtrAdvertisements = 3
+	minDelayBetweenRAs          = 3 * time.Second
+	maxRADelayTime              = 500 * time.Millisecond
+
+	retryInterval = 5 * time.Second
+)
+
+// Runner sends router advertisements on a link.
+//
+// Runner sends unsolicited router advertisements periodically, and responds to the router solicitations.
+type Runner struct {
+	LinkName string
+	Spec     Spec
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Start a runner with a given context.
+func (runner *Runner) Start(ctx context.Context, logger *zap.Logger) {
+	runner.wg.Add(1)
+
+	ctx, runner.cancel = context.WithCancel(ctx)
+
+	go func() {
+		defer runner.wg.Done()
+
+		runner.run(ctx, logger)
+	}()
+}
+
+// Stop a runner.
+//
+// Stopped runner sends a final router advertisement with zero router lifetime.
+func (runner *Runner) Stop() {
+	runner.cancel()
+
+	runner.wg.Wait()
+}
+
+func (runner *Runner) run(ctx context.Context, logger *zap.Logger) {
+	logger = logger.With(zap.String("link", runner.LinkName))
+
+	for {
+		err := runner.serve(ctx, logger)
+		if ctx.Err() != nil {
+			return
+		}
+
+		logger.Error("router advertisement failed, retrying", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (runner *Runner) serve(ctx context.Context, logger *zap.Logger) error {
+	message, err := runner.Spec.Marshal(runner.Spec.RouterLifetime)
+	if err != nil {
+		return fmt.Errorf("error encoding router advertisement: %w", err)
+	}
+
+	iface, err := net.InterfaceByName(runner.LinkName)
+	if err != nil {
+		return fmt.Errorf("error looking up link: %w", err)
+	}
+
+	conn, err := icmp.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return fmt.Errorf("error listening: %w", err)
+	}
+
+	pc := conn.IPv6PacketConn()
+
+	if err = setupConn(pc, iface); err != nil {
+		conn.Close() //nolint:errcheck
+
+		return err
+	}
+
+	dst := &net.IPAddr{IP: net.IPv6linklocalallnodes, Zone: iface.Name}
+
+	send := func(b []byte) error {
+		_, err := pc.WriteTo(b, &ipv6.ControlMessage{IfIndex: iface.Index, HopLimit: 255}, dst)
+
+		return err
+	}
+
+	solicitationCh := make(chan struct{}, 1)
+	readerDone := make(chan struct{})
+
+	go func() {
+		defer close(readerDone)
+
+		receiveSolicitations(pc, iface, solicitationCh)
+	}()
+
+	defer func() {
+		conn.Close() //nolint:errcheck
+
+		<-readerDone
+	}()
+
+	logger.Info("sending router advertisements")
+
+	var (
+		sent     int
+		lastSent time.Time
+	)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	nextSend := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// announce that the node is no longer a default router
+			final, err := runner.Spec.Marshal(0)
+			if err == nil {
+				err = send(final)
+			}
+
+			if err != nil {
+				logger.Warn("failed to send final router advertisement", zap.Error(err))
+			}
+
+			return nil
+		case <-solicitationCh:
+			delay := rand.N(maxRADelayTime)
+
+			if sinceLast := time.Since(lastSent); sinceLast+delay < minDelayBetweenRAs {
+				delay = minDelayBetweenRAs - sinceLast
+			}
+
+			if candidate := time.Now().Add(delay); candidate.Before(nextSend) {
+				nextSend = candidate
+
+				timer.Reset(delay)
+			}
+		case <-timer.C:
+			if err = send(message); err != nil {
+				return fmt.Errorf("error sending router advertisement: %w", err)
+			}
+
+			sent++
+			lastSent = time.Now()
+
+			interval := runner.nextInterval(sent)
+			nextSend = lastSent.Add(interval)
+
+			timer.Reset(interval)
+		}
+	}
+}
+
+// nextInterval returns a random interval before the next unsolicited router advertisement.
+func (runner *Runner) nextInterval(sent int) time.Duration {
+	maxInterval := runner.Spec.Interval
+	minInterval := maxInterval / 3
+
+	interval := minInterval + rand.N(maxInterval-minInterval+1)
+
+	if sent < maxInitialRtrAdvertisements {
+		interval = min(interval, maxInitialRtrAdvertInterval)
+	}
+
+	return interval
+}
+
+func setupConn(pc *ipv6.PacketConn, iface *net.Interface) error {
+	var filter ipv6.ICMPFilter
+
+	filter.SetAll(true)
+	filter.Accept(ipv6.ICMPTypeRouterSolicitation)
+
+	for _, step := range []struct {
+		name string
+		fn   func() error
+	}{
+		{"setting ICMP filter", func() error { return pc.SetICMPFilter(&filter) }},
+		{"setting control message", func() error { return pc.SetControlMessage(ipv6.FlagInterface|ipv6.FlagHopLimit, true) }},
+		{"setting multicast interface", func() error { return pc.SetMulticastInterface(iface) }},
+		{"setting multicast hop limit", func() error { return pc.SetMulticastHopLimit(255) }},
+		{"disabling multicast loopback", func() error { return pc.SetMulticastLoopback(false) }},
+		{"joining all-routers group", func() error { return pc.JoinGroup(iface, &net.IPAddr{IP: net.IPv6linklocalallrouters}) }},
+	} {
+		if err := step.fn(); err != nil {
+			return fmt.Errorf("error %s: %w", step.name, err)
+		}
+	}
+
+	return nil
+}
+
+// receiveSolicitations reads router solicitations on the link until the connection is closed.
+func receiveSolicitations(pc *ipv6.PacketConn, iface *net.Interface, solicitationCh chan<- struct{}) {
+	buf := make([]byte, max(iface.MTU, 1500))
+
+	for {
+		n, cm, _, err := pc.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		// router solicitations are link-local only, ignore forwarded or foreign link messages
+		if cm == nil || cm.IfIndex != iface.Index || cm.HopLimit != 255 {
+			continue
+		}
+
+		msg, err := icmp.ParseMessage(ipv6.ICMPTypeRouterSolicitation.Protocol(), buf[:n])
+		if err != nil || msg.Type != ipv6.ICMPTypeRouterSolicitation {
+			continue
+		}
+
+		select {
+		case solicitationCh <- struct{}{}:
+		default:
+		}
+	}
+}
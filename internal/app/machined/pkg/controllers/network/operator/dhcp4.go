@@ -6,10 +6,15 @@ package operator
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -24,8 +29,11 @@ import (
 	"go4.org/netipx"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
 )
 
 // DHCP4 implements the DHCPv4 network operator.
@@ -33,12 +41,21 @@ type DHCP4 struct {
 	logger *zap.Logger
 	state  state.State
 
-	linkName            string
-	routeMetric         uint32
-	skipHostnameRequest bool
-	requestMTU          bool
+	linkName              string
+	routeMetric           uint32
+	skipHostnameRequest   bool
+	requestMTU            bool
+	clientIdentifier      []byte
+	vendorClassIdentifier string
+	requestedOptions      []dhcpv4.OptionCode
+	persistLease          bool
+	leasePath             string
 
-	lease *nclient4.Lease
+	lease       *nclient4.Lease
+	leaseReused bool
+
+	// persistedLease is loaded from the STATE partition on start, and it is confirmed with the server on the first request
+	persistedLease *nclient4.Lease
 
 	mu          sync.Mutex
 	addresses   []network.AddressSpecSpec
@@ -47,16 +64,28 @@ type DHCP4 struct {
 	hostname    []network.HostnameSpecSpec
 	resolvers   []network.ResolverSpecSpec
 	timeservers []network.TimeServerSpecSpec
+	options     []network.DHCPOptionsStatusSpec
+	leases      []network.DHCPLeaseStatusSpec
 }
 
 // NewDHCP4 creates DHCPv4 operator.
 func NewDHCP4(logger *zap.Logger, linkName string, config network.DHCP4OperatorSpec, platform runtime.Platform, state state.State) *DHCP4 {
+	// client identifier is validated in the machine config, so the error is ignored here
+	clientIdentifier, _ := hex.DecodeString(strings.ReplaceAll(config.ClientIdentifier, ":", "")) //nolint:errcheck
+
 	return &DHCP4{
-		logger:              logger,
-		state:               state,
-		linkName:            linkName,
-		routeMetric:         config.RouteMetric,
-		skipHostnameRequest: config.SkipHostnameRequest,
+		logger:                logger,
+		state:                 state,
+		linkName:              linkName,
+		routeMetric:           config.RouteMetric,
+		skipHostnameRequest:   config.SkipHostnameRequest,
+		clientIdentifier:      clientIdentifier,
+		vendorClassIdentifier: config.VendorClassIdentifier,
+		requestedOptions: xslices.Map(config.RequestedOptions, func(code uint8) dhcpv4.OptionCode {
+			return dhcpv4.GenericOptionCode(code)
+		}),
+		persistLease: config.PersistLease,
+		leasePath:    filepath.Join(constants.StateMountPoint, fmt.Sprintf(constants.DHCP4LeaseFilenameFormat, linkName)),
 		// <3 azure
 		// When including dhcp.OptionInterfaceMTU we don't get a dhcp offer back on azure.
 		// So we'll need to explicitly exclude adding this option for azure.
@@ -134,6 +163,60 @@ func (d *DHCP4) waitForNetworkReady(ctx context.Context) error {
 	return nil
 }
 
+// stateMounted checks if the STATE partition is mounted, so that the lease can be persisted.
+func (d *DHCP4) stateMounted(ctx context.Context) bool {
+	_, err := d.state.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, runtimeres.MountStatusType, constants.StatePartitionLabel, resource.VersionUndefined))
+
+	return err == nil
+}
+
+// loadPersistedLease loads the lease persisted on the STATE partition before the reboot.
+func (d *DHCP4) loadPersistedLease(ctx context.Context) {
+	if !d.stateMounted(ctx) {
+		return
+	}
+
+	lease, err := loadLease(d.leasePath, time.Now())
+	if err != nil {
+		d.logger.Warn("failed to load persisted lease", zap.Error(err), zap.String("link", d.linkName))
+
+		d.removePersistedLease()
+
+		return
+	}
+
+	if lease != nil {
+		d.logger.Info("loaded persisted lease",
+			zap.String("link", d.linkName),
+			zap.Stringer("address", lease.ACK.YourIPAddr),
+			zap.Time("expires", leaseExpiration(lease)),
+		)
+	}
+
+	d.persistedLease = lease
+}
+
+// persistCurrentLease writes the current lease to the STATE partition, it returns true if the lease was persisted.
+func (d *DHCP4) persistCurrentLease(ctx context.Context) bool {
+	if !d.stateMounted(ctx) {
+		return false
+	}
+
+	if err := saveLease(d.leasePath, d.lease); err != nil {
+		d.logger.Warn("failed to persist lease", zap.Error(err), zap.String("link", d.linkName))
+
+		return false
+	}
+
+	return true
+}
+
+func (d *DHCP4) removePersistedLease() {
+	if err := os.Remove(d.leasePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		d.logger.Warn("failed to remove persisted lease", zap.Error(err), zap.String("link", d.linkName))
+	}
+}
+
 // Run the operator loop.
 //
 //nolint:gocyclo,cyclop
@@ -152,6 +235,13 @@ func (d *DHCP4) Run(ctx context.Context, notifyCh chan<- struct{}) {
 		d.logger.Warn("failed to watch for hostname changes", zap.Error(err))
 	}
 
+	if d.persistLease {
+		d.loadPersistedLease(ctx)
+	} else if d.stateMounted(ctx) {
+		// drop the lease persisted while the option was enabled, as it is not going to be renewed anymore
+		d.removePersistedLease()
+	}
+
 	for {
 		// Track if we need to acquire a new lease
 		newLease := d.lease == nil
@@ -294,6 +384,22 @@ func (d *DHCP4) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return d.timeservers
 }
 
+// DHCPOptionsStatuses implements Operator interface.
+func (d *DHCP4) DHCPOptionsStatuses() []network.DHCPOptionsStatusSpec {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.options
+}
+
+// DHCPLeaseStatuses implements Operator interface.
+func (d *DHCP4) DHCPLeaseStatuses() []network.DHCPLeaseStatusSpec {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.leases
+}
+
 //nolint:gocyclo
 func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool) {
 	d.mu.Lock()
@@ -444,6 +550,47 @@ func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool)
 	} else {
 		d.timeservers = nil
 	}
+
+	options := network.DHCPOptionsStatusSpec{
+		LinkName: d.linkName,
+	}
+
+	for _, code := range slices.Sorted(maps.Keys(ack.Options)) {
+		options.Options = append(options.Options, network.DHCPOption{
+			Code:  code,
+			Value: hex.EncodeToString(ack.Options[code]),
+		})
+	}
+
+	if domainSearch := ack.DomainSearch(); domainSearch != nil {
+		options.SearchDomains = domainSearch.Labels
+	}
+
+	d.options = []network.DHCPOptionsStatusSpec{options}
+}
+
+func (d *DHCP4) updateLeaseStatus(persisted bool) {
+	addr, _ := netipx.FromStdIPNet(&net.IPNet{
+		IP:   d.lease.ACK.YourIPAddr,
+		Mask: d.lease.ACK.SubnetMask(),
+	})
+
+	server, _ := netipx.FromStdIP(d.lease.ACK.ServerIdentifier())
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.leases = []network.DHCPLeaseStatusSpec{
+		{
+			LinkName:      d.linkName,
+			Address:       addr,
+			ServerAddress: server,
+			LeaseTime:     d.lease.ACK.IPAddressLeaseTime(defaultLeaseTime),
+			AcquiredAt:    d.lease.CreationTime,
+			Persisted:     persisted,
+			Reused:        d.leaseReused,
+		},
+	}
 }
 
 func (d *DHCP4) newClient() (*nclient4.Client, error) {
@@ -477,7 +624,7 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 	opts := []dhcpv4.OptionCode{
 		dhcpv4.OptionClasslessStaticRoute,
 		dhcpv4.OptionDomainNameServer,
-		// TODO(twelho): This is unused until network.ResolverSpec supports search domains
+		// exposed via DHCPOptionsStatus, not used for resolving until network.ResolverSpec supports search domains
 		dhcpv4.OptionDNSDomainSearchList,
 		dhcpv4.OptionNTPServers,
 	}
@@ -486,6 +633,8 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 		opts = append(opts, dhcpv4.OptionInterfaceMTU)
 	}
 
+	opts = append(opts, d.requestedOptions...)
+
 	sendHostnameRequest := !d.skipHostnameRequest
 	if hostname.Hostname != "" && !d.knownHostname(hostname) {
 		// If we are supposed to publish a hostname, don't request one from the DHCP server.
@@ -512,6 +661,14 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 
 	mods := []dhcpv4.Modifier{dhcpv4.WithRequestedOptions(opts...)}
 
+	if len(d.clientIdentifier) > 0 {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClientIdentifier(d.clientIdentifier)))
+	}
+
+	if d.vendorClassIdentifier != "" {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClassIdentifier(d.vendorClassIdentifier)))
+	}
+
 	if !sendHostnameRequest {
 		// If the node has a hostname, always send it to the DHCP
 		// server with option 12 during lease acquisition and renewal
@@ -539,9 +696,13 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 	case d.lease != nil && d.lease.Offer != nil:
 		d.logger.Debug("DHCP REQUEST FROM OFFER", zap.String("link", d.linkName))
 		d.lease, err = client.RequestFromOffer(ctx, d.lease.Offer, mods...)
+	case d.persistedLease != nil:
+		d.logger.Debug("DHCP INIT-REBOOT", zap.String("link", d.linkName))
+		d.lease, d.leaseReused, err = d.initReboot(ctx, client, mods...)
 	default:
 		d.logger.Debug("DHCP REQUEST", zap.String("link", d.linkName))
 		d.lease, err = client.Request(ctx, mods...)
+		d.leaseReused = false
 	}
 
 	if err != nil {
@@ -554,8 +715,64 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 	d.logger.Debug("DHCP ACK", zap.String("link", d.linkName), zap.String("dhcp", collapseSummary(d.lease.ACK.Summary())))
 
 	d.parseNetworkConfigFromAck(d.lease.ACK, sendHostnameRequest)
+	d.updateLeaseStatus(d.persistLease && d.persistCurrentLease(ctx))
+
+	// the lease might have been acquired before the reboot, so return the remaining lease time
+	return time.Until(leaseExpiration(d.lease)), nil
+}
+
+// initReboot attempts to reuse the persisted lease by confirming it with the DHCP server.
+//
+// If the server doesn't respond, the persisted lease is used for the remainder of its lease time (RFC 2131, section 3.7).
+// If the server declines the lease, the regular discovery sequence is performed.
+func (d *DHCP4) initReboot(ctx context.Context, client *nclient4.Client, mods ...dhcpv4.Modifier) (*nclient4.Lease, bool, error) {
+	// INIT-REBOOT is attempted only once
+	persisted := d.persistedLease
+	d.persistedLease = nil
+
+	// RFC 2131, section 4.3.2:
+	//     DHCPREQUEST generated during INIT-REBOOT state:
+	//     'server identifier' MUST NOT be filled in, 'requested IP address'
+	//     option MUST be filled in with client's notion of its previously
+	//     assigned address. 'ciaddr' MUST be zero. The client is seeking to
+	//     verify a previously allocated, cached configuration.
+	request, err := dhcpv4.New(dhcpv4.PrependModifiers(mods,
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeRequest),
+		dhcpv4.WithHwAddr(client.InterfaceAddr()),
+		dhcpv4.WithBroadcast(true),
+		dhcpv4.WithOption(dhcpv4.OptRequestedIPAddress(persisted.ACK.YourIPAddr)),
+		dhcpv4.WithOption(dhcpv4.OptMaxMessageSize(nclient4.MaxMessageSize)),
+	)...)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to create a request: %w", err)
+	}
+
+	response, err := client.SendAndRead(ctx, client.RemoteAddr(), request, nclient4.IsMessageType(dhcpv4.MessageTypeAck, dhcpv4.MessageTypeNak))
+
+	switch {
+	case errors.Is(err, nclient4.ErrNoResponse):
+		d.logger.Info("no response to INIT-REBOOT, using the persisted lease", zap.String("link", d.linkName))
+
+		return persisted, true, nil
+	case err != nil:
+		return nil, false, err
+	case response.MessageType() == dhcpv4.MessageTypeNak:
+		d.logger.Info("persisted lease was declined by the server", zap.String("link", d.linkName), zap.String("message", response.Message()))
+
+		d.removePersistedLease()
+
+		d.logger.Debug("DHCP REQUEST", zap.String("link", d.linkName))
+
+		lease, err := client.Request(ctx, mods...)
+
+		return lease, false, err
+	}
 
-	return d.lease.ACK.IPAddressLeaseTime(time.Minute * 30), nil
+	return &nclient4.Lease{
+		Offer:        response,
+		ACK:          response,
+		CreationTime: time.Now(),
+	}, true, nil
 }
 
 func collapseSummary(summary string) string {
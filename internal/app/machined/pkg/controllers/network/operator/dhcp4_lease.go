@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv4/nclient4"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultLeaseTime is used if the DHCP server doesn't specify the lease time.
+const defaultLeaseTime = 30 * time.Minute
+
+// persistedLease is the DHCPv4 lease as stored on the STATE partition.
+type persistedLease struct {
+	ACK          []byte    `yaml:"ack"`
+	CreationTime time.Time `yaml:"creationTime"`
+}
+
+// leaseExpiration returns the time when the lease expires.
+func leaseExpiration(lease *nclient4.Lease) time.Time {
+	return lease.CreationTime.Add(lease.ACK.IPAddressLeaseTime(defaultLeaseTime))
+}
+
+// saveLease writes the lease to the file.
+func saveLease(path string, lease *nclient4.Lease) error {
+	data, err := yaml.Marshal(persistedLease{
+		ACK:          lease.ACK.ToBytes(),
+		CreationTime: lease.CreationTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first to never leave a partially written lease behind
+	tmpPath := path + ".tmp"
+
+	if err = os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// loadLease reads the lease from the file.
+//
+// If the file doesn't exist or the lease is expired at the moment now, nil lease is returned.
+func loadLease(path string, now time.Time) (*nclient4.Lease, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var persisted persistedLease
+
+	if err = yaml.Unmarshal(data, &persisted); err != nil {
+		return nil, fmt.Errorf("error unmarshaling lease: %w", err)
+	}
+
+	ack, err := dhcpv4.FromBytes(persisted.ACK)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing lease ACK: %w", err)
+	}
+
+	if ack.MessageType() != dhcpv4.MessageTypeAck || ack.YourIPAddr.IsUnspecified() {
+		return nil, errors.New("lease doesn't contain a valid ACK")
+	}
+
+	lease := &nclient4.Lease{
+		// the server identifier is the only part of the offer used on renewal, and the ACK carries it as well
+		Offer:        ack,
+		ACK:          ack,
+		CreationTime: persisted.CreationTime,
+	}
+
+	if !now.Before(leaseExpiration(lease)) {
+		return nil, nil
+	}
+
+	return lease, nil
+}
@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv4/nclient4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/operator"
+)
+
+func TestLeasePersistence(t *testing.T) {
+	t.Parallel()
+
+	ack, err := dhcpv4.New(
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeAck),
+		dhcpv4.WithYourIP(net.ParseIP("172.20.0.2")),
+		dhcpv4.WithServerIP(net.ParseIP("172.20.0.1")),
+		dhcpv4.WithNetmask(net.CIDRMask(24, 32)),
+		dhcpv4.WithLeaseTime(3600),
+	)
+	require.NoError(t, err)
+
+	creationTime := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "lease.yaml")
+
+	// no lease persisted yet
+	lease, err := operator.LoadLease(path, creationTime)
+	require.NoError(t, err)
+	assert.Nil(t, lease)
+
+	require.NoError(t, operator.SaveLease(path, &nclient4.Lease{
+		Offer:        ack,
+		ACK:          ack,
+		CreationTime: creationTime,
+	}))
+
+	lease, err = operator.LoadLease(path, creationTime.Add(30*time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, lease)
+
+	assert.Equal(t, "172.20.0.2", lease.ACK.YourIPAddr.String())
+	assert.Equal(t, "172.20.0.1", lease.ACK.ServerIPAddr.String())
+	assert.Equal(t, time.Hour, lease.ACK.IPAddressLeaseTime(0))
+	assert.True(t, creationTime.Equal(lease.CreationTime))
+	assert.NotNil(t, lease.Offer)
+
+	// expired lease
+	lease, err = operator.LoadLease(path, creationTime.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Nil(t, lease)
+
+	// corrupted lease
+	require.NoError(t, os.WriteFile(path, []byte("ack: Zm9v\n"), 0o600))
+
+	_, err = operator.LoadLease(path, creationTime)
+	require.Error(t, err)
+}
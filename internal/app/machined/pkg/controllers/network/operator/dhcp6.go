@@ -147,6 +147,16 @@ func (d *DHCP6) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return d.timeservers
 }
 
+// DHCPOptionsStatuses implements Operator interface.
+func (d *DHCP6) DHCPOptionsStatuses() []network.DHCPOptionsStatusSpec {
+	return nil
+}
+
+// DHCPLeaseStatuses implements Operator interface.
+func (d *DHCP6) DHCPLeaseStatuses() []network.DHCPLeaseStatusSpec {
+	return nil
+}
+
 func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -24,4 +24,7 @@ type Operator interface {
 	HostnameSpecs() []network.HostnameSpecSpec
 	ResolverSpecs() []network.ResolverSpecSpec
 	TimeServerSpecs() []network.TimeServerSpecSpec
+
+	DHCPOptionsStatuses() []network.DHCPOptionsStatusSpec
+	DHCPLeaseStatuses() []network.DHCPLeaseStatusSpec
 }
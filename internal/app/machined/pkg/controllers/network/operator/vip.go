@@ -147,6 +147,16 @@ func (vip *VIP) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return nil
 }
 
+// DHCPOptionsStatuses implements Operator interface.
+func (vip *VIP) DHCPOptionsStatuses() []network.DHCPOptionsStatusSpec {
+	return nil
+}
+
+// DHCPLeaseStatuses implements Operator interface.
+func (vip *VIP) DHCPLeaseStatuses() []network.DHCPLeaseStatusSpec {
+	return nil
+}
+
 func (vip *VIP) etcdElectionKey() string {
 	return fmt.Sprintf("%s:vip:election:%s", constants.EtcdRootTalosKey, vip.sharedIP.String())
 }
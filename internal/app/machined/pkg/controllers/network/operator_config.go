@@ -144,7 +144,11 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 						LinkName:  device.Interface(),
 						RequireUp: true,
 						DHCP4: network.DHCP4OperatorSpec{
-							RouteMetric: routeMetric,
+							RouteMetric:           routeMetric,
+							ClientIdentifier:      device.DHCPOptions().ClientIdentifier(),
+							VendorClassIdentifier: device.DHCPOptions().VendorClassIdentifier(),
+							RequestedOptions:      device.DHCPOptions().RequestedOptions(),
+							PersistLease:          device.DHCPOptions().PersistLease(),
 						},
 						ConfigLayer: network.ConfigMachineConfiguration,
 					})
@@ -180,7 +184,11 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 							LinkName:  nethelpers.VLANLinkName(device.Interface(), vlan.ID()),
 							RequireUp: true,
 							DHCP4: network.DHCP4OperatorSpec{
-								RouteMetric: routeMetric,
+								RouteMetric:           routeMetric,
+								ClientIdentifier:      vlan.DHCPOptions().ClientIdentifier(),
+								VendorClassIdentifier: vlan.DHCPOptions().VendorClassIdentifier(),
+								RequestedOptions:      vlan.DHCPOptions().RequestedOptions(),
+								PersistLease:          vlan.DHCPOptions().PersistLease(),
 							},
 							ConfigLayer: network.ConfigMachineConfiguration,
 						})
@@ -249,8 +249,12 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP4() {
 								DeviceInterface: "eth3",
 								DeviceDHCP:      pointer.To(true),
 								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
-									DHCPIPv4:        pointer.To(true),
-									DHCPRouteMetric: 256,
+									DHCPIPv4:                  pointer.To(true),
+									DHCPRouteMetric:           256,
+									DHCPClientIdentifier:      "01:00:11:22:33:44:55",
+									DHCPVendorClassIdentifier: "talos",
+									DHCPRequestedOptions:      []uint8{119},
+									DHCPPersistLease:          pointer.To(true),
 								},
 							},
 							{
@@ -307,6 +311,10 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP4() {
 			case "configuration/dhcp4/eth3":
 				asrt.Equal("eth3", r.TypedSpec().LinkName)
 				asrt.EqualValues(256, r.TypedSpec().DHCP4.RouteMetric)
+				asrt.Equal("01:00:11:22:33:44:55", r.TypedSpec().DHCP4.ClientIdentifier)
+				asrt.Equal("talos", r.TypedSpec().DHCP4.VendorClassIdentifier)
+				asrt.Equal([]uint8{119}, r.TypedSpec().DHCP4.RequestedOptions)
+				asrt.True(r.TypedSpec().DHCP4.PersistLease)
 			case "configuration/dhcp4/eth4.25":
 				asrt.Equal("eth4.25", r.TypedSpec().LinkName)
 				asrt.EqualValues(network.DefaultRouteMetric, r.TypedSpec().DHCP4.RouteMetric)
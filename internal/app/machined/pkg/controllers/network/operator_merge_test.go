@@ -287,7 +287,7 @@ func (suite *OperatorMergeSuite) TestMergeFlapping() {
 							return retry.ExpectedErrorf("resource phase is %s", r.Metadata().Phase())
 						}
 
-						if *override.TypedSpec() != *r.TypedSpec() {
+						if !override.TypedSpec().Equal(*r.TypedSpec()) || override.TypedSpec().ConfigLayer != r.TypedSpec().ConfigLayer {
 							// using retry here, as it might not be reconciled immediately
 							return retry.ExpectedErrorf("not equal yet")
 						}
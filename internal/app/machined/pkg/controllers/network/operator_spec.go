@@ -81,6 +81,14 @@ func (ctrl *OperatorSpecController) Outputs() []controller.Output {
 			Type: network.TimeServerSpecType,
 			Kind: controller.OutputShared,
 		},
+		{
+			Type: network.DHCPOptionsStatusType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: network.DHCPLeaseStatusType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -366,18 +374,53 @@ func (ctrl *OperatorSpecController) reconcileOperatorOutputs(ctx context.Context
 				return fmt.Errorf("error applying spec: %w", err)
 			}
 		}
+
+		for _, optionsStatus := range op.Operator.DHCPOptionsStatuses() {
+			if err := apply(
+				network.NewDHCPOptionsStatus(
+					network.NamespaceName,
+					op.Operator.Prefix(),
+				),
+				func(r resource.Resource) {
+					*r.(*network.DHCPOptionsStatus).TypedSpec() = optionsStatus
+				},
+			); err != nil {
+				return fmt.Errorf("error applying status: %w", err)
+			}
+		}
+
+		for _, leaseStatus := range op.Operator.DHCPLeaseStatuses() {
+			if err := apply(
+				network.NewDHCPLeaseStatus(
+					network.NamespaceName,
+					op.Operator.Prefix(),
+				),
+				func(r resource.Resource) {
+					*r.(*network.DHCPLeaseStatus).TypedSpec() = leaseStatus
+				},
+			); err != nil {
+				return fmt.Errorf("error applying status: %w", err)
+			}
+		}
 	}
 
 	// clean up not touched specs
-	for _, resourceType := range []resource.Type{
-		network.AddressSpecType,
-		network.LinkSpecType,
-		network.RouteSpecType,
-		network.HostnameSpecType,
-		network.ResolverSpecType,
-		network.TimeServerSpecType,
+	for _, output := range []struct {
+		namespace    resource.Namespace
+		resourceType resource.Type
+	}{
+		{network.ConfigNamespaceName, network.AddressSpecType},
+		{network.ConfigNamespaceName, network.LinkSpecType},
+		{network.ConfigNamespaceName, network.RouteSpecType},
+		{network.ConfigNamespaceName, network.HostnameSpecType},
+		{network.ConfigNamespaceName, network.ResolverSpecType},
+		{network.ConfigNamespaceName, network.TimeServerSpecType},
+		{network.NamespaceName, network.DHCPOptionsStatusType},
+		{network.NamespaceName, network.DHCPLeaseStatusType},
 	} {
-		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, resourceType, "", resource.VersionUndefined))
+		resourceType := output.resourceType
+
+		list, err := r.List(ctx, resource.NewMetadata(output.namespace, resourceType, "", resource.VersionUndefined))
 		if err != nil {
 			return fmt.Errorf("error listing specs: %w", err)
 		}
@@ -141,6 +141,14 @@ func (mock *mockOperator) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return mock.timeservers
 }
 
+func (mock *mockOperator) DHCPOptionsStatuses() []network.DHCPOptionsStatusSpec {
+	return nil
+}
+
+func (mock *mockOperator) DHCPLeaseStatuses() []network.DHCPLeaseStatusSpec {
+	return nil
+}
+
 func (suite *OperatorSpecSuite) newOperator(logger *zap.Logger, spec *network.OperatorSpecSpec) operator.Operator {
 	return &mockOperator{
 		spec: *spec,
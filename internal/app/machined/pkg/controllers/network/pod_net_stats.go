@@ -0,0 +1,202 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/procfs"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/containers"
+	"github.com/siderolabs/talos/internal/pkg/containers/cri"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// errPodsUnavailable is returned when the pods can't be listed, e.g. while CRI is restarting.
+var errPodsUnavailable = errors.New("pods are not available")
+
+// PodNetStatsController publishes network interface counters of the Kubernetes pods.
+type PodNetStatsController struct {
+	// InspectorFactory creates CRI inspector, overridden in tests.
+	InspectorFactory func(ctx context.Context) (containers.Inspector, error)
+	// ProcPath is the path to /proc, overridden in tests.
+	ProcPath string
+	// UpdateInterval is the interval between the updates, defaults to 30s.
+	UpdateInterval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *PodNetStatsController) Name() string {
+	return "network.PodNetStatsController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *PodNetStatsController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some("cri"),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *PodNetStatsController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.PodNetStatsType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *PodNetStatsController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.InspectorFactory == nil {
+		ctrl.InspectorFactory = func(ctx context.Context) (containers.Inspector, error) {
+			return cri.NewInspector(ctx)
+		}
+	}
+
+	if ctrl.ProcPath == "" {
+		ctrl.ProcPath = procfs.DefaultMountPoint
+	}
+
+	if ctrl.UpdateInterval == 0 {
+		ctrl.UpdateInterval = 30 * time.Second
+	}
+
+	ticker := time.NewTicker(ctrl.UpdateInterval)
+	defer ticker.Stop()
+
+	var criIsUp bool
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-r.EventCh():
+			criService, err := safe.ReaderGet[*v1alpha1.Service](ctx, r, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "cri", resource.VersionUndefined))
+			if err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting CRI service: %w", err)
+			}
+
+			criIsUp = criService != nil && criService.TypedSpec().Running && criService.TypedSpec().Healthy
+		}
+
+		r.StartTrackingOutputs()
+
+		if criIsUp {
+			if err := ctrl.updateStats(ctx, r); err != nil {
+				if !errors.Is(err, errPodsUnavailable) {
+					return err
+				}
+
+				// CRI might be restarting, keep the last published stats and retry on the next tick
+				logger.Debug("error listing pods", zap.Error(err))
+
+				continue
+			}
+		}
+
+		if err := safe.CleanupOutputs[*network.PodNetStats](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *PodNetStatsController) updateStats(ctx context.Context, r controller.Runtime) error {
+	fs, err := procfs.NewFS(ctrl.ProcPath)
+	if err != nil {
+		return fmt.Errorf("error opening procfs: %w", err)
+	}
+
+	inspector, err := ctrl.InspectorFactory(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating CRI inspector: %w", err)
+	}
+
+	defer inspector.Close() //nolint:errcheck
+
+	pods, err := inspector.Pods()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errPodsUnavailable, err)
+	}
+
+	for _, pod := range pods {
+		sandbox := podSandbox(pod)
+		if sandbox == nil {
+			continue
+		}
+
+		namespace, podName, _ := strings.Cut(pod.Name, "/")
+
+		proc, err := fs.Proc(int(sandbox.Pid))
+		if err != nil {
+			// pod sandbox process is gone
+			continue
+		}
+
+		netDev, err := proc.NetDev()
+		if err != nil {
+			continue
+		}
+
+		for _, line := range netDev {
+			if line.Name == "lo" {
+				continue
+			}
+
+			if err = safe.WriterModify(ctx, r, network.NewPodNetStats(network.NamespaceName, network.PodNetStatsID(namespace, podName, line.Name)),
+				func(res *network.PodNetStats) error {
+					spec := res.TypedSpec()
+
+					spec.Namespace = namespace
+					spec.Pod = podName
+					spec.Interface = line.Name
+					spec.RxBytes = line.RxBytes
+					spec.TxBytes = line.TxBytes
+					spec.RxPackets = line.RxPackets
+					spec.TxPackets = line.TxPackets
+					spec.RxDropped = line.RxDropped
+					spec.TxDropped = line.TxDropped
+
+					return nil
+				},
+			); err != nil {
+				return fmt.Errorf("error updating pod network stats: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// podSandbox returns the pod sandbox container if the pod runs in a separate network namespace.
+func podSandbox(pod *containers.Pod) *containers.Container {
+	for _, container := range pod.Containers {
+		if container.IsPodSandbox && container.Pid != 0 && container.NetworkNamespace != "" {
+			return container
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/internal/pkg/containers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+const podNetDev = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:     100       1    0    0    0     0          0         0      100       1    0    0    0     0       0          0
+  eth0:    2000      20    0    3    0     0          0         0     1000      10    0    4    0     0       0          0
+`
+
+type fakeInspector struct {
+	mu   sync.Mutex
+	pods []*containers.Pod
+	err  error
+}
+
+func (i *fakeInspector) Pods() ([]*containers.Pod, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.pods, i.err
+}
+
+func (i *fakeInspector) setError(err error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.err = err
+}
+
+func (i *fakeInspector) Container(string) (*containers.Container, error) { return nil, nil }
+
+func (i *fakeInspector) Close() error { return nil }
+
+func (i *fakeInspector) GetProcessStderr(string) (string, error) { return "", nil }
+
+func (i *fakeInspector) Kill(string, bool, syscall.Signal) error { return nil }
+
+type PodNetStatsSuite struct {
+	ctest.DefaultSuite
+
+	procPath  string
+	inspector *fakeInspector
+}
+
+func (suite *PodNetStatsSuite) TestStats() {
+	suite.Require().NoError(os.MkdirAll(filepath.Join(suite.procPath, "1234", "net"), 0o755))
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.procPath, "1234", "net", "dev"), []byte(podNetDev), 0o644))
+
+	rtestutils.AssertNoResource[*network.PodNetStats](suite.Ctx(), suite.T(), suite.State(), network.PodNetStatsID("kube-system", "coredns-1", "eth0"))
+
+	cri := v1alpha1.NewService("cri")
+	cri.TypedSpec().Running = true
+	cri.TypedSpec().Healthy = true
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cri))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.PodNetStatsID("kube-system", "coredns-1", "eth0")},
+		func(stats *network.PodNetStats, asrt *assert.Assertions) {
+			spec := stats.TypedSpec()
+
+			asrt.Equal("kube-system", spec.Namespace)
+			asrt.Equal("coredns-1", spec.Pod)
+			asrt.Equal("eth0", spec.Interface)
+			asrt.EqualValues(2000, spec.RxBytes)
+			asrt.EqualValues(1000, spec.TxBytes)
+			asrt.EqualValues(20, spec.RxPackets)
+			asrt.EqualValues(10, spec.TxPackets)
+			asrt.EqualValues(3, spec.RxDropped)
+			asrt.EqualValues(4, spec.TxDropped)
+		},
+	)
+
+	// loopback and host network pods are skipped
+	rtestutils.AssertLength[*network.PodNetStats](suite.Ctx(), suite.T(), suite.State(), 1)
+
+	// the stats are kept while the pods can't be listed
+	suite.inspector.setError(errors.New("connection refused"))
+
+	time.Sleep(500 * time.Millisecond)
+
+	rtestutils.AssertLength[*network.PodNetStats](suite.Ctx(), suite.T(), suite.State(), 1)
+
+	suite.inspector.setError(nil)
+
+	cri.TypedSpec().Running = false
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cri))
+
+	rtestutils.AssertNoResource[*network.PodNetStats](suite.Ctx(), suite.T(), suite.State(), network.PodNetStatsID("kube-system", "coredns-1", "eth0"))
+}
+
+func TestPodNetStatsSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &PodNetStatsSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.procPath = t.TempDir()
+
+			s.inspector = &fakeInspector{
+				pods: []*containers.Pod{
+					{
+						Name: "kube-system/coredns-1",
+						Containers: []*containers.Container{
+							{
+								IsPodSandbox:     true,
+								Pid:              1234,
+								NetworkNamespace: "cni-1234",
+							},
+						},
+					},
+					{
+						Name: "kube-system/kube-proxy-1",
+						Containers: []*containers.Container{
+							{
+								IsPodSandbox: true,
+								Pid:          1,
+							},
+						},
+					},
+				},
+			}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.PodNetStatsController{
+				InspectorFactory: func(context.Context) (containers.Inspector, error) {
+					return s.inspector, nil
+				},
+				ProcPath:       s.procPath,
+				UpdateInterval: 100 * time.Millisecond,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
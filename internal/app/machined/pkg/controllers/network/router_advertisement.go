@@ -0,0 +1,153 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/routeradv"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RouterAdvertisementController sends IPv6 router advertisements on the links configured with RouterAdvertisementConfig documents.
+type RouterAdvertisementController struct {
+	runners map[string]*routeradv.Runner
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *RouterAdvertisementController) Name() string {
+	return "network.RouterAdvertisementController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RouterAdvertisementController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RouterAdvertisementController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RouterAdvertisementController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ctrl.runners = make(map[string]*routeradv.Runner)
+
+	defer func() {
+		for _, runner := range ctrl.runners {
+			runner.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err := ctrl.reconcileRunners(ctx, r, logger); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *RouterAdvertisementController) reconcileRunners(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting machine config: %w", err)
+	}
+
+	links, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing links: %w", err)
+	}
+
+	// figure out which links should have router advertisements
+	shouldRun := make(map[string]routeradv.Spec)
+
+	if cfg != nil {
+		for _, raConfig := range cfg.Config().RouterAdvertisements() {
+			link, found := links.Find(func(link *network.LinkStatus) bool {
+				return link.Metadata().ID() == raConfig.Interface()
+			})
+			if !found {
+				// the link might appear later, the controller is notified on link changes
+				logger.Debug("link for router advertisements not found", zap.String("link", raConfig.Interface()))
+
+				continue
+			}
+
+			shouldRun[raConfig.Interface()] = routeradv.Spec{
+				HardwareAddr:   net.HardwareAddr(link.TypedSpec().HardwareAddr),
+				MTU:            link.TypedSpec().MTU,
+				Interval:       raConfig.Interval(),
+				RouterLifetime: raConfig.RouterLifetime(),
+				Prefixes: xslices.Map(raConfig.Prefixes(), func(prefix talosconfig.RouterAdvertisementPrefix) routeradv.Prefix {
+					return routeradv.Prefix{
+						Prefix:            prefix.Prefix(),
+						ValidLifetime:     prefix.ValidLifetime(),
+						PreferredLifetime: prefix.PreferredLifetime(),
+					}
+				}),
+			}
+		}
+	}
+
+	// stop runners which shouldn't run
+	for linkName, runner := range ctrl.runners {
+		if spec, exists := shouldRun[linkName]; !exists {
+			logger.Debug("stopping router advertisements", zap.String("link", linkName))
+
+			runner.Stop()
+			delete(ctrl.runners, linkName)
+		} else if !spec.Equal(runner.Spec) {
+			logger.Debug("replacing router advertisements", zap.String("link", linkName))
+
+			runner.Stop()
+			delete(ctrl.runners, linkName)
+		}
+	}
+
+	// start runners which aren't running
+	for linkName, spec := range shouldRun {
+		if _, exists := ctrl.runners[linkName]; !exists {
+			ctrl.runners[linkName] = &routeradv.Runner{
+				LinkName: linkName,
+				Spec:     spec,
+			}
+
+			logger.Debug("starting router advertisements", zap.String("link", linkName))
+			ctrl.runners[linkName].Start(ctx, logger)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// AttestationStatusController periodically refreshes the TPM quote in the AttestationStatus resource.
+type AttestationStatusController struct {
+	V1Alpha1Mode machineruntime.Mode
+
+	// Interval is the interval between the quote refreshes, defaults to constants.AttestationRefreshInterval.
+	Interval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *AttestationStatusController) Name() string {
+	return "runtime.AttestationStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *AttestationStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *AttestationStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtimeres.AttestationStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *AttestationStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// in container mode, there is no TPM
+	if ctrl.V1Alpha1Mode == machineruntime.ModeContainer {
+		return nil
+	}
+
+	if ctrl.Interval == 0 {
+		ctrl.Interval = constants.AttestationRefreshInterval
+	}
+
+	ticker := time.NewTicker(ctrl.Interval)
+	defer ticker.Stop()
+
+	for {
+		status, err := ctrl.attest()
+		if err != nil {
+			// if the TPM is not available or not a TPM 2.0, there is nothing to attest
+			if os.IsNotExist(err) || strings.Contains(err.Error(), "device is not a TPM 2.0") {
+				logger.Info("TPM device is not available, skipping attestation")
+
+				return nil
+			}
+
+			// don't fail the controller, the quote will be retried on the next tick
+			logger.Warn("failed to refresh attestation status", zap.Error(err))
+		} else {
+			if err = safe.WriterModify(ctx, r, runtimeres.NewAttestationStatus(), func(res *runtimeres.AttestationStatus) error {
+				*res.TypedSpec() = *status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating attestation status: %w", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+	}
+}
+
+func (ctrl *AttestationStatusController) attest() (*runtimeres.AttestationStatusSpec, error) {
+	nonce := make([]byte, 32)
+
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("error generating nonce: %w", err)
+	}
+
+	return tpm2.Quote(nonce)
+}
@@ -0,0 +1,259 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/maps"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/cgroup"
+	"github.com/siderolabs/talos/internal/pkg/cgroups"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// cgroupCPUPeriod is the CPU period (in usec) used for the CPU limits.
+const cgroupCPUPeriod = 100000
+
+// CgroupLimitsController enforces the system service cgroup limits and publishes the cgroup usage.
+type CgroupLimitsController struct {
+	V1Alpha1Mode v1alpha1runtime.Mode
+
+	// CgroupRoot is the path to the cgroup hierarchy root, defaults to the Talos cgroup root.
+	CgroupRoot string
+	// Interval is the interval between the usage updates.
+	Interval time.Duration
+
+	// managed is the set of the cgroups with the limits set by the controller.
+	managed map[string]struct{}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *CgroupLimitsController) Name() string {
+	return "runtime.CgroupLimitsController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *CgroupLimitsController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *CgroupLimitsController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.CgroupStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *CgroupLimitsController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// cgroups are managed by the host in container mode
+	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
+		return nil
+	}
+
+	if ctrl.CgroupRoot == "" {
+		ctrl.CgroupRoot = filepath.Join(constants.CgroupMountPath, cgroup.Path("/"))
+	}
+
+	if ctrl.Interval == 0 {
+		ctrl.Interval = 30 * time.Second
+	}
+
+	ctrl.managed = map[string]struct{}{}
+
+	ticker := time.NewTicker(ctrl.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		limits := map[string]talosconfig.CgroupLimitsConfig{}
+
+		if cfg != nil {
+			for _, limit := range cfg.Config().CgroupLimits() {
+				limits[limit.Name()] = limit
+			}
+		}
+
+		if err = ctrl.reconcile(ctx, r, logger, limits); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *CgroupLimitsController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger, limits map[string]talosconfig.CgroupLimitsConfig) error {
+	r.StartTrackingOutputs()
+
+	names := maps.Keys(runtimecfg.CgroupLimitsTargets)
+	slices.Sort(names)
+
+	for _, name := range names {
+		cgroupPath := runtimecfg.CgroupLimitsTargets[name]
+		dir := filepath.Join(ctrl.CgroupRoot, cgroupPath)
+
+		if _, err := os.Stat(dir); err != nil {
+			// the cgroup is not created yet (e.g. the service is not running)
+			continue
+		}
+
+		if limit, ok := limits[name]; ok {
+			if err := applyCgroupLimits(dir, cgroupPath, limit.CPULimitMillicores(), limit.MemoryMax()); err != nil {
+				logger.Warn("error applying cgroup limits", zap.String("cgroup", name), zap.Error(err))
+			} else {
+				ctrl.managed[name] = struct{}{}
+			}
+		} else if _, ok := ctrl.managed[name]; ok {
+			// the limits were removed from the config, restore the defaults
+			if err := applyCgroupLimits(dir, cgroupPath, 0, 0); err != nil {
+				logger.Warn("error resetting cgroup limits", zap.String("cgroup", name), zap.Error(err))
+			} else {
+				delete(ctrl.managed, name)
+			}
+		}
+
+		node, err := readCgroupNode(dir)
+		if err != nil {
+			logger.Debug("error reading cgroup", zap.String("cgroup", name), zap.Error(err))
+
+			continue
+		}
+
+		if err = safe.WriterModify(ctx, r, runtime.NewCgroupStatus(name), func(res *runtime.CgroupStatus) error {
+			spec := res.TypedSpec()
+
+			spec.Path = cgroupPath
+			spec.CPULimitMillicores = 0
+			spec.MemoryMax = 0
+
+			if len(node.CPUMax) == 2 && !node.CPUMax[0].IsMax && node.CPUMax[1].Val > 0 {
+				spec.CPULimitMillicores = uint32(node.CPUMax[0].Val * 1000 / node.CPUMax[1].Val)
+			}
+
+			if node.MemoryMax.IsSet && !node.MemoryMax.IsMax {
+				spec.MemoryMax = uint64(node.MemoryMax.Val)
+			}
+
+			spec.MemoryCurrent = uint64(node.MemoryCurrent.Val)
+			spec.CPUUsageUsec = uint64(node.CPUStat["usage_usec"].Val)
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating cgroup status: %w", err)
+		}
+	}
+
+	return safe.CleanupOutputs[*runtime.CgroupStatus](ctx, r)
+}
+
+// applyCgroupLimits writes the cgroup limits, zero values restore the defaults.
+func applyCgroupLimits(dir, cgroupPath string, cpuMillicores uint32, memoryMax uint64) error {
+	cpuMax := "max " + strconv.Itoa(cgroupCPUPeriod)
+
+	if cpuMillicores != 0 {
+		cpuMax = strconv.FormatUint(uint64(cpuMillicores)*cgroupCPUPeriod/1000, 10) + " " + strconv.Itoa(cgroupCPUPeriod)
+	}
+
+	memMax := defaultCgroupMemoryMax(cgroupPath)
+
+	if memoryMax != 0 {
+		memMax = strconv.FormatUint(memoryMax, 10)
+	}
+
+	return errors.Join(
+		writeCgroupFile(filepath.Join(dir, "cpu.max"), cpuMax),
+		writeCgroupFile(filepath.Join(dir, "memory.max"), memMax),
+	)
+}
+
+// defaultCgroupMemoryMax returns the memory limit set for the cgroup at boot.
+func defaultCgroupMemoryMax(cgroupPath string) string {
+	switch cgroupPath {
+	case constants.CgroupApid:
+		return strconv.Itoa(constants.CgroupApidMaxMemory)
+	case constants.CgroupTrustd:
+		return strconv.Itoa(constants.CgroupTrustdMaxMemory)
+	case constants.CgroupDashboard:
+		return strconv.Itoa(constants.CgroupDashboardMaxMemory)
+	default:
+		return "max"
+	}
+}
+
+func writeCgroupFile(path, value string) error {
+	current, err := os.ReadFile(path)
+	if err == nil && strings.TrimSpace(string(current)) == value {
+		return nil
+	}
+
+	if err = os.WriteFile(path, []byte(value), 0o644); err != nil {
+		return fmt.Errorf("error writing %q: %w", filepath.Base(path), err)
+	}
+
+	return nil
+}
+
+func readCgroupNode(dir string) (*cgroups.Node, error) {
+	var node cgroups.Node
+
+	for _, name := range []string{"cpu.max", "cpu.stat", "memory.current", "memory.max"} {
+		if err := parseCgroupFile(&node, dir, name); err != nil {
+			return nil, err
+		}
+	}
+
+	return &node, nil
+}
+
+func parseCgroupFile(node *cgroups.Node, dir, name string) error {
+	f, err := os.Open(filepath.Join(dir, name))
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	return node.Parse(name, f)
+}
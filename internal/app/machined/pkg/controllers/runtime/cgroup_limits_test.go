@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type CgroupLimitsSuite struct {
+	ctest.DefaultSuite
+
+	root string
+}
+
+func TestCgroupLimitsSuite(t *testing.T) {
+	s := &CgroupLimitsSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.root = suite.T().TempDir()
+
+			for _, cgroupPath := range []string{constants.CgroupKubelet, constants.CgroupApid} {
+				dir := filepath.Join(s.root, cgroupPath)
+
+				suite.Require().NoError(os.MkdirAll(dir, 0o755))
+
+				for name, contents := range map[string]string{
+					"cpu.max":        "max 100000\n",
+					"cpu.stat":       "usage_usec 5000\nuser_usec 3000\nsystem_usec 2000\n",
+					"memory.current": "1048576\n",
+					"memory.max":     "max\n",
+				} {
+					suite.Require().NoError(os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644))
+				}
+			}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.CgroupLimitsController{
+				CgroupRoot: s.root,
+				Interval:   10 * time.Millisecond,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *CgroupLimitsSuite) readFile(cgroupPath, name string) string {
+	contents, err := os.ReadFile(filepath.Join(suite.root, cgroupPath, name))
+	suite.Require().NoError(err)
+
+	return strings.TrimSpace(string(contents))
+}
+
+func (suite *CgroupLimitsSuite) TestLimits() {
+	// without the config, only the usage is reported
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"apid", "kubelet"},
+		func(res *runtime.CgroupStatus, asrt *assert.Assertions) {
+			asrt.Zero(res.TypedSpec().CPULimitMillicores)
+			asrt.Zero(res.TypedSpec().MemoryMax)
+			asrt.EqualValues(1048576, res.TypedSpec().MemoryCurrent)
+			asrt.EqualValues(5000, res.TypedSpec().CPUUsageUsec)
+		},
+	)
+
+	kubeletLimits := runtimecfg.NewCgroupLimitsV1Alpha1()
+	kubeletLimits.MetaName = "kubelet"
+	kubeletLimits.CPUMillicores = 1500
+	kubeletLimits.MemoryMaxSize = "1GiB"
+
+	apidLimits := runtimecfg.NewCgroupLimitsV1Alpha1()
+	apidLimits.MetaName = "apid"
+	apidLimits.MemoryMaxSize = "64MiB"
+
+	cfg, err := container.New(kubeletLimits, apidLimits)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"kubelet"},
+		func(res *runtime.CgroupStatus, asrt *assert.Assertions) {
+			asrt.Equal(constants.CgroupKubelet, res.TypedSpec().Path)
+			asrt.EqualValues(1500, res.TypedSpec().CPULimitMillicores)
+			asrt.EqualValues(1024*1024*1024, res.TypedSpec().MemoryMax)
+		},
+	)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"apid"},
+		func(res *runtime.CgroupStatus, asrt *assert.Assertions) {
+			asrt.Zero(res.TypedSpec().CPULimitMillicores)
+			asrt.EqualValues(64*1024*1024, res.TypedSpec().MemoryMax)
+		},
+	)
+
+	suite.Assert().Equal("150000 100000", suite.readFile(constants.CgroupKubelet, "cpu.max"))
+	suite.Assert().Equal("1073741824", suite.readFile(constants.CgroupKubelet, "memory.max"))
+
+	// removing the limits restores the defaults
+	cfg, err = container.New(kubeletLimits)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"apid"},
+		func(res *runtime.CgroupStatus, asrt *assert.Assertions) {
+			asrt.EqualValues(constants.CgroupApidMaxMemory, res.TypedSpec().MemoryMax)
+		},
+	)
+
+	suite.Assert().Equal("max 100000", suite.readFile(constants.CgroupApid, "cpu.max"))
+
+	// removed cgroups are cleaned up
+	suite.Require().NoError(os.RemoveAll(filepath.Join(suite.root, constants.CgroupApid)))
+
+	rtestutils.AssertNoResource[*runtime.CgroupStatus](suite.Ctx(), suite.T(), suite.State(), "apid")
+}
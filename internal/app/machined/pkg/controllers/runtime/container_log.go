@@ -0,0 +1,426 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	machinedruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+// containerLogMaxMessageSize limits the size of the log message merged from the partial CRI log lines.
+const containerLogMaxMessageSize = 1024 * 1024
+
+// errDeliveryInterrupted is returned when the log delivery is interrupted by the configuration change or the shutdown.
+var errDeliveryInterrupted = errors.New("log delivery interrupted")
+
+// ContainerLogDeliveryController forwards the logs of the Kubernetes containers to the logging destinations
+// in the machine configuration which have the "container" source.
+//
+// The controller follows the container logs written by the CRI runtime in the kubelet pod logs directory.
+type ContainerLogDeliveryController struct {
+	// PodLogsDir is the kubelet pod logs directory, defaults to constants.PodLogsDir.
+	PodLogsDir string
+	// PollInterval is the interval between the pod logs directory scans.
+	PollInterval time.Duration
+
+	// files is the set of the followed container logs, nil if the logs are not followed.
+	files map[string]*containerLogFile
+}
+
+// containerLogFile is a container log followed by the controller.
+type containerLogFile struct {
+	f     *os.File
+	inode uint64
+	meta  logging.PodLogPath
+
+	// buf holds the data read from the file, which wasn't processed yet.
+	buf []byte
+	// partial holds the message merged from the partial CRI log lines.
+	partial []byte
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ContainerLogDeliveryController) Name() string {
+	return "runtime.ContainerLogDeliveryController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ContainerLogDeliveryController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ContainerLogDeliveryController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ContainerLogDeliveryController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.PodLogsDir == "" {
+		ctrl.PodLogsDir = constants.PodLogsDir
+	}
+
+	if ctrl.PollInterval == 0 {
+		ctrl.PollInterval = time.Second
+	}
+
+	defer ctrl.closeFiles()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var dests []talosconfig.LoggingDestination
+
+		if cfg != nil && cfg.Config().Machine() != nil {
+			dests = xslices.Filter(cfg.Config().Machine().Logging().Destinations(), func(dest talosconfig.LoggingDestination) bool {
+				return slices.Contains(dest.Sources(), constants.LoggingSourceContainer)
+			})
+		}
+
+		if len(dests) == 0 {
+			// stop following the logs, so that the logs written meanwhile are not sent once enabled again
+			ctrl.closeFiles()
+
+			continue
+		}
+
+		if err = ctrl.deliverLogs(ctx, r, logger, dests); err != nil {
+			return fmt.Errorf("error delivering container logs: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+
+		// the event which interrupted the delivery was consumed, so reconcile again
+		r.QueueReconcile()
+	}
+}
+
+// deliverLogs follows the container logs until the configuration changes.
+func (ctrl *ContainerLogDeliveryController) deliverLogs(ctx context.Context, r controller.Runtime, logger *zap.Logger, dests []talosconfig.LoggingDestination) error {
+	senders := make([]machinedruntime.LogSender, 0, len(dests))
+
+	for _, dest := range dests {
+		sender, err := logging.NewSender(dest, constants.LoggingSourceContainer)
+		if err != nil {
+			logger.Error("error creating log sender", zap.String("endpoint", dest.Endpoint().Redacted()), zap.Error(err))
+
+			continue
+		}
+
+		senders = append(senders, sender)
+	}
+
+	defer func() {
+		closeCtx, closeCtxCancel := context.WithTimeout(context.Background(), logCloseTimeout)
+		defer closeCtxCancel()
+
+		for _, sender := range senders {
+			if err := sender.Close(closeCtx); err != nil {
+				logger.Error("error closing log sender", zap.Error(err))
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(ctrl.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := ctrl.poll(ctx, r, logger, senders); err != nil {
+			if errors.Is(err, errDeliveryInterrupted) {
+				return nil
+			}
+
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll scans the pod logs directory, and sends the new log lines.
+func (ctrl *ContainerLogDeliveryController) poll(ctx context.Context, r controller.Runtime, logger *zap.Logger, senders []machinedruntime.LogSender) error {
+	paths, err := filepath.Glob(filepath.Join(ctrl.PodLogsDir, "*", "*", "*.log"))
+	if err != nil {
+		return err
+	}
+
+	// on the first scan, skip the existing logs, as they were written before the delivery was enabled
+	initial := ctrl.files == nil
+	if initial {
+		ctrl.files = map[string]*containerLogFile{}
+	}
+
+	seen := make(map[string]struct{}, len(paths))
+
+	for _, path := range paths {
+		seen[path] = struct{}{}
+
+		file, ok := ctrl.files[path]
+		if !ok {
+			file, err = ctrl.openFile(path, initial)
+			if err != nil {
+				logger.Debug("error opening container log", zap.String("path", path), zap.Error(err))
+
+				continue
+			}
+
+			ctrl.files[path] = file
+		}
+
+		if err = ctrl.readFile(ctx, r, logger, senders, file); err != nil {
+			return err
+		}
+
+		// the log was rotated, the old file is read completely, so switch to the new one
+		if st, statErr := os.Stat(path); statErr == nil && inode(st) != file.inode {
+			file.f.Close() //nolint:errcheck
+			delete(ctrl.files, path)
+
+			if file, err = ctrl.openFile(path, false); err != nil {
+				logger.Debug("error opening container log", zap.String("path", path), zap.Error(err))
+
+				continue
+			}
+
+			ctrl.files[path] = file
+
+			if err = ctrl.readFile(ctx, r, logger, senders, file); err != nil {
+				return err
+			}
+		}
+	}
+
+	for path, file := range ctrl.files {
+		if _, ok := seen[path]; !ok {
+			file.f.Close() //nolint:errcheck
+			delete(ctrl.files, path)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *ContainerLogDeliveryController) openFile(path string, seekEnd bool) (*containerLogFile, error) {
+	rel, err := filepath.Rel(ctrl.PodLogsDir, path)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := logging.ParsePodLogPath(rel)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := f.Stat()
+	if err != nil {
+		f.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	if seekEnd {
+		if _, err = f.Seek(0, io.SeekEnd); err != nil {
+			f.Close() //nolint:errcheck
+
+			return nil, err
+		}
+	}
+
+	return &containerLogFile{
+		f:     f,
+		inode: inode(st),
+		meta:  meta,
+	}, nil
+}
+
+// readFile reads the file till the end, and sends the complete log lines.
+func (ctrl *ContainerLogDeliveryController) readFile(
+	ctx context.Context, r controller.Runtime, logger *zap.Logger, senders []machinedruntime.LogSender, file *containerLogFile,
+) error {
+	// the file was truncated, start over
+	if offset, err := file.f.Seek(0, io.SeekCurrent); err == nil {
+		if st, err := file.f.Stat(); err == nil && st.Size() < offset {
+			file.f.Seek(0, io.SeekStart) //nolint:errcheck
+
+			file.buf = nil
+		}
+	}
+
+	chunk := make([]byte, 32*1024)
+
+	for {
+		n, err := file.f.Read(chunk)
+
+		file.buf = append(file.buf, chunk[:n]...)
+
+		for {
+			idx := bytes.IndexByte(file.buf, '\n')
+			if idx < 0 {
+				break
+			}
+
+			if sendErr := ctrl.processLine(ctx, r, logger, senders, file, file.buf[:idx]); sendErr != nil {
+				return sendErr
+			}
+
+			file.buf = file.buf[idx+1:]
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			logger.Debug("error reading container log", zap.String("container", file.meta.Container), zap.Error(err))
+
+			return nil
+		}
+	}
+}
+
+func (ctrl *ContainerLogDeliveryController) processLine(
+	ctx context.Context, r controller.Runtime, logger *zap.Logger, senders []machinedruntime.LogSender, file *containerLogFile, l []byte,
+) error {
+	line, err := logging.ParseCRILogLine(l)
+	if err != nil {
+		logger.Debug("error parsing container log line", zap.String("container", file.meta.Container), zap.Error(err))
+
+		return nil
+	}
+
+	msg := line.Message
+
+	if line.Partial || len(file.partial) > 0 {
+		// keep file.partial intact until the event is sent, as the line is processed again if the delivery is interrupted
+		merged := append(slices.Clip(file.partial), line.Message...)
+
+		if line.Partial && len(merged) < containerLogMaxMessageSize {
+			file.partial = merged
+
+			return nil
+		}
+
+		msg = string(merged)
+	}
+
+	event := &machinedruntime.LogEvent{
+		Msg:   strings.TrimRight(msg, "\r"),
+		Time:  line.Time,
+		Level: zapcore.InfoLevel,
+		Fields: map[string]any{
+			"namespace": file.meta.Namespace,
+			"pod":       file.meta.Pod,
+			"container": file.meta.Container,
+			"stream":    line.Stream,
+		},
+	}
+
+	if err = ctrl.send(ctx, r, logger, senders, event); err != nil {
+		return err
+	}
+
+	file.partial = nil
+
+	return nil
+}
+
+// send delivers the log event to each sender, retrying on errors.
+//
+// The senders are queued, so the errors mean that the queue is full, and the logs are not read further until
+// there is space in the queue.
+func (ctrl *ContainerLogDeliveryController) send(
+	ctx context.Context, r controller.Runtime, logger *zap.Logger, senders []machinedruntime.LogSender, e *machinedruntime.LogEvent,
+) error {
+	for _, sender := range senders {
+		for {
+			sendCtx, sendCancel := context.WithTimeout(ctx, logSendTimeout)
+			err := sender.Send(sendCtx, e)
+
+			sendCancel()
+
+			if err == nil || errors.Is(err, machinedruntime.ErrDontRetry) {
+				break
+			}
+
+			logger.Debug("error sending log event", zap.Error(err))
+
+			select {
+			case <-ctx.Done():
+				return errDeliveryInterrupted
+			case <-r.EventCh():
+				return errDeliveryInterrupted
+			case <-time.After(logRetryTimeout):
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *ContainerLogDeliveryController) closeFiles() {
+	for _, file := range ctrl.files {
+		file.f.Close() //nolint:errcheck
+	}
+
+	ctrl.files = nil
+}
+
+func inode(st os.FileInfo) uint64 {
+	if sys, ok := st.Sys().(*syscall.Stat_t); ok {
+		return sys.Ino
+	}
+
+	return 0
+}
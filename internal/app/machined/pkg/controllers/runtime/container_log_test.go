@@ -0,0 +1,191 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+type ContainerLogDeliverySuite struct {
+	ctest.DefaultSuite
+
+	podLogsDir string
+	listener   net.Listener
+	msgCh      chan map[string]any
+}
+
+func TestContainerLogDeliverySuite(t *testing.T) {
+	s := &ContainerLogDeliverySuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.podLogsDir = suite.T().TempDir()
+			s.msgCh = make(chan map[string]any, 16)
+
+			var err error
+
+			s.listener, err = net.Listen("tcp", "127.0.0.1:0")
+			suite.Require().NoError(err)
+
+			go s.serve()
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.ContainerLogDeliveryController{
+				PodLogsDir:   s.podLogsDir,
+				PollInterval: 10 * time.Millisecond,
+			}))
+		},
+		AfterTearDown: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(s.listener.Close())
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *ContainerLogDeliverySuite) serve() {
+	for {
+		conn, err := suite.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			defer conn.Close() //nolint:errcheck
+
+			scanner := bufio.NewScanner(conn)
+
+			for scanner.Scan() {
+				var m map[string]any
+
+				if json.Unmarshal(scanner.Bytes(), &m) == nil {
+					suite.msgCh <- m
+				}
+			}
+		}()
+	}
+}
+
+func (suite *ContainerLogDeliverySuite) appendLog(path string, lines ...string) {
+	path = filepath.Join(suite.podLogsDir, path)
+
+	suite.Require().NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	suite.Require().NoError(err)
+
+	for _, line := range lines {
+		_, err = f.WriteString(line + "\n")
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().NoError(f.Close())
+}
+
+func (suite *ContainerLogDeliverySuite) assertMessage(expected map[string]any) {
+	select {
+	case <-suite.Ctx().Done():
+		suite.FailNow("timed out waiting for message")
+	case msg := <-suite.msgCh:
+		suite.Assert().Equal(expected, msg)
+	}
+}
+
+func (suite *ContainerLogDeliverySuite) TestDelivery() {
+	const proxyLog = "kube-system_kube-proxy-6xl5z_6e1b5b5e/kube-proxy/0.log"
+
+	// logs written before the delivery was enabled are not sent
+	suite.appendLog(proxyLog, "2024-01-02T03:04:05.000000001Z stderr F old message")
+
+	cfg := container.NewV1Alpha1(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineLogging: &v1alpha1.LoggingConfig{
+				LoggingDestinations: []v1alpha1.LoggingDestination{
+					{
+						LoggingEndpoint: &v1alpha1.Endpoint{URL: ensure.Value(url.Parse("tcp://" + suite.listener.Addr().String()))},
+						LoggingFormat:   constants.LoggingFormatJSONLines,
+						LoggingSources:  []string{constants.LoggingSourceContainer},
+					},
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	// wait for the initial scan
+	time.Sleep(200 * time.Millisecond)
+
+	suite.appendLog(proxyLog,
+		"2024-01-02T03:04:06.000000001Z stdout F new message",
+		"2024-01-02T03:04:07.000000001Z stdout P long ",
+		"2024-01-02T03:04:07.000000002Z stdout F message",
+	)
+
+	suite.assertMessage(map[string]any{
+		"msg":         "new message",
+		"talos-level": "info",
+		"talos-time":  "2024-01-02T03:04:06.000000001Z",
+		"namespace":   "kube-system",
+		"pod":         "kube-proxy-6xl5z",
+		"container":   "kube-proxy",
+		"stream":      "stdout",
+	})
+
+	suite.assertMessage(map[string]any{
+		"msg":         "long message",
+		"talos-level": "info",
+		"talos-time":  "2024-01-02T03:04:07.000000002Z",
+		"namespace":   "kube-system",
+		"pod":         "kube-proxy-6xl5z",
+		"container":   "kube-proxy",
+		"stream":      "stdout",
+	})
+
+	// new containers are logged from the beginning
+	suite.appendLog("default_nginx_1234/nginx/0.log", "2024-01-02T03:04:08Z stderr F started")
+
+	suite.assertMessage(map[string]any{
+		"msg":         "started",
+		"talos-level": "info",
+		"talos-time":  "2024-01-02T03:04:08Z",
+		"namespace":   "default",
+		"pod":         "nginx",
+		"container":   "nginx",
+		"stream":      "stderr",
+	})
+
+	// rotated logs are followed
+	suite.Require().NoError(os.Rename(filepath.Join(suite.podLogsDir, proxyLog), filepath.Join(suite.podLogsDir, proxyLog+".20240102-030409")))
+	suite.appendLog(proxyLog, "2024-01-02T03:04:09Z stdout F rotated")
+
+	suite.assertMessage(map[string]any{
+		"msg":         "rotated",
+		"talos-level": "info",
+		"talos-time":  "2024-01-02T03:04:09Z",
+		"namespace":   "kube-system",
+		"pod":         "kube-proxy-6xl5z",
+		"container":   "kube-proxy",
+		"stream":      "stdout",
+	})
+}
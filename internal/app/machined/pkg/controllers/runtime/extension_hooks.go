@@ -0,0 +1,206 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/pmorjan/kmod"
+	"github.com/siderolabs/go-procfs/procfs"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/extensions"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// ExtensionHooksController runs system extension lifecycle hooks and updates ExtensionHooksStatus resources.
+//
+// Kernel modules are loaded in the order declared by the extension, health checks run after all modules are loaded.
+type ExtensionHooksController struct {
+	V1Alpha1Mode v1alpha1runtime.Mode
+
+	// Cmdline returns the kernel command line, defaults to /proc/cmdline.
+	Cmdline func() *procfs.Cmdline
+	// LoadModule loads a kernel module with parameters, defaults to kmod.
+	LoadModule func(name, parameters string) error
+	// HealthCheckInterval is the interval between health check attempts.
+	HealthCheckInterval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ExtensionHooksController) Name() string {
+	return "runtime.ExtensionHooksController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ExtensionHooksController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.ExtensionStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ExtensionHooksController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.ExtensionHooksStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ExtensionHooksController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
+		// not supported in container mode
+		return nil
+	}
+
+	if ctrl.Cmdline == nil {
+		ctrl.Cmdline = procfs.ProcCmdline
+	}
+
+	if ctrl.LoadModule == nil {
+		manager, err := kmod.New()
+		if err != nil {
+			return fmt.Errorf("error initializing kmod manager: %w", err)
+		}
+
+		ctrl.LoadModule = func(name, parameters string) error {
+			return manager.Load(name, parameters, 0)
+		}
+	}
+
+	if ctrl.HealthCheckInterval == 0 {
+		ctrl.HealthCheckInterval = time.Second
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		exts, err := safe.ReaderListAll[*runtime.ExtensionStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing extensions: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		for iter := exts.Iterator(); iter.Next(); {
+			ext := iter.Value()
+			hooks := ext.TypedSpec().Hooks
+
+			if hooks.IsZero() {
+				continue
+			}
+
+			status := ctrl.runHooks(ctx, logger.With(zap.String("extension", ext.TypedSpec().Metadata.Name)), hooks)
+			status.Name = ext.TypedSpec().Metadata.Name
+
+			if err = safe.WriterModify(ctx, r, runtime.NewExtensionHooksStatus(ext.Metadata().ID()), func(res *runtime.ExtensionHooksStatus) error {
+				*res.TypedSpec() = status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating extension hooks status: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*runtime.ExtensionHooksStatus](ctx, r); err != nil {
+			return err
+		}
+	}
+}
+
+func (ctrl *ExtensionHooksController) runHooks(ctx context.Context, logger *zap.Logger, hooks extensions.Hooks) runtime.ExtensionHooksStatusSpec {
+	var status runtime.ExtensionHooksStatusSpec
+
+	cmdline := ctrl.Cmdline()
+
+	for _, arg := range hooks.KernelArgs {
+		if !cmdlineHasArg(cmdline, arg) {
+			status.MissingKernelArgs = append(status.MissingKernelArgs, arg)
+		}
+	}
+
+	if len(status.MissingKernelArgs) > 0 {
+		logger.Warn("kernel arguments required by the extension are missing", zap.Strings("args", status.MissingKernelArgs))
+	}
+
+	for _, module := range hooks.KernelModules {
+		if err := ctrl.LoadModule(module.Name, strings.Join(module.Parameters, " ")); err != nil {
+			status.Error = fmt.Sprintf("error loading module %q: %s", module.Name, err)
+
+			logger.Error("extension hooks failed", zap.String("error", status.Error))
+
+			return status
+		}
+
+		status.LoadedModules = append(status.LoadedModules, module.Name)
+	}
+
+	for _, check := range hooks.HealthChecks {
+		if err := ctrl.runHealthCheck(ctx, check); err != nil {
+			status.Error = fmt.Sprintf("health check %q failed: %s", check.Path, err)
+
+			logger.Error("extension hooks failed", zap.String("error", status.Error))
+
+			return status
+		}
+
+		status.PassedHealthChecks = append(status.PassedHealthChecks, check.Path)
+	}
+
+	status.Ready = len(status.MissingKernelArgs) == 0
+
+	return status
+}
+
+func (ctrl *ExtensionHooksController) runHealthCheck(ctx context.Context, check extensions.HealthCheck) error {
+	ctx, cancel := context.WithTimeout(ctx, check.Timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(ctrl.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := os.Stat(check.Path)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-ticker.C:
+		}
+	}
+}
+
+// cmdlineHasArg checks whether the kernel argument (`key` or `key=value`) is present in the kernel command line.
+func cmdlineHasArg(cmdline *procfs.Cmdline, arg string) bool {
+	key, value, hasValue := strings.Cut(arg, "=")
+
+	param := cmdline.Get(key)
+	if param == nil {
+		return false
+	}
+
+	return !hasValue || param.Contains(value)
+}
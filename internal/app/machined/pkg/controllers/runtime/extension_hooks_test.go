@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-procfs/procfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/extensions"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type ExtensionHooksSuite struct {
+	ctest.DefaultSuite
+
+	mu     sync.Mutex
+	loaded []string
+}
+
+func TestExtensionHooksSuite(t *testing.T) {
+	s := &ExtensionHooksSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.ExtensionHooksController{
+				Cmdline: func() *procfs.Cmdline {
+					return procfs.NewCmdline("console=ttyS0 nvidia-drm.modeset=1")
+				},
+				LoadModule: func(name, parameters string) error {
+					if name == "broken" {
+						return errors.New("no such module")
+					}
+
+					s.mu.Lock()
+					defer s.mu.Unlock()
+
+					s.loaded = append(s.loaded, name+" "+parameters)
+
+					return nil
+				},
+				HealthCheckInterval: 10 * time.Millisecond,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *ExtensionHooksSuite) TestHooks() {
+	healthPath := filepath.Join(suite.T().TempDir(), "nvidiactl")
+
+	nvidia := runtime.NewExtensionStatus(runtime.NamespaceName, "0")
+	nvidia.TypedSpec().Metadata.Name = "nvidia"
+	nvidia.TypedSpec().Hooks = extensions.Hooks{
+		KernelArgs: []string{"nvidia-drm.modeset=1"},
+		KernelModules: []extensions.KernelModule{
+			{Name: "nvidia"},
+			{Name: "nvidia_drm", Parameters: []string{"modeset=1", "fbdev=1"}},
+		},
+		HealthChecks: []extensions.HealthCheck{
+			{Path: healthPath, Timeout: 5 * time.Second},
+		},
+	}
+
+	broken := runtime.NewExtensionStatus(runtime.NamespaceName, "1")
+	broken.TypedSpec().Metadata.Name = "broken"
+	broken.TypedSpec().Hooks = extensions.Hooks{
+		KernelArgs: []string{"iommu=pt", "console=ttyS0"},
+		KernelModules: []extensions.KernelModule{
+			{Name: "broken"},
+		},
+	}
+
+	noHooks := runtime.NewExtensionStatus(runtime.NamespaceName, "2")
+	noHooks.TypedSpec().Metadata.Name = "gvisor"
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+
+		suite.Assert().NoError(os.WriteFile(healthPath, nil, 0o644))
+	}()
+
+	for _, res := range []resource.Resource{nvidia, broken, noHooks} {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), res))
+	}
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"0"},
+		func(status *runtime.ExtensionHooksStatus, asrt *assert.Assertions) {
+			asrt.Equal("nvidia", status.TypedSpec().Name)
+			asrt.True(status.TypedSpec().Ready)
+			asrt.Empty(status.TypedSpec().MissingKernelArgs)
+			asrt.Equal([]string{"nvidia", "nvidia_drm"}, status.TypedSpec().LoadedModules)
+			asrt.Equal([]string{healthPath}, status.TypedSpec().PassedHealthChecks)
+			asrt.Empty(status.TypedSpec().Error)
+		})
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"1"},
+		func(status *runtime.ExtensionHooksStatus, asrt *assert.Assertions) {
+			asrt.Equal("broken", status.TypedSpec().Name)
+			asrt.False(status.TypedSpec().Ready)
+			asrt.Equal([]string{"iommu=pt"}, status.TypedSpec().MissingKernelArgs)
+			asrt.Empty(status.TypedSpec().LoadedModules)
+			asrt.Equal(`error loading module "broken": no such module`, status.TypedSpec().Error)
+		})
+
+	rtestutils.AssertNoResource[*runtime.ExtensionHooksStatus](suite.Ctx(), suite.T(), suite.State(), "2")
+
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	suite.Assert().Equal([]string{"nvidia ", "nvidia_drm modeset=1 fbdev=1"}, suite.loaded[:2])
+}
@@ -0,0 +1,162 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/textfile"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// ExtensionMetricsController watches the metrics drop-in directory and publishes ExtensionMetrics resources.
+type ExtensionMetricsController struct {
+	// Path is the drop-in directory, defaults to constants.ExtensionServiceMetricsPath.
+	Path string
+	// Interval is the interval between the directory scans.
+	Interval time.Duration
+}
+
+const (
+	extensionMetricsSuffix = ".prom"
+
+	// extensionMetricsMaxSize limits the size of a single metrics file.
+	extensionMetricsMaxSize = 1024 * 1024
+)
+
+// Name implements controller.Controller interface.
+func (ctrl *ExtensionMetricsController) Name() string {
+	return "runtime.ExtensionMetricsController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ExtensionMetricsController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ExtensionMetricsController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.ExtensionMetricsType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ExtensionMetricsController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.Path == "" {
+		ctrl.Path = constants.ExtensionServiceMetricsPath
+	}
+
+	if ctrl.Interval == 0 {
+		ctrl.Interval = 15 * time.Second
+	}
+
+	// the directory is world-writable for the extension services running as non-root users
+	if err := os.MkdirAll(ctrl.Path, 0o777); err != nil {
+		return fmt.Errorf("error creating metrics directory: %w", err)
+	}
+
+	if err := os.Chmod(ctrl.Path, 0o777|os.ModeSticky); err != nil {
+		return fmt.Errorf("error setting metrics directory permissions: %w", err)
+	}
+
+	ticker := time.NewTicker(ctrl.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := ctrl.scan(ctx, r, logger); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+	}
+}
+
+func (ctrl *ExtensionMetricsController) scan(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	entries, err := os.ReadDir(ctrl.Path)
+	if err != nil {
+		return fmt.Errorf("error reading metrics directory: %w", err)
+	}
+
+	r.StartTrackingOutputs()
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !strings.HasSuffix(entry.Name(), extensionMetricsSuffix) {
+			continue
+		}
+
+		id := strings.TrimSuffix(entry.Name(), extensionMetricsSuffix)
+		path := filepath.Join(ctrl.Path, entry.Name())
+
+		metrics, families, parseErr := readMetricsFile(path)
+
+		if err = safe.WriterModify(ctx, r, runtime.NewExtensionMetrics(id), func(res *runtime.ExtensionMetrics) error {
+			spec := res.TypedSpec()
+
+			spec.Path = path
+
+			if parseErr != nil {
+				// keep the last valid metrics
+				spec.Error = parseErr.Error()
+
+				return nil
+			}
+
+			spec.Metrics = metrics
+			spec.Families = families
+			spec.Error = ""
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating extension metrics: %w", err)
+		}
+
+		if parseErr != nil {
+			logger.Debug("error parsing extension metrics", zap.String("path", path), zap.Error(parseErr))
+		}
+	}
+
+	return safe.CleanupOutputs[*runtime.ExtensionMetrics](ctx, r)
+}
+
+func readMetricsFile(path string) (string, []string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(io.LimitReader(f, extensionMetricsMaxSize+1))
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(data) > extensionMetricsMaxSize {
+		return "", nil, errors.New("metrics file is too large")
+	}
+
+	return textfile.Normalize(data)
+}
@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type ExtensionMetricsSuite struct {
+	ctest.DefaultSuite
+
+	path string
+}
+
+func TestExtensionMetricsSuite(t *testing.T) {
+	s := &ExtensionMetricsSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.path = filepath.Join(suite.T().TempDir(), "metrics")
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.ExtensionMetricsController{
+				Path:     s.path,
+				Interval: 10 * time.Millisecond,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *ExtensionMetricsSuite) writeMetrics(name, contents string) {
+	suite.Require().Eventually(func() bool {
+		_, err := os.Stat(suite.path)
+
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.path, name), []byte(contents), 0o644))
+}
+
+func (suite *ExtensionMetricsSuite) TestMetrics() {
+	suite.writeMetrics("zfs.prom", "zfs_arc_size 1024\n")
+	suite.writeMetrics("ignored.txt", "foo 1\n")
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"zfs"},
+		func(res *runtime.ExtensionMetrics, asrt *assert.Assertions) {
+			asrt.Equal(filepath.Join(suite.path, "zfs.prom"), res.TypedSpec().Path)
+			asrt.Equal("# TYPE zfs_arc_size untyped\nzfs_arc_size 1024\n", res.TypedSpec().Metrics)
+			asrt.Equal([]string{"zfs_arc_size"}, res.TypedSpec().Families)
+			asrt.Empty(res.TypedSpec().Error)
+		},
+	)
+
+	// invalid metrics keep the last valid ones
+	suite.writeMetrics("zfs.prom", "zfs_arc_size invalid\n")
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"zfs"},
+		func(res *runtime.ExtensionMetrics, asrt *assert.Assertions) {
+			asrt.Equal("# TYPE zfs_arc_size untyped\nzfs_arc_size 1024\n", res.TypedSpec().Metrics)
+			asrt.NotEmpty(res.TypedSpec().Error)
+		},
+	)
+
+	suite.Require().NoError(os.Remove(filepath.Join(suite.path, "zfs.prom")))
+
+	rtestutils.AssertNoResource[*runtime.ExtensionMetrics](suite.Ctx(), suite.T(), suite.State(), "zfs")
+}
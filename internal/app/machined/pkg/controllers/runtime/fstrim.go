@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/fstrim"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// FilesystemTrimController periodically discards the unused blocks of the mounted filesystems.
+type FilesystemTrimController struct {
+	V1Alpha1Mode   v1alpha1runtime.Mode
+	V1Alpha1Events v1alpha1runtime.Publisher
+
+	// TrimAll trims the mounted filesystems, defaults to fstrim.TrimAll.
+	TrimAll func(ctx context.Context, includeEncrypted bool) ([]fstrim.Result, error)
+	Clock   clock.Clock
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *FilesystemTrimController) Name() string {
+	return "runtime.FilesystemTrimController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *FilesystemTrimController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *FilesystemTrimController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *FilesystemTrimController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// the filesystems are managed by the host in container mode
+	if ctrl.V1Alpha1Mode.InContainer() {
+		return nil
+	}
+
+	if ctrl.TrimAll == nil {
+		ctrl.TrimAll = fstrim.TrimAll
+	}
+
+	if ctrl.Clock == nil {
+		ctrl.Clock = clock.New()
+	}
+
+	ticker := ctrl.Clock.Ticker(constants.FilesystemTrimInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			continue
+		case <-ticker.C:
+		}
+
+		// the encrypted volumes are never trimmed on schedule, as it reveals which blocks are in use
+		results, err := ctrl.TrimAll(ctx, false)
+		if err != nil {
+			logger.Warn("error trimming filesystems", zap.Error(err))
+
+			continue
+		}
+
+		for _, result := range results {
+			if result.Err != nil {
+				logger.Warn("error trimming filesystem", zap.String("mountpoint", result.Mountpoint), zap.String("device", result.Device), zap.Error(result.Err))
+			} else {
+				logger.Info("filesystem trimmed", zap.String("mountpoint", result.Mountpoint), zap.String("device", result.Device), zap.Uint64("trimmed_bytes", result.Trimmed))
+			}
+
+			ctrl.V1Alpha1Events.Publish(ctx, result.Event())
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
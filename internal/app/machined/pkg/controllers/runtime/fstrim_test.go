@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/fstrim"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+type FilesystemTrimSuite struct {
+	ctest.DefaultSuite
+
+	fakeClock *clock.Mock
+	events    *mockTrimEvents
+}
+
+type mockTrimEvents struct {
+	mu     sync.Mutex
+	events []proto.Message
+}
+
+func (m *mockTrimEvents) Publish(_ context.Context, event proto.Message) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.events = append(m.events, event)
+}
+
+func (m *mockTrimEvents) get() []proto.Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]proto.Message(nil), m.events...)
+}
+
+func (suite *FilesystemTrimSuite) TestScheduledTrim() {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		suite.fakeClock.Add(constants.FilesystemTrimInterval)
+
+		if len(suite.events.get()) < 2 {
+			return retry.ExpectedErrorf("expected events, got %d", len(suite.events.get()))
+		}
+
+		return nil
+	}))
+
+	events := suite.events.get()
+
+	suite.Assert().Equal("/var", events[0].(*machine.FilesystemTrimEvent).GetMountpoint())
+	suite.Assert().EqualValues(1<<20, events[0].(*machine.FilesystemTrimEvent).GetTrimmedBytes())
+	suite.Assert().Equal("/system/state", events[1].(*machine.FilesystemTrimEvent).GetMountpoint())
+	suite.Assert().Equal("operation not supported", events[1].(*machine.FilesystemTrimEvent).GetError())
+}
+
+func TestFilesystemTrimSuite(t *testing.T) {
+	t.Parallel()
+
+	fakeClock := clock.NewMock()
+	events := &mockTrimEvents{}
+
+	suite.Run(t, &FilesystemTrimSuite{
+		fakeClock: fakeClock,
+		events:    events,
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrl.FilesystemTrimController{
+					V1Alpha1Mode:   v1alpha1runtime.ModeMetal,
+					V1Alpha1Events: events,
+					Clock:          fakeClock,
+					TrimAll: func(_ context.Context, includeEncrypted bool) ([]fstrim.Result, error) {
+						if includeEncrypted {
+							return nil, errors.New("encrypted volumes should not be trimmed on schedule")
+						}
+
+						return []fstrim.Result{
+							{
+								Filesystem: fstrim.Filesystem{Device: "/dev/sda6", Mountpoint: "/var", Type: "xfs"},
+								Trimmed:    1 << 20,
+							},
+							{
+								Filesystem: fstrim.Filesystem{Device: "/dev/sda5", Mountpoint: "/system/state", Type: "xfs"},
+								Err:        errors.New("operation not supported"),
+							},
+						}, nil
+					},
+				}))
+			},
+		},
+	})
+}
@@ -16,10 +16,11 @@ import (
 	"go.uber.org/zap"
 
 	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/kmodparams"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
-// KernelModuleSpecController watches KernelModuleSpecs, sets/resets kernel params.
+// KernelModuleSpecController watches KernelModuleSpecs, loads kernel modules and applies their parameters.
 type KernelModuleSpecController struct {
 	V1Alpha1Mode v1alpha1runtime.Mode
 }
@@ -78,7 +79,12 @@ func (ctrl *KernelModuleSpecController) Run(ctx context.Context, r controller.Ru
 
 			if err = manager.Load(module.Name, parameters, 0); err != nil {
 				multiErr = errors.Join(multiErr, fmt.Errorf("error loading module %q: %w", module.Name, err))
+
+				continue
 			}
+
+			// parameters are only passed on load, so apply them if the module was already loaded
+			applyModuleParameters(logger, module)
 		}
 
 		if multiErr != nil {
@@ -88,3 +94,41 @@ func (ctrl *KernelModuleSpecController) Run(ctx context.Context, r controller.Ru
 		r.ResetRestartBackoff()
 	}
 }
+
+func applyModuleParameters(logger *zap.Logger, module *runtime.KernelModuleSpecSpec) {
+	for _, parameter := range module.Parameters {
+		name, value, ok := strings.Cut(parameter, "=")
+		if !ok {
+			// boolean parameter without a value is enabled
+			value = "1"
+		}
+
+		current, err := kmodparams.Get(kmodparams.SysfsPath, module.Name, name)
+		if err != nil {
+			// not all parameters are exposed via sysfs
+			logger.Debug("skipped applying kernel module parameter", zap.String("module", module.Name), zap.String("parameter", name), zap.Error(err))
+
+			continue
+		}
+
+		if kmodparams.Equal(current.Value, value) {
+			continue
+		}
+
+		if !current.Writable {
+			logger.Warn("kernel module parameter can't be changed at runtime, reboot to apply it",
+				zap.String("module", module.Name), zap.String("parameter", name), zap.String("current", current.Value), zap.String("value", value))
+
+			continue
+		}
+
+		if _, err = kmodparams.Set(kmodparams.SysfsPath, module.Name, name, value); err != nil {
+			logger.Warn("error changing kernel module parameter", zap.String("module", module.Name), zap.String("parameter", name), zap.Error(err))
+
+			continue
+		}
+
+		logger.Info("changed kernel module parameter",
+			zap.String("module", module.Name), zap.String("parameter", name), zap.String("previous", current.Value), zap.String("value", value))
+	}
+}
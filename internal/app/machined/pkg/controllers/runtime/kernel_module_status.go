@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/kmodparams"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// KernelModuleStatusController publishes the current parameters of the loaded kernel modules.
+type KernelModuleStatusController struct {
+	// SysfsPath is the path to the kernel modules in sysfs, defaults to kmodparams.SysfsPath.
+	SysfsPath string
+	// Interval is the interval between the rescans of the parameters.
+	Interval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *KernelModuleStatusController) Name() string {
+	return "runtime.KernelModuleStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *KernelModuleStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.KernelModuleSpecType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *KernelModuleStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.KernelModuleStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *KernelModuleStatusController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	if ctrl.SysfsPath == "" {
+		ctrl.SysfsPath = kmodparams.SysfsPath
+	}
+
+	if ctrl.Interval == 0 {
+		ctrl.Interval = time.Minute
+	}
+
+	// parameters might be changed via the API or by the kernel itself, so rescan them periodically
+	ticker := time.NewTicker(ctrl.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := ctrl.scan(ctx, r); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+	}
+}
+
+func (ctrl *KernelModuleStatusController) scan(ctx context.Context, r controller.Runtime) error {
+	modules, err := kmodparams.Modules(ctrl.SysfsPath)
+	if err != nil {
+		return fmt.Errorf("error listing kernel modules: %w", err)
+	}
+
+	r.StartTrackingOutputs()
+
+	for _, module := range modules {
+		params, err := kmodparams.Read(ctrl.SysfsPath, module)
+		if err != nil {
+			// the module might be unloaded while scanning
+			if errors.Is(err, kmodparams.ErrModuleNotLoaded) || errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return fmt.Errorf("error reading parameters of module %q: %w", module, err)
+		}
+
+		if err = safe.WriterModify(ctx, r, runtime.NewKernelModuleStatus(runtime.NamespaceName, module), func(res *runtime.KernelModuleStatus) error {
+			spec := res.TypedSpec()
+
+			spec.Name = module
+			spec.Parameters = make([]runtime.KernelModuleParameter, 0, len(params))
+
+			for _, param := range params {
+				spec.Parameters = append(spec.Parameters, runtime.KernelModuleParameter{
+					Name:     param.Name,
+					Value:    param.Value,
+					Writable: param.Writable,
+				})
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating kernel module status: %w", err)
+		}
+	}
+
+	return safe.CleanupOutputs[*runtime.KernelModuleStatus](ctx, r)
+}
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type KernelModuleStatusSuite struct {
+	ctest.DefaultSuite
+
+	path string
+}
+
+func TestKernelModuleStatusSuite(t *testing.T) {
+	s := &KernelModuleStatusSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.path = suite.T().TempDir()
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.KernelModuleStatusController{
+				SysfsPath: s.path,
+				Interval:  10 * time.Millisecond,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *KernelModuleStatusSuite) writeParameter(module, name, value string, mode os.FileMode) {
+	dir := filepath.Join(suite.path, module, "parameters")
+
+	suite.Require().NoError(os.MkdirAll(dir, 0o755))
+	suite.Require().NoError(os.WriteFile(filepath.Join(dir, name), []byte(value+"\n"), mode))
+}
+
+func (suite *KernelModuleStatusSuite) TestStatus() {
+	suite.writeParameter("nf_conntrack", "hashsize", "65536", 0o644)
+	suite.writeParameter("nf_conntrack", "expect_hashsize", "1024", 0o444)
+	suite.writeParameter("kvm", "ignore_msrs", "N", 0o644)
+
+	// built-in module without parameters
+	suite.Require().NoError(os.MkdirAll(filepath.Join(suite.path, "xfs"), 0o755))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"nf_conntrack", "kvm"},
+		func(res *runtime.KernelModuleStatus, asrt *assert.Assertions) {
+			asrt.Equal(res.Metadata().ID(), res.TypedSpec().Name)
+		},
+	)
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), "nf_conntrack",
+		func(res *runtime.KernelModuleStatus, asrt *assert.Assertions) {
+			asrt.Equal([]runtime.KernelModuleParameter{
+				{Name: "expect_hashsize", Value: "1024"},
+				{Name: "hashsize", Value: "65536", Writable: true},
+			}, res.TypedSpec().Parameters)
+		},
+	)
+
+	rtestutils.AssertNoResource[*runtime.KernelModuleStatus](suite.Ctx(), suite.T(), suite.State(), "xfs")
+
+	// parameter changed outside of the controller
+	suite.writeParameter("nf_conntrack", "hashsize", "262144", 0o644)
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), "nf_conntrack",
+		func(res *runtime.KernelModuleStatus, asrt *assert.Assertions) {
+			asrt.Equal([]runtime.KernelModuleParameter{
+				{Name: "expect_hashsize", Value: "1024"},
+				{Name: "hashsize", Value: "262144", Writable: true},
+			}, res.TypedSpec().Parameters)
+		},
+	)
+
+	// module unloaded
+	suite.Require().NoError(os.RemoveAll(filepath.Join(suite.path, "kvm")))
+
+	rtestutils.AssertNoResource[*runtime.KernelModuleStatus](suite.Ctx(), suite.T(), suite.State(), "kvm")
+}
@@ -7,6 +7,8 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -79,6 +81,25 @@ func (ctrl *KernelParamConfigController) Run(ctx context.Context, r controller.R
 			})
 		}
 
+		// conntrack settings go first, so that explicit machine sysctls take precedence
+		if cfg != nil && cfg.Config().Conntrack() != nil {
+			conntrackCfg := cfg.Config().Conntrack()
+
+			for key, value := range map[string]uint64{
+				"net.netfilter.nf_conntrack_max":                     uint64(conntrackCfg.Max()),
+				"net.netfilter.nf_conntrack_buckets":                 uint64(conntrackCfg.Buckets()),
+				"net.netfilter.nf_conntrack_tcp_timeout_established": uint64(conntrackCfg.TCPEstablishedTimeout() / time.Second),
+			} {
+				if value == 0 {
+					continue
+				}
+
+				if err = setKernelParam(kernel.Sysctl, key, strconv.FormatUint(value, 10)); err != nil {
+					return err
+				}
+			}
+		}
+
 		if cfg != nil && cfg.Config().Machine() != nil {
 			for key, value := range cfg.Config().Machine().Sysctls() {
 				if err = setKernelParam(kernel.Sysctl, key, value); err != nil {
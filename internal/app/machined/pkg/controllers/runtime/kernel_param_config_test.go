@@ -14,6 +14,7 @@ import (
 
 	runtimecontrollers "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	runtimeresource "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
@@ -116,6 +117,50 @@ func (suite *KernelParamConfigSuite) TestReconcileConfig() {
 	))
 }
 
+func (suite *KernelParamConfigSuite) TestReconcileConntrack() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.KernelParamConfigController{}))
+
+	suite.startRuntime()
+
+	conntrackCfg := networkcfg.NewConntrackConfigV1Alpha1()
+	conntrackCfg.ConntrackMax = 262144
+	conntrackCfg.ConntrackTCPEstablishedTimeout = time.Hour
+
+	ctr, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineSysctls: map[string]string{
+					"net.netfilter.nf_conntrack_max": "524288",
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{},
+		},
+		conntrackCfg,
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, config.NewMachineConfig(ctr)))
+
+	for id, expected := range map[string]string{
+		// explicit machine sysctl wins over the conntrack config
+		"proc.sys.net.netfilter.nf_conntrack_max":                     "524288",
+		"proc.sys.net.netfilter.nf_conntrack_tcp_timeout_established": "3600",
+	} {
+		suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			suite.assertResource(
+				resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.KernelParamSpecType, id, resource.VersionUndefined),
+				func(res resource.Resource) bool {
+					return suite.Assert().Equal(expected, res.(*runtimeresource.KernelParamSpec).TypedSpec().Value)
+				},
+			),
+		))
+	}
+
+	_, err = suite.state.Get(suite.ctx, resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.KernelParamSpecType, "proc.sys.net.netfilter.nf_conntrack_buckets", resource.VersionUndefined))
+	suite.Assert().True(state.IsNotFoundError(err))
+}
+
 func TestKernelParamConfigSuite(t *testing.T) {
 	suite.Run(t, new(KernelParamConfigSuite))
 }
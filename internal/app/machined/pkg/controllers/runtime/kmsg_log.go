@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -25,6 +26,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	configres "github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
@@ -38,6 +40,9 @@ const (
 
 // KmsgLogDeliveryController watches events and forwards them to the events sink server
 // if it's configured.
+//
+// The kernel logs are also forwarded to the logging destinations in the machine configuration
+// which have the "kernel" source.
 type KmsgLogDeliveryController struct {
 	Drainer *machinedruntime.Drainer
 
@@ -72,6 +77,12 @@ func (ctrl *KmsgLogDeliveryController) Run(ctx context.Context, r controller.Run
 				ID:        optional.Some(runtime.KmsgLogConfigID),
 				Kind:      controller.InputWeak,
 			},
+			{
+				Namespace: configres.NamespaceName,
+				Type:      configres.MachineConfigType,
+				ID:        optional.Some(configres.V1Alpha1ID),
+				Kind:      controller.InputWeak,
+			},
 		},
 	); err != nil {
 		return fmt.Errorf("error waiting for network: %w", err)
@@ -99,12 +110,32 @@ func (ctrl *KmsgLogDeliveryController) Run(ctx context.Context, r controller.Run
 			return fmt.Errorf("error getting configuration: %w", err)
 		}
 
-		if cfg == nil {
+		machineConfig, err := safe.ReaderGetByID[*configres.MachineConfig](ctx, r, configres.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine configuration: %w", err)
+		}
+
+		var (
+			destURLs []*url.URL
+			dests    []config.LoggingDestination
+		)
+
+		if cfg != nil {
+			destURLs = cfg.TypedSpec().Destinations
+		}
+
+		if machineConfig != nil && machineConfig.Config().Machine() != nil {
+			dests = xslices.Filter(machineConfig.Config().Machine().Logging().Destinations(), func(dest config.LoggingDestination) bool {
+				return slices.Contains(dest.Sources(), constants.LoggingSourceKernel)
+			})
+		}
+
+		if len(destURLs) == 0 && len(dests) == 0 {
 			// no config, wait for the next event
 			continue
 		}
 
-		if err = ctrl.deliverLogs(ctx, r, logger, kmsgCh, cfg.TypedSpec().Destinations); err != nil {
+		if err = ctrl.deliverLogs(ctx, r, logger, kmsgCh, destURLs, dests); err != nil {
 			return fmt.Errorf("error delivering logs: %w", err)
 		}
 
@@ -128,8 +159,26 @@ func (c logConfig) ExtraTags() map[string]string {
 	return nil
 }
 
+func (c logConfig) Sources() []string {
+	return []string{constants.LoggingSourceKernel}
+}
+
+func (c logConfig) TLSCA() []byte {
+	return nil
+}
+
+func (c logConfig) TLSInsecureSkipVerify() bool {
+	return false
+}
+
+func (c logConfig) SpoolMaxSize() uint64 {
+	return 0
+}
+
 //nolint:gocyclo
-func (ctrl *KmsgLogDeliveryController) deliverLogs(ctx context.Context, r controller.Runtime, logger *zap.Logger, kmsgCh <-chan kmsg.Packet, destURLs []*url.URL) error {
+func (ctrl *KmsgLogDeliveryController) deliverLogs(
+	ctx context.Context, r controller.Runtime, logger *zap.Logger, kmsgCh <-chan kmsg.Packet, destURLs []*url.URL, dests []config.LoggingDestination,
+) error {
 	if ctrl.drainSub == nil {
 		ctrl.drainSub = ctrl.Drainer.Subscribe()
 	}
@@ -140,6 +189,17 @@ func (ctrl *KmsgLogDeliveryController) deliverLogs(ctx context.Context, r contro
 	})
 	senders := xslices.Map(destLogConfigs, logging.NewJSONLines)
 
+	for _, dest := range dests {
+		sender, err := logging.NewSender(dest, constants.LoggingSourceKernel)
+		if err != nil {
+			logger.Error("error creating log sender", zap.String("endpoint", dest.Endpoint().Redacted()), zap.Error(err))
+
+			continue
+		}
+
+		senders = append(senders, sender)
+	}
+
 	defer func() {
 		closeCtx, closeCtxCancel := context.WithTimeout(context.Background(), logCloseTimeout)
 		defer closeCtxCancel()
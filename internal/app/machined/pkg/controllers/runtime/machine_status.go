@@ -23,6 +23,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
@@ -80,6 +81,12 @@ func (ctrl *MachineStatusController) Inputs() []controller.Input {
 			ID:        optional.Some(config.MachineTypeID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: k8s.NamespaceName,
 			Type:      k8s.NodenameType,
@@ -136,6 +143,18 @@ func (ctrl *MachineStatusController) Run(ctx context.Context, r controller.Runti
 			machineType = machineTypeResource.MachineType()
 		}
 
+		machineConfig, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		// the node doesn't become ready until the CNI is running, which might be installed after the bootstrap
+		var skipNodeReady bool
+
+		if machineConfig != nil && machineConfig.Config().Cluster() != nil {
+			skipNodeReady = machineConfig.Config().Cluster().Network().CNI().Readiness().Mode() == constants.CNIReadinessDisabled
+		}
+
 		ctrl.mu.Lock()
 		currentStage := ctrl.currentStage
 		ctrl.mu.Unlock()
@@ -144,7 +163,7 @@ func (ctrl *MachineStatusController) Run(ctx context.Context, r controller.Runti
 
 		var unmetConditions []runtime.UnmetCondition
 
-		for _, check := range ctrl.getReadinessChecks(currentStage, machineType) {
+		for _, check := range ctrl.getReadinessChecks(currentStage, machineType, skipNodeReady) {
 			if err := check.f(ctx, r); err != nil {
 				ready = false
 
@@ -180,7 +199,7 @@ type readinessCheck struct {
 	f    func(context.Context, controller.Runtime) error
 }
 
-func (ctrl *MachineStatusController) getReadinessChecks(stage runtime.MachineStage, machineType machine.Type) []readinessCheck {
+func (ctrl *MachineStatusController) getReadinessChecks(stage runtime.MachineStage, machineType machine.Type, skipNodeReady bool) []readinessCheck {
 	requiredServices := []string{
 		"apid",
 		"machined",
@@ -196,7 +215,7 @@ func (ctrl *MachineStatusController) getReadinessChecks(stage runtime.MachineSta
 
 	switch stage { //nolint:exhaustive
 	case runtime.MachineStageBooting, runtime.MachineStageRunning:
-		return []readinessCheck{
+		checks := []readinessCheck{
 			{
 				name: "time",
 				f:    ctrl.timeSyncCheck,
@@ -213,11 +232,16 @@ func (ctrl *MachineStatusController) getReadinessChecks(stage runtime.MachineSta
 				name: "staticPods",
 				f:    ctrl.staticPodsCheck,
 			},
-			{
+		}
+
+		if !skipNodeReady {
+			checks = append(checks, readinessCheck{
 				name: "nodeReady",
 				f:    ctrl.nodeReadyCheck,
-			},
+			})
 		}
+
+		return checks
 	default:
 		return nil
 	}
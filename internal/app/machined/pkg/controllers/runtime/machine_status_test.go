@@ -18,7 +18,10 @@ import (
 	runtimectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
 	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	v1alpha1cfg "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
@@ -141,6 +144,29 @@ func (suite *MachineStatusSuite) TestReconcile() {
 
 	suite.assertMachineStatus(runtime.MachineStageRunning, false, []string{"nodeReady"})
 
+	// CNI readiness checks disabled, node readiness is not required
+	machineConfig := config.NewMachineConfig(container.NewV1Alpha1(&v1alpha1cfg.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1cfg.MachineConfig{},
+		ClusterConfig: &v1alpha1cfg.ClusterConfig{
+			ClusterNetwork: &v1alpha1cfg.ClusterNetworkConfig{
+				CNI: &v1alpha1cfg.CNIConfig{
+					CNIName: constants.NoneCNI,
+					CNIReadiness: &v1alpha1cfg.CNIReadinessConfig{
+						ReadinessMode: constants.CNIReadinessDisabled,
+					},
+				},
+			},
+		},
+	}))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	suite.assertMachineStatus(runtime.MachineStageRunning, true, nil)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+
+	suite.assertMachineStatus(runtime.MachineStageRunning, false, []string{"nodeReady"})
+
 	nodeStatus.TypedSpec().NodeReady = true
 	suite.Require().NoError(suite.State().Update(suite.Ctx(), nodeStatus))
 
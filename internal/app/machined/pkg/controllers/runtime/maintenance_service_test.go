@@ -215,6 +215,14 @@ func (mockController) Run(context.Context, talosruntime.Sequence, any, ...talosr
 	return nil
 }
 
+func (mockController) RunningSequences() []talosruntime.SequenceStatus {
+	return nil
+}
+
+func (mockController) CancelSequence(talosruntime.Sequence) error {
+	return nil
+}
+
 func (mockController) V1Alpha2() talosruntime.V1Alpha2Controller {
 	return nil
 }
@@ -0,0 +1,315 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/metrics"
+	"github.com/siderolabs/talos/internal/pkg/textfile"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// extensionMetricsSourceLabel is the label identifying the source of the extension metrics (same as in the ExtensionMetrics API).
+const extensionMetricsSourceLabel = "extension"
+
+// MetricsServerController runs the Prometheus metrics endpoint of machined.
+//
+// The endpoint exposes the machined metrics merged with the metrics published by the extensions.
+// If the endpoint doesn't listen on a loopback address, it is served over HTTPS with the Talos API
+// server certificate, and the clients should present a certificate issued by the Talos API CA.
+type MetricsServerController struct {
+	extensionMetricsMu sync.Mutex
+	extensionMetrics   []*dto.MetricFamily
+
+	certs metricsServerCertificates
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *MetricsServerController) Name() string {
+	return "runtime.MetricsServerController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MetricsServerController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.APIType,
+			ID:        optional.Some(secrets.APIID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.ExtensionMetricsType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MetricsServerController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *MetricsServerController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var (
+		server        *http.Server
+		serverAddress string
+		serverWg      sync.WaitGroup
+	)
+
+	serverErrCh := make(chan error, 1)
+
+	shutdownServer := func(ctx context.Context) {
+		if server == nil {
+			return
+		}
+
+		shutdownCtx, shutdownCancel := context.WithTimeout(ctx, 5*time.Second)
+		defer shutdownCancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Error("failed to shut down metrics server", zap.Error(err))
+		}
+
+		serverWg.Wait()
+
+		server = nil
+		serverAddress = ""
+
+		logger.Info("metrics server stopped")
+	}
+
+	defer shutdownServer(context.Background())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-serverErrCh:
+			return fmt.Errorf("metrics server failed: %w", err)
+		case <-r.EventCh():
+		}
+
+		if err := ctrl.updateExtensionMetrics(ctx, r, logger); err != nil {
+			return err
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		apiCerts, err := safe.ReaderGetByID[*secrets.API](ctx, r, secrets.APIID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting API certificates: %w", err)
+		}
+
+		if cfg == nil || cfg.Config().Machine() == nil || !cfg.Config().Machine().Features().Metrics().Enabled() {
+			shutdownServer(ctx)
+
+			continue
+		}
+
+		listenAddress := cfg.Config().Machine().Features().Metrics().ListenAddress()
+		secure := !isLoopbackAddress(listenAddress)
+
+		if secure {
+			if apiCerts == nil || apiCerts.TypedSpec().Server == nil {
+				// wait for the API certificates
+				shutdownServer(ctx)
+
+				continue
+			}
+
+			if err = ctrl.certs.Update(apiCerts.TypedSpec()); err != nil {
+				return err
+			}
+		}
+
+		if server != nil && serverAddress == listenAddress {
+			r.ResetRestartBackoff()
+
+			continue
+		}
+
+		shutdownServer(ctx)
+
+		listener, err := net.Listen("tcp", listenAddress)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %q: %w", listenAddress, err)
+		}
+
+		if secure {
+			listener = tls.NewListener(listener, ctrl.certs.TLSConfig())
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(
+			prometheus.Gatherers{metrics.Gatherer(), ctrl},
+			promhttp.HandlerOpts{
+				ErrorLog:      zap.NewStdLog(logger),
+				ErrorHandling: promhttp.ContinueOnError,
+			},
+		))
+
+		server = &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		serverAddress = listenAddress
+
+		serverWg.Add(1)
+
+		go func(server *http.Server) {
+			defer serverWg.Done()
+
+			if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				select {
+				case serverErrCh <- err:
+				default:
+				}
+			}
+		}(server)
+
+		logger.Info("metrics server started", zap.String("address", listenAddress), zap.Bool("tls", secure))
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// Gather implements prometheus.Gatherer interface.
+func (ctrl *MetricsServerController) Gather() ([]*dto.MetricFamily, error) {
+	ctrl.extensionMetricsMu.Lock()
+	defer ctrl.extensionMetricsMu.Unlock()
+
+	return ctrl.extensionMetrics, nil
+}
+
+func (ctrl *MetricsServerController) updateExtensionMetrics(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	list, err := safe.ReaderListAll[*runtime.ExtensionMetrics](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing extension metrics: %w", err)
+	}
+
+	sources := make(map[string][]*dto.MetricFamily, list.Len())
+
+	for iter := list.Iterator(); iter.Next(); {
+		families, err := textfile.Parse(strings.NewReader(iter.Value().TypedSpec().Metrics))
+		if err != nil {
+			logger.Debug("error parsing extension metrics", zap.String("id", iter.Value().Metadata().ID()), zap.Error(err))
+
+			continue
+		}
+
+		sources[iter.Value().Metadata().ID()] = families
+	}
+
+	merged, err := textfile.Merge(sources, extensionMetricsSourceLabel)
+	if err != nil {
+		logger.Debug("error merging extension metrics", zap.Error(err))
+	}
+
+	ctrl.extensionMetricsMu.Lock()
+	ctrl.extensionMetrics = merged
+	ctrl.extensionMetricsMu.Unlock()
+
+	return nil
+}
+
+// isLoopbackAddress returns true if the listen address is a loopback address.
+func isLoopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+
+	return addr.IsLoopback()
+}
+
+// metricsServerCertificates keeps the Talos API certificates for the metrics server.
+type metricsServerCertificates struct {
+	mu         sync.Mutex
+	serverCert *tls.Certificate
+	clientCAs  *stdx509.CertPool
+}
+
+// Update the certificates, the established connections are not affected.
+func (c *metricsServerCertificates) Update(spec *secrets.APICertsSpec) error {
+	serverCert, err := tls.X509KeyPair(spec.Server.Crt, spec.Server.Key)
+	if err != nil {
+		return fmt.Errorf("failed to parse server certificate: %w", err)
+	}
+
+	clientCAs := stdx509.NewCertPool()
+
+	for _, ca := range spec.AcceptedCAs {
+		if !clientCAs.AppendCertsFromPEM(ca.Crt) {
+			return errors.New("failed to parse accepted CA certificate")
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.serverCert = &serverCert
+	c.clientCAs = clientCAs
+
+	return nil
+}
+
+// TLSConfig returns the server TLS config which requires the client certificates issued by the accepted CAs.
+func (c *metricsServerCertificates) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS13,
+				Certificates: []tls.Certificate{*c.serverCert},
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				ClientCAs:    c.clientCAs,
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type MetricsServerSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestMetricsServerSuite(t *testing.T) {
+	suite.Run(t, &MetricsServerSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.MetricsServerController{}))
+			},
+		},
+	})
+}
+
+func (suite *MetricsServerSuite) freeAddress() string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+
+	address := listener.Addr().String()
+
+	suite.Require().NoError(listener.Close())
+
+	return address
+}
+
+func (suite *MetricsServerSuite) scrape(address string) (string, error) {
+	resp, err := http.Get("http://" + address + "/metrics") //nolint:noctx
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	body, err := io.ReadAll(resp.Body)
+
+	return string(body), err
+}
+
+func (suite *MetricsServerSuite) TestMetrics() {
+	address := suite.freeAddress()
+
+	extensionMetrics := runtime.NewExtensionMetrics("zfs")
+	extensionMetrics.TypedSpec().Metrics = "# TYPE zfs_arc_size gauge\nzfs_arc_size 1024\n"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), extensionMetrics))
+
+	cfg, err := container.New(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "worker",
+			MachineFeatures: &v1alpha1.FeaturesConfig{
+				MetricsConfig: &v1alpha1.MetricsConfig{
+					MetricsEnabled:       pointer.To(true),
+					MetricsListenAddress: address,
+				},
+			},
+		},
+	})
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	suite.Assert().EventuallyWithT(func(collect *assert.CollectT) {
+		body, err := suite.scrape(address)
+		if !assert.NoError(collect, err) {
+			return
+		}
+
+		assert.Contains(collect, body, "talos_controller_wakeups_total{controller=\"runtime.MetricsServerController\"}")
+		assert.Contains(collect, body, "zfs_arc_size{extension=\"zfs\"} 1024")
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// removing the machine config stops the server
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+
+	suite.Assert().Eventually(func() bool {
+		_, err := suite.scrape(address)
+
+		return err != nil
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -0,0 +1,208 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// UdevRuleController writes the udev rules files and reloads udevd.
+type UdevRuleController struct {
+	V1Alpha1Mode v1alpha1runtime.Mode
+
+	// RulesDir is the path to the udev rules directory, defaults to the Talos udev rules directory.
+	RulesDir string
+	// Udevadm runs the udevadm command, defaults to running /sbin/udevadm.
+	Udevadm func(ctx context.Context, args ...string) error
+
+	// managed is the set of the rules files written by the controller.
+	managed map[string]struct{}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *UdevRuleController) Name() string {
+	return "runtime.UdevRuleController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *UdevRuleController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.UdevRuleType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some("udevd"),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *UdevRuleController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *UdevRuleController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// udevd is not running in container mode
+	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
+		return nil
+	}
+
+	if ctrl.RulesDir == "" {
+		ctrl.RulesDir = constants.UdevRulesDir
+	}
+
+	if ctrl.Udevadm == nil {
+		ctrl.Udevadm = func(ctx context.Context, args ...string) error {
+			_, err := cmd.RunContext(ctx, "/sbin/udevadm", args...)
+
+			return err
+		}
+	}
+
+	ctrl.managed = map[string]struct{}{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		udevdService, err := safe.ReaderGet[*v1alpha1.Service](ctx, r, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "udevd", resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting udevd service: %w", err)
+		}
+
+		if udevdService == nil || !udevdService.TypedSpec().Running {
+			// rules are applied once udevd is running
+			continue
+		}
+
+		rules, err := safe.ReaderListAll[*runtime.UdevRule](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing udev rules: %w", err)
+		}
+
+		if err = os.MkdirAll(ctrl.RulesDir, 0o755); err != nil {
+			return fmt.Errorf("error creating udev rules directory: %w", err)
+		}
+
+		touched := map[string]struct{}{}
+		changed := false
+
+		for iter := rules.Iterator(); iter.Next(); {
+			rule := iter.Value()
+			name := rule.Metadata().ID()
+			touched[name] = struct{}{}
+
+			updated, err := ctrl.writeRule(name, rule.TypedSpec())
+			if err != nil {
+				return fmt.Errorf("error writing udev rule %q: %w", name, err)
+			}
+
+			if updated {
+				logger.Info("udev rule updated", zap.String("name", name), zap.Bool("masked", rule.TypedSpec().Masked))
+
+				changed = true
+			}
+
+			ctrl.managed[name] = struct{}{}
+		}
+
+		for name := range ctrl.managed {
+			if _, ok := touched[name]; ok {
+				continue
+			}
+
+			if err = os.Remove(filepath.Join(ctrl.RulesDir, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("error removing udev rule %q: %w", name, err)
+			}
+
+			logger.Info("udev rule removed", zap.String("name", name))
+
+			delete(ctrl.managed, name)
+
+			changed = true
+		}
+
+		if !changed {
+			continue
+		}
+
+		// reload the rules and replay the network devices events, so that the interface naming is applied
+		for _, args := range [][]string{
+			{"control", "--reload"},
+			{"trigger", "--action=add", "--subsystem-match=net"},
+			{"settle", "--timeout=50"},
+		} {
+			if err = ctrl.Udevadm(ctx, args...); err != nil {
+				return fmt.Errorf("error running udevadm %v: %w", args, err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// writeRule writes the rules file or masks it, and returns true if the file was updated.
+func (ctrl *UdevRuleController) writeRule(name string, spec *runtime.UdevRuleSpec) (bool, error) {
+	path := filepath.Join(ctrl.RulesDir, name)
+
+	if spec.Masked {
+		if target, err := os.Readlink(path); err == nil && target == os.DevNull {
+			return false, nil
+		}
+
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return false, err
+		}
+
+		return true, os.Symlink(os.DevNull, path)
+	}
+
+	if st, err := os.Lstat(path); err == nil && st.Mode().IsRegular() {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return false, err
+		}
+
+		if bytes.Equal(contents, []byte(spec.Rule)) {
+			return false, nil
+		}
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return false, err
+	}
+
+	return true, os.WriteFile(path, []byte(spec.Rule), 0o644)
+}
@@ -0,0 +1,123 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// Udev rules files controlling the network interface naming.
+const (
+	// udevNetSetupLinkRules is the rules file shipped with udev which applies the naming policy of the .link files.
+	udevNetSetupLinkRules = "80-net-setup-link.rules"
+	// udevNetNameSlotRules is the rules file which applies predictable names regardless of the `net.ifnames` kernel argument.
+	udevNetNameSlotRules = "80-net-name-slot.rules"
+)
+
+// udevNetNameSlotRule names the network interfaces using the names built by the udev net_id builtin.
+const udevNetNameSlotRule = `ACTION!="add", GOTO="net_name_slot_end"
+SUBSYSTEM!="net", GOTO="net_name_slot_end"
+NAME!="", GOTO="net_name_slot_end"
+
+NAME=="", ENV{ID_NET_NAME_ONBOARD}!="", NAME="$env{ID_NET_NAME_ONBOARD}"
+NAME=="", ENV{ID_NET_NAME_SLOT}!="", NAME="$env{ID_NET_NAME_SLOT}"
+NAME=="", ENV{ID_NET_NAME_PATH}!="", NAME="$env{ID_NET_NAME_PATH}"
+
+LABEL="net_name_slot_end"
+`
+
+// UdevRuleConfigController generates the udev rules from the machine configuration.
+type UdevRuleConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *UdevRuleConfigController) Name() string {
+	return "runtime.UdevRuleConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *UdevRuleConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *UdevRuleConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.UdevRuleType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *UdevRuleConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		rules := map[string]runtime.UdevRuleSpec{}
+
+		if cfg != nil {
+			if udevConfig := cfg.Config().UdevRules(); udevConfig != nil {
+				if predictable, ok := udevConfig.PredictableInterfaceNames().Get(); ok {
+					if predictable {
+						rules[udevNetNameSlotRules] = runtime.UdevRuleSpec{Rule: udevNetNameSlotRule}
+					} else {
+						rules[udevNetSetupLinkRules] = runtime.UdevRuleSpec{Masked: true}
+					}
+				}
+
+				for _, name := range udevConfig.DisabledRules() {
+					rules[name] = runtime.UdevRuleSpec{Masked: true}
+				}
+
+				for _, rule := range udevConfig.Rules() {
+					rules[rule.Name()] = runtime.UdevRuleSpec{Rule: rule.Contents()}
+				}
+			}
+		}
+
+		r.StartTrackingOutputs()
+
+		for name, spec := range rules {
+			if err = safe.WriterModify(ctx, r, runtime.NewUdevRule(name), func(res *runtime.UdevRule) error {
+				*res.TypedSpec() = spec
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating udev rule: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*runtime.UdevRule](ctx, r); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type UdevRuleConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestUdevRuleConfigSuite(t *testing.T) {
+	suite.Run(t, &UdevRuleConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.UdevRuleConfigController{}))
+			},
+		},
+	})
+}
+
+func (suite *UdevRuleConfigSuite) TestNoConfig() {
+	udevCfg := runtimecfg.NewUdevV1Alpha1()
+
+	cfg, err := container.New(udevCfg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	rtestutils.AssertNoResource[*runtime.UdevRule](suite.Ctx(), suite.T(), suite.State(), "80-net-setup-link.rules")
+	rtestutils.AssertNoResource[*runtime.UdevRule](suite.Ctx(), suite.T(), suite.State(), "80-net-name-slot.rules")
+}
+
+func (suite *UdevRuleConfigSuite) TestRules() {
+	udevCfg := runtimecfg.NewUdevV1Alpha1()
+	udevCfg.UdevPredictableInterfaceNames = pointer.To(false)
+	udevCfg.UdevRules = []runtimecfg.UdevRuleV1Alpha1{
+		{
+			RuleName:     "90-nic-names.rules",
+			RuleContents: `SUBSYSTEM=="net", ACTION=="add", ATTR{address}=="00:11:22:33:44:55", NAME="uplink0"` + "\n",
+		},
+	}
+	udevCfg.UdevDisabledRules = []string{"60-persistent-storage-tape.rules"}
+
+	cfg, err := container.New(udevCfg)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"60-persistent-storage-tape.rules", "80-net-setup-link.rules"},
+		func(rule *runtime.UdevRule, asrt *assert.Assertions) {
+			asrt.True(rule.TypedSpec().Masked)
+			asrt.Empty(rule.TypedSpec().Rule)
+		})
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), "90-nic-names.rules",
+		func(rule *runtime.UdevRule, asrt *assert.Assertions) {
+			asrt.False(rule.TypedSpec().Masked)
+			asrt.Equal(`SUBSYSTEM=="net", ACTION=="add", ATTR{address}=="00:11:22:33:44:55", NAME="uplink0"`+"\n", rule.TypedSpec().Rule)
+		})
+
+	// enable predictable names, drop the custom rules
+	udevCfg = runtimecfg.NewUdevV1Alpha1()
+	udevCfg.UdevPredictableInterfaceNames = pointer.To(true)
+
+	cfg, err = container.New(udevCfg)
+	suite.Require().NoError(err)
+
+	newMachineConfig := config.NewMachineConfig(cfg)
+	newMachineConfig.Metadata().SetVersion(machineConfig.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newMachineConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), "80-net-name-slot.rules",
+		func(rule *runtime.UdevRule, asrt *assert.Assertions) {
+			asrt.False(rule.TypedSpec().Masked)
+			asrt.Contains(rule.TypedSpec().Rule, `NAME="$env{ID_NET_NAME_PATH}"`)
+		})
+
+	rtestutils.AssertNoResource[*runtime.UdevRule](suite.Ctx(), suite.T(), suite.State(), "80-net-setup-link.rules")
+	rtestutils.AssertNoResource[*runtime.UdevRule](suite.Ctx(), suite.T(), suite.State(), "90-nic-names.rules")
+	rtestutils.AssertNoResource[*runtime.UdevRule](suite.Ctx(), suite.T(), suite.State(), "60-persistent-storage-tape.rules")
+}
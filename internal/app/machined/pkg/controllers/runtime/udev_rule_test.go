@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type UdevRuleSuite struct {
+	ctest.DefaultSuite
+
+	rulesDir string
+
+	mu       sync.Mutex
+	commands []string
+}
+
+func TestUdevRuleSuite(t *testing.T) {
+	s := &UdevRuleSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.rulesDir = filepath.Join(suite.T().TempDir(), "rules.d")
+			s.commands = nil
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.UdevRuleController{
+				RulesDir: s.rulesDir,
+				Udevadm: func(_ context.Context, args ...string) error {
+					s.mu.Lock()
+					defer s.mu.Unlock()
+
+					s.commands = append(s.commands, strings.Join(args, " "))
+
+					return nil
+				},
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *UdevRuleSuite) assertCommands(expected ...string) {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(10*time.Millisecond)).Retry(func() error {
+		suite.mu.Lock()
+		defer suite.mu.Unlock()
+
+		if len(suite.commands) != len(expected) {
+			return retry.ExpectedErrorf("expected %d commands, got %q", len(expected), suite.commands)
+		}
+
+		return nil
+	}))
+
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	suite.Assert().Equal(expected, suite.commands)
+}
+
+func (suite *UdevRuleSuite) TestRules() {
+	custom := runtime.NewUdevRule("90-nic-names.rules")
+	custom.TypedSpec().Rule = `SUBSYSTEM=="net", ACTION=="add", NAME="uplink0"` + "\n"
+	suite.Create(custom)
+
+	masked := runtime.NewUdevRule("80-net-setup-link.rules")
+	masked.TypedSpec().Masked = true
+	suite.Create(masked)
+
+	// nothing is written until udevd is running
+	time.Sleep(100 * time.Millisecond)
+
+	suite.Assert().NoDirExists(suite.rulesDir)
+
+	udevd := v1alpha1.NewService("udevd")
+	udevd.TypedSpec().Running = true
+	suite.Create(udevd)
+
+	reload := []string{"control --reload", "trigger --action=add --subsystem-match=net", "settle --timeout=50"}
+
+	suite.assertCommands(reload...)
+
+	contents, err := os.ReadFile(filepath.Join(suite.rulesDir, "90-nic-names.rules"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(custom.TypedSpec().Rule, string(contents))
+
+	target, err := os.Readlink(filepath.Join(suite.rulesDir, "80-net-setup-link.rules"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(os.DevNull, target)
+
+	// removing the rule removes the file and reloads udevd again
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), custom.Metadata()))
+
+	suite.assertCommands(append(reload, reload...)...)
+
+	suite.Assert().NoFileExists(filepath.Join(suite.rulesDir, "90-nic-names.rules"))
+	suite.Assert().FileExists(filepath.Join(suite.rulesDir, "80-net-setup-link.rules"))
+}
@@ -61,6 +61,10 @@ func (ctrl *SyncController) Outputs() []controller.Output {
 			Type: time.StatusType,
 			Kind: controller.OutputExclusive,
 		},
+		{
+			Type: time.SourceStatusType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -69,6 +73,8 @@ type NTPSyncer interface {
 	Run(ctx context.Context)
 	Synced() <-chan struct{}
 	EpochChange() <-chan struct{}
+	StatsChange() <-chan struct{}
+	SourceStats() []ntp.SourceStats
 	SetTimeServers([]string)
 }
 
@@ -96,6 +102,7 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 
 		syncCh  <-chan struct{}
 		epochCh <-chan struct{}
+		statsCh <-chan struct{}
 		syncer  NTPSyncer
 
 		timeSynced bool
@@ -127,6 +134,7 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			timeSynced = true
 		case <-epochCh:
 			epoch++
+		case <-statsCh:
 		case <-timeSyncTimeoutCh:
 			timeSynced = true
 			timeSyncTimeoutTimer = nil
@@ -200,11 +208,13 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			syncer = nil
 			syncCh = nil
 			epochCh = nil
+			statsCh = nil
 		case !syncDisabled && syncer == nil:
 			// start syncing
 			syncer = ctrl.NewNTPSyncer(logger, timeServers)
 			syncCh = syncer.Synced()
 			epochCh = syncer.EpochChange()
+			statsCh = syncer.StatsChange()
 
 			timeSynced = false
 
@@ -227,6 +237,8 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			timeSynced = true
 		}
 
+		r.StartTrackingOutputs()
+
 		if err = r.Modify(ctx, time.NewStatus(), func(r resource.Resource) error {
 			*r.(*time.Status).TypedSpec() = time.StatusSpec{
 				Epoch:        epoch,
@@ -239,6 +251,34 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			return fmt.Errorf("error updating objects: %w", err) //nolint:govet
 		}
 
+		if syncer != nil {
+			for _, stats := range syncer.SourceStats() {
+				if err = safe.WriterModify(ctx, r, time.NewSourceStatus(stats.Server), func(res *time.SourceStatus) error {
+					*res.TypedSpec() = time.SourceStatusSpec{
+						Server:         stats.Server,
+						Selected:       stats.Selected,
+						Stratum:        stats.Stratum,
+						Reachability:   stats.Reachability,
+						PollInterval:   stats.PollInterval,
+						LastSample:     stats.LastSample,
+						Offset:         stats.Offset,
+						Jitter:         stats.Jitter,
+						Delay:          stats.Delay,
+						RootDelay:      stats.RootDelay,
+						RootDispersion: stats.RootDispersion,
+					}
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating time source status: %w", err) //nolint:govet
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*time.SourceStatus](ctx, r); err != nil {
+			return err //nolint:govet
+		}
+
 		r.ResetRestartBackoff()
 	}
 }
@@ -13,11 +13,13 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/controller/runtime"
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	"github.com/siderolabs/go-pointer"
 	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
@@ -25,6 +27,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	timectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/time"
 	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/ntp"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -444,6 +447,84 @@ func (suite *SyncSuite) TestReconcileSyncBootTimeout() {
 	)
 }
 
+func (suite *SyncSuite) TestReconcileSourceStats() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&timectrl.SyncController{
+				V1Alpha1Mode: v1alpha1runtime.ModeMetal,
+				NewNTPSyncer: suite.newMockSyncer,
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	timeServers := network.NewTimeServerStatus(network.NamespaceName, network.TimeServerID)
+	timeServers.TypedSpec().NTPServers = []string{"127.0.0.1", "127.0.0.2"}
+	suite.Require().NoError(suite.state.Create(suite.ctx, timeServers))
+
+	var mockSyncer *mockSyncer
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				mockSyncer = suite.getMockSyncer()
+
+				if mockSyncer == nil {
+					return retry.ExpectedErrorf("syncer not created yet")
+				}
+
+				return nil
+			},
+		),
+	)
+
+	mockSyncer.setSourceStats([]ntp.SourceStats{
+		{
+			Server:       "127.0.0.1",
+			Reachability: 0b10,
+		},
+		{
+			Server:       "127.0.0.2",
+			Selected:     true,
+			Stratum:      2,
+			Reachability: 0b11,
+			PollInterval: 64 * time.Second,
+			Offset:       time.Millisecond,
+			Jitter:       100 * time.Microsecond,
+			Delay:        5 * time.Millisecond,
+		},
+	})
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, "127.0.0.1", func(r *timeresource.SourceStatus, asrt *assert.Assertions) {
+		asrt.False(r.TypedSpec().Selected)
+		asrt.EqualValues(0b10, r.TypedSpec().Reachability)
+	})
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, "127.0.0.2", func(r *timeresource.SourceStatus, asrt *assert.Assertions) {
+		asrt.True(r.TypedSpec().Selected)
+		asrt.EqualValues(2, r.TypedSpec().Stratum)
+		asrt.EqualValues(0b11, r.TypedSpec().Reachability)
+		asrt.Equal(64*time.Second, r.TypedSpec().PollInterval)
+		asrt.Equal(time.Millisecond, r.TypedSpec().Offset)
+		asrt.Equal(100*time.Microsecond, r.TypedSpec().Jitter)
+		asrt.Equal(5*time.Millisecond, r.TypedSpec().Delay)
+	})
+
+	mockSyncer.setSourceStats([]ntp.SourceStats{
+		{
+			Server:       "127.0.0.2",
+			Selected:     true,
+			Reachability: 0b111,
+		},
+	})
+
+	rtestutils.AssertNoResource[*timeresource.SourceStatus](suite.ctx, suite.T(), suite.state, "127.0.0.1")
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, "127.0.0.2", func(r *timeresource.SourceStatus, asrt *assert.Assertions) {
+		asrt.EqualValues(0b111, r.TypedSpec().Reachability)
+	})
+}
+
 func (suite *SyncSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -476,8 +557,10 @@ type mockSyncer struct {
 	mu sync.Mutex
 
 	timeServers []string
+	sourceStats []ntp.SourceStats
 	syncedCh    chan struct{}
 	epochCh     chan struct{}
+	statsCh     chan struct{}
 }
 
 func (mock *mockSyncer) Run(ctx context.Context) {
@@ -492,6 +575,25 @@ func (mock *mockSyncer) EpochChange() <-chan struct{} {
 	return mock.epochCh
 }
 
+func (mock *mockSyncer) StatsChange() <-chan struct{} {
+	return mock.statsCh
+}
+
+func (mock *mockSyncer) SourceStats() []ntp.SourceStats {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return slices.Clone(mock.sourceStats)
+}
+
+func (mock *mockSyncer) setSourceStats(stats []ntp.SourceStats) {
+	mock.mu.Lock()
+	mock.sourceStats = slices.Clone(stats)
+	mock.mu.Unlock()
+
+	mock.statsCh <- struct{}{}
+}
+
 func (mock *mockSyncer) getTimeServers() (servers []string) {
 	mock.mu.Lock()
 	defer mock.mu.Unlock()
@@ -511,5 +613,6 @@ func newMockSyncer(_ *zap.Logger, servers []string) *mockSyncer {
 		timeServers: slices.Clone(servers),
 		syncedCh:    make(chan struct{}, 1),
 		epochCh:     make(chan struct{}, 1),
+		statsCh:     make(chan struct{}, 1),
 	}
 }
@@ -7,6 +7,7 @@ package runtime
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 )
@@ -24,6 +25,22 @@ type Phase struct {
 	Name      string
 	Tasks     []TaskSetupFunc
 	CheckFunc func() bool
+
+	// Cancelable is true if the sequence can be canceled while running this phase,
+	// i.e. the phase doesn't leave the node in an inconsistent state when interrupted.
+	Cancelable bool
+}
+
+// SequenceStatus describes the sequence being run by the controller.
+type SequenceStatus struct {
+	Sequence Sequence
+	ActorID  string
+	Started  time.Time
+
+	Phase       string
+	PhaseNumber int
+	TotalPhases int
+	Cancelable  bool
 }
 
 // LockOptions represents the options for a controller.
@@ -54,6 +71,8 @@ type Controller interface {
 	Runtime() Runtime
 	Sequencer() Sequencer
 	Run(context.Context, Sequence, any, ...LockOption) error
+	RunningSequences() []SequenceStatus
+	CancelSequence(Sequence) error
 	V1Alpha2() V1Alpha2Controller
 }
 
@@ -20,6 +20,12 @@ var (
 
 	// ErrUndefinedRuntime indicates that the sequencer's runtime is not defined.
 	ErrUndefinedRuntime = errors.New("undefined runtime")
+
+	// ErrSequenceNotRunning indicates that the sequence to be canceled is not running.
+	ErrSequenceNotRunning = errors.New("sequence is not running")
+
+	// ErrSequenceNotCancelable indicates that the current phase of the sequence can't be canceled.
+	ErrSequenceNotCancelable = errors.New("sequence can't be canceled in the current phase")
 )
 
 // RebootError encapsulates unix.Reboot() cmd argument.
@@ -8,9 +8,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/rs/xid"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
@@ -20,6 +24,9 @@ import (
 // ActorIDCtxKey is the context key used for event actor id.
 type ActorIDCtxKey struct{}
 
+// EventTypeURLPrefix is the prefix of the runtime events type URL.
+const EventTypeURLPrefix = "talos/runtime/"
+
 // Event is what is sent on the wire.
 type Event struct {
 	TypeURL string
@@ -51,6 +58,29 @@ type WatchOptions struct {
 	TailDuration time.Duration
 	// ActorID to ID of the actor to filter events by.
 	ActorID string
+	// Types of the events to filter events by.
+	Types []string
+	// Since is the timestamp to filter events by (events published before Since are skipped).
+	//
+	// If none of the tail options is set, the history starts at Since.
+	Since time.Time
+}
+
+// Matches checks whether the event passes the watch filters.
+func (opts *WatchOptions) Matches(event Event) bool {
+	if opts.ActorID != "" && event.ActorID != opts.ActorID {
+		return false
+	}
+
+	if len(opts.Types) > 0 && !slices.ContainsFunc(opts.Types, event.HasType) {
+		return false
+	}
+
+	if !opts.Since.IsZero() && event.ID.Time().Before(opts.Since.Truncate(time.Second)) {
+		return false
+	}
+
+	return true
 }
 
 // WatchOptionFunc defines the options for the watcher.
@@ -106,6 +136,26 @@ func WithActorID(actorID string) WatchOptionFunc {
 	}
 }
 
+// WithTypes sets up Watcher to return events filtered by given event types.
+//
+// Event type is either a type URL, or full protobuf message name (e.g. machine.SequenceEvent).
+func WithTypes(types ...string) WatchOptionFunc {
+	return func(opts *WatchOptions) error {
+		opts.Types = append(opts.Types, types...)
+
+		return nil
+	}
+}
+
+// WithSince sets up Watcher to return events with timestamp >= since.
+func WithSince(since time.Time) WatchOptionFunc {
+	return func(opts *WatchOptions) error {
+		opts.Since = since
+
+		return nil
+	}
+}
+
 // Watcher defines a runtime event watcher.
 type Watcher interface {
 	Watch(WatchFunc, ...WatchOptionFunc) error
@@ -116,17 +166,26 @@ type Publisher interface {
 	Publish(context.Context, proto.Message)
 }
 
+// Persister defines a runtime event stream persistence.
+type Persister interface {
+	// Persist restores the events saved to the file on the previous boots, and starts saving the published events to the file.
+	Persist(path string) error
+	// StopPersisting stops saving the published events and closes the file.
+	StopPersisting() error
+}
+
 // EventStream defines the runtime event stream.
 type EventStream interface {
 	Watcher
 	Publisher
+	Persister
 }
 
 // NewEvent creates a new event with the provided payload and actor ID.
 func NewEvent(payload proto.Message, actorID string) Event {
 	typeURL := ""
 	if payload != nil {
-		typeURL = fmt.Sprintf("talos/runtime/%s", payload.ProtoReflect().Descriptor().FullName())
+		typeURL = EventTypeURLPrefix + string(payload.ProtoReflect().Descriptor().FullName())
 	}
 
 	return Event{
@@ -156,3 +215,36 @@ func (event *Event) ToMachineEvent() (*machine.Event, error) {
 		ActorId: event.ActorID,
 	}, nil
 }
+
+// HasType checks whether the event is of the specified type (type URL or full protobuf message name).
+func (event *Event) HasType(eventType string) bool {
+	return event.TypeURL == eventType || strings.TrimPrefix(event.TypeURL, EventTypeURLPrefix) == eventType
+}
+
+// EventFromMachineEvent deserializes Event from proto message machine.Event.
+func EventFromMachineEvent(msg *machine.Event) (Event, error) {
+	id, err := xid.FromString(msg.GetId())
+	if err != nil {
+		return Event{}, fmt.Errorf("error parsing event ID: %w", err)
+	}
+
+	typeURL := msg.GetData().GetTypeUrl()
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(strings.TrimPrefix(typeURL, EventTypeURLPrefix)))
+	if err != nil {
+		return Event{}, fmt.Errorf("unsupported event type %q: %w", typeURL, err)
+	}
+
+	payload := messageType.New().Interface()
+
+	if err = proto.Unmarshal(msg.GetData().GetValue(), payload); err != nil {
+		return Event{}, fmt.Errorf("error unmarshaling event %q: %w", typeURL, err)
+	}
+
+	return Event{
+		TypeURL: typeURL,
+		ID:      id,
+		Payload: payload,
+		ActorID: msg.GetActorId(),
+	}, nil
+}
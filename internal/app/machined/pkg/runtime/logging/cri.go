@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// CRILogLine is a parsed line of the container log written by the CRI runtime.
+type CRILogLine struct {
+	Time    time.Time
+	Stream  string
+	Message string
+
+	// Partial is true if the line is a part of the long log line split by the runtime.
+	Partial bool
+}
+
+// ParseCRILogLine parses the line in the CRI log format: "<RFC3339Nano time> <stream> <P|F> <message>".
+func ParseCRILogLine(l []byte) (CRILogLine, error) {
+	parts := bytes.SplitN(l, []byte(" "), 4)
+	if len(parts) < 3 {
+		return CRILogLine{}, fmt.Errorf("invalid CRI log line %q", l)
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, string(parts[0]))
+	if err != nil {
+		return CRILogLine{}, fmt.Errorf("invalid CRI log line time: %w", err)
+	}
+
+	line := CRILogLine{
+		Time:   t.UTC(),
+		Stream: string(parts[1]),
+	}
+
+	switch string(parts[2]) {
+	case "P":
+		line.Partial = true
+	case "F":
+	default:
+		return CRILogLine{}, fmt.Errorf("invalid CRI log line tag %q", parts[2])
+	}
+
+	if len(parts) == 4 {
+		line.Message = string(parts[3])
+	}
+
+	return line, nil
+}
+
+// PodLogPath describes the container log file in the kubelet pod logs directory.
+type PodLogPath struct {
+	Namespace string
+	Pod       string
+	UID       string
+	Container string
+}
+
+// ParsePodLogPath parses the path of the container log relative to the pod logs directory:
+// "<namespace>_<pod>_<uid>/<container>/<restart count>.log".
+func ParsePodLogPath(path string) (PodLogPath, error) {
+	dir, file := filepath.Split(filepath.Clean(path))
+	podDir, container := filepath.Split(filepath.Clean(dir))
+
+	podDir = filepath.Clean(podDir)
+
+	if filepath.Ext(file) != ".log" || container == "" || strings.Contains(podDir, "/") {
+		return PodLogPath{}, fmt.Errorf("unexpected pod log path %q", path)
+	}
+
+	// namespace and pod names can't contain underscores
+	namespace, rest, ok := strings.Cut(podDir, "_")
+	if !ok {
+		return PodLogPath{}, fmt.Errorf("unexpected pod log path %q", path)
+	}
+
+	pod, uid, ok := strings.Cut(rest, "_")
+	if !ok {
+		return PodLogPath{}, fmt.Errorf("unexpected pod log path %q", path)
+	}
+
+	return PodLogPath{
+		Namespace: namespace,
+		Pod:       pod,
+		UID:       uid,
+		Container: container,
+	}, nil
+}
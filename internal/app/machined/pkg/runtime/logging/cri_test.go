@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+func TestParseCRILogLine(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		line string
+
+		expected      logging.CRILogLine
+		expectedError string
+	}{
+		{
+			name: "full",
+			line: "2024-01-02T03:04:05.123456789Z stdout F hello world",
+
+			expected: logging.CRILogLine{
+				Time:    time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC),
+				Stream:  "stdout",
+				Message: "hello world",
+			},
+		},
+		{
+			name: "partial",
+			line: "2024-01-02T03:04:05.123456789+02:00 stderr P part",
+
+			expected: logging.CRILogLine{
+				Time:    time.Date(2024, 1, 2, 1, 4, 5, 123456789, time.UTC),
+				Stream:  "stderr",
+				Message: "part",
+				Partial: true,
+			},
+		},
+		{
+			name: "empty",
+			line: "2024-01-02T03:04:05Z stdout F",
+
+			expected: logging.CRILogLine{
+				Time:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				Stream: "stdout",
+			},
+		},
+		{
+			name: "invalid time",
+			line: "yesterday stdout F hello",
+
+			expectedError: "invalid CRI log line time",
+		},
+		{
+			name: "invalid tag",
+			line: "2024-01-02T03:04:05Z stdout X hello",
+
+			expectedError: `invalid CRI log line tag "X"`,
+		},
+		{
+			name: "short",
+			line: "hello",
+
+			expectedError: `invalid CRI log line "hello"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			line, err := logging.ParseCRILogLine([]byte(test.line))
+
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, line)
+		})
+	}
+}
+
+func TestParsePodLogPath(t *testing.T) {
+	t.Parallel()
+
+	path, err := logging.ParsePodLogPath("kube-system_kube-proxy-6xl5z_6e1b5b5e-2b9b-4d6c-9c0a-8d1d2e9f1a3b/kube-proxy/0.log")
+	require.NoError(t, err)
+
+	assert.Equal(t, logging.PodLogPath{
+		Namespace: "kube-system",
+		Pod:       "kube-proxy-6xl5z",
+		UID:       "6e1b5b5e-2b9b-4d6c-9c0a-8d1d2e9f1a3b",
+		Container: "kube-proxy",
+	}, path)
+
+	for _, invalid := range []string{
+		"kube-proxy/0.log",
+		"kube-system_kube-proxy/kube-proxy/0.log",
+		"kube-system_kube-proxy_uid/kube-proxy/0.log.20240101",
+		"a/kube-system_kube-proxy_uid/kube-proxy/0.log",
+	} {
+		_, err = logging.ParsePodLogPath(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"regexp"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+// maxRecordSize is the maximum size of the log line parsed as a record, longer lines fail the scanner.
+const maxRecordSize = 1024 * 1024
+
+// goLogTimeRe matches the timestamp written by the Go standard logger (optionally after the prefix like "[talos]").
+var goLogTimeRe = regexp.MustCompile(`^(\[[^\]]*\] )?(\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2}(?:\.\d+)?) `)
+
+// RecordFilter selects the log records by the level, the message pattern and the time range.
+//
+// Zero value of the filter matches all records.
+type RecordFilter struct {
+	// Since and Until limit the record time to [Since, Until), zero value means no limit.
+	Since time.Time
+	Until time.Time
+
+	// Pattern is matched against the record message.
+	Pattern *regexp.Regexp
+
+	MinLevel zapcore.Level
+}
+
+// Match returns true if the log record passes the filter.
+func (f RecordFilter) Match(e *runtime.LogEvent) bool {
+	if e.Level < f.MinLevel {
+		return false
+	}
+
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+
+	if !f.Until.IsZero() && !e.Time.Before(f.Until) {
+		return false
+	}
+
+	if f.Pattern != nil && !f.Pattern.MatchString(e.Msg) {
+		return false
+	}
+
+	return true
+}
+
+// ParseRecord parses the service log line into the log record.
+//
+// The time and the level are extracted from the JSON log lines and the lines written by the Go standard logger,
+// otherwise the time defaults to now.
+func ParseRecord(l []byte, now time.Time) *runtime.LogEvent {
+	e := parseLogLine(l, now)
+
+	if !e.Time.Equal(now) {
+		return e
+	}
+
+	if m := goLogTimeRe.FindStringSubmatch(e.Msg); m != nil {
+		if t, err := time.Parse("2006/01/02 15:04:05.999999999", m[2]); err == nil {
+			e.Time = t.UTC()
+			e.Msg = m[1] + e.Msg[len(m[0]):]
+		}
+	}
+
+	return e
+}
+
+// ReadRecords reads the log lines from r, parses them into the log records and calls fn for each record.
+//
+// If cri is true, the log lines are expected in the CRI log format, and the partial lines are joined.
+// ReadRecords stops on the first error returned by fn.
+func ReadRecords(r io.Reader, cri bool, fn func(*runtime.LogEvent) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxRecordSize)
+
+	var partial []byte
+
+	for scanner.Scan() {
+		l := bytes.TrimRight(scanner.Bytes(), "\r")
+
+		if !cri {
+			if len(bytes.TrimSpace(l)) == 0 {
+				continue
+			}
+
+			if err := fn(ParseRecord(l, time.Now())); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		line, err := ParseCRILogLine(l)
+		if err != nil {
+			// not a CRI log line, e.g. the log of the container without the log file
+			if err = fn(ParseRecord(l, time.Now())); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if line.Partial {
+			partial = append(partial, line.Message...)
+
+			continue
+		}
+
+		msg := append(partial, line.Message...)
+		partial = nil
+
+		e := ParseRecord(msg, line.Time)
+
+		if e.Fields == nil {
+			e.Fields = map[string]any{}
+		}
+
+		e.Fields["stream"] = line.Stream
+
+		if err = fn(e); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+func TestParseRecord(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 10, 19, 12, 42, 37, 0, time.UTC)
+
+	for _, test := range []struct {
+		name string
+		line string
+
+		expected *runtime.LogEvent
+	}{
+		{
+			name: "plain",
+			line: "hello world",
+
+			expected: &runtime.LogEvent{
+				Msg:   "hello world",
+				Time:  now,
+				Level: zapcore.InfoLevel,
+			},
+		},
+		{
+			name: "go log",
+			line: "2024/10/19 11:02:03.123456 apid started",
+
+			expected: &runtime.LogEvent{
+				Msg:   "apid started",
+				Time:  time.Date(2024, 10, 19, 11, 2, 3, 123456000, time.UTC),
+				Level: zapcore.InfoLevel,
+			},
+		},
+		{
+			name: "go log with prefix",
+			line: "[talos] 2024/10/19 11:02:03 task done",
+
+			expected: &runtime.LogEvent{
+				Msg:   "[talos] task done",
+				Time:  time.Date(2024, 10, 19, 11, 2, 3, 0, time.UTC),
+				Level: zapcore.InfoLevel,
+			},
+		},
+		{
+			name: "json",
+			line: `{"level":"warn","ts":"2024-10-19T10:00:00Z","msg":"slow request"}`,
+
+			expected: &runtime.LogEvent{
+				Msg:    "slow request",
+				Time:   time.Date(2024, 10, 19, 10, 0, 0, 0, time.UTC),
+				Level:  zapcore.WarnLevel,
+				Fields: map[string]any{},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, logging.ParseRecord([]byte(test.line), now))
+		})
+	}
+}
+
+func TestReadRecordsCRI(t *testing.T) {
+	t.Parallel()
+
+	log := strings.Join([]string{
+		"2024-01-02T03:04:05.000000001Z stdout F first",
+		"2024-01-02T03:04:06Z stderr P sec",
+		"2024-01-02T03:04:06Z stderr F ond",
+		"",
+	}, "\n")
+
+	var records []*runtime.LogEvent
+
+	require.NoError(t, logging.ReadRecords(strings.NewReader(log), true, func(e *runtime.LogEvent) error {
+		records = append(records, e)
+
+		return nil
+	}))
+
+	require.Len(t, records, 2)
+
+	assert.Equal(t, "first", records[0].Msg)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 1, time.UTC), records[0].Time)
+	assert.Equal(t, "stdout", records[0].Fields["stream"])
+
+	assert.Equal(t, "second", records[1].Msg)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC), records[1].Time)
+	assert.Equal(t, "stderr", records[1].Fields["stream"])
+}
+
+func TestRecordFilter(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2024, 10, 19, 12, 0, 0, 0, time.UTC)
+	e := &runtime.LogEvent{
+		Msg:   "connection refused",
+		Time:  ts,
+		Level: zapcore.WarnLevel,
+	}
+
+	for _, test := range []struct {
+		name   string
+		filter logging.RecordFilter
+
+		expected bool
+	}{
+		{
+			name:     "empty",
+			expected: true,
+		},
+		{
+			name:     "level below",
+			filter:   logging.RecordFilter{MinLevel: zapcore.InfoLevel},
+			expected: true,
+		},
+		{
+			name:   "level above",
+			filter: logging.RecordFilter{MinLevel: zapcore.ErrorLevel},
+		},
+		{
+			name:     "pattern match",
+			filter:   logging.RecordFilter{Pattern: regexp.MustCompile("refused$")},
+			expected: true,
+		},
+		{
+			name:   "pattern mismatch",
+			filter: logging.RecordFilter{Pattern: regexp.MustCompile("timeout")},
+		},
+		{
+			name:     "in range",
+			filter:   logging.RecordFilter{Since: ts, Until: ts.Add(time.Second)},
+			expected: true,
+		},
+		{
+			name:   "before since",
+			filter: logging.RecordFilter{Since: ts.Add(time.Second)},
+		},
+		{
+			name:   "at until",
+			filter: logging.RecordFilter{Until: ts},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.filter.Match(e))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// NewSender returns the queued log sender for the logging destination, which sends the logs of the source
+// (one of constants.LoggingSource*).
+//
+// The logs which couldn't be delivered are spooled to the EPHEMERAL partition if the spool is enabled for the destination.
+func NewSender(cfg config.LoggingDestination, source string) (runtime.LogSender, error) {
+	var sender runtime.LogSender
+
+	switch f := cfg.Format(); f {
+	case constants.LoggingFormatJSONLines:
+		sender = NewJSONLines(cfg)
+	case constants.LoggingFormatSyslog:
+		sender = NewSyslog(cfg)
+	case constants.LoggingFormatLoki:
+		sender = NewLoki(cfg)
+	default:
+		return nil, fmt.Errorf("unsupported log destination format %q", f)
+	}
+
+	return NewQueuedSender(sender, QueueOptions{
+		SpoolPath:    SpoolPath(cfg, source),
+		SpoolMaxSize: cfg.SpoolMaxSize(),
+		SpoolReady:   ephemeralMounted,
+	}), nil
+}
+
+// SpoolPath returns the path of the spool for the logging destination and the source.
+func SpoolPath(cfg config.LoggingDestination, source string) string {
+	hash := sha256.Sum256([]byte(cfg.Format() + "\x00" + cfg.Endpoint().String()))
+
+	return filepath.Join(constants.LogSpoolDir, fmt.Sprintf("%s-%s.spool", source, hex.EncodeToString(hash[:8])))
+}
+
+// ephemeralMounted returns true if the EPHEMERAL partition is mounted, so that the spool is persisted on disk.
+func ephemeralMounted() bool {
+	var root, ephemeral unix.Stat_t
+
+	if err := unix.Stat("/", &root); err != nil {
+		return false
+	}
+
+	if err := unix.Stat(constants.EphemeralMountPoint, &ephemeral); err != nil {
+		return false
+	}
+
+	return root.Dev != ephemeral.Dev
+}
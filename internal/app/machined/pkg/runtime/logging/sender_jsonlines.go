@@ -8,8 +8,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net"
-	"net/url"
 	"time"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
@@ -17,40 +15,22 @@ import (
 )
 
 type jsonLinesSender struct {
-	endpoint  *url.URL
+	conn      *streamConn
 	extraTags map[string]string
-
-	sema chan struct{}
-	conn net.Conn
 }
 
-// NewJSONLines returns log sender that sends logs in JSON over TCP (newline-delimited)
+// NewJSONLines returns log sender that sends logs in JSON over TCP or TLS (newline-delimited)
 // or UDP (one message per packet).
 func NewJSONLines(cfg config.LoggingDestination) runtime.LogSender {
-	sema := make(chan struct{}, 1)
-	sema <- struct{}{}
-
 	return &jsonLinesSender{
-		endpoint:  cfg.Endpoint(),
+		conn:      newStreamConn(cfg),
 		extraTags: cfg.ExtraTags(),
-
-		sema: sema,
 	}
 }
 
-func (j *jsonLinesSender) tryLock(ctx context.Context) (unlock func()) {
-	select {
-	case <-j.sema:
-		unlock = func() { j.sema <- struct{}{} }
-	case <-ctx.Done():
-		unlock = nil
-	}
-
-	return
-}
-
-func (j *jsonLinesSender) marshalJSON(e *runtime.LogEvent) ([]byte, error) {
-	m := make(map[string]any, len(e.Fields)+3)
+// marshalJSON encodes the log event as a JSON object with the message, time and level, and the extra tags.
+func marshalJSON(e *runtime.LogEvent, extraTags map[string]string) ([]byte, error) {
+	m := make(map[string]any, len(e.Fields)+len(extraTags)+3)
 	for k, v := range e.Fields {
 		m[k] = v
 	}
@@ -59,7 +39,7 @@ func (j *jsonLinesSender) marshalJSON(e *runtime.LogEvent) ([]byte, error) {
 	m["talos-time"] = e.Time.Format(time.RFC3339Nano)
 	m["talos-level"] = e.Level.String()
 
-	for k, v := range j.extraTags {
+	for k, v := range extraTags {
 		m[k] = v
 	}
 
@@ -68,77 +48,19 @@ func (j *jsonLinesSender) marshalJSON(e *runtime.LogEvent) ([]byte, error) {
 
 // Send implements LogSender interface.
 func (j *jsonLinesSender) Send(ctx context.Context, e *runtime.LogEvent) error {
-	b, err := j.marshalJSON(e)
+	b, err := marshalJSON(e, j.extraTags)
 	if err != nil {
 		return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
 	}
 
-	if j.endpoint.Scheme == "tcp" {
+	if !j.conn.datagram() {
 		b = append(b, '\n')
 	}
 
-	unlock := j.tryLock(ctx)
-	if unlock == nil {
-		return ctx.Err()
-	}
-
-	defer unlock()
-
-	// Connect (or "connect" for UDP) if no connection is established already.
-	if j.conn == nil {
-		conn, err := new(net.Dialer).DialContext(ctx, j.endpoint.Scheme, j.endpoint.Host)
-		if err != nil {
-			return err
-		}
-
-		j.conn = conn
-	}
-
-	d, _ := ctx.Deadline()
-	j.conn.SetWriteDeadline(d) //nolint:errcheck
-
-	// Close connection on send error.
-	if n, err := j.conn.Write(b); err != nil {
-		j.conn.Close() //nolint:errcheck
-		j.conn = nil
-
-		// skip partially sent events to avoid partial duplicates in the receiver
-		if n > 0 {
-			err = fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
-		}
-
-		return err
-	}
-
-	return nil
+	return j.conn.write(ctx, b)
 }
 
 // Close implements LogSender interface.
 func (j *jsonLinesSender) Close(ctx context.Context) error {
-	unlock := j.tryLock(ctx)
-	if unlock == nil {
-		return ctx.Err()
-	}
-
-	defer unlock()
-
-	if j.conn == nil {
-		return nil
-	}
-
-	conn := j.conn
-	j.conn = nil
-
-	closed := make(chan error, 1)
-
-	go func() {
-		closed <- conn.Close()
-	}()
-
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case err := <-closed:
-		return err
-	}
+	return j.conn.close(ctx)
 }
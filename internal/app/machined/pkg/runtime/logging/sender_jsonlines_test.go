@@ -101,8 +101,10 @@ func tcpHandler(ctx context.Context, t *testing.T, conn net.Listener, sendCh cha
 }
 
 type loggingDestination struct {
-	endpoint  *url.URL
-	extraTags map[string]string
+	format       string
+	endpoint     *url.URL
+	extraTags    map[string]string
+	spoolMaxSize uint64
 }
 
 func (l *loggingDestination) Endpoint() *url.URL {
@@ -114,7 +116,27 @@ func (l *loggingDestination) ExtraTags() map[string]string {
 }
 
 func (l *loggingDestination) Format() string {
-	return constants.LoggingFormatJSONLines
+	if l.format == "" {
+		return constants.LoggingFormatJSONLines
+	}
+
+	return l.format
+}
+
+func (l *loggingDestination) Sources() []string {
+	return []string{constants.LoggingSourceService}
+}
+
+func (l *loggingDestination) TLSCA() []byte {
+	return nil
+}
+
+func (l *loggingDestination) TLSInsecureSkipVerify() bool {
+	return false
+}
+
+func (l *loggingDestination) SpoolMaxSize() uint64 {
+	return l.spoolMaxSize
 }
 
 func TestSenderJSONLines(t *testing.T) { //nolint:tparallel
@@ -0,0 +1,158 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// lokiLabelFields maps the log event fields to the Loki stream labels.
+//
+// Only the fields with the low cardinality are used as labels, the rest are sent as part of the log line.
+var lokiLabelFields = map[string]string{
+	"talos-service": "service",
+	"facility":      "facility",
+	"namespace":     "namespace",
+	"pod":           "pod",
+	"container":     "container",
+	"stream":        "stream",
+}
+
+type lokiSender struct {
+	endpoint  *url.URL
+	extraTags map[string]string
+
+	client *http.Client
+}
+
+// NewLoki returns log sender that sends logs to the Loki push API over HTTP or HTTPS.
+//
+// The extra tags are used as the stream labels, and the log line is the log event encoded as JSON.
+func NewLoki(cfg config.LoggingDestination) runtime.LogSender {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if cfg.Endpoint().Scheme == "https" {
+		transport.TLSClientConfig = newTLSConfig(cfg)
+	}
+
+	return &lokiSender{
+		endpoint:  cfg.Endpoint(),
+		extraTags: cfg.ExtraTags(),
+
+		client: &http.Client{
+			Transport: transport,
+		},
+	}
+}
+
+type lokiPushRequest struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// lokiLabels returns the stream labels for the log event.
+func lokiLabels(e *runtime.LogEvent, extraTags map[string]string) map[string]string {
+	labels := map[string]string{
+		"level": e.Level.String(),
+	}
+
+	for field, label := range lokiLabelFields {
+		if v, ok := e.Fields[field]; ok {
+			labels[label] = fmt.Sprint(v)
+		}
+	}
+
+	for k, v := range extraTags {
+		labels[lokiLabelName(k)] = v
+	}
+
+	return labels
+}
+
+// lokiLabelName converts the string to the valid Loki label name.
+func lokiLabelName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+
+		return '_'
+	}, s)
+
+	if s == "" || (s[0] >= '0' && s[0] <= '9') {
+		s = "_" + s
+	}
+
+	return s
+}
+
+// Send implements LogSender interface.
+func (l *lokiSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	line, err := marshalJSON(e, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+	}
+
+	body, err := json.Marshal(lokiPushRequest{
+		Streams: []lokiStream{
+			{
+				Stream: lokiLabels(e, l.extraTags),
+				Values: [][2]string{{strconv.FormatInt(e.Time.UnixNano(), 10), string(line)}},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, l.endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	// drain the body to reuse the connection
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024)) //nolint:errcheck
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= 500:
+		return fmt.Errorf("unexpected Loki response status: %s", resp.Status)
+	default:
+		// the request is rejected (e.g. the entry is too old), so it won't be accepted on retry either
+		return fmt.Errorf("%w: unexpected Loki response status: %s", runtime.ErrDontRetry, resp.Status)
+	}
+}
+
+// Close implements LogSender interface.
+func (l *lokiSender) Close(context.Context) error {
+	l.client.CloseIdleConnections()
+
+	return nil
+}
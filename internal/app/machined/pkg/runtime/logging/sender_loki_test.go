@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+type lokiPush struct {
+	Streams []struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	} `json:"streams"`
+}
+
+func TestSenderLoki(t *testing.T) {
+	t.Parallel()
+
+	var status atomic.Int32
+
+	status.Store(http.StatusNoContent)
+
+	pushCh := make(chan lokiPush, 8)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/loki/api/v1/push", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var push lokiPush
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&push))
+
+		pushCh <- push
+
+		w.WriteHeader(int(status.Load()))
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	sender := logging.NewLoki(&loggingDestination{
+		format:    constants.LoggingFormatLoki,
+		endpoint:  ensure.Value(url.Parse(srv.URL + "/loki/api/v1/push")),
+		extraTags: map[string]string{"cluster-name": "test"},
+	})
+
+	e := &runtime.LogEvent{
+		Msg:   "hello",
+		Time:  time.Unix(1609459200, 123),
+		Level: zapcore.InfoLevel,
+		Fields: map[string]any{
+			"talos-service": "apid",
+			"field1":        "value1",
+		},
+	}
+
+	require.NoError(t, sender.Send(ctx, e))
+
+	push := <-pushCh
+	require.Len(t, push.Streams, 1)
+
+	assert.Equal(t, map[string]string{
+		"cluster_name": "test",
+		"level":        "info",
+		"service":      "apid",
+	}, push.Streams[0].Stream)
+
+	require.Len(t, push.Streams[0].Values, 1)
+	assert.Equal(t, "1609459200000000123", push.Streams[0].Values[0][0])
+
+	var line map[string]any
+
+	require.NoError(t, json.Unmarshal([]byte(push.Streams[0].Values[0][1]), &line))
+	assert.Equal(t, map[string]any{
+		"field1":        "value1",
+		"msg":           "hello",
+		"talos-level":   "info",
+		"talos-service": "apid",
+		"talos-time":    e.Time.Format(time.RFC3339Nano),
+	}, line)
+
+	// server errors are retried
+	status.Store(http.StatusServiceUnavailable)
+
+	err := sender.Send(ctx, e)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, runtime.ErrDontRetry)
+
+	<-pushCh
+
+	// rejected requests are not
+	status.Store(http.StatusBadRequest)
+
+	err = sender.Send(ctx, e)
+	require.ErrorIs(t, err, runtime.ErrDontRetry)
+
+	<-pushCh
+
+	require.NoError(t, sender.Close(ctx))
+}
@@ -0,0 +1,308 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/siderolabs/go-debug"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+const (
+	queueSize          = 1024
+	queueSendTimeout   = 5 * time.Second
+	queueRetryInterval = time.Second
+)
+
+// QueueOptions configures the queued sender.
+type QueueOptions struct {
+	// SpoolPath is the path of the on-disk spool of the events which couldn't be delivered.
+	SpoolPath string
+	// SpoolMaxSize is the maximum size of the spool, the spool is disabled if zero.
+	SpoolMaxSize uint64
+	// SpoolReady reports whether the spool can be opened (e.g. the filesystem is mounted).
+	//
+	// The spool is not used until SpoolReady returns true, if set.
+	SpoolReady func() bool
+}
+
+type queuedSender struct {
+	sender runtime.LogSender
+	opts   QueueOptions
+
+	queue   chan *runtime.LogEvent
+	closing chan struct{}
+	done    chan struct{}
+
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+
+	// accessed only by the delivery goroutine
+	spool   *spool
+	backlog []*runtime.LogEvent
+}
+
+// NewQueuedSender wraps the sender with the in-memory queue and optionally the on-disk spool.
+//
+// The events are delivered in order by the background goroutine, and the delivery is retried on errors.
+// Send returns once the event is queued, and blocks if the queue is full and the spool is full or disabled,
+// which applies the backpressure to the log producer.
+func NewQueuedSender(sender runtime.LogSender, opts QueueOptions) runtime.LogSender {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &queuedSender{
+		sender: sender,
+		opts:   opts,
+
+		queue:   make(chan *runtime.LogEvent, queueSize),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	go s.run()
+
+	return s
+}
+
+// Send implements LogSender interface.
+func (s *queuedSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	select {
+	case <-s.closing:
+		return fmt.Errorf("%w: sender is closed", runtime.ErrDontRetry)
+	default:
+	}
+
+	select {
+	case s.queue <- e:
+		return nil
+	case <-s.closing:
+		return fmt.Errorf("%w: sender is closed", runtime.ErrDontRetry)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close implements LogSender interface.
+//
+// Close waits for the queued events to be delivered, and on context cancelation
+// persists the events which weren't delivered to the spool (if enabled).
+func (s *queuedSender) Close(ctx context.Context) error {
+	select {
+	case <-s.closing:
+	default:
+		close(s.closing)
+	}
+
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+		s.cancel()
+
+		<-s.done
+	}
+
+	s.cancel()
+
+	return s.sender.Close(ctx)
+}
+
+// spoolAvailable opens the spool lazily, and returns true if the spool can be used.
+func (s *queuedSender) spoolAvailable() bool {
+	if s.spool != nil {
+		return true
+	}
+
+	if s.opts.SpoolMaxSize == 0 || (s.opts.SpoolReady != nil && !s.opts.SpoolReady()) {
+		return false
+	}
+
+	sp, err := openSpool(s.opts.SpoolPath, int64(s.opts.SpoolMaxSize))
+	if err != nil {
+		s.logf("error opening log spool: %s", err)
+
+		return false
+	}
+
+	s.spool = sp
+
+	return true
+}
+
+func (s *queuedSender) logf(format string, args ...any) {
+	if debug.Enabled {
+		log.Printf(format, args...)
+	}
+}
+
+// eventSource is the source of the event being delivered.
+type eventSource int
+
+const (
+	fromQueue eventSource = iota
+	fromBacklog
+	fromSpool
+)
+
+// next returns the next event to deliver: the oldest spooled event, the oldest event of the backlog,
+// or the next queued event.
+//
+// If the sender is closing, next returns nil once the queue is drained.
+func (s *queuedSender) next() (*runtime.LogEvent, eventSource) {
+	if s.spoolAvailable() && !s.spool.empty() {
+		e, err := s.spool.peek()
+		if err != nil {
+			s.logf("error reading log spool: %s", err)
+		}
+
+		if e != nil {
+			return e, fromSpool
+		}
+	}
+
+	if len(s.backlog) > 0 {
+		return s.backlog[0], fromBacklog
+	}
+
+	// prefer the queued events over closing, so that the queue is drained
+	select {
+	case e := <-s.queue:
+		return e, fromQueue
+	default:
+	}
+
+	select {
+	case e := <-s.queue:
+		return e, fromQueue
+	case <-s.closing:
+		return nil, fromQueue
+	case <-s.ctx.Done():
+		return nil, fromQueue
+	}
+}
+
+// run delivers the events until the sender is closed.
+func (s *queuedSender) run() {
+	defer close(s.done)
+	defer s.shutdown()
+
+	for {
+		e, source := s.next()
+		if e == nil {
+			return
+		}
+
+		if err := s.send(e); err == nil || errors.Is(err, runtime.ErrDontRetry) {
+			switch source {
+			case fromSpool:
+				if err = s.spool.pop(); err != nil {
+					s.logf("error updating log spool: %s", err)
+				}
+			case fromBacklog:
+				s.backlog = s.backlog[1:]
+			case fromQueue:
+			}
+
+			continue
+		}
+
+		// the spooled events are older than the backlog, and the backlog events are older than the queued events,
+		// so moving the event to the spool preserves the order
+		switch source {
+		case fromSpool:
+			// the event stays in the spool
+		case fromBacklog:
+			if s.spoolAvailable() && s.spool.push(e) == nil {
+				s.backlog = s.backlog[1:]
+			}
+		case fromQueue:
+			if !s.spoolAvailable() || s.spool.push(e) != nil {
+				s.backlog = append(s.backlog, e)
+			}
+		}
+
+		if !s.wait() {
+			return
+		}
+	}
+}
+
+// wait waits before the next delivery attempt, moving the queued events to the spool meanwhile
+// to keep the queue from filling up.
+func (s *queuedSender) wait() bool {
+	timer := time.NewTimer(queueRetryInterval)
+	defer timer.Stop()
+
+	var queue <-chan *runtime.LogEvent
+
+	if s.spool != nil && len(s.backlog) == 0 {
+		queue = s.queue
+	}
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return false
+		case <-timer.C:
+			return true
+		case e := <-queue:
+			if err := s.spool.push(e); err != nil {
+				// the spool is full, keep the event in memory, and stop draining the queue
+				s.backlog = append(s.backlog, e)
+				queue = nil
+			}
+		}
+	}
+}
+
+func (s *queuedSender) send(e *runtime.LogEvent) error {
+	ctx, cancel := context.WithTimeout(s.ctx, queueSendTimeout)
+	defer cancel()
+
+	err := s.sender.Send(ctx, e)
+	if err != nil {
+		s.logf("error sending log event: %s", err)
+	}
+
+	return err
+}
+
+// shutdown persists the events which weren't delivered to the spool, and closes the spool.
+func (s *queuedSender) shutdown() {
+	if !s.spoolAvailable() {
+		return
+	}
+
+	for _, e := range s.backlog {
+		if err := s.spool.push(e); err != nil {
+			s.logf("error spooling log event: %s", err)
+		}
+	}
+
+	s.backlog = nil
+
+	for drained := false; !drained; {
+		select {
+		case e := <-s.queue:
+			if err := s.spool.push(e); err != nil {
+				s.logf("error spooling log event: %s", err)
+			}
+		default:
+			drained = true
+		}
+	}
+
+	if err := s.spool.close(); err != nil {
+		s.logf("error closing log spool: %s", err)
+	}
+}
@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+// mockSender delivers the events only when it is available.
+type mockSender struct {
+	mu        sync.Mutex
+	available bool
+	delivered []string
+}
+
+func (s *mockSender) setAvailable(available bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.available = available
+}
+
+func (s *mockSender) getDelivered() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.delivered...)
+}
+
+func (s *mockSender) Send(_ context.Context, e *runtime.LogEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.available {
+		return errors.New("unavailable")
+	}
+
+	s.delivered = append(s.delivered, e.Msg)
+
+	return nil
+}
+
+func (s *mockSender) Close(context.Context) error {
+	return nil
+}
+
+func messages(from, to int) []string {
+	var res []string
+
+	for i := from; i < to; i++ {
+		res = append(res, "msg"+strconv.Itoa(i))
+	}
+
+	return res
+}
+
+func sendMessages(ctx context.Context, t *testing.T, sender runtime.LogSender, msgs []string) {
+	t.Helper()
+
+	for _, msg := range msgs {
+		require.NoError(t, sender.Send(ctx, &runtime.LogEvent{Msg: msg, Time: time.Now()}))
+	}
+}
+
+func TestQueuedSender(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	mock := &mockSender{available: true}
+	sender := logging.NewQueuedSender(mock, logging.QueueOptions{})
+
+	sendMessages(ctx, t, sender, messages(0, 10))
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, messages(0, 10), mock.getDelivered())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// destination is down, events are retried in order
+	mock.setAvailable(false)
+
+	sendMessages(ctx, t, sender, messages(10, 20))
+
+	time.Sleep(100 * time.Millisecond)
+
+	mock.setAvailable(true)
+
+	require.NoError(t, sender.Close(ctx))
+
+	assert.Equal(t, messages(0, 20), mock.getDelivered())
+
+	// sender is closed
+	require.ErrorIs(t, sender.Send(ctx, &runtime.LogEvent{Msg: "closed"}), runtime.ErrDontRetry)
+}
+
+func TestQueuedSenderSpool(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	opts := logging.QueueOptions{
+		SpoolPath:    filepath.Join(t.TempDir(), "spool", "test.spool"),
+		SpoolMaxSize: 1024 * 1024,
+	}
+
+	mock := &mockSender{}
+	sender := logging.NewQueuedSender(mock, opts)
+
+	// queue size is 1024, so the events must be moved to the spool to be accepted
+	sendMessages(ctx, t, sender, messages(0, 2000))
+
+	// the events are persisted on close
+	closeCtx, closeCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer closeCancel()
+
+	sender.Close(closeCtx) //nolint:errcheck
+
+	assert.Empty(t, mock.getDelivered())
+
+	st, err := os.Stat(opts.SpoolPath)
+	require.NoError(t, err)
+	assert.Positive(t, st.Size())
+
+	// spooled events are delivered first once the destination is available
+	mock.setAvailable(true)
+
+	sender = logging.NewQueuedSender(mock, opts)
+
+	sendMessages(ctx, t, sender, messages(2000, 2010))
+
+	require.NoError(t, sender.Close(ctx))
+
+	assert.Equal(t, messages(0, 2010), mock.getDelivered())
+
+	// the spool is truncated once drained
+	st, err = os.Stat(opts.SpoolPath)
+	require.NoError(t, err)
+	assert.Zero(t, st.Size())
+}
+
+func TestQueuedSenderSpoolFull(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	mock := &mockSender{}
+	sender := logging.NewQueuedSender(mock, logging.QueueOptions{
+		SpoolPath:    filepath.Join(t.TempDir(), "test.spool"),
+		SpoolMaxSize: 1024,
+	})
+
+	// the spool fits only a few events, so the queue is full
+	sendCtx, sendCancel := context.WithTimeout(ctx, 3*time.Second)
+	defer sendCancel()
+
+	var (
+		sent int
+		err  error
+	)
+
+	for ; err == nil; sent++ {
+		err = sender.Send(sendCtx, &runtime.LogEvent{Msg: "msg" + strconv.Itoa(sent), Time: time.Now()})
+	}
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	sent--
+
+	assert.Less(t, sent, 1100)
+
+	// once the destination is available, all accepted events are delivered in order
+	mock.setAvailable(true)
+
+	require.NoError(t, sender.Close(ctx))
+
+	assert.Equal(t, messages(0, sent), mock.getDelivered())
+}
@@ -0,0 +1,229 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// syslogSDID is the structured data ID for the log event fields.
+//
+// 32473 is the private enterprise number reserved for the documentation (RFC5612).
+const syslogSDID = "talos@32473"
+
+// syslogFacilities maps the facility names (as reported for the kernel messages) to the syslog facility codes.
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// syslogSeverities maps the kernel message priorities to the syslog severities.
+var syslogSeverities = map[string]int{
+	"emerg":   0,
+	"alert":   1,
+	"crit":    2,
+	"err":     3,
+	"warning": 4,
+	"notice":  5,
+	"info":    6,
+	"debug":   7,
+}
+
+type syslogSender struct {
+	conn      *streamConn
+	extraTags map[string]string
+}
+
+// NewSyslog returns log sender that sends logs in RFC5424 syslog format over TCP or TLS (octet-counted framing)
+// or UDP (one message per packet).
+func NewSyslog(cfg config.LoggingDestination) runtime.LogSender {
+	return &syslogSender{
+		conn:      newStreamConn(cfg),
+		extraTags: cfg.ExtraTags(),
+	}
+}
+
+// Send implements LogSender interface.
+func (s *syslogSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	b := []byte(formatSyslog(e, s.extraTags))
+
+	if !s.conn.datagram() {
+		b = append([]byte(strconv.Itoa(len(b))+" "), b...)
+	}
+
+	return s.conn.write(ctx, b)
+}
+
+// Close implements LogSender interface.
+func (s *syslogSender) Close(ctx context.Context) error {
+	return s.conn.close(ctx)
+}
+
+// formatSyslog formats the log event as RFC5424 syslog message.
+//
+// The event fields and the extra tags are sent as the structured data.
+func formatSyslog(e *runtime.LogEvent, extraTags map[string]string) string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "<%d>1 %s %s %s - - ",
+		syslogFacility(e)*8+syslogSeverity(e),
+		e.Time.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		syslogName(hostname, 255),
+		syslogName(syslogAppName(e), 48),
+	)
+
+	params := make(map[string]string, len(e.Fields)+len(extraTags))
+
+	for k, v := range e.Fields {
+		params[k] = fmt.Sprint(v)
+	}
+
+	maps.Copy(params, extraTags)
+
+	if len(params) == 0 {
+		sb.WriteString("-")
+	} else {
+		sb.WriteString("[" + syslogSDID)
+
+		for _, k := range slices.Sorted(maps.Keys(params)) {
+			fmt.Fprintf(&sb, " %s=\"%s\"", syslogParamName(k), syslogParamValue(params[k]))
+		}
+
+		sb.WriteString("]")
+	}
+
+	if e.Msg != "" {
+		sb.WriteString(" " + e.Msg)
+	}
+
+	return sb.String()
+}
+
+func syslogFacility(e *runtime.LogEvent) int {
+	if name, ok := e.Fields["facility"].(string); ok {
+		if facility, ok := syslogFacilities[name]; ok {
+			return facility
+		}
+	}
+
+	return syslogFacilities["daemon"]
+}
+
+func syslogSeverity(e *runtime.LogEvent) int {
+	if name, ok := e.Fields["priority"].(string); ok {
+		if severity, ok := syslogSeverities[name]; ok {
+			return severity
+		}
+	}
+
+	switch e.Level {
+	case zapcore.DebugLevel:
+		return syslogSeverities["debug"]
+	case zapcore.InfoLevel:
+		return syslogSeverities["info"]
+	case zapcore.WarnLevel:
+		return syslogSeverities["warning"]
+	case zapcore.ErrorLevel:
+		return syslogSeverities["err"]
+	case zapcore.DPanicLevel:
+		return syslogSeverities["crit"]
+	case zapcore.PanicLevel:
+		return syslogSeverities["alert"]
+	case zapcore.FatalLevel:
+		return syslogSeverities["emerg"]
+	default:
+		return syslogSeverities["info"]
+	}
+}
+
+// syslogAppName returns the name of the service, the container or "kernel" for the kernel messages.
+func syslogAppName(e *runtime.LogEvent) string {
+	for _, k := range []string{"talos-service", "container"} {
+		if name, ok := e.Fields[k].(string); ok && name != "" {
+			return name
+		}
+	}
+
+	if _, ok := e.Fields["facility"]; ok {
+		return "kernel"
+	}
+
+	return "talos"
+}
+
+// syslogName converts the string to the syslog header field (printable ASCII without spaces).
+func syslogName(s string, maxLen int) string {
+	s = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return '_'
+		}
+
+		return r
+	}, s)
+
+	if len(s) > maxLen {
+		s = s[:maxLen]
+	}
+
+	return s
+}
+
+// syslogParamName converts the string to the structured data parameter name.
+func syslogParamName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' || r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+
+		return r
+	}, s)
+
+	if len(s) > 32 {
+		s = s[:32]
+	}
+
+	return s
+}
+
+var syslogParamValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// syslogParamValue escapes the structured data parameter value.
+func syslogParamValue(s string) string {
+	return syslogParamValueReplacer.Replace(s)
+}
@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+func TestSenderSyslog(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, lis.Close())
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	msgCh := make(chan string, 8)
+
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close() //nolint:errcheck
+
+		r := bufio.NewReader(conn)
+
+		for {
+			// octet-counted framing: "<length> <message>"
+			var length int
+
+			if _, err := fmt.Fscanf(r, "%d ", &length); err != nil {
+				return
+			}
+
+			buf := make([]byte, length)
+
+			if _, err := io.ReadFull(r, buf); err != nil {
+				return
+			}
+
+			msgCh <- string(buf)
+		}
+	}()
+
+	sender := logging.NewSyslog(&loggingDestination{
+		format:    constants.LoggingFormatSyslog,
+		endpoint:  ensure.Value(url.Parse("tcp://" + lis.Addr().String())),
+		extraTags: map[string]string{"cluster": "test"},
+	})
+
+	ts := ensure.Value(time.Parse(time.RFC3339Nano, "2021-01-01T00:00:00.123456Z"))
+
+	for _, e := range []*runtime.LogEvent{
+		{
+			Msg:   "service started",
+			Time:  ts,
+			Level: zapcore.WarnLevel,
+			Fields: map[string]any{
+				"talos-service": "apid",
+				"quote":         `a "quoted" value]`,
+			},
+		},
+		{
+			Msg:   "eth0: link up",
+			Time:  ts,
+			Level: zapcore.InfoLevel,
+			Fields: map[string]any{
+				"facility": "kern",
+				"priority": "notice",
+			},
+		},
+	} {
+		require.NoError(t, sender.Send(ctx, e))
+	}
+
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+
+	for _, expected := range []string{
+		// daemon.warning
+		`<28>1 2021-01-01T00:00:00.123456Z ` + hostname + ` apid - - [talos@32473 cluster="test" quote="a \"quoted\" value\]" talos-service="apid"] service started`,
+		// kern.notice
+		`<5>1 2021-01-01T00:00:00.123456Z ` + hostname + ` kernel - - [talos@32473 cluster="test" facility="kern" priority="notice"] eth0: link up`,
+	} {
+		select {
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for message")
+		case msg := <-msgCh:
+			assert.Equal(t, expected, msg)
+		}
+	}
+
+	require.NoError(t, sender.Close(ctx))
+}
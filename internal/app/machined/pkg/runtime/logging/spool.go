@@ -0,0 +1,174 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+var errSpoolFull = errors.New("spool is full")
+
+// spool is an on-disk FIFO queue of the log events.
+//
+// The events are stored as JSON lines appended to the file, the file is truncated
+// once all events are consumed.
+// The consumed events are dropped from the file when the spool is closed,
+// so the events might be delivered again only if the spool wasn't closed properly.
+type spool struct {
+	f       *os.File
+	maxSize int64
+
+	// offset of the first event which wasn't consumed yet
+	readOffset int64
+	size       int64
+
+	head    *runtime.LogEvent
+	headLen int64
+}
+
+// openSpool opens the existing spool file or creates a new one.
+func openSpool(path string, maxSize int64) (*spool, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("error creating spool directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening spool: %w", err)
+	}
+
+	st, err := f.Stat()
+	if err != nil {
+		f.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error opening spool: %w", err)
+	}
+
+	return &spool{
+		f:       f,
+		maxSize: maxSize,
+		size:    st.Size(),
+	}, nil
+}
+
+// empty returns true if there are no events in the spool.
+func (s *spool) empty() bool {
+	return s.readOffset >= s.size
+}
+
+// push appends the event to the spool.
+func (s *spool) push(e *runtime.LogEvent) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	b = append(b, '\n')
+
+	if s.size+int64(len(b)) > s.maxSize {
+		return errSpoolFull
+	}
+
+	n, err := s.f.WriteAt(b, s.size)
+	if err != nil {
+		// drop the partially written event
+		s.f.Truncate(s.size) //nolint:errcheck
+
+		return err
+	}
+
+	s.size += int64(n)
+
+	return nil
+}
+
+// peek returns the first event in the spool without removing it, or nil if the spool is empty.
+func (s *spool) peek() (*runtime.LogEvent, error) {
+	for s.head == nil {
+		if s.empty() {
+			return nil, nil
+		}
+
+		line, err := bufio.NewReader(io.NewSectionReader(s.f, s.readOffset, s.size-s.readOffset)).ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+
+		var e runtime.LogEvent
+
+		if err = json.Unmarshal(line, &e); err != nil {
+			// skip the corrupted event (e.g. partially written before the crash)
+			if err = s.consume(int64(len(line))); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		s.head = &e
+		s.headLen = int64(len(line))
+	}
+
+	return s.head, nil
+}
+
+// pop removes the first event returned by peek.
+func (s *spool) pop() error {
+	if s.head == nil {
+		return nil
+	}
+
+	s.head = nil
+
+	return s.consume(s.headLen)
+}
+
+func (s *spool) consume(n int64) error {
+	s.readOffset += n
+
+	if !s.empty() {
+		return nil
+	}
+
+	s.readOffset, s.size = 0, 0
+
+	return s.f.Truncate(0)
+}
+
+// close closes the spool file, keeping the events which weren't consumed.
+func (s *spool) close() error {
+	if s.readOffset > 0 {
+		// drop the consumed events, so that they are not delivered again
+		rest := make([]byte, s.size-s.readOffset)
+
+		if _, err := s.f.ReadAt(rest, s.readOffset); err != nil && !errors.Is(err, io.EOF) {
+			s.f.Close() //nolint:errcheck
+
+			return err
+		}
+
+		if _, err := s.f.WriteAt(rest, 0); err != nil {
+			s.f.Close() //nolint:errcheck
+
+			return err
+		}
+
+		if err := s.f.Truncate(int64(len(rest))); err != nil {
+			s.f.Close() //nolint:errcheck
+
+			return err
+		}
+	}
+
+	return s.f.Close()
+}
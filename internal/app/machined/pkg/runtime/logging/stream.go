@@ -0,0 +1,158 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// streamConn is a connection to the "tcp", "udp" or "tls" logging endpoint.
+//
+// The connection is established on the first write, and re-established after a write error.
+type streamConn struct {
+	endpoint  *url.URL
+	tlsConfig *tls.Config
+
+	sema chan struct{}
+	conn net.Conn
+}
+
+func newStreamConn(cfg config.LoggingDestination) *streamConn {
+	sema := make(chan struct{}, 1)
+	sema <- struct{}{}
+
+	c := &streamConn{
+		endpoint: cfg.Endpoint(),
+
+		sema: sema,
+	}
+
+	if c.endpoint.Scheme == "tls" {
+		c.tlsConfig = newTLSConfig(cfg)
+	}
+
+	return c
+}
+
+// newTLSConfig builds the TLS client configuration for the logging destination.
+func newTLSConfig(cfg config.LoggingDestination) *tls.Config {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: cfg.TLSInsecureSkipVerify(), //nolint:gosec
+	}
+
+	if ca := cfg.TLSCA(); len(ca) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		// the certificate is validated in the machine configuration
+		pool.AppendCertsFromPEM(ca)
+
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig
+}
+
+// datagram returns true if each message is sent as a separate packet.
+func (c *streamConn) datagram() bool {
+	return c.endpoint.Scheme == "udp"
+}
+
+func (c *streamConn) tryLock(ctx context.Context) (unlock func()) {
+	select {
+	case <-c.sema:
+		unlock = func() { c.sema <- struct{}{} }
+	case <-ctx.Done():
+		unlock = nil
+	}
+
+	return
+}
+
+func (c *streamConn) dial(ctx context.Context) (net.Conn, error) {
+	if c.tlsConfig != nil {
+		return (&tls.Dialer{Config: c.tlsConfig}).DialContext(ctx, "tcp", c.endpoint.Host)
+	}
+
+	return new(net.Dialer).DialContext(ctx, c.endpoint.Scheme, c.endpoint.Host)
+}
+
+// write sends the framed message.
+func (c *streamConn) write(ctx context.Context, b []byte) error {
+	unlock := c.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	// Connect (or "connect" for UDP) if no connection is established already.
+	if c.conn == nil {
+		conn, err := c.dial(ctx)
+		if err != nil {
+			return err
+		}
+
+		c.conn = conn
+	}
+
+	d, _ := ctx.Deadline()
+	c.conn.SetWriteDeadline(d) //nolint:errcheck
+
+	// Close connection on send error.
+	if n, err := c.conn.Write(b); err != nil {
+		c.conn.Close() //nolint:errcheck
+		c.conn = nil
+
+		// skip partially sent events to avoid partial duplicates in the receiver
+		if n > 0 {
+			err = fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// close closes the connection if it is established.
+func (c *streamConn) close(ctx context.Context) error {
+	unlock := c.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+
+	conn := c.conn
+	c.conn = nil
+
+	closed := make(chan error, 1)
+
+	go func() {
+		closed <- conn.Close()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-closed:
+		return err
+	}
+}
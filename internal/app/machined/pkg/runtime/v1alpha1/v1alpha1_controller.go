@@ -12,6 +12,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/acpi"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha2"
+	"github.com/siderolabs/talos/internal/pkg/metrics"
 	krnl "github.com/siderolabs/talos/pkg/kernel"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
@@ -36,6 +38,10 @@ type Controller struct {
 	v2 *v1alpha2.Controller
 
 	priorityLock *PriorityLock[runtime.Sequence]
+
+	sequenceMu     sync.Mutex
+	sequenceStatus *runtime.SequenceStatus
+	sequenceCancel context.CancelFunc
 }
 
 // NewController intializes and returns a controller.
@@ -134,6 +140,20 @@ func (c *Controller) Run(ctx context.Context, seq runtime.Sequence, data any, se
 		return err
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	actorID, _ := ctx.Value(runtime.ActorIDCtxKey{}).(string)
+
+	c.setSequenceStatus(&runtime.SequenceStatus{
+		Sequence:    seq,
+		ActorID:     actorID,
+		Started:     time.Now(),
+		TotalPhases: len(phases),
+	}, cancel)
+
+	defer c.setSequenceStatus(nil, nil)
+
 	err = c.run(ctx, seq, phases, data)
 	if err != nil {
 		code := common.Code_FATAL
@@ -157,6 +177,66 @@ func (c *Controller) Run(ctx context.Context, seq runtime.Sequence, data any, se
 	return nil
 }
 
+// RunningSequences implements the controller interface.
+func (c *Controller) RunningSequences() []runtime.SequenceStatus {
+	c.sequenceMu.Lock()
+	defer c.sequenceMu.Unlock()
+
+	if c.sequenceStatus == nil {
+		return nil
+	}
+
+	return []runtime.SequenceStatus{*c.sequenceStatus}
+}
+
+// CancelSequence implements the controller interface.
+//
+// The sequence is canceled only if it is running a cancelable phase.
+func (c *Controller) CancelSequence(seq runtime.Sequence) error {
+	c.sequenceMu.Lock()
+	defer c.sequenceMu.Unlock()
+
+	if c.sequenceStatus == nil || c.sequenceStatus.Sequence != seq {
+		return runtime.ErrSequenceNotRunning
+	}
+
+	if !c.sequenceStatus.Cancelable {
+		return runtime.ErrSequenceNotCancelable
+	}
+
+	c.sequenceCancel()
+
+	return nil
+}
+
+func (c *Controller) setSequenceStatus(status *runtime.SequenceStatus, cancel context.CancelFunc) {
+	c.sequenceMu.Lock()
+	defer c.sequenceMu.Unlock()
+
+	c.sequenceStatus, c.sequenceCancel = status, cancel
+}
+
+// setSequencePhase records the phase being run.
+//
+// The check for the context cancellation is done under the lock, so that the sequence
+// canceled in the previous (cancelable) phase never enters the next one.
+func (c *Controller) setSequencePhase(ctx context.Context, phase runtime.Phase, number int) error {
+	c.sequenceMu.Lock()
+	defer c.sequenceMu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if c.sequenceStatus != nil {
+		c.sequenceStatus.Phase = phase.Name
+		c.sequenceStatus.PhaseNumber = number
+		c.sequenceStatus.Cancelable = phase.Cancelable
+	}
+
+	return nil
+}
+
 // V1Alpha2 implements the controller interface.
 func (c *Controller) V1Alpha2() runtime.V1Alpha2Controller {
 	return c.v2
@@ -251,6 +331,12 @@ func (c *Controller) run(ctx context.Context, seq runtime.Sequence, phases []run
 			}
 		} else {
 			log.Printf("%s sequence: done: %s", seq.String(), time.Since(start))
+
+			metrics.ObserveSequence(seq.String(), time.Since(start))
+
+			if seq == runtime.SequenceInitialize || seq == runtime.SequenceBoot {
+				metrics.SetBootStage(seq.String(), time.Since(start))
+			}
 		}
 	}()
 
@@ -262,6 +348,10 @@ func (c *Controller) run(ctx context.Context, seq runtime.Sequence, phases []run
 		// Make the phase number human friendly.
 		number++
 
+		if err = c.setSequencePhase(ctx, phase, number); err != nil {
+			return err
+		}
+
 		start := time.Now()
 
 		progress := fmt.Sprintf("%d/%d", number, len(phases))
@@ -278,6 +368,8 @@ func (c *Controller) run(ctx context.Context, seq runtime.Sequence, phases []run
 
 		log.Printf("phase %s (%s): done, %s", phase.Name, progress, time.Since(start))
 
+		metrics.ObservePhase(seq.String(), phase.Name, time.Since(start))
+
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
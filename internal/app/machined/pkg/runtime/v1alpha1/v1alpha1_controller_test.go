@@ -227,3 +227,87 @@ func wait(seq runtime.Sequence, data any) (runtime.TaskExecutionFunc, string) {
 		return nil
 	}, "wait"
 }
+
+func TestCancelSequence(t *testing.T) {
+	tests := []struct {
+		name        string
+		cancelable  bool
+		expectError error
+	}{
+		{
+			name:       "cancelable phase",
+			cancelable: true,
+		},
+		{
+			name:        "non-cancelable phase",
+			expectError: runtime.ErrSequenceNotCancelable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			assert := assert.New(t)
+
+			t.Setenv("PLATFORM", "container")
+
+			s, err := NewState()
+			require.NoError(err)
+
+			sequencer := &mockSequencer{
+				calls:  map[runtime.Sequence]int{},
+				phases: map[runtime.Sequence]PhaseList{},
+			}
+
+			phases := PhaseList{}.Append("wait", wait)
+			if tt.cancelable {
+				phases = phases.Cancelable()
+			}
+
+			sequencer.phases[runtime.SequenceUpgrade] = phases.Append("reboot", sequencer.trackCall("reboot", nil))
+
+			l := logging.NewCircularBufferLoggingManager(log.New(os.Stdout, "machined fallback logger: ", log.Flags()))
+
+			controller := Controller{
+				r:            NewRuntime(s, NewEvents(1000, 10), l),
+				s:            sequencer,
+				priorityLock: NewPriorityLock[runtime.Sequence](),
+			}
+
+			errCh := make(chan error, 1)
+
+			go func() {
+				errCh <- controller.Run(context.Background(), runtime.SequenceUpgrade, &machine.UpgradeRequest{})
+			}()
+
+			require.Eventually(func() bool {
+				sequences := controller.RunningSequences()
+
+				return len(sequences) == 1 && sequences[0].Phase == "wait"
+			}, time.Second, time.Millisecond)
+
+			sequences := controller.RunningSequences()
+			assert.Equal(runtime.SequenceUpgrade, sequences[0].Sequence)
+			assert.Equal(1, sequences[0].PhaseNumber)
+			assert.Equal(2, sequences[0].TotalPhases)
+			assert.Equal(tt.cancelable, sequences[0].Cancelable)
+
+			require.ErrorIs(controller.CancelSequence(runtime.SequenceReboot), runtime.ErrSequenceNotRunning)
+
+			err = controller.CancelSequence(runtime.SequenceUpgrade)
+			if tt.expectError != nil {
+				require.ErrorIs(err, tt.expectError)
+				require.NoError(<-errCh)
+
+				assert.Equal(1, sequencer.calls[runtime.SequenceUpgrade])
+			} else {
+				require.NoError(err)
+				require.ErrorIs(<-errCh, context.Canceled)
+
+				assert.Equal(0, sequencer.calls[runtime.SequenceUpgrade])
+			}
+
+			assert.Empty(controller.RunningSequences())
+		})
+	}
+}
@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	"context"
+	"os"
 	"sort"
 	"sync"
 	"time"
@@ -71,7 +72,17 @@ type Events struct {
 	// gap is a safety gap between consumers and publishers
 	gap int
 
-	// mutext protects access to writePos and stream
+	// history is the event history restored from the previous boots (ordered by event ID)
+	history []runtime.Event
+
+	// persistFile is the file the published events are appended to (nil if the events are not persisted)
+	persistFile *os.File
+	// persistPath is the path to the persistFile
+	persistPath string
+	// persisted is the number of events written to the persistFile
+	persisted int
+
+	// mutext protects access to writePos, stream, history and persistence
 	mu sync.Mutex
 	c  *sync.Cond
 }
@@ -129,37 +140,63 @@ func (e *Events) Watch(f runtime.WatchFunc, opt ...runtime.WatchOptionFunc) erro
 	minPos := e.writePos - int64(e.cap-e.gap)
 	minPos = max(minPos, 0)
 
+	// history contains the past events restored from the previous boots, which are sent before the stream events
+	var history []runtime.Event
+
 	// calculate initial position based on options
 	switch {
 	case opts.TailEvents != 0:
 		if opts.TailEvents < 0 {
 			pos = minPos
+			history = e.history
 		} else {
 			pos -= int64(opts.TailEvents)
 
-			pos = max(pos, minPos)
+			if pos < minPos {
+				history = e.history[max(len(e.history)-int(minPos-pos), 0):]
+				pos = minPos
+			}
 		}
 	case !opts.TailID.IsNil():
-		pos = minPos + int64(sort.Search(int(pos-minPos), func(i int) bool {
-			event := e.stream[(minPos+int64(i))%int64(e.cap)]
-
+		pos, history = e.seek(minPos, pos, func(event runtime.Event) bool {
 			return event.ID.Compare(opts.TailID) > 0
-		}))
+		})
 	case opts.TailDuration != 0:
 		timestamp := time.Now().Add(-opts.TailDuration)
 
-		pos = minPos + int64(sort.Search(int(pos-minPos), func(i int) bool {
-			event := e.stream[(minPos+int64(i))%int64(e.cap)]
-
+		pos, history = e.seek(minPos, pos, func(event runtime.Event) bool {
 			return event.ID.Time().After(timestamp)
-		}))
+		})
+	case !opts.Since.IsZero():
+		timestamp := opts.Since.Truncate(time.Second)
+
+		pos, history = e.seek(minPos, pos, func(event runtime.Event) bool {
+			return !event.ID.Time().Before(timestamp)
+		})
 	}
 
+	backlog := int(e.writePos - pos)
+
 	e.mu.Unlock()
 
 	go func() {
 		defer close(ch)
 
+		for i, event := range history {
+			if !opts.Matches(event) {
+				continue
+			}
+
+			select {
+			case ch <- runtime.EventInfo{
+				Event:   event,
+				Backlog: len(history) - i - 1 + backlog,
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+
 		for {
 			e.mu.Lock()
 			// while there's no data to consume (pos == e.writePos), wait for Condition variable signal,
@@ -190,8 +227,8 @@ func (e *Events) Watch(f runtime.WatchFunc, opt ...runtime.WatchOptionFunc) erro
 
 			e.mu.Unlock()
 
-			// if actor id filter is specified and does not match the event, skip it
-			if opts.ActorID != "" && event.ActorID != opts.ActorID {
+			// if the event doesn't match the filters, skip it
+			if !opts.Matches(event) {
 				continue
 			}
 
@@ -210,6 +247,24 @@ func (e *Events) Watch(f runtime.WatchFunc, opt ...runtime.WatchOptionFunc) erro
 	return nil
 }
 
+// seek returns the position of the first stream event matching the predicate.
+//
+// If all stream events match the predicate, the history events matching the predicate are returned as well.
+// The predicate should be monotonic: once an event matches it, all the following events should match as well.
+func (e *Events) seek(minPos, maxPos int64, pred func(runtime.Event) bool) (int64, []runtime.Event) {
+	pos := minPos + int64(sort.Search(int(maxPos-minPos), func(i int) bool {
+		return pred(e.stream[(minPos+int64(i))%int64(e.cap)])
+	}))
+
+	if pos > minPos {
+		return pos, nil
+	}
+
+	return pos, e.history[sort.Search(len(e.history), func(i int) bool {
+		return pred(e.history[i])
+	}):]
+}
+
 // Publish implements the Events interface.
 func (e *Events) Publish(ctx context.Context, msg proto.Message) {
 	actorID, ok := ctx.Value(runtime.ActorIDCtxKey{}).(string)
@@ -226,4 +281,11 @@ func (e *Events) Publish(ctx context.Context, msg proto.Message) {
 	e.writePos++
 
 	e.c.Broadcast()
+
+	if e.persistFile != nil {
+		if err := e.persistEvent(event); err != nil {
+			// there's no way to report the error, so stop persisting the events
+			e.closePersistFile() //nolint:errcheck
+		}
+	}
 }
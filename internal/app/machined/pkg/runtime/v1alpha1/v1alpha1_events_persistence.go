@@ -0,0 +1,174 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// Persist implements the Events interface.
+//
+// The events saved to the file on the previous boots become the event history available to the watchers
+// (up to the maximum available event history), the file is rewritten with the history and the events
+// published so far, and each published event is appended to the file.
+func (e *Events) Persist(path string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.persistFile != nil {
+		return errors.New("events are already persisted")
+	}
+
+	history, err := loadEvents(path)
+	if err != nil {
+		return err
+	}
+
+	e.history = history[max(len(history)-(e.cap-e.gap), 0):]
+	e.persistPath = path
+
+	return e.compact()
+}
+
+// StopPersisting implements the Events interface.
+func (e *Events) StopPersisting() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.closePersistFile()
+}
+
+// compact rewrites the persistence file with the last events up to the maximum available event history.
+//
+// Should be called with the mutex held.
+func (e *Events) compact() error {
+	if err := e.closePersistFile(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(e.persistPath), 0o700); err != nil {
+		return err
+	}
+
+	minPos := max(e.writePos-int64(e.cap-e.gap), 0)
+
+	events := make([]runtime.Event, 0, len(e.history)+int(e.writePos-minPos))
+	events = append(events, e.history...)
+
+	for pos := minPos; pos < e.writePos; pos++ {
+		events = append(events, e.stream[pos%int64(e.cap)])
+	}
+
+	events = events[max(len(events)-(e.cap-e.gap), 0):]
+
+	tmpPath := e.persistPath + ".tmp"
+
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	e.persistFile = f
+	e.persisted = 0
+
+	for _, event := range events {
+		if err = e.persistEvent(event); err != nil {
+			e.closePersistFile() //nolint:errcheck
+
+			return fmt.Errorf("error writing events: %w", err)
+		}
+	}
+
+	if err = os.Rename(tmpPath, e.persistPath); err != nil {
+		e.closePersistFile() //nolint:errcheck
+
+		return err
+	}
+
+	return nil
+}
+
+// persistEvent appends the event to the persistence file, compacting the file when it grows too large.
+//
+// Should be called with the mutex held.
+func (e *Events) persistEvent(event runtime.Event) error {
+	if e.persisted >= 2*e.cap {
+		return e.compact()
+	}
+
+	msg, err := event.ToMachineEvent()
+	if err != nil {
+		return err
+	}
+
+	if _, err = protodelim.MarshalTo(e.persistFile, msg); err != nil {
+		return err
+	}
+
+	e.persisted++
+
+	// events are synced to survive the crashes, which is the case they are the most useful for
+	return e.persistFile.Sync()
+}
+
+// closePersistFile closes the persistence file, if it's open.
+//
+// Should be called with the mutex held.
+func (e *Events) closePersistFile() error {
+	if e.persistFile == nil {
+		return nil
+	}
+
+	err := e.persistFile.Close()
+	e.persistFile = nil
+
+	return err
+}
+
+// loadEvents reads the events saved to the file.
+//
+// Reading stops at the first event which can't be decoded (e.g. truncated on crash).
+func loadEvents(path string) ([]runtime.Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	var events []runtime.Event
+
+	r := bufio.NewReader(f)
+
+	for {
+		var msg machine.Event
+
+		if err = protodelim.UnmarshalFrom(r, &msg); err != nil {
+			// either the end of the file, or the event is truncated
+			return events, nil //nolint:nilerr
+		}
+
+		event, decodeErr := runtime.EventFromMachineEvent(&msg)
+		if decodeErr != nil {
+			// skip the events which can't be decoded
+			continue
+		}
+
+		events = append(events, event)
+	}
+}
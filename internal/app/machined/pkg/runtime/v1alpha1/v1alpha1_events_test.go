@@ -8,6 +8,8 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -255,6 +257,143 @@ func TestEvents_WatchOptionsTailID(t *testing.T) {
 	}
 }
 
+func TestEvents_WatchOptionsFilters(t *testing.T) {
+	e := NewEvents(100, 10)
+
+	for i := range 20 {
+		e.Publish(context.WithValue(context.Background(), runtime.ActorIDCtxKey{}, strconv.Itoa(i%2)), &machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+
+		e.Publish(context.Background(), &machine.PhaseEvent{
+			Phase: strconv.Itoa(i),
+		})
+	}
+
+	assert.Equal(t, gen(0, 20), extractSeq(t, receive(t, e, 20, runtime.WithTailEvents(-1), runtime.WithTypes("machine.SequenceEvent"))))
+	assert.Equal(t, gen(0, 20), extractSeq(t, receive(t, e, 20, runtime.WithTailEvents(-1), runtime.WithTypes("talos/runtime/machine.SequenceEvent"))))
+	assert.Len(t, receive(t, e, 40, runtime.WithTailEvents(-1), runtime.WithTypes("machine.SequenceEvent", "machine.PhaseEvent")), 40)
+	assert.Equal(t, []int{1, 3, 5, 7, 9, 11, 13, 15, 17, 19}, extractSeq(t, receive(t, e, 10, runtime.WithTailEvents(-1), runtime.WithActorID("1"))))
+	assert.Equal(t, []int{19}, extractSeq(t, receive(t, e, 1, runtime.WithTailEvents(4), runtime.WithTypes("machine.SequenceEvent"), runtime.WithActorID("1"))))
+}
+
+func TestEvents_WatchOptionsSince(t *testing.T) {
+	e := NewEvents(100, 10)
+
+	for i := range 20 {
+		e.Publish(context.Background(), &machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	// sleep to get time gap between two series of events
+	time.Sleep(2 * time.Second)
+
+	since := time.Now()
+
+	for i := 20; i < 30; i++ {
+		e.Publish(context.Background(), &machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	assert.Equal(t, gen(20, 30), extractSeq(t, receive(t, e, 10, runtime.WithSince(since))))
+	assert.Equal(t, gen(0, 30), extractSeq(t, receive(t, e, 30, runtime.WithSince(since.Add(-time.Minute)))))
+	assert.Equal(t, gen(25, 30), extractSeq(t, receive(t, e, 5, runtime.WithTailEvents(5), runtime.WithSince(since))))
+	assert.Equal(t, gen(20, 30), extractSeq(t, receive(t, e, 10, runtime.WithTailEvents(-1), runtime.WithSince(since))))
+}
+
+func TestEvents_Persist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events")
+
+	e := NewEvents(100, 10)
+
+	for i := range 10 {
+		e.Publish(context.Background(), &machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	assert.NoError(t, e.Persist(path))
+	assert.Error(t, e.Persist(path))
+
+	for i := 10; i < 20; i++ {
+		e.Publish(context.Background(), &machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	assert.NoError(t, e.StopPersisting())
+
+	// not persisted
+	e.Publish(context.Background(), &machine.SequenceEvent{
+		Sequence: "20",
+	})
+
+	// next boot
+	e = NewEvents(100, 10)
+
+	for i := 100; i < 105; i++ {
+		e.Publish(context.Background(), &machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	assert.NoError(t, e.Persist(path))
+
+	assert.Equal(t, gen(100, 105), extractSeq(t, receive(t, e, 5, runtime.WithTailEvents(5))))
+	assert.Equal(t, append(gen(17, 20), gen(100, 105)...), extractSeq(t, receive(t, e, 8, runtime.WithTailEvents(8))))
+	assert.Equal(t, append(gen(0, 20), gen(100, 105)...), extractSeq(t, receive(t, e, 25, runtime.WithTailEvents(-1))))
+
+	events := receive(t, e, 25, runtime.WithTailEvents(-1))
+	assert.Equal(t, append(gen(11, 20), gen(100, 105)...), extractSeq(t, receive(t, e, 14, runtime.WithTailID(events[10].ID))))
+	assert.Equal(t, gen(101, 105), extractSeq(t, receive(t, e, 4, runtime.WithTailID(events[20].ID))))
+
+	e.Publish(context.Background(), &machine.SequenceEvent{
+		Sequence: "105",
+	})
+
+	assert.NoError(t, e.StopPersisting())
+
+	// the history is limited to the maximum available event history
+	e = NewEvents(20, 10)
+
+	assert.NoError(t, e.Persist(path))
+	assert.NoError(t, e.StopPersisting())
+
+	assert.Equal(t, append(gen(16, 20), gen(100, 106)...), extractSeq(t, receive(t, e, 10, runtime.WithTailEvents(-1))))
+}
+
+func TestEvents_PersistCompact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events")
+
+	e := NewEvents(20, 10)
+
+	assert.NoError(t, e.Persist(path))
+
+	for i := range 100 {
+		e.Publish(context.Background(), &machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	assert.NoError(t, e.StopPersisting())
+
+	events, err := loadEvents(path)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, len(events), 40)
+	assert.Equal(t, "99", events[len(events)-1].Payload.(*machine.SequenceEvent).Sequence)
+
+	// truncated file
+	contents, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(path, contents[:len(contents)-3], 0o600))
+
+	events, err = loadEvents(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "98", events[len(events)-1].Payload.(*machine.SequenceEvent).Sequence)
+}
+
 func BenchmarkWatch(b *testing.B) {
 	e := NewEvents(100, 10)
 
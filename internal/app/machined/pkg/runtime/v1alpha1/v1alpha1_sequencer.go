@@ -12,6 +12,7 @@ import (
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
@@ -62,6 +63,15 @@ func (p PhaseList) AppendList(list PhaseList) PhaseList {
 	return append(p, list...)
 }
 
+// Cancelable marks all phases appended so far as cancelable.
+func (p PhaseList) Cancelable() PhaseList {
+	for i := range p {
+		p[i].Cancelable = true
+	}
+
+	return p
+}
+
 // Initialize is the initialize sequence. The primary goals of this sequence is
 // to load the config and enforce kernel security requirements.
 func (*Sequencer) Initialize(r runtime.Runtime) []runtime.Phase {
@@ -196,6 +206,9 @@ func (*Sequencer) Install(r runtime.Runtime) []runtime.Phase {
 			).Append(
 				"unmountState",
 				UnmountStatePartition,
+			).Append(
+				"postInstall",
+				RunLifecycleHooks(config.LifecycleHookPostInstall),
 			).Append(
 				"volumeFinalize",
 				TeardownVolumeLifecycle,
@@ -256,6 +269,9 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 	).Append(
 		"var",
 		SetupVarDirectory,
+	).Append(
+		"events",
+		PersistEvents,
 	).AppendWhen(
 		r.State().Platform().Mode() != runtime.ModeContainer,
 		"overlay",
@@ -271,6 +287,10 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 	).Append(
 		"userSetup",
 		pauseOnFailure(WriteUserFiles, constants.FailurePauseTimeout),
+	).AppendWhen(
+		r.State().Platform().Mode() != runtime.ModeContainer,
+		"measureBootConfig",
+		MeasureBootConfig,
 	).Append(
 		"extendPCRStartAll",
 		ExtendPCRStartAll,
@@ -285,6 +305,9 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 // Reboot is the reboot sequence.
 func (*Sequencer) Reboot(r runtime.Runtime) []runtime.Phase {
 	phases := PhaseList{}.Append(
+		"preReboot",
+		RunLifecycleHooks(config.LifecycleHookPreReboot),
+	).Cancelable().Append(
 		"cleanup",
 		StopAllPods,
 	).Append(
@@ -332,11 +355,14 @@ func (*Sequencer) Reset(r runtime.Runtime, in runtime.ResetOptions) []runtime.Ph
 				Shutdown,
 			)
 	default:
-		phases = phases.AppendWhen(
+		phases = phases.Append(
+			"preResetHooks",
+			RunLifecycleHooks(config.LifecycleHookPreReset),
+		).AppendWhen(
 			in.GetGraceful() && !r.Config().Machine().Kubelet().SkipNodeRegistration(),
 			"drain",
 			taskErrorHandler(logError, CordonAndDrainNode),
-		).AppendWhen(
+		).Cancelable().AppendWhen(
 			in.GetGraceful(),
 			"cleanup",
 			taskErrorHandler(logError, RemoveAllPods),
@@ -417,7 +443,11 @@ func (*Sequencer) StageUpgrade(r runtime.Runtime, in *machineapi.UpgradeRequest)
 	case runtime.ModeContainer:
 		return nil
 	default:
-		phases = phases.Append(
+		phases = phases.AppendWhen(
+			in.GetGracefulPodShutdown(),
+			"podShutdown",
+			GracefulPodShutdown,
+		).Append(
 			"cleanup",
 			StopAllPods,
 		).Append(
@@ -472,10 +502,17 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 	case runtime.ModeContainer:
 		return nil
 	default:
-		phases = phases.AppendWhen(
+		phases = phases.Append(
+			"preReboot",
+			RunLifecycleHooks(config.LifecycleHookPreReboot),
+		).AppendWhen(
 			!r.Config().Machine().Kubelet().SkipNodeRegistration(),
 			"drain",
 			CordonAndDrainNode,
+		).Cancelable().AppendWhen(
+			in.GetGracefulPodShutdown(),
+			"podShutdown",
+			GracefulPodShutdown,
 		).Append(
 			"cleanup",
 			StopAllPods,
@@ -485,6 +522,7 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 		).Append(
 			"stopServices",
 			StopServicesEphemeral,
+			StopPersistingEvents,
 		).Append(
 			"unmountUser",
 			UnmountUserDisks,
@@ -537,6 +575,7 @@ func stopAllPhaselist(r runtime.Runtime, enableKexec bool) PhaseList {
 		phases = phases.Append(
 			"stopServices",
 			StopServicesEphemeral,
+			StopPersistingEvents,
 		).Append(
 			"unmountUser",
 			UnmountUserDisks,
@@ -19,6 +19,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -57,6 +58,7 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/environment"
 	"github.com/siderolabs/talos/internal/pkg/etcd"
 	"github.com/siderolabs/talos/internal/pkg/install"
+	"github.com/siderolabs/talos/internal/pkg/lifecyclehooks"
 	"github.com/siderolabs/talos/internal/pkg/logind"
 	"github.com/siderolabs/talos/internal/pkg/mount"
 	mountv2 "github.com/siderolabs/talos/internal/pkg/mount/v2"
@@ -65,16 +67,19 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
 	"github.com/siderolabs/talos/internal/pkg/zboot"
 	"github.com/siderolabs/talos/pkg/conditions"
+	"github.com/siderolabs/talos/pkg/download"
 	"github.com/siderolabs/talos/pkg/images"
 	"github.com/siderolabs/talos/pkg/kernel/kspp"
 	"github.com/siderolabs/talos/pkg/kubernetes"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	metamachinery "github.com/siderolabs/talos/pkg/machinery/meta"
 	blockres "github.com/siderolabs/talos/pkg/machinery/resources/block"
 	resourcefiles "github.com/siderolabs/talos/pkg/machinery/resources/files"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	resourceruntime "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	resourcev1alpha1 "github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/version"
@@ -752,6 +757,32 @@ func ExtendPCRStartAll(runtime.Sequence, any) (runtime.TaskExecutionFunc, string
 	}, "extendPCRStartAll"
 }
 
+// MeasureBootConfig represents the task to measure the kernel command line and the machine configuration into the PCR.
+//
+// The PCR is covered by the TPM quote, so the attestation verifies the configuration the node booted with.
+func MeasureBootConfig(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+		cmdline, err := os.ReadFile("/proc/cmdline")
+		if err != nil {
+			return fmt.Errorf("error reading kernel command line: %w", err)
+		}
+
+		cfg, err := r.ConfigContainer().Bytes()
+		if err != nil {
+			return fmt.Errorf("error encoding machine configuration: %w", err)
+		}
+
+		// the order matters, see attestation.ConfigPCRValue
+		for _, data := range [][]byte{cmdline, cfg} {
+			if err = tpm2.PCRExtent(constants.ConfigPCR, data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, "measureBootConfig"
+}
+
 // StartAllServices represents the task to start the system services.
 func StartAllServices(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -843,6 +874,25 @@ func StopServicesEphemeral(runtime.Sequence, any) (runtime.TaskExecutionFunc, st
 	}, "stopServicesForUpgrade"
 }
 
+// PersistEvents represents the PersistEvents task.
+func PersistEvents(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		// events persistence is best-effort, it should never block the boot
+		if err = r.Events().Persist(constants.EventsPath); err != nil {
+			logger.Printf("failed to persist events: %s", err)
+		}
+
+		return nil
+	}, "persistEvents"
+}
+
+// StopPersistingEvents represents the StopPersistingEvents task.
+func StopPersistingEvents(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		return r.Events().StopPersisting()
+	}, "stopPersistingEvents"
+}
+
 // StopAllServices represents the StopAllServices task.
 func StopAllServices(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -1003,8 +1053,19 @@ func WriteUserFiles(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 			return fmt.Errorf("error generating extra files: %w", err)
 		}
 
+		fileFacts := sync.OnceValues(func() (config.FileFacts, error) {
+			return userFileFacts(ctx, r)
+		})
+
 		for _, f := range files {
-			content := f.Content()
+			var content string
+
+			content, err = userFileContent(ctx, f, fileFacts)
+			if err != nil {
+				result = multierror.Append(result, fmt.Errorf("error preparing file %q: %w", f.Path(), err))
+
+				continue
+			}
 
 			switch f.Op() {
 			case "create":
@@ -1031,7 +1092,7 @@ func WriteUserFiles(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 					continue
 				}
 
-				content = string(existingFileContents) + "\n" + f.Content()
+				content = string(existingFileContents) + "\n" + content
 			default:
 				result = multierror.Append(result, fmt.Errorf("unknown operation for file %q: %q", f.Path(), f.Op()))
 
@@ -1056,7 +1117,7 @@ func WriteUserFiles(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 
 			// CRI configuration customization
 			if f.Path() == filepath.Join("/etc", constants.CRICustomizationConfigPart) {
-				if err = injectCRIConfigPatch(ctx, r.State().V1Alpha2().Resources(), []byte(f.Content())); err != nil {
+				if err = injectCRIConfigPatch(ctx, r.State().V1Alpha2().Resources(), []byte(content)); err != nil {
 					result = multierror.Append(result, err)
 				}
 
@@ -1112,6 +1173,68 @@ func WriteUserFiles(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	}, "writeUserFiles"
 }
 
+// userFileContent returns the contents of the user file, downloading and rendering them if needed.
+func userFileContent(ctx context.Context, f config.File, fileFacts func() (config.FileFacts, error)) (string, error) {
+	content := f.Content()
+
+	if f.URL() != "" {
+		data, err := download.Download(ctx, f.URL())
+		if err != nil {
+			return "", fmt.Errorf("error downloading %q: %w", f.URL(), err)
+		}
+
+		if err = config.VerifyFileChecksum(data, f.Checksum()); err != nil {
+			return "", fmt.Errorf("error verifying %q: %w", f.URL(), err)
+		}
+
+		content = string(data)
+	}
+
+	if !f.Template() {
+		return content, nil
+	}
+
+	facts, err := fileFacts()
+	if err != nil {
+		return "", err
+	}
+
+	return config.RenderFileTemplate(content, facts)
+}
+
+// userFileFacts gathers the node facts for the user file templates.
+func userFileFacts(ctx context.Context, r runtime.Runtime) (config.FileFacts, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	st := r.State().V1Alpha2().Resources()
+
+	hostname, err := safe.StateWatchFor[*network.HostnameStatus](ctx, st,
+		network.NewHostnameStatus(network.NamespaceName, network.HostnameID).Metadata(),
+		state.WithEventTypes(state.Created, state.Updated),
+	)
+	if err != nil {
+		return config.FileFacts{}, fmt.Errorf("error waiting for hostname: %w", err)
+	}
+
+	addresses, err := safe.StateWatchFor[*network.NodeAddress](ctx, st,
+		network.NewNodeAddress(network.NamespaceName, network.NodeAddressDefaultID).Metadata(),
+		state.WithEventTypes(state.Created, state.Updated),
+		state.WithCondition(func(r resource.Resource) (bool, error) {
+			return len(r.(*network.NodeAddress).TypedSpec().Addresses) > 0, nil
+		}),
+	)
+	if err != nil {
+		return config.FileFacts{}, fmt.Errorf("error waiting for node address: %w", err)
+	}
+
+	return config.FileFacts{
+		Hostname:    hostname.TypedSpec().Hostname,
+		NodeIP:      addresses.TypedSpec().IPs()[0].String(),
+		MachineType: r.Config().Machine().Type().String(),
+	}, nil
+}
+
 func injectCRIConfigPatch(ctx context.Context, st state.State, content []byte) error {
 	// limit overall waiting time
 	ctx, cancel := context.WithTimeout(ctx, time.Minute)
@@ -1456,6 +1579,92 @@ func StopAllPods(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return stopAndRemoveAllPods(cri.StopOnly), "stopAllPods"
 }
 
+// GracefulPodShutdown represents the task for the kubelet graceful shutdown before the upgrade.
+//
+// The task triggers kubelet graceful node shutdown and waits for the pods to terminate,
+// reporting the progress via PodShutdownEvent.
+func GracefulPodShutdown(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+		// check that the CRI is running and the socket is available, if not, skip the rest
+		if _, err := os.Stat(constants.CRIContainerdAddress); os.IsNotExist(err) {
+			return nil
+		}
+
+		client, err := cri.NewClient("unix://"+constants.CRIContainerdAddress, 10*time.Second)
+		if err != nil {
+			return err
+		}
+
+		//nolint:errcheck
+		defer client.Close()
+
+		logger.Printf("triggering kubelet graceful shutdown")
+
+		shutdownCtx, shutdownCtxCancel := context.WithTimeout(ctx, logind.InhibitMaxDelay)
+		defer shutdownCtxCancel()
+
+		lockReleasedCh := make(chan error, 1)
+
+		go func() {
+			lockReleasedCh <- r.State().Machine().DBus().WaitShutdown(shutdownCtx)
+		}()
+
+		ticker := time.NewTicker(5 * time.Second)
+		defer ticker.Stop()
+
+		lockReleased := false
+
+		for {
+			pods, err := client.ListPodSandbox(ctx, &runtimeapi.PodSandboxFilter{
+				State: &runtimeapi.PodSandboxStateValue{
+					State: runtimeapi.PodSandboxState_SANDBOX_READY,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			running := xslices.Map(pods, func(pod *runtimeapi.PodSandbox) string {
+				return pod.GetMetadata().GetNamespace() + "/" + pod.GetMetadata().GetName()
+			})
+
+			r.Events().Publish(ctx, &machineapi.PodShutdownEvent{
+				RunningPods: int32(len(running)),
+				Pods:        running,
+			})
+
+			if len(running) == 0 {
+				logger.Printf("all pods terminated")
+
+				return nil
+			}
+
+			// kubelet is done with the shutdown, the remaining pods are stopped in the cleanup phase
+			if lockReleased {
+				logger.Printf("kubelet graceful shutdown finished, pods still running: %s", strings.Join(running, ", "))
+
+				return nil
+			}
+
+			logger.Printf("waiting for %d pods to terminate", len(running))
+
+			select {
+			case <-shutdownCtx.Done():
+				logger.Printf("timed out waiting for pods to terminate: %s", strings.Join(running, ", "))
+
+				return nil
+			case lockErr := <-lockReleasedCh:
+				if lockErr != nil {
+					logger.Printf("failed waiting for inhibit shutdown lock: %s", lockErr)
+				}
+
+				lockReleased = true
+			case <-ticker.C:
+			}
+		}
+	}, "gracefulPodShutdown"
+}
+
 func waitForKubeletLifecycleFinalizers(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
 	logger.Printf("waiting for kubelet lifecycle finalizers")
 
@@ -2025,6 +2234,7 @@ func Install(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 				install.WithForce(true),
 				install.WithZero(r.Config().Machine().Install().Zero()),
 				install.WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()),
+				install.WithPullRateLimit(r.Config().Machine().Install().PullRateLimit()),
 			)
 			if err != nil {
 				platform.FireEvent(
@@ -2350,6 +2560,29 @@ func SendResetSignal(runtime.Sequence, any) (runtime.TaskExecutionFunc, string)
 	}, "sendResetSignal"
 }
 
+// RunLifecycleHooks represents the task which fires the lifecycle hooks configured for the stage.
+func RunLifecycleHooks(stage config.LifecycleHookStage) func(seq runtime.Sequence, _ any) (runtime.TaskExecutionFunc, string) {
+	return func(seq runtime.Sequence, _ any) (runtime.TaskExecutionFunc, string) {
+		return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+			if r.Config() == nil {
+				return nil
+			}
+
+			hostname, err := os.Hostname()
+			if err != nil {
+				return err
+			}
+
+			return lifecyclehooks.Run(ctx, logger, r.Config().LifecycleHooks(), lifecyclehooks.Payload{
+				Stage:     stage,
+				Sequence:  seq.String(),
+				Hostname:  hostname,
+				Timestamp: time.Now(),
+			})
+		}, "runLifecycleHooks"
+	}
+}
+
 // WaitForCARoots represents the WaitForCARoots task.
 //
 //nolint:gocyclo
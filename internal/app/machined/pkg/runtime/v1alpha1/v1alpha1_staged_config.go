@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+	"github.com/siderolabs/talos/internal/pkg/stagedconfig"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	resourceruntime "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// WatchStagedConfig confirms or rolls back the staged machine config after the reboot into it.
+//
+// The machine booted into the staged config should become ready within the rollback timeout,
+// otherwise the previous config is restored, and the machine is rebooted with the reboot sequence.
+//
+// WatchStagedConfig should be started before the boot sequence, as it waits for the STATE partition to be mounted.
+func (c *Controller) WatchStagedConfig(ctx context.Context) error {
+	// the staged config is kept on the STATE partition
+	if c.r.State().Platform().Mode() != runtime.ModeContainer {
+		if _, err := c.r.State().V1Alpha2().Resources().WatchFor(ctx,
+			resource.NewMetadata(resourceruntime.NamespaceName, resourceruntime.MountStatusType, constants.StatePartitionLabel, resource.VersionUndefined),
+			state.WithEventTypes(state.Created, state.Updated),
+		); err != nil {
+			return err
+		}
+	}
+
+	store := stagedconfig.Default()
+
+	timeout, pending, err := store.Pending()
+	if err != nil {
+		return err
+	}
+
+	if !pending {
+		return nil
+	}
+
+	requireReady := !c.waitingForBootstrap()
+
+	if requireReady {
+		log.Printf("booted into the staged config, waiting %s for the machine to become ready", timeout)
+	} else {
+		log.Printf("booted into the staged config, waiting %s for the machine to boot (etcd is not bootstrapped yet)", timeout)
+	}
+
+	watchCtx, watchCancel := context.WithTimeout(ctx, timeout)
+	defer watchCancel()
+
+	_, err = c.r.State().V1Alpha2().Resources().WatchFor(watchCtx,
+		resourceruntime.NewMachineStatus().Metadata(),
+		state.WithCondition(func(res resource.Resource) (bool, error) {
+			machineStatus, ok := res.(*resourceruntime.MachineStatus)
+			if !ok {
+				return false, nil
+			}
+
+			return machineStatus.TypedSpec().Stage == resourceruntime.MachineStageRunning && (machineStatus.TypedSpec().Status.Ready || !requireReady), nil
+		}),
+	)
+	if err == nil {
+		log.Printf("machine is ready, staged config is confirmed")
+
+		return store.Commit()
+	}
+
+	if ctx.Err() != nil {
+		// machined is shutting down, the staged config is watched again on the next boot
+		return nil
+	}
+
+	log.Printf("machine didn't become ready in %s, rolling back to the previous config", timeout)
+
+	if err = store.Rollback(); err != nil {
+		return fmt.Errorf("config rollback failed: %w", err)
+	}
+
+	if err = c.Run(context.Background(), runtime.SequenceReboot, nil, runtime.WithTakeover()); err != nil && !runtime.IsRebootError(err) {
+		return fmt.Errorf("reboot after the config rollback failed: %w", err)
+	}
+
+	return nil
+}
+
+// waitingForBootstrap returns true if the machine is a control plane node which hasn't joined etcd yet.
+//
+// Such node can't become ready until the cluster is bootstrapped, so the staged config is confirmed once the machine boots.
+func (c *Controller) waitingForBootstrap() bool {
+	cfg := c.r.Config()
+	if cfg == nil || cfg.Machine() == nil || !cfg.Machine().Type().IsControlPlane() {
+		return false
+	}
+
+	empty, err := services.IsDirEmpty(constants.EtcdDataPath)
+	if err != nil {
+		return errors.Is(err, os.ErrNotExist)
+	}
+
+	return empty
+}
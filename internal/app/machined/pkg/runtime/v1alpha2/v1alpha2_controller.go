@@ -5,9 +5,11 @@
 package v1alpha2
 
 import (
+	"bytes"
 	"context"
-	"fmt"
+	"maps"
 	"net/url"
+	"slices"
 	"sync"
 	"time"
 
@@ -135,6 +137,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		},
 		&etcd.AdvertisedPeerController{},
 		etcd.NewConfigController(),
+		&etcd.ConsistencyController{},
 		&etcd.PKIController{},
 		&etcd.SpecController{},
 		&etcd.MemberController{},
@@ -180,6 +183,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&k8s.NodeAnnotationSpecController{},
 		&k8s.NodeApplyController{},
 		&k8s.NodeCordonedSpecController{},
+		&k8s.NodeEventsController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&k8s.NodeLabelSpecController{},
 		&k8s.NodeStatusController{},
 		&k8s.NodeTaintSpecController{},
@@ -192,6 +198,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		kubeaccess.NewConfigController(),
 		&kubeaccess.CRDController{},
 		&kubeaccess.EndpointController{},
+		&kubeaccess.ServiceAccountBridgeController{},
 		kubespan.NewConfigController(),
 		&kubespan.EndpointController{},
 		&kubespan.IdentityController{},
@@ -207,6 +214,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.AddressMergeController{},
 		&network.AddressSpecController{},
 		&network.AddressStatusController{},
+		&network.ConntrackStatusController{},
 		&network.DeviceConfigController{},
 		&network.DNSResolveCacheController{
 			State:  ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
@@ -250,6 +258,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			V1alpha1Platform: ctrl.v1alpha1Runtime.State().Platform(),
 			PlatformState:    ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
 		},
+		&network.PodNetStatsController{},
 		&network.ProbeController{},
 		&network.ResolverConfigController{
 			Cmdline: procfs.ProcCmdline(),
@@ -262,6 +271,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.RouteMergeController{},
 		&network.RouteSpecController{},
 		&network.RouteStatusController{},
+		&network.RouterAdvertisementController{},
 		&network.StatusController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -271,7 +281,14 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.TimeServerMergeController{},
 		&network.TimeServerSpecController{},
 		&perf.StatsController{},
+		&runtimecontrollers.AttestationStatusController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
+		&runtimecontrollers.CgroupLimitsController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&runtimecontrollers.CRIImageGCController{},
+		&runtimecontrollers.ContainerLogDeliveryController{},
 		&runtimecontrollers.DevicesStatusController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -297,11 +314,20 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			V1Alpha1Services: system.Services(ctrl.v1alpha1Runtime),
 			ConfigPath:       constants.ExtensionServiceConfigPath,
 		},
+		&runtimecontrollers.ExtensionHooksController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
+		&runtimecontrollers.ExtensionMetricsController{},
 		&runtimecontrollers.ExtensionStatusController{},
+		&runtimecontrollers.FilesystemTrimController{
+			V1Alpha1Mode:   ctrl.v1alpha1Runtime.State().Platform().Mode(),
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&runtimecontrollers.KernelModuleConfigController{},
 		&runtimecontrollers.KernelModuleSpecController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&runtimecontrollers.KernelModuleStatusController{},
 		&runtimecontrollers.KernelParamConfigController{},
 		&runtimecontrollers.KernelParamDefaultsController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -321,9 +347,14 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&runtimecontrollers.MachineStatusPublisherController{
 			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
 		},
+		&runtimecontrollers.MetricsServerController{},
 		&runtimecontrollers.SecurityStateController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&runtimecontrollers.UdevRuleConfigController{},
+		&runtimecontrollers.UdevRuleController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		runtimecontrollers.NewUniqueMachineTokenController(),
 		&runtimecontrollers.WatchdogTimerConfigController{},
 		&runtimecontrollers.WatchdogTimerController{},
@@ -375,9 +406,12 @@ func (ctrl *Controller) DependencyGraph() (*controller.DependencyGraph, error) {
 }
 
 type loggingDestination struct {
-	Format    string
-	Endpoint  *url.URL
-	ExtraTags map[string]string
+	Format                string
+	Endpoint              *url.URL
+	ExtraTags             map[string]string
+	TLSCA                 []byte
+	TLSInsecureSkipVerify bool
+	SpoolMaxSize          uint64
 }
 
 func (a *loggingDestination) Equal(b *loggingDestination) bool {
@@ -389,17 +423,15 @@ func (a *loggingDestination) Equal(b *loggingDestination) bool {
 		return false
 	}
 
-	if len(a.ExtraTags) != len(b.ExtraTags) {
+	if !maps.Equal(a.ExtraTags, b.ExtraTags) {
 		return false
 	}
 
-	for k, v := range a.ExtraTags {
-		if vv, ok := b.ExtraTags[k]; !ok || vv != v {
-			return false
-		}
+	if !bytes.Equal(a.TLSCA, b.TLSCA) || a.TLSInsecureSkipVerify != b.TLSInsecureSkipVerify {
+		return false
 	}
 
-	return true
+	return a.SpoolMaxSize == b.SpoolMaxSize
 }
 
 func (ctrl *Controller) watchMachineConfig(ctx context.Context) {
@@ -454,21 +486,21 @@ func (ctrl *Controller) updateConsoleLoggingConfig(debug bool) {
 }
 
 func (ctrl *Controller) updateLoggingConfig(ctx context.Context, dests []talosconfig.LoggingDestination, prevLoggingDestinations *[]loggingDestination) {
-	loggingDestinations := make([]loggingDestination, len(dests))
-
-	for i, dest := range dests {
-		switch f := dest.Format(); f {
-		case constants.LoggingFormatJSONLines:
-			loggingDestinations[i] = loggingDestination{
-				Format:    f,
-				Endpoint:  dest.Endpoint(),
-				ExtraTags: dest.ExtraTags(),
-			}
-		default:
-			// should not be possible due to validation
-			panic(fmt.Sprintf("unhandled log destination format %q", f))
+	// only the destinations which receive the service logs are handled here
+	dests = xslices.Filter(dests, func(dest talosconfig.LoggingDestination) bool {
+		return slices.Contains(dest.Sources(), constants.LoggingSourceService)
+	})
+
+	loggingDestinations := xslices.Map(dests, func(dest talosconfig.LoggingDestination) loggingDestination {
+		return loggingDestination{
+			Format:                dest.Format(),
+			Endpoint:              dest.Endpoint(),
+			ExtraTags:             dest.ExtraTags(),
+			TLSCA:                 dest.TLSCA(),
+			TLSInsecureSkipVerify: dest.TLSInsecureSkipVerify(),
+			SpoolMaxSize:          dest.SpoolMaxSize(),
 		}
-	}
+	})
 
 	loggingChanged := len(*prevLoggingDestinations) != len(loggingDestinations)
 	if !loggingChanged {
@@ -489,13 +521,25 @@ func (ctrl *Controller) updateLoggingConfig(ctx context.Context, dests []talosco
 
 	var prevSenders []runtime.LogSender
 
-	if len(loggingDestinations) > 0 {
-		senders := xslices.Map(dests, runtimelogging.NewJSONLines)
+	senders := make([]runtime.LogSender, 0, len(dests))
+
+	for _, dest := range dests {
+		sender, err := runtimelogging.NewSender(dest, constants.LoggingSourceService)
+		if err != nil {
+			// should not be possible due to validation
+			ctrl.logger.Error("error creating log sender", zap.String("endpoint", dest.Endpoint().Redacted()), zap.Error(err))
+
+			continue
+		}
+
+		senders = append(senders, sender)
+	}
 
-		ctrl.logger.Info("enabling JSON logging")
+	if len(senders) > 0 {
+		ctrl.logger.Info("enabling log forwarding")
 		prevSenders = ctrl.loggingManager.SetSenders(senders)
 	} else {
-		ctrl.logger.Info("disabling JSON logging")
+		ctrl.logger.Info("disabling log forwarding")
 		prevSenders = ctrl.loggingManager.SetSenders(nil)
 	}
 
@@ -114,6 +114,7 @@ func NewState() (*State, error) {
 		&config.MachineType{},
 		&cri.SeccompProfile{},
 		&etcd.Config{},
+		&etcd.ConsistencyStatus{},
 		&etcd.PKIStatus{},
 		&etcd.Spec{},
 		&etcd.Member{},
@@ -149,6 +150,7 @@ func NewState() (*State, error) {
 		&k8s.NodeTaintSpec{},
 		&k8s.SchedulerConfig{},
 		&k8s.StaticPod{},
+		&k8s.StaticPodManifestStatus{},
 		&k8s.StaticPodServerStatus{},
 		&k8s.StaticPodStatus{},
 		&k8s.SecretsStatus{},
@@ -160,13 +162,17 @@ func NewState() (*State, error) {
 		&kubespan.PeerStatus{},
 		&network.AddressStatus{},
 		&network.AddressSpec{},
+		&network.ConntrackStatus{},
 		&network.DeviceConfigSpec{},
+		&network.DHCPLeaseStatus{},
+		&network.DHCPOptionsStatus{},
 		&network.DNSResolveCache{},
 		&network.DNSUpstream{},
 		&network.HardwareAddr{},
 		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
+		&network.IPAMLease{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
@@ -174,6 +180,7 @@ func NewState() (*State, error) {
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
+		&network.PodNetStats{},
 		&network.ProbeSpec{},
 		&network.ProbeStatus{},
 		&network.ResolverStatus{},
@@ -185,13 +192,19 @@ func NewState() (*State, error) {
 		&network.TimeServerSpec{},
 		&perf.CPU{},
 		&perf.Memory{},
+		&runtime.AttestationStatus{},
+		&runtime.CgroupStatus{},
 		&runtime.DevicesStatus{},
 		&runtime.Diagnostic{},
+		&runtime.DiskBenchmark{},
 		&runtime.EventSinkConfig{},
 		&runtime.ExtensionServiceConfig{},
 		&runtime.ExtensionServiceConfigStatus{},
+		&runtime.ExtensionHooksStatus{},
+		&runtime.ExtensionMetrics{},
 		&runtime.ExtensionStatus{},
 		&runtime.KernelModuleSpec{},
+		&runtime.KernelModuleStatus{},
 		&runtime.KernelParamSpec{},
 		&runtime.KernelParamDefaultSpec{},
 		&runtime.KernelParamStatus{},
@@ -205,6 +218,8 @@ func NewState() (*State, error) {
 		&runtime.MountStatus{},
 		&runtime.PlatformMetadata{},
 		&runtime.SecurityState{},
+		&runtime.StagedConfigStatus{},
+		&runtime.UdevRule{},
 		&runtime.UniqueMachineToken{},
 		&runtime.WatchdogTimerConfig{},
 		&runtime.WatchdogTimerStatus{},
@@ -224,6 +239,7 @@ func NewState() (*State, error) {
 		&siderolink.Status{},
 		&siderolink.Tunnel{},
 		&time.AdjtimeStatus{},
+		&time.SourceStatus{},
 		&time.Status{},
 		&v1alpha1.AcquireConfigSpec{},
 		&v1alpha1.AcquireConfigStatus{},
@@ -156,6 +156,27 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 		args.ProcessArgs = append(args.ProcessArgs, "--enable-ext-key-usage-check")
 	}
 
+	if apiOIDC := r.Config().Machine().Features().APIOIDC(); apiOIDC.Enabled() {
+		args.ProcessArgs = append(args.ProcessArgs,
+			"--oidc-issuer-url="+apiOIDC.IssuerURL(),
+			"--oidc-audience="+apiOIDC.Audience(),
+			"--oidc-groups-claim="+apiOIDC.GroupsClaim(),
+		)
+
+		for _, groupRoles := range apiOIDC.GroupRoles() {
+			args.ProcessArgs = append(args.ProcessArgs, "--oidc-group-roles="+groupRoles.Group()+"="+strings.Join(groupRoles.Roles(), ","))
+		}
+	}
+
+	deadlines := r.Config().Machine().Features().APIDDeadlines()
+
+	args.ProcessArgs = append(args.ProcessArgs,
+		"--unary-default-deadline="+deadlines.UnaryDefault().String(),
+		"--unary-max-deadline="+deadlines.UnaryMax().String(),
+		"--streaming-default-deadline="+deadlines.StreamingDefault().String(),
+		"--streaming-max-deadline="+deadlines.StreamingMax().String(),
+	)
+
 	// Set the mounts.
 	mounts := []specs.Mount{
 		{Type: "bind", Destination: "/etc/ssl", Source: "/etc/ssl", Options: []string{"bind", "ro"}},
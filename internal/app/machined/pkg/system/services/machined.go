@@ -23,8 +23,11 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/health"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner/goroutine"
+	"github.com/siderolabs/talos/internal/pkg/metrics"
+	"github.com/siderolabs/talos/internal/pkg/nodehealth"
 	"github.com/siderolabs/talos/pkg/conditions"
 	"github.com/siderolabs/talos/pkg/grpc/factory"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/audit"
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/role"
@@ -33,37 +36,62 @@ import (
 const machinedServiceID = "machined"
 
 var rules = map[string]role.Set{
+	"/audit.AuditService/List":  role.MakeSet(role.Admin, role.Operator),
+	"/audit.AuditService/Watch": role.MakeSet(role.Admin, role.Operator),
+
 	"/cluster.ClusterService/HealthCheck": role.MakeSet(role.Admin, role.Operator, role.Reader),
 
+	"/health.HealthService/Check": role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/health.HealthService/List":  role.MakeSet(role.Admin, role.Operator, role.Reader),
+
 	"/inspect.InspectService/ControllerRuntimeDependencies": role.MakeSet(role.Admin, role.Operator, role.Reader),
 
 	"/machine.MachineService/ApplyConfiguration":          role.MakeSet(role.Admin),
+	"/machine.MachineService/BenchmarkDisk":               role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Bootstrap":                   role.MakeSet(role.Admin),
 	"/machine.MachineService/CPUInfo":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/Capabilities":                role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/CgroupStats":                 role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/ConntrackFlush":              role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/ConntrackList":               role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Containers":                  role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Copy":                        role.MakeSet(role.Admin),
 	"/machine.MachineService/DiskStats":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/DiskUsage":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Dmesg":                       role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/EmergencyConsole":            role.MakeSet(role.Admin),
+	"/machine.MachineService/EncryptionAddKey":            role.MakeSet(role.Admin),
+	"/machine.MachineService/EncryptionRemoveKey":         role.MakeSet(role.Admin),
+	"/machine.MachineService/EncryptionRotateKey":         role.MakeSet(role.Admin),
+	"/machine.MachineService/EncryptionStatus":            role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/EtcdAlarmList":               role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/EtcdAlarmDisarm":             role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/EtcdConsistencyCheck":        role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/EtcdDefragment":              role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/EtcdForfeitLeadership":       role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdLeaveCluster":            role.MakeSet(role.Admin),
+	"/machine.MachineService/EtcdMemberLatency":           role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/EtcdMemberList":              role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/EtcdRecover":                 role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdRemoveMemberByID":        role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdSnapshot":                role.MakeSet(role.Admin, role.Operator, role.EtcdBackup),
 	"/machine.MachineService/EtcdStatus":                  role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Events":                      role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/ExtensionMetrics":            role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/FeatureFlagList":             role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/FeatureFlagSet":              role.MakeSet(role.Admin),
+	"/machine.MachineService/FilesystemTrim":              role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/GenerateClientConfiguration": role.MakeSet(role.Admin),
 	"/machine.MachineService/GenerateConfiguration":       role.MakeSet(role.Admin),
+	"/machine.MachineService/GeneratedFiles":              role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Hostname":                    role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/ImageList":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/ImagePull":                   role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/KernelModuleParameterSet":    role.MakeSet(role.Admin),
 	"/machine.MachineService/Kubeconfig":                  role.MakeSet(role.Admin),
 	"/machine.MachineService/List":                        role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/LoadAvg":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/LogRecords":                  role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Logs":                        role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/LogsContainers":              role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Memory":                      role.MakeSet(role.Admin, role.Operator, role.Reader),
@@ -74,19 +102,27 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/Netstat":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/PacketCapture":               role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Processes":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/ProcessesStream":             role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Read":                        role.MakeSet(role.Admin),
 	"/machine.MachineService/Reboot":                      role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/RenewClientCertificate":      role.MakeSet(role.Admin, role.Operator, role.Reader, role.EtcdBackup),
 	"/machine.MachineService/Reset":                       role.MakeSet(role.Admin),
 	"/machine.MachineService/Restart":                     role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Rollback":                    role.MakeSet(role.Admin),
+	"/machine.MachineService/RootfsIntegrity":             role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/SequenceCancel":              role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/SequenceList":                role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/ServiceList":                 role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/ServiceRestart":              role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/ServiceStart":                role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/ServiceStop":                 role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Shutdown":                    role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/SocketStatistics":            role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Stats":                       role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/SystemStat":                  role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Upgrade":                     role.MakeSet(role.Admin),
+	"/machine.MachineService/UserFileRead":                role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/UserFileWrite":               role.MakeSet(role.Admin),
 	"/machine.MachineService/Version":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
 
 	// per-type authorization is handled by the service itself
@@ -97,6 +133,8 @@ var rules = map[string]role.Set{
 	"/cosi.resource.State/Update":  role.MakeSet(role.Admin),
 	"/cosi.resource.State/Watch":   role.MakeSet(role.Admin, role.Operator, role.Reader),
 
+	"/securityapi.SecurityService/Attest": role.MakeSet(role.Admin, role.Operator, role.Reader),
+
 	"/storage.StorageService/Disks": role.MakeSet(role.Admin, role.Operator, role.Reader),
 
 	"/time.TimeService/Time":      role.MakeSet(role.Admin, role.Operator, role.Reader),
@@ -123,12 +161,38 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 		Logger:        log.New(logWriter, "machined/authz/authorizer ", log.Flags()).Printf,
 	}
 
+	auditLog := audit.NewLog(constants.AuditLogCapacity)
+
+	syslogSink := audit.NewSyslogSink(func() string {
+		cfg := r.Config()
+		if cfg == nil || cfg.Machine() == nil {
+			return ""
+		}
+
+		if endpoint := cfg.Machine().Features().AuditLog().SyslogEndpoint(); endpoint != nil {
+			return endpoint.String()
+		}
+
+		return ""
+	}, log.New(logWriter, "machined/audit ", log.Flags()))
+
+	go syslogSink.Run(ctx)
+
+	auditor := audit.NewMiddleware(auditLog, syslogSink)
+
+	healthChecks, err := nodehealth.NewRegistry(nodehealth.DefaultChecks()...)
+	if err != nil {
+		return err
+	}
+
 	// Start the API server.
 	server := factory.NewServer( //nolint:contextcheck
 		&v1alpha1server.Server{
 			Controller: s.c,
 			// breaking the import loop cycle between services/ package and v1alpha1_server.go
 			EtcdBootstrapper: BootstrapEtcd,
+			AuditLog:         auditLog,
+			HealthChecks:     healthChecks,
 
 			ShutdownCtx: ctx,
 		},
@@ -138,9 +202,15 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 			grpc.MaxRecvMsgSize(constants.GRPCMaxMessageSize),
 		),
 
+		factory.WithUnaryInterceptor(metrics.UnaryInterceptor()),
+		factory.WithStreamInterceptor(metrics.StreamInterceptor()), //nolint:contextcheck
+
 		factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
 		factory.WithStreamInterceptor(injector.StreamInterceptor()), //nolint:contextcheck
 
+		factory.WithUnaryInterceptor(auditor.UnaryInterceptor()),
+		factory.WithStreamInterceptor(auditor.StreamInterceptor()), //nolint:contextcheck
+
 		factory.WithUnaryInterceptor(authorizer.UnaryInterceptor()),
 		factory.WithStreamInterceptor(authorizer.StreamInterceptor()), //nolint:contextcheck
 	)
@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-procfs/procfs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/ipam"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	v1alpha1machine "github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// allocateAddresses requests static addresses from the IPAM plugin (if configured via the kernel argument)
+// for the interfaces which have no addressing configured (neither DHCP, nor CIDR).
+//
+// The allocated addresses are filled into the generate request, and the leases are recorded as IPAMLease resources.
+func (s *Server) allocateAddresses(ctx context.Context, in *machine.GenerateConfigurationRequest) error {
+	endpoint := procfs.ProcCmdline().Get(constants.KernelParamIPAMEndpoint).First()
+	if endpoint == nil || in.MachineConfig.NetworkConfig == nil {
+		return nil
+	}
+
+	allocator, err := ipam.NewGRPCAllocator(*endpoint)
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	defer allocator.Close() //nolint:errcheck
+
+	st := s.controller.Runtime().State().V1Alpha2().Resources()
+
+	for _, device := range in.MachineConfig.NetworkConfig.Interfaces {
+		if device.Dhcp || device.Ignore || device.Cidr != "" {
+			continue
+		}
+
+		req := ipam.Request{
+			MachineType: v1alpha1machine.Type(in.MachineConfig.Type).String(),
+			Hostname:    in.MachineConfig.NetworkConfig.Hostname,
+			Interface:   device.Interface,
+		}
+
+		if in.ClusterConfig != nil {
+			req.ClusterName = in.ClusterConfig.Name
+		}
+
+		if link, err := safe.StateGetByID[*network.LinkStatus](ctx, st, device.Interface); err == nil {
+			req.HardwareAddr = net.HardwareAddr(link.TypedSpec().HardwareAddr).String()
+		}
+
+		lease, err := allocator.Allocate(ctx, req)
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "error allocating address for %q: %s", device.Interface, err)
+		}
+
+		log.Printf("allocated address %s for %q from IPAM (lease %q)", lease.Address, device.Interface, lease.ID)
+
+		device.Cidr = lease.Address
+
+		if lease.Gateway != "" && len(device.Routes) == 0 {
+			defaultRoute := "0.0.0.0/0"
+
+			if netip.MustParseAddr(lease.Gateway).Is6() {
+				defaultRoute = "::/0"
+			}
+
+			device.Routes = append(device.Routes, &machine.RouteConfig{
+				Network: defaultRoute,
+				Gateway: lease.Gateway,
+			})
+		}
+
+		if err = recordLease(ctx, st, device.Interface, allocator.Endpoint(), lease); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func recordLease(ctx context.Context, st state.State, linkName, endpoint string, lease ipam.Lease) error {
+	res := network.NewIPAMLease(network.NamespaceName, linkName)
+
+	*res.TypedSpec() = network.IPAMLeaseSpec{
+		LeaseID:  lease.ID,
+		Address:  lease.Address,
+		Gateway:  lease.Gateway,
+		Endpoint: endpoint,
+	}
+
+	err := st.Create(ctx, res)
+	if state.IsConflictError(err) {
+		_, err = safe.StateUpdateWithConflicts(ctx, st, res.Metadata(), func(r *network.IPAMLease) error {
+			*r.TypedSpec() = *res.TypedSpec()
+
+			return nil
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error recording IPAM lease: %w", err)
+	}
+
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/resources"
 	storaged "github.com/siderolabs/talos/internal/app/storaged"
 	"github.com/siderolabs/talos/internal/pkg/configuration"
+	"github.com/siderolabs/talos/internal/pkg/secretsstore"
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/api/storage"
@@ -64,7 +65,7 @@ func (s *Server) Register(obj *grpc.Server) {
 
 	// wrap resources with access filter
 	resourceState := s.controller.Runtime().State().V1Alpha2().Resources()
-	resourceState = state.WrapCore(state.Filter(resourceState, resources.AccessPolicy(resourceState)))
+	resourceState = state.WrapCore(resources.WithListSorting(resources.WithConditionalGet(resources.WithoutOwner(state.Filter(resourceState, resources.AccessPolicy(resourceState))))))
 
 	storage.RegisterStorageServiceServer(obj, &storaged.Server{Controller: s.controller})
 	machine.RegisterMachineServiceServer(obj, s)
@@ -85,11 +86,31 @@ func (s *Server) ApplyConfiguration(_ context.Context, in *machine.ApplyConfigur
 			strings.ReplaceAll(strings.ToLower(in.Mode.String()), "_", "-"))
 	}
 
+	if len(in.GetPatches()) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "configuration patches are not supported in maintenance mode, as there is no configuration to patch")
+	}
+
 	cfgProvider, err := configloader.NewFromBytes(in.GetData())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
+	// the node secrets store is not available in maintenance mode, so the secrets bundle should be provided
+	if cfgProvider.SecretsReference() != nil && len(in.GetSecretsBundle()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "the configuration references the secrets bundle, it should be provided in maintenance mode")
+	}
+
+	if len(in.GetSecretsBundle()) > 0 {
+		secretsBundle, err := secretsstore.DecodeBundle(in.GetSecretsBundle())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if cfgProvider, err = secretsstore.Default().Resolve(cfgProvider, secretsBundle); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	warnings, err := cfgProvider.Validate(s.controller.Runtime().State().Platform().Mode())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "configuration validation failed: %s", err)
@@ -127,6 +148,10 @@ func (s *Server) GenerateConfiguration(ctx context.Context, in *machine.Generate
 		return nil, errors.New("join config can't be generated in the maintenance mode")
 	}
 
+	if err := s.allocateAddresses(ctx, in); err != nil {
+		return nil, err
+	}
+
 	return configuration.Generate(ctx, in)
 }
 
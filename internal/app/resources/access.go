@@ -8,6 +8,7 @@ package resources
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -18,14 +19,40 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/role"
 )
 
+// WritableResources is the list of resource types which can be created, updated and destroyed via the API.
+//
+// Each resource type can be modified only in the specified namespace.
+// Network configuration specs are merged by the controllers with the specs coming from other configuration
+// sources according to the configuration layer of the spec.
+var WritableResources = map[resource.Type]resource.Namespace{
+	network.AddressSpecType:    network.ConfigNamespaceName,
+	network.HostnameSpecType:   network.ConfigNamespaceName,
+	network.LinkSpecType:       network.ConfigNamespaceName,
+	network.OperatorSpecType:   network.ConfigNamespaceName,
+	network.ResolverSpecType:   network.ConfigNamespaceName,
+	network.RouteSpecType:      network.ConfigNamespaceName,
+	network.TimeServerSpecType: network.ConfigNamespaceName,
+}
+
 // AccessPolicy defines the access policy for resources accessed via the API.
 func AccessPolicy(st state.State) state.FilteringRule {
 	return func(ctx context.Context, access state.Access) error {
+		roles := authz.GetRoles(ctx)
+
 		if !access.Verb.Readonly() {
-			return status.Error(codes.PermissionDenied, "write access is not allowed")
+			if namespace, ok := WritableResources[access.ResourceType]; !ok || namespace != access.ResourceNamespace {
+				return status.Error(codes.PermissionDenied, "write access is not allowed")
+			}
+
+			if !roles.Includes(role.Admin) {
+				return authz.ErrNotAuthorized
+			}
 		}
 
 		rd, err := safe.StateGet[*meta.ResourceDefinition](ctx, st, resource.NewMetadata(meta.NamespaceName, meta.ResourceDefinitionType, strings.ToLower(access.ResourceType), resource.VersionUndefined))
@@ -37,7 +64,6 @@ func AccessPolicy(st state.State) state.FilteringRule {
 			return err
 		}
 
-		roles := authz.GetRoles(ctx)
 		spec := rd.TypedSpec()
 
 		switch spec.Sensitivity {
@@ -51,6 +77,10 @@ func AccessPolicy(st state.State) state.FilteringRule {
 			return fmt.Errorf("unexpected sensitivity %q", spec.Sensitivity)
 		}
 
+		if err = checkResourceAccessPolicy(ctx, st, roles, access, spec); err != nil {
+			return err
+		}
+
 		_, err = safe.StateGet[*meta.Namespace](ctx, st, resource.NewMetadata(meta.NamespaceName, meta.NamespaceType, access.ResourceNamespace, resource.VersionUndefined))
 		if err != nil {
 			if state.IsNotFoundError(err) {
@@ -63,3 +93,73 @@ func AccessPolicy(st state.State) state.FilteringRule {
 		return nil
 	}
 }
+
+// resourceReadRoles is the set of roles which grant the read access to the resources on their own.
+var resourceReadRoles = role.MakeSet(role.Admin, role.Operator, role.Reader)
+
+// checkResourceAccessPolicy enforces the resource access policy of the machine configuration.
+//
+// Each role of the caller is evaluated separately: the role which is not listed in the policy grants the access it grants on its own,
+// and the role which is listed in the policy grants the access only to the resources matching one of its rules.
+func checkResourceAccessPolicy(ctx context.Context, st state.State, roles role.Set, access state.Access, rd *meta.ResourceDefinitionSpec) error {
+	if roles.Includes(role.Admin) {
+		return nil
+	}
+
+	cfg, err := safe.StateGetByID[*config.MachineConfig](ctx, st, config.V1Alpha1ID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if cfg.Config().Machine() == nil {
+		return nil
+	}
+
+	rules := map[role.Role][]talosconfig.ResourceAccessRule{}
+
+	for _, rule := range cfg.Config().Machine().Features().ResourceAccessPolicy() {
+		rules[role.Role(rule.Role())] = append(rules[role.Role(rule.Role())], rule)
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	for _, r := range roles.Strings() {
+		if !resourceReadRoles.Includes(role.Role(r)) {
+			continue
+		}
+
+		roleRules, restricted := rules[role.Role(r)]
+		if !restricted {
+			return nil
+		}
+
+		if slices.ContainsFunc(roleRules, func(rule talosconfig.ResourceAccessRule) bool {
+			return matchesResourceAccessRule(rule, access.ResourceNamespace, rd)
+		}) {
+			return nil
+		}
+	}
+
+	return status.Error(codes.PermissionDenied,
+		fmt.Sprintf("access to %q in namespace %q is not allowed by the resource access policy", access.ResourceType, access.ResourceNamespace))
+}
+
+func matchesResourceAccessRule(rule talosconfig.ResourceAccessRule, namespace resource.Namespace, rd *meta.ResourceDefinitionSpec) bool {
+	if len(rule.Namespaces()) > 0 && !slices.Contains(rule.Namespaces(), namespace) {
+		return false
+	}
+
+	if len(rule.Types()) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(rule.Types(), func(typ string) bool {
+		return strings.EqualFold(typ, rd.Type) || slices.Contains(rd.AllAliases, strings.ToLower(typ))
+	})
+}
@@ -0,0 +1,167 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package resources_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/cosi-project/runtime/pkg/state/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/app/resources"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+func TestAccessPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	require.NoError(t, registry.NewNamespaceRegistry(st).Register(ctx, network.NamespaceName, "network"))
+	require.NoError(t, registry.NewNamespaceRegistry(st).Register(ctx, network.ConfigNamespaceName, "network config"))
+	require.NoError(t, registry.NewResourceRegistry(st).Register(ctx, &network.AddressSpec{}))
+	require.NoError(t, registry.NewResourceRegistry(st).Register(ctx, &network.AddressStatus{}))
+
+	controllerOwned := network.NewAddressSpec(network.ConfigNamespaceName, "configuration/eth0/10.0.0.1/24")
+	require.NoError(t, st.Create(ctx, controllerOwned, state.WithCreateOwner("network.AddressConfigController")))
+
+	apiState := state.WrapCore(resources.WithoutOwner(state.Filter(st, resources.AccessPolicy(st))))
+
+	adminCtx := authz.ContextWithRoles(ctx, role.MakeSet(role.Admin))
+	readerCtx := authz.ContextWithRoles(ctx, role.MakeSet(role.Reader))
+
+	newSpec := func() *network.AddressSpec {
+		spec := network.NewAddressSpec(network.ConfigNamespaceName, "operator/eth0/10.0.0.2/24")
+		spec.TypedSpec().Address = netip.MustParsePrefix("10.0.0.2/24")
+		spec.TypedSpec().LinkName = "eth0"
+		spec.TypedSpec().ConfigLayer = network.ConfigOperator
+
+		return spec
+	}
+
+	// readers can't write
+	assert.Equal(t, codes.PermissionDenied, status.Code(apiState.Create(readerCtx, newSpec())))
+
+	// non-writable resource types and namespaces
+	assert.Equal(t, codes.PermissionDenied, status.Code(apiState.Create(adminCtx, network.NewAddressStatus(network.NamespaceName, "eth0/10.0.0.2/24"))))
+	assert.Equal(t, codes.PermissionDenied, status.Code(apiState.Create(adminCtx, network.NewAddressSpec(network.NamespaceName, "eth0/10.0.0.2/24"))))
+
+	// resources can't be created on behalf of the controllers
+	assert.Equal(t, codes.PermissionDenied, status.Code(apiState.Create(adminCtx, newSpec(), state.WithCreateOwner("network.AddressConfigController"))))
+
+	// create, update and destroy the resource
+	spec := newSpec()
+	require.NoError(t, apiState.Create(adminCtx, spec))
+
+	spec.TypedSpec().LinkName = "eth1"
+	require.NoError(t, apiState.Update(adminCtx, spec))
+
+	// stale version is rejected
+	stale := newSpec()
+	stale.Metadata().SetVersion(spec.Metadata().Version())
+	require.NoError(t, apiState.Update(adminCtx, spec))
+
+	stale.TypedSpec().LinkName = "eth2"
+	assert.True(t, state.IsConflictError(apiState.Update(adminCtx, stale)))
+
+	// finalizers are honored
+	require.NoError(t, st.AddFinalizer(ctx, spec.Metadata(), "external-controller"))
+	assert.Error(t, apiState.Destroy(adminCtx, spec.Metadata()))
+	require.NoError(t, st.RemoveFinalizer(ctx, spec.Metadata(), "external-controller"))
+
+	require.NoError(t, apiState.Destroy(adminCtx, spec.Metadata()))
+
+	// controller-owned resources can't be modified
+	assert.True(t, state.IsOwnerConflictError(apiState.Destroy(adminCtx, controllerOwned.Metadata())))
+	assert.Equal(t, codes.PermissionDenied, status.Code(apiState.Destroy(adminCtx, controllerOwned.Metadata(), state.WithDestroyOwner("network.AddressConfigController"))))
+
+	_, err := st.Get(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.AddressSpecType, controllerOwned.Metadata().ID(), resource.VersionUndefined))
+	require.NoError(t, err)
+}
+
+func TestAccessPolicyResourceAccessRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	newAPIState := func(rules []v1alpha1.ResourceAccessRule) state.State {
+		st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+		require.NoError(t, registry.NewNamespaceRegistry(st).Register(ctx, network.NamespaceName, "network"))
+		require.NoError(t, registry.NewNamespaceRegistry(st).Register(ctx, network.ConfigNamespaceName, "network config"))
+		require.NoError(t, registry.NewResourceRegistry(st).Register(ctx, &network.AddressSpec{}))
+		require.NoError(t, registry.NewResourceRegistry(st).Register(ctx, &network.AddressStatus{}))
+		require.NoError(t, registry.NewResourceRegistry(st).Register(ctx, &network.RouteStatus{}))
+
+		cfg, err := container.New(&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineType: "worker",
+				MachineFeatures: &v1alpha1.FeaturesConfig{
+					ResourceAccessPolicyRules: rules,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, st.Create(ctx, config.NewMachineConfig(cfg)))
+
+		return state.WrapCore(resources.WithoutOwner(state.Filter(st, resources.AccessPolicy(st))))
+	}
+
+	readerRule := v1alpha1.ResourceAccessRule{
+		AccessRole:       string(role.Reader),
+		AccessNamespaces: []string{network.NamespaceName},
+	}
+
+	operatorRule := v1alpha1.ResourceAccessRule{
+		AccessRole:  string(role.Operator),
+		AccessTypes: []string{"routestatus"},
+	}
+
+	policyState := newAPIState([]v1alpha1.ResourceAccessRule{readerRule, operatorRule})
+	readerPolicyState := newAPIState([]v1alpha1.ResourceAccessRule{readerRule})
+
+	for _, test := range []struct {
+		name      string
+		apiState  state.State
+		roles     role.Set
+		namespace resource.Namespace
+		typ       resource.Type
+		expected  codes.Code
+	}{
+		{"reader allowed namespace", policyState, role.MakeSet(role.Reader), network.NamespaceName, network.AddressStatusType, codes.OK},
+		{"reader other namespace", policyState, role.MakeSet(role.Reader), network.ConfigNamespaceName, network.AddressSpecType, codes.PermissionDenied},
+		{"operator allowed type", policyState, role.MakeSet(role.Operator), network.NamespaceName, network.RouteStatusType, codes.OK},
+		{"operator other type", policyState, role.MakeSet(role.Operator), network.NamespaceName, network.AddressStatusType, codes.PermissionDenied},
+		{"restricted roles", policyState, role.MakeSet(role.Reader, role.Operator), network.ConfigNamespaceName, network.AddressSpecType, codes.PermissionDenied},
+		{"restricted roles union", policyState, role.MakeSet(role.Reader, role.Operator), network.NamespaceName, network.AddressStatusType, codes.OK},
+		{"unlisted role without resource access", policyState, role.MakeSet(role.Reader, role.EtcdBackup), network.ConfigNamespaceName, network.AddressSpecType, codes.PermissionDenied},
+		{"unlisted role without resource access allowed namespace", policyState, role.MakeSet(role.Reader, role.EtcdBackup), network.NamespaceName, network.AddressStatusType, codes.OK},
+		{"unlisted role with resource access", readerPolicyState, role.MakeSet(role.Reader, role.Operator), network.ConfigNamespaceName, network.AddressSpecType, codes.OK},
+		{"unlisted role alone", readerPolicyState, role.MakeSet(role.Operator), network.ConfigNamespaceName, network.AddressSpecType, codes.OK},
+		{"admin", policyState, role.MakeSet(role.Admin), network.ConfigNamespaceName, network.AddressSpecType, codes.OK},
+	} {
+		_, err := test.apiState.List(authz.ContextWithRoles(ctx, test.roles), resource.NewMetadata(test.namespace, test.typ, "", resource.VersionUndefined))
+
+		assert.Equal(t, test.expected, status.Code(err), test.name)
+	}
+}
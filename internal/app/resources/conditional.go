@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package resources
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// WithConditionalGet wraps the state to support the version-conditional Get.
+//
+// If the client submits the resource version it already has in the gRPC metadata, and the version matches,
+// the resource is not returned, and the NotModified error is returned instead.
+// This allows the clients polling the resources to avoid transferring the resources which haven't changed.
+func WithConditionalGet(st state.CoreState) state.CoreState {
+	return &conditionalGet{CoreState: st}
+}
+
+type conditionalGet struct {
+	state.CoreState
+}
+
+// Get implements state.CoreState interface.
+func (st *conditionalGet) Get(ctx context.Context, resourcePointer resource.Pointer, opts ...state.GetOption) (resource.Resource, error) {
+	r, err := st.CoreState.Get(ctx, resourcePointer, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if version := r.Metadata().Version().String(); slices.Contains(md.Get(constants.ResourceIfNoneMatchMetadataKey), version) {
+		return nil, &client.NotModifiedError{Version: version}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package resources_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/app/resources"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestConditionalGet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	spec := network.NewAddressStatus(network.NamespaceName, "eth0/10.0.0.1/24")
+	require.NoError(t, st.Create(ctx, spec))
+
+	apiState := state.WrapCore(resources.WithConditionalGet(st))
+
+	withVersion := func(version string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(constants.ResourceIfNoneMatchMetadataKey, version))
+	}
+
+	// no version submitted
+	r, err := apiState.Get(ctx, spec.Metadata())
+	require.NoError(t, err)
+
+	version := r.Metadata().Version().String()
+
+	// version matches
+	_, err = apiState.Get(withVersion(version), spec.Metadata())
+	require.Error(t, err)
+	assert.True(t, client.IsNotModified(err))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// the status survives the round-trip over gRPC
+	assert.True(t, client.IsNotModified(status.ErrorProto(status.Convert(err).Proto())))
+
+	// version doesn't match
+	_, err = safe.StateUpdateWithConflicts(ctx, st, spec.Metadata(), func(r *network.AddressStatus) error {
+		r.TypedSpec().LinkName = "eth0"
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	r, err = apiState.Get(withVersion(version), spec.Metadata())
+	require.NoError(t, err)
+	assert.NotEqual(t, version, r.Metadata().Version().String())
+
+	// not found errors are returned as is
+	_, err = apiState.Get(withVersion(version), network.NewAddressStatus(network.NamespaceName, "eth1/10.0.0.2/24").Metadata())
+	assert.True(t, state.IsNotFoundError(err))
+}
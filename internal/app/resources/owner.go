@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package resources
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithoutOwner wraps the state to make sure that the resources modified via the API are never owned by the controllers.
+//
+// The API clients can't impersonate the controllers, so the resources managed by the controllers can't be modified,
+// and the resources created via the API are not touched by the controllers.
+func WithoutOwner(st state.CoreState) state.CoreState {
+	return &withoutOwner{CoreState: st}
+}
+
+type withoutOwner struct {
+	state.CoreState
+}
+
+var errOwnerNotAllowed = status.Error(codes.PermissionDenied, "resource owner can't be set via the API")
+
+// Create implements state.CoreState interface.
+func (st *withoutOwner) Create(ctx context.Context, res resource.Resource, opts ...state.CreateOption) error {
+	var options state.CreateOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.Owner != "" {
+		return errOwnerNotAllowed
+	}
+
+	return st.CoreState.Create(ctx, res, opts...)
+}
+
+// Update implements state.CoreState interface.
+func (st *withoutOwner) Update(ctx context.Context, newResource resource.Resource, opts ...state.UpdateOption) error {
+	options := state.DefaultUpdateOptions()
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.Owner != "" {
+		return errOwnerNotAllowed
+	}
+
+	return st.CoreState.Update(ctx, newResource, opts...)
+}
+
+// Destroy implements state.CoreState interface.
+func (st *withoutOwner) Destroy(ctx context.Context, resourcePointer resource.Pointer, opts ...state.DestroyOption) error {
+	var options state.DestroyOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.Owner != "" {
+		return errOwnerNotAllowed
+	}
+
+	return st.CoreState.Destroy(ctx, resourcePointer, opts...)
+}
@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package resources
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// WithListSorting wraps the state to support sorting the resource List by the metadata fields.
+//
+// If the client submits the field to sort by in the gRPC metadata, the resources are returned sorted by this field,
+// with the resource ID used to order the resources with equal field values.
+func WithListSorting(st state.CoreState) state.CoreState {
+	return &listSorting{CoreState: st}
+}
+
+type listSorting struct {
+	state.CoreState
+}
+
+// List implements state.CoreState interface.
+func (st *listSorting) List(ctx context.Context, kind resource.Kind, opts ...state.ListOption) (resource.List, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	sortBy := md.Get(constants.ResourceListSortMetadataKey)
+	if len(sortBy) == 0 {
+		return st.CoreState.List(ctx, kind, opts...)
+	}
+
+	compare, err := listSortCompare(sortBy[len(sortBy)-1])
+	if err != nil {
+		return resource.List{}, err
+	}
+
+	list, err := st.CoreState.List(ctx, kind, opts...)
+	if err != nil {
+		return resource.List{}, err
+	}
+
+	slices.SortStableFunc(list.Items, func(a, b resource.Resource) int {
+		return cmp.Or(
+			compare(a.Metadata(), b.Metadata()),
+			cmp.Compare(a.Metadata().ID(), b.Metadata().ID()),
+		)
+	})
+
+	return list, nil
+}
+
+func listSortCompare(field string) (func(a, b *resource.Metadata) int, error) {
+	field, descending := strings.CutPrefix(field, "-")
+
+	var compare func(a, b *resource.Metadata) int
+
+	switch field {
+	case "id":
+		compare = func(a, b *resource.Metadata) int { return cmp.Compare(a.ID(), b.ID()) }
+	case "version":
+		compare = func(a, b *resource.Metadata) int { return cmp.Compare(a.Version().Value(), b.Version().Value()) }
+	case "updated":
+		compare = func(a, b *resource.Metadata) int { return a.Updated().Compare(b.Updated()) }
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported resource list sort field %q", field)
+	}
+
+	if descending {
+		return func(a, b *resource.Metadata) int { return compare(b, a) }, nil
+	}
+
+	return compare, nil
+}
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package resources_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/app/resources"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestListSorting(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for _, id := range []string{"eth1/10.0.0.2/24", "eth0/10.0.0.1/24", "eth2/10.0.0.3/24"} {
+		require.NoError(t, st.Create(ctx, network.NewAddressStatus(network.NamespaceName, id)))
+	}
+
+	// bump the version of eth1 twice and of eth2 once
+	for _, id := range []string{"eth1/10.0.0.2/24", "eth1/10.0.0.2/24", "eth2/10.0.0.3/24"} {
+		_, err := safe.StateUpdateWithConflicts(ctx, st, network.NewAddressStatus(network.NamespaceName, id).Metadata(), func(r *network.AddressStatus) error {
+			r.TypedSpec().LinkIndex++
+
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	apiState := state.WrapCore(resources.WithListSorting(st))
+
+	kind := resource.NewMetadata(network.NamespaceName, network.AddressStatusType, "", resource.VersionUndefined)
+
+	for _, test := range []struct {
+		name   string
+		sortBy string
+
+		expectedIDs  []string
+		expectedCode codes.Code
+	}{
+		{
+			name: "default",
+
+			expectedIDs: []string{"eth0/10.0.0.1/24", "eth1/10.0.0.2/24", "eth2/10.0.0.3/24"},
+		},
+		{
+			name:   "id descending",
+			sortBy: "-id",
+
+			expectedIDs: []string{"eth2/10.0.0.3/24", "eth1/10.0.0.2/24", "eth0/10.0.0.1/24"},
+		},
+		{
+			name:   "version",
+			sortBy: "version",
+
+			expectedIDs: []string{"eth0/10.0.0.1/24", "eth2/10.0.0.3/24", "eth1/10.0.0.2/24"},
+		},
+		{
+			name:   "version descending",
+			sortBy: "-version",
+
+			expectedIDs: []string{"eth1/10.0.0.2/24", "eth2/10.0.0.3/24", "eth0/10.0.0.1/24"},
+		},
+		{
+			name:   "unsupported field",
+			sortBy: "spec",
+
+			expectedCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			listCtx := ctx
+
+			if test.sortBy != "" {
+				listCtx = metadata.NewIncomingContext(ctx, metadata.Pairs(constants.ResourceListSortMetadataKey, test.sortBy))
+			}
+
+			list, err := apiState.List(listCtx, kind)
+
+			if test.expectedCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedCode, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedIDs, xslices.Map(list.Items, func(r resource.Resource) string { return r.Metadata().ID() }))
+		})
+	}
+}
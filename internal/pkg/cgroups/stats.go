@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cgroups
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// Stats is the resource usage of the cgroup.
+//
+// All the counters are cumulative.
+type Stats struct {
+	// Path of the cgroup relative to the cgroup root, e.g. /system/runtime.
+	Path string
+
+	CPUUsageUsec  uint64
+	MemoryCurrent uint64
+	IOReadBytes   uint64
+	IOWriteBytes  uint64
+	PidsCurrent   uint64
+}
+
+// statsFiles are the cgroup files required to build the Stats.
+var statsFiles = []string{
+	"cpu.stat",
+	"io.stat",
+	"memory.current",
+	"pids.current",
+}
+
+// ReadStats reads the resource usage of the cgroup and its descendants up to the depth.
+//
+// The cgroupPath is relative to the cgroup root (e.g. /sys/fs/cgroup), depth zero reads only the cgroup itself.
+func ReadStats(root, cgroupPath string, depth int) ([]Stats, error) {
+	cgroupPath = path.Clean("/" + cgroupPath)
+
+	var stats []Stats
+
+	if err := readStats(root, cgroupPath, depth, &stats); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func readStats(root, cgroupPath string, depth int, stats *[]Stats) error {
+	dir := filepath.Join(root, filepath.FromSlash(cgroupPath))
+
+	node := &Node{}
+
+	for _, filename := range statsFiles {
+		if err := parseFile(node, dir, filename); err != nil {
+			return err
+		}
+	}
+
+	*stats = append(*stats, node.stats(cgroupPath))
+
+	if depth == 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		err = readStats(root, path.Join(cgroupPath, entry.Name()), depth-1, stats)
+
+		// the cgroup might be removed while walking the tree
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseFile(node *Node, dir, filename string) error {
+	f, err := os.Open(filepath.Join(dir, filename))
+	if err != nil {
+		// not all the controllers are enabled for each cgroup (e.g. memory.current is missing for the root cgroup)
+		if errors.Is(err, fs.ErrNotExist) {
+			if _, statErr := os.Stat(dir); statErr != nil {
+				return statErr
+			}
+
+			return nil
+		}
+
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	if err = node.Parse(filename, f); err != nil {
+		return fmt.Errorf("failed to parse %q: %w", filepath.Join(dir, filename), err)
+	}
+
+	return nil
+}
+
+func (n *Node) stats(cgroupPath string) Stats {
+	stats := Stats{
+		Path:          cgroupPath,
+		CPUUsageUsec:  counter(n.CPUStat["usage_usec"]),
+		MemoryCurrent: counter(n.MemoryCurrent),
+		PidsCurrent:   counter(n.PidsCurrent),
+	}
+
+	for _, device := range n.IOStat {
+		stats.IOReadBytes += counter(device["rbytes"])
+		stats.IOWriteBytes += counter(device["wbytes"])
+	}
+
+	return stats
+}
+
+func counter(v Value) uint64 {
+	if !v.IsSet || v.IsMax || v.Val < 0 {
+		return 0
+	}
+
+	return uint64(v.Val)
+}
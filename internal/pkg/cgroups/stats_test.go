@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cgroups_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/cgroups"
+)
+
+func writeCgroupFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	for name, contents := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644))
+	}
+}
+
+func TestReadStats(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	writeCgroupFiles(t, root, map[string]string{
+		"cpu.stat": "usage_usec 1000\nuser_usec 600\nsystem_usec 400\n",
+		"io.stat":  "8:0 rbytes=100 wbytes=200 rios=1 wios=2 dbytes=0 dios=0\n",
+	})
+
+	writeCgroupFiles(t, filepath.Join(root, "system"), map[string]string{
+		"cpu.stat":       "usage_usec 500\n",
+		"io.stat":        "8:0 rbytes=10 wbytes=20 rios=1 wios=2 dbytes=0 dios=0\n8:16 rbytes=1 wbytes=2 rios=1 wios=2 dbytes=0 dios=0\n",
+		"memory.current": "4096\n",
+		"pids.current":   "5\n",
+	})
+
+	writeCgroupFiles(t, filepath.Join(root, "system", "runtime"), map[string]string{
+		"cpu.stat":       "usage_usec 300\n",
+		"memory.current": "1024\n",
+		"pids.current":   "2\n",
+	})
+
+	stats, err := cgroups.ReadStats(root, "/", 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, []cgroups.Stats{
+		{Path: "/", CPUUsageUsec: 1000, IOReadBytes: 100, IOWriteBytes: 200},
+		{Path: "/system", CPUUsageUsec: 500, MemoryCurrent: 4096, IOReadBytes: 11, IOWriteBytes: 22, PidsCurrent: 5},
+		{Path: "/system/runtime", CPUUsageUsec: 300, MemoryCurrent: 1024, PidsCurrent: 2},
+	}, stats)
+
+	stats, err = cgroups.ReadStats(root, "system", 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, []cgroups.Stats{
+		{Path: "/system", CPUUsageUsec: 500, MemoryCurrent: 4096, IOReadBytes: 11, IOWriteBytes: 22, PidsCurrent: 5},
+	}, stats)
+
+	_, err = cgroups.ReadStats(root, "missing", 0)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
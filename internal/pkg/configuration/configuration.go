@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/siderolabs/gen/xslices"
@@ -19,9 +20,11 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
 	v1alpha1machine "github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
@@ -45,6 +48,7 @@ func Generate(ctx context.Context, in *machine.GenerateConfigurationRequest) (re
 		if in.MachineConfig.NetworkConfig != nil {
 			networkConfig := &v1alpha1.NetworkConfig{
 				NetworkHostname: in.MachineConfig.NetworkConfig.Hostname,
+				NameServers:     in.MachineConfig.NetworkConfig.Nameservers,
 			}
 
 			networkInterfaces := in.MachineConfig.NetworkConfig.Interfaces
@@ -52,28 +56,7 @@ func Generate(ctx context.Context, in *machine.GenerateConfigurationRequest) (re
 				networkConfig.NetworkInterfaces = make([]*v1alpha1.Device, len(networkInterfaces))
 
 				for i, device := range networkInterfaces {
-					iface := &v1alpha1.Device{
-						DeviceInterface: device.Interface,
-						DeviceMTU:       int(device.Mtu),
-						DeviceCIDR:      device.Cidr,
-						DeviceDHCP:      pointer.To(device.Dhcp),
-						DeviceIgnore:    pointer.To(device.Ignore),
-						DeviceRoutes: xslices.Map(device.Routes, func(route *machine.RouteConfig) *v1alpha1.Route {
-							return &v1alpha1.Route{
-								RouteNetwork: route.Network,
-								RouteGateway: route.Gateway,
-								RouteMetric:  route.Metric,
-							}
-						}),
-					}
-
-					if device.DhcpOptions != nil {
-						iface.DeviceDHCPOptions = &v1alpha1.DHCPOptions{
-							DHCPRouteMetric: device.DhcpOptions.RouteMetric,
-						}
-					}
-
-					networkConfig.NetworkInterfaces[i] = iface
+					networkConfig.NetworkInterfaces[i] = NetworkDeviceFromAPI(device)
 				}
 			}
 
@@ -88,6 +71,21 @@ func Generate(ctx context.Context, in *machine.GenerateConfigurationRequest) (re
 			if in.MachineConfig.InstallConfig.InstallImage != "" {
 				options = append(options, generate.WithInstallImage(in.MachineConfig.InstallConfig.InstallImage))
 			}
+
+			options = append(options, generate.WithInstallWipe(in.MachineConfig.InstallConfig.Wipe))
+		}
+
+		if len(in.MachineConfig.DataDisks) > 0 {
+			options = append(options, generate.WithUserDisks(xslices.Map(in.MachineConfig.DataDisks, func(disk *machine.DataDiskConfig) *v1alpha1.MachineDisk {
+				return &v1alpha1.MachineDisk{
+					DeviceName: disk.Device,
+					DiskPartitions: []*v1alpha1.DiskPartition{
+						{
+							DiskMountPoint: disk.MountPoint,
+						},
+					},
+				}
+			})))
 		}
 
 		if in.ClusterConfig.ClusterNetwork != nil {
@@ -105,6 +103,16 @@ func Generate(ctx context.Context, in *machine.GenerateConfigurationRequest) (re
 
 		options = append(options, generate.WithAllowSchedulingOnControlPlanes(in.ClusterConfig.AllowSchedulingOnControlPlanes))
 
+		endpoint, err := url.Parse(in.ClusterConfig.ControlPlane.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options,
+			generate.WithEndpointList([]string{endpoint.Hostname()}),
+			generate.WithAdditionalControlPlaneEndpoints(in.ClusterConfig.ControlPlane.AdditionalEndpoints),
+		)
+
 		var (
 			input         *generate.Input
 			cfgBytes      []byte
@@ -150,23 +158,34 @@ func Generate(ctx context.Context, in *machine.GenerateConfigurationRequest) (re
 			return nil, err
 		}
 
-		cfgBytes, err = c.Bytes()
+		ephemeralMaxSize := in.MachineConfig.GetInstallConfig().GetEphemeralMaxSize()
+
+		c, err = withEphemeralMaxSize(c, ephemeralMaxSize)
 		if err != nil {
 			return nil, err
 		}
 
-		talosconfig, err := input.Talosconfig()
+		cfgBytes, err = c.Bytes()
 		if err != nil {
 			return nil, err
 		}
 
-		endpoint, err := url.Parse(in.ClusterConfig.ControlPlane.Endpoint)
-		if err != nil {
-			return nil, err
+		data := [][]byte{cfgBytes}
+
+		for _, machinePool := range in.MachinePools {
+			var poolBytes []byte
+
+			poolBytes, err = generatePoolConfig(input, machinePool, ephemeralMaxSize)
+			if err != nil {
+				return nil, err
+			}
+
+			data = append(data, poolBytes)
 		}
 
-		talosconfig.Contexts[talosconfig.Context].Endpoints = []string{
-			endpoint.Hostname(),
+		talosconfig, err := input.Talosconfig()
+		if err != nil {
+			return nil, err
 		}
 
 		taloscfgBytes, err = talosconfig.Bytes()
@@ -177,7 +196,7 @@ func Generate(ctx context.Context, in *machine.GenerateConfigurationRequest) (re
 		reply = &machine.GenerateConfigurationResponse{
 			Messages: []*machine.GenerateConfiguration{
 				{
-					Data:        [][]byte{cfgBytes},
+					Data:        data,
 					Talosconfig: taloscfgBytes,
 				},
 			},
@@ -188,3 +207,120 @@ func Generate(ctx context.Context, in *machine.GenerateConfigurationRequest) (re
 
 	return reply, nil
 }
+
+// NetworkDeviceFromAPI converts the network device config of the GenerateConfiguration API to the v1alpha1 network device.
+func NetworkDeviceFromAPI(device *machine.NetworkDeviceConfig) *v1alpha1.Device {
+	iface := &v1alpha1.Device{
+		DeviceInterface: device.Interface,
+		DeviceMTU:       int(device.Mtu),
+		DeviceCIDR:      device.Cidr,
+		DeviceDHCP:      pointer.To(device.Dhcp),
+		DeviceIgnore:    pointer.To(device.Ignore),
+		DeviceRoutes: xslices.Map(device.Routes, func(route *machine.RouteConfig) *v1alpha1.Route {
+			return &v1alpha1.Route{
+				RouteNetwork: route.Network,
+				RouteGateway: route.Gateway,
+				RouteMetric:  route.Metric,
+			}
+		}),
+	}
+
+	if device.DhcpOptions != nil {
+		iface.DeviceDHCPOptions = &v1alpha1.DHCPOptions{
+			DHCPRouteMetric: device.DhcpOptions.RouteMetric,
+		}
+	}
+
+	if len(device.Vlans) > 0 {
+		iface.DeviceVlans = xslices.Map(device.Vlans, func(vlan *machine.VLANConfig) *v1alpha1.Vlan {
+			return &v1alpha1.Vlan{
+				VlanID:   uint16(vlan.VlanId),
+				VlanCIDR: vlan.Cidr,
+				VlanDHCP: pointer.To(vlan.Dhcp),
+				VlanMTU:  vlan.Mtu,
+			}
+		})
+	}
+
+	if device.Bond != nil {
+		iface.DeviceBond = &v1alpha1.Bond{
+			BondInterfaces: device.Bond.Interfaces,
+			BondMode:       device.Bond.Mode,
+			BondHashPolicy: device.Bond.HashPolicy,
+		}
+	}
+
+	return iface
+}
+
+// generatePoolConfig generates the worker config for the machine pool.
+func generatePoolConfig(input *generate.Input, machinePool *machine.MachinePoolConfig, ephemeralMaxSize string) ([]byte, error) {
+	pool := generate.MachinePool{
+		Name:        machinePool.Name,
+		InstallDisk: machinePool.InstallDisk,
+	}
+
+	var err error
+
+	if pool.NodeLabels, err = parseKeyValues(machinePool.NodeLabels); err != nil {
+		return nil, fmt.Errorf("machine pool %q: invalid node labels: %w", machinePool.Name, err)
+	}
+
+	if pool.NodeTaints, err = parseKeyValues(machinePool.NodeTaints); err != nil {
+		return nil, fmt.Errorf("machine pool %q: invalid node taints: %w", machinePool.Name, err)
+	}
+
+	if pool.KubeletExtraArgs, err = parseKeyValues(machinePool.KubeletExtraArgs); err != nil {
+		return nil, fmt.Errorf("machine pool %q: invalid kubelet extra args: %w", machinePool.Name, err)
+	}
+
+	cfg, err := input.PoolConfig(pool)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err = withEphemeralMaxSize(cfg, ephemeralMaxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg.Bytes()
+}
+
+// withEphemeralMaxSize appends the EPHEMERAL volume config document limiting the volume size.
+//
+// If the size is empty, the config is returned as is.
+func withEphemeralMaxSize(cfg config.Provider, maxSize string) (config.Provider, error) {
+	if maxSize == "" {
+		return cfg, nil
+	}
+
+	volumeConfig := block.NewVolumeConfigV1Alpha1()
+	volumeConfig.MetaName = constants.EphemeralPartitionLabel
+
+	if err := volumeConfig.ProvisioningSpec.ProvisioningMaxSize.UnmarshalText([]byte(maxSize)); err != nil {
+		return nil, fmt.Errorf("invalid EPHEMERAL max size %q: %w", maxSize, err)
+	}
+
+	return container.New(append(cfg.Documents(), volumeConfig)...)
+}
+
+// parseKeyValues parses the list of key=value pairs.
+func parseKeyValues(values []string) (map[string]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]string, len(values))
+
+	for _, value := range values {
+		key, val, ok := strings.Cut(value, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("expected key=value, got %q", value)
+		}
+
+		result[key] = val
+	}
+
+	return result, nil
+}
@@ -12,6 +12,7 @@ import (
 
 	containerd "github.com/containerd/containerd/v2/client"
 	"github.com/containerd/containerd/v2/core/images"
+	"github.com/containerd/containerd/v2/core/remotes/docker"
 	"github.com/containerd/errdefs"
 	"github.com/distribution/reference"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
@@ -41,6 +42,7 @@ type PullOption func(*PullOptions)
 // PullOptions configure Pull function.
 type PullOptions struct {
 	SkipIfAlreadyPulled bool
+	RateLimit           uint64
 }
 
 // WithSkipIfAlreadyPulled skips pulling if image is already pulled and unpacked.
@@ -50,6 +52,15 @@ func WithSkipIfAlreadyPulled() PullOption {
 	}
 }
 
+// WithRateLimit limits the download rate of the image pull (in bytes per second).
+//
+// Zero value means no limit.
+func WithRateLimit(bytesPerSecond uint64) PullOption {
+	return func(opts *PullOptions) {
+		opts.RateLimit = bytesPerSecond
+	}
+}
+
 // Pull is a convenience function that wraps the containerd image pull func with
 // retry functionality.
 //
@@ -85,6 +96,12 @@ func Pull(ctx context.Context, reg config.Registries, client *containerd.Client,
 
 	resolver := NewResolver(reg)
 
+	if opts.RateLimit > 0 {
+		resolver = docker.NewResolver(docker.ResolverOptions{
+			Hosts: RateLimitedRegistryHosts(RegistryHosts(reg), opts.RateLimit),
+		})
+	}
+
 	err = retry.Exponential(PullTimeout, retry.WithUnits(PullRetryInterval), retry.WithErrorLogging(true)).Retry(func() error {
 		if img, err = client.Pull(
 			ctx,
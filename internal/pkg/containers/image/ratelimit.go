@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/containerd/containerd/v2/core/remotes/docker"
+	"golang.org/x/time/rate"
+)
+
+// minRateLimitBurst is the minimum burst size for the rate limiter, so that small limits still allow reasonable reads.
+const minRateLimitBurst = 32 * 1024
+
+// newRateLimiter creates a limiter which allows bytesPerSecond on average.
+func newRateLimiter(bytesPerSecond uint64) *rate.Limiter {
+	burst := max(int(min(bytesPerSecond, uint64(1<<30))), minRateLimitBurst)
+
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), burst)
+}
+
+// RateLimitedRegistryHosts wraps registry hosts so that all responses are read at most at bytesPerSecond rate.
+//
+// The limit is shared across all hosts and requests, so it applies to the pull as a whole.
+func RateLimitedRegistryHosts(hosts docker.RegistryHosts, bytesPerSecond uint64) docker.RegistryHosts {
+	limiter := newRateLimiter(bytesPerSecond)
+
+	return func(host string) ([]docker.RegistryHost, error) {
+		registries, err := hosts(host)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range registries {
+			client := *registries[i].Client
+
+			transport := client.Transport
+			if transport == nil {
+				transport = http.DefaultTransport
+			}
+
+			client.Transport = &rateLimitedTransport{
+				transport: transport,
+				limiter:   limiter,
+			}
+
+			registries[i].Client = &client
+		}
+
+		return registries, nil
+	}
+}
+
+type rateLimitedTransport struct {
+	transport http.RoundTripper
+	limiter   *rate.Limiter
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = &rateLimitedReader{
+		ReadCloser: resp.Body,
+		req:        req,
+		limiter:    t.limiter,
+	}
+
+	return resp, nil
+}
+
+type rateLimitedReader struct {
+	io.ReadCloser
+
+	req     *http.Request
+	limiter *rate.Limiter
+}
+
+func (r *rateLimitedReader) Read(p []byte) (int, error) {
+	if len(p) > r.limiter.Burst() {
+		p = p[:r.limiter.Burst()]
+	}
+
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		if waitErr := r.limiter.WaitN(r.req.Context(), n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+
+	return n, err
+}
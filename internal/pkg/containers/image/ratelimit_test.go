@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd/v2/core/remotes/docker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
+)
+
+func TestRateLimitedRegistryHosts(t *testing.T) {
+	t.Parallel()
+
+	const size = 96 * 1024
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(bytes.Repeat([]byte{0xaa}, size)) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	hosts := image.RateLimitedRegistryHosts(func(string) ([]docker.RegistryHost, error) {
+		return []docker.RegistryHost{
+			{
+				Client: srv.Client(),
+				Host:   srv.Listener.Addr().String(),
+			},
+		}, nil
+	}, 64*1024)
+
+	registries, err := hosts("example.com")
+	require.NoError(t, err)
+	require.Len(t, registries, 1)
+
+	start := time.Now()
+
+	resp, err := registries[0].Client.Get(srv.URL)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { resp.Body.Close() }) //nolint:errcheck
+
+	n, err := io.Copy(io.Discard, resp.Body)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, size, n)
+
+	// first 64 KiB are allowed by the burst, the rest should take ~0.5s
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
@@ -23,6 +23,7 @@ type Node struct {
 	DiskStats   *machine.DiskStats
 	Processes   *machine.Process
 	ServiceList *machine.ServiceList
+	EtcdStatus  *machine.EtcdStatus
 
 	// These fields are calculated as diff with Node data from previous pol.
 	SystemStatDiff  *machine.SystemStat
@@ -282,6 +282,32 @@ func (source *Source) gather() *Data {
 				result.Nodes[node].ServiceList = msg
 			}
 
+			return nil
+		},
+		func() error {
+			resp, err := source.MachineClient.EtcdStatus(source.ctx, &emptypb.Empty{})
+			if err != nil {
+				return err
+			}
+
+			resultLock.Lock()
+			defer resultLock.Unlock()
+
+			for _, msg := range resp.GetMessages() {
+				// etcd is not running on the worker nodes
+				if msg.GetMetadata().GetError() != "" {
+					continue
+				}
+
+				node := source.node(msg)
+
+				if _, ok := result.Nodes[node]; !ok {
+					result.Nodes[node] = &Node{}
+				}
+
+				result.Nodes[node].EtcdStatus = msg
+			}
+
 			return nil
 		},
 	}
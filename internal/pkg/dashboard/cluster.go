@@ -0,0 +1,201 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dashboard
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/components"
+	"github.com/siderolabs/talos/pkg/images"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+const clusterHelp = "[::b]r[::-] reboot node   [::b]u[::-] upgrade node"
+
+// ClusterGrid represents the cluster overview grid with all nodes, their etcd status, and the events.
+type ClusterGrid struct {
+	tview.Grid
+
+	ctx       context.Context //nolint:containedctx
+	dashboard *Dashboard
+
+	table  *components.ClusterTable
+	events *components.EventViewer
+	status *tview.TextView
+
+	selectedNode string
+	active       bool
+}
+
+// NewClusterGrid initializes ClusterGrid.
+func NewClusterGrid(ctx context.Context, dashboard *Dashboard) *ClusterGrid {
+	widget := &ClusterGrid{
+		Grid:      *tview.NewGrid(),
+		ctx:       ctx,
+		dashboard: dashboard,
+		table:     components.NewClusterTable(dashboard.nodes),
+		events:    components.NewEventViewer(),
+		status:    tview.NewTextView().SetDynamicColors(true).SetText(clusterHelp),
+	}
+
+	widget.status.SetBorderPadding(0, 0, 1, 1)
+
+	widget.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 'r':
+			widget.showRebootModal()
+
+			return nil
+		case 'u':
+			widget.showUpgradeModal()
+
+			return nil
+		}
+
+		return event
+	})
+
+	widget.SetRows(len(dashboard.nodes)+2, 0, 1).SetColumns(0)
+
+	widget.AddItem(widget.table, 0, 0, 1, 1, 0, 0, false)
+	widget.AddItem(widget.events, 1, 0, 1, 1, 0, 0, false)
+	widget.AddItem(widget.status, 2, 0, 1, 1, 0, 0, false)
+
+	return widget
+}
+
+// OnScreenSelect implements the screenSelectListener interface.
+func (widget *ClusterGrid) onScreenSelect(active bool) {
+	widget.active = active
+
+	if active {
+		widget.dashboard.app.SetFocus(widget.table)
+	} else {
+		widget.closeModal()
+	}
+}
+
+// OnNodeSelect implements the NodeSelectListener interface.
+func (widget *ClusterGrid) OnNodeSelect(node string) {
+	widget.selectedNode = node
+
+	widget.table.OnNodeSelect(node)
+}
+
+// OnAPIDataChange implements the APIDataListener interface.
+func (widget *ClusterGrid) OnAPIDataChange(node string, data *apidata.Data) {
+	widget.table.OnAPIDataChange(node, data)
+}
+
+// OnEventDataChange implements the EventDataListener interface.
+func (widget *ClusterGrid) OnEventDataChange(node, event, eventError string) {
+	widget.events.WriteEvent(node, event, eventError)
+}
+
+func (widget *ClusterGrid) showRebootModal() {
+	node := widget.selectedNode
+
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Reboot node %s?", widget.nodeName(node))).
+		AddButtons([]string{"Reboot", "Cancel"}).
+		SetDoneFunc(func(_ int, buttonLabel string) {
+			widget.closeModal()
+
+			if buttonLabel != "Reboot" {
+				return
+			}
+
+			widget.runAction(node, "reboot", func(ctx context.Context) error {
+				return widget.dashboard.cli.Reboot(ctx)
+			})
+		})
+
+	widget.dashboard.pages.AddPage(pageAction, modal, true, true)
+	widget.dashboard.app.SetFocus(modal)
+}
+
+func (widget *ClusterGrid) showUpgradeModal() {
+	node := widget.selectedNode
+
+	form := tview.NewForm().
+		AddInputField("Image", images.DefaultInstallerImage, 0, nil, nil).
+		AddCheckbox("Stage", false, nil)
+
+	form.AddButton("Upgrade", func() {
+		image := form.GetFormItemByLabel("Image").(*tview.InputField).GetText() //nolint:forcetypeassert
+		stage := form.GetFormItemByLabel("Stage").(*tview.Checkbox).IsChecked() //nolint:forcetypeassert
+
+		if image == "" {
+			return
+		}
+
+		widget.closeModal()
+
+		widget.runAction(node, "upgrade", func(ctx context.Context) error {
+			_, err := widget.dashboard.cli.UpgradeWithOptions(ctx,
+				client.WithUpgradeImage(image),
+				client.WithUpgradeStage(stage),
+			)
+
+			return err
+		})
+	}).
+		AddButton("Cancel", widget.closeModal).
+		SetCancelFunc(widget.closeModal)
+
+	form.SetBorder(true).SetTitle(fmt.Sprintf(" Upgrade node %s ", widget.nodeName(node)))
+
+	modal := tview.NewGrid().
+		SetRows(0, 9, 0).
+		SetColumns(0, 80, 0).
+		AddItem(form, 1, 1, 1, 1, 0, 0, true)
+
+	widget.dashboard.pages.AddPage(pageAction, modal, true, true)
+	widget.dashboard.app.SetFocus(form)
+}
+
+func (widget *ClusterGrid) closeModal() {
+	if !widget.dashboard.pages.HasPage(pageAction) {
+		return
+	}
+
+	widget.dashboard.pages.RemovePage(pageAction)
+
+	if widget.active {
+		widget.dashboard.app.SetFocus(widget.table)
+	}
+}
+
+// runAction runs the node action in the background and reports the result in the status line.
+func (widget *ClusterGrid) runAction(node, action string, f func(ctx context.Context) error) {
+	widget.status.SetText(fmt.Sprintf("[yellow]%s of %s requested...[-]", action, tview.Escape(widget.nodeName(node))))
+
+	go func() {
+		err := f(nodeContext(widget.ctx, node))
+
+		widget.dashboard.app.QueueUpdateDraw(func() {
+			if err != nil {
+				widget.status.SetText(fmt.Sprintf("[red]%s of %s failed: %s[-]", action, tview.Escape(widget.nodeName(node)), tview.Escape(err.Error())))
+
+				return
+			}
+
+			widget.status.SetText(fmt.Sprintf("[green]%s of %s started[-]   %s", action, tview.Escape(widget.nodeName(node)), clusterHelp))
+		})
+	}()
+}
+
+func (widget *ClusterGrid) nodeName(node string) string {
+	if node == "" {
+		return "(local)"
+	}
+
+	return node
+}
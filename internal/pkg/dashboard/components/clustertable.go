@@ -0,0 +1,206 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// sectorSize is the size of the disk sector reported in the disk stats.
+const sectorSize = 512
+
+var clusterTableHeader = []string{"NODE", "VERSION", "CPU", "MEM", "DISK R/W", "SERVICES", "ETCD"}
+
+// ClusterTable represents the widget with the overview of all nodes of the cluster.
+type ClusterTable struct {
+	tview.Table
+
+	nodes        []string
+	selectedNode string
+	data         *apidata.Data
+}
+
+// NewClusterTable initializes ClusterTable.
+//
+// Nodes are listed in the order of the node navigation.
+func NewClusterTable(nodes []string) *ClusterTable {
+	widget := &ClusterTable{
+		Table: *tview.NewTable(),
+		nodes: nodes,
+	}
+
+	widget.SetFixed(1, 0).
+		SetSelectable(false, false).
+		SetBorderPadding(0, 0, 1, 1)
+
+	widget.redraw()
+
+	return widget
+}
+
+// OnNodeSelect implements the NodeSelectListener interface.
+func (widget *ClusterTable) OnNodeSelect(node string) {
+	if node != widget.selectedNode {
+		widget.selectedNode = node
+
+		widget.redraw()
+	}
+}
+
+// OnAPIDataChange implements the APIDataListener interface.
+func (widget *ClusterTable) OnAPIDataChange(_ string, data *apidata.Data) {
+	widget.data = data
+
+	widget.redraw()
+}
+
+func (widget *ClusterTable) redraw() {
+	widget.Clear()
+
+	for col, title := range clusterTableHeader {
+		widget.SetCell(0, col, tview.NewTableCell(title).
+			SetAttributes(tcell.AttrBold).
+			SetTextColor(tcell.ColorYellow).
+			SetSelectable(false).
+			SetExpansion(1))
+	}
+
+	for i, node := range widget.nodes {
+		row := ClusterTableRow(node, widget.nodeData(node), widget.interval())
+
+		for col, value := range row {
+			cell := tview.NewTableCell(value).SetExpansion(1)
+
+			if node == widget.selectedNode {
+				cell.SetAttributes(tcell.AttrReverse)
+			}
+
+			widget.SetCell(i+1, col, cell)
+		}
+	}
+}
+
+func (widget *ClusterTable) nodeData(node string) *apidata.Node {
+	if widget.data == nil {
+		return nil
+	}
+
+	return widget.data.Nodes[node]
+}
+
+func (widget *ClusterTable) interval() float64 {
+	if widget.data == nil {
+		return 0
+	}
+
+	return widget.data.Interval.Seconds()
+}
+
+// ClusterTableRow renders the node data as the cluster table row.
+//
+// Interval is the time between the data updates in seconds, used to calculate the disk throughput.
+func ClusterTableRow(node string, nodeData *apidata.Node, interval float64) []string {
+	name := node
+	if name == "" {
+		name = "(local)"
+	}
+
+	row := []string{tview.Escape(name)}
+
+	if nodeData == nil {
+		for range len(clusterTableHeader) - 1 {
+			row = append(row, noData)
+		}
+
+		return row
+	}
+
+	version := notAvailable
+	if tag := nodeData.Version.GetVersion().GetTag(); tag != "" {
+		version = tag
+	}
+
+	disk := notAvailable
+
+	if nodeData.DiskStatsDiff != nil && interval > 0 {
+		total := nodeData.DiskStatsDiff.GetTotal()
+
+		disk = fmt.Sprintf("%s/s / %s/s",
+			humanize.Bytes(uint64(float64(total.GetReadSectors()*sectorSize)/interval)),
+			humanize.Bytes(uint64(float64(total.GetWriteSectors()*sectorSize)/interval)),
+		)
+	}
+
+	return append(row,
+		version,
+		fmt.Sprintf("%.1f%%", nodeData.CPUUsageByName("usage")*100.0),
+		fmt.Sprintf("%.1f%%", nodeData.MemUsage()*100.0),
+		disk,
+		servicesSummary(nodeData.ServiceList),
+		etcdSummary(nodeData.EtcdStatus),
+	)
+}
+
+func servicesSummary(serviceList *machine.ServiceList) string {
+	if serviceList == nil {
+		return notAvailable
+	}
+
+	var unhealthy []string
+
+	services := serviceList.GetServices()
+
+	for _, svc := range services {
+		if svc.GetState() == "Failed" || (!svc.GetHealth().GetUnknown() && !svc.GetHealth().GetHealthy()) {
+			unhealthy = append(unhealthy, svc.GetId())
+		}
+	}
+
+	summary := fmt.Sprintf("%d/%d healthy", len(services)-len(unhealthy), len(services))
+
+	if len(unhealthy) > 0 {
+		slices.Sort(unhealthy)
+
+		summary += " [red](" + tview.Escape(strings.Join(unhealthy, ", ")) + ")[-]"
+	}
+
+	return summary
+}
+
+func etcdSummary(etcdStatus *machine.EtcdStatus) string {
+	if etcdStatus == nil {
+		return "-"
+	}
+
+	member := etcdStatus.GetMemberStatus()
+
+	var role string
+
+	switch {
+	case member.GetIsLearner():
+		role = "learner"
+	case member.GetMemberId() != 0 && member.GetMemberId() == member.GetLeader():
+		role = "leader"
+	default:
+		role = "follower"
+	}
+
+	summary := fmt.Sprintf("%s, db %s", role, humanize.Bytes(uint64(member.GetDbSize())))
+
+	if errs := member.GetErrors(); len(errs) > 0 {
+		summary += " [red](" + tview.Escape(strings.Join(errs, ", ")) + ")[-]"
+	}
+
+	return summary
+}
@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/components"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func TestClusterTableRow(t *testing.T) {
+	assert.Equal(t,
+		[]string{"node1", "...", "...", "...", "...", "...", "..."},
+		components.ClusterTableRow("node1", nil, 3),
+	)
+
+	row := components.ClusterTableRow("node1", &apidata.Node{
+		Version: &machine.Version{
+			Version: &machine.VersionInfo{Tag: "v1.9.0"},
+		},
+		DiskStatsDiff: &machine.DiskStats{
+			Total: &machine.DiskStat{ReadSectors: 6000, WriteSectors: 12000},
+		},
+		ServiceList: &machine.ServiceList{
+			Services: []*machine.ServiceInfo{
+				{Id: "apid", State: "Running", Health: &machine.ServiceHealth{Healthy: true}},
+				{Id: "etcd", State: "Running", Health: &machine.ServiceHealth{Healthy: false}},
+				{Id: "machined", State: "Running", Health: &machine.ServiceHealth{Unknown: true}},
+			},
+		},
+		EtcdStatus: &machine.EtcdStatus{
+			MemberStatus: &machine.EtcdMemberStatus{MemberId: 1, Leader: 1, DbSize: 20480000},
+		},
+	}, 3)
+
+	assert.Equal(t, "v1.9.0", row[1])
+	assert.Equal(t, "1.0 MB/s / 2.0 MB/s", row[4])
+	assert.Equal(t, "2/3 healthy [red](etcd)[-]", row[5])
+	assert.Equal(t, "leader, db 20 MB", row[6])
+}
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"github.com/rivo/tview"
+)
+
+// EventViewer represents the widget with the events of all nodes.
+type EventViewer struct {
+	tview.Grid
+	events tview.TextView
+}
+
+// NewEventViewer initializes EventViewer.
+func NewEventViewer() *EventViewer {
+	widget := &EventViewer{
+		Grid:   *tview.NewGrid(),
+		events: *tview.NewTextView(),
+	}
+
+	widget.events.ScrollToEnd().
+		SetDynamicColors(true).
+		SetMaxLines(maxLogLines).
+		SetText(noData).
+		SetBorderPadding(0, 0, 1, 1)
+
+	widget.SetRows(1, 0).SetColumns(0)
+
+	widget.AddItem(NewHorizontalLine("Events"), 0, 0, 1, 1, 0, 0, false)
+	widget.AddItem(&widget.events, 1, 0, 1, 1, 0, 0, false)
+
+	return widget
+}
+
+// WriteEvent writes the event of the node to the widget.
+func (widget *EventViewer) WriteEvent(node, event, eventError string) {
+	if node == "" {
+		node = "(local)"
+	}
+
+	line := "[blue]" + tview.Escape(node) + "[-] "
+
+	if eventError != "" {
+		line += "[red]" + tview.Escape(eventError) + "[-]\n"
+	} else {
+		line += tview.Escape(event) + "\n"
+	}
+
+	widget.events.Write([]byte(line)) //nolint:errcheck
+}
@@ -24,6 +24,7 @@ type talosInfoData struct {
 	ready           string
 	numMachinesText string
 	secureBootState string
+	stagedConfig    string
 
 	machineIDSet map[string]struct{}
 }
@@ -106,6 +107,12 @@ func (widget *TalosInfo) updateNodeData(data resourcedata.Data) {
 		} else {
 			nodeData.secureBootState = formatStatus(res.TypedSpec().SecureBoot)
 		}
+	case *runtime.StagedConfigStatus:
+		if data.Deleted {
+			nodeData.stagedConfig = "None"
+		} else {
+			nodeData.stagedConfig = formatStagedConfig(res.TypedSpec().Count())
+		}
 	case *cluster.Member:
 		if data.Deleted {
 			delete(nodeData.machineIDSet, res.Metadata().ID())
@@ -133,6 +140,7 @@ func (widget *TalosInfo) getOrCreateNodeData(node string) *talosInfoData {
 			ready:           notAvailable,
 			numMachinesText: notAvailable,
 			secureBootState: notAvailable,
+			stagedConfig:    "None",
 			machineIDSet:    make(map[string]struct{}),
 		}
 
@@ -171,8 +179,21 @@ func (widget *TalosInfo) redraw() {
 				Name:  "SECUREBOOT",
 				Value: data.secureBootState,
 			},
+			{
+				Name:  "STAGED CONFIG",
+				Value: data.stagedConfig,
+			},
 		},
 	}
 
 	widget.SetText(fields.String())
 }
+
+func formatStagedConfig(count int) string {
+	suffix := ""
+	if count != 1 {
+		suffix = "s"
+	}
+
+	return fmt.Sprintf("[yellow]%d change%s pending reboot[-]", count, suffix)
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
 	"github.com/siderolabs/talos/internal/pkg/dashboard/components"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/eventdata"
 	"github.com/siderolabs/talos/internal/pkg/dashboard/logdata"
 	"github.com/siderolabs/talos/internal/pkg/dashboard/resolver"
 	"github.com/siderolabs/talos/internal/pkg/dashboard/resourcedata"
@@ -44,7 +45,8 @@ func init() {
 type Screen string
 
 const (
-	pageMain = "main"
+	pageMain   = "main"
+	pageAction = "action"
 
 	// ScreenSummary is the summary screen.
 	ScreenSummary Screen = "Summary"
@@ -57,6 +59,9 @@ const (
 
 	// ScreenConfigURL is the config URL screen.
 	ScreenConfigURL Screen = "Config URL"
+
+	// ScreenCluster is the cluster overview screen.
+	ScreenCluster Screen = "Cluster"
 )
 
 // APIDataListener is a listener which is notified when API-sourced data is updated.
@@ -74,6 +79,11 @@ type LogDataListener interface {
 	OnLogDataChange(node, logLine, logError string)
 }
 
+// EventDataListener is a listener which is notified when a machine event is received.
+type EventDataListener interface {
+	OnEventDataChange(node, event, eventError string)
+}
+
 // NodeSelectListener is a listener which is notified when a node is selected.
 type NodeSelectListener interface {
 	OnNodeSelect(node string)
@@ -102,10 +112,12 @@ type Dashboard struct {
 	apiDataSource      *apidata.Source
 	resourceDataSource *resourcedata.Source
 	logDataSource      *logdata.Source
+	eventDataSource    *eventdata.Source
 
 	apiDataListeners      []APIDataListener
 	resourceDataListeners []ResourceDataListener
 	logDataListeners      []LogDataListener
+	eventDataListeners    []EventDataListener
 	nodeSelectListeners   []NodeSelectListener
 
 	app *tview.Application
@@ -186,6 +198,9 @@ func buildDashboard(ctx context.Context, cli *client.Client, opts ...Option) (*D
 		allowNodeNavigation := dashboard.selectedScreenConfig != nil && dashboard.selectedScreenConfig.allowNodeNavigation
 
 		switch {
+		case dashboard.pages.HasPage(pageAction) && event.Key() != tcell.KeyCtrlC:
+			// pass the keys through to the action dialog
+			return event
 		case screenOk:
 			dashboard.selectScreen(config.screen)
 
@@ -242,6 +257,11 @@ func buildDashboard(ctx context.Context, cli *client.Client, opts ...Option) (*D
 			dashboard.logDataListeners = append(dashboard.logDataListeners, logDataListener)
 		}
 
+		eventDataListener, ok := screenPrimitive.(EventDataListener)
+		if ok {
+			dashboard.eventDataListeners = append(dashboard.eventDataListeners, eventDataListener)
+		}
+
 		nodeSelectListener, ok := screenPrimitive.(NodeSelectListener)
 		if ok {
 			dashboard.nodeSelectListeners = append(dashboard.nodeSelectListeners, nodeSelectListener)
@@ -262,6 +282,8 @@ func buildDashboard(ctx context.Context, cli *client.Client, opts ...Option) (*D
 
 	dashboard.logDataSource = logdata.NewSource(cli, nodeResolver)
 
+	dashboard.eventDataSource = eventdata.NewSource(cli, nodeResolver)
+
 	return dashboard, nil
 }
 
@@ -276,6 +298,8 @@ func (d *Dashboard) initScreenConfigs(ctx context.Context, screens []Screen) err
 			return NewNetworkConfigGrid(ctx, d)
 		case ScreenConfigURL:
 			return NewConfigURLGrid(ctx, d)
+		case ScreenCluster:
+			return NewClusterGrid(ctx, d)
 		default:
 			return nil
 		}
@@ -383,6 +407,12 @@ func (d *Dashboard) startDataHandler(ctx context.Context) func() error {
 		d.logDataSource.Start(ctx)
 		defer d.logDataSource.Stop() //nolint:errcheck
 
+		// start events data source only if there are screens showing the events
+		if len(d.eventDataListeners) > 0 {
+			d.eventDataSource.Start(ctx)
+			defer d.eventDataSource.Stop() //nolint:errcheck
+		}
+
 		lastLogTime := time.Now()
 
 		for {
@@ -409,6 +439,10 @@ func (d *Dashboard) startDataHandler(ctx context.Context) func() error {
 				d.app.QueueUpdateDraw(func() {
 					d.processNodeResource(nodeResource)
 				})
+			case nodeEvent := <-d.eventDataSource.EventCh:
+				d.app.QueueUpdateDraw(func() {
+					d.processEvent(nodeEvent.Node, nodeEvent.Event, nodeEvent.Error)
+				})
 			}
 		}
 	})
@@ -462,6 +496,13 @@ func (d *Dashboard) processLog(node, logLine, logError string) {
 	}
 }
 
+// processEvent re-renders the event components with new event data.
+func (d *Dashboard) processEvent(node, event, eventError string) {
+	for _, component := range d.eventDataListeners {
+		component.OnEventDataChange(node, event, eventError)
+	}
+}
+
 func (d *Dashboard) selectScreen(screen Screen) {
 	for _, info := range d.screenConfigs {
 		if info.screen == screen {
@@ -0,0 +1,203 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package eventdata implements the types and the data sources for the data sourced from the Talos events API.
+package eventdata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/resolver"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/util"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// tailEvents is the number of the past events to fetch on start.
+const tailEvents = 20
+
+// Data is an event from a node.
+type Data struct {
+	Node  string
+	Event string
+	Error string
+}
+
+// Source is a data source for the machine events.
+type Source struct {
+	client *client.Client
+
+	resolver resolver.Resolver
+
+	eventCtxCancel context.CancelFunc
+
+	eg   errgroup.Group
+	once sync.Once
+
+	EventCh chan Data
+}
+
+// NewSource initializes and returns Source data source.
+func NewSource(client *client.Client, resolver resolver.Resolver) *Source {
+	return &Source{
+		client:   client,
+		resolver: resolver,
+		EventCh:  make(chan Data),
+	}
+}
+
+// Start starts the data source.
+func (source *Source) Start(ctx context.Context) {
+	source.once.Do(func() {
+		source.start(ctx)
+	})
+}
+
+// Stop stops the data source.
+func (source *Source) Stop() error {
+	if source.eventCtxCancel == nil {
+		return nil
+	}
+
+	source.eventCtxCancel()
+
+	return source.eg.Wait()
+}
+
+func (source *Source) start(ctx context.Context) {
+	ctx, source.eventCtxCancel = context.WithCancel(ctx)
+
+	for _, nodeContext := range util.NodeContexts(ctx) {
+		source.eg.Go(func() error {
+			return source.watchNodeWithRetries(nodeContext.Ctx, nodeContext.Node)
+		})
+	}
+}
+
+func (source *Source) watchNodeWithRetries(ctx context.Context, node string) error {
+	// fetch the tail of the events only on the first attempt, as the retries would duplicate them
+	opts := []client.EventsOptionFunc{client.WithTailEvents(tailEvents)}
+
+	for {
+		watchErr := source.watchEvents(ctx, node, opts...)
+		if errors.Is(watchErr, context.Canceled) || status.Code(watchErr) == codes.Canceled {
+			return nil
+		}
+
+		if watchErr != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case source.EventCh <- Data{Node: source.resolver.Resolve(node), Error: watchErr.Error()}:
+			}
+		}
+
+		opts = nil
+
+		// back off a bit before retrying
+		sleepWithContext(ctx, 30*time.Second)
+	}
+}
+
+func (source *Source) watchEvents(ctx context.Context, node string, opts ...client.EventsOptionFunc) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	eventCh := make(chan client.EventResult)
+
+	if err := source.client.EventsWatchV2(ctx, eventCh, opts...); err != nil {
+		return fmt.Errorf("dashboard: error watching events: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case result := <-eventCh:
+			if result.Error != nil {
+				return fmt.Errorf("error watching events: %w", result.Error)
+			}
+
+			eventNode := node
+			if eventNode == "" {
+				eventNode = source.resolver.Resolve(result.Event.Node)
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case source.EventCh <- Data{Node: eventNode, Event: Format(result.Event)}:
+			}
+		}
+	}
+}
+
+// Format the event as a single line.
+func Format(event client.Event) string {
+	switch msg := event.Payload.(type) {
+	case *machine.SequenceEvent:
+		line := fmt.Sprintf("sequence %s: %s", msg.GetSequence(), strings.ToLower(msg.GetAction().String()))
+
+		if msg.GetError() != nil {
+			line += ", error: " + msg.GetError().GetMessage()
+		}
+
+		return line
+	case *machine.PhaseEvent:
+		return fmt.Sprintf("phase %s: %s", msg.GetPhase(), strings.ToLower(msg.GetAction().String()))
+	case *machine.TaskEvent:
+		return fmt.Sprintf("task %s: %s", msg.GetTask(), strings.ToLower(msg.GetAction().String()))
+	case *machine.ServiceStateEvent:
+		return fmt.Sprintf("service %s: %s, %s", msg.GetService(), msg.GetAction(), msg.GetMessage())
+	case *machine.ConfigLoadErrorEvent:
+		return "config load error: " + msg.GetError()
+	case *machine.ConfigValidationErrorEvent:
+		return "config validation error: " + msg.GetError()
+	case *machine.AddressEvent:
+		return fmt.Sprintf("addresses of %s: %s", msg.GetHostname(), strings.Join(msg.GetAddresses(), ", "))
+	case *machine.MachineStatusEvent:
+		line := fmt.Sprintf("machine %s, ready: %v", strings.ToLower(msg.GetStage().String()), msg.GetStatus().GetReady())
+
+		if unmet := msg.GetStatus().GetUnmetConditions(); len(unmet) > 0 {
+			line += ", unmet conditions: " + strings.Join(xslices.Map(unmet, func(c *machine.MachineStatusEvent_MachineStatus_UnmetCondition) string {
+				return c.GetName()
+			}), ", ")
+		}
+
+		return line
+	case *machine.PodShutdownEvent:
+		return fmt.Sprintf("pods running: %d", msg.GetRunningPods())
+	case *machine.FilesystemTrimEvent:
+		if msg.GetError() != "" {
+			return fmt.Sprintf("filesystem %s trim failed: %s", msg.GetMountpoint(), msg.GetError())
+		}
+
+		return fmt.Sprintf("filesystem %s trimmed: %d bytes", msg.GetMountpoint(), msg.GetTrimmedBytes())
+	case *machine.FeatureFlagChangeEvent:
+		return fmt.Sprintf("feature flag %s enabled: %v", msg.GetName(), msg.GetEnabled())
+	default:
+		return event.TypeURL
+	}
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	select {
+	case <-ctx.Done():
+		if !timer.Stop() {
+			<-timer.C
+		}
+	case <-timer.C:
+	}
+}
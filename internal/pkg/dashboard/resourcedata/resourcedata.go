@@ -135,6 +135,7 @@ func (source *Source) runResourceWatch(ctx context.Context, node string) error {
 		network.NewNodeAddress(network.NamespaceName, "").Metadata(),
 		siderolink.NewStatus().Metadata(),
 		runtime.NewDiagnostic(runtime.NamespaceName, "").Metadata(),
+		runtime.NewStagedConfigStatus().Metadata(),
 	}
 
 	for _, ptr := range watchKindResources {
@@ -0,0 +1,344 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package diskbench measures the performance of the filesystem with the synthetic workloads.
+//
+// The fsync test mimics the etcd write-ahead log: small sequential writes each followed by fdatasync.
+package diskbench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// DefaultDuration is the default total duration of the benchmark.
+	DefaultDuration = 20 * time.Second
+
+	// DefaultFileSize is the default size of the file used for the sequential and random I/O tests.
+	DefaultFileSize = 256 * 1024 * 1024
+
+	// EtcdFsyncLatencyThreshold is the 99th percentile of the fsync latency recommended by etcd.
+	EtcdFsyncLatencyThreshold = 10 * time.Millisecond
+
+	// walWriteSize is the size of a single write in the fsync test, same as in the etcd hardware recommendations.
+	walWriteSize = 2300
+	// walFileSize is the size of the file in the fsync test, it is overwritten from the beginning once full.
+	walFileSize = 22 * 1024 * 1024
+
+	sequentialBlockSize = 1024 * 1024
+	randomBlockSize     = 4096
+)
+
+// Options configures the benchmark.
+type Options struct {
+	// Dir is the directory to create the temporary files in.
+	Dir string
+	// Duration is the total duration of the benchmark, it is split evenly between the tests.
+	Duration time.Duration
+	// FileSize is the size of the file used for the sequential and random I/O tests.
+	FileSize int64
+}
+
+// Result is the result of the benchmark.
+type Result struct {
+	// DirectIO is true if the sequential and random I/O tests bypass the page cache.
+	DirectIO bool
+
+	FsyncCount int
+	FsyncP50   time.Duration
+	FsyncP99   time.Duration
+	FsyncMax   time.Duration
+
+	SequentialWriteBytesPerSecond uint64
+	SequentialWriteIOPS           uint64
+	RandomWriteIOPS               uint64
+	RandomReadIOPS                uint64
+}
+
+// EtcdSuitable returns true if the fsync latency meets the etcd recommendations.
+func (result *Result) EtcdSuitable() bool {
+	return result.FsyncCount > 0 && result.FsyncP99 < EtcdFsyncLatencyThreshold
+}
+
+// Run the benchmark.
+//
+// The temporary files are removed once the benchmark is done.
+func Run(ctx context.Context, opts Options) (*Result, error) {
+	if opts.Duration <= 0 {
+		opts.Duration = DefaultDuration
+	}
+
+	if opts.FileSize <= 0 {
+		opts.FileSize = DefaultFileSize
+	}
+
+	// round down to the block size, but keep at least a single block
+	opts.FileSize = max(opts.FileSize/sequentialBlockSize*sequentialBlockSize, sequentialBlockSize)
+
+	dir, err := os.MkdirTemp(opts.Dir, ".diskbench-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	testDuration := opts.Duration / 4
+
+	var result Result
+
+	if err = fsyncTest(ctx, filepath.Join(dir, "wal"), testDuration, &result); err != nil {
+		return nil, fmt.Errorf("fsync test failed: %w", err)
+	}
+
+	path := filepath.Join(dir, "data")
+
+	written, err := sequentialWriteTest(ctx, path, opts.FileSize, testDuration, &result)
+	if err != nil {
+		return nil, fmt.Errorf("sequential write test failed: %w", err)
+	}
+
+	if result.RandomWriteIOPS, err = randomTest(ctx, path, written, testDuration, result.DirectIO, true); err != nil {
+		return nil, fmt.Errorf("random write test failed: %w", err)
+	}
+
+	if result.RandomReadIOPS, err = randomTest(ctx, path, written, testDuration, result.DirectIO, false); err != nil {
+		return nil, fmt.Errorf("random read test failed: %w", err)
+	}
+
+	return &result, nil
+}
+
+func fsyncTest(ctx context.Context, path string, duration time.Duration, result *Result) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	buf := randomBuffer(make([]byte, walWriteSize))
+
+	var (
+		latencies []time.Duration
+		offset    int64
+	)
+
+	for deadline := time.Now().Add(duration); time.Now().Before(deadline); {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		if offset+walWriteSize > walFileSize {
+			offset = 0
+		}
+
+		if _, err = f.WriteAt(buf, offset); err != nil {
+			return err
+		}
+
+		offset += walWriteSize
+
+		start := time.Now()
+
+		if err = unix.Fdatasync(int(f.Fd())); err != nil {
+			return fmt.Errorf("fdatasync failed: %w", err)
+		}
+
+		latencies = append(latencies, time.Since(start))
+	}
+
+	slices.Sort(latencies)
+
+	result.FsyncCount = len(latencies)
+	result.FsyncP50 = percentile(latencies, 0.5)
+	result.FsyncP99 = percentile(latencies, 0.99)
+	result.FsyncMax = percentile(latencies, 1)
+
+	return f.Close()
+}
+
+// sequentialWriteTest writes the file in large blocks, and returns the number of bytes laid out in the file.
+func sequentialWriteTest(ctx context.Context, path string, fileSize int64, duration time.Duration, result *Result) (int64, error) {
+	f, direct, err := openFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return 0, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	result.DirectIO = direct
+
+	buf, err := alignedBuffer(sequentialBlockSize)
+	if err != nil {
+		return 0, err
+	}
+
+	defer unix.Munmap(buf) //nolint:errcheck
+
+	var (
+		offset, written, blocks int64
+		laidOut                 int64
+	)
+
+	start := time.Now()
+
+	// write at least a single block, so that the random I/O tests have some data to work with
+	for deadline := start.Add(duration); blocks == 0 || time.Now().Before(deadline); {
+		if err = ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		if offset == fileSize {
+			offset = 0
+		}
+
+		if _, err = f.WriteAt(buf, offset); err != nil {
+			return 0, err
+		}
+
+		offset += sequentialBlockSize
+		written += sequentialBlockSize
+		blocks++
+
+		laidOut = max(laidOut, offset)
+	}
+
+	if err = unix.Fdatasync(int(f.Fd())); err != nil {
+		return 0, fmt.Errorf("fdatasync failed: %w", err)
+	}
+
+	elapsed := time.Since(start).Seconds()
+
+	result.SequentialWriteBytesPerSecond = uint64(float64(written) / elapsed)
+	result.SequentialWriteIOPS = uint64(float64(blocks) / elapsed)
+
+	return laidOut, f.Close()
+}
+
+// randomTest reads or writes the small blocks at the random offsets of the file, and returns the number of operations per second.
+func randomTest(ctx context.Context, path string, size int64, duration time.Duration, direct, write bool) (uint64, error) {
+	flags := os.O_RDONLY
+	if write {
+		flags = os.O_WRONLY
+	}
+
+	f, err := os.OpenFile(path, flags|directFlag(direct), 0)
+	if err != nil {
+		return 0, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	if !direct && !write {
+		// drop the file from the page cache, so that the reads hit the disk
+		if err = unix.Fadvise(int(f.Fd()), 0, 0, unix.FADV_DONTNEED); err != nil {
+			return 0, fmt.Errorf("fadvise failed: %w", err)
+		}
+	}
+
+	buf, err := alignedBuffer(randomBlockSize)
+	if err != nil {
+		return 0, err
+	}
+
+	defer unix.Munmap(buf) //nolint:errcheck
+
+	blocks := size / randomBlockSize
+
+	var ops int64
+
+	start := time.Now()
+
+	for deadline := start.Add(duration); time.Now().Before(deadline); {
+		if err = ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		offset := rand.Int64N(blocks) * randomBlockSize
+
+		if write {
+			_, err = f.WriteAt(buf, offset)
+		} else {
+			_, err = f.ReadAt(buf, offset)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		ops++
+	}
+
+	if write {
+		if err = unix.Fdatasync(int(f.Fd())); err != nil {
+			return 0, fmt.Errorf("fdatasync failed: %w", err)
+		}
+	}
+
+	return uint64(float64(ops) / time.Since(start).Seconds()), f.Close()
+}
+
+// openFile opens the file bypassing the page cache if the filesystem supports it.
+func openFile(path string, flags int) (*os.File, bool, error) {
+	f, err := os.OpenFile(path, flags|unix.O_DIRECT, 0o600)
+	if err == nil {
+		return f, true, nil
+	}
+
+	if !errors.Is(err, unix.EINVAL) {
+		return nil, false, err
+	}
+
+	// the filesystem doesn't support direct I/O (e.g. tmpfs)
+	f, err = os.OpenFile(path, flags, 0o600)
+
+	return f, false, err
+}
+
+func directFlag(direct bool) int {
+	if direct {
+		return unix.O_DIRECT
+	}
+
+	return 0
+}
+
+// alignedBuffer allocates the page-aligned buffer filled with the random data, as required for the direct I/O.
+func alignedBuffer(size int) ([]byte, error) {
+	buf, err := unix.Mmap(-1, 0, size, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		return nil, fmt.Errorf("error allocating buffer: %w", err)
+	}
+
+	return randomBuffer(buf), nil
+}
+
+// randomBuffer fills the buffer with the random data, so that the storage can't compress or deduplicate it.
+func randomBuffer(buf []byte) []byte {
+	for i := range buf {
+		buf[i] = byte(rand.Uint32())
+	}
+
+	return buf
+}
+
+// percentile returns the percentile of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	idx := int(float64(len(sorted))*p+0.5) - 1
+
+	return sorted[min(max(idx, 0), len(sorted)-1)]
+}
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package diskbench_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/diskbench"
+)
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+
+	result, err := diskbench.Run(context.Background(), diskbench.Options{
+		Dir:      dir,
+		Duration: 400 * time.Millisecond,
+		FileSize: 4 * 1024 * 1024,
+	})
+	require.NoError(t, err)
+
+	assert.Positive(t, result.FsyncCount)
+	assert.LessOrEqual(t, result.FsyncP50, result.FsyncP99)
+	assert.LessOrEqual(t, result.FsyncP99, result.FsyncMax)
+	assert.Positive(t, result.SequentialWriteBytesPerSecond)
+	assert.Positive(t, result.RandomWriteIOPS)
+	assert.Positive(t, result.RandomReadIOPS)
+
+	// temporary files are removed
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := diskbench.Run(ctx, diskbench.Options{
+		Dir:      t.TempDir(),
+		Duration: time.Minute,
+	})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestEtcdSuitable(t *testing.T) {
+	assert.False(t, (&diskbench.Result{}).EtcdSuitable())
+	assert.True(t, (&diskbench.Result{FsyncCount: 10, FsyncP99: 2 * time.Millisecond}).EtcdSuitable())
+	assert.False(t, (&diskbench.Result{FsyncCount: 10, FsyncP99: 15 * time.Millisecond}).EtcdSuitable())
+}
@@ -54,10 +54,11 @@ func NewHandler(encryptionConfig block.EncryptionSpec, volumeID string, getSyste
 		opts = append(opts, luks.WithPerfOptions(encryptionConfig.PerfOptions...))
 	}
 
+	keyOptions := []keys.KeyOption{keys.WithVolumeID(volumeID), keys.WithSystemInformationGetter(getSystemInformation)}
 	keyHandlers := make([]keys.Handler, 0, len(encryptionConfig.Keys))
 
 	for _, cfg := range encryptionConfig.Keys {
-		handler, err := keys.NewHandler(cfg, keys.WithVolumeID(volumeID), keys.WithSystemInformationGetter(getSystemInformation))
+		handler, err := keys.NewHandler(cfg, keyOptions...)
 		if err != nil {
 			return nil, err
 		}
@@ -65,8 +66,17 @@ func NewHandler(encryptionConfig block.EncryptionSpec, volumeID string, getSyste
 		keyHandlers = append(keyHandlers, handler)
 	}
 
+	// key handlers with the lockout enabled go first, so that the volume is never opened with other keys
+	// if the lockout key can't be unsealed
+	//
 	//nolint:scopelint
-	sort.Slice(keyHandlers, func(i, j int) bool { return keyHandlers[i].Slot() < keyHandlers[j].Slot() })
+	sort.Slice(keyHandlers, func(i, j int) bool {
+		if li, lj := isLockout(keyHandlers[i]), isLockout(keyHandlers[j]); li != lj {
+			return li
+		}
+
+		return keyHandlers[i].Slot() < keyHandlers[j].Slot()
+	})
 
 	provider := luks.New(
 		cipher,
@@ -76,6 +86,7 @@ func NewHandler(encryptionConfig block.EncryptionSpec, volumeID string, getSyste
 	return &Handler{
 		encryptionProvider: provider,
 		keyHandlers:        keyHandlers,
+		keyOptions:         keyOptions,
 	}, nil
 }
 
@@ -84,6 +95,7 @@ func NewHandler(encryptionConfig block.EncryptionSpec, volumeID string, getSyste
 type Handler struct {
 	encryptionProvider encryption.Provider
 	keyHandlers        []keys.Handler
+	keyOptions         []keys.KeyOption
 }
 
 // Open encrypted partition.
@@ -103,6 +115,10 @@ func (h *Handler) Open(ctx context.Context, logger *zap.Logger, devicePath, encr
 			}
 
 			slotKey, err := handler.GetKey(ctx, slotToken)
+			if errors.Is(err, keys.ErrTokenInvalid) {
+				return h.resumeRotation(ctx, logger, devicePath, handler, err)
+			}
+
 			if err != nil {
 				return nil, nil, err
 			}
@@ -319,6 +335,10 @@ func (h *Handler) tryHandlers(
 
 			logger.Warn("failed to call key handler", zap.Int("slot", h.Slot()), zap.Error(err))
 
+			if errors.Is(err, keys.ErrLockout) {
+				return nil, nil, nil, err
+			}
+
 			continue
 		}
 
@@ -328,6 +348,12 @@ func (h *Handler) tryHandlers(
 	return nil, nil, nil, fmt.Errorf("no handlers available to get encryption keys from: %w", errs)
 }
 
+func isLockout(h keys.Handler) bool {
+	lockoutHandler, ok := h.(interface{ Lockout() bool })
+
+	return ok && lockoutHandler.Lockout()
+}
+
 func (h *Handler) readToken(ctx context.Context, path string, id int) (token.Token, error) {
 	token := luks.Token[json.RawMessage]{}
 
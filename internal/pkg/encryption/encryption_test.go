@@ -5,12 +5,329 @@
 package encryption_test
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
 	"testing"
+
+	"github.com/siderolabs/go-blockdevice/v2/encryption"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/luks"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	talosencryption "github.com/siderolabs/talos/internal/pkg/encryption"
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+// mockProvider keeps the key slots in memory.
+type mockProvider struct {
+	encryption.Provider
+
+	keys   map[int][]byte
+	tokens map[int][]byte
+
+	// failRemoveToken simulates the failure to update the token of the slot.
+	failRemoveToken bool
+	// failAddKey simulates the failure to enroll the key in the slot.
+	failAddKey map[int]bool
+}
+
+func (p *mockProvider) IsOpen(context.Context, string, string) (bool, string, error) {
+	return false, "", nil
+}
+
+func (p *mockProvider) Open(_ context.Context, _, mappedName string, key *encryption.Key) (string, error) {
+	if !p.unlocks(key, -1) {
+		return "", encryption.ErrEncryptionKeyRejected
+	}
+
+	return "/dev/mapper/" + mappedName, nil
+}
+
+func (p *mockProvider) unlocks(key *encryption.Key, excludeSlot int) bool {
+	for slot, value := range p.keys {
+		if slot != excludeSlot && bytes.Equal(value, key.Value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *mockProvider) AddKey(_ context.Context, _ string, key, newKey *encryption.Key) error {
+	if !p.unlocks(key, -1) {
+		return encryption.ErrEncryptionKeyRejected
+	}
+
+	if _, ok := p.keys[newKey.Slot]; ok {
+		return errors.New("slot is in use")
+	}
+
+	if p.failAddKey[newKey.Slot] {
+		return errors.New("key enrollment failed")
+	}
+
+	p.keys[newKey.Slot] = newKey.Value
+
+	return nil
+}
+
+func (p *mockProvider) SetKey(_ context.Context, _ string, key, newKey *encryption.Key) error {
+	if !bytes.Equal(p.keys[key.Slot], key.Value) {
+		return encryption.ErrEncryptionKeyRejected
+	}
+
+	p.keys[newKey.Slot] = newKey.Value
+
+	return nil
+}
+
+func (p *mockProvider) CheckKey(_ context.Context, _ string, key *encryption.Key) (bool, error) {
+	value, ok := p.keys[key.Slot]
+
+	return ok && bytes.Equal(value, key.Value), nil
+}
+
+func (p *mockProvider) RemoveKey(_ context.Context, _ string, slot int, key *encryption.Key) error {
+	if !p.unlocks(key, slot) {
+		return encryption.ErrEncryptionKeyRejected
+	}
+
+	delete(p.keys, slot)
+	delete(p.tokens, slot)
+
+	return nil
+}
+
+func (p *mockProvider) ReadKeyslots(string) (*encryption.Keyslots, error) {
+	keyslots := &encryption.Keyslots{Keyslots: map[string]*encryption.Keyslot{}}
+
+	for slot := range p.keys {
+		keyslots.Keyslots[strconv.Itoa(slot)] = &encryption.Keyslot{Type: "luks2"}
+	}
+
+	return keyslots, nil
+}
+
+func (p *mockProvider) SetToken(_ context.Context, _ string, slot int, token token.Token) error {
+	data, err := token.Bytes()
+	if err != nil {
+		return err
+	}
+
+	p.tokens[slot] = data
+
+	return nil
+}
+
+func (p *mockProvider) ReadToken(_ context.Context, _ string, slot int, token token.Token) error {
+	data, ok := p.tokens[slot]
+	if !ok {
+		return encryption.ErrTokenNotFound
+	}
+
+	return token.Decode(data)
+}
+
+func (p *mockProvider) RemoveToken(_ context.Context, _ string, slot int) error {
+	if p.failRemoveToken {
+		return errors.New("token update failed")
+	}
+
+	if _, ok := p.tokens[slot]; !ok {
+		return encryption.ErrTokenNotFound
+	}
+
+	delete(p.tokens, slot)
+
+	return nil
+}
+
+// sealedKeyHandler stores the key in the token of the slot, similar to the KMS key handler.
+type sealedKeyHandler struct {
+	slot  int
+	value []byte
+}
+
+func (h *sealedKeyHandler) NewKey(context.Context) (*encryption.Key, token.Token, error) {
+	return encryption.NewKey(h.slot, h.value), &luks.Token[*keys.KMSToken]{
+		Type:     keys.TokenTypeKMS,
+		UserData: &keys.KMSToken{SealedData: h.value},
+	}, nil
+}
+
+func (h *sealedKeyHandler) GetKey(_ context.Context, t token.Token) (*encryption.Key, error) {
+	token, ok := t.(*luks.Token[*keys.KMSToken])
+	if !ok {
+		return nil, keys.ErrTokenInvalid
+	}
+
+	return encryption.NewKey(h.slot, token.UserData.SealedData), nil
+}
+
+func (h *sealedKeyHandler) Slot() int {
+	return h.slot
+}
+
+func newProvider(t *testing.T) *mockProvider {
+	t.Helper()
+
+	kmsToken, err := (&luks.Token[map[string]string]{Type: "sideroKMS", UserData: map[string]string{}}).Bytes()
+	require.NoError(t, err)
+
+	return &mockProvider{
+		keys: map[int][]byte{
+			0: []byte("old"),
+			1: []byte("recovery"),
+			3: []byte("sealed"),
+		},
+		tokens: map[int][]byte{
+			3: kmsToken,
+		},
+	}
+}
+
+func newHandler(t *testing.T, provider *mockProvider) *talosencryption.Handler {
+	t.Helper()
+
+	handler, err := talosencryption.NewHandlerWithProvider(provider, []block.EncryptionKey{
+		{Slot: 0, Type: block.EncryptionKeyStatic, StaticPassphrase: []byte("new")},
+		{Slot: 1, Type: block.EncryptionKeyStatic, StaticPassphrase: []byte("recovery")},
+	})
+	require.NoError(t, err)
+
+	return handler
+}
+
+func TestKeySlots(t *testing.T) {
+	t.Parallel()
+
+	provider := newProvider(t)
+	handler := newHandler(t, provider)
+
+	keySlots, err := handler.KeySlots(context.Background(), "/dev/sda")
+	require.NoError(t, err)
+
+	assert.Equal(t, []talosencryption.KeySlot{
+		{Slot: 0},
+		{Slot: 1},
+		{Slot: 3, TokenType: "sideroKMS"},
+	}, keySlots)
+}
+
+func TestRotateKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+
+	provider := newProvider(t)
+	handler := newHandler(t, provider)
+
+	// the key in the slot 0 was changed in the config, the volume is unlocked with the key from the slot 1
+	require.NoError(t, handler.RotateKey(ctx, logger, "/dev/sda", 0))
+	assert.Equal(t, []byte("new"), provider.keys[0])
+
+	// the key is rotated in place
+	require.NoError(t, handler.RotateKey(ctx, logger, "/dev/sda", 0))
+	assert.Equal(t, []byte("new"), provider.keys[0])
+	assert.Equal(t, []byte("recovery"), provider.keys[1])
+
+	// the temporary key slot is removed
+	assert.Len(t, provider.keys, 3)
+
+	require.ErrorIs(t, handler.RotateKey(ctx, logger, "/dev/sda", 3), talosencryption.ErrKeySlotNotConfigured)
+}
+
+func TestRotateKeyInterrupted(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+
+	provider := newProvider(t)
+	handler := newHandler(t, provider)
+
+	provider.failRemoveToken = true
+
+	// the old key is kept if the new key can't be enrolled with its token
+	require.Error(t, handler.RotateKey(ctx, logger, "/dev/sda", 0))
+	assert.Equal(t, []byte("old"), provider.keys[0])
+	assert.Equal(t, []byte("recovery"), provider.keys[1])
+}
+
+func TestRotateKeyResumedOnOpen(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+
+	provider := newProvider(t)
+	handler := talosencryption.NewHandlerWithKeyHandlers(provider,
+		&sealedKeyHandler{slot: 0, value: []byte("sealed-new")},
+		&sealedKeyHandler{slot: 1, value: []byte("recovery")},
+	)
+
+	// the old key is removed, but the new key can't be enrolled back in the slot
+	provider.failAddKey = map[int]bool{0: true}
+	provider.tokens[1] = mustTokenBytes(t, []byte("recovery"))
+
+	require.Error(t, handler.RotateKey(ctx, logger, "/dev/sda", 0))
+	assert.NotContains(t, provider.keys, 0)
+	assert.Equal(t, []byte("sealed-new"), provider.keys[2])
+
+	provider.failAddKey = nil
+
+	// opening the volume enrolls the new key from the temporary slot, and the temporary slot is removed
+	path, err := handler.Open(ctx, logger, "/dev/sda", "luks-state")
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/mapper/luks-state", path)
+
+	assert.Equal(t, map[int][]byte{
+		0: []byte("sealed-new"),
+		1: []byte("recovery"),
+	}, provider.keys)
+	assert.Equal(t, mustTokenBytes(t, []byte("sealed-new")), provider.tokens[0])
+}
+
+func mustTokenBytes(t *testing.T, value []byte) []byte {
+	t.Helper()
+
+	data, err := (&luks.Token[*keys.KMSToken]{Type: keys.TokenTypeKMS, UserData: &keys.KMSToken{SealedData: value}}).Bytes()
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestAddRemoveKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+
+	provider := newProvider(t)
+	handler := newHandler(t, provider)
+
+	require.NoError(t, handler.AddKey(ctx, logger, "/dev/sda", block.EncryptionKey{Slot: 2, Type: block.EncryptionKeyStatic, StaticPassphrase: []byte("extra")}))
+	assert.Equal(t, []byte("extra"), provider.keys[2])
+
+	require.ErrorIs(t,
+		handler.AddKey(ctx, logger, "/dev/sda", block.EncryptionKey{Slot: 1, Type: block.EncryptionKeyStatic, StaticPassphrase: []byte("extra")}),
+		talosencryption.ErrKeySlotInUse,
+	)
+
+	require.NoError(t, handler.RemoveKey(ctx, logger, "/dev/sda", 3))
+	assert.NotContains(t, provider.keys, 3)
+	assert.NotContains(t, provider.tokens, 3)
+
+	require.ErrorIs(t, handler.RemoveKey(ctx, logger, "/dev/sda", 3), talosencryption.ErrKeySlotNotFound)
+
+	require.NoError(t, handler.RemoveKey(ctx, logger, "/dev/sda", 0))
+	require.NoError(t, handler.RemoveKey(ctx, logger, "/dev/sda", 2))
+
+	require.ErrorIs(t, handler.RemoveKey(ctx, logger, "/dev/sda", 1), talosencryption.ErrLastKeySlot)
 }
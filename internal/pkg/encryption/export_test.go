@@ -0,0 +1,38 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"github.com/siderolabs/go-blockdevice/v2/encryption"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// NewHandlerWithProvider creates a Handler with the custom encryption provider for testing.
+func NewHandlerWithProvider(provider encryption.Provider, keyConfigs []block.EncryptionKey) (*Handler, error) {
+	handler := &Handler{
+		encryptionProvider: provider,
+	}
+
+	for _, cfg := range keyConfigs {
+		keyHandler, err := keys.NewHandler(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		handler.keyHandlers = append(handler.keyHandlers, keyHandler)
+	}
+
+	return handler, nil
+}
+
+// NewHandlerWithKeyHandlers creates a Handler with the custom encryption provider and key handlers for testing.
+func NewHandlerWithKeyHandlers(provider encryption.Provider, keyHandlers ...keys.Handler) *Handler {
+	return &Handler{
+		encryptionProvider: provider,
+		keyHandlers:        keyHandlers,
+	}
+}
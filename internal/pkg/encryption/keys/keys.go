@@ -46,7 +46,7 @@ func NewHandler(cfg block.EncryptionKey, options ...KeyOption) (Handler, error)
 			return nil, fmt.Errorf("failed to create KMS key handler at slot %d: %w", cfg.Slot, errNoSystemInfoGetter)
 		}
 
-		return NewKMSKeyHandler(key, cfg.KMSEndpoint, opts.GetSystemInformation)
+		return NewKMSKeyHandler(key, cfg, opts.GetSystemInformation)
 	case block.EncryptionKeyTPM:
 		return NewTPMKeyHandler(key, cfg.TPMCheckSecurebootStatusOnEnroll)
 	default:
@@ -8,7 +8,9 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -24,43 +26,73 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/encryption/helpers"
 	"github.com/siderolabs/talos/internal/pkg/endpoint"
 	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
+// ErrLockout is returned by the KMS key handler with the lockout enabled if the key can't be unsealed.
+//
+// The volume should stay locked, the other key slots should not be used to open it.
+var ErrLockout = errors.New("volume is locked until the key is unsealed by the KMS")
+
 // KMSToken is the userdata stored in the partition token metadata.
 type KMSToken struct {
 	SealedData []byte `json:"sealedData"`
 }
 
+// kmsSealer seals and unseals the data with the KMS.
+type kmsSealer interface {
+	Seal(ctx context.Context, nodeUUID string, data []byte) ([]byte, error)
+	Unseal(ctx context.Context, nodeUUID string, data []byte) ([]byte, error)
+}
+
 // KMSKeyHandler seals token using KMS service.
 type KMSKeyHandler struct {
 	KeyHandler
 	kmsEndpoint   string
+	sealer        kmsSealer
+	lockout       bool
 	getSystemInfo helpers.SystemInformationGetter
 }
 
 // NewKMSKeyHandler creates new KMSKeyHandler.
-func NewKMSKeyHandler(key KeyHandler, kmsEndpoint string, getSystemInfo helpers.SystemInformationGetter) (*KMSKeyHandler, error) {
+func NewKMSKeyHandler(key KeyHandler, cfg block.EncryptionKey, getSystemInfo helpers.SystemInformationGetter) (*KMSKeyHandler, error) {
+	tlsConfig, err := kmsTLSConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error building KMS TLS configuration, slot %d: %w", key.slot, err)
+	}
+
+	var sealer kmsSealer
+
+	if cfg.KMSVaultTransitKeyName != "" {
+		sealer = newVaultTransitSealer(cfg, tlsConfig)
+	} else {
+		sealer = &grpcSealer{
+			endpoint:  cfg.KMSEndpoint,
+			tlsConfig: tlsConfig,
+		}
+	}
+
 	return &KMSKeyHandler{
 		KeyHandler:    key,
-		kmsEndpoint:   kmsEndpoint,
+		kmsEndpoint:   cfg.KMSEndpoint,
+		sealer:        sealer,
+		lockout:       cfg.KMSLockout,
 		getSystemInfo: getSystemInfo,
 	}, nil
 }
 
+// Lockout returns true if the volume should stay locked if the key can't be unsealed.
+func (h *KMSKeyHandler) Lockout() bool {
+	return h.lockout
+}
+
 // NewKey implements Handler interface.
 func (h *KMSKeyHandler) NewKey(ctx context.Context) (*encryption.Key, token.Token, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	conn, err := h.getConn()
-	if err != nil {
-		return nil, nil, fmt.Errorf("error dialing KMS endpoint %q: %w", h.kmsEndpoint, err)
-	}
-
-	client := kms.NewKMSServiceClient(conn)
-
 	key := make([]byte, 32)
-	if _, err = io.ReadFull(rand.Reader, key); err != nil {
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
 		return nil, nil, err
 	}
 
@@ -69,18 +101,15 @@ func (h *KMSKeyHandler) NewKey(ctx context.Context) (*encryption.Key, token.Toke
 		return nil, nil, err
 	}
 
-	resp, err := client.Seal(ctx, &kms.Request{
-		NodeUuid: systemInformation.TypedSpec().UUID,
-		Data:     key,
-	})
+	sealedData, err := h.sealer.Seal(ctx, systemInformation.TypedSpec().UUID, key)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to seal KMS passphrase, slot %d: %w", h.Slot(), err)
+		return nil, nil, h.wrapError(fmt.Errorf("failed to seal KMS passphrase via %q, slot %d: %w", h.kmsEndpoint, h.Slot(), err))
 	}
 
 	token := &luks.Token[*KMSToken]{
 		Type: TokenTypeKMS,
 		UserData: &KMSToken{
-			SealedData: resp.Data,
+			SealedData: sealedData,
 		},
 	}
 
@@ -97,33 +126,101 @@ func (h *KMSKeyHandler) GetKey(ctx context.Context, t token.Token) (*encryption.
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	conn, err := h.getConn()
+	systemInformation, err := h.getSystemInfo(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error dialing KMS endpoint %q: %w", h.kmsEndpoint, err)
+		return nil, err
 	}
 
-	client := kms.NewKMSServiceClient(conn)
+	key, err := h.sealer.Unseal(ctx, systemInformation.TypedSpec().UUID, token.UserData.SealedData)
+	if err != nil {
+		return nil, h.wrapError(fmt.Errorf("failed to unseal KMS passphrase via %q, slot %d: %w", h.kmsEndpoint, h.Slot(), err))
+	}
 
-	systemInformation, err := h.getSystemInfo(ctx)
+	return encryption.NewKey(h.slot, []byte(base64.StdEncoding.EncodeToString(key))), nil
+}
+
+func (h *KMSKeyHandler) wrapError(err error) error {
+	if h.lockout {
+		return fmt.Errorf("%w: %w", ErrLockout, err)
+	}
+
+	return err
+}
+
+// kmsTLSConfig builds the TLS configuration for the KMS endpoint, with the optional client identity and CA.
+func kmsTLSConfig(cfg block.EncryptionKey) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		RootCAs: httpdefaults.RootCAs(),
+	}
+
+	if len(cfg.KMSClientCertificate) > 0 {
+		cert, err := tls.X509KeyPair(cfg.KMSClientCertificate, cfg.KMSClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing client identity: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if len(cfg.KMSCA) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(cfg.KMSCA) {
+			return nil, errors.New("error parsing CA certificate")
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// grpcSealer seals the data with the KMS API.
+type grpcSealer struct {
+	tlsConfig *tls.Config
+	endpoint  string
+}
+
+func (s *grpcSealer) Seal(ctx context.Context, nodeUUID string, data []byte) ([]byte, error) {
+	conn, err := s.getConn()
+	if err != nil {
+		return nil, fmt.Errorf("error dialing KMS endpoint %q: %w", s.endpoint, err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	resp, err := kms.NewKMSServiceClient(conn).Seal(ctx, &kms.Request{
+		NodeUuid: nodeUUID,
+		Data:     data,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := client.Unseal(ctx, &kms.Request{
-		NodeUuid: systemInformation.TypedSpec().UUID,
-		Data:     token.UserData.SealedData,
+	return resp.Data, nil
+}
+
+func (s *grpcSealer) Unseal(ctx context.Context, nodeUUID string, data []byte) ([]byte, error) {
+	conn, err := s.getConn()
+	if err != nil {
+		return nil, fmt.Errorf("error dialing KMS endpoint %q: %w", s.endpoint, err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	resp, err := kms.NewKMSServiceClient(conn).Unseal(ctx, &kms.Request{
+		NodeUuid: nodeUUID,
+		Data:     data,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to unseal KMS passphrase, slot %d: %w", h.Slot(), err)
+		return nil, err
 	}
 
-	return encryption.NewKey(h.slot, []byte(base64.StdEncoding.EncodeToString(resp.Data))), nil
+	return resp.Data, nil
 }
 
-func (h *KMSKeyHandler) getConn() (*grpc.ClientConn, error) {
+func (s *grpcSealer) getConn() (*grpc.ClientConn, error) {
 	var transportCredentials credentials.TransportCredentials
 
-	endpoint, err := endpoint.Parse(h.kmsEndpoint)
+	endpoint, err := endpoint.Parse(s.endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -131,9 +228,7 @@ func (h *KMSKeyHandler) getConn() (*grpc.ClientConn, error) {
 	if endpoint.Insecure {
 		transportCredentials = insecure.NewCredentials()
 	} else {
-		transportCredentials = credentials.NewTLS(&tls.Config{
-			RootCAs: httpdefaults.RootCAs(),
-		})
+		transportCredentials = credentials.NewTLS(s.tlsConfig)
 	}
 
 	return grpc.NewClient(
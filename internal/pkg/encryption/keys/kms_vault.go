@@ -0,0 +1,167 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// vaultTransitSealer seals the data with the Vault transit secrets engine.
+//
+// The sealer logs in with the TLS certificate auth method using the client identity,
+// so no Vault token is stored on the node.
+type vaultTransitSealer struct {
+	client    *http.Client
+	endpoint  string
+	keyName   string
+	mountPath string
+	authMount string
+}
+
+func newVaultTransitSealer(cfg block.EncryptionKey, tlsConfig *tls.Config) *vaultTransitSealer {
+	transport := httpdefaults.PatchTransport(http.DefaultTransport.(*http.Transport).Clone()) //nolint:forcetypeassert
+	transport.TLSClientConfig = tlsConfig
+
+	return &vaultTransitSealer{
+		client:    &http.Client{Transport: transport},
+		endpoint:  strings.TrimRight(cfg.KMSEndpoint, "/"),
+		keyName:   cfg.KMSVaultTransitKeyName,
+		mountPath: cfg.KMSVaultTransitMount,
+		authMount: cfg.KMSVaultAuthMount,
+	}
+}
+
+// Seal implements kmsSealer.
+//
+// The node UUID is passed as the key derivation context, so the ciphertext can be only decrypted for the same node
+// if the transit key is created with `derived=true`.
+func (s *vaultTransitSealer) Seal(ctx context.Context, nodeUUID string, data []byte) ([]byte, error) {
+	token, err := s.login(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+
+	if err = s.do(ctx, token, "/v1/"+s.mountPath+"/encrypt/"+url.PathEscape(s.keyName), map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(data),
+		"context":   base64.StdEncoding.EncodeToString([]byte(nodeUUID)),
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Data.Ciphertext == "" {
+		return nil, errors.New("vault returned empty ciphertext")
+	}
+
+	return []byte(resp.Data.Ciphertext), nil
+}
+
+// Unseal implements kmsSealer.
+func (s *vaultTransitSealer) Unseal(ctx context.Context, nodeUUID string, data []byte) ([]byte, error) {
+	token, err := s.login(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+
+	if err = s.do(ctx, token, "/v1/"+s.mountPath+"/decrypt/"+url.PathEscape(s.keyName), map[string]string{
+		"ciphertext": string(data),
+		"context":    base64.StdEncoding.EncodeToString([]byte(nodeUUID)),
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding vault plaintext: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+func (s *vaultTransitSealer) login(ctx context.Context) (string, error) {
+	var resp struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+
+	if err := s.do(ctx, "", "/v1/auth/"+s.authMount+"/login", map[string]string{}, &resp); err != nil {
+		return "", fmt.Errorf("vault login failed: %w", err)
+	}
+
+	if resp.Auth.ClientToken == "" {
+		return "", errors.New("vault login failed: empty client token")
+	}
+
+	return resp.Auth.ClientToken, nil
+}
+
+func (s *vaultTransitSealer) do(ctx context.Context, token, path string, body, out any) error {
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		var vaultErr struct {
+			Errors []string `json:"errors"`
+		}
+
+		if json.Unmarshal(respBody, &vaultErr) == nil && len(vaultErr.Errors) > 0 {
+			return fmt.Errorf("vault returned %s: %s", resp.Status, strings.Join(vaultErr.Errors, "; "))
+		}
+
+		return fmt.Errorf("vault returned %s", resp.Status)
+	}
+
+	return json.Unmarshal(respBody, out)
+}
@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/luks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+// mockVault implements the subset of the Vault API used by the transit sealer.
+type mockVault struct {
+	denied atomic.Bool
+}
+
+func (v *mockVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req map[string]string
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	reply := func(code int, resp any) {
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}
+
+	if r.URL.Path == "/v1/auth/cert/login" {
+		if len(r.TLS.PeerCertificates) == 0 {
+			reply(http.StatusBadRequest, map[string]any{"errors": []string{"missing client token"}})
+
+			return
+		}
+
+		reply(http.StatusOK, map[string]any{"auth": map[string]any{"client_token": "s.token"}})
+
+		return
+	}
+
+	if r.Header.Get("X-Vault-Token") != "s.token" || v.denied.Load() {
+		reply(http.StatusForbidden, map[string]any{"errors": []string{"permission denied"}})
+
+		return
+	}
+
+	switch r.URL.Path {
+	case "/v1/transit/encrypt/talos":
+		reply(http.StatusOK, map[string]any{"data": map[string]any{"ciphertext": "vault:v1:" + req["context"] + ":" + req["plaintext"]}})
+	case "/v1/transit/decrypt/talos":
+		plaintext, ok := strings.CutPrefix(req["ciphertext"], "vault:v1:"+req["context"]+":")
+		if !ok {
+			reply(http.StatusBadRequest, map[string]any{"errors": []string{"invalid ciphertext"}})
+
+			return
+		}
+
+		reply(http.StatusOK, map[string]any{"data": map[string]any{"plaintext": plaintext}})
+	default:
+		reply(http.StatusNotFound, map[string]any{"errors": []string{}})
+	}
+}
+
+func TestKMSVaultTransit(t *testing.T) {
+	t.Parallel()
+
+	vault := &mockVault{}
+
+	srv := httptest.NewUnstartedServer(vault)
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	clientIdentity, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("talos"))
+	require.NoError(t, err)
+
+	getSystemInfo := func(context.Context) (*hardware.SystemInformation, error) {
+		systemInformation := hardware.NewSystemInformation(hardware.SystemInformationID)
+		systemInformation.TypedSpec().UUID = "0123-4567"
+
+		return systemInformation, nil
+	}
+
+	newHandler := func(lockout bool) keys.Handler {
+		handler, err := keys.NewHandler(block.EncryptionKey{
+			Slot:                   1,
+			Type:                   block.EncryptionKeyKMS,
+			KMSEndpoint:            srv.URL,
+			KMSClientCertificate:   clientIdentity.CrtPEM,
+			KMSClientKey:           clientIdentity.KeyPEM,
+			KMSCA:                  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}),
+			KMSVaultTransitKeyName: "talos",
+			KMSVaultTransitMount:   "transit",
+			KMSVaultAuthMount:      "cert",
+			KMSLockout:             lockout,
+		}, keys.WithSystemInformationGetter(getSystemInfo))
+		require.NoError(t, err)
+
+		return handler
+	}
+
+	ctx := context.Background()
+
+	handler := newHandler(false)
+
+	key, token, err := handler.NewKey(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, key.Slot)
+
+	kmsToken, ok := token.(*luks.Token[*keys.KMSToken])
+	require.True(t, ok)
+
+	assert.Equal(t, keys.TokenTypeKMS, kmsToken.Type)
+	assert.Contains(t, string(kmsToken.UserData.SealedData), "vault:v1:")
+
+	unsealedKey, err := handler.GetKey(ctx, token)
+	require.NoError(t, err)
+
+	assert.Equal(t, key.Value, unsealedKey.Value)
+
+	// the key is revoked in the KMS
+	vault.denied.Store(true)
+
+	_, err = handler.GetKey(ctx, token)
+	require.ErrorContains(t, err, "permission denied")
+	assert.NotErrorIs(t, err, keys.ErrLockout)
+
+	lockoutHandler := newHandler(true)
+
+	_, err = lockoutHandler.GetKey(ctx, token)
+	require.ErrorIs(t, err, keys.ErrLockout)
+
+	// the slot is not enrolled yet, the lockout doesn't apply
+	_, err = lockoutHandler.GetKey(ctx, &luks.Token[*keys.TPMToken]{})
+	require.ErrorIs(t, err, keys.ErrTokenInvalid)
+	assert.NotErrorIs(t, err, keys.ErrLockout)
+}
@@ -0,0 +1,376 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/go-blockdevice/v2/encryption"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/luks"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/token"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// luks2KeySlots is the number of key slots in the LUKS2 header.
+const luks2KeySlots = 32
+
+var (
+	// ErrKeySlotNotConfigured is returned when the key slot is not defined in the encryption config.
+	ErrKeySlotNotConfigured = errors.New("key slot is not configured")
+	// ErrKeySlotNotFound is returned when the key slot is not enrolled in the encrypted volume.
+	ErrKeySlotNotFound = errors.New("key slot not found")
+	// ErrKeySlotInUse is returned when the key slot is already enrolled in the encrypted volume.
+	ErrKeySlotInUse = errors.New("key slot is already in use")
+	// ErrLastKeySlot is returned when removing the key slot would leave the volume without keys.
+	ErrLastKeySlot = errors.New("can't remove the last key slot")
+)
+
+// KeySlot describes a key slot enrolled in the encrypted volume.
+type KeySlot struct {
+	Slot int
+	// TokenType is the type of the token stored for the slot, empty if there is no token.
+	TokenType string
+}
+
+// KeySlots returns the key slots enrolled in the encrypted volume sorted by the slot number.
+func (h *Handler) KeySlots(ctx context.Context, path string) ([]KeySlot, error) {
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]KeySlot, 0, len(keyslots.Keyslots))
+
+	for id := range keyslots.Keyslots {
+		slot, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, err
+		}
+
+		token := luks.Token[json.RawMessage]{}
+
+		if err = h.encryptionProvider.ReadToken(ctx, path, slot, &token); err != nil && !errors.Is(err, encryption.ErrTokenNotFound) {
+			return nil, err
+		}
+
+		result = append(result, KeySlot{
+			Slot:      slot,
+			TokenType: token.Type,
+		})
+	}
+
+	slices.SortFunc(result, func(a, b KeySlot) int { return a.Slot - b.Slot })
+
+	return result, nil
+}
+
+// RotateKey replaces the key in the slot with a new key generated by the configured key handler of the slot.
+//
+// KMS and TPM keys are replaced with new random keys, static and node ID keys are re-enrolled
+// from the current encryption config.
+//
+// The key is not changed in place, as the key and the token of the slot can't be updated atomically.
+// Instead, the new key is enrolled with its token in a free temporary slot first, and only then the old key is removed.
+// If the rotation is interrupted before the new key is enrolled back in the slot, the next time the volume is opened
+// the rotation is resumed from the temporary slot (see resumeRotation).
+// The temporary slot is removed once the new key is enrolled in the slot.
+func (h *Handler) RotateKey(ctx context.Context, logger *zap.Logger, path string, slot int) error {
+	handler := h.keyHandler(slot)
+	if handler == nil {
+		return fmt.Errorf("%w: %d", ErrKeySlotNotConfigured, slot)
+	}
+
+	enrolled, err := h.isEnrolled(path, slot)
+	if err != nil {
+		return err
+	}
+
+	existingKey, err := h.unlockKey(ctx, logger, path, -1)
+	if err != nil {
+		return err
+	}
+
+	newKey, token, err := handler.NewKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	tempSlot, err := h.freeKeySlot(path)
+	if err != nil {
+		return err
+	}
+
+	tempKey := encryption.NewKey(tempSlot, newKey.Value)
+
+	if err = h.enrollKey(ctx, path, existingKey, tempKey, token); err != nil {
+		return fmt.Errorf("error enrolling new key in temporary key slot %d: %w", tempSlot, err)
+	}
+
+	if enrolled {
+		if err = h.encryptionProvider.RemoveKey(ctx, path, slot, tempKey); err != nil {
+			return fmt.Errorf("error removing key slot %d: %w", slot, err)
+		}
+	}
+
+	if err = h.enrollKey(ctx, path, tempKey, newKey, token); err != nil {
+		return fmt.Errorf("error enrolling new key in key slot %d: %w", slot, err)
+	}
+
+	if err = h.encryptionProvider.RemoveKey(ctx, path, tempSlot, newKey); err != nil {
+		return fmt.Errorf("error removing temporary key slot %d: %w", tempSlot, err)
+	}
+
+	logger.Info("rotated encryption key", zap.Int("slot", slot), zap.String("type", fmt.Sprintf("%T", handler)))
+
+	return nil
+}
+
+// AddKey enrolls a new key in the free key slot.
+//
+// Key slots which are not defined in the encryption config are removed the next time the volume is opened.
+func (h *Handler) AddKey(ctx context.Context, logger *zap.Logger, path string, cfg block.EncryptionKey) error {
+	enrolled, err := h.isEnrolled(path, cfg.Slot)
+	if err != nil {
+		return err
+	}
+
+	if enrolled {
+		return fmt.Errorf("%w: %d", ErrKeySlotInUse, cfg.Slot)
+	}
+
+	handler, err := keys.NewHandler(cfg, h.keyOptions...)
+	if err != nil {
+		return err
+	}
+
+	existingKey, err := h.unlockKey(ctx, logger, path, -1)
+	if err != nil {
+		return err
+	}
+
+	if err = h.addKey(ctx, path, existingKey, handler); err != nil {
+		return err
+	}
+
+	logger.Info("added encryption key", zap.Int("slot", cfg.Slot), zap.Stringer("type", cfg.Type))
+
+	return nil
+}
+
+// RemoveKey removes the key slot from the encrypted volume.
+//
+// Key slots which are defined in the encryption config are enrolled again the next time the volume is opened.
+func (h *Handler) RemoveKey(ctx context.Context, logger *zap.Logger, path string, slot int) error {
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := keyslots.Keyslots[strconv.Itoa(slot)]; !ok {
+		return fmt.Errorf("%w: %d", ErrKeySlotNotFound, slot)
+	}
+
+	if len(keyslots.Keyslots) == 1 {
+		return ErrLastKeySlot
+	}
+
+	// the key to remove the slot should come from any other slot
+	existingKey, err := h.unlockKey(ctx, logger, path, slot)
+	if err != nil {
+		return err
+	}
+
+	if err = h.encryptionProvider.RemoveKey(ctx, path, slot, existingKey); err != nil {
+		return fmt.Errorf("error removing key slot %d: %w", slot, err)
+	}
+
+	logger.Info("removed encryption key", zap.Int("slot", slot))
+
+	return nil
+}
+
+func (h *Handler) keyHandler(slot int) keys.Handler {
+	for _, handler := range h.keyHandlers {
+		if handler.Slot() == slot {
+			return handler
+		}
+	}
+
+	return nil
+}
+
+// enrollKey adds the key to the free slot, and sets the token of the slot to match the key.
+func (h *Handler) enrollKey(ctx context.Context, path string, existingKey, key *encryption.Key, token token.Token) error {
+	if err := h.encryptionProvider.AddKey(ctx, path, existingKey, key); err != nil {
+		return err
+	}
+
+	var err error
+
+	if token != nil {
+		err = h.encryptionProvider.SetToken(ctx, path, key.Slot, token)
+	} else if err = h.encryptionProvider.RemoveToken(ctx, path, key.Slot); errors.Is(err, encryption.ErrTokenNotFound) {
+		err = nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error updating token: %w", err)
+	}
+
+	return nil
+}
+
+// resumeRotation finishes the key rotation interrupted after the old key was removed from the slot of the handler.
+//
+// The new key is looked up in the key slots which are not defined in the encryption config, and is enrolled
+// back in the slot of the handler with its token. The temporary slot is removed by the key sync afterwards.
+// If there is no such key, notFoundErr is returned.
+func (h *Handler) resumeRotation(
+	ctx context.Context, logger *zap.Logger, path string, handler keys.Handler, notFoundErr error,
+) (*encryption.Key, token.Token, error) {
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for id := range keyslots.Keyslots {
+		tempSlot, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if h.keyHandler(tempSlot) != nil {
+			continue
+		}
+
+		tempToken, err := h.readToken(ctx, path, tempSlot)
+		if err != nil || tempToken == nil {
+			continue
+		}
+
+		key, err := handler.GetKey(ctx, tempToken)
+		if err != nil {
+			continue
+		}
+
+		tempKey := encryption.NewKey(tempSlot, key.Value)
+
+		if valid, err := h.encryptionProvider.CheckKey(ctx, path, tempKey); err != nil || !valid {
+			continue
+		}
+
+		if _, ok := keyslots.Keyslots[strconv.Itoa(handler.Slot())]; ok {
+			if err = h.encryptionProvider.RemoveKey(ctx, path, handler.Slot(), tempKey); err != nil {
+				return nil, nil, fmt.Errorf("error removing key slot %d: %w", handler.Slot(), err)
+			}
+		}
+
+		if err = h.enrollKey(ctx, path, tempKey, key, tempToken); err != nil {
+			return nil, nil, fmt.Errorf("error enrolling key from temporary key slot %d in key slot %d: %w", tempSlot, handler.Slot(), err)
+		}
+
+		logger.Info("resumed encryption key rotation", zap.Int("slot", handler.Slot()), zap.Int("temporary_slot", tempSlot))
+
+		return key, tempToken, nil
+	}
+
+	return nil, nil, notFoundErr
+}
+
+// freeKeySlot returns the first key slot which is neither enrolled in the volume nor defined in the encryption config.
+func (h *Handler) freeKeySlot(path string) (int, error) {
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return 0, err
+	}
+
+	for slot := range luks2KeySlots {
+		if _, ok := keyslots.Keyslots[strconv.Itoa(slot)]; ok {
+			continue
+		}
+
+		if h.keyHandler(slot) != nil {
+			continue
+		}
+
+		return slot, nil
+	}
+
+	return 0, errors.New("no free key slots")
+}
+
+func (h *Handler) isEnrolled(path string, slot int) (bool, error) {
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := keyslots.Keyslots[strconv.Itoa(slot)]
+
+	return ok, nil
+}
+
+// unlockKey returns the first configured key which unlocks the volume, skipping the excluded slot.
+func (h *Handler) unlockKey(ctx context.Context, logger *zap.Logger, path string, excludeSlot int) (*encryption.Key, error) {
+	var errs error
+
+	for _, handler := range h.keyHandlers {
+		if handler.Slot() == excludeSlot {
+			continue
+		}
+
+		key, err := h.getKey(ctx, path, handler)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+
+			logger.Warn("failed to call key handler", zap.Int("slot", handler.Slot()), zap.Error(err))
+
+			continue
+		}
+
+		return key, nil
+	}
+
+	if errs == nil {
+		errs = errors.New("no encryption keys found")
+	}
+
+	return nil, fmt.Errorf("no keys available to unlock the volume: %w", errs)
+}
+
+func (h *Handler) getKey(ctx context.Context, path string, handler keys.Handler) (*encryption.Key, error) {
+	ctx, cancel := context.WithTimeout(ctx, keyHandlerTimeout)
+	defer cancel()
+
+	token, err := h.readToken(ctx, path, handler.Slot())
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := handler.GetKey(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := h.encryptionProvider.CheckKey(ctx, path, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !valid {
+		return nil, fmt.Errorf("key in slot %d was rejected", handler.Slot())
+	}
+
+	return key, nil
+}
@@ -0,0 +1,217 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"golang.org/x/sync/errgroup"
+)
+
+// ConsistencyLagThreshold is the number of revisions a member can be behind the most recent member
+// before it is reported as lagging.
+const ConsistencyLagThreshold = 1000
+
+// MemberConsistency is the result of the consistency check of a single etcd member.
+type MemberConsistency struct {
+	ID               uint64
+	Name             string
+	Revision         int64
+	RaftAppliedIndex uint64
+	Hash             uint32
+	CompactRevision  int64
+
+	// RevisionLag is the number of revisions the member is behind the most recent member.
+	RevisionLag int64
+	// Divergent is set if the member hash doesn't match the hash of the majority of the members.
+	Divergent bool
+
+	Err error
+}
+
+// ConsistencyReport is the result of the consistency check of all etcd members.
+type ConsistencyReport struct {
+	// HashRevision is the revision the hashes were computed at.
+	HashRevision int64
+	Members      []MemberConsistency
+}
+
+// Divergent returns the members with the diverged data.
+func (report *ConsistencyReport) Divergent() []MemberConsistency {
+	return slices.DeleteFunc(slices.Clone(report.Members), func(member MemberConsistency) bool {
+		return !member.Divergent
+	})
+}
+
+// Lagging returns the members which are behind the most recent member more than ConsistencyLagThreshold revisions.
+func (report *ConsistencyReport) Lagging() []MemberConsistency {
+	return slices.DeleteFunc(slices.Clone(report.Members), func(member MemberConsistency) bool {
+		return member.RevisionLag <= ConsistencyLagThreshold
+	})
+}
+
+// Unreachable returns the members which failed the check.
+func (report *ConsistencyReport) Unreachable() []MemberConsistency {
+	return slices.DeleteFunc(slices.Clone(report.Members), func(member MemberConsistency) bool {
+		return member.Err == nil
+	})
+}
+
+// MaxRevisionLag returns the maximum revision lag across the members.
+func (report *ConsistencyReport) MaxRevisionLag() int64 {
+	var lag int64
+
+	for _, member := range report.Members {
+		lag = max(lag, member.RevisionLag)
+	}
+
+	return lag
+}
+
+// CheckConsistency compares the key-value store hashes and revisions of all members of the etcd cluster.
+//
+// The hashes are computed at the lowest revision across the members, so that the members which are
+// behind can still be compared with the rest of the cluster.
+//
+//nolint:gocyclo
+func (c *Client) CheckConsistency(ctx context.Context) (*ConsistencyReport, error) {
+	memberList, err := c.MemberList(clientv3.WithRequireLeader(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error listing etcd members: %w", err)
+	}
+
+	members := make([]MemberConsistency, len(memberList.Members))
+	endpoints := make([]string, len(memberList.Members))
+
+	for i, member := range memberList.Members {
+		members[i] = MemberConsistency{
+			ID:   member.GetID(),
+			Name: member.GetName(),
+		}
+
+		if len(member.GetClientURLs()) == 0 {
+			members[i].Err = errors.New("member has no client URLs")
+
+			continue
+		}
+
+		endpoints[i] = member.GetClientURLs()[0]
+	}
+
+	var statusEg errgroup.Group
+
+	for i := range members {
+		if members[i].Err != nil {
+			continue
+		}
+
+		statusEg.Go(func() error {
+			status, statusErr := c.Status(ctx, endpoints[i])
+			if statusErr != nil {
+				members[i].Err = fmt.Errorf("error getting member status: %w", statusErr)
+
+				return nil
+			}
+
+			members[i].Revision = status.Header.GetRevision()
+			members[i].RaftAppliedIndex = status.RaftAppliedIndex
+
+			return nil
+		})
+	}
+
+	statusEg.Wait() //nolint:errcheck
+
+	var (
+		hashRevision int64
+		maxRevision  int64
+	)
+
+	for _, member := range members {
+		if member.Err != nil {
+			continue
+		}
+
+		if hashRevision == 0 || member.Revision < hashRevision {
+			hashRevision = member.Revision
+		}
+
+		maxRevision = max(maxRevision, member.Revision)
+	}
+
+	if hashRevision == 0 {
+		return nil, errors.New("no etcd members are reachable")
+	}
+
+	var hashEg errgroup.Group
+
+	for i := range members {
+		if members[i].Err != nil {
+			continue
+		}
+
+		members[i].RevisionLag = maxRevision - members[i].Revision
+
+		hashEg.Go(func() error {
+			resp, hashErr := c.HashKV(ctx, endpoints[i], hashRevision)
+			if hashErr != nil {
+				members[i].Err = fmt.Errorf("error getting member hash: %w", hashErr)
+
+				return nil
+			}
+
+			members[i].Hash = resp.Hash
+			members[i].CompactRevision = resp.CompactRevision
+
+			return nil
+		})
+	}
+
+	hashEg.Wait() //nolint:errcheck
+
+	markDivergent(members)
+
+	return &ConsistencyReport{
+		HashRevision: hashRevision,
+		Members:      members,
+	}, nil
+}
+
+// markDivergent marks the members which hash doesn't match the majority of the members.
+//
+// Hashes are only comparable for the members with the same compact revision.
+// If there is no majority within the group, all members of the group are marked as divergent.
+func markDivergent(members []MemberConsistency) {
+	type hashKey struct {
+		compactRevision int64
+		hash            uint32
+	}
+
+	groupSize := map[int64]int{}
+	hashCount := map[hashKey]int{}
+
+	for _, member := range members {
+		if member.Err != nil {
+			continue
+		}
+
+		groupSize[member.CompactRevision]++
+		hashCount[hashKey{member.CompactRevision, member.Hash}]++
+	}
+
+	for i, member := range members {
+		if member.Err != nil {
+			continue
+		}
+
+		count := hashCount[hashKey{member.CompactRevision, member.Hash}]
+
+		members[i].Divergent = count*2 <= groupSize[member.CompactRevision]
+	}
+}
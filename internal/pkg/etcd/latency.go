@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultLatencySamples is the default number of requests sent to each member to measure the latency.
+const DefaultLatencySamples = 5
+
+// MemberLatency is the request latency to a single etcd member.
+type MemberLatency struct {
+	ID   uint64
+	Name string
+
+	Min time.Duration
+	Avg time.Duration
+	Max time.Duration
+
+	Err error
+}
+
+// MeasureLatency measures the round-trip time of the status requests to each member of the etcd cluster.
+//
+// The connection to each member is established and warmed up before the measurement,
+// so that the latency doesn't include the connection setup.
+func (c *Client) MeasureLatency(ctx context.Context, samples int) ([]MemberLatency, error) {
+	memberList, err := c.MemberList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing etcd members: %w", err)
+	}
+
+	members := make([]MemberLatency, len(memberList.Members))
+
+	var eg errgroup.Group
+
+	for i, member := range memberList.Members {
+		members[i] = MemberLatency{
+			ID:   member.GetID(),
+			Name: member.GetName(),
+		}
+
+		if len(member.GetClientURLs()) == 0 {
+			members[i].Err = errors.New("member has no client URLs")
+
+			continue
+		}
+
+		eg.Go(func() error {
+			members[i].Err = c.measureMemberLatency(ctx, member.GetClientURLs()[0], samples, &members[i])
+
+			return nil
+		})
+	}
+
+	eg.Wait() //nolint:errcheck
+
+	return members, nil
+}
+
+func (c *Client) measureMemberLatency(ctx context.Context, endpoint string, samples int, latency *MemberLatency) error {
+	conn, err := c.Dial(endpoint)
+	if err != nil {
+		return fmt.Errorf("error dialing member: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	maintenance := etcdserverpb.NewMaintenanceClient(conn)
+
+	// warm up the connection
+	if _, err = maintenance.Status(ctx, &etcdserverpb.StatusRequest{}); err != nil {
+		return fmt.Errorf("error getting member status: %w", clientv3.ContextError(ctx, err))
+	}
+
+	var total time.Duration
+
+	for i := range samples {
+		start := time.Now()
+
+		if _, err = maintenance.Status(ctx, &etcdserverpb.StatusRequest{}); err != nil {
+			return fmt.Errorf("error getting member status: %w", clientv3.ContextError(ctx, err))
+		}
+
+		elapsed := time.Since(start)
+		total += elapsed
+
+		if i == 0 || elapsed < latency.Min {
+			latency.Min = elapsed
+		}
+
+		latency.Max = max(latency.Max, elapsed)
+	}
+
+	latency.Avg = total / time.Duration(samples)
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// CheckQuorum checks whether the etcd cluster keeps the quorum once the local member goes down (or leaves the cluster).
+//
+// The learners are not counted, as they don't vote. A single-member cluster can't keep the quorum
+// through any operation on its only member, so the check doesn't apply to it.
+// The returned error is a *client.QuorumError explaining the refusal.
+func CheckQuorum(operation string, members []*etcdserverpb.Member, healthy map[uint64]struct{}, localMemberID uint64, leave bool) error {
+	quorumErr := client.QuorumError{
+		Operation: operation,
+	}
+
+	isVoter := false
+
+	for _, member := range members {
+		if member.IsLearner {
+			continue
+		}
+
+		quorumErr.Members++
+
+		if _, ok := healthy[member.ID]; ok {
+			quorumErr.Healthy++
+		} else {
+			quorumErr.Unhealthy = append(quorumErr.Unhealthy, memberName(member))
+		}
+
+		if member.ID == localMemberID {
+			isVoter = true
+		}
+	}
+
+	if !isVoter || quorumErr.Members < 2 {
+		return nil
+	}
+
+	votersAfter := quorumErr.Members
+
+	if leave {
+		votersAfter--
+	}
+
+	quorumErr.Required = votersAfter/2 + 1
+	quorumErr.Remaining = quorumErr.Healthy
+
+	if _, ok := healthy[localMemberID]; ok {
+		quorumErr.Remaining--
+	}
+
+	if quorumErr.Remaining >= quorumErr.Required {
+		return nil
+	}
+
+	return &quorumErr
+}
+
+// ValidateQuorumImpact checks the health of the etcd members and validates that the etcd cluster keeps the quorum
+// once the local member goes down (or leaves the cluster, if leave is set).
+func (c *Client) ValidateQuorumImpact(ctx context.Context, operation string, localMemberID uint64, leave bool) error {
+	resp, err := c.MemberList(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list etcd members: %w", err)
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	healthy := map[uint64]struct{}{}
+
+	for _, member := range resp.Members {
+		// If the member is not started, the name will be an empty string.
+		if member.IsLearner || len(member.Name) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if validateMemberHealth(ctx, member.GetClientURLs()) != nil {
+				return
+			}
+
+			mu.Lock()
+			healthy[member.ID] = struct{}{}
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	return CheckQuorum(operation, resp.Members, healthy, localMemberID, leave)
+}
+
+func memberName(member *etcdserverpb.Member) string {
+	if member.Name != "" {
+		return member.Name
+	}
+
+	return fmt.Sprintf("%016x", member.ID)
+}
@@ -0,0 +1,133 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+
+	"github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func TestCheckQuorum(t *testing.T) {
+	t.Parallel()
+
+	members := func(n int) []*etcdserverpb.Member {
+		result := make([]*etcdserverpb.Member, 0, n)
+
+		for i := range n {
+			result = append(result, &etcdserverpb.Member{ID: uint64(i + 1), Name: "cp-" + string(rune('1'+i))})
+		}
+
+		return result
+	}
+
+	healthy := func(ids ...uint64) map[uint64]struct{} {
+		result := map[uint64]struct{}{}
+
+		for _, id := range ids {
+			result[id] = struct{}{}
+		}
+
+		return result
+	}
+
+	for _, tt := range []struct {
+		name    string
+		members []*etcdserverpb.Member
+		healthy map[uint64]struct{}
+		leave   bool
+
+		expected *client.QuorumError
+	}{
+		{
+			name:    "single member",
+			members: members(1),
+			healthy: healthy(1),
+		},
+		{
+			name:    "three healthy members",
+			members: members(3),
+			healthy: healthy(1, 2, 3),
+		},
+		{
+			name:    "three members, one unhealthy",
+			members: members(3),
+			healthy: healthy(1, 2),
+			expected: &client.QuorumError{
+				Operation: "shutdown",
+				Unhealthy: []string{"cp-3"},
+				Members:   3,
+				Healthy:   2,
+				Required:  2,
+				Remaining: 1,
+			},
+		},
+		{
+			name:    "three members, local unhealthy",
+			members: members(3),
+			healthy: healthy(2, 3),
+		},
+		{
+			name:    "two members",
+			members: members(2),
+			healthy: healthy(1, 2),
+			expected: &client.QuorumError{
+				Operation: "shutdown",
+				Members:   2,
+				Healthy:   2,
+				Required:  2,
+				Remaining: 1,
+			},
+		},
+		{
+			name:    "two members, leave",
+			members: members(2),
+			healthy: healthy(1, 2),
+			leave:   true,
+		},
+		{
+			name:    "five members, one unhealthy",
+			members: members(5),
+			healthy: healthy(1, 2, 3, 4),
+		},
+		{
+			name:    "learner doesn't vote",
+			members: append(members(2), &etcdserverpb.Member{ID: 3, Name: "cp-3", IsLearner: true}),
+			healthy: healthy(1, 2, 3),
+			expected: &client.QuorumError{
+				Operation: "shutdown",
+				Members:   2,
+				Healthy:   2,
+				Required:  2,
+				Remaining: 1,
+			},
+		},
+		{
+			name:    "local learner",
+			members: []*etcdserverpb.Member{{ID: 1, Name: "cp-1", IsLearner: true}, {ID: 2, Name: "cp-2"}},
+			healthy: healthy(1, 2),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := etcd.CheckQuorum("shutdown", tt.members, tt.healthy, 1, tt.leave)
+
+			if tt.expected == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, client.QuorumLoss(err))
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package fstrim discards the unused blocks of the mounted filesystems.
+package fstrim
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// fitrim is the FITRIM ioctl request: _IOWR('X', 121, struct fstrim_range).
+const fitrim = 0xc0185879
+
+// fstrimRange is the struct fstrim_range.
+type fstrimRange struct {
+	Start  uint64
+	Len    uint64
+	MinLen uint64
+}
+
+// supportedTypes is the list of the filesystem types which support FITRIM.
+var supportedTypes = []string{"btrfs", "ext4", "f2fs", "vfat", "xfs"}
+
+// Filesystem is a mounted filesystem which can be trimmed.
+type Filesystem struct {
+	Device     string
+	Mountpoint string
+	Type       string
+	Encrypted  bool
+}
+
+// Result is the result of trimming a filesystem.
+type Result struct {
+	Filesystem
+
+	// Trimmed is the number of bytes discarded by the filesystem.
+	Trimmed uint64
+	Err     error
+}
+
+// Event converts the result to the event.
+func (result Result) Event() *machine.FilesystemTrimEvent {
+	event := &machine.FilesystemTrimEvent{
+		Mountpoint:   result.Mountpoint,
+		Device:       result.Device,
+		TrimmedBytes: result.Trimmed,
+		Encrypted:    result.Encrypted,
+	}
+
+	if result.Err != nil {
+		event.Error = result.Err.Error()
+	}
+
+	return event
+}
+
+// Filesystems parses the mount table (in /proc/mounts format) and returns the filesystems which can be trimmed.
+//
+// The read-only filesystems are skipped, and each device is returned only once.
+func Filesystems(r io.Reader) ([]Filesystem, error) {
+	var filesystems []Filesystem
+
+	seen := map[string]struct{}{}
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) < 4 {
+			continue
+		}
+
+		device, mountpoint, fsType, options := fields[0], fields[1], fields[2], strings.Split(fields[3], ",")
+
+		if !strings.HasPrefix(device, "/dev/") || !slices.Contains(supportedTypes, fsType) || slices.Contains(options, "ro") {
+			continue
+		}
+
+		if _, ok := seen[device]; ok {
+			continue
+		}
+
+		seen[device] = struct{}{}
+
+		filesystems = append(filesystems, Filesystem{
+			Device:     device,
+			Mountpoint: mountpoint,
+			Type:       fsType,
+		})
+	}
+
+	return filesystems, scanner.Err()
+}
+
+// Mounted returns the currently mounted filesystems which can be trimmed.
+func Mounted() ([]Filesystem, error) {
+	f, err := os.Open("/proc/mounts")
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	filesystems, err := Filesystems(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading mounts: %w", err)
+	}
+
+	for i := range filesystems {
+		filesystems[i].Encrypted = isEncrypted(filesystems[i].Device)
+	}
+
+	return filesystems, nil
+}
+
+// isEncrypted checks whether the device is a dm-crypt mapping.
+func isEncrypted(device string) bool {
+	var st unix.Stat_t
+
+	if err := unix.Stat(device, &st); err != nil {
+		return false
+	}
+
+	uuid, err := os.ReadFile(filepath.Join("/sys/dev/block", fmt.Sprintf("%d:%d", unix.Major(st.Rdev), unix.Minor(st.Rdev)), "dm", "uuid"))
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(string(uuid), "CRYPT-")
+}
+
+// Trim discards the unused blocks of the filesystem mounted at the mountpoint, and returns the number of bytes discarded.
+func Trim(mountpoint string) (uint64, error) {
+	f, err := os.OpenFile(mountpoint, os.O_RDONLY|unix.O_DIRECTORY, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	r := fstrimRange{
+		Len: math.MaxUint64,
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fitrim, uintptr(unsafe.Pointer(&r))); errno != 0 {
+		return 0, errno
+	}
+
+	return r.Len, nil
+}
+
+// trimMu serializes the concurrent trim runs.
+var trimMu sync.Mutex
+
+// TrimAll trims all mounted filesystems.
+//
+// The filesystems on the encrypted volumes are skipped unless includeEncrypted is set,
+// as discarding the unused blocks reveals which blocks of the encrypted volume are in use.
+func TrimAll(ctx context.Context, includeEncrypted bool) ([]Result, error) {
+	trimMu.Lock()
+	defer trimMu.Unlock()
+
+	filesystems, err := Mounted()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, 0, len(filesystems))
+
+	for _, filesystem := range filesystems {
+		if filesystem.Encrypted && !includeEncrypted {
+			continue
+		}
+
+		if err = ctx.Err(); err != nil {
+			return results, err
+		}
+
+		trimmed, trimErr := Trim(filesystem.Mountpoint)
+
+		results = append(results, Result{
+			Filesystem: filesystem,
+			Trimmed:    trimmed,
+			Err:        trimErr,
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package fstrim_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/fstrim"
+)
+
+const mounts = `rootfs / rootfs rw 0 0
+/dev/loop0 / squashfs ro,relatime,errors=continue 0 0
+proc /proc proc rw,nosuid,nodev,noexec,relatime 0 0
+/dev/sda3 /system/state xfs rw,relatime,attr2,inode64,logbufs=8,logbsize=32k,noquota 0 0
+/dev/sda1 /boot/EFI vfat ro,relatime,fmask=0022,dmask=0022 0 0
+/dev/mapper/luks2-ephemeral /var xfs rw,relatime,attr2,inode64,logbufs=8,logbsize=32k,noquota 0 0
+/dev/mapper/luks2-ephemeral /etc/cni xfs rw,relatime,attr2,inode64,logbufs=8,logbsize=32k,noquota 0 0
+tmpfs /run tmpfs rw,nosuid,nodev,mode=755 0 0
+/dev/sdb1 /var/mnt/data ext4 rw,relatime 0 0
+`
+
+func TestFilesystems(t *testing.T) {
+	t.Parallel()
+
+	filesystems, err := fstrim.Filesystems(strings.NewReader(mounts))
+	require.NoError(t, err)
+
+	assert.Equal(t, []fstrim.Filesystem{
+		{
+			Device:     "/dev/sda3",
+			Mountpoint: "/system/state",
+			Type:       "xfs",
+		},
+		{
+			Device:     "/dev/mapper/luks2-ephemeral",
+			Mountpoint: "/var",
+			Type:       "xfs",
+		},
+		{
+			Device:     "/dev/sdb1",
+			Mountpoint: "/var/mnt/data",
+			Type:       "ext4",
+		},
+	}, filesystems)
+}
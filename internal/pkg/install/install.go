@@ -88,7 +88,7 @@ func RunInstallerContainer(disk, platform, ref string, cfg configcore.Config, cf
 	if img == nil || err != nil && errdefs.IsNotFound(err) {
 		log.Printf("pulling %q", ref)
 
-		img, err = image.Pull(ctx, registriesConfig, client, ref)
+		img, err = image.Pull(ctx, registriesConfig, client, ref, image.WithRateLimit(options.PullRateLimit))
 	}
 
 	if err != nil {
@@ -296,5 +296,22 @@ func OptionsFromUpgradeRequest(r runtime.Runtime, in *machineapi.UpgradeRequest)
 		opts = append(opts, WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()))
 	}
 
+	opts = append(opts, WithPullRateLimit(PullRateLimitFromUpgradeRequest(r, in)))
+
 	return opts
 }
+
+// PullRateLimitFromUpgradeRequest returns the installer image pull bandwidth limit for the upgrade request.
+//
+// The limit set in the request takes precedence over the machine configuration.
+func PullRateLimitFromUpgradeRequest(r runtime.Runtime, in *machineapi.UpgradeRequest) uint64 {
+	if in.GetPullRateLimit() > 0 {
+		return in.GetPullRateLimit()
+	}
+
+	if r.Config() != nil && r.Config().Machine() != nil {
+		return r.Config().Machine().Install().PullRateLimit()
+	}
+
+	return 0
+}
@@ -14,6 +14,7 @@ type Options struct {
 	Upgrade         bool
 	Zero            bool
 	ExtraKernelArgs []string
+	PullRateLimit   uint64
 }
 
 // DefaultInstallOptions returns default options.
@@ -87,3 +88,12 @@ func WithExtraKernelArgs(s []string) Option {
 		return nil
 	}
 }
+
+// WithPullRateLimit sets the installer image pull bandwidth limit (in bytes per second).
+func WithPullRateLimit(bytesPerSecond uint64) Option {
+	return func(o *Options) error {
+		o.PullRateLimit = bytesPerSecond
+
+		return nil
+	}
+}
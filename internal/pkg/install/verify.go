@@ -0,0 +1,185 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+
+	containerd "github.com/containerd/containerd/v2/client"
+	"github.com/containerd/containerd/v2/core/mount"
+	"github.com/containerd/containerd/v2/pkg/namespaces"
+	"github.com/containerd/errdefs"
+	"github.com/distribution/reference"
+	"github.com/opencontainers/image-spec/identity"
+
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/pesign"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// SignatureExtension is the extension of the detached signature files of the kernel and initramfs.
+const SignatureExtension = ".sig"
+
+// VerifiedAsset describes the boot asset with the verified signature.
+type VerifiedAsset struct {
+	// Path of the asset in the installer image.
+	Path string
+	// Signer is the certificate the asset is signed with.
+	Signer *x509.Certificate
+}
+
+// VerifyInstallerImage verifies the signatures of the boot assets in the installer image against the trusted certificates.
+//
+// The image should be already pulled (see PullAndValidateInstallerImage).
+// The reference of the verified image pinned to the digest (repository@sha256:...) is returned,
+// so that the image which gets installed is exactly the verified one, even if the tag is moved afterwards.
+//
+//nolint:gocyclo
+func VerifyInstallerImage(ctx context.Context, reg config.Registries, ref string, certs []*x509.Certificate, pullOpts ...image.PullOption) (string, []VerifiedAsset, error) {
+	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
+
+	const snapshotKey = "verify"
+
+	client, err := containerd.New(constants.SystemContainerdAddress)
+	if err != nil {
+		return "", nil, err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	namedRef, err := reference.ParseDockerRef(ref)
+	if err != nil {
+		return "", nil, err
+	}
+
+	img, err := image.Pull(containerdctx, reg, client, ref, append([]image.PullOption{image.WithSkipIfAlreadyPulled()}, pullOpts...)...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	pinnedRef, err := reference.WithDigest(reference.TrimNamed(namedRef), img.Target().Digest)
+	if err != nil {
+		return "", nil, err
+	}
+
+	diffs, err := img.RootFS(containerdctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	snapshotService := client.SnapshotService("")
+
+	if err = snapshotService.Remove(containerdctx, snapshotKey); err != nil && !errdefs.IsNotFound(err) {
+		return "", nil, fmt.Errorf("error cleaning up stale snapshot: %w", err)
+	}
+
+	mounts, err := snapshotService.View(containerdctx, snapshotKey, identity.ChainID(diffs).String())
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating installer image snapshot: %w", err)
+	}
+
+	//nolint:errcheck
+	defer snapshotService.Remove(containerdctx, snapshotKey)
+
+	var verified []VerifiedAsset
+
+	err = mount.WithReadonlyTempMount(containerdctx, mounts, func(root string) error {
+		verified, err = VerifyBootAssets(root, goruntime.GOARCH, certs)
+
+		return err
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return pinnedRef.String(), verified, nil
+}
+
+// VerifyBootAssets verifies the signatures of the boot assets in the installer image root.
+//
+// If the image contains the UKI, its Authenticode signature is verified.
+// Otherwise, the kernel and initramfs are verified against the detached signatures
+// (the files with the SignatureExtension next to the assets).
+func VerifyBootAssets(root, arch string, certs []*x509.Certificate) ([]VerifiedAsset, error) {
+	if len(certs) == 0 {
+		return nil, errors.New("no trusted certificates")
+	}
+
+	ukiPath := fmt.Sprintf(constants.UKIAssetPath, arch)
+
+	if _, err := os.Stat(filepath.Join(root, ukiPath)); err == nil {
+		signer, err := pesign.Verify(filepath.Join(root, ukiPath), certs)
+		if err != nil {
+			return nil, fmt.Errorf("error verifying %q: %w", ukiPath, err)
+		}
+
+		return []VerifiedAsset{{Path: ukiPath, Signer: signer}}, nil
+	}
+
+	verified := make([]VerifiedAsset, 0, 2)
+
+	for _, path := range []string{
+		fmt.Sprintf(constants.KernelAssetPath, arch),
+		fmt.Sprintf(constants.InitramfsAssetPath, arch),
+	} {
+		signer, err := verifyDetachedSignature(filepath.Join(root, path), certs)
+		if err != nil {
+			return nil, fmt.Errorf("error verifying %q: %w", path, err)
+		}
+
+		verified = append(verified, VerifiedAsset{Path: path, Signer: signer})
+	}
+
+	return verified, nil
+}
+
+func verifyDetachedSignature(path string, certs []*x509.Certificate) (*x509.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := os.ReadFile(path + SignatureExtension)
+	if err != nil {
+		return nil, fmt.Errorf("error reading detached signature: %w", err)
+	}
+
+	for _, cert := range certs {
+		algorithm, ok := signatureAlgorithm(cert)
+		if !ok {
+			continue
+		}
+
+		if cert.CheckSignature(algorithm, data, signature) == nil {
+			return cert, nil
+		}
+	}
+
+	return nil, errors.New("asset is not signed by any of the trusted certificates")
+}
+
+// signatureAlgorithm returns the SHA-256 based signature algorithm for the certificate public key.
+func signatureAlgorithm(cert *x509.Certificate) (x509.SignatureAlgorithm, bool) {
+	switch cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return x509.SHA256WithRSA, true
+	case *ecdsa.PublicKey:
+		return x509.ECDSAWithSHA256, true
+	case ed25519.PublicKey:
+		return x509.PureEd25519, true
+	default:
+		return 0, false
+	}
+}
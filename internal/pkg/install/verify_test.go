@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/install"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+func newSigner(t *testing.T, name string) (*ecdsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return key, cert
+}
+
+func writeAsset(t *testing.T, root, pathTemplate string, key *ecdsa.PrivateKey) {
+	t.Helper()
+
+	path := filepath.Join(root, fmt.Sprintf(pathTemplate, "amd64"))
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(path), 0o644))
+
+	if key == nil {
+		return
+	}
+
+	digest := sha256.Sum256([]byte(path))
+
+	signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path+install.SignatureExtension, signature, 0o644))
+}
+
+func TestVerifyBootAssets(t *testing.T) {
+	t.Parallel()
+
+	trustedKey, trustedCert := newSigner(t, "trusted")
+	otherKey, otherCert := newSigner(t, "other")
+
+	t.Run("signed", func(t *testing.T) {
+		t.Parallel()
+
+		root := t.TempDir()
+
+		writeAsset(t, root, constants.KernelAssetPath, trustedKey)
+		writeAsset(t, root, constants.InitramfsAssetPath, trustedKey)
+
+		verified, err := install.VerifyBootAssets(root, "amd64", []*x509.Certificate{otherCert, trustedCert})
+		require.NoError(t, err)
+
+		require.Len(t, verified, 2)
+		require.Equal(t, "/usr/install/amd64/vmlinuz", verified[0].Path)
+		require.Equal(t, trustedCert, verified[0].Signer)
+		require.Equal(t, "/usr/install/amd64/initramfs.xz", verified[1].Path)
+		require.Equal(t, trustedCert, verified[1].Signer)
+	})
+
+	t.Run("untrusted signer", func(t *testing.T) {
+		t.Parallel()
+
+		root := t.TempDir()
+
+		writeAsset(t, root, constants.KernelAssetPath, trustedKey)
+		writeAsset(t, root, constants.InitramfsAssetPath, otherKey)
+
+		_, err := install.VerifyBootAssets(root, "amd64", []*x509.Certificate{trustedCert})
+		require.ErrorContains(t, err, "initramfs.xz\": asset is not signed by any of the trusted certificates")
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		t.Parallel()
+
+		root := t.TempDir()
+
+		writeAsset(t, root, constants.KernelAssetPath, nil)
+		writeAsset(t, root, constants.InitramfsAssetPath, trustedKey)
+
+		_, err := install.VerifyBootAssets(root, "amd64", []*x509.Certificate{trustedCert})
+		require.ErrorContains(t, err, "error reading detached signature")
+	})
+
+	t.Run("unsigned UKI", func(t *testing.T) {
+		t.Parallel()
+
+		root := t.TempDir()
+
+		writeAsset(t, root, constants.UKIAssetPath, nil)
+		writeAsset(t, root, constants.KernelAssetPath, trustedKey)
+		writeAsset(t, root, constants.InitramfsAssetPath, trustedKey)
+
+		_, err := install.VerifyBootAssets(root, "amd64", []*x509.Certificate{trustedCert})
+		require.ErrorContains(t, err, "vmlinuz.efi.signed")
+	})
+}
@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package integrity implements verification of the Talos rootfs image against the checksum manifest.
+package integrity
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Status of the rootfs integrity verification.
+type Status int
+
+// Status values.
+const (
+	// StatusUnavailable means that the verification can't be performed (e.g. no manifest or no rootfs image).
+	StatusUnavailable Status = iota
+	// StatusVerified means that the rootfs image matches the manifest.
+	StatusVerified
+	// StatusMismatch means that the rootfs image doesn't match the manifest.
+	StatusMismatch
+)
+
+// Result of the rootfs integrity verification.
+type Result struct {
+	// Status of the verification.
+	Status Status
+	// Device is the loop device backing the rootfs.
+	Device string
+	// Expected is the SHA256 digest from the manifest.
+	Expected string
+	// Actual is the SHA256 digest of the rootfs image.
+	Actual string
+	// Error is the reason verification is unavailable.
+	Error error
+}
+
+// Options configure the paths used for verification.
+type Options struct {
+	// SysBlockPath is the path to the /sys/block.
+	SysBlockPath string
+	// DevPath is the path to the /dev.
+	DevPath string
+	// ManifestPath is the path to the checksum manifest.
+	ManifestPath string
+	// BackingFile is the path to the rootfs image attached to the loop device.
+	BackingFile string
+}
+
+// DefaultOptions returns the options for the running Talos system.
+func DefaultOptions() Options {
+	return Options{
+		SysBlockPath: "/sys/block",
+		DevPath:      "/dev",
+		ManifestPath: constants.RootfsChecksumFile,
+		BackingFile:  "/" + constants.RootfsAsset,
+	}
+}
+
+// VerifyRootfs computes the digest of the rootfs image and compares it with the manifest.
+//
+// Failures to locate the manifest or the rootfs image are reported as StatusUnavailable,
+// read errors and context cancellation are returned as errors.
+func VerifyRootfs(ctx context.Context, opts Options) (*Result, error) {
+	expected, err := ReadManifest(opts.ManifestPath)
+	if err != nil {
+		return &Result{Status: StatusUnavailable, Error: err}, nil
+	}
+
+	device, err := FindLoopDevice(opts.SysBlockPath, opts.BackingFile)
+	if err != nil {
+		return &Result{Status: StatusUnavailable, Expected: expected, Error: err}, nil
+	}
+
+	device = filepath.Join(opts.DevPath, device)
+
+	actual, err := Checksum(ctx, device)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Status:   StatusMismatch,
+		Device:   device,
+		Expected: expected,
+		Actual:   actual,
+	}
+
+	if actual == expected {
+		result.Status = StatusVerified
+	}
+
+	return result, nil
+}
+
+// ReadManifest reads the SHA256 digest from the manifest in `sha256sum` format.
+func ReadManifest(path string) (string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading checksum manifest: %w", err)
+	}
+
+	fields := strings.Fields(string(contents))
+	if len(fields) == 0 {
+		return "", errors.New("checksum manifest is empty")
+	}
+
+	digest := strings.ToLower(fields[0])
+
+	if decoded, err := hex.DecodeString(digest); err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("invalid checksum in the manifest: %q", fields[0])
+	}
+
+	return digest, nil
+}
+
+// FindLoopDevice returns the name of the loop device attached to the backing file.
+func FindLoopDevice(sysBlockPath, backingFile string) (string, error) {
+	matches, err := filepath.Glob(filepath.Join(sysBlockPath, "loop*", "loop", "backing_file"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, match := range matches {
+		contents, err := os.ReadFile(match)
+		if err != nil {
+			continue
+		}
+
+		// the backing file is deleted from the initramfs after switching to the rootfs
+		path := strings.TrimSuffix(strings.TrimSpace(string(contents)), " (deleted)")
+
+		if path == backingFile {
+			return filepath.Base(filepath.Dir(filepath.Dir(match))), nil
+		}
+	}
+
+	return "", fmt.Errorf("no loop device found for %q", backingFile)
+}
+
+// Checksum computes the SHA256 digest of the file contents.
+func Checksum(ctx context.Context, path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	h := sha256.New()
+
+	if _, err = io.Copy(h, &contextReader{ctx: ctx, r: f}); err != nil {
+		return "", fmt.Errorf("error reading %q: %w", path, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type contextReader struct {
+	ctx context.Context //nolint:containedctx
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return r.r.Read(p)
+}
@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package integrity_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/integrity"
+)
+
+func setup(t *testing.T, image []byte, manifest string) integrity.Options {
+	t.Helper()
+
+	root := t.TempDir()
+
+	opts := integrity.Options{
+		SysBlockPath: filepath.Join(root, "sys", "block"),
+		DevPath:      filepath.Join(root, "dev"),
+		ManifestPath: filepath.Join(root, "rootfs.sha256"),
+		BackingFile:  "/rootfs.sqsh",
+	}
+
+	for device, backingFile := range map[string]string{
+		"loop0": "/var/lib/other.img\n",
+		"loop1": "/rootfs.sqsh (deleted)\n",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(opts.SysBlockPath, device, "loop"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(opts.SysBlockPath, device, "loop", "backing_file"), []byte(backingFile), 0o644))
+	}
+
+	require.NoError(t, os.MkdirAll(opts.DevPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(opts.DevPath, "loop1"), image, 0o644))
+
+	if manifest != "" {
+		require.NoError(t, os.WriteFile(opts.ManifestPath, []byte(manifest), 0o644))
+	}
+
+	return opts
+}
+
+func TestVerifyRootfs(t *testing.T) {
+	t.Parallel()
+
+	image := []byte("squashfs image")
+	digest := sha256.Sum256(image)
+	expected := hex.EncodeToString(digest[:])
+
+	for _, test := range []struct {
+		name     string
+		manifest string
+		image    []byte
+
+		expectedStatus integrity.Status
+	}{
+		{
+			name:     "verified",
+			manifest: expected + "  rootfs.sqsh\n",
+			image:    image,
+
+			expectedStatus: integrity.StatusVerified,
+		},
+		{
+			name:     "mismatch",
+			manifest: expected + "  rootfs.sqsh\n",
+			image:    []byte("tampered image"),
+
+			expectedStatus: integrity.StatusMismatch,
+		},
+		{
+			name:  "no manifest",
+			image: image,
+
+			expectedStatus: integrity.StatusUnavailable,
+		},
+		{
+			name:     "invalid manifest",
+			manifest: "foo  rootfs.sqsh\n",
+			image:    image,
+
+			expectedStatus: integrity.StatusUnavailable,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := setup(t, test.image, test.manifest)
+
+			result, err := integrity.VerifyRootfs(context.Background(), opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedStatus, result.Status)
+
+			if test.expectedStatus == integrity.StatusUnavailable {
+				assert.Error(t, result.Error)
+
+				return
+			}
+
+			assert.Equal(t, filepath.Join(opts.DevPath, "loop1"), result.Device)
+			assert.Equal(t, expected, result.Expected)
+		})
+	}
+}
+
+func TestVerifyRootfsNoDevice(t *testing.T) {
+	t.Parallel()
+
+	opts := setup(t, nil, "0000000000000000000000000000000000000000000000000000000000000000  rootfs.sqsh\n")
+	opts.BackingFile = "/missing.sqsh"
+
+	result, err := integrity.VerifyRootfs(context.Background(), opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, integrity.StatusUnavailable, result.Status)
+	assert.EqualError(t, result.Error, `no loop device found for "/missing.sqsh"`)
+}
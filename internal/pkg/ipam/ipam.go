@@ -0,0 +1,154 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ipam implements static address allocation via an external IP address management (IPAM) plugin.
+package ipam
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/siderolabs/talos/internal/pkg/endpoint"
+	ipamapi "github.com/siderolabs/talos/pkg/machinery/api/ipam"
+)
+
+// DefaultTimeout is the default timeout for the IPAM plugin calls.
+const DefaultTimeout = 30 * time.Second
+
+// Request is the address allocation request sent to the IPAM plugin.
+type Request struct {
+	ClusterName  string
+	MachineType  string
+	Hostname     string
+	Interface    string
+	HardwareAddr string
+}
+
+// Lease is the address allocated by the IPAM plugin.
+type Lease struct {
+	// ID is the allocation identifier in the IPAM.
+	ID string
+	// Address in CIDR notation, e.g. 10.5.0.10/24.
+	Address string
+	// Gateway is the optional default gateway.
+	Gateway string
+}
+
+// Validate the lease returned by the IPAM plugin.
+func (lease *Lease) Validate() error {
+	if lease.ID == "" {
+		return errors.New("lease ID is empty")
+	}
+
+	prefix, err := netip.ParsePrefix(lease.Address)
+	if err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+
+	if lease.Gateway != "" {
+		gw, err := netip.ParseAddr(lease.Gateway)
+		if err != nil {
+			return fmt.Errorf("invalid gateway: %w", err)
+		}
+
+		if gw.Is4() != prefix.Addr().Is4() {
+			return fmt.Errorf("gateway %s doesn't match the address family of %s", gw, prefix)
+		}
+	}
+
+	return nil
+}
+
+// Allocator allocates static addresses.
+type Allocator interface {
+	Allocate(ctx context.Context, req Request) (Lease, error)
+}
+
+// GRPCAllocator allocates addresses via the IPAM plugin gRPC API (see pkg/machinery/api/ipam).
+type GRPCAllocator struct {
+	endpoint string
+	conn     *grpc.ClientConn
+	client   ipamapi.IPAMServiceClient
+}
+
+// NewGRPCAllocator creates a new GRPCAllocator for the endpoint.
+//
+// The plugin is called over plaintext gRPC for the grpc:// endpoints (and the endpoints without the scheme),
+// and over TLS otherwise (e.g. https://ipam.example.com:8443).
+func NewGRPCAllocator(ipamEndpoint string) (*GRPCAllocator, error) {
+	parsed, err := endpoint.Parse(ipamEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing IPAM endpoint: %w", err)
+	}
+
+	var transportCredentials credentials.TransportCredentials
+
+	if parsed.Insecure {
+		transportCredentials = insecure.NewCredentials()
+	} else {
+		transportCredentials = credentials.NewTLS(&tls.Config{})
+	}
+
+	conn, err := grpc.NewClient(
+		parsed.Host,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithSharedWriteBuffer(true),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing IPAM endpoint %q: %w", ipamEndpoint, err)
+	}
+
+	return &GRPCAllocator{
+		endpoint: ipamEndpoint,
+		conn:     conn,
+		client:   ipamapi.NewIPAMServiceClient(conn),
+	}, nil
+}
+
+// Endpoint returns the endpoint of the IPAM plugin.
+func (a *GRPCAllocator) Endpoint() string {
+	return a.endpoint
+}
+
+// Close the connection to the IPAM plugin.
+func (a *GRPCAllocator) Close() error {
+	return a.conn.Close()
+}
+
+// Allocate implements Allocator interface.
+func (a *GRPCAllocator) Allocate(ctx context.Context, req Request) (Lease, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	resp, err := a.client.Allocate(ctx, &ipamapi.AllocateRequest{
+		ClusterName:  req.ClusterName,
+		MachineType:  req.MachineType,
+		Hostname:     req.Hostname,
+		Interface:    req.Interface,
+		HardwareAddr: req.HardwareAddr,
+	})
+	if err != nil {
+		return Lease{}, err
+	}
+
+	lease := Lease{
+		ID:      resp.GetLeaseId(),
+		Address: resp.GetAddress(),
+		Gateway: resp.GetGateway(),
+	}
+
+	if err = lease.Validate(); err != nil {
+		return Lease{}, err
+	}
+
+	return lease, nil
+}
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ipam_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/ipam"
+	ipamapi "github.com/siderolabs/talos/pkg/machinery/api/ipam"
+)
+
+type mockPlugin struct {
+	ipamapi.UnimplementedIPAMServiceServer
+}
+
+func (mockPlugin) Allocate(_ context.Context, req *ipamapi.AllocateRequest) (*ipamapi.AllocateResponse, error) {
+	switch req.GetInterface() {
+	case "eth0":
+		if req.GetHostname() != "talos-cp-1" || req.GetMachineType() != "controlplane" {
+			return nil, status.Error(codes.InvalidArgument, "unexpected request")
+		}
+
+		return &ipamapi.AllocateResponse{
+			LeaseId: "lease-1",
+			Address: "10.5.0.10/24",
+			Gateway: "10.5.0.1",
+		}, nil
+	case "eth1":
+		return &ipamapi.AllocateResponse{
+			LeaseId: "lease-2",
+			Address: "10.5.0.10",
+		}, nil
+	default:
+		return nil, status.Error(codes.ResourceExhausted, "no free addresses")
+	}
+}
+
+func TestGRPCAllocator(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	ipamapi.RegisterIPAMServiceServer(srv, mockPlugin{})
+
+	go srv.Serve(lis) //nolint:errcheck
+
+	t.Cleanup(srv.Stop)
+
+	allocator, err := ipam.NewGRPCAllocator("grpc://" + lis.Addr().String())
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, allocator.Close()) })
+
+	lease, err := allocator.Allocate(context.Background(), ipam.Request{Interface: "eth0", Hostname: "talos-cp-1", MachineType: "controlplane"})
+	require.NoError(t, err)
+
+	assert.Equal(t, ipam.Lease{ID: "lease-1", Address: "10.5.0.10/24", Gateway: "10.5.0.1"}, lease)
+
+	_, err = allocator.Allocate(context.Background(), ipam.Request{Interface: "eth1"})
+	require.ErrorContains(t, err, "invalid address")
+
+	_, err = allocator.Allocate(context.Background(), ipam.Request{Interface: "eth2"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestLeaseValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name  string
+		lease ipam.Lease
+
+		expectedError string
+	}{
+		{
+			name:  "valid",
+			lease: ipam.Lease{ID: "1", Address: "2001:db8::10/64", Gateway: "2001:db8::1"},
+		},
+		{
+			name:          "no id",
+			lease:         ipam.Lease{Address: "10.5.0.10/24"},
+			expectedError: "lease ID is empty",
+		},
+		{
+			name:          "family mismatch",
+			lease:         ipam.Lease{ID: "1", Address: "10.5.0.10/24", Gateway: "2001:db8::1"},
+			expectedError: "gateway 2001:db8::1 doesn't match the address family of 10.5.0.10/24",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.lease.Validate()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package kmodparams reads and changes the parameters of the loaded kernel modules via sysfs.
+package kmodparams
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// SysfsPath is the default path to the kernel modules in sysfs.
+const SysfsPath = "/sys/module"
+
+var (
+	// ErrModuleNotLoaded is returned when the module is not loaded (or it has no parameters).
+	ErrModuleNotLoaded = errors.New("module is not loaded")
+	// ErrParameterNotFound is returned when the module doesn't have the parameter.
+	ErrParameterNotFound = errors.New("parameter not found")
+	// ErrReadOnly is returned when the parameter can't be changed at runtime.
+	ErrReadOnly = errors.New("parameter can't be changed at runtime")
+)
+
+// Parameter describes a kernel module parameter.
+type Parameter struct {
+	Name     string
+	Value    string
+	Writable bool
+}
+
+// NormalizeName returns the name of the module or the parameter as it is shown in sysfs.
+//
+// The kernel treats dashes and underscores in the names as equivalent.
+func NormalizeName(name string) string {
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+// Modules returns the names of the loaded modules which have parameters.
+func Modules(root string) ([]string, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []string
+
+	for _, entry := range entries {
+		if _, err = os.Stat(filepath.Join(root, entry.Name(), "parameters")); err == nil {
+			modules = append(modules, entry.Name())
+		}
+	}
+
+	return modules, nil
+}
+
+// Read returns the parameters of the module sorted by name.
+func Read(root, module string) ([]Parameter, error) {
+	dir := filepath.Join(root, NormalizeName(module), "parameters")
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrModuleNotLoaded, module)
+		}
+
+		return nil, err
+	}
+
+	params := make([]Parameter, 0, len(entries))
+
+	for _, entry := range entries {
+		param, err := read(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		params = append(params, param)
+	}
+
+	slices.SortFunc(params, func(a, b Parameter) int { return strings.Compare(a.Name, b.Name) })
+
+	return params, nil
+}
+
+// Get returns the parameter of the module.
+func Get(root, module, name string) (Parameter, error) {
+	if _, err := os.Stat(filepath.Join(root, NormalizeName(module), "parameters")); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return Parameter{}, fmt.Errorf("%w: %s", ErrModuleNotLoaded, module)
+		}
+
+		return Parameter{}, err
+	}
+
+	param, err := read(filepath.Join(root, NormalizeName(module), "parameters", NormalizeName(name)))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return Parameter{}, fmt.Errorf("%w: %s.%s", ErrParameterNotFound, module, name)
+		}
+
+		return Parameter{}, err
+	}
+
+	return param, nil
+}
+
+// Set changes the value of the module parameter.
+//
+// Set returns the parameter as it was before the change.
+func Set(root, module, name, value string) (Parameter, error) {
+	previous, err := Get(root, module, name)
+	if err != nil {
+		return Parameter{}, err
+	}
+
+	if !previous.Writable {
+		return Parameter{}, fmt.Errorf("%w: %s.%s", ErrReadOnly, module, name)
+	}
+
+	path := filepath.Join(root, NormalizeName(module), "parameters", previous.Name)
+
+	if err = os.WriteFile(path, []byte(value), 0); err != nil {
+		return Parameter{}, fmt.Errorf("error setting %s.%s: %w", module, name, err)
+	}
+
+	return previous, nil
+}
+
+// Equal returns true if the current value of the parameter (as read from sysfs) matches the value.
+//
+// Boolean parameters are shown as Y/N by the kernel, but they accept other spellings.
+func Equal(current, value string) bool {
+	if current == value {
+		return true
+	}
+
+	switch current {
+	case "Y":
+		return slices.Contains([]string{"1", "y", "true"}, strings.ToLower(value))
+	case "N":
+		return slices.Contains([]string{"0", "n", "false"}, strings.ToLower(value))
+	}
+
+	return false
+}
+
+func read(path string) (Parameter, error) {
+	st, err := os.Stat(path)
+	if err != nil {
+		return Parameter{}, err
+	}
+
+	param := Parameter{
+		Name:     filepath.Base(path),
+		Writable: st.Mode().Perm()&0o200 != 0,
+	}
+
+	// some parameters are write-only or fail to be read, keep the value empty for them
+	if st.Mode().Perm()&0o444 != 0 {
+		if contents, err := os.ReadFile(path); err == nil {
+			param.Value = strings.TrimSuffix(string(contents), "\n")
+		}
+	}
+
+	return param, nil
+}
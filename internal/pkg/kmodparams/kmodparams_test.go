@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmodparams_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/kmodparams"
+)
+
+func setupSysfs(t *testing.T) string {
+	t.Helper()
+
+	root := t.TempDir()
+
+	for _, param := range []struct {
+		path  string
+		value string
+		mode  os.FileMode
+	}{
+		{"nf_conntrack/parameters/hashsize", "65536\n", 0o644},
+		{"nf_conntrack/parameters/expect_hashsize", "1024\n", 0o444},
+		{"nf_conntrack/parameters/acct", "N\n", 0o644},
+		{"kvm/parameters/ignore_msrs", "N\n", 0o644},
+		{"kvm/parameters/trigger", "", 0o200},
+	} {
+		path := filepath.Join(root, param.path)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(param.value), param.mode))
+	}
+
+	// a built-in module without parameters
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "xfs"), 0o755))
+
+	return root
+}
+
+func TestModules(t *testing.T) {
+	t.Parallel()
+
+	root := setupSysfs(t)
+
+	modules, err := kmodparams.Modules(root)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"kvm", "nf_conntrack"}, modules)
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+
+	root := setupSysfs(t)
+
+	params, err := kmodparams.Read(root, "nf-conntrack")
+	require.NoError(t, err)
+
+	assert.Equal(t, []kmodparams.Parameter{
+		{Name: "acct", Value: "N", Writable: true},
+		{Name: "expect_hashsize", Value: "1024"},
+		{Name: "hashsize", Value: "65536", Writable: true},
+	}, params)
+
+	params, err = kmodparams.Read(root, "kvm")
+	require.NoError(t, err)
+
+	assert.Equal(t, []kmodparams.Parameter{
+		{Name: "ignore_msrs", Value: "N", Writable: true},
+		{Name: "trigger", Writable: true},
+	}, params)
+
+	_, err = kmodparams.Read(root, "xfs")
+	require.ErrorIs(t, err, kmodparams.ErrModuleNotLoaded)
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	root := setupSysfs(t)
+
+	previous, err := kmodparams.Set(root, "nf_conntrack", "hashsize", "262144")
+	require.NoError(t, err)
+
+	assert.Equal(t, kmodparams.Parameter{Name: "hashsize", Value: "65536", Writable: true}, previous)
+
+	current, err := kmodparams.Get(root, "nf_conntrack", "hashsize")
+	require.NoError(t, err)
+
+	assert.Equal(t, "262144", current.Value)
+
+	_, err = kmodparams.Set(root, "nf_conntrack", "expect-hashsize", "2048")
+	require.ErrorIs(t, err, kmodparams.ErrReadOnly)
+
+	_, err = kmodparams.Set(root, "nf_conntrack", "nonexistent", "1")
+	require.ErrorIs(t, err, kmodparams.ErrParameterNotFound)
+
+	_, err = kmodparams.Set(root, "e1000", "debug", "1")
+	require.ErrorIs(t, err, kmodparams.ErrModuleNotLoaded)
+}
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		current string
+		value   string
+
+		expected bool
+	}{
+		{"65536", "65536", true},
+		{"65536", "1024", false},
+		{"Y", "1", true},
+		{"Y", "true", true},
+		{"Y", "0", false},
+		{"N", "n", true},
+		{"N", "Y", false},
+	} {
+		assert.Equal(t, test.expected, kmodparams.Equal(test.current, test.value), "%q == %q", test.current, test.value)
+	}
+}
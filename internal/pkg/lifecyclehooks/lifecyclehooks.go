@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package lifecyclehooks implements webhooks fired at the defined points of the machine lifecycle.
+package lifecyclehooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// Payload is the JSON payload sent to the lifecycle hook.
+type Payload struct {
+	Stage     config.LifecycleHookStage `json:"stage"`
+	Sequence  string                    `json:"sequence"`
+	Hostname  string                    `json:"hostname"`
+	Timestamp time.Time                 `json:"timestamp"`
+}
+
+// Run fires the hooks configured for the stage of the payload.
+//
+// Hooks are called sequentially in the order of the configuration documents.
+// The first failure of a hook with the `fail` policy is returned, other failures are logged.
+func Run(ctx context.Context, logger *log.Logger, hooks []config.LifecycleHookConfig, payload Payload) error {
+	for _, hook := range hooks {
+		if !slices.Contains(hook.Stages(), payload.Stage) {
+			continue
+		}
+
+		logger.Printf("calling lifecycle hook %q for stage %q", hook.Name(), payload.Stage)
+
+		err := call(ctx, hook, payload)
+		if err == nil {
+			continue
+		}
+
+		if hook.FailurePolicy() == config.LifecycleHookFailurePolicyFail {
+			return fmt.Errorf("lifecycle hook %q failed: %w", hook.Name(), err)
+		}
+
+		logger.Printf("lifecycle hook %q failed, ignoring: %s", hook.Name(), err)
+	}
+
+	return nil
+}
+
+func call(ctx context.Context, hook config.LifecycleHookConfig, payload Payload) error {
+	ctx, cancel := context.WithTimeout(ctx, hook.Timeout())
+	defer cancel()
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL().String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for key, value := range hook.Headers() {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024)) //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
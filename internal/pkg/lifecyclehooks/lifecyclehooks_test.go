@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lifecyclehooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/lifecyclehooks"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+func hook(name, rawURL string, policy config.LifecycleHookFailurePolicy, stages ...config.LifecycleHookStage) config.LifecycleHookConfig {
+	cfg := runtime.NewLifecycleHookV1Alpha1()
+	cfg.MetaName = name
+	cfg.HookStages = stages
+	cfg.HookURL.URL = ensure.Value(url.Parse(rawURL))
+	cfg.HookHeaders = map[string]string{"Authorization": "Bearer token"}
+	cfg.HookTimeout = time.Second
+	cfg.HookFailurePolicy = policy
+
+	return cfg
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	var received []lifecyclehooks.Payload
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		var payload lifecyclehooks.Payload
+
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload)) {
+			return
+		}
+
+		received = append(received, payload)
+
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	logger := log.New(log.Writer(), "", 0)
+
+	payload := lifecyclehooks.Payload{
+		Stage:    config.LifecycleHookPreReboot,
+		Sequence: "reboot",
+		Hostname: "talos-default-worker-1",
+	}
+
+	// ignored failure, another stage, successful call
+	require.NoError(t, lifecyclehooks.Run(context.Background(), logger, []config.LifecycleHookConfig{
+		hook("ignored", srv.URL+"/fail", config.LifecycleHookFailurePolicyIgnore, config.LifecycleHookPreReboot),
+		hook("other-stage", srv.URL+"/fail", config.LifecycleHookFailurePolicyFail, config.LifecycleHookPreReset),
+		hook("ok", srv.URL+"/ok", config.LifecycleHookFailurePolicyFail, config.LifecycleHookPreReboot),
+	}, payload))
+
+	require.Len(t, received, 2)
+	assert.Equal(t, payload, received[1])
+
+	// failure with the fail policy
+	err := lifecyclehooks.Run(context.Background(), logger, []config.LifecycleHookConfig{
+		hook("closed", srv.URL+"/fail", config.LifecycleHookFailurePolicyFail, config.LifecycleHookPreReboot),
+	}, payload)
+	require.EqualError(t, err, `lifecycle hook "closed" failed: unexpected status code 503`)
+}
@@ -6,7 +6,6 @@
 package logind
 
 import (
-	"slices"
 	"sync"
 	"syscall"
 	"time"
@@ -24,7 +23,7 @@ const (
 )
 
 // InhibitMaxDelay is the maximum delay for graceful shutdown.
-const InhibitMaxDelay = 40 * constants.KubeletShutdownGracePeriod
+const InhibitMaxDelay = constants.KubeletShutdownGracePeriodMax
 
 type logindMock struct {
 	mu          sync.Mutex
@@ -45,7 +44,9 @@ func (mock *logindMock) Inhibit(what, who, why, mode string) (dbus.UnixFD, *dbus
 	defer mock.mu.Unlock()
 
 	for _, fd := range mock.inhibitPipe {
-		syscall.Close(fd) //nolint:errcheck
+		if fd >= 0 {
+			syscall.Close(fd) //nolint:errcheck
+		}
 	}
 
 	mock.inhibitPipe = make([]int, 2)
@@ -56,9 +57,26 @@ func (mock *logindMock) Inhibit(what, who, why, mode string) (dbus.UnixFD, *dbus
 	return dbus.UnixFD(mock.inhibitPipe[1]), nil
 }
 
-func (mock *logindMock) getPipe() []int {
+// closeWriteEnd closes our copy of the write side of the inhibit pipe and returns the read side.
+//
+// The write side is closed only once, so that repeated shutdown requests don't close an unrelated
+// file descriptor which reused the same number.
+func (mock *logindMock) closeWriteEnd() (int, error) {
 	mock.mu.Lock()
 	defer mock.mu.Unlock()
 
-	return slices.Clone(mock.inhibitPipe)
+	// no inhibit lock
+	if len(mock.inhibitPipe) == 0 {
+		return -1, nil
+	}
+
+	if mock.inhibitPipe[1] >= 0 {
+		if err := syscall.Close(mock.inhibitPipe[1]); err != nil {
+			return -1, err
+		}
+
+		mock.inhibitPipe[1] = -1
+	}
+
+	return mock.inhibitPipe[0], nil
 }
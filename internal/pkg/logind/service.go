@@ -65,24 +65,23 @@ func (mock *ServiceMock) EmitShutdown() error {
 
 // WaitLockRelease waits for the inhibit lock to be released.
 func (mock *ServiceMock) WaitLockRelease(ctx context.Context) error {
-	pipe := mock.logind.getPipe()
+	// close the write side of the pipe, other fd to the write pipe is in the kubelet
+	readFd, err := mock.logind.closeWriteEnd()
+	if err != nil {
+		return err
+	}
 
 	// no inhibit lock
-	if len(pipe) == 0 {
+	if readFd < 0 {
 		return nil
 	}
 
-	// close the write side of the pipe, other fd to the write pipe is in the kubelet
-	if err := syscall.Close(pipe[1]); err != nil {
-		return err
-	}
-
 	errCh := make(chan error, 1)
 
 	go func() {
 		// attempt to read from the pipe, as soon as kubelet closes its end, read should return
 		buf := make([]byte, 1)
-		_, err := syscall.Read(pipe[0], buf)
+		_, err := syscall.Read(readFd, buf)
 
 		errCh <- err
 	}()
@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics
+
+import (
+	"expvar"
+	"strconv"
+
+	cosimetrics "github.com/cosi-project/runtime/pkg/controller/runtime/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// expvarMetric exposes the per-controller expvar map of the controller runtime.
+type expvarMetric struct {
+	vars      *expvar.Map
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+}
+
+// controllerCollector exposes the controller runtime metrics.
+//
+// Controllers only report wakeups and crashes, while QControllers also report the time spent reconciling.
+type controllerCollector struct {
+	metrics []expvarMetric
+}
+
+func newControllerCollector() *controllerCollector {
+	metric := func(vars *expvar.Map, name, help string, valueType prometheus.ValueType) expvarMetric {
+		return expvarMetric{
+			vars:      vars,
+			desc:      prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, []string{"controller"}, nil),
+			valueType: valueType,
+		}
+	}
+
+	return &controllerCollector{
+		metrics: []expvarMetric{
+			metric(cosimetrics.ControllerWakeups, "controller_wakeups_total", "Number of the controller wakeups.", prometheus.CounterValue),
+			metric(cosimetrics.ControllerCrashes, "controller_crashes_total", "Number of the controller crashes (the controller returned an error).", prometheus.CounterValue),
+			metric(cosimetrics.ControllerReads, "controller_reads_total", "Number of the resource reads by the controller.", prometheus.CounterValue),
+			metric(cosimetrics.ControllerWrites, "controller_writes_total", "Number of the resource writes by the controller.", prometheus.CounterValue),
+			metric(cosimetrics.QControllerProcessed, "qcontroller_processed_total", "Number of the reconcile events processed by the controller.", prometheus.CounterValue),
+			metric(cosimetrics.QControllerRequeues, "qcontroller_requeues_total", "Number of the reconcile events requeued by the controller.", prometheus.CounterValue),
+			metric(cosimetrics.QControllerCrashes, "qcontroller_crashes_total", "Number of the reconcile failures of the controller.", prometheus.CounterValue),
+			metric(cosimetrics.QControllerReconcileBusy, "qcontroller_reconcile_busy_seconds_total", "Time spent by the controller reconciling.", prometheus.CounterValue),
+			metric(cosimetrics.QControllerMapBusy, "qcontroller_map_busy_seconds_total", "Time spent by the controller mapping the input events.", prometheus.CounterValue),
+			metric(cosimetrics.QControllerQueueLength, "qcontroller_queue_length", "Number of the events queued for the controller.", prometheus.GaugeValue),
+		},
+	}
+}
+
+// Describe implements prometheus.Collector interface.
+func (c *controllerCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, metric := range c.metrics {
+		ch <- metric.desc
+	}
+}
+
+// Collect implements prometheus.Collector interface.
+func (c *controllerCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, metric := range c.metrics {
+		metric.vars.Do(func(kv expvar.KeyValue) {
+			value, err := strconv.ParseFloat(kv.Value.String(), 64)
+			if err != nil {
+				return
+			}
+
+			ch <- prometheus.MustNewConstMetric(metric.desc, metric.valueType, value, kv.Key)
+		})
+	}
+}
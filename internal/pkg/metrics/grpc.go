@@ -0,0 +1,39 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor recording the API request durations.
+func UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		startTime := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		ObserveAPIRequest(info.FullMethod, status.Code(err), time.Since(startTime))
+
+		return resp, err
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor recording the API request durations.
+func StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		startTime := time.Now()
+
+		err := handler(srv, stream)
+
+		ObserveAPIRequest(info.FullMethod, status.Code(err), time.Since(startTime))
+
+		return err
+	}
+}
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package metrics implements the Prometheus metrics of the machined internals.
+//
+// The metrics are collected all the time, they are exposed only if the metrics endpoint is enabled.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"google.golang.org/grpc/codes"
+)
+
+const namespace = "talos"
+
+// BootStageKernel is the boot stage from the kernel start until machined is started.
+const BootStageKernel = "kernel"
+
+var (
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of the Talos API requests handled by machined (the duration of the streaming requests is the lifetime of the stream).",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+	}, []string{"method", "code"})
+
+	sequenceDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "sequencer",
+		Name:      "sequence_duration_seconds",
+		Help:      "Duration of the successfully completed sequences.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 3, 10),
+	}, []string{"sequence"})
+
+	phaseDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "sequencer",
+		Name:      "phase_duration_seconds",
+		Help:      "Duration of the successfully completed sequence phases.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	}, []string{"sequence", "phase"})
+
+	bootStageDuration = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "boot",
+		Name:      "stage_duration_seconds",
+		Help:      "Duration of the stages of the current boot: kernel (until machined is started), initialize and boot sequences.",
+	}, []string{"stage"})
+)
+
+var registry = newRegistry()
+
+func newRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		newControllerCollector(),
+		apiRequestDuration,
+		sequenceDuration,
+		phaseDuration,
+		bootStageDuration,
+	)
+
+	return reg
+}
+
+// Gatherer returns the gatherer of the machined metrics.
+func Gatherer() prometheus.Gatherer {
+	return registry
+}
+
+// ObserveAPIRequest records the duration of the Talos API request.
+func ObserveAPIRequest(method string, code codes.Code, duration time.Duration) {
+	apiRequestDuration.WithLabelValues(method, code.String()).Observe(duration.Seconds())
+}
+
+// ObserveSequence records the duration of the completed sequence.
+func ObserveSequence(sequence string, duration time.Duration) {
+	sequenceDuration.WithLabelValues(sequence).Observe(duration.Seconds())
+}
+
+// ObservePhase records the duration of the completed sequence phase.
+func ObservePhase(sequence, phase string, duration time.Duration) {
+	phaseDuration.WithLabelValues(sequence, phase).Observe(duration.Seconds())
+}
+
+// SetBootStage records the duration of the boot stage.
+func SetBootStage(stage string, duration time.Duration) {
+	bootStageDuration.WithLabelValues(stage).Set(duration.Seconds())
+}
@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	cosimetrics "github.com/cosi-project/runtime/pkg/controller/runtime/metrics"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/siderolabs/talos/internal/pkg/metrics"
+)
+
+// findMetric returns the metric of the family with the label value.
+func findMetric(t *testing.T, name, label, value string) *dto.Metric {
+	t.Helper()
+
+	families, err := metrics.Gatherer().Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, metric := range family.Metric {
+			for _, pair := range metric.Label {
+				if pair.GetName() == label && pair.GetValue() == value {
+					return metric
+				}
+			}
+		}
+	}
+
+	require.Failf(t, "metric not found", "%s{%s=%q}", name, label, value)
+
+	return nil
+}
+
+func TestControllerMetrics(t *testing.T) {
+	cosimetrics.ControllerWakeups.Add("test.WakeupController", 3)
+	cosimetrics.QControllerReconcileBusy.AddFloat("test.QController", 1.5)
+
+	assert.Equal(t, 3.0, findMetric(t, "talos_controller_wakeups_total", "controller", "test.WakeupController").GetCounter().GetValue())
+	assert.Equal(t, 1.5, findMetric(t, "talos_qcontroller_reconcile_busy_seconds_total", "controller", "test.QController").GetCounter().GetValue())
+}
+
+func TestAPIRequestMetrics(t *testing.T) {
+	metrics.ObserveAPIRequest("/machine.MachineService/Version", codes.OK, 10*time.Millisecond)
+	metrics.ObserveAPIRequest("/machine.MachineService/Version", codes.OK, 20*time.Millisecond)
+
+	histogram := findMetric(t, "talos_api_request_duration_seconds", "method", "/machine.MachineService/Version").GetHistogram()
+
+	assert.EqualValues(t, 2, histogram.GetSampleCount())
+	assert.InDelta(t, 0.03, histogram.GetSampleSum(), 1e-9)
+}
+
+func TestBootMetrics(t *testing.T) {
+	metrics.ObservePhase("boot", "udevSetup", time.Second)
+	metrics.SetBootStage(metrics.BootStageKernel, 3*time.Second)
+	metrics.SetBootStage(metrics.BootStageKernel, 2*time.Second)
+
+	assert.EqualValues(t, 1, findMetric(t, "talos_sequencer_phase_duration_seconds", "phase", "udevSetup").GetHistogram().GetSampleCount())
+	assert.Equal(t, 2.0, findMetric(t, "talos_boot_stage_duration_seconds", "stage", "kernel").GetGauge().GetValue())
+}
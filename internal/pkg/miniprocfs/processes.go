@@ -167,6 +167,13 @@ func (procs *Processes) readProc(pidString string) (*machine.ProcessInfo, error)
 		label = string(bytes.TrimSpace(procs.buf))
 	}
 
+	var ioReadBytes, ioWriteBytes uint64
+
+	// io is readable only with the ptrace access to the process, so it's optional
+	if err = procs.readFileIntoBuf(path + "io"); err == nil {
+		ioReadBytes, ioWriteBytes = parseIO(procs.buf)
+	}
+
 	return &machine.ProcessInfo{
 		Pid:            int32(pid),
 		Ppid:           int32(ppid),
@@ -179,9 +186,30 @@ func (procs *Processes) readProc(pidString string) (*machine.ProcessInfo, error)
 		Executable:     executable,
 		Args:           args,
 		Label:          label,
+		IoReadBytes:    ioReadBytes,
+		IoWriteBytes:   ioWriteBytes,
 	}, nil
 }
 
+// parseIO extracts read_bytes and write_bytes from the contents of /proc/<pid>/io.
+func parseIO(buf []byte) (readBytes, writeBytes uint64) {
+	for _, line := range bytes.Split(buf, []byte{'\n'}) {
+		key, value, ok := bytes.Cut(line, []byte(": "))
+		if !ok {
+			continue
+		}
+
+		switch string(key) {
+		case "read_bytes":
+			readBytes, _ = strconv.ParseUint(string(value), 10, 64)
+		case "write_bytes":
+			writeBytes, _ = strconv.ParseUint(string(value), 10, 64)
+		}
+	}
+
+	return readBytes, writeBytes
+}
+
 func (procs *Processes) readFileIntoBuf(path string) error {
 	f, err := os.Open(path)
 	if err != nil {
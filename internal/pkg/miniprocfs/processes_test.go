@@ -79,6 +79,12 @@ func TestMock(t *testing.T) {
 		assert.EqualValues(t, goldStat.CPUTime(), proc.CpuTime)
 		assert.EqualValues(t, goldStat.VirtualMemory(), proc.VirtualMemory)
 		assert.EqualValues(t, goldStat.ResidentMemory(), proc.ResidentMemory)
+
+		goldIO, err := goldInfo.IO()
+		require.NoError(t, err)
+
+		assert.EqualValues(t, goldIO.ReadBytes, proc.IoReadBytes)
+		assert.EqualValues(t, goldIO.WriteBytes, proc.IoWriteBytes)
 	}
 
 	assert.NoError(t, processes.Close())
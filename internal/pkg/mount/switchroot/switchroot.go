@@ -21,9 +21,10 @@ import (
 
 // Paths preserved in the initramfs.
 var preservedPaths = map[string]struct{}{
-	constants.ExtensionsConfigFile:    {},
-	constants.FirmwarePath:            {},
-	constants.SDStubDynamicInitrdPath: {},
+	constants.ExtensionsConfigFile:      {},
+	constants.FirmwarePath:              {},
+	constants.SDStubDynamicInitrdPath:   {},
+	"/" + constants.RootfsChecksumAsset: {},
 }
 
 // Switch moves the rootfs to a specified directory. See
@@ -0,0 +1,443 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nodehealth
+
+import (
+	"context"
+	stdx509 "crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/generic"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	timeres "github.com/siderolabs/talos/pkg/machinery/resources/time"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+const (
+	// diskSpaceWarnPercent is the percentage of the free disk space which triggers a warning.
+	diskSpaceWarnPercent = 15
+	// diskSpaceFailPercent is the percentage of the free disk space which fails the check.
+	diskSpaceFailPercent = 5
+
+	// certificateExpiryWarning is the remaining lifetime of the certificate which triggers a warning.
+	certificateExpiryWarning = 30 * 24 * time.Hour
+)
+
+// DefaultChecks returns the checks in the order they are run.
+func DefaultChecks() []Check {
+	return []Check{
+		{
+			Name:        "etcd",
+			Description: "etcd member is healthy and the cluster has quorum",
+			Run:         checkEtcd,
+		},
+		{
+			Name:        "kubelet",
+			Description: "kubelet service is healthy",
+			Run:         serviceCheck("kubelet", "Check the kubelet logs with `talosctl logs kubelet`."),
+		},
+		{
+			Name:        "cri",
+			Description: "container runtime (CRI) service is healthy",
+			Run:         serviceCheck("cri", "Check the container runtime logs with `talosctl logs cri`."),
+		},
+		{
+			Name:        "time",
+			Description: "time is in sync",
+			Run:         checkTime,
+		},
+		{
+			Name:        "disk-space",
+			Description: "system partitions have enough free space",
+			Run:         checkDiskSpace,
+		},
+		{
+			Name:        "certificates",
+			Description: "certificates are not expired or about to expire",
+			Run: func(ctx context.Context, node Node) Result {
+				return checkCertificates(ctx, node, time.Now())
+			},
+		},
+	}
+}
+
+func isControlPlane(ctx context.Context, st state.State) (bool, error) {
+	machineType, err := safe.StateGetByID[*config.MachineType](ctx, st, config.MachineTypeID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get machine type: %w", err)
+	}
+
+	return machineType.MachineType().IsControlPlane(), nil
+}
+
+func serviceCheck(id, remediation string) func(ctx context.Context, node Node) Result {
+	return func(ctx context.Context, node Node) Result {
+		return checkService(ctx, node.Resources, id, remediation)
+	}
+}
+
+func checkService(ctx context.Context, st state.State, id, remediation string) Result {
+	service, err := safe.StateGetByID[*v1alpha1.Service](ctx, st, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return Result{
+				Status:      StatusFail,
+				Message:     fmt.Sprintf("service %s is not running", id),
+				Remediation: remediation,
+			}
+		}
+
+		return Result{
+			Status:  StatusFail,
+			Message: fmt.Sprintf("failed to get service %s status: %s", id, err),
+		}
+	}
+
+	spec := service.TypedSpec()
+
+	switch {
+	case !spec.Running:
+		return Result{
+			Status:      StatusFail,
+			Message:     fmt.Sprintf("service %s is not running", id),
+			Remediation: remediation,
+		}
+	case spec.Unknown:
+		return Result{
+			Status:  StatusPass,
+			Message: fmt.Sprintf("service %s is running", id),
+		}
+	case !spec.Healthy:
+		return Result{
+			Status:      StatusFail,
+			Message:     fmt.Sprintf("service %s is not healthy", id),
+			Remediation: remediation,
+		}
+	}
+
+	return Result{
+		Status:  StatusPass,
+		Message: fmt.Sprintf("service %s is healthy", id),
+	}
+}
+
+func checkEtcd(ctx context.Context, node Node) Result {
+	controlPlane, err := isControlPlane(ctx, node.Resources)
+	if err != nil {
+		return Result{
+			Status:  StatusFail,
+			Message: err.Error(),
+		}
+	}
+
+	if !controlPlane {
+		return Result{
+			Status:  StatusSkip,
+			Message: "etcd is running only on the control plane nodes",
+		}
+	}
+
+	const remediation = "Check the etcd logs with `talosctl logs etcd` and the cluster members with `talosctl etcd members`."
+
+	if result := checkService(ctx, node.Resources, "etcd", remediation); result.Status != StatusPass {
+		return result
+	}
+
+	client, err := etcd.NewLocalClient(ctx)
+	if err != nil {
+		return Result{
+			Status:      StatusFail,
+			Message:     fmt.Sprintf("failed to create etcd client: %s", err),
+			Remediation: remediation,
+		}
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	if err = client.ValidateQuorum(ctx); err != nil {
+		return Result{
+			Status:      StatusFail,
+			Message:     fmt.Sprintf("etcd cluster has no quorum: %s", err),
+			Remediation: remediation,
+		}
+	}
+
+	return Result{
+		Status:  StatusPass,
+		Message: "etcd member is healthy, the cluster has quorum",
+	}
+}
+
+func checkTime(ctx context.Context, node Node) Result {
+	status, err := safe.StateGetByID[*timeres.Status](ctx, node.Resources, timeres.StatusID)
+	if err != nil {
+		return Result{
+			Status:  StatusFail,
+			Message: fmt.Sprintf("failed to get time status: %s", err),
+		}
+	}
+
+	switch {
+	case status.TypedSpec().SyncDisabled:
+		return Result{
+			Status:  StatusSkip,
+			Message: "time sync is disabled",
+		}
+	case !status.TypedSpec().Synced:
+		return Result{
+			Status:      StatusFail,
+			Message:     "time is not in sync",
+			Remediation: "Check that the time servers in .machine.time are reachable, and the time sync status with `talosctl get timeservers` and `talosctl logs controller-runtime`.",
+		}
+	}
+
+	return Result{
+		Status:  StatusPass,
+		Message: "time is in sync",
+	}
+}
+
+// diskUsage is the usage of the filesystem mounted at the path.
+type diskUsage struct {
+	path  string
+	free  uint64
+	total uint64
+}
+
+func checkDiskSpace(context.Context, Node) Result {
+	var usages []diskUsage
+
+	for _, path := range []string{constants.EphemeralMountPoint, constants.StateMountPoint} {
+		var stat unix.Statfs_t
+
+		if err := unix.Statfs(path, &stat); err != nil {
+			if errors.Is(err, unix.ENOENT) {
+				continue
+			}
+
+			return Result{
+				Status:  StatusFail,
+				Message: fmt.Sprintf("failed to get the disk usage of %s: %s", path, err),
+			}
+		}
+
+		usages = append(usages, diskUsage{
+			path:  path,
+			free:  stat.Bavail * uint64(stat.Bsize),
+			total: stat.Blocks * uint64(stat.Bsize),
+		})
+	}
+
+	return diskSpaceResult(usages)
+}
+
+func diskSpaceResult(usages []diskUsage) Result {
+	result := Result{
+		Status: StatusPass,
+	}
+
+	messages := make([]string, 0, len(usages))
+
+	for _, usage := range usages {
+		if usage.total == 0 {
+			continue
+		}
+
+		percent := usage.free * 100 / usage.total
+
+		messages = append(messages, fmt.Sprintf("%s: %d%% free", usage.path, percent))
+
+		switch {
+		case percent < diskSpaceFailPercent:
+			result.Status = StatusFail
+		case percent < diskSpaceWarnPercent:
+			result.Status = max(result.Status, StatusWarn)
+		}
+	}
+
+	if len(messages) == 0 {
+		return Result{
+			Status:  StatusSkip,
+			Message: "no system partitions are mounted",
+		}
+	}
+
+	result.Message = strings.Join(messages, ", ")
+
+	if result.Status != StatusPass {
+		result.Remediation = "Check the disk usage with `talosctl usage`, and remove the unused container images and logs."
+	}
+
+	return result
+}
+
+// certificate is the certificate checked for the expiry.
+type certificate struct {
+	name string
+	cert *stdx509.Certificate
+	ca   bool
+}
+
+// certificateSource fetches the certificate checked for the expiry.
+type certificateSource struct {
+	name string
+	ca   bool
+	get  func() (*stdx509.Certificate, error)
+}
+
+func checkCertificates(ctx context.Context, node Node, now time.Time) Result {
+	controlPlane, err := isControlPlane(ctx, node.Resources)
+	if err != nil {
+		return Result{Status: StatusFail, Message: err.Error()}
+	}
+
+	sources := []certificateSource{
+		{
+			name: "Talos API server",
+			get: func() (*stdx509.Certificate, error) {
+				return getCertificate(ctx, node.Resources, secrets.APIID, func(r *secrets.API) *x509.PEMEncodedCertificateAndKey {
+					return r.TypedSpec().Server
+				})
+			},
+		},
+	}
+
+	if controlPlane {
+		sources = append(sources,
+			certificateSource{
+				name: "Talos CA",
+				ca:   true,
+				get: func() (*stdx509.Certificate, error) {
+					return getCertificate(ctx, node.Resources, secrets.OSRootID, func(r *secrets.OSRoot) *x509.PEMEncodedCertificateAndKey {
+						return r.TypedSpec().IssuingCA
+					})
+				},
+			},
+			certificateSource{
+				name: "etcd CA",
+				ca:   true,
+				get: func() (*stdx509.Certificate, error) {
+					return getCertificate(ctx, node.Resources, secrets.EtcdRootID, func(r *secrets.EtcdRoot) *x509.PEMEncodedCertificateAndKey {
+						return r.TypedSpec().EtcdCA
+					})
+				},
+			},
+			certificateSource{
+				name: "Kubernetes CA",
+				ca:   true,
+				get: func() (*stdx509.Certificate, error) {
+					return getCertificate(ctx, node.Resources, secrets.KubernetesRootID, func(r *secrets.KubernetesRoot) *x509.PEMEncodedCertificateAndKey {
+						return r.TypedSpec().IssuingCA
+					})
+				},
+			},
+		)
+	}
+
+	certs := make([]certificate, 0, len(sources))
+
+	for _, source := range sources {
+		cert, err := source.get()
+		if err != nil {
+			return Result{
+				Status:  StatusFail,
+				Message: fmt.Sprintf("failed to get %s certificate: %s", source.name, err),
+			}
+		}
+
+		if cert != nil {
+			certs = append(certs, certificate{name: source.name, cert: cert, ca: source.ca})
+		}
+	}
+
+	return certificatesResult(certs, now)
+}
+
+// getCertificate returns the certificate from the resource, or nil if the resource doesn't exist (yet).
+func getCertificate[T generic.ResourceWithRD](ctx context.Context, st state.State, id resource.ID, pem func(T) *x509.PEMEncodedCertificateAndKey) (*stdx509.Certificate, error) {
+	r, err := safe.StateGetByID[T](ctx, st, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	encoded := pem(r)
+	if encoded == nil {
+		return nil, nil
+	}
+
+	return encoded.GetCert()
+}
+
+func certificatesResult(certs []certificate, now time.Time) Result {
+	if len(certs) == 0 {
+		return Result{
+			Status:  StatusSkip,
+			Message: "no certificates are issued yet",
+		}
+	}
+
+	var (
+		problems    []string
+		status      = StatusPass
+		remediation string
+	)
+
+	for _, cert := range certs {
+		var problem string
+
+		switch {
+		case now.After(cert.cert.NotAfter):
+			problem = fmt.Sprintf("%s certificate expired at %s", cert.name, cert.cert.NotAfter.Format(time.RFC3339))
+			status = StatusFail
+		case now.Before(cert.cert.NotBefore):
+			problem = fmt.Sprintf("%s certificate is not valid until %s", cert.name, cert.cert.NotBefore.Format(time.RFC3339))
+			status = StatusFail
+		case cert.cert.NotAfter.Sub(now) < certificateExpiryWarning:
+			problem = fmt.Sprintf("%s certificate expires at %s", cert.name, cert.cert.NotAfter.Format(time.RFC3339))
+			status = max(status, StatusWarn)
+		default:
+			continue
+		}
+
+		problems = append(problems, problem)
+
+		if remediation == "" || cert.ca {
+			if cert.ca {
+				remediation = "Rotate the CA with `talosctl rotate-ca`."
+			} else {
+				remediation = "The certificate is renewed automatically, check the node clock and the `talosctl logs controller-runtime` output."
+			}
+		}
+	}
+
+	if status == StatusPass {
+		return Result{
+			Status:  StatusPass,
+			Message: fmt.Sprintf("%d certificates are valid", len(certs)),
+		}
+	}
+
+	return Result{
+		Status:      status,
+		Message:     strings.Join(problems, ", "),
+		Remediation: remediation,
+	}
+}
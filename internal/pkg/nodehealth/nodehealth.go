@@ -0,0 +1,154 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package nodehealth implements the health checks of the node components run by the health API.
+package nodehealth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+)
+
+// CheckTimeout is the maximum duration of a single check.
+const CheckTimeout = 30 * time.Second
+
+// Status of the check.
+type Status int
+
+// Status values.
+const (
+	StatusPass Status = iota
+	StatusWarn
+	StatusFail
+	StatusSkip
+)
+
+// String implements fmt.Stringer.
+func (s Status) String() string {
+	switch s {
+	case StatusPass:
+		return "PASS"
+	case StatusWarn:
+		return "WARN"
+	case StatusFail:
+		return "FAIL"
+	case StatusSkip:
+		return "SKIP"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Result of the check.
+type Result struct {
+	Status  Status
+	Message string
+	// Remediation is the hint on how to fix the problem, set for the failed checks and warnings.
+	Remediation string
+}
+
+// Node is the node the checks are run on.
+type Node struct {
+	// Resources is the resource state of the node.
+	Resources state.State
+}
+
+// Check is a single health check.
+type Check struct {
+	// Name is the unique name of the check used to select the checks to run.
+	Name        string
+	Description string
+	Run         func(ctx context.Context, node Node) Result
+}
+
+// Registry keeps the checks in the registration order.
+type Registry struct {
+	mu     sync.Mutex
+	checks []Check
+}
+
+// NewRegistry creates a registry with the checks.
+func NewRegistry(checks ...Check) (*Registry, error) {
+	r := &Registry{}
+
+	for _, check := range checks {
+		if err := r.Register(check); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// Register adds the check to the registry.
+func (r *Registry) Register(check Check) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if slices.ContainsFunc(r.checks, func(c Check) bool { return c.Name == check.Name }) {
+		return fmt.Errorf("check %q is already registered", check.Name)
+	}
+
+	r.checks = append(r.checks, check)
+
+	return nil
+}
+
+// Checks returns the registered checks.
+func (r *Registry) Checks() []Check {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.checks)
+}
+
+// Run runs the checks with the given names (all the registered checks if names is empty) one by one.
+//
+// Each result is passed to fn as soon as the check completes, the error returned by fn aborts the run.
+func (r *Registry) Run(ctx context.Context, node Node, names []string, fn func(check Check, result Result, duration time.Duration) error) error {
+	checks := r.Checks()
+
+	if len(names) > 0 {
+		selected := make([]Check, 0, len(names))
+
+		for _, name := range names {
+			idx := slices.IndexFunc(checks, func(c Check) bool { return c.Name == name })
+			if idx == -1 {
+				return fmt.Errorf("unknown check %q", name)
+			}
+
+			selected = append(selected, checks[idx])
+		}
+
+		checks = selected
+	}
+
+	for _, check := range checks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		start := time.Now()
+
+		result := runCheck(ctx, node, check)
+
+		if err := fn(check, result, time.Since(start)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runCheck(ctx context.Context, node Node, check Check) Result {
+	ctx, cancel := context.WithTimeout(ctx, CheckTimeout)
+	defer cancel()
+
+	return check.Run(ctx, node)
+}
@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nodehealth
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func staticCheck(name string, status Status) Check {
+	return Check{
+		Name: name,
+		Run: func(context.Context, Node) Result {
+			return Result{Status: status, Message: name}
+		},
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry, err := NewRegistry(staticCheck("a", StatusPass), staticCheck("b", StatusFail))
+	require.NoError(t, err)
+
+	require.EqualError(t, registry.Register(staticCheck("a", StatusWarn)), `check "a" is already registered`)
+	require.NoError(t, registry.Register(staticCheck("c", StatusSkip)))
+
+	run := func(names ...string) ([]string, error) {
+		var results []string
+
+		err := registry.Run(context.Background(), Node{}, names, func(check Check, result Result, _ time.Duration) error {
+			results = append(results, check.Name+"="+result.Status.String())
+
+			return nil
+		})
+
+		return results, err
+	}
+
+	results, err := run()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a=PASS", "b=FAIL", "c=SKIP"}, results)
+
+	results, err = run("c", "a")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c=SKIP", "a=PASS"}, results)
+
+	_, err = run("d")
+	require.EqualError(t, err, `unknown check "d"`)
+}
+
+func TestDiskSpaceResult(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		usages []diskUsage
+
+		expectedStatus  Status
+		expectedMessage string
+	}{
+		{
+			name:            "none",
+			expectedStatus:  StatusSkip,
+			expectedMessage: "no system partitions are mounted",
+		},
+		{
+			name: "pass",
+			usages: []diskUsage{
+				{path: "/var", free: 50, total: 100},
+				{path: "/system/state", free: 90, total: 100},
+			},
+			expectedStatus:  StatusPass,
+			expectedMessage: "/var: 50% free, /system/state: 90% free",
+		},
+		{
+			name: "warn",
+			usages: []diskUsage{
+				{path: "/var", free: 10, total: 100},
+				{path: "/system/state", free: 90, total: 100},
+			},
+			expectedStatus:  StatusWarn,
+			expectedMessage: "/var: 10% free, /system/state: 90% free",
+		},
+		{
+			name: "fail",
+			usages: []diskUsage{
+				{path: "/var", free: 10, total: 100},
+				{path: "/system/state", free: 1, total: 100},
+			},
+			expectedStatus:  StatusFail,
+			expectedMessage: "/var: 10% free, /system/state: 1% free",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := diskSpaceResult(test.usages)
+
+			assert.Equal(t, test.expectedStatus, result.Status)
+			assert.Equal(t, test.expectedMessage, result.Message)
+			assert.Equal(t, result.Status == StatusWarn || result.Status == StatusFail, result.Remediation != "")
+		})
+	}
+}
+
+func TestCertificatesResult(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	cert := func(notBefore, notAfter time.Time) *x509.Certificate {
+		return &x509.Certificate{NotBefore: notBefore, NotAfter: notAfter}
+	}
+
+	valid := cert(now.Add(-time.Hour), now.Add(365*24*time.Hour))
+	expiring := cert(now.Add(-time.Hour), now.Add(24*time.Hour))
+	expired := cert(now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+
+	for _, test := range []struct {
+		name  string
+		certs []certificate
+
+		expectedStatus      Status
+		expectedMessage     string
+		expectedRemediation string
+	}{
+		{
+			name:            "none",
+			expectedStatus:  StatusSkip,
+			expectedMessage: "no certificates are issued yet",
+		},
+		{
+			name: "valid",
+			certs: []certificate{
+				{name: "Talos API server", cert: valid},
+				{name: "Talos CA", cert: valid, ca: true},
+			},
+			expectedStatus:  StatusPass,
+			expectedMessage: "2 certificates are valid",
+		},
+		{
+			name: "expiring",
+			certs: []certificate{
+				{name: "Talos API server", cert: valid},
+				{name: "etcd CA", cert: expiring, ca: true},
+			},
+			expectedStatus:      StatusWarn,
+			expectedMessage:     "etcd CA certificate expires at 2024-10-20T12:00:00Z",
+			expectedRemediation: "Rotate the CA with `talosctl rotate-ca`.",
+		},
+		{
+			name: "expired",
+			certs: []certificate{
+				{name: "Talos API server", cert: expired},
+				{name: "etcd CA", cert: expiring, ca: true},
+			},
+			expectedStatus:      StatusFail,
+			expectedMessage:     "Talos API server certificate expired at 2024-10-18T12:00:00Z, etcd CA certificate expires at 2024-10-20T12:00:00Z",
+			expectedRemediation: "Rotate the CA with `talosctl rotate-ca`.",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := certificatesResult(test.certs, now)
+
+			assert.Equal(t, test.expectedStatus, result.Status)
+			assert.Equal(t, test.expectedMessage, result.Message)
+			assert.Equal(t, test.expectedRemediation, result.Remediation)
+		})
+	}
+}
@@ -40,6 +40,10 @@ type Syncer struct {
 
 	restartSyncCh chan struct{}
 	epochChangeCh chan struct{}
+	statsChangeCh chan struct{}
+
+	statsMu sync.Mutex
+	sources map[string]*sourceState
 
 	firstSync bool
 
@@ -70,6 +74,9 @@ func NewSyncer(logger *zap.Logger, timeServers []string) *Syncer {
 
 		restartSyncCh: make(chan struct{}, 1),
 		epochChangeCh: make(chan struct{}, 1),
+		statsChangeCh: make(chan struct{}, 1),
+
+		sources: map[string]*sourceState{},
 
 		firstSync: true,
 
@@ -121,15 +128,21 @@ func (syncer *Syncer) setLastSyncServer(lastSyncServer string) {
 // SetTimeServers sets the list of time servers to use.
 func (syncer *Syncer) SetTimeServers(timeServers []string) {
 	syncer.timeServersMu.Lock()
-	defer syncer.timeServersMu.Unlock()
 
 	if slices.Equal(timeServers, syncer.timeServers) {
+		syncer.timeServersMu.Unlock()
+
 		return
 	}
 
 	syncer.timeServers = slices.Clone(timeServers)
 	syncer.lastSyncServer = ""
 
+	syncer.timeServersMu.Unlock()
+
+	// statistics of the old time servers are no longer relevant
+	syncer.resetSourceStats()
+
 	syncer.restartSync()
 }
 
@@ -208,6 +221,8 @@ func (syncer *Syncer) Run(ctx context.Context) {
 			pollInterval = syncer.MinPoll
 		}
 
+		syncer.recordPollInterval(lastSyncServer, pollInterval)
+
 		syncer.logger.Debug("sample stats",
 			zap.Duration("jitter", time.Duration(syncer.spikeDetector.Jitter()*float64(time.Second))),
 			zap.Duration("poll_interval", pollInterval),
@@ -230,6 +245,8 @@ func (syncer *Syncer) Run(ctx context.Context) {
 			}
 		}
 
+		syncer.notifyStatsChange()
+
 		select {
 		case <-ctx.Done():
 			return
@@ -335,6 +352,8 @@ func (syncer *Syncer) queryServer(server string) (*Measurement, error) {
 func (syncer *Syncer) queryPTP(server string) (*Measurement, error) {
 	phc, err := os.Open(server)
 	if err != nil {
+		syncer.recordSample(server, nil, nil)
+
 		return nil, err
 	}
 
@@ -354,6 +373,8 @@ func (syncer *Syncer) queryPTP(server string) (*Measurement, error) {
 
 	err = unix.ClockGettime(clockid, &ts)
 	if err != nil {
+		syncer.recordSample(server, nil, nil)
+
 		return nil, err
 	}
 
@@ -371,12 +392,16 @@ func (syncer *Syncer) queryPTP(server string) (*Measurement, error) {
 		Spike:       false,
 	}
 
+	syncer.recordSample(server, meas, nil)
+
 	return meas, err
 }
 
 func (syncer *Syncer) queryNTP(server string) (*Measurement, error) {
 	resp, err := syncer.NTPQuery(server)
 	if err != nil {
+		syncer.recordSample(server, nil, nil)
+
 		return nil, err
 	}
 
@@ -393,14 +418,20 @@ func (syncer *Syncer) queryNTP(server string) (*Measurement, error) {
 
 	validationError := resp.Validate()
 	if validationError != nil {
+		syncer.recordSample(server, nil, resp)
+
 		return nil, validationError
 	}
 
-	return &Measurement{
+	meas := &Measurement{
 		ClockOffset: resp.ClockOffset,
 		Leap:        resp.Leap,
 		Spike:       syncer.isSpike(resp),
-	}, nil
+	}
+
+	syncer.recordSample(server, meas, resp)
+
+	return meas, nil
 }
 
 // log2i returns 0 for v == 0 and v == 1.
@@ -266,6 +266,56 @@ func (suite *NTPSuite) TestSyncContinuous() {
 	wg.Wait()
 }
 
+func (suite *NTPSuite) TestSourceStats() {
+	syncer := ntp.NewSyncer(zaptest.NewLogger(suite.T()).With(zap.String("controller", "ntp")), []string{"127.0.0.1", "127.0.0.3"})
+
+	syncer.AdjustTime = suite.adjustSystemClock
+	syncer.CurrentTime = suite.getSystemClock
+	syncer.NTPQuery = suite.fakeQuery
+
+	syncer.MinPoll = time.Second
+	syncer.MaxPoll = time.Second
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	select {
+	case <-syncer.StatsChange():
+	case <-time.After(10 * time.Second):
+		suite.Assert().Fail("stats change timeout")
+	}
+
+	stats := syncer.SourceStats()
+	suite.Require().Len(stats, 2)
+
+	suite.Assert().Equal("127.0.0.1", stats[0].Server)
+	suite.Assert().False(stats[0].Selected)
+	suite.Assert().EqualValues(0, stats[0].Reachability)
+
+	suite.Assert().Equal("127.0.0.3", stats[1].Server)
+	suite.Assert().True(stats[1].Selected)
+	suite.Assert().EqualValues(1, stats[1].Reachability)
+	suite.Assert().EqualValues(1, stats[1].Stratum)
+	suite.Assert().Equal(time.Millisecond, stats[1].Offset)
+	suite.Assert().Equal(time.Millisecond/2, stats[1].Delay)
+	suite.Assert().Equal(time.Second, stats[1].PollInterval)
+	suite.Assert().Zero(stats[1].Jitter)
+
+	cancel()
+
+	wg.Wait()
+}
+
 //nolint:dupl
 func (suite *NTPSuite) TestSyncKissOfDeath() {
 	syncer := ntp.NewSyncer(zaptest.NewLogger(suite.T()).With(zap.String("controller", "ntp")), []string{"127.0.0.8"})
@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ntp
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/beevik/ntp"
+)
+
+// sourceSamples is the number of the most recent offset samples kept per source to calculate jitter.
+const sourceSamples = 8
+
+// SourceStats describes statistics of a single time source.
+type SourceStats struct {
+	Server         string
+	Selected       bool
+	Stratum        uint8
+	Reachability   uint8
+	PollInterval   time.Duration
+	LastSample     time.Time
+	Offset         time.Duration
+	Jitter         time.Duration
+	Delay          time.Duration
+	RootDelay      time.Duration
+	RootDispersion time.Duration
+}
+
+type sourceState struct {
+	stats   SourceStats
+	offsets []time.Duration
+}
+
+// jitter returns RMS of the differences between the latest offset and the older offsets (RFC 5905, peer jitter).
+func (state *sourceState) jitter() time.Duration {
+	if len(state.offsets) < 2 {
+		return 0
+	}
+
+	latest := state.offsets[len(state.offsets)-1]
+
+	var sum float64
+
+	for _, offset := range state.offsets[:len(state.offsets)-1] {
+		diff := (latest - offset).Seconds()
+		sum += diff * diff
+	}
+
+	return time.Duration(math.Sqrt(sum/float64(len(state.offsets)-1)) * float64(time.Second))
+}
+
+// StatsChange returns a channel which receives a value each time source statistics are updated.
+func (syncer *Syncer) StatsChange() <-chan struct{} {
+	return syncer.statsChangeCh
+}
+
+// SourceStats returns a snapshot of the statistics of all polled time sources sorted by server.
+func (syncer *Syncer) SourceStats() []SourceStats {
+	lastSyncServer := syncer.getLastSyncServer()
+
+	syncer.statsMu.Lock()
+	defer syncer.statsMu.Unlock()
+
+	result := make([]SourceStats, 0, len(syncer.sources))
+
+	for server, state := range syncer.sources {
+		stats := state.stats
+		stats.Selected = server == lastSyncServer
+
+		result = append(result, stats)
+	}
+
+	slices.SortFunc(result, func(a, b SourceStats) int {
+		return strings.Compare(a.Server, b.Server)
+	})
+
+	return result
+}
+
+func (syncer *Syncer) resetSourceStats() {
+	syncer.statsMu.Lock()
+	syncer.sources = map[string]*sourceState{}
+	syncer.statsMu.Unlock()
+
+	syncer.notifyStatsChange()
+}
+
+func (syncer *Syncer) getSourceState(server string) *sourceState {
+	state, ok := syncer.sources[server]
+	if !ok {
+		state = &sourceState{
+			stats: SourceStats{
+				Server: server,
+			},
+		}
+
+		syncer.sources[server] = state
+	}
+
+	return state
+}
+
+// recordSample records the result of the time source poll.
+//
+// Response might be nil for PTP sources or failed polls.
+func (syncer *Syncer) recordSample(server string, measurement *Measurement, resp *ntp.Response) {
+	syncer.statsMu.Lock()
+	defer syncer.statsMu.Unlock()
+
+	state := syncer.getSourceState(server)
+
+	state.stats.Reachability <<= 1
+
+	if measurement == nil {
+		return
+	}
+
+	state.stats.Reachability |= 1
+	state.stats.LastSample = syncer.CurrentTime()
+	state.stats.Offset = measurement.ClockOffset
+
+	if resp != nil {
+		state.stats.Stratum = resp.Stratum
+		state.stats.Delay = resp.RTT
+		state.stats.RootDelay = resp.RootDelay
+		state.stats.RootDispersion = resp.RootDispersion
+	}
+
+	state.offsets = append(state.offsets, measurement.ClockOffset)
+
+	if len(state.offsets) > sourceSamples {
+		state.offsets = state.offsets[len(state.offsets)-sourceSamples:]
+	}
+
+	state.stats.Jitter = state.jitter()
+}
+
+// recordPollInterval records the poll interval of the source.
+func (syncer *Syncer) recordPollInterval(server string, pollInterval time.Duration) {
+	if server == "" {
+		return
+	}
+
+	syncer.statsMu.Lock()
+	defer syncer.statsMu.Unlock()
+
+	syncer.getSourceState(server).stats.PollInterval = pollInterval
+}
+
+func (syncer *Syncer) notifyStatsChange() {
+	select {
+	case syncer.statsChangeCh <- struct{}{}:
+	default:
+	}
+}
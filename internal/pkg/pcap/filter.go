@@ -0,0 +1,551 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pcap
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/bpf"
+)
+
+// filterSnapLen is the number of bytes of the packet accepted by the compiled filter.
+const filterSnapLen = 262144
+
+// CompileFilter compiles the packet filter expression into the BPF program.
+//
+// The expression supports a subset of the tcpdump (pcap-filter) syntax:
+//
+//	ip, ip6, arp, tcp, udp, sctp, icmp, icmp6
+//	[src|dst] host <address>
+//	[src|dst] net <prefix>
+//	[tcp|udp|sctp] [src|dst] port <port>
+//
+// Primitives can be combined with 'and' ('&&'), 'or' ('||'), 'not' ('!') and parentheses.
+//
+// IPv6 extension headers are not parsed, so the transport protocol and ports are only matched
+// if the transport header immediately follows the IPv6 header.
+func CompileFilter(expr string, linkType LinkType) ([]bpf.RawInstruction, error) {
+	var nh uint32 // network header offset
+
+	switch linkType {
+	case LinkTypeEthernet:
+		nh = 14
+	case LinkTypeRaw:
+		nh = 0
+	default:
+		return nil, fmt.Errorf("unsupported link type %d", linkType)
+	}
+
+	p := &filterParser{
+		tokens:   tokenizeFilter(expr),
+		linkType: linkType,
+		nh:       nh,
+	}
+
+	root, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing filter %q: %w", expr, err)
+	}
+
+	var g filterGen
+
+	accept, reject := g.newLabel(), g.newLabel()
+
+	g.gen(root, accept, reject)
+
+	g.place(accept)
+	g.emit(filterInsn{ins: bpf.RetConstant{Val: filterSnapLen}})
+	g.place(reject)
+	g.emit(filterInsn{ins: bpf.RetConstant{Val: 0}})
+
+	program, err := g.resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	return bpf.Assemble(program)
+}
+
+func tokenizeFilter(expr string) []string {
+	for _, op := range []string{"(", ")", "&&", "||", "!"} {
+		expr = strings.ReplaceAll(expr, op, " "+op+" ")
+	}
+
+	return strings.Fields(expr)
+}
+
+// filterNode is a node of the parsed filter expression.
+type filterNode interface {
+	filterNode()
+}
+
+type (
+	andNode struct{ left, right filterNode }
+	orNode  struct{ left, right filterNode }
+	notNode struct{ node filterNode }
+
+	// checkNode is a sequence of loads and tests, it matches if all tests pass.
+	checkNode struct{ steps []bpf.Instruction }
+)
+
+func (andNode) filterNode()   {}
+func (orNode) filterNode()    {}
+func (notNode) filterNode()   {}
+func (checkNode) filterNode() {}
+
+type filterParser struct {
+	tokens   []string
+	pos      int
+	linkType LinkType
+	nh       uint32
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+
+	return ""
+}
+
+func (p *filterParser) next() string {
+	token := p.peek()
+
+	if token != "" {
+		p.pos++
+	}
+
+	return token
+}
+
+func (p *filterParser) parse() (filterNode, error) {
+	if len(p.tokens) == 0 {
+		return nil, errors.New("empty expression")
+	}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := p.peek(); token != "" {
+		return nil, fmt.Errorf("unexpected %q", token)
+	}
+
+	return node, nil
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "or" || p.peek() == "||" {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = orNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "and" || p.peek() == "&&" {
+		p.next()
+
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		left = andNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (filterNode, error) {
+	switch p.peek() {
+	case "not", "!":
+		p.next()
+
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		return notNode{node}, nil
+	case "(":
+		p.next()
+
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if token := p.next(); token != ")" {
+			return nil, fmt.Errorf("expected \")\", got %q", token)
+		}
+
+		return node, nil
+	default:
+		return p.parsePrimitive()
+	}
+}
+
+// IP protocol numbers.
+const (
+	protoICMP   = 1
+	protoTCP    = 6
+	protoUDP    = 17
+	protoICMPv6 = 58
+	protoSCTP   = 132
+)
+
+var transportProtocols = map[string]uint32{
+	"tcp":  protoTCP,
+	"udp":  protoUDP,
+	"sctp": protoSCTP,
+}
+
+//nolint:gocyclo,cyclop
+func (p *filterParser) parsePrimitive() (filterNode, error) {
+	token := p.next()
+
+	switch token {
+	case "":
+		return nil, errors.New("unexpected end of expression")
+	case "ip":
+		return p.ip4(), nil
+	case "ip6":
+		return p.ip6(), nil
+	case "arp":
+		if p.linkType != LinkTypeEthernet {
+			return nil, errors.New("arp can only be matched on ethernet links")
+		}
+
+		return checkNode{[]bpf.Instruction{
+			bpf.LoadAbsolute{Off: 12, Size: 2},
+			bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x0806},
+		}}, nil
+	case "icmp":
+		return andNode{p.ip4(), p.ip4Proto(protoICMP)}, nil
+	case "icmp6":
+		return andNode{p.ip6(), p.ip6Proto(protoICMPv6)}, nil
+	}
+
+	protocols := []uint32{protoTCP, protoUDP, protoSCTP}
+
+	if proto, ok := transportProtocols[token]; ok {
+		protocols = []uint32{proto}
+
+		switch p.peek() {
+		case "src", "dst", "port":
+			token = p.next()
+		default:
+			return orNode{
+				andNode{p.ip4(), p.ip4Proto(proto)},
+				andNode{p.ip6(), p.ip6Proto(proto)},
+			}, nil
+		}
+	}
+
+	var src, dst bool
+
+	switch token {
+	case "src":
+		src = true
+		token = p.next()
+	case "dst":
+		dst = true
+		token = p.next()
+	default:
+		src, dst = true, true
+	}
+
+	switch token {
+	case "port":
+		port, err := strconv.ParseUint(p.next(), 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port: %w", err)
+		}
+
+		return p.port(protocols, src, dst, uint32(port)), nil
+	case "host", "net":
+		value := p.next()
+
+		var (
+			prefix netip.Prefix
+			err    error
+		)
+
+		if token == "host" {
+			var addr netip.Addr
+
+			addr, err = netip.ParseAddr(value)
+			if err == nil {
+				prefix = netip.PrefixFrom(addr, addr.BitLen())
+			}
+		} else {
+			prefix, err = netip.ParsePrefix(value)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", token, err)
+		}
+
+		return p.address(prefix.Masked(), src, dst), nil
+	default:
+		return nil, fmt.Errorf("unexpected %q", token)
+	}
+}
+
+func (p *filterParser) ip4() filterNode {
+	if p.linkType == LinkTypeRaw {
+		return checkNode{[]bpf.Instruction{
+			bpf.LoadAbsolute{Off: 0, Size: 1},
+			bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: 0xf0},
+			bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x40},
+		}}
+	}
+
+	return checkNode{[]bpf.Instruction{
+		bpf.LoadAbsolute{Off: 12, Size: 2},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x0800},
+	}}
+}
+
+func (p *filterParser) ip6() filterNode {
+	if p.linkType == LinkTypeRaw {
+		return checkNode{[]bpf.Instruction{
+			bpf.LoadAbsolute{Off: 0, Size: 1},
+			bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: 0xf0},
+			bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x60},
+		}}
+	}
+
+	return checkNode{[]bpf.Instruction{
+		bpf.LoadAbsolute{Off: 12, Size: 2},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x86dd},
+	}}
+}
+
+func (p *filterParser) ip4Proto(proto uint32) filterNode {
+	return checkNode{[]bpf.Instruction{
+		bpf.LoadAbsolute{Off: p.nh + 9, Size: 1},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: proto},
+	}}
+}
+
+func (p *filterParser) ip6Proto(proto uint32) filterNode {
+	return checkNode{[]bpf.Instruction{
+		bpf.LoadAbsolute{Off: p.nh + 6, Size: 1},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: proto},
+	}}
+}
+
+func (p *filterParser) port(protocols []uint32, src, dst bool, port uint32) filterNode {
+	var ip4Proto, ip6Proto filterNode
+
+	for _, proto := range protocols {
+		ip4Proto = orNodes(ip4Proto, p.ip4Proto(proto))
+		ip6Proto = orNodes(ip6Proto, p.ip6Proto(proto))
+	}
+
+	var ip4Port, ip6Port filterNode
+
+	for _, dir := range []struct {
+		match bool
+		off   uint32
+	}{{src, 0}, {dst, 2}} {
+		if !dir.match {
+			continue
+		}
+
+		ip4Port = orNodes(ip4Port, checkNode{[]bpf.Instruction{
+			bpf.LoadMemShift{Off: p.nh},
+			bpf.LoadIndirect{Off: p.nh + dir.off, Size: 2},
+			bpf.JumpIf{Cond: bpf.JumpEqual, Val: port},
+		}})
+
+		ip6Port = orNodes(ip6Port, checkNode{[]bpf.Instruction{
+			bpf.LoadAbsolute{Off: p.nh + 40 + dir.off, Size: 2},
+			bpf.JumpIf{Cond: bpf.JumpEqual, Val: port},
+		}})
+	}
+
+	// only the first fragment of IPv4 packet carries the transport header
+	ip4NotFragment := checkNode{[]bpf.Instruction{
+		bpf.LoadAbsolute{Off: p.nh + 6, Size: 2},
+		bpf.JumpIf{Cond: bpf.JumpBitsNotSet, Val: 0x1fff},
+	}}
+
+	return orNode{
+		andNode{andNode{andNode{p.ip4(), ip4Proto}, ip4NotFragment}, ip4Port},
+		andNode{andNode{p.ip6(), ip6Proto}, ip6Port},
+	}
+}
+
+func (p *filterParser) address(prefix netip.Prefix, src, dst bool) filterNode {
+	ipCheck, srcOff, dstOff := p.ip4(), p.nh+12, p.nh+16
+
+	if prefix.Addr().Is6() {
+		ipCheck, srcOff, dstOff = p.ip6(), p.nh+8, p.nh+24
+	}
+
+	addr := prefix.Addr().AsSlice()
+
+	var match filterNode
+
+	for _, dir := range []struct {
+		match bool
+		off   uint32
+	}{{src, srcOff}, {dst, dstOff}} {
+		if !dir.match {
+			continue
+		}
+
+		var steps []bpf.Instruction
+
+		for i := 0; i < len(addr) && i*8 < prefix.Bits(); i += 4 {
+			bits := min(prefix.Bits()-i*8, 32)
+			mask := uint32(math.MaxUint32 << (32 - bits))
+			word := uint32(addr[i])<<24 | uint32(addr[i+1])<<16 | uint32(addr[i+2])<<8 | uint32(addr[i+3])
+
+			steps = append(steps, bpf.LoadAbsolute{Off: dir.off + uint32(i), Size: 4})
+
+			if mask != math.MaxUint32 {
+				steps = append(steps, bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: mask})
+			}
+
+			steps = append(steps, bpf.JumpIf{Cond: bpf.JumpEqual, Val: word})
+		}
+
+		match = orNodes(match, checkNode{steps})
+	}
+
+	return andNode{ipCheck, match}
+}
+
+func orNodes(left, right filterNode) filterNode {
+	if left == nil {
+		return right
+	}
+
+	return orNode{left, right}
+}
+
+type filterLabel int
+
+// labelNext is a pseudo-label pointing to the next instruction.
+const labelNext filterLabel = -1
+
+type filterInsn struct {
+	ins    bpf.Instruction
+	jt, jf filterLabel
+}
+
+// filterGen generates the BPF program from the filter expression.
+//
+// Every node is compiled to jump to the 'true' label if it matches, and to the 'false' label otherwise.
+// All jumps are forward, as labels are always placed after the code which refers to them.
+type filterGen struct {
+	insns  []filterInsn
+	labels []int
+}
+
+func (g *filterGen) newLabel() filterLabel {
+	g.labels = append(g.labels, -1)
+
+	return filterLabel(len(g.labels) - 1)
+}
+
+func (g *filterGen) place(l filterLabel) {
+	g.labels[l] = len(g.insns)
+}
+
+func (g *filterGen) emit(insn filterInsn) {
+	g.insns = append(g.insns, insn)
+}
+
+func (g *filterGen) gen(node filterNode, t, f filterLabel) {
+	switch n := node.(type) {
+	case andNode:
+		mid := g.newLabel()
+
+		g.gen(n.left, mid, f)
+		g.place(mid)
+		g.gen(n.right, t, f)
+	case orNode:
+		mid := g.newLabel()
+
+		g.gen(n.left, t, mid)
+		g.place(mid)
+		g.gen(n.right, t, f)
+	case notNode:
+		g.gen(n.node, f, t)
+	case checkNode:
+		for _, step := range n.steps {
+			if _, ok := step.(bpf.JumpIf); ok {
+				g.emit(filterInsn{ins: step, jt: labelNext, jf: f})
+			} else {
+				g.emit(filterInsn{ins: step})
+			}
+		}
+
+		g.emit(filterInsn{ins: bpf.Jump{}, jt: t})
+	}
+}
+
+func (g *filterGen) resolve() ([]bpf.Instruction, error) {
+	program := make([]bpf.Instruction, 0, len(g.insns))
+
+	skip := func(pc int, l filterLabel) int {
+		if l == labelNext {
+			return 0
+		}
+
+		return g.labels[l] - pc - 1
+	}
+
+	for pc, insn := range g.insns {
+		switch ins := insn.ins.(type) {
+		case bpf.JumpIf:
+			skipTrue, skipFalse := skip(pc, insn.jt), skip(pc, insn.jf)
+
+			if skipTrue > math.MaxUint8 || skipFalse > math.MaxUint8 {
+				return nil, errors.New("filter expression is too complex")
+			}
+
+			ins.SkipTrue, ins.SkipFalse = uint8(skipTrue), uint8(skipFalse)
+
+			program = append(program, ins)
+		case bpf.Jump:
+			ins.Skip = uint32(skip(pc, insn.jt))
+
+			program = append(program, ins)
+		default:
+			program = append(program, ins)
+		}
+	}
+
+	return program, nil
+}
@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pcap_test
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/bpf"
+
+	"github.com/siderolabs/talos/internal/pkg/pcap"
+)
+
+func ipv4Packet(proto uint8, src, dst string, srcPort, dstPort uint16) []byte {
+	pkt := make([]byte, 14+20+8)
+
+	binary.BigEndian.PutUint16(pkt[12:], 0x0800)
+
+	ip := pkt[14:]
+	ip[0] = 0x45
+	ip[9] = proto
+	copy(ip[12:], netip.MustParseAddr(src).AsSlice())
+	copy(ip[16:], netip.MustParseAddr(dst).AsSlice())
+
+	binary.BigEndian.PutUint16(ip[20:], srcPort)
+	binary.BigEndian.PutUint16(ip[22:], dstPort)
+
+	return pkt
+}
+
+func ipv6Packet(proto uint8, src, dst string, srcPort, dstPort uint16) []byte {
+	pkt := make([]byte, 14+40+8)
+
+	binary.BigEndian.PutUint16(pkt[12:], 0x86dd)
+
+	ip := pkt[14:]
+	ip[0] = 0x60
+	ip[6] = proto
+	copy(ip[8:], netip.MustParseAddr(src).AsSlice())
+	copy(ip[24:], netip.MustParseAddr(dst).AsSlice())
+
+	binary.BigEndian.PutUint16(ip[40:], srcPort)
+	binary.BigEndian.PutUint16(ip[42:], dstPort)
+
+	return pkt
+}
+
+func TestCompileFilter(t *testing.T) {
+	t.Parallel()
+
+	tcp4 := ipv4Packet(6, "10.5.0.2", "10.5.0.3", 34567, 6443)
+	udp4 := ipv4Packet(17, "10.5.0.3", "1.1.1.1", 5353, 53)
+	icmp4 := ipv4Packet(1, "10.5.0.2", "10.5.0.3", 0, 0)
+	tcp6 := ipv6Packet(6, "fd00::2", "fd00::3", 34567, 6443)
+
+	arp := make([]byte, 42)
+	binary.BigEndian.PutUint16(arp[12:], 0x0806)
+
+	for _, test := range []struct {
+		filter string
+
+		matches    [][]byte
+		notMatches [][]byte
+	}{
+		{
+			filter:     "port 6443",
+			matches:    [][]byte{tcp4, tcp6},
+			notMatches: [][]byte{udp4, icmp4, arp},
+		},
+		{
+			filter:     "tcp dst port 6443",
+			matches:    [][]byte{tcp4, tcp6},
+			notMatches: [][]byte{udp4},
+		},
+		{
+			filter:     "src port 6443",
+			notMatches: [][]byte{tcp4, tcp6},
+		},
+		{
+			filter:     "udp and not port 53",
+			notMatches: [][]byte{tcp4, udp4},
+		},
+		{
+			filter:     "host 10.5.0.3",
+			matches:    [][]byte{tcp4, udp4, icmp4},
+			notMatches: [][]byte{tcp6, arp},
+		},
+		{
+			filter:     "src net 10.5.0.0/24 && (icmp || tcp)",
+			matches:    [][]byte{tcp4, icmp4},
+			notMatches: [][]byte{udp4, tcp6},
+		},
+		{
+			filter:     "dst host fd00::3",
+			matches:    [][]byte{tcp6},
+			notMatches: [][]byte{tcp4},
+		},
+		{
+			filter:     "ip6 or arp",
+			matches:    [][]byte{tcp6, arp},
+			notMatches: [][]byte{tcp4},
+		},
+		{
+			filter:     "!ip",
+			matches:    [][]byte{tcp6, arp},
+			notMatches: [][]byte{tcp4, udp4},
+		},
+	} {
+		t.Run(test.filter, func(t *testing.T) {
+			t.Parallel()
+
+			raw, err := pcap.CompileFilter(test.filter, pcap.LinkTypeEthernet)
+			require.NoError(t, err)
+
+			program, ok := bpf.Disassemble(raw)
+			require.True(t, ok)
+
+			vm, err := bpf.NewVM(program)
+			require.NoError(t, err)
+
+			for _, pkt := range test.matches {
+				n, err := vm.Run(pkt)
+				require.NoError(t, err)
+
+				assert.NotZero(t, n)
+			}
+
+			for _, pkt := range test.notMatches {
+				n, err := vm.Run(pkt)
+				require.NoError(t, err)
+
+				assert.Zero(t, n)
+			}
+		})
+	}
+}
+
+func TestCompileFilterRaw(t *testing.T) {
+	t.Parallel()
+
+	raw, err := pcap.CompileFilter("tcp port 6443", pcap.LinkTypeRaw)
+	require.NoError(t, err)
+
+	program, ok := bpf.Disassemble(raw)
+	require.True(t, ok)
+
+	vm, err := bpf.NewVM(program)
+	require.NoError(t, err)
+
+	pkt := ipv4Packet(6, "10.5.0.2", "10.5.0.3", 34567, 6443)[14:]
+
+	n, err := vm.Run(pkt)
+	require.NoError(t, err)
+	assert.NotZero(t, n)
+}
+
+func TestCompileFilterErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, filter := range []string{
+		"",
+		"port",
+		"port 70000",
+		"host 10.5.0",
+		"tcp and",
+		"(tcp",
+		"tcp)",
+		"ether host 00:11:22:33:44:55",
+	} {
+		_, err := pcap.CompileFilter(filter, pcap.LinkTypeEthernet)
+		assert.Error(t, err, filter)
+	}
+
+	_, err := pcap.CompileFilter("arp", pcap.LinkTypeRaw)
+	assert.Error(t, err)
+}
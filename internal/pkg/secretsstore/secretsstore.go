@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package secretsstore implements the node store of the secrets bundles referenced by the machine config.
+//
+// The machine config generated with the secrets reference doesn't embed the private keys and the shared secrets,
+// they are resolved from the secrets bundle with the referenced ID before the config is used.
+package secretsstore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// ErrNotFound is returned when the secrets bundle is not in the store.
+var ErrNotFound = errors.New("secrets bundle not found")
+
+// Store manages the secrets bundles saved on the node.
+type Store struct {
+	Path string
+}
+
+// Default returns the Store on the STATE partition.
+func Default() Store {
+	return Store{
+		Path: constants.SecretsStorePath,
+	}
+}
+
+// DecodeBundle decodes the secrets bundle as generated by `talosctl gen secrets`.
+func DecodeBundle(data []byte) (*secrets.Bundle, error) {
+	bundle := &secrets.Bundle{
+		Clock: secrets.NewClock(),
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(bundle); err != nil {
+		return nil, fmt.Errorf("error decoding secrets bundle: %w", err)
+	}
+
+	if bundle.Certs == nil || bundle.Certs.OS == nil || bundle.Cluster == nil || bundle.Secrets == nil || bundle.TrustdInfo == nil {
+		return nil, errors.New("secrets bundle is incomplete")
+	}
+
+	return bundle, nil
+}
+
+// Save stores the secrets bundle with the given ID.
+func (s Store) Save(id string, bundle *secrets.Bundle) error {
+	data, err := yaml.Marshal(bundle)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(s.Path, 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.path(id), data, 0o600)
+}
+
+// Load returns the secrets bundle with the given ID.
+func (s Store) Load(id string) (*secrets.Bundle, error) {
+	data, err := os.ReadFile(s.path(id))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %q", ErrNotFound, id)
+		}
+
+		return nil, err
+	}
+
+	return DecodeBundle(data)
+}
+
+// Resolve fills the secrets of the machine config referencing the secrets bundle.
+//
+// If the bundle is nil, the referenced secrets bundle is loaded from the store.
+// The machine config without the secrets reference is returned as is.
+func (s Store) Resolve(cfg config.Provider, bundle *secrets.Bundle) (config.Provider, error) {
+	if cfg.SecretsReference() == nil {
+		if bundle != nil {
+			return nil, errors.New("secrets bundle is provided, but the machine config doesn't reference it")
+		}
+
+		return cfg, nil
+	}
+
+	if bundle == nil {
+		var err error
+
+		bundle, err = s.Load(cfg.SecretsReference().SecretsBundleID())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return generate.ResolveSecretsReference(cfg, bundle)
+}
+
+func (s Store) path(id string) string {
+	return filepath.Join(s.Path, id+".yaml")
+}
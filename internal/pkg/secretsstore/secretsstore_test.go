@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secretsstore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/internal/pkg/secretsstore"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	store := secretsstore.Store{
+		Path: t.TempDir(),
+	}
+
+	bundle, err := secrets.NewBundle(secrets.NewClock(), nil)
+	require.NoError(t, err)
+
+	input, err := generate.NewInput("test", "https://10.0.1.5", constants.DefaultKubernetesVersion,
+		generate.WithSecretsBundle(bundle),
+		generate.WithSecretsReference("production"),
+	)
+	require.NoError(t, err)
+
+	cfg, err := input.Config(machine.TypeControlPlane)
+	require.NoError(t, err)
+
+	_, err = store.Resolve(cfg, nil)
+	require.ErrorIs(t, err, secretsstore.ErrNotFound)
+
+	require.NoError(t, store.Save("production", bundle))
+
+	resolved, err := store.Resolve(cfg, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, bundle.Certs.OS.Key, resolved.Machine().Security().IssuingCA().Key)
+	assert.Equal(t, bundle.Cluster.Secret, resolved.Cluster().Secret())
+
+	// the config without the secrets reference is returned as is
+	plainInput, err := generate.NewInput("test", "https://10.0.1.5", constants.DefaultKubernetesVersion, generate.WithSecretsBundle(bundle))
+	require.NoError(t, err)
+
+	plainCfg, err := plainInput.Config(machine.TypeWorker)
+	require.NoError(t, err)
+
+	unchanged, err := store.Resolve(plainCfg, nil)
+	require.NoError(t, err)
+	assert.Same(t, plainCfg, unchanged)
+
+	_, err = store.Resolve(plainCfg, bundle)
+	require.Error(t, err)
+}
+
+func TestDecodeBundle(t *testing.T) {
+	t.Parallel()
+
+	bundle, err := secrets.NewBundle(secrets.NewClock(), nil)
+	require.NoError(t, err)
+
+	data, err := yaml.Marshal(bundle)
+	require.NoError(t, err)
+
+	decoded, err := secretsstore.DecodeBundle(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, bundle.Certs.OS.Key, decoded.Certs.OS.Key)
+	assert.Equal(t, bundle.TrustdInfo.Token, decoded.TrustdInfo.Token)
+
+	_, err = secretsstore.DecodeBundle([]byte("cluster: {}\n"))
+	require.Error(t, err)
+}
@@ -8,6 +8,7 @@ package pesign
 import (
 	"crypto"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"os"
 
@@ -53,3 +54,37 @@ func (s *Signer) Sign(input, output string) error {
 
 	return os.WriteFile(output, pecoff.Bytes(), 0o600)
 }
+
+// Verify verifies the signature of the PE file against the list of certificates.
+//
+// Verify returns the certificate the file is signed with.
+func Verify(path string, certs []*x509.Certificate) (*x509.Certificate, error) {
+	in, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer in.Close() //nolint:errcheck
+
+	pecoff, err := authenticode.Parse(in)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing binary: %w", err)
+	}
+
+	for _, cert := range certs {
+		ok, err := pecoff.Verify(cert)
+		if err != nil {
+			if errors.Is(err, authenticode.ErrNoSignatures) {
+				return nil, err
+			}
+
+			continue
+		}
+
+		if ok {
+			return cert, nil
+		}
+	}
+
+	return nil, errors.New("binary is not signed by any of the trusted certificates")
+}
@@ -61,3 +61,41 @@ func TestSign(t *testing.T) {
 
 	require.Greater(t, signed.Size(), unsigned.Size())
 }
+
+func TestVerify(t *testing.T) {
+	currentTime := time.Now()
+
+	newCA := func(name string) *x509.CertificateAuthority {
+		ca, err := x509.NewSelfSignedCertificateAuthority(
+			x509.RSA(true),
+			x509.Bits(2048),
+			x509.CommonName(name),
+			x509.NotAfter(currentTime.Add(secrets.CAValidityTime)),
+			x509.NotBefore(currentTime),
+			x509.Organization(name),
+		)
+		require.NoError(t, err)
+
+		return ca
+	}
+
+	signingKey := newCA("test-sign")
+	otherKey := newCA("test-other")
+
+	tmpDir := t.TempDir()
+
+	signer, err := pesign.NewSigner(&certificateProvider{signingKey})
+	require.NoError(t, err)
+
+	require.NoError(t, signer.Sign("testdata/systemd-bootx64.efi", filepath.Join(tmpDir, "boot.efi")))
+
+	cert, err := pesign.Verify(filepath.Join(tmpDir, "boot.efi"), []*stdx509.Certificate{otherKey.Crt, signingKey.Crt})
+	require.NoError(t, err)
+	require.Equal(t, signingKey.Crt, cert)
+
+	_, err = pesign.Verify(filepath.Join(tmpDir, "boot.efi"), []*stdx509.Certificate{otherKey.Crt})
+	require.Error(t, err)
+
+	_, err = pesign.Verify("testdata/systemd-bootx64.efi", []*stdx509.Certificate{signingKey.Crt})
+	require.Error(t, err)
+}
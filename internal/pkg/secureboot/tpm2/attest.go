@@ -0,0 +1,235 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tpm2
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpm2/transport"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot"
+	"github.com/siderolabs/talos/pkg/machinery/attestation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// AttestationPCRs is the list of PCRs covered by the node quotes:
+// firmware and boot loader measurements, the UKI PCR and the boot configuration PCR.
+var AttestationPCRs = []int{0, 1, 2, 3, 4, 5, 6, secureboot.SecureBootStatePCR, secureboot.UKIPCR, constants.ConfigPCR}
+
+// attestationRetries is the number of attempts to get a consistent quote if PCRs are extended during the quote.
+const attestationRetries = 3
+
+// AttestationKeyTemplate is the template of the restricted signing key used to sign the quotes.
+//
+// The key is a primary key in the endorsement hierarchy, so it is re-created with the same
+// public part as long as the TPM is not cleared.
+var AttestationKeyTemplate = tpm2.TPMTPublic{
+	Type:    tpm2.TPMAlgECC,
+	NameAlg: tpm2.TPMAlgSHA256,
+	ObjectAttributes: tpm2.TPMAObject{
+		FixedTPM:            true,
+		FixedParent:         true,
+		SensitiveDataOrigin: true,
+		UserWithAuth:        true,
+		NoDA:                true,
+		Restricted:          true,
+		SignEncrypt:         true,
+	},
+	Parameters: tpm2.NewTPMUPublicParms(
+		tpm2.TPMAlgECC,
+		&tpm2.TPMSECCParms{
+			Symmetric: tpm2.TPMTSymDefObject{
+				Algorithm: tpm2.TPMAlgNull,
+			},
+			Scheme: tpm2.TPMTECCScheme{
+				Scheme: tpm2.TPMAlgECDSA,
+				Details: tpm2.NewTPMUAsymScheme(
+					tpm2.TPMAlgECDSA,
+					&tpm2.TPMSSigSchemeECDSA{
+						HashAlg: tpm2.TPMAlgSHA256,
+					},
+				),
+			},
+			CurveID: tpm2.TPMECCNistP256,
+		},
+	),
+	Unique: tpm2.NewTPMUPublicID(
+		tpm2.TPMAlgECC,
+		&tpm2.TPMSECCPoint{
+			X: tpm2.TPM2BECCParameter{
+				Buffer: make([]byte, 32),
+			},
+			Y: tpm2.TPM2BECCParameter{
+				Buffer: make([]byte, 32),
+			},
+		},
+	),
+}
+
+// AttestationResponse is the response from the TPM2.0 Attest operation.
+type AttestationResponse struct {
+	// Quote is the marshaled TPMS_ATTEST structure.
+	Quote []byte
+	// Signature is the marshaled TPMT_SIGNATURE structure.
+	Signature []byte
+	// AttestationKey is the PKIX DER encoded public part of the attestation key.
+	AttestationKey []byte
+	// PCRValues are the hex encoded SHA256 PCR values in the order of the PCR selection.
+	PCRValues []string
+}
+
+// Attest generates a TPM quote over the SHA256 PCR bank values qualified with the nonce.
+//
+// PCR values are read after the quote is generated, so the caller should verify the quote
+// to make sure PCRs were not extended in between.
+func Attest(nonce []byte, pcrs []int) (resp *AttestationResponse, err error) {
+	t, err := transport.OpenTPM()
+	if err != nil {
+		return nil, err
+	}
+	defer t.Close() //nolint:errcheck
+
+	pcrSelector, err := CreateSelector(pcrs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PCR selection: %w", err)
+	}
+
+	primary := tpm2.CreatePrimary{
+		PrimaryHandle: tpm2.TPMRHEndorsement,
+		InPublic:      tpm2.New2B(AttestationKeyTemplate),
+	}
+
+	createPrimaryResponse, err := primary.Execute(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attestation key: %w", err)
+	}
+
+	defer func() {
+		flush := tpm2.FlushContext{
+			FlushHandle: createPrimaryResponse.ObjectHandle,
+		}
+
+		_, flushErr := flush.Execute(t)
+		if flushErr != nil {
+			err = flushErr
+		}
+	}()
+
+	outPub, err := createPrimaryResponse.OutPublic.Contents()
+	if err != nil {
+		return nil, err
+	}
+
+	point, err := outPub.Unique.ECC()
+	if err != nil {
+		return nil, err
+	}
+
+	attestationKey, err := x509.MarshalPKIXPublicKey(&ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(point.X.Buffer),
+		Y:     new(big.Int).SetBytes(point.Y.Buffer),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal attestation key: %w", err)
+	}
+
+	quote := tpm2.Quote{
+		SignHandle: tpm2.AuthHandle{
+			Handle: createPrimaryResponse.ObjectHandle,
+			Name:   createPrimaryResponse.Name,
+			Auth:   tpm2.PasswordAuth(nil),
+		},
+		QualifyingData: tpm2.TPM2BData{
+			Buffer: nonce,
+		},
+		InScheme: tpm2.TPMTSigScheme{
+			Scheme: tpm2.TPMAlgNull,
+		},
+		PCRSelect: tpm2.TPMLPCRSelection{
+			PCRSelections: []tpm2.TPMSPCRSelection{
+				{
+					Hash:      tpm2.TPMAlgSHA256,
+					PCRSelect: pcrSelector,
+				},
+			},
+		},
+	}
+
+	quoteResponse, err := quote.Execute(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate quote: %w", err)
+	}
+
+	resp = &AttestationResponse{
+		Quote:          quoteResponse.Quoted.Bytes(),
+		Signature:      tpm2.Marshal(quoteResponse.Signature),
+		AttestationKey: attestationKey,
+	}
+
+	// the quote lists PCRs in the ascending order
+	for i := range len(pcrSelector) * 8 {
+		if pcrSelector[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+
+		value, err := ReadPCR(t, i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read PCR %d: %w", i, err)
+		}
+
+		resp.PCRValues = append(resp.PCRValues, hex.EncodeToString(value))
+	}
+
+	return resp, nil
+}
+
+// Quote generates a verified TPM quote of the AttestationPCRs qualified with the nonce.
+//
+// PCRs might be extended while the quote is generated, so the quote is retried until it is consistent with the PCR values.
+func Quote(nonce []byte) (*runtime.AttestationStatusSpec, error) {
+	var lastErr error
+
+	for range attestationRetries {
+		resp, err := Attest(nonce, AttestationPCRs)
+		if err != nil {
+			return nil, err
+		}
+
+		status := &runtime.AttestationStatusSpec{
+			Nonce:          nonce,
+			Quote:          resp.Quote,
+			Signature:      resp.Signature,
+			AttestationKey: resp.AttestationKey,
+			PCRValues:      resp.PCRValues,
+		}
+
+		// the event log is read after the PCRs, so it might contain extra entries, but never misses the quoted ones
+		eventLog, err := os.ReadFile(constants.TPMEventLogPath)
+		if err == nil {
+			digest := sha256.Sum256(eventLog)
+
+			status.EventLogDigest = hex.EncodeToString(digest[:])
+		} else if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("error reading TPM event log: %w", err)
+		}
+
+		// PCRs are read after the quote, so they might have been extended in between
+		if _, lastErr = attestation.Verify(status); lastErr == nil {
+			return status, nil
+		}
+	}
+
+	return nil, fmt.Errorf("error verifying quote: %w", lastErr)
+}
@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package stagedconfig implements A/B machine config updates with the automatic rollback.
+//
+// When the machine config is persisted, the previous config is kept next to it.
+// Staged configs are additionally marked as pending: on the next boot into the staged config
+// the machine should become ready within the timeout, otherwise the previous config is restored.
+package stagedconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Store manages the current, previous and staged machine config files.
+type Store struct {
+	ConfigPath   string
+	PreviousPath string
+	MarkerPath   string
+}
+
+// Default returns the Store for the machine config on the STATE partition.
+func Default() Store {
+	return Store{
+		ConfigPath:   constants.ConfigPath,
+		PreviousPath: constants.ConfigPreviousPath,
+		MarkerPath:   constants.ConfigStagedMarkerPath,
+	}
+}
+
+// Save persists the new config keeping the current one as the previous config.
+//
+// If rollbackTimeout is not zero, the config is marked as staged: after the reboot it should be confirmed
+// within the timeout, otherwise it is rolled back. Otherwise any pending staged config mark is cleared.
+func (s Store) Save(cfg []byte, rollbackTimeout time.Duration) error {
+	current, err := os.ReadFile(s.ConfigPath)
+
+	switch {
+	case err == nil:
+		if err = os.WriteFile(s.PreviousPath, current, 0o600); err != nil {
+			return fmt.Errorf("error saving previous config: %w", err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("error reading current config: %w", err)
+	}
+
+	if err = os.WriteFile(s.ConfigPath, cfg, 0o600); err != nil {
+		return err
+	}
+
+	if rollbackTimeout == 0 {
+		return s.Commit()
+	}
+
+	return os.WriteFile(s.MarkerPath, []byte(rollbackTimeout.String()), 0o600)
+}
+
+// Pending returns the rollback timeout if the staged config is not confirmed yet.
+func (s Store) Pending() (time.Duration, bool, error) {
+	contents, err := os.ReadFile(s.MarkerPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, false, nil
+		}
+
+		return 0, false, err
+	}
+
+	timeout, err := time.ParseDuration(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return 0, false, fmt.Errorf("error parsing staged config marker: %w", err)
+	}
+
+	return timeout, true, nil
+}
+
+// Commit confirms the staged config.
+func (s Store) Commit() error {
+	if err := os.Remove(s.MarkerPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// Rollback restores the previous config, the current config becomes the previous one.
+func (s Store) Rollback() error {
+	previous, err := os.ReadFile(s.PreviousPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return errors.New("no previous config to roll back to")
+		}
+
+		return err
+	}
+
+	return s.Save(previous, 0)
+}
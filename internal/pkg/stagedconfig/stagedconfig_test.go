@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package stagedconfig_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/stagedconfig"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	store := stagedconfig.Store{
+		ConfigPath:   filepath.Join(dir, "config.yaml"),
+		PreviousPath: filepath.Join(dir, "config.yaml.prev"),
+		MarkerPath:   filepath.Join(dir, "config.yaml.staged"),
+	}
+
+	assertConfig := func(path, expected string) {
+		t.Helper()
+
+		contents, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, string(contents))
+	}
+
+	require.EqualError(t, store.Rollback(), "no previous config to roll back to")
+
+	require.NoError(t, store.Save([]byte("a"), 0))
+	assertConfig(store.ConfigPath, "a")
+
+	_, pending, err := store.Pending()
+	require.NoError(t, err)
+	assert.False(t, pending)
+
+	require.NoError(t, store.Save([]byte("b"), 5*time.Minute))
+	assertConfig(store.ConfigPath, "b")
+	assertConfig(store.PreviousPath, "a")
+
+	timeout, pending, err := store.Pending()
+	require.NoError(t, err)
+	assert.True(t, pending)
+	assert.Equal(t, 5*time.Minute, timeout)
+
+	require.NoError(t, store.Rollback())
+	assertConfig(store.ConfigPath, "a")
+	assertConfig(store.PreviousPath, "b")
+
+	_, pending, err = store.Pending()
+	require.NoError(t, err)
+	assert.False(t, pending)
+
+	require.NoError(t, store.Save([]byte("c"), time.Minute))
+	require.NoError(t, store.Commit())
+
+	_, pending, err = store.Pending()
+	require.NoError(t, err)
+	assert.False(t, pending)
+	assertConfig(store.ConfigPath, "c")
+}
@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package textfile implements parsing and merging of metrics in the Prometheus text format.
+//
+// The format is the same as the one used by the node_exporter textfile collector.
+package textfile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"google.golang.org/protobuf/proto"
+)
+
+// Parse the metrics in the Prometheus text format.
+//
+// Metric families are returned sorted by name.
+func Parse(r io.Reader) ([]*dto.MetricFamily, error) {
+	var parser expfmt.TextParser
+
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.SortedFunc(maps.Values(families), compareFamilies), nil
+}
+
+// Encode the metric families in the Prometheus text format.
+func Encode(w io.Writer, families []*dto.MetricFamily) error {
+	for _, family := range families {
+		if _, err := expfmt.MetricFamilyToText(w, family); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Normalize parses the metrics and encodes them back, returning the normalized text and family names.
+func Normalize(data []byte) (string, []string, error) {
+	families, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+
+	if err = Encode(&buf, families); err != nil {
+		return "", nil, err
+	}
+
+	names := make([]string, 0, len(families))
+
+	for _, family := range families {
+		names = append(names, family.GetName())
+	}
+
+	return buf.String(), names, nil
+}
+
+// Merge metric families from multiple sources.
+//
+// Each metric gets the label with the name of the source, so that the metrics with the same name
+// published by different sources don't collide.
+// Families with the same name but conflicting types are skipped, and the conflicts are returned as an error.
+func Merge(sources map[string][]*dto.MetricFamily, sourceLabel string) ([]*dto.MetricFamily, error) {
+	merged := map[string]*dto.MetricFamily{}
+
+	var errs error
+
+	for _, source := range slices.Sorted(maps.Keys(sources)) {
+		for _, family := range sources[source] {
+			existing, ok := merged[family.GetName()]
+			if !ok {
+				existing = &dto.MetricFamily{
+					Name: family.Name,
+					Help: family.Help,
+					Type: family.Type,
+				}
+
+				merged[family.GetName()] = existing
+			} else if existing.GetType() != family.GetType() {
+				errs = errors.Join(errs, fmt.Errorf("metric %q from %q has type %s, conflicting with type %s", family.GetName(), source, family.GetType(), existing.GetType()))
+
+				continue
+			}
+
+			for _, metric := range family.Metric {
+				metric = proto.Clone(metric).(*dto.Metric) //nolint:forcetypeassert,errcheck
+
+				metric.Label = slices.DeleteFunc(metric.Label, func(label *dto.LabelPair) bool {
+					return label.GetName() == sourceLabel
+				})
+
+				metric.Label = append(metric.Label, &dto.LabelPair{Name: proto.String(sourceLabel), Value: proto.String(source)})
+
+				slices.SortFunc(metric.Label, func(a, b *dto.LabelPair) int {
+					return strings.Compare(a.GetName(), b.GetName())
+				})
+
+				existing.Metric = append(existing.Metric, metric)
+			}
+		}
+	}
+
+	return slices.SortedFunc(maps.Values(merged), compareFamilies), errs
+}
+
+func compareFamilies(a, b *dto.MetricFamily) int {
+	return strings.Compare(a.GetName(), b.GetName())
+}
@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package textfile_test
+
+import (
+	"strings"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/textfile"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	text, names, err := textfile.Normalize([]byte(`zfs_pool_healthy{pool="tank"} 1
+# HELP zfs_arc_size ARC size.
+# TYPE zfs_arc_size gauge
+zfs_arc_size 1024
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"zfs_arc_size", "zfs_pool_healthy"}, names)
+	assert.Equal(t, `# HELP zfs_arc_size ARC size.
+# TYPE zfs_arc_size gauge
+zfs_arc_size 1024
+# TYPE zfs_pool_healthy untyped
+zfs_pool_healthy{pool="tank"} 1
+`, text)
+
+	_, _, err = textfile.Normalize([]byte("invalid metric\n"))
+	require.Error(t, err)
+}
+
+func parse(t *testing.T, text string) []*dto.MetricFamily {
+	t.Helper()
+
+	families, err := textfile.Parse(strings.NewReader(text))
+	require.NoError(t, err)
+
+	return families
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	merged, err := textfile.Merge(map[string][]*dto.MetricFamily{
+		"zfs": parse(t, `# TYPE up gauge
+up{extension="spoofed"} 1
+# TYPE zfs_arc_size gauge
+zfs_arc_size 1024
+`),
+		"nvidia": parse(t, `# TYPE up gauge
+up 0
+# TYPE zfs_arc_size counter
+zfs_arc_size 1
+`),
+	}, "extension")
+	require.EqualError(t, err, `metric "zfs_arc_size" from "zfs" has type GAUGE, conflicting with type COUNTER`)
+
+	var sb strings.Builder
+
+	require.NoError(t, textfile.Encode(&sb, merged))
+
+	assert.Equal(t, `# TYPE up gauge
+up{extension="nvidia"} 0
+up{extension="zfs"} 1
+# TYPE zfs_arc_size counter
+zfs_arc_size{extension="nvidia"} 1
+`, sb.String())
+}
@@ -46,6 +46,10 @@ type Item struct {
 	description string
 	dest        any
 	options     []any
+
+	validator       Validator
+	validationLabel *ValidationLabel
+	inputErr        error
 }
 
 // TableHeaders represents table headers list for item options which are using table representation.
@@ -66,11 +70,55 @@ func NewItem(name, description string, dest any, options ...any) *Item {
 	}
 }
 
+// SetValidator sets the validator which checks the item value on each change.
+func (item *Item) SetValidator(validator Validator) *Item {
+	item.validator = validator
+
+	return item
+}
+
+// Validate checks the current item value.
+func (item *Item) Validate() error {
+	if item.inputErr != nil {
+		return item.inputErr
+	}
+
+	if item.validator == nil {
+		return nil
+	}
+
+	v := reflect.ValueOf(item.dest)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.String {
+		return item.validator(v.String())
+	}
+
+	if list, ok := v.Interface().([]string); ok {
+		return item.validator(strings.Join(list, ","))
+	}
+
+	return item.validator(fmt.Sprint(v.Interface()))
+}
+
 func (item *Item) assign(value string) error {
 	// rely on yaml parser to decode value into the right type
 	return yaml.Unmarshal([]byte(value), item.dest)
 }
 
+// showValidation updates the inline validation error of the item.
+func (item *Item) showValidation() error {
+	err := item.Validate()
+
+	if item.validationLabel != nil {
+		item.validationLabel.SetError(err)
+	}
+
+	return err
+}
+
 // createFormItems dynamically creates tview.FormItem list based on the wrapped type.
 //
 //nolint:gocyclo,cyclop
@@ -102,6 +150,34 @@ func (item *Item) createFormItems() ([]tview.Primitive, error) {
 		checkbox.SetChecked(v.Bool())
 		checkbox.SetLabel(label)
 		formItem = checkbox
+	case reflect.Slice:
+		// lists of strings are edited as comma-separated values
+		if v.Type().Elem().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported list type %s", v.Type())
+		}
+
+		input := tview.NewInputField()
+		formItem = input
+
+		input.SetLabel(label)
+		input.SetText(strings.Join(v.Interface().([]string), ", ")) //nolint:forcetypeassert
+		input.SetChangedFunc(func(text string) {
+			var list []string
+
+			for _, element := range strings.Split(text, ",") {
+				if element = strings.TrimSpace(element); element != "" {
+					list = append(list, element)
+				}
+			}
+
+			v.Set(reflect.ValueOf(list))
+
+			item.showValidation() //nolint:errcheck
+		})
+
+		if item.validator != nil {
+			item.validationLabel = NewValidationLabel()
+		}
 	default:
 		if len(item.options) > 0 {
 			tableHeaders, ok := item.options[0].(TableHeaders)
@@ -182,16 +258,27 @@ func (item *Item) createFormItems() ([]tview.Primitive, error) {
 
 			input.SetText(string(text))
 			input.SetChangedFunc(func(text string) {
+				item.inputErr = nil
+
 				if err := item.assign(text); err != nil {
-					// TODO: highlight red
-					return
+					item.inputErr = fmt.Errorf("invalid value %q", strings.TrimSpace(text))
 				}
+
+				item.showValidation() //nolint:errcheck
 			})
+
+			if item.validator != nil {
+				item.validationLabel = NewValidationLabel()
+			}
 		}
 	}
 
 	res = append(res, formItem)
 
+	if item.validationLabel != nil {
+		res = append(res, item.validationLabel)
+	}
+
 	if item.description != "" && addDescription {
 		parts := strings.Split(item.description, "\n")
 		for _, part := range parts {
@@ -239,6 +326,7 @@ type Form struct {
 	form          *tview.Flex
 	buttons       *tview.Flex
 	formItems     []tview.FormItem
+	items         []*Item
 	maxLabelLen   int
 	hasMenuButton bool
 	group         *Group
@@ -286,6 +374,7 @@ func (f *Form) AddFormItem(item tview.Primitive) {
 
 	switch item.(type) {
 	case *FormLabel:
+	case *ValidationLabel:
 	case *Separator:
 	default:
 		f.group.AddElement(item)
@@ -330,11 +419,26 @@ func (f *Form) AddFormItems(items []*Item) error {
 		for _, formItem := range formItems {
 			f.AddFormItem(formItem)
 		}
+
+		f.items = append(f.items, item)
 	}
 
 	return nil
 }
 
+// Validate checks all form items, shows the inline validation errors and returns them.
+func (f *Form) Validate() []error {
+	var errs []error
+
+	for _, item := range f.items {
+		if err := item.showValidation(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", item.Name, err))
+		}
+	}
+
+	return errs
+}
+
 // Multiline interface represents elements that can occupy more than one line.
 type Multiline interface {
 	GetHeight() int
@@ -59,3 +59,38 @@ func (b *FormLabel) GetLabel() string {
 func (b *FormLabel) GetFieldHeight() int {
 	return 1
 }
+
+// NewValidationLabel creates a new ValidationLabel.
+func NewValidationLabel() *ValidationLabel {
+	return &ValidationLabel{
+		FormLabel: NewFormLabel(""),
+	}
+}
+
+// ValidationLabel shows the validation error of the form item.
+type ValidationLabel struct {
+	*FormLabel
+
+	labelWidth int
+}
+
+// SetFormAttributes sets form attributes.
+func (b *ValidationLabel) SetFormAttributes(labelWidth int, labelColor, bgColor, fieldTextColor, fieldBgColor tcell.Color) tview.FormItem {
+	b.FormLabel.SetFormAttributes(labelWidth, labelColor, bgColor, fieldTextColor, fieldBgColor)
+	b.SetTextColor(tcell.ColorRed)
+
+	b.labelWidth = labelWidth
+
+	return b
+}
+
+// SetError shows the validation error, nil error clears the label.
+func (b *ValidationLabel) SetError(err error) {
+	if err == nil {
+		b.SetText("")
+
+		return
+	}
+
+	b.SetText(strings.Repeat(" ", b.labelWidth) + err.Error())
+}
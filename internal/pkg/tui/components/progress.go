@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	progressFilled = "█"
+	progressEmpty  = "░"
+)
+
+// NewProgressBar creates a new progress bar.
+//
+// If app is not nil, the application is redrawn on each progress update.
+func NewProgressBar(label string, app *tview.Application) *ProgressBar {
+	return &ProgressBar{
+		Box:   tview.NewBox(),
+		label: label,
+		app:   app,
+	}
+}
+
+// ProgressBar is a determinate progress bar primitive.
+//
+// ProgressBar is safe to update from any goroutine.
+type ProgressBar struct {
+	*tview.Box
+	app   *tview.Application
+	label string
+
+	mu      sync.Mutex
+	current int64
+	total   int64
+}
+
+// SetProgress updates the progress, total is the value which corresponds to 100%.
+func (p *ProgressBar) SetProgress(current, total int64) {
+	p.mu.Lock()
+	p.current, p.total = current, total
+	p.mu.Unlock()
+
+	if p.app != nil {
+		p.app.Draw()
+	}
+}
+
+// Progress returns the completed fraction in the range [0, 1].
+func (p *ProgressBar) Progress() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return progressFraction(p.current, p.total)
+}
+
+// Draw draws this primitive onto the screen.
+func (p *ProgressBar) Draw(screen tcell.Screen) {
+	p.Box.Draw(screen)
+	x, y, width, _ := p.GetInnerRect()
+
+	fraction := p.Progress()
+	percent := fmt.Sprintf(" %3d%%", int(fraction*100))
+
+	line := p.label
+	if line != "" {
+		line += " "
+	}
+
+	barWidth := width - tview.TaggedStringWidth(line) - len(percent)
+
+	line += renderProgressBar(barWidth, fraction) + percent
+
+	tview.Print(screen, line, x, y, width, tview.AlignLeft, tcell.ColorWhite)
+}
+
+func progressFraction(current, total int64) float64 {
+	if total <= 0 || current <= 0 {
+		return 0
+	}
+
+	if current >= total {
+		return 1
+	}
+
+	return float64(current) / float64(total)
+}
+
+func renderProgressBar(width int, fraction float64) string {
+	if width <= 0 {
+		return ""
+	}
+
+	filled := int(fraction * float64(width))
+
+	return strings.Repeat(progressFilled, filled) + strings.Repeat(progressEmpty, width-filled)
+}
@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/tui/components"
+)
+
+// drawLines draws the primitive on the simulation screen and returns the screen contents line by line.
+func drawLines(t *testing.T, primitive tview.Primitive, width, height int) []string {
+	t.Helper()
+
+	screen := tcell.NewSimulationScreen("")
+	require.NoError(t, screen.Init())
+
+	t.Cleanup(screen.Fini)
+
+	screen.SetSize(width, height)
+
+	primitive.SetRect(0, 0, width, height)
+	primitive.Draw(screen)
+	screen.Show()
+
+	cells, _, _ := screen.GetContents()
+	lines := make([]string, height)
+
+	for y := range height {
+		var sb strings.Builder
+
+		for x := range width {
+			sb.WriteString(string(cells[y*width+x].Runes))
+		}
+
+		lines[y] = strings.TrimRight(sb.String(), " ")
+	}
+
+	return lines
+}
+
+func TestProgressBar(t *testing.T) {
+	t.Parallel()
+
+	bar := components.NewProgressBar("Pulling", nil)
+
+	assert.Equal(t, []string{"Pulling ░░░░░░░░░░   0%"}, drawLines(t, bar, 23, 1))
+
+	bar.SetProgress(50, 200)
+
+	assert.InDelta(t, 0.25, bar.Progress(), 0.001)
+	assert.Equal(t, []string{"Pulling ██░░░░░░░░  25%"}, drawLines(t, bar, 23, 1))
+
+	bar.SetProgress(300, 200)
+
+	assert.InDelta(t, 1, bar.Progress(), 0.001)
+	assert.Equal(t, []string{"Pulling ██████████ 100%"}, drawLines(t, bar, 23, 1))
+
+	bar.SetProgress(10, 0)
+
+	assert.Zero(t, bar.Progress())
+}
@@ -0,0 +1,228 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// TaskStatus is the status of the task in the TaskList.
+type TaskStatus int
+
+// Task statuses.
+const (
+	TaskPending TaskStatus = iota
+	TaskRunning
+	TaskDone
+	TaskFailed
+	TaskSkipped
+)
+
+var taskStatusSymbols = map[TaskStatus]string{
+	TaskPending: "[gray::]•[-::]",
+	TaskDone:    spinnerStatusSymbols[spinnerStateComplete],
+	TaskFailed:  spinnerStatusSymbols[spinnerStateFailed],
+	TaskSkipped: "[yellow::]-[-::]",
+}
+
+// NewTaskList creates a new task list.
+//
+// The running tasks are animated with the spinner until the list is stopped.
+// Stop should be always called to stop the list background routine.
+func NewTaskList(spinner []string, app *tview.Application) *TaskList {
+	l := &TaskList{
+		Box:      tview.NewBox(),
+		app:      app,
+		spinner:  spinner,
+		ticker:   time.NewTicker(time.Millisecond * 50),
+		shutdown: make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+
+	go func() {
+		defer func() {
+			app.Draw()
+			close(l.stopped)
+		}()
+
+		for {
+			select {
+			case <-l.shutdown:
+				return
+			case <-l.ticker.C:
+				if l.running() {
+					app.Draw()
+				}
+			}
+		}
+	}()
+
+	return l
+}
+
+// TaskList is a checklist of the steps of a long operation with their statuses.
+//
+// Tasks are safe to update from any goroutine.
+type TaskList struct {
+	*tview.Box
+	app      *tview.Application
+	spinner  []string
+	shutdown chan struct{}
+	stopped  chan struct{}
+	ticker   *time.Ticker
+	stopOnce sync.Once
+
+	mu    sync.Mutex
+	tasks []*Task
+	index int
+}
+
+// Task is a single step in the TaskList.
+type Task struct {
+	list    *TaskList
+	label   string
+	status  TaskStatus
+	details string
+}
+
+// AddTask appends a new pending task to the list.
+func (l *TaskList) AddTask(label string) *Task {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	task := &Task{
+		list:  l,
+		label: label,
+	}
+
+	l.tasks = append(l.tasks, task)
+
+	return task
+}
+
+// Len returns the number of tasks, which is the height of the list.
+func (l *TaskList) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.tasks)
+}
+
+// running returns true if any task is running, i.e. the spinner should be animated.
+func (l *TaskList) running() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return slices.ContainsFunc(l.tasks, func(task *Task) bool { return task.status == TaskRunning })
+}
+
+// Draw draws this primitive onto the screen.
+func (l *TaskList) Draw(screen tcell.Screen) {
+	l.Box.Draw(screen)
+	x, y, width, height := l.GetInnerRect()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, task := range l.tasks {
+		if i >= height {
+			break
+		}
+
+		tview.Print(screen, task.line(l.spinner[l.index]), x, y+i, width, tview.AlignLeft, tcell.ColorWhite)
+	}
+
+	l.index++
+
+	if l.index == len(l.spinner) {
+		l.index = 0
+	}
+}
+
+// Stop should be always called to stop the task list background routine.
+func (l *TaskList) Stop() <-chan struct{} {
+	l.stopOnce.Do(func() {
+		l.ticker.Stop()
+		close(l.shutdown)
+	})
+
+	return l.stopped
+}
+
+// Start marks the task as running.
+func (t *Task) Start() {
+	t.update(TaskRunning, "")
+}
+
+// Done marks the task as completed.
+func (t *Task) Done() {
+	t.update(TaskDone, "")
+}
+
+// Fail marks the task as failed.
+func (t *Task) Fail(err error) {
+	var details string
+
+	if err != nil {
+		details = err.Error()
+	}
+
+	t.update(TaskFailed, details)
+}
+
+// Skip marks the task as skipped.
+func (t *Task) Skip(reason string) {
+	t.update(TaskSkipped, reason)
+}
+
+// Finish marks the task as completed or failed depending on the error.
+func (t *Task) Finish(err error) {
+	if err != nil {
+		t.Fail(err)
+
+		return
+	}
+
+	t.Done()
+}
+
+// Status returns the task status.
+func (t *Task) Status() TaskStatus {
+	t.list.mu.Lock()
+	defer t.list.mu.Unlock()
+
+	return t.status
+}
+
+func (t *Task) update(status TaskStatus, details string) {
+	t.list.mu.Lock()
+	t.status = status
+	t.details = details
+	t.list.mu.Unlock()
+
+	t.list.app.Draw()
+}
+
+// line renders the task, should be called with the list mutex held.
+func (t *Task) line(spinner string) string {
+	symbol := spinner
+
+	if t.status != TaskRunning {
+		symbol = taskStatusSymbols[t.status]
+	}
+
+	line := symbol + " " + t.label
+
+	if t.details != "" {
+		line += " [gray::](" + tview.Escape(t.details) + ")[-::]"
+	}
+
+	return line
+}
@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/tui/components"
+)
+
+func TestTaskList(t *testing.T) {
+	t.Parallel()
+
+	// the application only processes the redraw requests, the list is drawn on the separate screen below
+	app := tview.NewApplication().SetScreen(tcell.NewSimulationScreen(""))
+
+	appErr := make(chan error, 1)
+
+	go func() {
+		appErr <- app.Run()
+	}()
+
+	list := components.NewTaskList([]string{"|", "/"}, app)
+
+	generate := list.AddTask("Generating configuration")
+	apply := list.AddTask("Applying configuration")
+	reboot := list.AddTask("Rebooting")
+	cleanup := list.AddTask("Cleaning up")
+
+	assert.Equal(t, 4, list.Len())
+
+	generate.Start()
+	assert.Equal(t, components.TaskRunning, generate.Status())
+
+	assert.Equal(t, []string{
+		"| Generating configuration",
+		"• Applying configuration",
+		"• Rebooting",
+		"• Cleaning up",
+	}, drawLines(t, list, 40, 4))
+
+	generate.Finish(nil)
+	apply.Finish(errors.New("connection refused"))
+	reboot.Skip("dry run")
+
+	assert.Equal(t, components.TaskDone, generate.Status())
+	assert.Equal(t, components.TaskFailed, apply.Status())
+	assert.Equal(t, components.TaskSkipped, reboot.Status())
+	assert.Equal(t, components.TaskPending, cleanup.Status())
+
+	assert.Equal(t, []string{
+		"✓ Generating configuration",
+		"✖ Applying configuration (connection refused)",
+		"- Rebooting (dry run)",
+		"• Cleaning up",
+	}, drawLines(t, list, 60, 4))
+
+	<-list.Stop()
+	<-list.Stop()
+
+	app.Stop()
+	require.NoError(t, <-appErr)
+}
@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Validator checks the form item value.
+type Validator func(value string) error
+
+// Optional allows the value to be empty, otherwise the value is checked with the validator.
+func Optional(validator Validator) Validator {
+	return func(value string) error {
+		if strings.TrimSpace(value) == "" {
+			return nil
+		}
+
+		return validator(value)
+	}
+}
+
+// CommaSeparated checks each element of the comma-separated list with the validator.
+func CommaSeparated(validator Validator) Validator {
+	return func(value string) error {
+		for _, element := range strings.Split(value, ",") {
+			if element = strings.TrimSpace(element); element == "" {
+				continue
+			}
+
+			if err := validator(element); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// ValidateNotEmpty checks that the value is set.
+func ValidateNotEmpty(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return errors.New("value is required")
+	}
+
+	return nil
+}
+
+// ValidateIP checks that the value is an IP address.
+func ValidateIP(value string) error {
+	if _, err := netip.ParseAddr(strings.TrimSpace(value)); err != nil {
+		return fmt.Errorf("invalid IP address %q", value)
+	}
+
+	return nil
+}
+
+// ValidateCIDR checks that the value is an address in CIDR notation.
+func ValidateCIDR(value string) error {
+	if _, err := netip.ParsePrefix(strings.TrimSpace(value)); err != nil {
+		return fmt.Errorf("invalid CIDR %q, expected address/prefix length", value)
+	}
+
+	return nil
+}
+
+var hostnameLabelRe = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// ValidateHostname checks that the value is a valid (RFC 1123) hostname or domain name.
+func ValidateHostname(value string) error {
+	value = strings.TrimSpace(value)
+
+	if value == "" || len(value) > 253 {
+		return fmt.Errorf("invalid hostname %q, expected up to 253 characters", value)
+	}
+
+	for _, label := range strings.Split(value, ".") {
+		if len(label) > 63 || !hostnameLabelRe.MatchString(label) {
+			return fmt.Errorf("invalid hostname %q, expected lowercase alphanumeric characters, '-' or '.'", value)
+		}
+	}
+
+	return nil
+}
+
+// ValidateHost checks that the value is either an IP address or a hostname.
+func ValidateHost(value string) error {
+	if ValidateIP(value) == nil {
+		return nil
+	}
+
+	if ValidateHostname(value) != nil {
+		return fmt.Errorf("invalid host %q, expected IP address or DNS name", value)
+	}
+
+	return nil
+}
+
+// ValidateURL checks that the value is an absolute http(s) URL.
+func ValidateURL(value string) error {
+	u, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL %q, expected https://host[:port]", value)
+	}
+
+	return nil
+}
+
+// IntRange checks that the value is an integer in the [minValue, maxValue] range.
+func IntRange(minValue, maxValue int) Validator {
+	return func(value string) error {
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < minValue || n > maxValue {
+			return fmt.Errorf("invalid value %q, expected integer in range %d-%d", value, minValue, maxValue)
+		}
+
+		return nil
+	}
+}
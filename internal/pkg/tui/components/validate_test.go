@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/internal/pkg/tui/components"
+)
+
+func TestValidators(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name      string
+		validator components.Validator
+		valid     []string
+		invalid   []string
+	}{
+		{
+			name:      "ip",
+			validator: components.ValidateIP,
+			valid:     []string{"10.5.0.1", "2001:db8::1"},
+			invalid:   []string{"", "10.5.0", "10.5.0.1/24"},
+		},
+		{
+			name:      "cidr",
+			validator: components.ValidateCIDR,
+			valid:     []string{"10.5.0.2/24", "2001:db8::2/64"},
+			invalid:   []string{"", "10.5.0.2", "10.5.0.2/33"},
+		},
+		{
+			name:      "hostname",
+			validator: components.ValidateHostname,
+			valid:     []string{"talos-1", "cluster.local", "node1.example.com"},
+			invalid:   []string{"", "-talos", "talos_1", "Talos", "node..example"},
+		},
+		{
+			name:      "host",
+			validator: components.ValidateHost,
+			valid:     []string{"10.5.0.2", "2001:db8::2", "cp.example.com"},
+			invalid:   []string{"", "10.5.0.2:6443", "https://cp.example.com"},
+		},
+		{
+			name:      "url",
+			validator: components.ValidateURL,
+			valid:     []string{"https://10.5.0.2:6443", "https://cp.example.com"},
+			invalid:   []string{"", "10.5.0.2:6443", "ftp://cp.example.com", "https://"},
+		},
+		{
+			name:      "int range",
+			validator: components.IntRange(0, 65535),
+			valid:     []string{"0", "1500", "65535"},
+			invalid:   []string{"", "-1", "65536", "abc"},
+		},
+		{
+			name:      "optional",
+			validator: components.Optional(components.ValidateCIDR),
+			valid:     []string{"", " ", "10.5.0.2/24"},
+			invalid:   []string{"10.5.0.2"},
+		},
+		{
+			name:      "comma separated",
+			validator: components.CommaSeparated(components.ValidateIP),
+			valid:     []string{"", "1.1.1.1", "1.1.1.1, 8.8.8.8,"},
+			invalid:   []string{"1.1.1.1,dns.example.com"},
+		},
+		{
+			name:      "not empty",
+			validator: components.ValidateNotEmpty,
+			valid:     []string{"talos-default"},
+			invalid:   []string{"", "  "},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, value := range test.valid {
+				assert.NoError(t, test.validator(value), "value %q", value)
+			}
+
+			for _, value := range test.invalid {
+				assert.Error(t, test.validator(value), "value %q", value)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package doctor
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+)
+
+// Status of the check.
+type Status int
+
+// Status values.
+const (
+	StatusOK Status = iota
+	StatusWarning
+	StatusFailed
+	StatusSkipped
+)
+
+// String implements fmt.Stringer.
+func (s Status) String() string {
+	switch s {
+	case StatusOK:
+		return "OK"
+	case StatusWarning:
+		return "WARNING"
+	case StatusFailed:
+		return "FAILED"
+	case StatusSkipped:
+		return "SKIPPED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Knowledge base links.
+const (
+	kbTroubleshooting       = "https://www.talos.dev/latest/introduction/troubleshooting/"
+	kbFirewall              = kbTroubleshooting + "#firewall-issues"
+	kbClientConfiguration   = kbTroubleshooting + "#client-configuration-issues"
+	kbWrongEndpoints        = kbTroubleshooting + "#wrong-endpoints"
+	kbEtcd                  = kbTroubleshooting + "#etcd-issues"
+	kbEtcdAlarm             = kbTroubleshooting + "#etcd-reports-and-alarm"
+	kbCertificateManagement = "https://www.talos.dev/latest/talos-guides/howto/cert-management/"
+)
+
+// certificateExpiryWarning is the remaining lifetime of the client certificate which triggers a warning.
+const certificateExpiryWarning = 7 * 24 * time.Hour
+
+// Finding is the result of a check.
+type Finding struct {
+	Status     Status
+	Summary    string
+	Suggestion string
+	KB         string
+}
+
+// Check is a single troubleshooting step.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context, c *client.Client) Finding
+}
+
+// DefaultChecks returns the checks in the order they are run.
+//
+// Each check is run against a single node, the node is set in the context.
+func DefaultChecks() []Check {
+	return []Check{
+		{
+			Name: "Client certificate",
+			Run: func(_ context.Context, c *client.Client) Finding {
+				return CheckClientCertificate(c.GetConfigContext(), time.Now())
+			},
+		},
+		{
+			Name: "Node reachable",
+			Run:  checkReachable,
+		},
+		{
+			Name: "etcd health",
+			Run:  checkEtcd,
+		},
+	}
+}
+
+// CheckClientCertificate checks the client certificate in the talosconfig context.
+func CheckClientCertificate(configContext *clientconfig.Context, now time.Time) Finding {
+	if configContext == nil {
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    "talosconfig context is not found",
+			Suggestion: "Check the talosconfig with `talosctl config info`, or select the context with `talosctl config context`.",
+			KB:         kbClientConfiguration,
+		}
+	}
+
+	if configContext.Crt == "" {
+		return Finding{
+			Status:  StatusSkipped,
+			Summary: "talosconfig context has no client certificate",
+		}
+	}
+
+	cert, err := parseCertificate(configContext.Crt)
+	if err != nil {
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    fmt.Sprintf("failed to parse the client certificate: %s", err),
+			Suggestion: "Regenerate the talosconfig with `talosctl config new` using a working talosconfig, or from the secrets bundle with `talosctl gen config`.",
+			KB:         kbClientConfiguration,
+		}
+	}
+
+	switch {
+	case now.After(cert.NotAfter):
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    fmt.Sprintf("client certificate expired at %s", cert.NotAfter.Format(time.RFC3339)),
+			Suggestion: "Generate a new talosconfig from the secrets bundle with `talosctl gen config --with-secrets`, or with `talosctl config new` using a working talosconfig.",
+			KB:         kbCertificateManagement,
+		}
+	case now.Before(cert.NotBefore):
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    fmt.Sprintf("client certificate is not valid until %s", cert.NotBefore.Format(time.RFC3339)),
+			Suggestion: "Check the clock on the local machine and on the node.",
+			KB:         kbCertificateManagement,
+		}
+	case cert.NotAfter.Sub(now) < certificateExpiryWarning:
+		return Finding{
+			Status:     StatusWarning,
+			Summary:    fmt.Sprintf("client certificate expires at %s", cert.NotAfter.Format(time.RFC3339)),
+			Suggestion: "Generate a new talosconfig with `talosctl config new`.",
+			KB:         kbCertificateManagement,
+		}
+	}
+
+	return Finding{
+		Status:  StatusOK,
+		Summary: fmt.Sprintf("client certificate is valid until %s", cert.NotAfter.Format(time.RFC3339)),
+	}
+}
+
+func parseCertificate(encoded string) (*x509.Certificate, error) {
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(decoded)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func checkReachable(ctx context.Context, c *client.Client) Finding {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := c.Version(ctx)
+	if err != nil {
+		return DiagnoseError(err)
+	}
+
+	finding := Finding{
+		Status:  StatusOK,
+		Summary: "node is reachable",
+	}
+
+	for _, msg := range resp.Messages {
+		finding.Summary = fmt.Sprintf("node is running Talos %s", msg.GetVersion().GetTag())
+	}
+
+	return finding
+}
+
+// DiagnoseError converts the API call error into a finding with a suggested fix.
+func DiagnoseError(err error) Finding {
+	msg := err.Error()
+
+	if clockSkew := client.ClockSkew(err); clockSkew != nil {
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    fmt.Sprintf("clock skew detected (server clock is %s ahead): %s", clockSkew.Skew(time.Now()).Round(time.Second), clockSkew),
+			Suggestion: "Synchronize the clock on the local machine and on the node (check the time servers), or regenerate the client certificate.",
+			KB:         kbCertificateManagement,
+		}
+	}
+
+	switch {
+	case strings.Contains(msg, "certificate has expired") || strings.Contains(msg, "certificate is not yet valid"):
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    fmt.Sprintf("certificate is not valid: %s", msg),
+			Suggestion: "Check the clock on the local machine and on the node, and the expiration of the client and server certificates.",
+			KB:         kbCertificateManagement,
+		}
+	case strings.Contains(msg, "x509:") || strings.Contains(msg, "tls:") || strings.Contains(msg, "authentication handshake failed"):
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    fmt.Sprintf("TLS handshake failed: %s", msg),
+			Suggestion: "Make sure the talosconfig belongs to this cluster (the CA matches) and the endpoints point to the nodes of this cluster.",
+			KB:         kbClientConfiguration,
+		}
+	}
+
+	switch client.ReasonOf(err) { //nolint:exhaustive
+	case client.ReasonUnauthorized:
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    fmt.Sprintf("access denied: %s", msg),
+			Suggestion: "The client certificate role doesn't allow this call, use a talosconfig with the `os:admin` role.",
+			KB:         kbClientConfiguration,
+		}
+	case client.ReasonUnsupportedVersion:
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    fmt.Sprintf("API is not supported: %s", msg),
+			Suggestion: "Use the talosctl version matching the Talos version of the node.",
+		}
+	case client.ReasonNodeUnreachable:
+		return Finding{
+			Status:  StatusFailed,
+			Summary: fmt.Sprintf("node is unreachable: %s", msg),
+			Suggestion: "Check that the endpoints and nodes in the talosconfig are correct, the node is up (check the console dashboard), " +
+				"and the Talos API port 50000 is not blocked by a firewall.",
+			KB: kbWrongEndpoints,
+		}
+	}
+
+	return Finding{
+		Status:     StatusFailed,
+		Summary:    msg,
+		Suggestion: "Check the node connectivity to the endpoints and the Talos API port 50000.",
+		KB:         kbFirewall,
+	}
+}
+
+func checkEtcd(ctx context.Context, c *client.Client) Finding {
+	services, err := c.ServiceInfo(ctx, "etcd")
+	if err != nil {
+		return DiagnoseError(err)
+	}
+
+	if len(services) == 0 {
+		return Finding{
+			Status:  StatusSkipped,
+			Summary: "etcd is not running on the node (worker node)",
+		}
+	}
+
+	return DiagnoseEtcdService(services[0].Service, func() ([]*machine.EtcdMemberAlarm, error) {
+		resp, err := c.EtcdAlarmList(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var memberAlarms []*machine.EtcdMemberAlarm
+
+		for _, msg := range resp.Messages {
+			memberAlarms = append(memberAlarms, msg.GetMemberAlarms()...)
+		}
+
+		return memberAlarms, nil
+	})
+}
+
+// DiagnoseEtcdService converts the etcd service state and alarms into a finding.
+func DiagnoseEtcdService(svc *machine.ServiceInfo, alarms func() ([]*machine.EtcdMemberAlarm, error)) Finding {
+	switch {
+	case svc.GetState() == "Preparing" || svc.GetState() == "Waiting":
+		return Finding{
+			Status:  StatusFailed,
+			Summary: fmt.Sprintf("etcd is in %s state", svc.GetState()),
+			Suggestion: "If the cluster was never bootstrapped, run `talosctl bootstrap` on a single control plane node. " +
+				"Otherwise check the discovery and the connectivity between control plane nodes with `talosctl logs etcd`.",
+			KB: kbEtcd,
+		}
+	case svc.GetState() != "Running":
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    fmt.Sprintf("etcd is in %s state", svc.GetState()),
+			Suggestion: "Check the etcd logs with `talosctl logs etcd` and the service events with `talosctl service etcd`.",
+			KB:         kbEtcd,
+		}
+	case !svc.GetHealth().GetHealthy():
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    fmt.Sprintf("etcd is not healthy: %s", svc.GetHealth().GetLastMessage()),
+			Suggestion: "Check the etcd logs with `talosctl logs etcd` and the etcd members with `talosctl etcd members`.",
+			KB:         kbEtcd,
+		}
+	}
+
+	memberAlarms, err := alarms()
+	if err != nil {
+		return DiagnoseError(err)
+	}
+
+	var active []string
+
+	for _, alarm := range memberAlarms {
+		if alarm.GetAlarm() != machine.EtcdMemberAlarm_NONE {
+			active = append(active, fmt.Sprintf("%s (member %x)", alarm.GetAlarm(), alarm.GetMemberId()))
+		}
+	}
+
+	if len(active) > 0 {
+		return Finding{
+			Status:     StatusFailed,
+			Summary:    fmt.Sprintf("etcd reports alarms: %s", strings.Join(active, ", ")),
+			Suggestion: "For NOSPACE alarm, defragment etcd with `talosctl etcd defrag` and disarm the alarm with `talosctl etcd alarm disarm`.",
+			KB:         kbEtcdAlarm,
+		}
+	}
+
+	return Finding{
+		Status:  StatusOK,
+		Summary: "etcd is running and healthy",
+	}
+}
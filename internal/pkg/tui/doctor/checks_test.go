@@ -0,0 +1,188 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package doctor_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/tui/doctor"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+)
+
+func TestCheckClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := notBefore.Add(365 * 24 * time.Hour)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	configContext := &clientconfig.Context{
+		Crt: base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+
+	for _, test := range []struct {
+		name           string
+		configContext  *clientconfig.Context
+		now            time.Time
+		expectedStatus doctor.Status
+	}{
+		{
+			name:           "valid",
+			configContext:  configContext,
+			now:            notBefore.Add(time.Hour),
+			expectedStatus: doctor.StatusOK,
+		},
+		{
+			name:           "expiring",
+			configContext:  configContext,
+			now:            notAfter.Add(-time.Hour),
+			expectedStatus: doctor.StatusWarning,
+		},
+		{
+			name:           "expired",
+			configContext:  configContext,
+			now:            notAfter.Add(time.Hour),
+			expectedStatus: doctor.StatusFailed,
+		},
+		{
+			name:           "not yet valid",
+			configContext:  configContext,
+			now:            notBefore.Add(-time.Hour),
+			expectedStatus: doctor.StatusFailed,
+		},
+		{
+			name:           "no context",
+			expectedStatus: doctor.StatusFailed,
+		},
+		{
+			name:           "no certificate",
+			configContext:  &clientconfig.Context{},
+			expectedStatus: doctor.StatusSkipped,
+		},
+		{
+			name:           "invalid certificate",
+			configContext:  &clientconfig.Context{Crt: "Zm9v"},
+			expectedStatus: doctor.StatusFailed,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expectedStatus, doctor.CheckClientCertificate(test.configContext, test.now).Status)
+		})
+	}
+}
+
+func TestDiagnoseError(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name       string
+		err        error
+		expectedKB string
+	}{
+		{
+			name:       "expired",
+			err:        status.Error(codes.Unavailable, "connection error: desc = \"transport: authentication handshake failed: tls: failed to verify certificate: x509: certificate has expired or is not yet valid\""),
+			expectedKB: "https://www.talos.dev/latest/talos-guides/howto/cert-management/",
+		},
+		{
+			name:       "unknown authority",
+			err:        status.Error(codes.Unavailable, "connection error: desc = \"transport: authentication handshake failed: tls: failed to verify certificate: x509: certificate signed by unknown authority\""),
+			expectedKB: "https://www.talos.dev/latest/introduction/troubleshooting/#client-configuration-issues",
+		},
+		{
+			name: "unreachable",
+			err: &client.APIError{
+				Err:    status.Error(codes.Unavailable, "connection error: desc = \"transport: Error while dialing: dial tcp 10.5.0.2:50000: connect: connection refused\""),
+				Reason: client.ReasonNodeUnreachable,
+			},
+			expectedKB: "https://www.talos.dev/latest/introduction/troubleshooting/#wrong-endpoints",
+		},
+		{
+			name: "denied",
+			err: &client.APIError{
+				Err:    status.Error(codes.PermissionDenied, "not authorized"),
+				Reason: client.ReasonUnauthorized,
+			},
+			expectedKB: "https://www.talos.dev/latest/introduction/troubleshooting/#client-configuration-issues",
+		},
+		{
+			name:       "other",
+			err:        errors.New("something went wrong"),
+			expectedKB: "https://www.talos.dev/latest/introduction/troubleshooting/#firewall-issues",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			finding := doctor.DiagnoseError(test.err)
+
+			assert.Equal(t, doctor.StatusFailed, finding.Status)
+			assert.Equal(t, test.expectedKB, finding.KB)
+			assert.NotEmpty(t, finding.Suggestion)
+		})
+	}
+}
+
+func TestDiagnoseEtcdService(t *testing.T) {
+	t.Parallel()
+
+	noAlarms := func() ([]*machine.EtcdMemberAlarm, error) {
+		return []*machine.EtcdMemberAlarm{{MemberId: 1, Alarm: machine.EtcdMemberAlarm_NONE}}, nil
+	}
+
+	healthy := &machine.ServiceInfo{
+		Id:     "etcd",
+		State:  "Running",
+		Health: &machine.ServiceHealth{Healthy: true},
+	}
+
+	assert.Equal(t, doctor.StatusOK, doctor.DiagnoseEtcdService(healthy, noAlarms).Status)
+
+	finding := doctor.DiagnoseEtcdService(healthy, func() ([]*machine.EtcdMemberAlarm, error) {
+		return []*machine.EtcdMemberAlarm{{MemberId: 0xabc, Alarm: machine.EtcdMemberAlarm_NOSPACE}}, nil
+	})
+	assert.Equal(t, doctor.StatusFailed, finding.Status)
+	assert.Equal(t, "etcd reports alarms: NOSPACE (member abc)", finding.Summary)
+
+	finding = doctor.DiagnoseEtcdService(&machine.ServiceInfo{Id: "etcd", State: "Preparing"}, noAlarms)
+	assert.Equal(t, doctor.StatusFailed, finding.Status)
+	assert.Contains(t, finding.Suggestion, "talosctl bootstrap")
+
+	finding = doctor.DiagnoseEtcdService(&machine.ServiceInfo{
+		Id:     "etcd",
+		State:  "Running",
+		Health: &machine.ServiceHealth{LastMessage: "context deadline exceeded"},
+	}, noAlarms)
+	assert.Equal(t, doctor.StatusFailed, finding.Status)
+	assert.Equal(t, "etcd is not healthy: context deadline exceeded", finding.Summary)
+}
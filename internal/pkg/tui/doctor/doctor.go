@@ -0,0 +1,239 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package doctor contains terminal UI based troubleshooting wizard.
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/siderolabs/talos/internal/pkg/tui/components"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+const (
+	color        = tcell.Color238
+	frameBGColor = tcell.Color235
+)
+
+var spinner = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+var statusColors = map[Status]string{
+	StatusOK:      "green",
+	StatusWarning: "yellow",
+	StatusFailed:  "red",
+	StatusSkipped: "gray",
+}
+
+// ErrChecksFailed is returned when some of the checks failed.
+var ErrChecksFailed = errors.New("some checks failed")
+
+// Doctor is the interactive troubleshooting wizard.
+//
+// Doctor runs the checks against each node in order, stopping at the first failed check for the node,
+// as the following checks depend on the previous ones (e.g. etcd can't be checked if the node is unreachable).
+type Doctor struct {
+	app    *tview.Application
+	client *client.Client
+	nodes  []string
+	checks []Check
+
+	list    *tview.Flex
+	details *tview.TextView
+}
+
+// New creates a new troubleshooting wizard.
+//
+// If nodes are empty, the checks are run against the endpoints.
+func New(c *client.Client, nodes []string, checks []Check) *Doctor {
+	if len(nodes) == 0 {
+		nodes = []string{""}
+	}
+
+	return &Doctor{
+		client: c,
+		nodes:  nodes,
+		checks: checks,
+	}
+}
+
+// Run the wizard until all checks are done and the user exits.
+func (d *Doctor) Run(ctx context.Context) error {
+	d.app = tview.NewApplication()
+
+	d.list = tview.NewFlex().SetDirection(tview.FlexRow)
+	d.list.SetBackgroundColor(color)
+
+	d.details = tview.NewTextView().SetDynamicColors(true).SetWordWrap(true)
+	d.details.SetBackgroundColor(color)
+	d.details.SetChangedFunc(func() { d.details.ScrollToEnd() })
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(d.list, len(d.nodes)*(len(d.checks)+1), 0, false).
+		AddItem(d.details, 0, 1, true)
+
+	page := tview.NewFrame(content).SetBorders(1, 1, 1, 1, 2, 2)
+	page.SetBackgroundColor(color)
+
+	frame := tview.NewFrame(page).SetBorders(1, 1, 1, 1, 2, 2).
+		AddText("Talos Doctor", true, tview.AlignCenter, tcell.ColorWhite).
+		AddText(version.Tag, true, tview.AlignRight, tcell.ColorIvory).
+		AddText("<UP>/<DOWN> to scroll, <Q> to quit", false, tview.AlignLeft, tcell.ColorIvory)
+	frame.SetBackgroundColor(frameBGColor)
+
+	eg, ctx := errgroup.WithContext(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+
+	d.app.SetInputCapture(func(e *tcell.EventKey) *tcell.EventKey {
+		if e.Key() == tcell.KeyEscape || e.Key() == tcell.KeyCtrlC || e.Rune() == 'q' || e.Rune() == 'Q' {
+			cancel()
+
+			return nil
+		}
+
+		return e
+	})
+
+	var failed bool
+
+	eg.Go(func() error {
+		defer cancel()
+
+		return d.app.SetRoot(frame, true).EnableMouse(true).Run()
+	})
+
+	eg.Go(func() error {
+		defer d.app.Stop()
+
+		<-ctx.Done()
+
+		return nil
+	})
+
+	eg.Go(func() error {
+		for _, node := range d.nodes {
+			if ctx.Err() != nil {
+				return nil //nolint:nilerr
+			}
+
+			if !d.runNode(ctx, node) {
+				failed = true
+			}
+		}
+
+		d.print(ctx, "", "Press [::b]Q[::-] to exit.")
+
+		return nil
+	})
+
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	if failed {
+		return ErrChecksFailed
+	}
+
+	return nil
+}
+
+func (d *Doctor) runNode(ctx context.Context, node string) bool {
+	title := "Node [green::]" + node + "[-::]"
+	nodeCtx := ctx
+
+	if node == "" {
+		title = "Endpoints"
+	} else {
+		nodeCtx = client.WithNode(ctx, node)
+	}
+
+	d.update(ctx, func() {
+		header := tview.NewTextView().SetDynamicColors(true).SetText("[::b]" + title + "[::-]")
+		header.SetBackgroundColor(color)
+
+		d.list.AddItem(header, 1, 0, false)
+	})
+
+	d.print(ctx, "[::b]"+title+"[::-]", "")
+
+	ok := true
+
+	for _, check := range d.checks {
+		s := components.NewSpinner("  "+check.Name, spinner, d.app)
+		s.SetBackgroundColor(color)
+
+		d.update(ctx, func() {
+			d.list.AddItem(s, 1, 0, false)
+		})
+
+		finding := Finding{
+			Status:  StatusSkipped,
+			Summary: "skipped, as the previous check failed",
+		}
+
+		if ok {
+			finding = check.Run(nodeCtx, d.client)
+		}
+
+		select {
+		case <-s.Stop(finding.Status != StatusFailed):
+		case <-ctx.Done():
+			return ok
+		}
+
+		d.printFinding(ctx, check.Name, finding)
+
+		if finding.Status == StatusFailed {
+			ok = false
+		}
+	}
+
+	return ok
+}
+
+func (d *Doctor) printFinding(ctx context.Context, name string, finding Finding) {
+	lines := []string{
+		fmt.Sprintf("  [%s::]%s[-::] %s: %s", statusColors[finding.Status], finding.Status, name, tview.Escape(finding.Summary)),
+	}
+
+	if finding.Suggestion != "" {
+		lines = append(lines, "    Suggestion: "+tview.Escape(finding.Suggestion))
+	}
+
+	if finding.KB != "" {
+		lines = append(lines, "    See: [::u]"+finding.KB+"[::-]")
+	}
+
+	d.print(ctx, lines...)
+}
+
+func (d *Doctor) print(ctx context.Context, lines ...string) {
+	d.update(ctx, func() {
+		for _, line := range lines {
+			fmt.Fprintln(d.details, line)
+		}
+	})
+}
+
+// update runs f in the application event loop, unless the application is stopped.
+func (d *Doctor) update(ctx context.Context, f func()) {
+	done := make(chan struct{})
+
+	go d.app.QueueUpdateDraw(func() {
+		f()
+		close(done)
+	})
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
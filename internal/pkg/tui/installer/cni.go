@@ -0,0 +1,321 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package installer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// CNIPreset describes a CNI which can be selected in the installer.
+//
+// Manifest URLs are Go templates which can reference the selected version as {{ .Version }}
+// and the preset values as {{ .Values.key }}.
+type CNIPreset struct {
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description"`
+	Versions    []string          `yaml:"versions,omitempty"`
+	URLs        []string          `yaml:"urls,omitempty"`
+	Values      map[string]string `yaml:"values,omitempty"`
+}
+
+// DefaultVersion returns the version used when no version is selected.
+func (preset *CNIPreset) DefaultVersion() string {
+	if len(preset.Versions) == 0 {
+		return ""
+	}
+
+	return preset.Versions[0]
+}
+
+// Validate checks the preset definition.
+func (preset *CNIPreset) Validate() error {
+	if preset.Name == "" {
+		return errors.New("preset name is required")
+	}
+
+	if preset.Name == constants.CustomCNI {
+		return fmt.Errorf("preset name %q is reserved", preset.Name)
+	}
+
+	if preset.Name == constants.FlannelCNI || preset.Name == constants.NoneCNI {
+		if len(preset.URLs) > 0 {
+			return fmt.Errorf("preset %q can't have manifest URLs", preset.Name)
+		}
+
+		return nil
+	}
+
+	if len(preset.URLs) == 0 {
+		return fmt.Errorf("preset %q should have at least one manifest URL", preset.Name)
+	}
+
+	_, err := preset.Render("", nil)
+
+	return err
+}
+
+// ValidateVersion checks that the version is supported by the preset, empty version means the default one.
+func (preset *CNIPreset) ValidateVersion(version string) error {
+	version = strings.TrimSpace(version)
+
+	if version == "" || slices.Contains(preset.Versions, version) {
+		return nil
+	}
+
+	if len(preset.Versions) == 0 {
+		return fmt.Errorf("CNI %s doesn't support version selection", preset.Name)
+	}
+
+	return fmt.Errorf("unsupported CNI %s version %q, expected one of %s", preset.Name, version, strings.Join(preset.Versions, ", "))
+}
+
+// Render returns the CNI config for the version and the value overrides.
+func (preset *CNIPreset) Render(version string, overrides map[string]string) (*machineapi.CNIConfig, error) {
+	if err := preset.ValidateVersion(version); err != nil {
+		return nil, err
+	}
+
+	if len(preset.URLs) == 0 {
+		return &machineapi.CNIConfig{
+			Name: preset.Name,
+		}, nil
+	}
+
+	if version = strings.TrimSpace(version); version == "" {
+		version = preset.DefaultVersion()
+	}
+
+	values := maps.Clone(preset.Values)
+	if values == nil {
+		values = map[string]string{}
+	}
+
+	for key, value := range overrides {
+		if _, ok := values[key]; !ok {
+			return nil, fmt.Errorf("unknown CNI %s value %q", preset.Name, key)
+		}
+
+		values[key] = value
+	}
+
+	data := struct {
+		Version string
+		Values  map[string]string
+	}{
+		Version: version,
+		Values:  values,
+	}
+
+	urls := make([]string, 0, len(preset.URLs))
+
+	for _, url := range preset.URLs {
+		tmpl, err := template.New("url").Option("missingkey=error").Parse(url)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing CNI %s URL template: %w", preset.Name, err)
+		}
+
+		var buf bytes.Buffer
+
+		if err = tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("error rendering CNI %s URL template: %w", preset.Name, err)
+		}
+
+		urls = append(urls, buf.String())
+	}
+
+	return &machineapi.CNIConfig{
+		Name: constants.CustomCNI,
+		Urls: urls,
+	}, nil
+}
+
+// DefaultCNIPresets is the list of the CNI presets available in the installer by default.
+var DefaultCNIPresets = []CNIPreset{
+	{
+		Name:        constants.FlannelCNI,
+		Description: "CNI used by Talos by default",
+	},
+	{
+		Name:        "calico",
+		Description: "Calico with the default manifest",
+		Versions:    []string{"v3.28.2", "v3.27.4"},
+		URLs: []string{
+			"https://raw.githubusercontent.com/projectcalico/calico/{{ .Version }}/manifests/calico.yaml",
+		},
+	},
+	{
+		Name:        "cilium",
+		Description: "Cilium quick install manifest",
+		Versions:    []string{"v1.8"},
+		URLs: []string{
+			"https://raw.githubusercontent.com/cilium/cilium/{{ .Version }}/install/kubernetes/quick-install.yaml",
+		},
+	},
+	{
+		Name:        "flannel-custom",
+		Description: "Upstream Flannel manifest, managed outside of Talos",
+		Versions:    []string{"v0.25.7", "v0.24.4"},
+		URLs: []string{
+			"https://github.com/flannel-io/flannel/releases/download/{{ .Version }}/kube-flannel.yml",
+		},
+	},
+	{
+		Name:        constants.NoneCNI,
+		Description: "CNI will not be installed",
+	},
+}
+
+// CNIPresetCatalog is the document format of the user-provided CNI presets.
+type CNIPresetCatalog struct {
+	Presets []CNIPreset `yaml:"presets"`
+}
+
+// cniPresetsMaxSize limits the size of the presets document.
+const cniPresetsMaxSize = 1024 * 1024
+
+// LoadCNIPresets reads the CNI presets document from the file or http(s) URL.
+func LoadCNIPresets(ctx context.Context, source string) ([]CNIPreset, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		data, err = downloadCNIPresets(ctx, source)
+	} else {
+		data, err = os.ReadFile(source)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading CNI presets from %q: %w", source, err)
+	}
+
+	return parseCNIPresets(data)
+}
+
+func downloadCNIPresets(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, cniPresetsMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > cniPresetsMaxSize {
+		return nil, errors.New("presets document is too large")
+	}
+
+	return data, nil
+}
+
+func parseCNIPresets(data []byte) ([]CNIPreset, error) {
+	var catalog CNIPresetCatalog
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&catalog); err != nil {
+		return nil, fmt.Errorf("error decoding CNI presets: %w", err)
+	}
+
+	for i := range catalog.Presets {
+		if err := catalog.Presets[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return catalog.Presets, nil
+}
+
+// mergeCNIPresets adds the presets to the list, presets with the same name are replaced.
+func mergeCNIPresets(presets, extra []CNIPreset) []CNIPreset {
+	result := slices.Clone(presets)
+
+	for _, preset := range extra {
+		if idx := slices.IndexFunc(result, func(p CNIPreset) bool { return p.Name == preset.Name }); idx != -1 {
+			result[idx] = preset
+
+			continue
+		}
+
+		result = append(result, preset)
+	}
+
+	return result
+}
+
+// matchCNIPreset finds the preset and the version which produce the CNI config with the default values.
+func matchCNIPreset(presets []CNIPreset, name string, urls []string) (*CNIPreset, string, bool) {
+	for i := range presets {
+		preset := &presets[i]
+
+		versions := preset.Versions
+		if len(versions) == 0 {
+			versions = []string{""}
+		}
+
+		for _, version := range versions {
+			cniConfig, err := preset.Render(version, nil)
+			if err != nil {
+				continue
+			}
+
+			if cniConfig.Name == name && slices.Equal(cniConfig.Urls, urls) {
+				return preset, version, true
+			}
+		}
+	}
+
+	return nil, "", false
+}
+
+// parseCNIValues parses the comma-separated list of key=value overrides.
+func parseCNIValues(value string) (map[string]string, error) {
+	var values map[string]string
+
+	for _, pair := range splitKeyValues(value) {
+		key, val, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid value %q, expected key=value", pair)
+		}
+
+		if values == nil {
+			values = map[string]string{}
+		}
+
+		values[strings.TrimSpace(key)] = strings.TrimSpace(val)
+	}
+
+	return values, nil
+}
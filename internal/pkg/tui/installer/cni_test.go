@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package installer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/tui/installer"
+)
+
+func TestCNIPresetRender(t *testing.T) {
+	t.Parallel()
+
+	preset := installer.CNIPreset{
+		Name:     "example",
+		Versions: []string{"v2", "v1"},
+		URLs: []string{
+			"https://example.com/{{ .Version }}/{{ .Values.mode }}.yaml",
+		},
+		Values: map[string]string{
+			"mode": "default",
+		},
+	}
+
+	require.NoError(t, preset.Validate())
+
+	cniConfig, err := preset.Render("", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "custom", cniConfig.Name)
+	assert.Equal(t, []string{"https://example.com/v2/default.yaml"}, cniConfig.Urls)
+
+	cniConfig, err = preset.Render("v1", map[string]string{"mode": "ebpf"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"https://example.com/v1/ebpf.yaml"}, cniConfig.Urls)
+
+	_, err = preset.Render("v3", nil)
+	assert.EqualError(t, err, `unsupported CNI example version "v3", expected one of v2, v1`)
+
+	_, err = preset.Render("", map[string]string{"unknown": "value"})
+	assert.EqualError(t, err, `unknown CNI example value "unknown"`)
+
+	for _, preset := range installer.DefaultCNIPresets {
+		assert.NoError(t, preset.Validate(), preset.Name)
+	}
+}
+
+func TestLoadCNIPresets(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "presets.yaml")
+
+	require.NoError(t, os.WriteFile(path, []byte(`presets:
+  - name: cilium
+    description: Cilium rendered with Helm
+    versions:
+      - 1.16.3
+    urls:
+      - https://example.com/cilium-{{ .Version }}.yaml
+`), 0o644))
+
+	presets, err := installer.LoadCNIPresets(context.Background(), path)
+	require.NoError(t, err)
+
+	require.Len(t, presets, 1)
+	assert.Equal(t, "cilium", presets[0].Name)
+	assert.Equal(t, "1.16.3", presets[0].DefaultVersion())
+
+	require.NoError(t, os.WriteFile(path, []byte(`presets:
+  - name: broken
+`), 0o644))
+
+	_, err = installer.LoadCNIPresets(context.Background(), path)
+	assert.EqualError(t, err, `preset "broken" should have at least one manifest URL`)
+}
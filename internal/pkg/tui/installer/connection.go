@@ -6,16 +6,22 @@ package installer
 
 import (
 	"context"
+	"fmt"
 	"net"
 
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"gopkg.in/yaml.v3"
 
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/api/storage"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -28,6 +34,9 @@ type Connection struct {
 	nodeCtx           context.Context //nolint:containedctx
 	bootstrapCtx      context.Context //nolint:containedctx
 	dryRun            bool
+	editing           bool
+	editConfig        []byte
+	cniPresets        []CNIPreset
 }
 
 // NewConnection creates new installer connection.
@@ -39,6 +48,7 @@ func NewConnection(ctx context.Context, nodeClient *client.Client, endpoint stri
 		nodeEndpoint: endpoint,
 		nodeClient:   nodeClient,
 		nodeCtx:      ctx,
+		cniPresets:   DefaultCNIPresets,
 	}
 
 	for _, opt := range options {
@@ -127,6 +137,131 @@ func (c *Connection) Links() ([]Link, error) {
 	return links, nil
 }
 
+// Partition a subset of fields from DiscoveredVolume resource.
+type Partition struct {
+	DevPath    string
+	Disk       string
+	Label      string
+	Filesystem string
+	Size       string
+}
+
+// Partitions gets a list of existing partitions on the target node disks.
+func (c *Connection) Partitions() ([]Partition, error) {
+	ctx := c.nodeCtx
+
+	md, _ := metadata.FromOutgoingContext(c.nodeCtx)
+	if nodes := md["nodes"]; len(nodes) > 0 {
+		ctx = client.WithNode(ctx, nodes[0])
+	}
+
+	items, err := safe.StateListAll[*block.DiscoveredVolume](ctx, c.nodeClient.COSI)
+	if err != nil {
+		return nil, err
+	}
+
+	it := items.Iterator()
+
+	var partitions []Partition
+
+	for it.Next() {
+		spec := it.Value().TypedSpec()
+
+		if spec.Type != "partition" {
+			continue
+		}
+
+		partitions = append(partitions, Partition{
+			DevPath:    spec.DevPath,
+			Disk:       spec.ParentDevPath,
+			Label:      spec.PartitionLabel,
+			Filesystem: spec.Name,
+			Size:       spec.PrettySize,
+		})
+	}
+
+	return partitions, nil
+}
+
+// Member a subset of fields from cluster Member resource.
+type Member struct {
+	Hostname  string
+	Role      string
+	Version   string
+	Addresses []string
+}
+
+// Members gets a list of cluster members as seen by the bootstrap node.
+func (c *Connection) Members() ([]Member, error) {
+	ctx := c.bootstrapCtx
+
+	md, _ := metadata.FromOutgoingContext(c.bootstrapCtx)
+	if nodes := md["nodes"]; len(nodes) > 0 {
+		ctx = client.WithNode(ctx, nodes[0])
+	}
+
+	items, err := safe.StateListAll[*cluster.Member](ctx, c.bootstrapClient.COSI)
+	if err != nil {
+		return nil, err
+	}
+
+	it := items.Iterator()
+
+	var members []Member
+
+	for it.Next() {
+		spec := it.Value().TypedSpec()
+
+		addresses := make([]string, 0, len(spec.Addresses))
+
+		for _, addr := range spec.Addresses {
+			addresses = append(addresses, addr.String())
+		}
+
+		members = append(members, Member{
+			Hostname:  spec.Hostname,
+			Role:      spec.MachineType.String(),
+			Version:   spec.OperatingSystem,
+			Addresses: addresses,
+		})
+	}
+
+	return members, nil
+}
+
+// Editing checks if the installer edits the existing machine config instead of generating a new one.
+func (c *Connection) Editing() bool {
+	return c.editing
+}
+
+// ExistingConfig returns the machine config to edit.
+//
+// If the config wasn't loaded from a file, the current machine config of the target node is fetched.
+func (c *Connection) ExistingConfig() ([]byte, error) {
+	if len(c.editConfig) > 0 {
+		return c.editConfig, nil
+	}
+
+	ctx := c.nodeCtx
+
+	md, _ := metadata.FromOutgoingContext(c.nodeCtx)
+	if nodes := md["nodes"]; len(nodes) > 0 {
+		ctx = client.WithNode(ctx, nodes[0])
+	}
+
+	mc, err := c.nodeClient.COSI.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching current machine config: %w", err)
+	}
+
+	return yaml.Marshal(mc.Spec())
+}
+
+// CNIPresets returns the list of the CNI presets available in the installer.
+func (c *Connection) CNIPresets() []CNIPreset {
+	return c.cniPresets
+}
+
 // ExpandingCluster check if bootstrap node is set.
 func (c *Connection) ExpandingCluster() bool {
 	return c.bootstrapClient != nil
@@ -146,6 +281,18 @@ func WithBootstrapNode(ctx context.Context, bootstrapClient *client.Client, boot
 	}
 }
 
+// WithEditConfig makes the installer edit the existing machine config.
+//
+// If data is empty, the current machine config of the node is edited, the edited config is applied to the node.
+func WithEditConfig(data []byte) Option {
+	return func(c *Connection) error {
+		c.editing = true
+		c.editConfig = data
+
+		return nil
+	}
+}
+
 // WithDryRun enables dry run mode in the installer.
 func WithDryRun(dryRun bool) Option {
 	return func(c *Connection) error {
@@ -154,3 +301,12 @@ func WithDryRun(dryRun bool) Option {
 		return nil
 	}
 }
+
+// WithCNIPresets adds the CNI presets to the default ones, presets with the same name replace the default ones.
+func WithCNIPresets(presets []CNIPreset) Option {
+	return func(c *Connection) error {
+		c.cniPresets = mergeCNIPresets(c.cniPresets, presets)
+
+		return nil
+	}
+}
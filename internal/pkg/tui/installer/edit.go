@@ -0,0 +1,304 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package installer
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-pointer"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/internal/pkg/configuration"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// editedConfig keeps the machine config being edited and the form values it was loaded into.
+//
+// The sections of the config which can't be represented in the form without losing details (network interfaces, disks)
+// are only replaced if the form values were changed.
+type editedConfig struct {
+	cfg config.Provider
+
+	networkConfig *machineapi.NetworkConfig
+	dataDisks     string
+	cniConfig     *machineapi.CNIConfig
+}
+
+// Editing checks if the existing machine config is edited.
+func (s *State) Editing() bool {
+	return s.edited != nil
+}
+
+// loadConfig populates the form values from the existing machine config.
+//
+//nolint:gocyclo,cyclop
+func (s *State) loadConfig(data []byte) error {
+	cfg, err := configloader.NewFromBytes(data)
+	if err != nil {
+		return fmt.Errorf("error loading machine config: %w", err)
+	}
+
+	raw := cfg.RawV1Alpha1()
+	if raw == nil || raw.MachineConfig == nil {
+		return errors.New("only the v1alpha1 machine config can be edited")
+	}
+
+	opts := s.opts
+
+	opts.MachineConfig.Type = machineapi.MachineConfig_MachineType(cfg.Machine().Type())
+
+	if network := raw.MachineConfig.MachineNetwork; network != nil {
+		opts.MachineConfig.NetworkConfig.Hostname = network.NetworkHostname
+		s.nameservers = strings.Join(network.NameServers, ",")
+
+		for _, device := range network.NetworkInterfaces {
+			if device.DeviceBond != nil && s.bondName == "" {
+				s.bondName = device.DeviceInterface
+				s.bondMembers = strings.Join(device.DeviceBond.BondInterfaces, ",")
+				s.bondMode = device.DeviceBond.BondMode
+				s.bondHashPolicy = device.DeviceBond.BondHashPolicy
+				s.bondCIDR = deviceCIDR(device.DeviceCIDR, device.DeviceAddresses)
+
+				continue
+			}
+
+			opts.MachineConfig.NetworkConfig.Interfaces = append(opts.MachineConfig.NetworkConfig.Interfaces, networkDeviceToAPI(device))
+
+			s.adapterExtras[device.DeviceInterface] = &adapterExtras{
+				routes: strings.Join(xslices.Map(device.DeviceRoutes, func(route *v1alpha1.Route) string {
+					return route.RouteNetwork + "=" + route.RouteGateway
+				}), ","),
+				vlans: strings.Join(xslices.Map(device.DeviceVlans, func(vlan *v1alpha1.Vlan) string {
+					if cidr := deviceCIDR(vlan.VlanCIDR, vlan.VlanAddresses); cidr != "" {
+						return strconv.Itoa(int(vlan.VlanID)) + "=" + cidr
+					}
+
+					return strconv.Itoa(int(vlan.VlanID))
+				}), ","),
+			}
+		}
+	}
+
+	if install := raw.MachineConfig.MachineInstall; install != nil {
+		opts.MachineConfig.InstallConfig.InstallDisk = install.InstallDisk
+		opts.MachineConfig.InstallConfig.InstallImage = install.InstallImage
+		opts.MachineConfig.InstallConfig.Wipe = pointer.SafeDeref(install.InstallWipe)
+	}
+
+	var dataDisks []string
+
+	for _, disk := range raw.MachineConfig.MachineDisks {
+		for _, partition := range disk.DiskPartitions {
+			dataDisks = append(dataDisks, disk.DeviceName+":"+partition.DiskMountPoint)
+		}
+	}
+
+	s.dataDisks = strings.Join(dataDisks, ",")
+
+	if cluster := raw.ClusterConfig; cluster != nil {
+		opts.ClusterConfig.Name = cluster.ClusterName
+		opts.ClusterConfig.AllowSchedulingOnControlPlanes = pointer.SafeDeref(cluster.AllowSchedulingOnControlPlanes)
+
+		if cluster.ControlPlane != nil && cluster.ControlPlane.Endpoint != nil && cluster.ControlPlane.Endpoint.URL != nil {
+			opts.ClusterConfig.ControlPlane.Endpoint = cluster.ControlPlane.Endpoint.String()
+		}
+
+		if cluster.ClusterNetwork != nil {
+			opts.ClusterConfig.ClusterNetwork.DnsDomain = cluster.ClusterNetwork.DNSDomain
+
+			if cni := cluster.ClusterNetwork.CNI; cni != nil {
+				s.loadCNI(cni.CNIName, cni.CNIUrls)
+			}
+		}
+	}
+
+	if err = s.updateNetworkConfig(); err != nil {
+		return err
+	}
+
+	cniConfig, err := s.cniConfig()
+	if err != nil {
+		return err
+	}
+
+	s.edited = &editedConfig{
+		cfg:           cfg,
+		networkConfig: proto.Clone(opts.MachineConfig.NetworkConfig).(*machineapi.NetworkConfig), //nolint:forcetypeassert
+		dataDisks:     s.dataDisks,
+		cniConfig:     cniConfig,
+	}
+
+	return nil
+}
+
+// EditedConfig returns the existing machine config updated with the form values encoded in yaml.
+//
+//nolint:gocyclo
+func (s *State) EditedConfig() ([]byte, error) {
+	if err := s.updateNetworkConfig(); err != nil {
+		return nil, err
+	}
+
+	dataDisks, err := parseDataDisks(s.dataDisks)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := s.opts
+
+	var endpoint *url.URL
+
+	if opts.ClusterConfig.ControlPlane.Endpoint != "" {
+		endpoint, err = url.Parse(opts.ClusterConfig.ControlPlane.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid control plane endpoint: %w", err)
+		}
+	}
+
+	networkChanged := !proto.Equal(s.edited.networkConfig, opts.MachineConfig.NetworkConfig)
+
+	cniConfig, err := s.cniConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := s.edited.cfg.PatchV1Alpha1(func(cfg *v1alpha1.Config) error {
+		machineConfig := cfg.MachineConfig
+
+		if machineConfig.MachineNetwork == nil {
+			machineConfig.MachineNetwork = &v1alpha1.NetworkConfig{}
+		}
+
+		machineConfig.MachineNetwork.NetworkHostname = opts.MachineConfig.NetworkConfig.Hostname
+
+		if networkChanged {
+			machineConfig.MachineNetwork.NameServers = opts.MachineConfig.NetworkConfig.Nameservers
+			machineConfig.MachineNetwork.NetworkInterfaces = xslices.Map(opts.MachineConfig.NetworkConfig.Interfaces, configuration.NetworkDeviceFromAPI)
+		}
+
+		if machineConfig.MachineInstall == nil {
+			machineConfig.MachineInstall = &v1alpha1.InstallConfig{}
+		}
+
+		machineConfig.MachineInstall.InstallDisk = opts.MachineConfig.InstallConfig.InstallDisk
+		machineConfig.MachineInstall.InstallImage = opts.MachineConfig.InstallConfig.InstallImage
+
+		if s.dataDisks != s.edited.dataDisks {
+			machineConfig.MachineDisks = xslices.Map(dataDisks, func(disk *machineapi.DataDiskConfig) *v1alpha1.MachineDisk {
+				return &v1alpha1.MachineDisk{
+					DeviceName: disk.Device,
+					DiskPartitions: []*v1alpha1.DiskPartition{
+						{
+							DiskMountPoint: disk.MountPoint,
+						},
+					},
+				}
+			})
+		}
+
+		clusterConfig := cfg.ClusterConfig
+		if clusterConfig == nil {
+			return nil
+		}
+
+		clusterConfig.ClusterName = opts.ClusterConfig.Name
+		clusterConfig.AllowSchedulingOnControlPlanes = pointer.To(opts.ClusterConfig.AllowSchedulingOnControlPlanes)
+
+		if endpoint != nil {
+			if clusterConfig.ControlPlane == nil {
+				clusterConfig.ControlPlane = &v1alpha1.ControlPlaneConfig{}
+			}
+
+			clusterConfig.ControlPlane.Endpoint = &v1alpha1.Endpoint{URL: endpoint}
+		}
+
+		if clusterConfig.ClusterNetwork == nil {
+			clusterConfig.ClusterNetwork = &v1alpha1.ClusterNetworkConfig{}
+		}
+
+		clusterConfig.ClusterNetwork.DNSDomain = opts.ClusterConfig.ClusterNetwork.DnsDomain
+
+		if !proto.Equal(cniConfig, s.edited.cniConfig) {
+			clusterConfig.ClusterNetwork.CNI = &v1alpha1.CNIConfig{
+				CNIName: cniConfig.Name,
+				CNIUrls: cniConfig.Urls,
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg.Bytes()
+}
+
+// networkDeviceToAPI converts the network device to the GenerateConfiguration API representation.
+//
+// Routes and VLANs are kept as the raw adapter input, see adapterExtras.
+func networkDeviceToAPI(device *v1alpha1.Device) *machineapi.NetworkDeviceConfig {
+	cidr := deviceCIDR(device.DeviceCIDR, device.DeviceAddresses)
+
+	result := &machineapi.NetworkDeviceConfig{
+		Interface:   device.DeviceInterface,
+		Cidr:        cidr,
+		Mtu:         int32(device.DeviceMTU),
+		Dhcp:        pointer.SafeDeref(device.DeviceDHCP),
+		Ignore:      pointer.SafeDeref(device.DeviceIgnore),
+		DhcpOptions: &machineapi.DHCPOptionsConfig{},
+	}
+
+	if device.DeviceDHCPOptions != nil {
+		result.DhcpOptions.RouteMetric = device.DeviceDHCPOptions.DHCPRouteMetric
+	}
+
+	return result
+}
+
+// deviceCIDR returns the (first) address of the device.
+func deviceCIDR(cidr string, addresses []string) string {
+	if cidr != "" {
+		return cidr
+	}
+
+	if len(addresses) > 0 {
+		return addresses[0]
+	}
+
+	return ""
+}
+
+// loadCNI selects the CNI preset which matches the existing CNI config.
+//
+// Custom CNI manifests which don't match any preset are kept as is.
+func (s *State) loadCNI(name string, urls []string) {
+	if name == "" {
+		name = constants.FlannelCNI
+	}
+
+	if preset, version, ok := matchCNIPreset(s.cniPresets, name, urls); ok {
+		s.cni = preset.Name
+		s.cniVersion = version
+
+		return
+	}
+
+	s.cniPresets = append(s.cniPresets, CNIPreset{
+		Name:        constants.CustomCNI,
+		Description: "CNI manifests of the existing machine config",
+		URLs:        urls,
+	})
+	s.cni = constants.CustomCNI
+}
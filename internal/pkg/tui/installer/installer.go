@@ -7,9 +7,9 @@ package installer
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -144,8 +144,13 @@ func (installer *Installer) Run(conn *Connection) error {
 }
 
 func (installer *Installer) init(conn *Connection) (err error) {
+	service := "the maintenance service"
+	if conn.Editing() {
+		service = "the node"
+	}
+
 	s := components.NewSpinner(
-		fmt.Sprintf("Connecting to the maintenance service at [green::]%s[white::]", conn.nodeEndpoint),
+		fmt.Sprintf("Connecting to %s at [green::]%s[white::]", service, conn.nodeEndpoint),
 		spinner,
 		installer.app,
 	)
@@ -180,6 +185,7 @@ func (installer *Installer) configure() error {
 	var menuButtons []*components.MenuButton
 
 	done := make(chan struct{})
+	closeDone := sync.OnceFunc(func() { close(done) })
 	state := installer.state
 
 	setPage := func(index int) {
@@ -282,11 +288,18 @@ func (installer *Installer) configure() error {
 					},
 				)
 			} else {
-				install := form.AddMenuButton("Install", false)
+				label := "Install"
+				if state.Editing() {
+					label = "Apply"
+				}
+
+				install := form.AddMenuButton(label, false)
 				install.SetBackgroundColor(tcell.ColorGreen)
 				install.SetSelectedFunc(
 					func() {
-						close(done)
+						installer.preflight(forms, closeDone, func() {
+							setPage(currentPage)
+						})
 					},
 				)
 			}
@@ -325,27 +338,37 @@ func (installer *Installer) apply(conn *Connection) error {
 
 	list := tview.NewFlex().SetDirection(tview.FlexRow)
 	list.SetBackgroundColor(color)
+
+	if installer.state.Editing() {
+		installer.addPage("Updating Configuration", list, true, nil)
+
+		return installer.applyEdited(conn, list)
+	}
+
 	installer.addPage("Installing Talos", list, true, nil)
 
-	{
-		s := components.NewSpinner(
-			"Generating configuration...",
-			spinner,
-			installer.app,
-		)
-		s.SetBackgroundColor(color)
+	tasks := components.NewTaskList(spinner, installer.app)
+	tasks.SetBackgroundColor(color)
+
+	defer tasks.Stop()
+
+	generateTask := tasks.AddTask("Generating configuration")
+	applyTask := tasks.AddTask("Applying configuration")
+
+	list.AddItem(tasks, tasks.Len(), 1, false)
 
-		list.AddItem(s, 1, 1, false)
+	{
+		generateTask.Start()
 
 		response, err = installer.state.GenConfig()
 
-		s.Stop(err == nil)
+		generateTask.Finish(err)
 
 		if err != nil {
 			return err
 		}
 
-		config = response.Messages[0].Data[0]
+		config = installer.state.NodeConfig(response)
 
 		talosconfig, err = clientconfig.FromBytes(response.Messages[0].Talosconfig)
 		if err != nil {
@@ -354,18 +377,10 @@ func (installer *Installer) apply(conn *Connection) error {
 	}
 
 	{
-		s := components.NewSpinner(
-			"Applying configuration...",
-			spinner,
-			installer.app,
-		)
-		s.SetBackgroundColor(color)
+		applyTask.Start()
 
 		var reply *machineapi.ApplyConfigurationResponse
 
-		// TODO: progress bar, logs?
-		list.AddItem(s, 1, 1, false)
-
 		reply, err = conn.ApplyConfiguration(
 			&machineapi.ApplyConfigurationRequest{
 				Data:   config,
@@ -374,48 +389,88 @@ func (installer *Installer) apply(conn *Connection) error {
 		)
 
 		if conn.dryRun {
-			err = errors.New("skipped in dry run")
+			applyTask.Skip("dry run")
+
+			installer.showApplyDetails(list, reply)
+
+			return nil
 		}
 
-		s.Stop(err == nil)
+		applyTask.Finish(err)
 
-		if conn.dryRun {
-			text := tview.NewTextView()
-			addLines := func(lines ...string) {
-				t := text.GetText(false)
-				t += strings.Join(lines, "\n")
-				text.SetText(t)
-				installer.app.Draw()
-			}
+		if err != nil {
+			return err
+		}
+	}
 
-			for _, m := range reply.Messages {
-				addLines("", m.ModeDetails)
-			}
+	return installer.writeTalosconfig(list, talosconfig)
+}
 
-			addLines(
-				"",
-				"Press any key to exit.",
-			)
+// applyEdited applies the edited existing machine config to the node.
+//
+// Unlike the installation, no new talosconfig is generated, as the config secrets stay the same.
+func (installer *Installer) applyEdited(conn *Connection, list *tview.Flex) error {
+	tasks := components.NewTaskList(spinner, installer.app)
+	tasks.SetBackgroundColor(color)
 
-			text.SetBackgroundColor(color)
-			list.AddItem(text, 0, 1, false)
-			installer.app.Draw()
+	defer tasks.Stop()
 
-			installer.awaitKey()
+	applyTask := tasks.AddTask("Applying configuration")
 
-			return nil
-		}
+	list.AddItem(tasks, tasks.Len(), 1, false)
 
-		if err != nil {
-			return err
-		}
+	applyTask.Start()
+
+	config, err := installer.state.EditedConfig()
+	if err != nil {
+		applyTask.Fail(err)
+
+		return err
 	}
 
+	reply, err := conn.ApplyConfiguration(
+		&machineapi.ApplyConfigurationRequest{
+			Data:   config,
+			Mode:   machineapi.ApplyConfigurationRequest_AUTO,
+			DryRun: conn.dryRun,
+		},
+	)
+
+	applyTask.Finish(err)
+
 	if err != nil {
 		return err
 	}
 
-	return installer.writeTalosconfig(list, talosconfig)
+	installer.showApplyDetails(list, reply)
+
+	return nil
+}
+
+// showApplyDetails shows how the config was applied, and waits for the user to exit.
+func (installer *Installer) showApplyDetails(list *tview.Flex, reply *machineapi.ApplyConfigurationResponse) {
+	text := tview.NewTextView()
+	addLines := func(lines ...string) {
+		t := text.GetText(false)
+		t += strings.Join(lines, "\n")
+		text.SetText(t)
+		installer.app.Draw()
+	}
+
+	for _, m := range reply.GetMessages() {
+		addLines("", m.ModeDetails)
+	}
+
+	addLines(
+		"",
+		"Press any key to exit.",
+	)
+
+	text.SetBackgroundColor(color)
+	list.AddItem(text, 0, 1, false)
+	installer.app.Draw()
+
+	installer.awaitKey()
 }
 
 func (installer *Installer) writeTalosconfig(list *tview.Flex, talosconfig *clientconfig.Config) error {
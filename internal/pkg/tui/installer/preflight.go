@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package installer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/tui/components"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
+// preflight validates the form values and shows the summary of the configuration before it is applied.
+//
+// The configuration can be applied only if there are no validation errors, otherwise the user should go back and fix them.
+func (installer *Installer) preflight(forms []*components.Form, proceed, back func()) {
+	state := installer.state
+
+	var issues []string
+
+	for i, form := range forms {
+		for _, err := range form.Validate() {
+			issues = append(issues, fmt.Sprintf("%s: %s", state.pages[i].name, err))
+		}
+	}
+
+	issues = append(issues, state.preflightIssues()...)
+
+	form := components.NewForm(installer.app)
+	form.SetBackgroundColor(color)
+
+	items := []*components.Item{
+		components.NewSeparator("Configuration summary:"),
+		components.NewItem("", "", func(*components.Item) tview.Primitive {
+			table := components.NewTable()
+			table.SetHeader("SETTING", "VALUE")
+
+			for _, row := range state.summary() {
+				table.AddRow(row[0], row[1])
+			}
+
+			return table
+		}),
+	}
+
+	if len(issues) > 0 {
+		items = append(items, components.NewSeparator("Please go back and fix the following issues:"))
+	}
+
+	if err := form.AddFormItems(items); err != nil {
+		panic(err)
+	}
+
+	for _, issue := range issues {
+		form.AddFormItem(components.NewFormLabel("✗ " + issue))
+	}
+
+	if state.conn.ExpandingCluster() {
+		label := components.NewFormLabel("Checking the control plane endpoint...")
+		form.AddFormItem(label)
+
+		endpoint := state.opts.ClusterConfig.ControlPlane.Endpoint
+
+		go func() {
+			text := " ✓ The control plane endpoint is reachable."
+
+			if err := checkEndpoint(installer.ctx, endpoint); err != nil {
+				text = fmt.Sprintf(" ! The control plane endpoint is not reachable from this machine: %s", err)
+			}
+
+			installer.app.QueueUpdateDraw(func() {
+				label.SetText(text)
+			})
+		}()
+	}
+
+	form.AddMenuButton("[::u]B[::-]ack", false).SetSelectedFunc(back)
+
+	if len(issues) == 0 {
+		label := "Install"
+		if state.Editing() {
+			label = "Apply"
+		}
+
+		form.AddMenuButton(label, true).SetSelectedFunc(proceed)
+	}
+
+	installer.addPage("Preflight Check", form, true, nil)
+	installer.app.SetFocus(form)
+}
+
+// preflightIssues returns the issues which can't be detected by the validation of the single form item.
+func (s *State) preflightIssues() []string {
+	var issues []string
+
+	for _, device := range s.opts.MachineConfig.NetworkConfig.Interfaces {
+		if device.Bond == nil && !device.Dhcp && !device.Ignore && device.Cidr == "" {
+			issues = append(issues, fmt.Sprintf("Network Config: interface %s should either use DHCP or have a CIDR", device.Interface))
+		}
+	}
+
+	if strings.TrimSpace(s.bondName) != "" && len(splitKeyValues(s.bondMembers)) == 0 {
+		issues = append(issues, fmt.Sprintf("Network Config: bond %s should have at least one member interface", s.bondName))
+	}
+
+	// the version and the values are validated against the preset which was selected at the moment of the input
+	if !s.conn.ExpandingCluster() {
+		if _, err := s.cniConfig(); err != nil {
+			issues = append(issues, "Network Config: "+err.Error())
+		}
+	}
+
+	return issues
+}
+
+// summary returns the main settings of the configuration as setting, value pairs.
+func (s *State) summary() [][2]string {
+	opts := s.opts
+
+	orDefault := func(value, defaultValue string) string {
+		if strings.TrimSpace(value) == "" {
+			return defaultValue
+		}
+
+		return value
+	}
+
+	rows := [][2]string{
+		{"Machine Type", machine.Type(opts.MachineConfig.Type).String()},
+		{"Cluster Name", opts.ClusterConfig.Name},
+		{"Control Plane Endpoint", opts.ClusterConfig.ControlPlane.Endpoint},
+		{"Install Disk", opts.MachineConfig.InstallConfig.InstallDisk},
+		{"Install Image", opts.MachineConfig.InstallConfig.InstallImage},
+		{"Hostname", orDefault(opts.MachineConfig.NetworkConfig.Hostname, "(default)")},
+		{"DNS Servers", orDefault(s.nameservers, "(default)")},
+	}
+
+	if endpoints := opts.ClusterConfig.ControlPlane.AdditionalEndpoints; len(endpoints) > 0 {
+		rows = slices.Insert(rows, 3, [2]string{"Additional Endpoints", strings.Join(endpoints, ", ")})
+	}
+
+	for _, device := range opts.MachineConfig.NetworkConfig.Interfaces {
+		if device.Bond != nil {
+			continue
+		}
+
+		rows = append(rows, [2]string{"Interface " + device.Interface, describeAddressing(device.Dhcp, device.Ignore, device.Cidr)})
+	}
+
+	if bondName := strings.TrimSpace(s.bondName); bondName != "" {
+		rows = append(rows, [2]string{
+			"Bond " + bondName,
+			fmt.Sprintf("%s (%s), %s", s.bondMode, orDefault(s.bondMembers, "no members"), describeAddressing(s.bondCIDR == "", false, s.bondCIDR)),
+		})
+	}
+
+	rows = append(rows, [2]string{"Data Disks", orDefault(s.dataDisks, "(none)")})
+
+	if !s.conn.ExpandingCluster() {
+		cni := s.cni
+
+		if preset, err := s.cniPreset(); err == nil && len(preset.Versions) > 0 {
+			cni += " " + orDefault(s.cniVersion, preset.DefaultVersion())
+		}
+
+		rows = append(rows, [2]string{"CNI", cni})
+	}
+
+	return rows
+}
+
+func describeAddressing(dhcp, ignore bool, cidr string) string {
+	switch {
+	case ignore:
+		return "ignored"
+	case dhcp:
+		return "DHCP"
+	default:
+		return cidr
+	}
+}
+
+// checkEndpoint checks that the control plane endpoint accepts TCP connections.
+func checkEndpoint(ctx context.Context, endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
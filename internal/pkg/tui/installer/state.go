@@ -8,6 +8,10 @@ package installer
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -31,8 +35,10 @@ func NewState(ctx context.Context, installer *Installer, conn *Connection) (*Sta
 	opts := &machineapi.GenerateConfigurationRequest{
 		ConfigVersion: "v1alpha1",
 		MachineConfig: &machineapi.MachineConfig{
-			Type:              machineapi.MachineConfig_MachineType(machine.TypeInit),
-			NetworkConfig:     &machineapi.NetworkConfig{},
+			Type: machineapi.MachineConfig_MachineType(machine.TypeInit),
+			NetworkConfig: &machineapi.NetworkConfig{
+				Interfaces: []*machineapi.NetworkDeviceConfig{},
+			},
 			KubernetesVersion: constants.DefaultKubernetesVersion,
 			InstallConfig: &machineapi.InstallConfig{
 				InstallImage: images.DefaultInstallerImage,
@@ -88,9 +94,29 @@ func NewState(ctx context.Context, installer *Installer, conn *Connection) (*Sta
 	}
 
 	state := &State{
-		opts: opts,
-		conn: conn,
-		cni:  constants.FlannelCNI,
+		opts:           opts,
+		conn:           conn,
+		cni:            constants.FlannelCNI,
+		cniPresets:     slices.Clone(conn.CNIPresets()),
+		adapterExtras:  map[string]*adapterExtras{},
+		bondMode:       "active-backup",
+		bondHashPolicy: "layer2",
+	}
+
+	if conn.Editing() {
+		data, err := conn.ExistingConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		if err = state.loadConfig(data); err != nil {
+			return nil, err
+		}
+
+		// the machine type can't be changed for the existing machine config
+		machineTypes = []any{
+			fmt.Sprintf(" %s ", machine.Type(opts.MachineConfig.Type)), opts.MachineConfig.Type,
+		}
 	}
 
 	networkConfigItems := []*components.Item{
@@ -98,12 +124,17 @@ func NewState(ctx context.Context, installer *Installer, conn *Connection) (*Sta
 			"Hostname",
 			describe[v1alpha1.NetworkConfig]("hostname", true),
 			&opts.MachineConfig.NetworkConfig.Hostname,
-		),
+		).SetValidator(components.Optional(components.ValidateHostname)),
 		components.NewItem(
 			"DNS Domain",
 			describe[v1alpha1.ClusterNetworkConfig]("dnsDomain", true),
 			&opts.ClusterConfig.ClusterNetwork.DnsDomain,
-		),
+		).SetValidator(components.ValidateHostname),
+		components.NewItem(
+			"DNS Servers",
+			"Comma-separated list of the DNS servers, e.g. 1.1.1.1,8.8.8.8 (empty means the default ones).",
+			&state.nameservers,
+		).SetValidator(components.CommaSeparated(components.ValidateIP)),
 	}
 
 	links, err := conn.Links()
@@ -112,7 +143,6 @@ func NewState(ctx context.Context, installer *Installer, conn *Connection) (*Sta
 	}
 
 	addedInterfaces := false
-	opts.MachineConfig.NetworkConfig.Interfaces = []*machineapi.NetworkDeviceConfig{}
 
 	for _, link := range links {
 		status := ""
@@ -133,30 +163,76 @@ func NewState(ctx context.Context, installer *Installer, conn *Connection) (*Sta
 		networkConfigItems = append(networkConfigItems, components.NewItem(
 			fmt.Sprintf("%s, %s%s", link.Name, link.HardwareAddr, status),
 			"",
-			configureAdapter(installer, opts, &link),
+			configureAdapter(installer, state, &link),
 		))
 	}
 
+	networkConfigItems = append(networkConfigItems, bondItems(state)...)
+
 	if !conn.ExpandingCluster() {
-		networkConfigItems = append(networkConfigItems,
-			components.NewSeparator(describe[v1alpha1.ClusterNetworkConfig]("cni", true)),
-			components.NewItem(
-				"Type",
-				describe[v1alpha1.ClusterNetworkConfig]("cni", true),
-				&state.cni,
-				components.NewTableHeaders("CNI", "description"),
-				constants.FlannelCNI, "CNI used by Talos by default",
-				constants.NoneCNI, "CNI will not be installed",
-			))
+		networkConfigItems = append(networkConfigItems, cniItems(state)...)
+	}
+
+	if conn.ExpandingCluster() {
+		members, err := conn.Members()
+		if err != nil {
+			return nil, err
+		}
+
+		state.pages = append(state.pages, NewPage("Cluster Members", clusterMembersItems(members)...))
+	}
+
+	machineConfigItems := []*components.Item{
+		components.NewItem(
+			"Machine Type",
+			describe[v1alpha1.MachineConfig]("type", true),
+			&opts.MachineConfig.Type,
+			machineTypes...,
+		),
+		components.NewItem(
+			"Cluster Name",
+			describe[v1alpha1.ClusterConfig]("clusterName", true),
+			&opts.ClusterConfig.Name,
+		).SetValidator(components.ValidateNotEmpty),
+		components.NewItem(
+			"Control Plane Endpoint",
+			describe[v1alpha1.ControlPlaneConfig]("endpoint", true),
+			&opts.ClusterConfig.ControlPlane.Endpoint,
+		).SetValidator(components.ValidateURL),
+	}
+
+	// additional endpoints are baked into the generated certificate SANs, so they are set only for the new machine config
+	if !state.Editing() {
+		machineConfigItems = append(machineConfigItems, components.NewItem(
+			"Additional Endpoints",
+			"Comma-separated list of additional control plane endpoints (DNS names or IPs), e.g. cp.example.com,10.5.0.10.\n"+
+				"The endpoints are added to the certificate SANs and to the talosconfig endpoints.",
+			&opts.ClusterConfig.ControlPlane.AdditionalEndpoints,
+		).SetValidator(components.CommaSeparated(components.ValidateHost)))
+	}
+
+	// Kubernetes is upgraded with 'talosctl upgrade-k8s' for the existing machine config
+	if !state.Editing() {
+		machineConfigItems = append(machineConfigItems, components.NewItem(
+			"Kubernetes Version",
+			"",
+			&opts.MachineConfig.KubernetesVersion,
+		).SetValidator(components.ValidateNotEmpty))
 	}
 
-	state.pages = []*Page{
+	machineConfigItems = append(machineConfigItems, components.NewItem(
+		"Allow Scheduling on Control Planes",
+		describe[v1alpha1.ClusterConfig]("allowSchedulingOnControlPlanes", true),
+		&opts.ClusterConfig.AllowSchedulingOnControlPlanes,
+	))
+
+	state.pages = append(state.pages,
 		NewPage("Installer Params",
 			components.NewItem(
 				"Image",
 				describe[v1alpha1.InstallConfig]("image", true),
 				&opts.MachineConfig.InstallConfig.InstallImage,
-			),
+			).SetValidator(components.ValidateNotEmpty),
 			components.NewSeparator(
 				describe[v1alpha1.InstallConfig]("disk", true),
 			),
@@ -165,39 +241,46 @@ func NewState(ctx context.Context, installer *Installer, conn *Connection) (*Sta
 				"",
 				&opts.MachineConfig.InstallConfig.InstallDisk,
 				installDiskOptions...,
-			),
-		),
-		NewPage("Machine Config",
-			components.NewItem(
-				"Machine Type",
-				describe[v1alpha1.MachineConfig]("type", true),
-				&opts.MachineConfig.Type,
-				machineTypes...,
-			),
-			components.NewItem(
-				"Cluster Name",
-				describe[v1alpha1.ClusterConfig]("clusterName", true),
-				&opts.ClusterConfig.Name,
-			),
-			components.NewItem(
-				"Control Plane Endpoint",
-				describe[v1alpha1.ControlPlaneConfig]("endpoint", true),
-				&opts.ClusterConfig.ControlPlane.Endpoint,
-			),
-			components.NewItem(
-				"Kubernetes Version",
-				"",
-				&opts.MachineConfig.KubernetesVersion,
-			),
-			components.NewItem(
-				"Allow Scheduling on Control Planes",
-				describe[v1alpha1.ClusterConfig]("allowSchedulingOnControlPlanes", true),
-				&opts.ClusterConfig.AllowSchedulingOnControlPlanes,
-			),
+			).SetValidator(components.ValidateNotEmpty),
 		),
+		NewPage("Machine Config", machineConfigItems...),
 		NewPage("Network Config",
 			networkConfigItems...,
 		),
+	)
+
+	partitions, err := conn.Partitions()
+	if err != nil {
+		return nil, err
+	}
+
+	state.pages = append(state.pages,
+		NewPage("Storage", storageItems(opts, state, partitions)...),
+	)
+
+	if conn.ExpandingCluster() {
+		state.pages = append(state.pages,
+			NewPage("Worker Pool",
+				components.NewSeparator(
+					"Overrides applied to the worker machine config, comma-separated key=value pairs.",
+				),
+				components.NewItem(
+					"Node Labels",
+					describe[v1alpha1.MachineConfig]("nodeLabels", true),
+					&state.poolNodeLabels,
+				),
+				components.NewItem(
+					"Node Taints",
+					describe[v1alpha1.MachineConfig]("nodeTaints", true),
+					&state.poolNodeTaints,
+				),
+				components.NewItem(
+					"Kubelet Extra Args",
+					describe[v1alpha1.KubeletConfig]("extraArgs", true),
+					&state.poolKubeletExtraArgs,
+				),
+			),
+		)
 	}
 
 	return state, nil
@@ -208,23 +291,374 @@ type State struct {
 	pages []*Page
 	opts  *machineapi.GenerateConfigurationRequest
 	conn  *Connection
-	cni   string
+
+	cni        string
+	cniVersion string
+	cniValues  string
+	cniPresets []CNIPreset
+
+	poolNodeLabels       string
+	poolNodeTaints       string
+	poolKubeletExtraArgs string
+
+	dataDisks string
+
+	nameservers   string
+	adapterExtras map[string]*adapterExtras
+
+	bondName       string
+	bondMembers    string
+	bondMode       string
+	bondHashPolicy string
+	bondCIDR       string
+
+	edited *editedConfig
+}
+
+// adapterExtras keeps the raw static routes and VLANs input of the adapter, it is parsed at GenConfig.
+type adapterExtras struct {
+	routes string
+	vlans  string
 }
 
 // GenConfig returns current config encoded in yaml.
 func (s *State) GenConfig() (*machineapi.GenerateConfigurationResponse, error) {
-	cniConfig := &machineapi.CNIConfig{
-		Name: s.cni,
+	cniConfig, err := s.cniConfig()
+	if err != nil {
+		return nil, err
 	}
 
 	s.opts.ClusterConfig.ClusterNetwork.CniConfig = cniConfig
 
 	s.opts.OverrideTime = timestamppb.New(time.Now().UTC())
 
+	dataDisks, err := parseDataDisks(s.dataDisks)
+	if err != nil {
+		return nil, err
+	}
+
+	s.opts.MachineConfig.DataDisks = dataDisks
+
+	if err = s.updateNetworkConfig(); err != nil {
+		return nil, err
+	}
+
+	s.opts.MachinePools = nil
+
+	if machine.Type(s.opts.MachineConfig.Type) == machine.TypeWorker {
+		pool := &machineapi.MachinePoolConfig{
+			Name:             "installer",
+			NodeLabels:       splitKeyValues(s.poolNodeLabels),
+			NodeTaints:       splitKeyValues(s.poolNodeTaints),
+			KubeletExtraArgs: splitKeyValues(s.poolKubeletExtraArgs),
+		}
+
+		if len(pool.NodeLabels) > 0 || len(pool.NodeTaints) > 0 || len(pool.KubeletExtraArgs) > 0 {
+			s.opts.MachinePools = append(s.opts.MachinePools, pool)
+		}
+	}
+
 	return s.conn.GenerateConfiguration(s.opts)
 }
 
-func configureAdapter(installer *Installer, opts *machineapi.GenerateConfigurationRequest, link *Link) func(item *components.Item) tview.Primitive {
+// NodeConfig returns the machine config to be applied to the node from the generate response.
+//
+// If the worker pool overrides are set, the pool config (which is the last one) is used.
+func (s *State) NodeConfig(response *machineapi.GenerateConfigurationResponse) []byte {
+	data := response.Messages[0].Data
+
+	return data[len(data)-1]
+}
+
+// updateNetworkConfig fills in the network settings which are not edited directly in the request.
+func (s *State) updateNetworkConfig() error {
+	networkConfig := s.opts.MachineConfig.NetworkConfig
+
+	networkConfig.Nameservers = splitKeyValues(s.nameservers)
+
+	for _, device := range networkConfig.Interfaces {
+		extras, ok := s.adapterExtras[device.Interface]
+		if !ok {
+			continue
+		}
+
+		routes, err := parseRoutes(extras.routes)
+		if err != nil {
+			return fmt.Errorf("interface %s: %w", device.Interface, err)
+		}
+
+		vlans, err := parseVLANs(extras.vlans)
+		if err != nil {
+			return fmt.Errorf("interface %s: %w", device.Interface, err)
+		}
+
+		device.Routes = routes
+		device.Vlans = vlans
+	}
+
+	// drop the bond generated previously, GenConfig might be called several times
+	networkConfig.Interfaces = slices.DeleteFunc(networkConfig.Interfaces, func(device *machineapi.NetworkDeviceConfig) bool {
+		return device.Bond != nil
+	})
+
+	bondName := strings.TrimSpace(s.bondName)
+	if bondName == "" {
+		return nil
+	}
+
+	members := splitKeyValues(s.bondMembers)
+	if len(members) == 0 {
+		return fmt.Errorf("bond %s should have at least one member interface", bondName)
+	}
+
+	// bond members are configured by the bond itself
+	networkConfig.Interfaces = slices.DeleteFunc(networkConfig.Interfaces, func(device *machineapi.NetworkDeviceConfig) bool {
+		return slices.Contains(members, device.Interface)
+	})
+
+	cidr := strings.TrimSpace(s.bondCIDR)
+
+	if cidr != "" {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			return fmt.Errorf("invalid bond %s CIDR %q: %w", bondName, cidr, err)
+		}
+	}
+
+	networkConfig.Interfaces = append(networkConfig.Interfaces, &machineapi.NetworkDeviceConfig{
+		Interface: bondName,
+		Cidr:      cidr,
+		Dhcp:      cidr == "",
+		Bond: &machineapi.BondConfig{
+			Interfaces: members,
+			Mode:       s.bondMode,
+			HashPolicy: s.bondHashPolicy,
+		},
+	})
+
+	return nil
+}
+
+// cniPreset returns the selected CNI preset.
+func (s *State) cniPreset() (*CNIPreset, error) {
+	idx := slices.IndexFunc(s.cniPresets, func(preset CNIPreset) bool { return preset.Name == s.cni })
+	if idx == -1 {
+		return nil, fmt.Errorf("unknown CNI %q", s.cni)
+	}
+
+	return &s.cniPresets[idx], nil
+}
+
+// cniConfig renders the CNI config out of the selected CNI preset, version and value overrides.
+func (s *State) cniConfig() (*machineapi.CNIConfig, error) {
+	preset, err := s.cniPreset()
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := parseCNIValues(s.cniValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return preset.Render(s.cniVersion, values)
+}
+
+// validateParsed turns the parser of the raw form value into a validator.
+func validateParsed[T any](parse func(string) (T, error)) components.Validator {
+	return func(value string) error {
+		_, err := parse(value)
+
+		return err
+	}
+}
+
+func splitKeyValues(value string) []string {
+	var result []string
+
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair != "" {
+			result = append(result, pair)
+		}
+	}
+
+	return result
+}
+
+// parseDataDisks parses the comma-separated list of device:mountpoint pairs.
+func parseDataDisks(value string) ([]*machineapi.DataDiskConfig, error) {
+	var dataDisks []*machineapi.DataDiskConfig
+
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		device, mountPoint, ok := strings.Cut(pair, ":")
+		if !ok || device == "" || mountPoint == "" {
+			return nil, fmt.Errorf("invalid data disk %q, expected device:mountpoint", pair)
+		}
+
+		dataDisks = append(dataDisks, &machineapi.DataDiskConfig{
+			Device:     device,
+			MountPoint: mountPoint,
+		})
+	}
+
+	return dataDisks, nil
+}
+
+// parseRoutes parses the comma-separated list of network=gateway pairs.
+func parseRoutes(value string) ([]*machineapi.RouteConfig, error) {
+	var routes []*machineapi.RouteConfig
+
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		network, gateway, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid route %q, expected network=gateway", pair)
+		}
+
+		if _, err := netip.ParsePrefix(network); err != nil {
+			return nil, fmt.Errorf("invalid route %q network: %w", pair, err)
+		}
+
+		if _, err := netip.ParseAddr(gateway); err != nil {
+			return nil, fmt.Errorf("invalid route %q gateway: %w", pair, err)
+		}
+
+		routes = append(routes, &machineapi.RouteConfig{
+			Network: network,
+			Gateway: gateway,
+		})
+	}
+
+	return routes, nil
+}
+
+// parseVLANs parses the comma-separated list of VLANs in the vlanId[=cidr] format, VLANs without CIDR use DHCP.
+func parseVLANs(value string) ([]*machineapi.VLANConfig, error) {
+	var vlans []*machineapi.VLANConfig
+
+	for _, vlan := range strings.Split(value, ",") {
+		if vlan = strings.TrimSpace(vlan); vlan == "" {
+			continue
+		}
+
+		id, cidr, _ := strings.Cut(vlan, "=")
+
+		vlanID, err := strconv.ParseUint(id, 10, 12)
+		if err != nil || vlanID == 0 {
+			return nil, fmt.Errorf("invalid VLAN %q, expected VLAN ID in range 1-4095", vlan)
+		}
+
+		if cidr != "" {
+			if _, err = netip.ParsePrefix(cidr); err != nil {
+				return nil, fmt.Errorf("invalid VLAN %q CIDR: %w", vlan, err)
+			}
+		}
+
+		vlans = append(vlans, &machineapi.VLANConfig{
+			VlanId: uint32(vlanID),
+			Cidr:   cidr,
+			Dhcp:   cidr == "",
+		})
+	}
+
+	return vlans, nil
+}
+
+// cniItems allows to pick the CNI preset, its version and the values.
+func cniItems(state *State) []*components.Item {
+	presetOptions := []any{
+		components.NewTableHeaders("CNI", "versions", "description"),
+	}
+
+	for _, preset := range state.cniPresets {
+		presetOptions = append(presetOptions, preset.Name, strings.Join(preset.Versions, ", "), preset.Description)
+	}
+
+	return []*components.Item{
+		components.NewSeparator(describe[v1alpha1.ClusterNetworkConfig]("cni", true)),
+		components.NewItem(
+			"Type",
+			describe[v1alpha1.ClusterNetworkConfig]("cni", true),
+			&state.cni,
+			presetOptions...,
+		),
+		components.NewItem(
+			"Version",
+			"CNI version, one of the versions listed for the preset (empty means the first one).",
+			&state.cniVersion,
+		).SetValidator(func(value string) error {
+			preset, err := state.cniPreset()
+			if err != nil {
+				return err
+			}
+
+			return preset.ValidateVersion(value)
+		}),
+		components.NewItem(
+			"Values",
+			"Comma-separated list of key=value overrides of the CNI preset values.",
+			&state.cniValues,
+		).SetValidator(func(value string) error {
+			_, err := state.cniConfig()
+
+			return err
+		}),
+	}
+}
+
+// bondItems allows to create a bond out of the network interfaces.
+func bondItems(state *State) []*components.Item {
+	return []*components.Item{
+		components.NewSeparator(describe[v1alpha1.Device]("bond", true)),
+		components.NewItem(
+			"Bond Name",
+			"Name of the bond interface, e.g. bond0 (empty means no bond).",
+			&state.bondName,
+		),
+		components.NewItem(
+			"Members",
+			"Comma-separated list of the bond member interfaces, e.g. eth0,eth1.",
+			&state.bondMembers,
+		),
+		components.NewItem(
+			"Mode",
+			describe[v1alpha1.Bond]("mode", true),
+			&state.bondMode,
+			" balance-rr ", "balance-rr",
+			" active-backup ", "active-backup",
+			" balance-xor ", "balance-xor",
+			" broadcast ", "broadcast",
+			" 802.3ad ", "802.3ad",
+			" balance-tlb ", "balance-tlb",
+			" balance-alb ", "balance-alb",
+		),
+		components.NewItem(
+			"Hash Policy",
+			describe[v1alpha1.Bond]("xmitHashPolicy", true),
+			&state.bondHashPolicy,
+			" layer2 ", "layer2",
+			" layer2+3 ", "layer2+3",
+			" layer3+4 ", "layer3+4",
+			" encap2+3 ", "encap2+3",
+			" encap3+4 ", "encap3+4",
+		),
+		components.NewItem(
+			"CIDR",
+			"Bond address in CIDR notation (empty means DHCP).",
+			&state.bondCIDR,
+		).SetValidator(components.Optional(components.ValidateCIDR)),
+	}
+}
+
+func configureAdapter(installer *Installer, state *State, link *Link) func(item *components.Item) tview.Primitive {
+	opts := state.opts
+
 	return func(item *components.Item) tview.Primitive {
 		return components.NewFormModalButton(item.Name, "configure").
 			SetSelectedFunc(func() {
@@ -251,6 +685,12 @@ func configureAdapter(installer *Installer, opts *machineapi.GenerateConfigurati
 					}
 				}
 
+				extras := &adapterExtras{}
+
+				if saved, ok := state.adapterExtras[link.Name]; ok {
+					*extras = *saved
+				}
+
 				items := []*components.Item{
 					components.NewItem(
 						"Use DHCP",
@@ -266,17 +706,27 @@ func configureAdapter(installer *Installer, opts *machineapi.GenerateConfigurati
 						"CIDR",
 						describe[v1alpha1.Device]("cidr", true),
 						&adapterSettings.Cidr,
-					),
+					).SetValidator(components.Optional(components.ValidateCIDR)),
 					components.NewItem(
 						"MTU",
 						describe[v1alpha1.Device]("mtu", true),
 						&adapterSettings.Mtu,
-					),
+					).SetValidator(components.IntRange(0, 65535)),
 					components.NewItem(
 						"Route Metric",
 						describe[v1alpha1.Device]("dhcpOptions", true),
 						&adapterSettings.DhcpOptions.RouteMetric,
 					),
+					components.NewItem(
+						"Static Routes",
+						"Comma-separated list of network=gateway pairs, e.g. 10.0.0.0/8=192.168.1.1.",
+						&extras.routes,
+					).SetValidator(validateParsed(parseRoutes)),
+					components.NewItem(
+						"VLANs",
+						"Comma-separated list of VLANs in the vlanId[=cidr] format, e.g. 100,200=10.2.0.5/24 (VLANs without CIDR use DHCP).",
+						&extras.vlans,
+					).SetValidator(validateParsed(parseVLANs)),
 				}
 
 				adapterConfiguration := components.NewForm(installer.app)
@@ -297,12 +747,19 @@ func configureAdapter(installer *Installer, opts *machineapi.GenerateConfigurati
 				})
 
 				adapterConfiguration.AddMenuButton("Apply", false).SetSelectedFunc(func() {
+					// invalid values are highlighted inline
+					if errs := adapterConfiguration.Validate(); len(errs) > 0 {
+						return
+					}
+
 					goBack()
 
 					if adapterSettings.Dhcp {
 						adapterSettings.Cidr = ""
 					}
 
+					state.adapterExtras[link.Name] = extras
+
 					if deviceIndex == -1 {
 						opts.MachineConfig.NetworkConfig.Interfaces = append(
 							opts.MachineConfig.NetworkConfig.Interfaces,
@@ -326,6 +783,78 @@ func configureAdapter(installer *Installer, opts *machineapi.GenerateConfigurati
 	}
 }
 
+// clusterMembersItems shows the members of the cluster being expanded, so that the user can verify the node joins the intended cluster.
+func clusterMembersItems(members []Member) []*components.Item {
+	if len(members) == 0 {
+		return []*components.Item{
+			components.NewSeparator("No cluster members were discovered, make sure the cluster discovery is enabled."),
+		}
+	}
+
+	return []*components.Item{
+		components.NewSeparator("The node is going to join the cluster with the following members:"),
+		components.NewItem("", "", func(*components.Item) tview.Primitive {
+			table := components.NewTable()
+			table.SetHeader("HOSTNAME", "ROLE", "VERSION", "ADDRESSES")
+
+			for _, member := range members {
+				table.AddRow(member.Hostname, member.Role, member.Version, strings.Join(member.Addresses, ", "))
+			}
+
+			return table
+		}),
+	}
+}
+
+// storageItems shows the existing partitions on the node disks and the disk layout options.
+func storageItems(opts *machineapi.GenerateConfigurationRequest, state *State, partitions []Partition) []*components.Item {
+	var items []*components.Item
+
+	if len(partitions) == 0 {
+		items = append(items, components.NewSeparator("No existing partitions were discovered."))
+	} else {
+		items = append(items,
+			components.NewSeparator("Existing partitions:"),
+			components.NewItem("", "", func(*components.Item) tview.Primitive {
+				table := components.NewTable()
+				table.SetHeader("DEVICE", "DISK", "LABEL", "FILESYSTEM", "SIZE")
+
+				for _, partition := range partitions {
+					table.AddRow(partition.DevPath, partition.Disk, partition.Label, partition.Filesystem, partition.Size)
+				}
+
+				return table
+			}),
+		)
+	}
+
+	// the install disk layout can't be changed for the installed machine
+	if !state.Editing() {
+		items = append(items,
+			components.NewSeparator(describe[v1alpha1.InstallConfig]("wipe", true)),
+			components.NewItem(
+				"Wipe Install Disk",
+				"",
+				&opts.MachineConfig.InstallConfig.Wipe,
+			),
+			components.NewItem(
+				"EPHEMERAL Max Size",
+				"Maximum size of the EPHEMERAL volume, e.g. 50GiB (empty means the whole free space of the install disk).",
+				&opts.MachineConfig.InstallConfig.EphemeralMaxSize,
+			),
+		)
+	}
+
+	return append(items,
+		components.NewSeparator(describe[v1alpha1.MachineConfig]("disks", true)),
+		components.NewItem(
+			"Data Disks",
+			"Comma-separated list of device:mountpoint pairs, e.g. /dev/sdb:/var/mnt/data.",
+			&state.dataDisks,
+		).SetValidator(validateParsed(parseDataDisks)),
+	)
+}
+
 type documentable interface {
 	Doc() *encoder.Doc
 }
@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package userfiles implements the access to the files under the user files directories.
+package userfiles
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrOutsideRoots is returned when the path is not under any of the user files directories.
+var ErrOutsideRoots = errors.New("path is outside of the user files directories")
+
+// ErrTooLarge is returned when the file exceeds the size limit.
+var ErrTooLarge = errors.New("file exceeds the size limit")
+
+// Resolve validates that the path is under one of the roots, and returns the cleaned path.
+//
+// The symlinks in the existing parent directories are resolved, so that the path can't escape the roots.
+// The file itself is not resolved: it is replaced on write and not followed on read.
+func Resolve(roots []string, path string) (string, error) {
+	path = filepath.Clean(path)
+
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("path %q is not absolute", path)
+	}
+
+	for _, root := range roots {
+		if !strings.HasPrefix(path, root+string(filepath.Separator)) {
+			continue
+		}
+
+		for dir := filepath.Dir(path); ; dir = filepath.Dir(dir) {
+			resolved, err := filepath.EvalSymlinks(dir)
+			if err == nil {
+				if resolved != root && !strings.HasPrefix(resolved, root+string(filepath.Separator)) {
+					return "", fmt.Errorf("%w: %q resolves to %q", ErrOutsideRoots, dir, resolved)
+				}
+
+				return path, nil
+			}
+
+			if !errors.Is(err, fs.ErrNotExist) {
+				return "", err
+			}
+
+			if dir == root {
+				// the root doesn't exist yet, it is created on write
+				return path, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q (allowed: %s)", ErrOutsideRoots, path, strings.Join(roots, ", "))
+}
+
+// Options are the attributes of the written file.
+type Options struct {
+	Mode fs.FileMode
+	UID  int
+	GID  int
+
+	// MaxSize is the size limit of the file, zero means no limit.
+	MaxSize int64
+}
+
+// Write atomically replaces the file at the resolved path with the contents of r.
+//
+// The missing parent directories are created. Write returns the size of the written file.
+func Write(path string, r io.Reader, opts Options) (int64, error) {
+	dir := filepath.Dir(path)
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, fmt.Errorf("error creating directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return 0, fmt.Errorf("error creating temporary file: %w", err)
+	}
+
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	defer tmp.Close() //nolint:errcheck
+
+	if opts.MaxSize > 0 {
+		r = io.LimitReader(r, opts.MaxSize+1)
+	}
+
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return 0, fmt.Errorf("error writing file: %w", err)
+	}
+
+	if opts.MaxSize > 0 && size > opts.MaxSize {
+		return 0, fmt.Errorf("%w of %d bytes", ErrTooLarge, opts.MaxSize)
+	}
+
+	if err = tmp.Chmod(opts.Mode); err != nil {
+		return 0, fmt.Errorf("error changing file mode: %w", err)
+	}
+
+	if err = tmp.Chown(opts.UID, opts.GID); err != nil {
+		return 0, fmt.Errorf("error changing file owner: %w", err)
+	}
+
+	if err = tmp.Sync(); err != nil {
+		return 0, fmt.Errorf("error syncing file: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return 0, fmt.Errorf("error closing file: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return 0, fmt.Errorf("error renaming file: %w", err)
+	}
+
+	return size, nil
+}
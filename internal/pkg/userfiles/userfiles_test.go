@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package userfiles_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/userfiles"
+)
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	tmp := t.TempDir()
+
+	root := filepath.Join(tmp, "user")
+	outside := filepath.Join(tmp, "outside")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "dir"), 0o755))
+	require.NoError(t, os.MkdirAll(outside, 0o755))
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "escape")))
+	require.NoError(t, os.Symlink(filepath.Join(root, "dir"), filepath.Join(root, "inside")))
+
+	roots := []string{root, filepath.Join(tmp, "missing")}
+
+	for _, tt := range []struct {
+		name string
+		path string
+
+		expected string
+		outside  bool
+	}{
+		{
+			name:     "file",
+			path:     filepath.Join(root, "file"),
+			expected: filepath.Join(root, "file"),
+		},
+		{
+			name:     "unclean path",
+			path:     root + "/dir/../dir/file",
+			expected: filepath.Join(root, "dir", "file"),
+		},
+		{
+			name:     "missing parent directories",
+			path:     filepath.Join(root, "a", "b", "file"),
+			expected: filepath.Join(root, "a", "b", "file"),
+		},
+		{
+			name:     "symlink inside root",
+			path:     filepath.Join(root, "inside", "file"),
+			expected: filepath.Join(root, "inside", "file"),
+		},
+		{
+			name:     "missing root",
+			path:     filepath.Join(tmp, "missing", "file"),
+			expected: filepath.Join(tmp, "missing", "file"),
+		},
+		{
+			name:    "symlink escape",
+			path:    filepath.Join(root, "escape", "file"),
+			outside: true,
+		},
+		{
+			name:    "dot dot escape",
+			path:    root + "/../outside/file",
+			outside: true,
+		},
+		{
+			name:    "root itself",
+			path:    root,
+			outside: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resolved, err := userfiles.Resolve(roots, tt.path)
+
+			if tt.outside {
+				require.ErrorIs(t, err, userfiles.ErrOutsideRoots)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resolved)
+		})
+	}
+
+	_, err := userfiles.Resolve(roots, "relative/file")
+	require.Error(t, err)
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "a", "file")
+
+	opts := userfiles.Options{
+		Mode:    0o600,
+		UID:     os.Getuid(),
+		GID:     os.Getgid(),
+		MaxSize: 8,
+	}
+
+	size, err := userfiles.Write(path, strings.NewReader("contents"), opts)
+	require.NoError(t, err)
+	assert.EqualValues(t, 8, size)
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(contents))
+
+	st, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), st.Mode().Perm())
+
+	_, err = userfiles.Write(path, strings.NewReader("too large"), opts)
+	require.ErrorIs(t, err, userfiles.ErrTooLarge)
+
+	// the previous contents are kept, and no temporary files are left
+	contents, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(contents))
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
@@ -19,8 +19,30 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 )
 
+// TarOption configures Tar.
+type TarOption func(*tarOptions)
+
+type tarOptions struct {
+	contentsFilter func([]byte) []byte
+}
+
+// WithContentsFilter transforms the contents of the regular files before writing them to the archive.
+//
+// With the filter set, each file is read into memory as a whole.
+func WithContentsFilter(filter func([]byte) []byte) TarOption {
+	return func(o *tarOptions) {
+		o.contentsFilter = filter
+	}
+}
+
 // Tar creates .tar archive and writes it to output for every item in paths channel.
-func Tar(ctx context.Context, paths <-chan FileItem, output io.Writer) error {
+func Tar(ctx context.Context, paths <-chan FileItem, output io.Writer, opts ...TarOption) error {
+	var options tarOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	tw := tar.NewWriter(output)
 	//nolint:errcheck
 	defer tw.Close()
@@ -36,7 +58,7 @@ func Tar(ctx context.Context, paths <-chan FileItem, output io.Writer) error {
 			continue
 		}
 
-		err := processFile(ctx, tw, fi, buf)
+		err := processFile(ctx, tw, fi, buf, options)
 		if err != nil {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("skipping %q: %s", fi.FullPath, err))
 		}
@@ -50,7 +72,7 @@ func Tar(ctx context.Context, paths <-chan FileItem, output io.Writer) error {
 }
 
 //nolint:gocyclo
-func processFile(ctx context.Context, tw *tar.Writer, fi FileItem, buf []byte) error {
+func processFile(ctx context.Context, tw *tar.Writer, fi FileItem, buf []byte, options tarOptions) error {
 	header, err := tar.FileInfoHeader(fi.FileInfo, fi.Link)
 	if err != nil {
 		// not supported by tar
@@ -113,6 +135,20 @@ func processFile(ctx context.Context, tw *tar.Writer, fi FileItem, buf []byte) e
 		}
 	}
 
+	if !skipData && options.contentsFilter != nil {
+		var contents []byte
+
+		contents, err = io.ReadAll(io.LimitReader(r, header.Size))
+		if err != nil {
+			return err
+		}
+
+		contents = options.contentsFilter(contents)
+
+		header.Size = int64(len(contents))
+		r = bytes.NewReader(contents)
+	}
+
 	err = tw.WriteHeader(header)
 	if err != nil {
 		return err
@@ -111,6 +111,31 @@ func (suite *TarSuite) TestArchiveFile() {
 	}
 }
 
+func (suite *TarSuite) TestArchiveFileContentsFilter() {
+	ch, err := archiver.Walker(context.Background(), filepath.Join(suite.tmpDir, "/usr/bin/cp"))
+	suite.Require().NoError(err)
+
+	var buf bytes.Buffer
+
+	err = archiver.Tar(context.Background(), ch, &buf, archiver.WithContentsFilter(bytes.ToLower))
+	suite.Require().NoError(err)
+
+	tr := tar.NewReader(&buf)
+
+	hdr, err := tr.Next()
+	suite.Require().NoError(err)
+
+	expectedContents := []byte("elf executable iirc")
+
+	suite.Require().EqualValues(hdr.Name, "cp")
+	suite.Require().EqualValues(hdr.Size, len(expectedContents))
+
+	contents, err := io.ReadAll(tr)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(expectedContents, contents)
+}
+
 func (suite *TarSuite) TestArchiveProcfs() {
 	ch, err := archiver.Walker(context.Background(), "/proc/self/", archiver.WithMaxRecurseDepth(0))
 	suite.Require().NoError(err)
@@ -27,41 +27,71 @@ type ApplyConfigClient struct {
 // ApplyConfig on the node via the API using insecure mode.
 func (s *APIBootstrapper) ApplyConfig(ctx context.Context, nodes []provision.NodeRequest, sl provision.SiderolinkRequest, out io.Writer) error {
 	for _, node := range nodes {
-		configureNode := func() error {
-			ep := node.IPs[0].String()
-
-			if addr, ok := sl.GetAddr(node.UUID); ok {
-				fmt.Fprintln(out, "using SideroLink node address for 'with-apply-config'", node.UUID, "=", addr.String())
-
-				ep = addr.String()
-			}
-
-			c, err := client.New(ctx, client.WithTLSConfig(&tls.Config{
-				InsecureSkipVerify: true,
-			}), client.WithEndpoints(ep))
-			if err != nil {
-				return err
-			}
-
-			cfgBytes, err := node.Config.Bytes()
-			if err != nil {
-				return err
-			}
-
-			_, err = c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
-				Data: cfgBytes,
-			})
-			if err != nil {
-				return retry.ExpectedError(err)
-			}
-
-			return nil
+		cfgBytes, err := node.Config.Bytes()
+		if err != nil {
+			return err
 		}
 
-		if err := retry.Constant(2*time.Minute, retry.WithUnits(250*time.Millisecond), retry.WithJitter(50*time.Millisecond)).Retry(configureNode); err != nil {
+		if err = ApplyConfigInsecure(ctx, ApplyConfigEndpoint(node, sl, out), cfgBytes); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// ApplyConfigEndpoint returns the endpoint to apply the config to the node running in maintenance mode.
+func ApplyConfigEndpoint(node provision.NodeRequest, sl provision.SiderolinkRequest, out io.Writer) string {
+	if addr, ok := sl.GetAddr(node.UUID); ok {
+		fmt.Fprintln(out, "using SideroLink node address for 'with-apply-config'", node.UUID, "=", addr.String())
+
+		return addr.String()
+	}
+
+	return node.IPs[0].String()
+}
+
+// ApplyConfigInsecure applies the config to the node running in maintenance mode via the insecure API.
+//
+// The config is applied with retries, as the node might be still booting.
+func ApplyConfigInsecure(ctx context.Context, endpoint string, cfgBytes []byte) error {
+	configureNode := func() error {
+		c, err := client.New(ctx, client.WithTLSConfig(&tls.Config{
+			InsecureSkipVerify: true,
+		}), client.WithEndpoints(endpoint))
+		if err != nil {
+			return err
+		}
+
+		_, err = c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
+			Data: cfgBytes,
+		})
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		return nil
+	}
+
+	return retry.Constant(2*time.Minute, retry.WithUnits(250*time.Millisecond), retry.WithJitter(50*time.Millisecond)).Retry(configureNode)
+}
+
+// ConfigAppliedAuthenticated returns the function which checks whether the config was already applied to the node.
+//
+// The node running in maintenance mode has a self-signed certificate, so it is only reachable via the insecure API,
+// while the node with the config applied is reachable via the API authenticated with the cluster credentials.
+func ConfigAppliedAuthenticated(clientProvider ClientProvider) func(ctx context.Context, endpoint string) bool {
+	return func(ctx context.Context, endpoint string) bool {
+		c, err := clientProvider.Client(endpoint)
+		if err != nil {
+			return false
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		_, err = c.Version(ctx)
+
+		return err == nil
+	}
+}
@@ -0,0 +1,223 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// Cluster bring-up steps, in the order of execution.
+const (
+	BringUpStepApplyConfig   = "apply-config"
+	BringUpStepBootstrapEtcd = "bootstrap-etcd"
+	BringUpStepWaitHealthy   = "wait-healthy"
+)
+
+// BringUpSteps is the list of the cluster bring-up steps.
+var BringUpSteps = []string{
+	BringUpStepApplyConfig,
+	BringUpStepBootstrapEtcd,
+	BringUpStepWaitHealthy,
+}
+
+// BringUpNode is a node started in maintenance mode which gets the machine config applied.
+type BringUpNode struct {
+	Name     string `yaml:"name"`
+	Endpoint string `yaml:"endpoint"`
+	Config   string `yaml:"config"`
+}
+
+// BringUpCheckpoint is the persisted plan and progress of the cluster bring-up.
+//
+// The plan (the nodes to apply the config to, whether to bootstrap etcd and to wait for the cluster to be healthy)
+// is recorded when the bring-up starts, so that the resumed bring-up performs the same steps in the same order:
+// completed steps and the nodes which already got the config are skipped.
+type BringUpCheckpoint struct {
+	ClusterName      string        `yaml:"clusterName"`
+	ApplyConfigNodes []BringUpNode `yaml:"applyConfigNodes,omitempty"`
+	BootstrapEtcd    bool          `yaml:"bootstrapEtcd"`
+	WaitHealthy      bool          `yaml:"waitHealthy"`
+	AppliedNodes     []string      `yaml:"appliedNodes,omitempty"`
+	CompletedSteps   []string      `yaml:"completedSteps"`
+}
+
+// Completed returns true if the step was completed.
+func (checkpoint *BringUpCheckpoint) Completed(step string) bool {
+	return slices.Contains(checkpoint.CompletedSteps, step)
+}
+
+// Done returns true if all the steps were completed.
+func (checkpoint *BringUpCheckpoint) Done() bool {
+	return !slices.ContainsFunc(BringUpSteps, func(step string) bool { return !checkpoint.Completed(step) })
+}
+
+// LoadBringUpCheckpoint loads the checkpoint from the file.
+//
+// If the file doesn't exist, nil checkpoint is returned.
+func LoadBringUpCheckpoint(path string) (*BringUpCheckpoint, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var checkpoint BringUpCheckpoint
+
+	if err = yaml.Unmarshal(contents, &checkpoint); err != nil {
+		return nil, fmt.Errorf("error decoding checkpoint %q: %w", path, err)
+	}
+
+	return &checkpoint, nil
+}
+
+// Save writes the checkpoint to the file.
+//
+// The checkpoint contains the machine configs, so the file is only readable by the owner.
+func (checkpoint *BringUpCheckpoint) Save(path string) error {
+	contents, err := yaml.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, contents, 0o600)
+}
+
+// BringUp brings up the provisioned cluster.
+//
+// The bring-up is performed step by step:
+//   - apply the machine config to the nodes running in maintenance mode, one node at a time
+//   - bootstrap etcd on the first control plane node (unless the cluster has an init node)
+//   - wait for the cluster to be healthy (including the CNI)
+//
+// The progress is recorded in the checkpoint file after each step (and after each node the config is applied to),
+// and the interrupted bring-up can be resumed from the checkpoint.
+type BringUp struct {
+	// Bootstrapper bootstraps etcd.
+	Bootstrapper Bootstrapper
+	// WaitHealthy waits for the cluster to be healthy.
+	WaitHealthy func(ctx context.Context, out io.Writer) error
+	// ApplyConfig applies the config to the node running in maintenance mode, defaults to ApplyConfigInsecure.
+	ApplyConfig func(ctx context.Context, endpoint string, cfg []byte) error
+	// ConfigApplied checks whether the config was already applied to the node (see ConfigAppliedAuthenticated).
+	//
+	// The bring-up might be interrupted after the node accepted the config, but before the checkpoint is saved,
+	// and the node doesn't accept the config via the insecure API anymore.
+	ConfigApplied func(ctx context.Context, endpoint string) bool
+	// CheckpointPath is the path to the checkpoint file.
+	CheckpointPath string
+}
+
+// Run performs (or resumes) the bring-up according to the checkpoint.
+func (bringUp *BringUp) Run(ctx context.Context, checkpoint *BringUpCheckpoint, out io.Writer) error {
+	if bringUp.ApplyConfig == nil {
+		bringUp.ApplyConfig = ApplyConfigInsecure
+	}
+
+	// record the plan before the first step
+	if err := checkpoint.Save(bringUp.CheckpointPath); err != nil {
+		return fmt.Errorf("error saving checkpoint: %w", err)
+	}
+
+	steps := map[string]func(context.Context, *BringUpCheckpoint, io.Writer) error{
+		BringUpStepApplyConfig:   bringUp.applyConfig,
+		BringUpStepBootstrapEtcd: bringUp.bootstrapEtcd,
+		BringUpStepWaitHealthy:   bringUp.waitHealthy,
+	}
+
+	for _, step := range BringUpSteps {
+		if checkpoint.Completed(step) {
+			fmt.Fprintf(out, "%s: already completed, skipping\n", step)
+
+			continue
+		}
+
+		if err := steps[step](ctx, checkpoint, out); err != nil {
+			return fmt.Errorf("step %q failed (the bring-up can be resumed): %w", step, err)
+		}
+
+		checkpoint.CompletedSteps = append(checkpoint.CompletedSteps, step)
+
+		if err := checkpoint.Save(bringUp.CheckpointPath); err != nil {
+			return fmt.Errorf("error saving checkpoint: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (bringUp *BringUp) applyConfig(ctx context.Context, checkpoint *BringUpCheckpoint, out io.Writer) error {
+	for _, node := range checkpoint.ApplyConfigNodes {
+		if slices.Contains(checkpoint.AppliedNodes, node.Name) {
+			fmt.Fprintf(out, "%s: config is already applied to %q, skipping\n", BringUpStepApplyConfig, node.Name)
+
+			continue
+		}
+
+		if bringUp.configApplied(ctx, node.Endpoint) {
+			fmt.Fprintf(out, "%s: %q is already running with the config applied, skipping\n", BringUpStepApplyConfig, node.Name)
+		} else {
+			fmt.Fprintf(out, "%s: applying config to %q\n", BringUpStepApplyConfig, node.Name)
+
+			// the node might have been still rebooting with the config applied during the check above
+			if err := bringUp.ApplyConfig(ctx, node.Endpoint, []byte(node.Config)); err != nil && !bringUp.configApplied(ctx, node.Endpoint) {
+				return fmt.Errorf("error applying config to %q: %w", node.Name, err)
+			}
+		}
+
+		checkpoint.AppliedNodes = append(checkpoint.AppliedNodes, node.Name)
+
+		if err := checkpoint.Save(bringUp.CheckpointPath); err != nil {
+			return fmt.Errorf("error saving checkpoint: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (bringUp *BringUp) configApplied(ctx context.Context, endpoint string) bool {
+	return bringUp.ConfigApplied != nil && bringUp.ConfigApplied(ctx, endpoint)
+}
+
+func (bringUp *BringUp) bootstrapEtcd(ctx context.Context, checkpoint *BringUpCheckpoint, out io.Writer) error {
+	if !checkpoint.BootstrapEtcd {
+		return nil
+	}
+
+	fmt.Fprintf(out, "%s: running\n", BringUpStepBootstrapEtcd)
+
+	err := bringUp.Bootstrapper.Bootstrap(ctx, out)
+
+	// the bring-up might have been interrupted after the bootstrap call succeeded
+	if client.StatusCode(err) == codes.AlreadyExists {
+		fmt.Fprintf(out, "%s: etcd is already bootstrapped\n", BringUpStepBootstrapEtcd)
+
+		return nil
+	}
+
+	return err
+}
+
+func (bringUp *BringUp) waitHealthy(ctx context.Context, checkpoint *BringUpCheckpoint, out io.Writer) error {
+	if !checkpoint.WaitHealthy || bringUp.WaitHealthy == nil {
+		return nil
+	}
+
+	fmt.Fprintf(out, "%s: running\n", BringUpStepWaitHealthy)
+
+	return bringUp.WaitHealthy(ctx, out)
+}
@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/cluster"
+)
+
+type mockBootstrapper struct {
+	calls int
+	err   error
+}
+
+func (bootstrapper *mockBootstrapper) Bootstrap(context.Context, io.Writer) error {
+	bootstrapper.calls++
+
+	return bootstrapper.err
+}
+
+func TestBringUpResume(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "bringup.yaml")
+
+	var (
+		applied     []string
+		failOn      = "worker-1"
+		healthCalls int
+	)
+
+	bootstrapper := &mockBootstrapper{}
+
+	bringUp := cluster.BringUp{
+		Bootstrapper: bootstrapper,
+		WaitHealthy: func(context.Context, io.Writer) error {
+			healthCalls++
+
+			return nil
+		},
+		ApplyConfig: func(_ context.Context, endpoint string, _ []byte) error {
+			if endpoint == failOn {
+				return errors.New("connection refused")
+			}
+
+			applied = append(applied, endpoint)
+
+			return nil
+		},
+		CheckpointPath: path,
+	}
+
+	checkpoint := &cluster.BringUpCheckpoint{
+		ClusterName: "test",
+		ApplyConfigNodes: []cluster.BringUpNode{
+			{Name: "cp-1", Endpoint: "cp-1", Config: "version: v1alpha1\n"},
+			{Name: "worker-1", Endpoint: "worker-1", Config: "version: v1alpha1\n"},
+		},
+		BootstrapEtcd: true,
+		WaitHealthy:   true,
+	}
+
+	require.ErrorContains(t, bringUp.Run(context.Background(), checkpoint, io.Discard), `step "apply-config" failed`)
+
+	loaded, err := cluster.LoadBringUpCheckpoint(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"cp-1"}, loaded.AppliedNodes)
+	assert.Empty(t, loaded.CompletedSteps)
+	assert.False(t, loaded.Done())
+
+	// etcd was bootstrapped by the interrupted run
+	failOn = ""
+	bootstrapper.err = status.Error(codes.AlreadyExists, "etcd data directory is not empty")
+
+	require.NoError(t, bringUp.Run(context.Background(), loaded, io.Discard))
+
+	assert.Equal(t, []string{"cp-1", "worker-1"}, applied)
+	assert.Equal(t, 1, bootstrapper.calls)
+	assert.Equal(t, 1, healthCalls)
+
+	loaded, err = cluster.LoadBringUpCheckpoint(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, cluster.BringUpSteps, loaded.CompletedSteps)
+	assert.True(t, loaded.Done())
+
+	// completed bring-up is a no-op
+	require.NoError(t, bringUp.Run(context.Background(), loaded, io.Discard))
+
+	assert.Equal(t, 1, bootstrapper.calls)
+	assert.Equal(t, 1, healthCalls)
+}
+
+func TestBringUpResumeAfterConfigAccepted(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "bringup.yaml")
+
+	var (
+		// configured nodes only accept the authenticated API
+		configured  = map[string]bool{}
+		interruptOn = "worker-1"
+		applyCalls  int
+	)
+
+	bringUp := cluster.BringUp{
+		Bootstrapper: &mockBootstrapper{},
+		ApplyConfig: func(_ context.Context, endpoint string, _ []byte) error {
+			applyCalls++
+
+			if configured[endpoint] {
+				return errors.New("tls: certificate required")
+			}
+
+			configured[endpoint] = true
+
+			// the node accepted the config, but the bring-up was interrupted before the checkpoint was saved
+			if endpoint == interruptOn {
+				return context.Canceled
+			}
+
+			return nil
+		},
+		ConfigApplied: func(_ context.Context, endpoint string) bool {
+			return configured[endpoint] && endpoint != interruptOn
+		},
+		CheckpointPath: path,
+	}
+
+	checkpoint := &cluster.BringUpCheckpoint{
+		ClusterName: "test",
+		ApplyConfigNodes: []cluster.BringUpNode{
+			{Name: "cp-1", Endpoint: "cp-1", Config: "version: v1alpha1\n"},
+			{Name: "worker-1", Endpoint: "worker-1", Config: "version: v1alpha1\n"},
+		},
+	}
+
+	require.ErrorIs(t, bringUp.Run(context.Background(), checkpoint, io.Discard), context.Canceled)
+
+	loaded, err := cluster.LoadBringUpCheckpoint(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"cp-1"}, loaded.AppliedNodes)
+	assert.Equal(t, 2, applyCalls)
+
+	// the node is now reachable via the authenticated API only
+	interruptOn = ""
+
+	require.NoError(t, bringUp.Run(context.Background(), loaded, io.Discard))
+
+	// the config is not applied again
+	assert.Equal(t, 2, applyCalls)
+
+	loaded, err = cluster.LoadBringUpCheckpoint(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"cp-1", "worker-1"}, loaded.AppliedNodes)
+	assert.True(t, loaded.Done())
+}
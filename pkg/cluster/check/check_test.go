@@ -4,11 +4,77 @@
 
 package check_test
 
-import "testing"
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/pkg/cluster/check"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+func checkNames(checks []check.ClusterCheck) []string {
+	return xslices.Map(checks, func(c check.ClusterCheck) string {
+		return fmt.Sprint(c(nil))
+	})
+}
+
+func TestClusterChecksForCNI(t *testing.T) {
+	t.Parallel()
+
+	defaultChecks := checkNames(check.DefaultClusterChecks())
+
+	for _, test := range []struct {
+		name      string
+		readiness *v1alpha1.CNIReadinessConfig
+
+		expected []string
+	}{
+		{
+			name:     "nil",
+			expected: defaultChecks,
+		},
+		{
+			name: "default",
+			readiness: &v1alpha1.CNIReadinessConfig{
+				ReadinessMode: constants.CNIReadinessDefault,
+			},
+			expected: defaultChecks,
+		},
+		{
+			name: "disabled",
+			readiness: &v1alpha1.CNIReadinessConfig{
+				ReadinessMode: constants.CNIReadinessDisabled,
+			},
+			expected: slices.Concat(checkNames(check.PreBootSequenceChecks()), checkNames(check.K8sComponentsReadinessChecks())),
+		},
+		{
+			name: "custom",
+			readiness: &v1alpha1.CNIReadinessConfig{
+				ReadinessMode:       constants.CNIReadinessCustom,
+				ReadinessDaemonSets: []string{"kube-system/cilium", "kube-system/cilium-envoy"},
+				ReadinessTimeout:    time.Hour,
+			},
+			expected: slices.Concat(
+				checkNames(check.PreBootSequenceChecks()),
+				checkNames(check.K8sComponentsReadinessChecks()),
+				[]string{
+					"CNI DaemonSet kube-system/cilium to be ready: ...",
+					"CNI DaemonSet kube-system/cilium-envoy to be ready: ...",
+				},
+				checkNames(check.K8sNodesReadinessChecks()),
+			),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, checkNames(check.ClusterChecksForCNI(test.readiness)))
+		})
+	}
 }
@@ -6,11 +6,17 @@ package check
 
 import (
 	"context"
+	"fmt"
 	"slices"
+	"strings"
 	"time"
 
+	"github.com/siderolabs/gen/xslices"
+
 	"github.com/siderolabs/talos/pkg/conditions"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // DefaultClusterChecks returns a set of default Talos cluster readiness checks.
@@ -18,56 +24,97 @@ func DefaultClusterChecks() []ClusterCheck {
 	return slices.Concat(
 		PreBootSequenceChecks(),
 		K8sComponentsReadinessChecks(),
-		[]ClusterCheck{
-			// wait for all the nodes to report ready at k8s level
-			func(cluster ClusterInfo) conditions.Condition {
-				return conditions.PollingCondition("all k8s nodes to report ready", func(ctx context.Context) error {
-					return K8sAllNodesReadyAssertion(ctx, cluster)
-				}, 10*time.Minute, 5*time.Second)
-			},
-
-			// wait for kube-proxy to report ready
-			func(cluster ClusterInfo) conditions.Condition {
-				return conditions.PollingCondition("kube-proxy to report ready", func(ctx context.Context) error {
-					present, replicas, err := DaemonSetPresent(ctx, cluster, "kube-system", "k8s-app=kube-proxy")
-					if err != nil {
-						return err
-					}
-
-					if !present {
-						return conditions.ErrSkipAssertion
-					}
-
-					return K8sPodReadyAssertion(ctx, cluster, replicas, "kube-system", "k8s-app=kube-proxy")
-				}, 5*time.Minute, 5*time.Second)
-			},
-
-			// wait for coredns to report ready
-			func(cluster ClusterInfo) conditions.Condition {
-				return conditions.PollingCondition("coredns to report ready", func(ctx context.Context) error {
-					present, replicas, err := DeploymentPresent(ctx, cluster, "kube-system", "k8s-app=kube-dns")
-					if err != nil {
-						return err
-					}
-
-					if !present {
-						return conditions.ErrSkipAssertion
-					}
-
-					return K8sPodReadyAssertion(ctx, cluster, replicas, "kube-system", "k8s-app=kube-dns")
-				}, 5*time.Minute, 5*time.Second)
-			},
-
-			// wait for all the nodes to be schedulable
-			func(cluster ClusterInfo) conditions.Condition {
-				return conditions.PollingCondition("all k8s nodes to report schedulable", func(ctx context.Context) error {
-					return K8sAllNodesSchedulableAssertion(ctx, cluster)
-				}, 5*time.Minute, 5*time.Second)
-			},
-		},
+		K8sNodesReadinessChecks(),
 	)
 }
 
+// ClusterChecksForCNI returns a set of default Talos cluster readiness checks adjusted for the CNI readiness configuration.
+//
+// If the CNI is installed outside of Talos, the checks which depend on the CNI are either skipped ("disabled" mode),
+// or run once the CNI DaemonSets are ready ("custom" mode).
+func ClusterChecksForCNI(readiness config.CNIReadiness) []ClusterCheck {
+	switch readiness.Mode() {
+	case constants.CNIReadinessDisabled:
+		return slices.Concat(
+			PreBootSequenceChecks(),
+			K8sComponentsReadinessChecks(),
+		)
+	case constants.CNIReadinessCustom:
+		return slices.Concat(
+			PreBootSequenceChecks(),
+			K8sComponentsReadinessChecks(),
+			CNIReadinessChecks(readiness),
+			K8sNodesReadinessChecks(),
+		)
+	default:
+		return DefaultClusterChecks()
+	}
+}
+
+// CNIReadinessChecks returns a set of checks which wait for the CNI DaemonSets to be ready.
+func CNIReadinessChecks(readiness config.CNIReadiness) []ClusterCheck {
+	return xslices.Map(readiness.DaemonSets(), func(ds string) ClusterCheck {
+		namespace, name, _ := strings.Cut(ds, "/")
+
+		return func(cluster ClusterInfo) conditions.Condition {
+			return conditions.PollingCondition(fmt.Sprintf("CNI DaemonSet %s to be ready", ds), func(ctx context.Context) error {
+				return K8sDaemonSetReadyAssertion(ctx, cluster, namespace, name)
+			}, readiness.Timeout(), 5*time.Second)
+		}
+	})
+}
+
+// K8sNodesReadinessChecks returns a set of K8s cluster readiness checks which depend on the CNI being up and running.
+func K8sNodesReadinessChecks() []ClusterCheck {
+	return []ClusterCheck{
+		// wait for all the nodes to report ready at k8s level
+		func(cluster ClusterInfo) conditions.Condition {
+			return conditions.PollingCondition("all k8s nodes to report ready", func(ctx context.Context) error {
+				return K8sAllNodesReadyAssertion(ctx, cluster)
+			}, 10*time.Minute, 5*time.Second)
+		},
+
+		// wait for kube-proxy to report ready
+		func(cluster ClusterInfo) conditions.Condition {
+			return conditions.PollingCondition("kube-proxy to report ready", func(ctx context.Context) error {
+				present, replicas, err := DaemonSetPresent(ctx, cluster, "kube-system", "k8s-app=kube-proxy")
+				if err != nil {
+					return err
+				}
+
+				if !present {
+					return conditions.ErrSkipAssertion
+				}
+
+				return K8sPodReadyAssertion(ctx, cluster, replicas, "kube-system", "k8s-app=kube-proxy")
+			}, 5*time.Minute, 5*time.Second)
+		},
+
+		// wait for coredns to report ready
+		func(cluster ClusterInfo) conditions.Condition {
+			return conditions.PollingCondition("coredns to report ready", func(ctx context.Context) error {
+				present, replicas, err := DeploymentPresent(ctx, cluster, "kube-system", "k8s-app=kube-dns")
+				if err != nil {
+					return err
+				}
+
+				if !present {
+					return conditions.ErrSkipAssertion
+				}
+
+				return K8sPodReadyAssertion(ctx, cluster, replicas, "kube-system", "k8s-app=kube-dns")
+			}, 5*time.Minute, 5*time.Second)
+		},
+
+		// wait for all the nodes to be schedulable
+		func(cluster ClusterInfo) conditions.Condition {
+			return conditions.PollingCondition("all k8s nodes to report schedulable", func(ctx context.Context) error {
+				return K8sAllNodesSchedulableAssertion(ctx, cluster)
+			}, 5*time.Minute, 5*time.Second)
+		},
+	}
+}
+
 // K8sComponentsReadinessChecks returns a set of K8s cluster readiness checks which are specific to the k8s components
 // being up and running. This test can be skipped if the cluster is set to use a custom CNI, as the checks won't be healthy
 // until the CNI is up and running.
@@ -377,6 +377,32 @@ func DaemonSetPresent(ctx context.Context, cluster cluster.K8sProvider, namespac
 	return true, int(dss.Items[0].Status.DesiredNumberScheduled), nil
 }
 
+// K8sDaemonSetReadyAssertion checks whether the DaemonSet is scheduled on the nodes, and all its pods are up to date and ready.
+func K8sDaemonSetReadyAssertion(ctx context.Context, cluster cluster.K8sProvider, namespace, name string) error {
+	clientset, err := cluster.K8sClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	ds, err := clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	status := ds.Status
+
+	if status.DesiredNumberScheduled == 0 {
+		return fmt.Errorf("DaemonSet %s/%s is not scheduled on any node", namespace, name)
+	}
+
+	if status.UpdatedNumberScheduled != status.DesiredNumberScheduled || status.NumberReady != status.DesiredNumberScheduled {
+		return fmt.Errorf("DaemonSet %s/%s is not ready: desired %d, updated %d, ready %d",
+			namespace, name, status.DesiredNumberScheduled, status.UpdatedNumberScheduled, status.NumberReady)
+	}
+
+	return nil
+}
+
 // DeploymentPresent returns true if there is at least one ReplicaSet matching given label selector.
 func DeploymentPresent(ctx context.Context, cluster cluster.K8sProvider, namespace, labelSelector string) (bool, int, error) {
 	clientset, err := cluster.K8sClient(ctx)
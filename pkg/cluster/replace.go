@@ -0,0 +1,344 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"gopkg.in/yaml.v3"
+
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Control plane node replacement steps, in the order of execution.
+const (
+	ReplaceStepCaptureConfig = "capture-config"
+	ReplaceStepResetOldNode  = "reset-old-node"
+	ReplaceStepRemoveMember  = "remove-etcd-member"
+	ReplaceStepJoinNewNode   = "join-new-node"
+	ReplaceStepWaitMember    = "wait-etcd-member"
+)
+
+// ReplaceSteps is the list of the control plane node replacement steps.
+var ReplaceSteps = []string{
+	ReplaceStepCaptureConfig,
+	ReplaceStepResetOldNode,
+	ReplaceStepRemoveMember,
+	ReplaceStepJoinNewNode,
+	ReplaceStepWaitMember,
+}
+
+// ReplaceCheckpoint is the persisted progress of the control plane node replacement.
+//
+// The checkpoint allows to resume the replacement after a failure: completed steps are skipped.
+type ReplaceCheckpoint struct {
+	ViaNode        string   `yaml:"viaNode"`
+	OldNode        string   `yaml:"oldNode"`
+	NewNode        string   `yaml:"newNode"`
+	Config         string   `yaml:"config,omitempty"`
+	ConfigSource   string   `yaml:"configSource,omitempty"`
+	CompletedSteps []string `yaml:"completedSteps"`
+}
+
+// Completed returns true if the step was completed.
+func (checkpoint *ReplaceCheckpoint) Completed(step string) bool {
+	return slices.Contains(checkpoint.CompletedSteps, step)
+}
+
+// LoadReplaceCheckpoint loads the checkpoint from the file.
+//
+// If the file doesn't exist, nil checkpoint is returned.
+func LoadReplaceCheckpoint(path string) (*ReplaceCheckpoint, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var checkpoint ReplaceCheckpoint
+
+	if err = yaml.Unmarshal(contents, &checkpoint); err != nil {
+		return nil, fmt.Errorf("error decoding checkpoint %q: %w", path, err)
+	}
+
+	return &checkpoint, nil
+}
+
+// Save writes the checkpoint to the file.
+//
+// The checkpoint contains the machine config, so the file is only readable by the owner.
+func (checkpoint *ReplaceCheckpoint) Save(path string) error {
+	contents, err := yaml.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, contents, 0o600)
+}
+
+// ControlPlaneReplacer replaces a control plane node with a new node.
+//
+// The replacement is performed step by step:
+//   - capture the machine config of the old node (or of the healthy control plane node if the old node is not reachable)
+//   - gracefully reset the old node (which makes it leave etcd)
+//   - remove the old node etcd member if it is still registered
+//   - apply the captured config to the new node running in maintenance mode
+//   - wait for the new node to join etcd as a voting member
+//
+// The progress is recorded in the checkpoint file after each step, and the replacement can be resumed.
+type ControlPlaneReplacer struct {
+	// Client is the Talos client for the cluster.
+	Client *client.Client
+	// ViaNode is a healthy control plane node which stays in the cluster.
+	ViaNode string
+	// OldNode is the address of the control plane node being replaced.
+	OldNode string
+	// NewNode is the address of the replacement node running in maintenance mode.
+	NewNode string
+	// CheckpointPath is the path to the checkpoint file.
+	CheckpointPath string
+	// JoinTimeout is the timeout for the new node to join etcd.
+	JoinTimeout time.Duration
+
+	checkpoint *ReplaceCheckpoint
+}
+
+// Run performs (or resumes) the replacement.
+func (replacer *ControlPlaneReplacer) Run(ctx context.Context, out io.Writer) error {
+	checkpoint, err := LoadReplaceCheckpoint(replacer.CheckpointPath)
+	if err != nil {
+		return err
+	}
+
+	if checkpoint == nil {
+		checkpoint = &ReplaceCheckpoint{
+			ViaNode: replacer.ViaNode,
+			OldNode: replacer.OldNode,
+			NewNode: replacer.NewNode,
+		}
+	} else if checkpoint.OldNode != replacer.OldNode || checkpoint.NewNode != replacer.NewNode {
+		return fmt.Errorf("checkpoint %q is for replacing %q with %q, remove it to start over", replacer.CheckpointPath, checkpoint.OldNode, checkpoint.NewNode)
+	}
+
+	replacer.checkpoint = checkpoint
+
+	if replacer.JoinTimeout == 0 {
+		replacer.JoinTimeout = 15 * time.Minute
+	}
+
+	steps := map[string]func(context.Context, io.Writer) error{
+		ReplaceStepCaptureConfig: replacer.captureConfig,
+		ReplaceStepResetOldNode:  replacer.resetOldNode,
+		ReplaceStepRemoveMember:  replacer.removeMember,
+		ReplaceStepJoinNewNode:   replacer.joinNewNode,
+		ReplaceStepWaitMember:    replacer.waitMember,
+	}
+
+	for _, step := range ReplaceSteps {
+		if checkpoint.Completed(step) {
+			fmt.Fprintf(out, "%s: already completed, skipping\n", step)
+
+			continue
+		}
+
+		fmt.Fprintf(out, "%s: running\n", step)
+
+		if err = steps[step](ctx, out); err != nil {
+			return fmt.Errorf("step %q failed (the replacement can be resumed): %w", step, err)
+		}
+
+		checkpoint.CompletedSteps = append(checkpoint.CompletedSteps, step)
+
+		if err = checkpoint.Save(replacer.CheckpointPath); err != nil {
+			return fmt.Errorf("error saving checkpoint: %w", err)
+		}
+
+		fmt.Fprintf(out, "%s: done\n", step)
+	}
+
+	return nil
+}
+
+func (replacer *ControlPlaneReplacer) readConfig(ctx context.Context, node string) ([]byte, error) {
+	r, err := replacer.Client.Read(client.WithNode(ctx, node), constants.ConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close() //nolint:errcheck
+
+	cfg, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, r.Close()
+}
+
+func (replacer *ControlPlaneReplacer) captureConfig(ctx context.Context, out io.Writer) error {
+	cfg, err := replacer.readConfig(ctx, replacer.OldNode)
+	if err == nil {
+		replacer.checkpoint.Config = string(cfg)
+		replacer.checkpoint.ConfigSource = replacer.OldNode
+
+		return nil
+	}
+
+	fmt.Fprintf(out, "failed to read the config from the old node %q (%s), using the config of %q\n", replacer.OldNode, err, replacer.ViaNode)
+	fmt.Fprintln(out, "WARNING: node-specific settings (hostname, static addresses) of the captured config should be verified")
+
+	cfg, err = replacer.readConfig(ctx, replacer.ViaNode)
+	if err != nil {
+		return fmt.Errorf("error reading config from %q: %w", replacer.ViaNode, err)
+	}
+
+	replacer.checkpoint.Config = string(cfg)
+	replacer.checkpoint.ConfigSource = replacer.ViaNode
+
+	return nil
+}
+
+func (replacer *ControlPlaneReplacer) resetOldNode(ctx context.Context, out io.Writer) error {
+	err := replacer.Client.ResetGeneric(client.WithNode(ctx, replacer.OldNode), &machineapi.ResetRequest{
+		Graceful: true,
+		Reboot:   false,
+	})
+	if err != nil {
+		// the old node might be dead already, the etcd member is removed on the next step
+		fmt.Fprintf(out, "failed to reset the old node %q, continuing: %s\n", replacer.OldNode, err)
+	}
+
+	return nil
+}
+
+func (replacer *ControlPlaneReplacer) etcdMembers(ctx context.Context) ([]*machineapi.EtcdMember, error) {
+	resp, err := replacer.Client.EtcdMemberList(client.WithNode(ctx, replacer.ViaNode), &machineapi.EtcdMemberListRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*machineapi.EtcdMember
+
+	for _, msg := range resp.GetMessages() {
+		members = append(members, msg.GetMembers()...)
+	}
+
+	return members, nil
+}
+
+func (replacer *ControlPlaneReplacer) removeMember(ctx context.Context, out io.Writer) error {
+	// graceful reset leaves etcd asynchronously, so retry until the member is gone or removed
+	return retry.Constant(time.Minute, retry.WithUnits(5*time.Second)).RetryWithContext(ctx, func(ctx context.Context) error {
+		members, err := replacer.etcdMembers(ctx)
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		for _, member := range members {
+			if !EtcdMemberMatches(member, replacer.OldNode) {
+				continue
+			}
+
+			fmt.Fprintf(out, "removing etcd member %q (%x)\n", member.GetHostname(), member.GetId())
+
+			if err = replacer.Client.EtcdRemoveMemberByID(client.WithNode(ctx, replacer.ViaNode), &machineapi.EtcdRemoveMemberByIDRequest{
+				MemberId: member.GetId(),
+			}); err != nil {
+				return retry.ExpectedError(err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (replacer *ControlPlaneReplacer) joinNewNode(ctx context.Context, out io.Writer) error {
+	c, err := client.New(ctx, client.WithTLSConfig(&tls.Config{
+		InsecureSkipVerify: true,
+	}), client.WithEndpoints(replacer.NewNode))
+	if err != nil {
+		return err
+	}
+
+	defer c.Close() //nolint:errcheck
+
+	fmt.Fprintf(out, "applying the config captured from %q to %q\n", replacer.checkpoint.ConfigSource, replacer.NewNode)
+
+	return retry.Constant(2*time.Minute, retry.WithUnits(time.Second)).RetryWithContext(ctx, func(ctx context.Context) error {
+		if _, err := c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
+			Data: []byte(replacer.checkpoint.Config),
+		}); err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		return nil
+	})
+}
+
+func (replacer *ControlPlaneReplacer) waitMember(ctx context.Context, out io.Writer) error {
+	fmt.Fprintf(out, "waiting for %q to join etcd\n", replacer.NewNode)
+
+	return retry.Constant(replacer.JoinTimeout, retry.WithUnits(5*time.Second)).RetryWithContext(ctx, func(ctx context.Context) error {
+		members, err := replacer.etcdMembers(ctx)
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		for _, member := range members {
+			if !EtcdMemberMatches(member, replacer.NewNode) {
+				continue
+			}
+
+			if member.GetIsLearner() {
+				return retry.ExpectedErrorf("etcd member %q is still a learner", member.GetHostname())
+			}
+
+			return nil
+		}
+
+		return retry.ExpectedErrorf("etcd member for %q is not registered yet", replacer.NewNode)
+	})
+}
+
+// EtcdMemberMatches returns true if the etcd member belongs to the node (matched by hostname or peer URL host).
+func EtcdMemberMatches(member *machineapi.EtcdMember, node string) bool {
+	if member.GetHostname() == node {
+		return true
+	}
+
+	for _, peerURL := range member.GetPeerUrls() {
+		u, err := url.Parse(peerURL)
+		if err != nil {
+			continue
+		}
+
+		host := u.Hostname()
+
+		if host == node {
+			return true
+		}
+
+		if ip := net.ParseIP(host); ip != nil && ip.Equal(net.ParseIP(node)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/cluster"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func TestEtcdMemberMatches(t *testing.T) {
+	t.Parallel()
+
+	member := &machineapi.EtcdMember{
+		Hostname: "cp-1",
+		PeerUrls: []string{"https://10.5.0.2:2380", "https://[2001:db8::2]:2380"},
+	}
+
+	assert.True(t, cluster.EtcdMemberMatches(member, "cp-1"))
+	assert.True(t, cluster.EtcdMemberMatches(member, "10.5.0.2"))
+	assert.True(t, cluster.EtcdMemberMatches(member, "2001:db8:0::2"))
+	assert.False(t, cluster.EtcdMemberMatches(member, "10.5.0.3"))
+	assert.False(t, cluster.EtcdMemberMatches(member, "cp-2"))
+}
+
+func TestReplaceCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "checkpoint.yaml")
+
+	checkpoint, err := cluster.LoadReplaceCheckpoint(path)
+	require.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	checkpoint = &cluster.ReplaceCheckpoint{
+		ViaNode:        "10.5.0.2",
+		OldNode:        "10.5.0.3",
+		NewNode:        "10.5.0.9",
+		Config:         "version: v1alpha1\n",
+		CompletedSteps: []string{cluster.ReplaceStepCaptureConfig},
+	}
+
+	require.NoError(t, checkpoint.Save(path))
+
+	loaded, err := cluster.LoadReplaceCheckpoint(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, checkpoint, loaded)
+	assert.True(t, loaded.Completed(cluster.ReplaceStepCaptureConfig))
+	assert.False(t, loaded.Completed(cluster.ReplaceStepJoinNewNode))
+}
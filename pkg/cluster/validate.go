@@ -0,0 +1,223 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/siderolabs/crypto/x509"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+)
+
+// NodeConfig is a machine configuration of a single cluster node.
+type NodeConfig struct {
+	// Name identifies the node in the validation errors (e.g. node address or config file name).
+	Name   string
+	Config config.Provider
+}
+
+// ValidateConfigs checks the cross-node invariants of the machine configurations of the cluster nodes.
+//
+// The configs are expected to pass the per-node validation; ValidateConfigs only verifies that the nodes
+// agree on the cluster secrets, the control plane endpoint and the cluster network settings, and that
+// the node addresses don't overlap with the pod and service CIDRs.
+// Values which are not present in the config (e.g. control plane secrets in the worker configs) are skipped.
+func ValidateConfigs(nodes []NodeConfig) error {
+	var errs error
+
+	for _, node := range nodes {
+		if node.Config == nil || node.Config.Machine() == nil || node.Config.Cluster() == nil {
+			errs = errors.Join(errs, fmt.Errorf("node %q: machine configuration is missing", node.Name))
+		}
+	}
+
+	if errs != nil {
+		return errs
+	}
+
+	allNodes := func(config.Provider) bool { return true }
+	controlPlaneNodes := func(cfg config.Provider) bool { return cfg.Machine().Type().IsControlPlane() }
+
+	for _, check := range []struct {
+		name string
+		// hideValue is set for secrets and the values which are too long to be printed
+		hideValue bool
+		filter    func(config.Provider) bool
+		value     func(config.Provider) string
+	}{
+		{"cluster name", false, allNodes, func(cfg config.Provider) string { return cfg.Cluster().Name() }},
+		{"cluster ID", false, allNodes, func(cfg config.Provider) string { return cfg.Cluster().ID() }},
+		{"cluster secret", true, allNodes, func(cfg config.Provider) string { return cfg.Cluster().Secret() }},
+		{"control plane endpoint", false, allNodes, func(cfg config.Provider) string {
+			if endpoint := cfg.Cluster().Endpoint(); endpoint != nil {
+				return endpoint.String()
+			}
+
+			return ""
+		}},
+		{"bootstrap token", true, allNodes, func(cfg config.Provider) string {
+			if token := cfg.Cluster().Token(); token != nil && token.ID() != "" {
+				return token.ID() + "." + token.Secret()
+			}
+
+			return ""
+		}},
+		{"Kubernetes CA certificate", true, allNodes, func(cfg config.Provider) string { return caCert(cfg.Cluster().IssuingCA()) }},
+		{"Kubernetes CA key", true, controlPlaneNodes, func(cfg config.Provider) string { return caKey(cfg.Cluster().IssuingCA()) }},
+		{"Talos CA certificate", true, allNodes, func(cfg config.Provider) string { return caCert(cfg.Machine().Security().IssuingCA()) }},
+		{"Talos CA key", true, controlPlaneNodes, func(cfg config.Provider) string { return caKey(cfg.Machine().Security().IssuingCA()) }},
+		{"etcd CA", true, controlPlaneNodes, func(cfg config.Provider) string {
+			ca := cfg.Cluster().Etcd().CA()
+
+			return caCert(ca) + caKey(ca)
+		}},
+		{"aggregator CA", true, controlPlaneNodes, func(cfg config.Provider) string {
+			ca := cfg.Cluster().AggregatorCA()
+
+			return caCert(ca) + caKey(ca)
+		}},
+		{"service account key", true, controlPlaneNodes, func(cfg config.Provider) string {
+			if key := cfg.Cluster().ServiceAccount(); key != nil {
+				return string(key.Key)
+			}
+
+			return ""
+		}},
+		{"AESCBC encryption secret", true, controlPlaneNodes, func(cfg config.Provider) string { return cfg.Cluster().AESCBCEncryptionSecret() }},
+		{"secretbox encryption secret", true, controlPlaneNodes, func(cfg config.Provider) string { return cfg.Cluster().SecretboxEncryptionSecret() }},
+		{"pod CIDRs", false, allNodes, func(cfg config.Provider) string { return strings.Join(cfg.Cluster().Network().PodCIDRs(), ",") }},
+		{"service CIDRs", false, allNodes, func(cfg config.Provider) string { return strings.Join(cfg.Cluster().Network().ServiceCIDRs(), ",") }},
+		{"DNS domain", false, allNodes, func(cfg config.Provider) string { return cfg.Cluster().Network().DNSDomain() }},
+	} {
+		errs = errors.Join(errs, checkConsistent(nodes, check.name, check.hideValue, check.filter, check.value))
+	}
+
+	for _, node := range nodes {
+		errs = errors.Join(errs, checkNodeCIDRs(node))
+	}
+
+	return errs
+}
+
+// checkConsistent verifies that all the nodes selected by the filter have the same non-empty value.
+func checkConsistent(nodes []NodeConfig, name string, hideValue bool, filter func(config.Provider) bool, value func(config.Provider) string) error {
+	var (
+		values []string
+		groups = map[string][]string{}
+	)
+
+	for _, node := range nodes {
+		if !filter(node.Config) {
+			continue
+		}
+
+		v := value(node.Config)
+		if v == "" {
+			continue
+		}
+
+		if _, ok := groups[v]; !ok {
+			values = append(values, v)
+		}
+
+		groups[v] = append(groups[v], node.Name)
+	}
+
+	if len(values) < 2 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if hideValue {
+			descriptions = append(descriptions, fmt.Sprintf("%q", groups[v]))
+		} else {
+			descriptions = append(descriptions, fmt.Sprintf("%q on %q", v, groups[v]))
+		}
+	}
+
+	return fmt.Errorf("%s differs across nodes: %s", name, strings.Join(descriptions, " vs "))
+}
+
+// checkNodeCIDRs verifies that the pod and service CIDRs don't overlap with each other and with the node static addresses.
+func checkNodeCIDRs(node NodeConfig) error {
+	var errs error
+
+	parse := func(what string, cidrs []string) []netip.Prefix {
+		prefixes := make([]netip.Prefix, 0, len(cidrs))
+
+		for _, cidr := range cidrs {
+			prefix, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("node %q: invalid %s %q: %w", node.Name, what, cidr, err))
+
+				continue
+			}
+
+			prefixes = append(prefixes, prefix.Masked())
+		}
+
+		return prefixes
+	}
+
+	podCIDRs := parse("pod CIDR", node.Config.Cluster().Network().PodCIDRs())
+	serviceCIDRs := parse("service CIDR", node.Config.Cluster().Network().ServiceCIDRs())
+
+	for _, podCIDR := range podCIDRs {
+		for _, serviceCIDR := range serviceCIDRs {
+			if podCIDR.Overlaps(serviceCIDR) {
+				errs = errors.Join(errs, fmt.Errorf("node %q: pod CIDR %s overlaps with service CIDR %s", node.Name, podCIDR, serviceCIDR))
+			}
+		}
+	}
+
+	var addresses []string
+
+	for _, device := range node.Config.Machine().Network().Devices() {
+		addresses = append(addresses, device.Addresses()...)
+
+		for _, vlan := range device.Vlans() {
+			addresses = append(addresses, vlan.Addresses()...)
+		}
+	}
+
+	for _, address := range addresses {
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil {
+			// per-node validation reports invalid addresses
+			continue
+		}
+
+		for _, cidr := range slices.Concat(podCIDRs, serviceCIDRs) {
+			if cidr.Contains(prefix.Addr()) {
+				errs = errors.Join(errs, fmt.Errorf("node %q: address %s overlaps with cluster CIDR %s", node.Name, address, cidr))
+			}
+		}
+	}
+
+	return errs
+}
+
+func caCert(ca *x509.PEMEncodedCertificateAndKey) string {
+	if ca == nil {
+		return ""
+	}
+
+	return string(ca.Crt)
+}
+
+func caKey(ca *x509.PEMEncodedCertificateAndKey) string {
+	if ca == nil {
+		return ""
+	}
+
+	return string(ca.Key)
+}
@@ -0,0 +1,162 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/bundle"
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+)
+
+func generateBundle(t *testing.T, secretsBundle *secrets.Bundle, endpoint string, patches ...string) *bundle.Bundle {
+	t.Helper()
+
+	loadedPatches, err := configpatcher.LoadPatches(patches)
+	require.NoError(t, err)
+
+	configBundle, err := bundle.NewBundle(
+		bundle.WithInputOptions(
+			&bundle.InputOptions{
+				ClusterName: "test-cluster",
+				Endpoint:    endpoint,
+				KubeVersion: "1.31.0",
+				GenOptions:  []generate.Option{generate.WithSecretsBundle(secretsBundle)},
+			},
+		),
+		bundle.WithPatch(loadedPatches),
+		bundle.WithVerbose(false),
+	)
+	require.NoError(t, err)
+
+	return configBundle
+}
+
+func TestValidateConfigs(t *testing.T) {
+	t.Parallel()
+
+	secretsBundle, err := secrets.NewBundle(secrets.NewClock(), config.TalosVersionCurrent)
+	require.NoError(t, err)
+
+	otherSecretsBundle, err := secrets.NewBundle(secrets.NewClock(), config.TalosVersionCurrent)
+	require.NoError(t, err)
+
+	base := generateBundle(t, secretsBundle, "https://10.5.0.1:6443")
+	otherSecrets := generateBundle(t, otherSecretsBundle, "https://10.5.0.1:6443")
+	otherEndpoint := generateBundle(t, secretsBundle, "https://10.5.0.2:6443")
+	overlappingCIDRs := generateBundle(t, secretsBundle, "https://10.5.0.1:6443", `cluster:
+  network:
+    podSubnets:
+      - 10.96.0.0/16
+`)
+	addressInPodCIDR := generateBundle(t, secretsBundle, "https://10.5.0.1:6443", `machine:
+  network:
+    interfaces:
+      - interface: eth0
+        addresses:
+          - 10.244.1.1/24
+`)
+
+	for _, test := range []struct {
+		name  string
+		nodes []cluster.NodeConfig
+
+		expectedErrors []string
+	}{
+		{
+			name: "consistent",
+			nodes: []cluster.NodeConfig{
+				{Name: "cp1", Config: base.ControlPlane()},
+				{Name: "cp2", Config: base.ControlPlane()},
+				{Name: "w1", Config: base.Worker()},
+			},
+		},
+		{
+			name: "different secrets",
+			nodes: []cluster.NodeConfig{
+				{Name: "cp1", Config: base.ControlPlane()},
+				{Name: "cp2", Config: otherSecrets.ControlPlane()},
+				{Name: "w1", Config: base.Worker()},
+			},
+
+			expectedErrors: []string{
+				`cluster secret differs across nodes: ["cp1" "w1"] vs ["cp2"]`,
+				`Kubernetes CA key differs across nodes: ["cp1"] vs ["cp2"]`,
+				`service account key differs across nodes: ["cp1"] vs ["cp2"]`,
+			},
+		},
+		{
+			name: "different endpoint",
+			nodes: []cluster.NodeConfig{
+				{Name: "cp1", Config: base.ControlPlane()},
+				{Name: "w1", Config: otherEndpoint.Worker()},
+			},
+
+			expectedErrors: []string{
+				`control plane endpoint differs across nodes: "https://10.5.0.1:6443" on ["cp1"] vs "https://10.5.0.2:6443" on ["w1"]`,
+			},
+		},
+		{
+			name: "overlapping CIDRs",
+			nodes: []cluster.NodeConfig{
+				{Name: "cp1", Config: overlappingCIDRs.ControlPlane()},
+				{Name: "w1", Config: base.Worker()},
+			},
+
+			expectedErrors: []string{
+				`pod CIDRs differs across nodes: "10.96.0.0/16" on ["cp1"] vs "10.244.0.0/16" on ["w1"]`,
+				`node "cp1": pod CIDR 10.96.0.0/16 overlaps with service CIDR 10.96.0.0/12`,
+			},
+		},
+		{
+			name: "address in pod CIDR",
+			nodes: []cluster.NodeConfig{
+				{Name: "cp1", Config: addressInPodCIDR.ControlPlane()},
+			},
+
+			expectedErrors: []string{
+				`node "cp1": address 10.244.1.1/24 overlaps with cluster CIDR 10.244.0.0/16`,
+			},
+		},
+		{
+			name: "missing config",
+			nodes: []cluster.NodeConfig{
+				{Name: "cp1", Config: base.ControlPlane()},
+				{Name: "cp2"},
+			},
+
+			expectedErrors: []string{
+				`node "cp2": machine configuration is missing`,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := cluster.ValidateConfigs(test.nodes)
+
+			if len(test.expectedErrors) == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+
+			for _, expected := range test.expectedErrors {
+				assert.Contains(t, err.Error(), expected)
+			}
+
+			assert.NotContains(t, err.Error(), secretsBundle.Cluster.Secret)
+		})
+	}
+}
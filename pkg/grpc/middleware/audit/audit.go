@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package audit provides gRPC middleware recording the API calls to the audit log.
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	auditapi "github.com/siderolabs/talos/pkg/machinery/api/audit"
+)
+
+// Sink receives the audit records.
+type Sink interface {
+	// Record is called for each API call handled.
+	//
+	// Record should not block, and it should not modify the record.
+	Record(record *auditapi.Record)
+}
+
+// Middleware records the API calls to the audit sinks.
+//
+// Middleware should be installed after the authz.Injector, so that the caller identity and roles are known,
+// but before the authz.Authorizer, so that the calls which were not authorized are recorded as well.
+type Middleware struct {
+	sinks []Sink
+
+	mu     sync.Mutex
+	lastID uint64
+}
+
+// NewMiddleware creates new audit middleware.
+func NewMiddleware(sinks ...Sink) *Middleware {
+	return &Middleware{
+		sinks: sinks,
+	}
+}
+
+func (m *Middleware) record(ctx context.Context, method, request string, startTime time.Time, err error) {
+	caller := authz.GetCaller(ctx)
+	st := status.Convert(err)
+
+	record := &auditapi.Record{
+		Timestamp: timestamppb.New(startTime),
+		Identity:  caller.Identity,
+		Roles:     authz.GetRoles(ctx).Strings(),
+		Peer:      caller.Peer,
+		Method:    method,
+		Request:   request,
+		Code:      uint32(st.Code()),
+		Duration:  durationpb.New(time.Since(startTime)),
+	}
+
+	if err != nil {
+		record.Error = st.Message()
+	}
+
+	// sinks are called under the lock to deliver the records in the order of IDs
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.lastID++
+	record.Id = m.lastID
+
+	for _, sink := range m.sinks {
+		sink.Record(record)
+	}
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (m *Middleware) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		startTime := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		m.record(ctx, info.FullMethod, Summarize(req), startTime, err)
+
+		return resp, err
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+//
+// The request recorded for the stream is the first message received from the client.
+func (m *Middleware) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		startTime := time.Now()
+
+		wrapped := &recordingStream{WrappedServerStream: grpc_middleware.WrapServerStream(stream)}
+
+		err := handler(srv, wrapped)
+
+		m.record(stream.Context(), info.FullMethod, wrapped.request, startTime, err)
+
+		return err
+	}
+}
+
+// recordingStream captures the summary of the first message received from the client.
+type recordingStream struct {
+	*grpc_middleware.WrappedServerStream
+
+	request  string
+	received bool
+}
+
+func (s *recordingStream) RecvMsg(m any) error {
+	err := s.WrappedServerStream.RecvMsg(m)
+	if err == nil && !s.received {
+		s.request = Summarize(m)
+		s.received = true
+	}
+
+	return err
+}
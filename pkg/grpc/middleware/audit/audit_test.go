@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package audit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/audit"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	log := audit.NewLog(10)
+	middleware := audit.NewMiddleware(log)
+	interceptor := middleware.UnaryInterceptor()
+
+	ctx := authz.ContextWithRoles(context.Background(), role.MakeSet(role.Reader))
+	ctx = authz.ContextWithCaller(ctx, authz.Caller{Identity: "reader", Peer: "10.0.0.1"})
+
+	_, err := interceptor(ctx, &machine.ReadRequest{Path: "/etc/os-release"}, &grpc.UnaryServerInfo{FullMethod: "/machine.MachineService/Read"},
+		func(context.Context, any) (any, error) {
+			return &machine.ReadRequest{}, nil
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = interceptor(ctx, &machine.RebootRequest{}, &grpc.UnaryServerInfo{FullMethod: "/machine.MachineService/Reboot"},
+		func(context.Context, any) (any, error) {
+			return nil, status.Error(codes.PermissionDenied, "not authorized")
+		},
+	)
+	require.Error(t, err)
+
+	records := log.List(0)
+	require.Len(t, records, 2)
+
+	for i, record := range records {
+		assert.EqualValues(t, i+1, record.Id)
+		assert.Equal(t, "reader", record.Identity)
+		assert.Equal(t, "10.0.0.1", record.Peer)
+		assert.Equal(t, []string{string(role.Reader)}, record.Roles)
+		assert.NotNil(t, record.Timestamp)
+		assert.NotNil(t, record.Duration)
+	}
+
+	assert.Equal(t, "/machine.MachineService/Read", records[0].Method)
+	assert.Equal(t, `path:"/etc/os-release"`, records[0].Request)
+	assert.EqualValues(t, codes.OK, records[0].Code)
+	assert.Empty(t, records[0].Error)
+
+	assert.Equal(t, "/machine.MachineService/Reboot", records[1].Method)
+	assert.Empty(t, records[1].Request)
+	assert.EqualValues(t, codes.PermissionDenied, records[1].Code)
+	assert.Equal(t, "not authorized", records[1].Error)
+}
@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package audit
+
+import (
+	"context"
+	"sync"
+
+	auditapi "github.com/siderolabs/talos/pkg/machinery/api/audit"
+)
+
+// Log keeps the most recent audit records in memory.
+//
+// Log implements Sink.
+type Log struct {
+	mu sync.Mutex
+
+	// records is a ring buffer, written is the total number of the records written
+	records []*auditapi.Record
+	written int
+
+	// changed is closed and replaced when a new record is written
+	changed chan struct{}
+}
+
+// NewLog creates a new Log which keeps up to capacity most recent records.
+func NewLog(capacity int) *Log {
+	return &Log{
+		records: make([]*auditapi.Record, capacity),
+		changed: make(chan struct{}),
+	}
+}
+
+// Record implements Sink.
+func (l *Log) Record(record *auditapi.Record) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.records[l.written%len(l.records)] = record
+	l.written++
+
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// List returns up to tail most recent records, oldest first.
+//
+// If tail is not positive, all the records kept are returned.
+func (l *Log) List(tail int) []*auditapi.Record {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	records, _, _ := l.since(l.tailPos(tail))
+
+	return records
+}
+
+// Watch calls f for up to tail most recent records, and then for each new record until the context is canceled.
+//
+// If tail is negative, all the records kept are sent, if tail is zero, only the new records are sent.
+// If the watcher falls behind the capacity of the log, the records which were overwritten are skipped.
+func (l *Log) Watch(ctx context.Context, tail int, f func(record *auditapi.Record) error) error {
+	l.mu.Lock()
+	pos := l.written
+
+	if tail != 0 {
+		pos = l.tailPos(tail)
+	}
+
+	l.mu.Unlock()
+
+	for {
+		l.mu.Lock()
+		records, next, changed := l.since(pos)
+		l.mu.Unlock()
+
+		for _, record := range records {
+			if err := f(record); err != nil {
+				return err
+			}
+		}
+
+		pos = next
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// tailPos returns the position of the tail most recent record.
+//
+// Should be called with the mutex held.
+func (l *Log) tailPos(tail int) int {
+	if tail <= 0 || tail > len(l.records) {
+		tail = len(l.records)
+	}
+
+	return max(l.written-tail, 0)
+}
+
+// since returns the records written starting at pos, the position of the next record and the channel which is closed
+// when the next record is written.
+//
+// Should be called with the mutex held.
+func (l *Log) since(pos int) ([]*auditapi.Record, int, <-chan struct{}) {
+	pos = max(pos, l.written-len(l.records))
+
+	records := make([]*auditapi.Record, 0, l.written-pos)
+
+	for ; pos < l.written; pos++ {
+		records = append(records, l.records[pos%len(l.records)])
+	}
+
+	return records, pos, l.changed
+}
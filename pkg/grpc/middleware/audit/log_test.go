@@ -0,0 +1,123 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package audit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/audit"
+	auditapi "github.com/siderolabs/talos/pkg/machinery/api/audit"
+)
+
+func recordIDs(records []*auditapi.Record) []uint64 {
+	return xslices.Map(records, (*auditapi.Record).GetId)
+}
+
+func TestLogList(t *testing.T) {
+	t.Parallel()
+
+	log := audit.NewLog(4)
+
+	assert.Empty(t, log.List(0))
+
+	for id := range uint64(3) {
+		log.Record(&auditapi.Record{Id: id + 1})
+	}
+
+	assert.Equal(t, []uint64{1, 2, 3}, recordIDs(log.List(0)))
+	assert.Equal(t, []uint64{2, 3}, recordIDs(log.List(2)))
+
+	for id := range uint64(3) {
+		log.Record(&auditapi.Record{Id: id + 4})
+	}
+
+	assert.Equal(t, []uint64{3, 4, 5, 6}, recordIDs(log.List(-1)))
+	assert.Equal(t, []uint64{3, 4, 5, 6}, recordIDs(log.List(10)))
+	assert.Equal(t, []uint64{6}, recordIDs(log.List(1)))
+}
+
+func TestLogWatch(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		tail     int
+		expected []uint64
+	}{
+		{
+			name:     "new only",
+			tail:     0,
+			expected: []uint64{4, 5},
+		},
+		{
+			name:     "tail",
+			tail:     2,
+			expected: []uint64{2, 3, 4, 5},
+		},
+		{
+			name:     "all",
+			tail:     -1,
+			expected: []uint64{1, 2, 3, 4, 5},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			t.Cleanup(cancel)
+
+			log := audit.NewLog(10)
+
+			for id := range uint64(3) {
+				log.Record(&auditapi.Record{Id: id + 1})
+			}
+
+			watchCh := make(chan *auditapi.Record)
+			errCh := make(chan error, 1)
+
+			go func() {
+				errCh <- log.Watch(ctx, test.tail, func(record *auditapi.Record) error {
+					select {
+					case watchCh <- record:
+					case <-ctx.Done():
+					}
+
+					return nil
+				})
+			}()
+
+			var received []uint64
+
+			for range len(test.expected) - 2 {
+				received = append(received, (<-watchCh).Id)
+			}
+
+			// the watcher is guaranteed to be running now, unless no records were expected before the new ones
+			if len(received) == 0 {
+				// give the watcher a chance to start
+				time.Sleep(100 * time.Millisecond)
+			}
+
+			log.Record(&auditapi.Record{Id: 4})
+			log.Record(&auditapi.Record{Id: 5})
+
+			for range 2 {
+				received = append(received, (<-watchCh).Id)
+			}
+
+			assert.Equal(t, test.expected, received)
+
+			cancel()
+
+			require.ErrorIs(t, <-errCh, context.Canceled)
+		})
+	}
+}
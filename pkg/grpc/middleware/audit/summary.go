@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package audit
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// maxSummaryLength limits the length of the request summary.
+	maxSummaryLength = 1024
+
+	// maxStringLength limits the length of the string fields in the summary,
+	// longer strings are likely to be the contents (e.g. machine configuration patches).
+	maxStringLength = 128
+
+	// maxListItems limits the number of the list items in the summary.
+	maxListItems = 16
+)
+
+// sensitiveFields are never included in the summary.
+var sensitiveFields = []string{"token", "secret", "password", "passphrase", "key"}
+
+// Summarize returns the summary of the API request.
+//
+// The summary contains the request fields in the text format, the contents of the binary, long string
+// and sensitive fields are omitted.
+func Summarize(req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	summarizeMessage(&sb, msg.ProtoReflect())
+
+	summary := sb.String()
+
+	if len(summary) > maxSummaryLength {
+		summary = summary[:maxSummaryLength] + "..."
+	}
+
+	return summary
+}
+
+func summarizeMessage(sb *strings.Builder, msg protoreflect.Message) {
+	var fields []protoreflect.FieldDescriptor
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+
+		return true
+	})
+
+	slices.SortFunc(fields, func(a, b protoreflect.FieldDescriptor) int {
+		return int(a.Number()) - int(b.Number())
+	})
+
+	for i, fd := range fields {
+		if i > 0 {
+			sb.WriteString(" ")
+		}
+
+		sb.WriteString(string(fd.Name()))
+		sb.WriteString(":")
+
+		if isSensitive(fd) {
+			sb.WriteString("<hidden>")
+
+			continue
+		}
+
+		value := msg.Get(fd)
+
+		switch {
+		case fd.IsList():
+			list := value.List()
+
+			sb.WriteString("[")
+
+			for j := range min(list.Len(), maxListItems) {
+				if j > 0 {
+					sb.WriteString(" ")
+				}
+
+				summarizeValue(sb, fd, list.Get(j))
+			}
+
+			if list.Len() > maxListItems {
+				fmt.Fprintf(sb, " <%d more>", list.Len()-maxListItems)
+			}
+
+			sb.WriteString("]")
+		case fd.IsMap():
+			fmt.Fprintf(sb, "<%d entries>", value.Map().Len())
+		default:
+			summarizeValue(sb, fd, value)
+		}
+	}
+}
+
+func summarizeValue(sb *strings.Builder, fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Kind() { //nolint:exhaustive
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		sb.WriteString("{")
+		summarizeMessage(sb, value.Message())
+		sb.WriteString("}")
+	case protoreflect.BytesKind:
+		fmt.Fprintf(sb, "<%d bytes>", len(value.Bytes()))
+	case protoreflect.StringKind:
+		if len(value.String()) > maxStringLength {
+			fmt.Fprintf(sb, "<%d bytes>", len(value.String()))
+		} else {
+			sb.WriteString(strconv.Quote(value.String()))
+		}
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			sb.WriteString(string(enumValue.Name()))
+		} else {
+			sb.WriteString(strconv.Itoa(int(value.Enum())))
+		}
+	default:
+		sb.WriteString(value.String())
+	}
+}
+
+func isSensitive(fd protoreflect.FieldDescriptor) bool {
+	name := strings.ToLower(string(fd.Name()))
+
+	return slices.ContainsFunc(sensitiveFields, func(sensitive string) bool {
+		return strings.Contains(name, sensitive)
+	})
+}
@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package audit_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/audit"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		req      any
+		expected string
+	}{
+		{
+			name: "not a message",
+			req:  "foo",
+		},
+		{
+			name: "empty",
+			req:  &emptypb.Empty{},
+		},
+		{
+			name: "scalars and enums",
+			req: &machine.ListRequest{
+				Root:    "/var/log",
+				Recurse: true,
+				Types:   []machine.ListRequest_Type{machine.ListRequest_REGULAR, machine.ListRequest_DIRECTORY},
+			},
+			expected: `root:"/var/log" recurse:true types:[REGULAR DIRECTORY]`,
+		},
+		{
+			name: "bytes and nested messages",
+			req: &machine.ApplyConfigurationRequest{
+				Data:           []byte("version: v1alpha1"),
+				Mode:           machine.ApplyConfigurationRequest_TRY,
+				TryModeTimeout: durationpb.New(time.Minute),
+			},
+			expected: `data:<17 bytes> mode:TRY try_mode_timeout:{seconds:60}`,
+		},
+		{
+			name: "sensitive",
+			req: &machine.MetaWriteRequest{
+				Key:   10,
+				Value: []byte("foo"),
+			},
+			expected: `key:<hidden> value:<3 bytes>`,
+		},
+		{
+			name: "passphrase",
+			req: &machine.EncryptionAddKeyRequest{
+				Volume:           "STATE",
+				Slot:             2,
+				StaticPassphrase: []byte("secret"),
+			},
+			expected: `volume:"STATE" slot:2 static_passphrase:<hidden>`,
+		},
+		{
+			name: "long string",
+			req: &machine.ListRequest{
+				Root: strings.Repeat("a", 200),
+			},
+			expected: `root:<200 bytes>`,
+		},
+		{
+			name: "long list",
+			req: &machine.DiskUsageRequest{
+				Paths: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18"},
+			},
+			expected: `paths:["1" "2" "3" "4" "5" "6" "7" "8" "9" "10" "11" "12" "13" "14" "15" "16" <2 more>]`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, audit.Summarize(test.req))
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/syslog"
+	"net/url"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	auditapi "github.com/siderolabs/talos/pkg/machinery/api/audit"
+)
+
+// syslogQueueSize is the number of the records buffered while the syslog server is slow or unavailable.
+const syslogQueueSize = 256
+
+// SyslogTag is the tag of the audit records sent to the syslog server.
+const SyslogTag = "talos-audit"
+
+// SyslogSink sends the audit records to the remote syslog server.
+//
+// The records are sent as JSON objects in the syslog messages with the AUTH facility.
+// If the syslog server is slow or unavailable, the records are dropped.
+type SyslogSink struct {
+	endpoint func() string
+	logger   *log.Logger
+	queue    chan *auditapi.Record
+}
+
+// NewSyslogSink creates a new SyslogSink.
+//
+// The endpoint function returns the syslog server endpoint in the URL format (e.g. udp://10.0.0.1:514),
+// it is called for every record, so that the endpoint can be changed on the fly.
+// If the endpoint is empty, the records are dropped.
+func NewSyslogSink(endpoint func() string, logger *log.Logger) *SyslogSink {
+	return &SyslogSink{
+		endpoint: endpoint,
+		logger:   logger,
+		queue:    make(chan *auditapi.Record, syslogQueueSize),
+	}
+}
+
+// Record implements Sink.
+func (s *SyslogSink) Record(record *auditapi.Record) {
+	select {
+	case s.queue <- record:
+	default:
+	}
+}
+
+// Run sends the queued records to the syslog server until the context is canceled.
+func (s *SyslogSink) Run(ctx context.Context) {
+	var (
+		writer          *syslog.Writer
+		currentEndpoint string
+	)
+
+	defer func() {
+		if writer != nil {
+			writer.Close() //nolint:errcheck
+		}
+	}()
+
+	for {
+		var record *auditapi.Record
+
+		select {
+		case <-ctx.Done():
+			return
+		case record = <-s.queue:
+		}
+
+		endpoint := s.endpoint()
+
+		if writer != nil && endpoint != currentEndpoint {
+			writer.Close() //nolint:errcheck
+			writer = nil
+		}
+
+		currentEndpoint = endpoint
+
+		if endpoint == "" {
+			continue
+		}
+
+		if writer == nil {
+			var err error
+
+			writer, err = dialSyslog(endpoint)
+			if err != nil {
+				s.logger.Printf("error connecting to the audit syslog server %q: %s", endpoint, err)
+
+				continue
+			}
+		}
+
+		if err := writer.Info(string(marshalRecord(record))); err != nil {
+			s.logger.Printf("error sending the audit record to the syslog server %q: %s", endpoint, err)
+
+			// reconnect on the next record
+			writer.Close() //nolint:errcheck
+			writer = nil
+		}
+	}
+}
+
+func dialSyslog(endpoint string) (*syslog.Writer, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "tcp", "udp":
+	default:
+		return nil, fmt.Errorf("unsupported syslog endpoint scheme %q", u.Scheme)
+	}
+
+	return syslog.Dial(u.Scheme, u.Host, syslog.LOG_AUTH|syslog.LOG_INFO, SyslogTag)
+}
+
+func marshalRecord(record *auditapi.Record) []byte {
+	data, err := protojson.Marshal(record)
+	if err != nil {
+		// should never happen
+		return []byte(err.Error())
+	}
+
+	return data
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package audit_test
+
+import (
+	"context"
+	"log"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/audit"
+	auditapi "github.com/siderolabs/talos/pkg/machinery/api/audit"
+)
+
+func TestSyslogSink(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	sink := audit.NewSyslogSink(func() string {
+		return "udp://" + conn.LocalAddr().String()
+	}, log.New(log.Writer(), "", 0))
+
+	go sink.Run(ctx)
+
+	sink.Record(&auditapi.Record{
+		Id:       1,
+		Identity: "admin",
+		Method:   "/machine.MachineService/Reboot",
+	})
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	buf := make([]byte, 4096)
+
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+
+	msg := string(buf[:n])
+
+	// LOG_AUTH|LOG_INFO priority
+	assert.Contains(t, msg, "<38>")
+	assert.Contains(t, msg, audit.SyslogTag)
+
+	var record auditapi.Record
+
+	require.NoError(t, protojson.Unmarshal([]byte(msg[strings.Index(msg, "{"):]), &record))
+
+	assert.Equal(t, "admin", record.Identity)
+	assert.Equal(t, "/machine.MachineService/Reboot", record.Method)
+}
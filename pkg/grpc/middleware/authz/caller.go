@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package authz
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// AuthSource describes how the client was authenticated.
+type AuthSource string
+
+// Authentication sources.
+const (
+	// AuthSourceCertificate is the client authenticated with the client certificate.
+	AuthSourceCertificate AuthSource = "certificate"
+	// AuthSourceToken is the client authenticated with the bearer token.
+	AuthSourceToken AuthSource = "token"
+)
+
+// Caller describes the client which made the API call.
+type Caller struct {
+	// Identity of the client (common name of the client certificate).
+	Identity string
+	// Peer is the address of the client.
+	Peer string
+	// AuthSource is the way the client was authenticated, empty if not known.
+	AuthSource AuthSource
+	// CertificateNotAfter is the expiration time of the client certificate (for AuthSourceCertificate).
+	CertificateNotAfter time.Time
+}
+
+// callerCtxKey is used to store the caller in the context.
+type callerCtxKey struct{}
+
+// GetCaller returns the caller stored in the context by the Injector interceptor.
+//
+// Zero value is returned if the caller is not known.
+func GetCaller(ctx context.Context) Caller {
+	caller, _ := ctx.Value(callerCtxKey{}).(Caller)
+
+	return caller
+}
+
+// ContextWithCaller returns derived context with the caller set.
+func ContextWithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerCtxKey{}, caller)
+}
+
+// SetCallerMetadata sets the caller in gRPC metadata.
+//
+// Empty fields are removed from the metadata, so that they can't be spoofed by the client.
+func SetCallerMetadata(md metadata.MD, caller Caller) {
+	var certificateNotAfter string
+
+	if !caller.CertificateNotAfter.IsZero() {
+		certificateNotAfter = caller.CertificateNotAfter.UTC().Format(time.RFC3339)
+	}
+
+	for key, value := range map[string]string{
+		constants.APIAuthzIdentityMetadataKey:            caller.Identity,
+		constants.APIAuthzPeerMetadataKey:                caller.Peer,
+		constants.APIAuthzAuthSourceMetadataKey:          string(caller.AuthSource),
+		constants.APIAuthzCertificateNotAfterMetadataKey: certificateNotAfter,
+	} {
+		if value == "" {
+			md.Delete(key)
+		} else {
+			md.Set(key, value)
+		}
+	}
+}
+
+// getCallerFromMetadata returns the caller extracted from gRPC metadata.
+func getCallerFromMetadata(ctx context.Context) (Caller, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	identity := md.Get(constants.APIAuthzIdentityMetadataKey)
+	peerAddr := md.Get(constants.APIAuthzPeerMetadataKey)
+
+	if len(identity) == 0 && len(peerAddr) == 0 {
+		return Caller{}, false
+	}
+
+	var caller Caller
+
+	if len(identity) > 0 {
+		caller.Identity = identity[0]
+	}
+
+	if len(peerAddr) > 0 {
+		caller.Peer = peerAddr[0]
+	}
+
+	if authSource := md.Get(constants.APIAuthzAuthSourceMetadataKey); len(authSource) > 0 {
+		caller.AuthSource = AuthSource(authSource[0])
+	}
+
+	if certificateNotAfter := md.Get(constants.APIAuthzCertificateNotAfterMetadataKey); len(certificateNotAfter) > 0 {
+		caller.CertificateNotAfter, _ = time.Parse(time.RFC3339, certificateNotAfter[0])
+	}
+
+	return caller, true
+}
+
+// CallerFromConnection returns the caller extracted from the connection information
+// (client certificate and the remote address).
+func CallerFromConnection(ctx context.Context) Caller {
+	var caller Caller
+
+	if cert := peerCertificate(ctx); cert != nil {
+		caller.Identity = cert.Subject.CommonName
+		caller.AuthSource = AuthSourceCertificate
+		caller.CertificateNotAfter = cert.NotAfter
+	}
+
+	if addr, ok := peerAddress(ctx); ok {
+		caller.Peer = addr.String()
+	}
+
+	return caller
+}
+
+// peerCertificate returns the client certificate the connection was verified against, if any.
+func peerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil
+	}
+
+	return tlsInfo.State.PeerCertificates[0]
+}
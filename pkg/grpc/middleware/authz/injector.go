@@ -57,6 +57,10 @@ type Injector struct {
 	// When not specified, it defaults to isSideroLinkPeer.
 	SideroLinkPeerCheckFunc SideroLinkPeerCheckFunc
 
+	// TokenVerifier authenticates the clients which don't present a client certificate with the bearer token.
+	// When not specified, the clients should always present a client certificate.
+	TokenVerifier TokenVerifier
+
 	// Logger.
 	Logger func(format string, v ...any)
 }
@@ -147,10 +151,61 @@ func (i *Injector) extractRoles(ctx context.Context) role.Set {
 	panic("unreachable")
 }
 
+// extractCaller returns the caller extracted from the connection (in case of the first apid instance),
+// or from gRPC metadata (in case of subsequent apid instances, machined, or user with impersonator role).
+func (i *Injector) extractCaller(ctx context.Context) Caller {
+	if i.Mode == MetadataOnly {
+		caller, _ := getCallerFromMetadata(ctx)
+
+		return caller
+	}
+
+	// trust gRPC metadata from clients with impersonator role if present
+	// (including requests proxied from other apid instances)
+	if cert := peerCertificate(ctx); cert != nil {
+		if roles, _ := role.Parse(cert.Subject.Organization); roles.Includes(role.Impersonator) {
+			if caller, ok := getCallerFromMetadata(ctx); ok {
+				return caller
+			}
+		}
+	}
+
+	return CallerFromConnection(ctx)
+}
+
+// inject returns derived context with the roles and the caller set.
+func (i *Injector) inject(ctx context.Context) (context.Context, error) {
+	if i.TokenVerifier != nil && i.Mode != MetadataOnly && peerCertificate(ctx) == nil {
+		identity, roles, err := i.verifyToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// with RBAC disabled, the token is only used to authenticate the client
+		if i.Mode != Enabled {
+			roles = i.extractRoles(ctx)
+		}
+
+		caller := CallerFromConnection(ctx)
+		caller.Identity = identity
+		caller.AuthSource = AuthSourceToken
+
+		return ContextWithCaller(ContextWithRoles(ctx, roles), caller), nil
+	}
+
+	ctx = ContextWithRoles(ctx, i.extractRoles(ctx))
+	ctx = ContextWithCaller(ctx, i.extractCaller(ctx))
+
+	return ctx, nil
+}
+
 // UnaryInterceptor returns grpc UnaryServerInterceptor.
 func (i *Injector) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		ctx = ContextWithRoles(ctx, i.extractRoles(ctx))
+		ctx, err := i.inject(ctx)
+		if err != nil {
+			return nil, err
+		}
 
 		return handler(ctx, req)
 	}
@@ -159,8 +214,10 @@ func (i *Injector) UnaryInterceptor() grpc.UnaryServerInterceptor {
 // StreamInterceptor returns grpc StreamServerInterceptor.
 func (i *Injector) StreamInterceptor() grpc.StreamServerInterceptor {
 	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		ctx := stream.Context()
-		ctx = ContextWithRoles(ctx, i.extractRoles(ctx))
+		ctx, err := i.inject(stream.Context())
+		if err != nil {
+			return err
+		}
 
 		wrapped := grpc_middleware.WrapServerStream(stream)
 		wrapped.WrappedContext = ctx //nolint:fatcontext
@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package authz
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// TokenVerifier verifies the bearer tokens of the clients which don't present a client certificate.
+type TokenVerifier interface {
+	// Verify returns the identity of the client and the roles granted by the token.
+	Verify(ctx context.Context, token string) (string, role.Set, error)
+}
+
+// ErrNotAuthenticated is returned to the client which presents neither a client certificate nor a bearer token.
+var ErrNotAuthenticated = status.Error(codes.Unauthenticated, "client certificate or bearer token is required")
+
+// verifyToken authenticates the client with the bearer token from gRPC metadata.
+func (i *Injector) verifyToken(ctx context.Context) (string, role.Set, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(constants.APIAuthorizationMetadataKey)
+	if len(values) == 0 {
+		return "", role.Zero, ErrNotAuthenticated
+	}
+
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return "", role.Zero, ErrNotAuthenticated
+	}
+
+	identity, roles, err := i.TokenVerifier.Verify(ctx, token)
+	if err != nil {
+		i.logf("bearer token verification failed: %s", err)
+
+		return "", role.Zero, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+
+	i.logf("bearer token of %q verified, roles %v", identity, roles.Strings())
+
+	return identity, roles, nil
+}
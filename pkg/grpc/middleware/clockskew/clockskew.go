@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package clockskew provides gRPC middleware which reports client certificates rejected due to the clock skew.
+//
+// Certificates which are not valid at the server time fail the TLS handshake with an opaque error,
+// so the time validity of the client certificate is checked after the handshake, and the client gets
+// an error which carries the server time and the certificate validity bounds.
+package clockskew
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// VerifyChain verifies the client certificate chain against the roots ignoring the current time.
+//
+// The chain is verified at the current time clamped to the validity bounds of the leaf certificate,
+// so the certificates which are valid, but not at the current time, pass the verification.
+// The time validity should be enforced with the Enforcer interceptors.
+func VerifyChain(rawCerts [][]byte, roots *x509.CertPool, now time.Time) ([][]*x509.Certificate, error) {
+	if len(rawCerts) == 0 {
+		return nil, errors.New("no client certificate provided")
+	}
+
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		certs = append(certs, cert)
+	}
+
+	intermediates := x509.NewCertPool()
+
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	leaf := certs[0]
+
+	verifyTime := now
+
+	switch {
+	case verifyTime.Before(leaf.NotBefore):
+		verifyTime = leaf.NotBefore
+	case verifyTime.After(leaf.NotAfter):
+		verifyTime = leaf.NotAfter
+	}
+
+	return leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   verifyTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+}
+
+// Enforcer rejects the calls with the client certificates which are not valid at the server time.
+type Enforcer struct {
+	// Tolerance is the allowed difference between the server time and the certificate validity bounds.
+	Tolerance time.Duration
+
+	// Now returns the current time, defaults to time.Now.
+	Now func() time.Time
+}
+
+// Check the client certificate of the peer in the context.
+//
+// If the peer is not authenticated with TLS, the check is skipped.
+func (e *Enforcer) Check(ctx context.Context) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+
+	now := time.Now()
+	if e.Now != nil {
+		now = e.Now()
+	}
+
+	for _, cert := range tlsInfo.State.PeerCertificates {
+		if now.Add(e.Tolerance).Before(cert.NotBefore) || now.Add(-e.Tolerance).After(cert.NotAfter) {
+			return &client.ClockSkewError{
+				ServerTime: now,
+				NotBefore:  cert.NotBefore,
+				NotAfter:   cert.NotAfter,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (e *Enforcer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := e.Check(ctx); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+func (e *Enforcer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := e.Check(stream.Context()); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
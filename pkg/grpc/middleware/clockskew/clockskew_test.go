@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package clockskew_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/clockskew"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func peerContext(notBefore, notAfter time.Time) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{},
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{
+					{
+						NotBefore: notBefore,
+						NotAfter:  notAfter,
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestEnforcerCheck(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, test := range []struct {
+		name      string
+		notBefore time.Time
+		notAfter  time.Time
+		tolerance time.Duration
+		expectErr bool
+	}{
+		{
+			name:      "valid",
+			notBefore: now.Add(-time.Hour),
+			notAfter:  now.Add(time.Hour),
+		},
+		{
+			name:      "expired",
+			notBefore: now.Add(-2 * time.Hour),
+			notAfter:  now.Add(-time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "not yet valid",
+			notBefore: now.Add(time.Hour),
+			notAfter:  now.Add(2 * time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "expired within tolerance",
+			notBefore: now.Add(-2 * time.Hour),
+			notAfter:  now.Add(-time.Minute),
+			tolerance: 5 * time.Minute,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			enforcer := &clockskew.Enforcer{
+				Tolerance: test.tolerance,
+				Now:       func() time.Time { return now },
+			}
+
+			err := enforcer.Check(peerContext(test.notBefore, test.notAfter))
+			if !test.expectErr {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+
+			// the error should survive the round-trip through the gRPC status
+			clockSkewErr := client.ClockSkew(status.Convert(err).Err())
+			require.NotNil(t, clockSkewErr)
+
+			assert.True(t, clockSkewErr.ServerTime.Equal(now))
+			assert.True(t, clockSkewErr.NotBefore.Equal(test.notBefore))
+			assert.True(t, clockSkewErr.NotAfter.Equal(test.notAfter))
+			assert.Equal(t, time.Hour, clockSkewErr.Skew(now.Add(-time.Hour)))
+		})
+	}
+}
+
+func TestEnforcerCheckNoPeer(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, (&clockskew.Enforcer{}).Check(context.Background()))
+}
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package deadline provides gRPC middleware enforcing default and maximum deadlines for the calls.
+package deadline
+
+import (
+	"context"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/grpc"
+)
+
+// Limits of the call deadline.
+type Limits struct {
+	// Default is applied to the calls without a deadline, zero means no default deadline.
+	Default time.Duration
+	// Max caps the call deadline, zero means no maximum.
+	Max time.Duration
+}
+
+// Apply the limits to the context.
+//
+// If the returned cancel function is nil, the context is not changed.
+func (limits Limits) Apply(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := limits.Default
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if limits.Max == 0 || time.Until(deadline) <= limits.Max {
+			return ctx, nil
+		}
+
+		timeout = limits.Max
+	} else if limits.Max > 0 && (timeout == 0 || timeout > limits.Max) {
+		timeout = limits.Max
+	}
+
+	if timeout == 0 {
+		return ctx, nil
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// Enforcer applies deadline limits per call category (unary or streaming).
+type Enforcer struct {
+	// Unary limits apply to the unary calls.
+	Unary Limits
+	// Streaming limits apply to the streaming calls.
+	Streaming Limits
+
+	// IsStreaming detects streaming calls by the full method name.
+	//
+	// It is used for the calls handled by the stream interceptor which might be unary from the client point of view
+	// (e.g. calls handled by the proxy).
+	// If not set, all calls handled by the stream interceptor are considered to be streaming.
+	IsStreaming func(fullMethodName string) bool
+}
+
+func (e *Enforcer) streamLimits(fullMethodName string) Limits {
+	if e.IsStreaming == nil || e.IsStreaming(fullMethodName) {
+		return e.Streaming
+	}
+
+	return e.Unary
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (e *Enforcer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, cancel := e.Unary.Apply(ctx)
+		if cancel != nil {
+			defer cancel()
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+func (e *Enforcer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := e.streamLimits(info.FullMethod).Apply(stream.Context())
+		if cancel == nil {
+			return handler(srv, stream)
+		}
+
+		defer cancel()
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx //nolint:fatcontext
+
+		return handler(srv, wrapped)
+	}
+}
@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package deadline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/deadline"
+)
+
+func TestLimitsApply(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name        string
+		limits      deadline.Limits
+		timeout     time.Duration
+		expected    time.Duration
+		hasDeadline bool
+	}{
+		{
+			name: "no limits",
+		},
+		{
+			name:        "no limits with deadline",
+			timeout:     time.Hour,
+			expected:    time.Hour,
+			hasDeadline: true,
+		},
+		{
+			name:        "default",
+			limits:      deadline.Limits{Default: time.Minute},
+			expected:    time.Minute,
+			hasDeadline: true,
+		},
+		{
+			name:        "default with deadline",
+			limits:      deadline.Limits{Default: time.Minute},
+			timeout:     time.Hour,
+			expected:    time.Hour,
+			hasDeadline: true,
+		},
+		{
+			name:        "max without deadline",
+			limits:      deadline.Limits{Max: time.Minute},
+			expected:    time.Minute,
+			hasDeadline: true,
+		},
+		{
+			name:        "default over max",
+			limits:      deadline.Limits{Default: time.Hour, Max: time.Minute},
+			expected:    time.Minute,
+			hasDeadline: true,
+		},
+		{
+			name:        "deadline over max",
+			limits:      deadline.Limits{Default: time.Second, Max: time.Minute},
+			timeout:     time.Hour,
+			expected:    time.Minute,
+			hasDeadline: true,
+		},
+		{
+			name:        "deadline under max",
+			limits:      deadline.Limits{Default: time.Second, Max: time.Hour},
+			timeout:     time.Minute,
+			expected:    time.Minute,
+			hasDeadline: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if test.timeout > 0 {
+				var cancel context.CancelFunc
+
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				t.Cleanup(cancel)
+			}
+
+			ctx, cancel := test.limits.Apply(ctx)
+			if cancel != nil {
+				t.Cleanup(cancel)
+			}
+
+			d, ok := ctx.Deadline()
+			require.Equal(t, test.hasDeadline, ok)
+
+			if ok {
+				assert.InDelta(t, test.expected, time.Until(d), float64(time.Second))
+			}
+		})
+	}
+}
+
+type mockServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context //nolint:containedctx
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	enforcer := &deadline.Enforcer{
+		Unary:     deadline.Limits{Default: time.Minute},
+		Streaming: deadline.Limits{Default: time.Hour},
+		IsStreaming: func(fullMethodName string) bool {
+			return fullMethodName == "/test.Service/Stream"
+		},
+	}
+
+	interceptor := enforcer.StreamInterceptor()
+
+	for method, expected := range map[string]time.Duration{
+		"/test.Service/Stream": time.Hour,
+		"/test.Service/Unary":  time.Minute,
+	} {
+		require.NoError(t, interceptor(nil, &mockServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: method},
+			func(_ any, stream grpc.ServerStream) error {
+				d, ok := stream.Context().Deadline()
+				require.True(t, ok)
+
+				assert.InDelta(t, expected, time.Until(d), float64(time.Second), method)
+
+				return nil
+			},
+		))
+	}
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	enforcer := &deadline.Enforcer{
+		Unary: deadline.Limits{Max: time.Minute},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	_, err := enforcer.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Unary"},
+		func(ctx context.Context, _ any) (any, error) {
+			d, ok := ctx.Deadline()
+			require.True(t, ok)
+
+			assert.InDelta(t, time.Minute, time.Until(d), float64(time.Second))
+
+			return nil, nil //nolint:nilnil
+		},
+	)
+	require.NoError(t, err)
+}
@@ -46,6 +46,10 @@ func (l *Local) GetConnection(ctx context.Context, fullMethodName string) (conte
 	md = md.Copy()
 
 	authz.SetMetadata(md, authz.GetRoles(ctx))
+	authz.SetCallerMetadata(md, authz.GetCaller(ctx))
+
+	// the client is already authenticated, don't pass the bearer token further
+	md.Delete(constants.APIAuthorizationMetadataKey)
 
 	outCtx := metadata.NewOutgoingContext(ctx, md)
 
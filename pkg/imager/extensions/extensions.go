@@ -28,6 +28,15 @@ type Builder struct {
 	Printf func(format string, v ...any)
 	// Quirks for the Talos version being used.
 	Quirks quirks.Quirks
+
+	kernelArgs []string
+}
+
+// KernelArgs returns the kernel arguments required by the extensions.
+//
+// The list is populated by Build.
+func (builder *Builder) KernelArgs() []string {
+	return builder.kernelArgs
 }
 
 // Build rebuilds the initramfs.xz with extensions.
@@ -51,6 +60,10 @@ func (builder *Builder) Build() error {
 		return err
 	}
 
+	for _, ext := range extensionsList {
+		builder.kernelArgs = append(builder.kernelArgs, ext.Manifest.Hooks.KernelArgs...)
+	}
+
 	extensionPathsWithKernelModules := findExtensionsWithKernelModules(extensionsList)
 
 	if len(extensionPathsWithKernelModules) > 0 {
@@ -118,6 +131,7 @@ func (builder *Builder) compressExtensions(extensions []*extensions.Extension, t
 		cfg.Layers = append(cfg.Layers, &extinterface.Layer{
 			Image:    filepath.Base(path),
 			Metadata: ext.Manifest.Metadata,
+			Hooks:    ext.Manifest.Hooks,
 		})
 	}
 
@@ -47,6 +47,9 @@ type Imager struct {
 	initramfsPath string
 	cmdline       string
 
+	// kernel arguments required by the system extensions
+	extensionKernelArgs []string
+
 	sdBootPath string
 	ukiPath    string
 }
@@ -314,6 +317,8 @@ func (i *Imager) buildInitramfs(ctx context.Context, report *reporter.Reporter)
 		return err
 	}
 
+	i.extensionKernelArgs = builder.KernelArgs()
+
 	report.Report(reporter.Update{
 		Message: "initramfs ready",
 		Status:  reporter.StatusSucceeded,
@@ -392,6 +397,11 @@ func (i *Imager) buildCmdline() error {
 		)
 	}
 
+	// kernel arguments required by the system extensions
+	if err = cmdline.AppendAll(i.extensionKernelArgs); err != nil {
+		return err
+	}
+
 	// apply customization
 	if err = cmdline.AppendAll(
 		i.prof.Customization.ExtraKernelArgs,
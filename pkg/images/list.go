@@ -6,8 +6,11 @@ package images
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
@@ -52,3 +55,39 @@ func List(config config.Config) Versions {
 
 	return images
 }
+
+// ListForVersions returns default image versions for the Talos and Kubernetes versions.
+//
+// The Kubernetes and installer images follow the versions, while etcd, CoreDNS, Flannel and pause images
+// are the defaults of the current Talos version.
+func ListForVersions(talosVersion, kubernetesVersion string) Versions {
+	kubernetesVersion = strings.TrimPrefix(kubernetesVersion, "v")
+
+	images := List(container.NewV1Alpha1(&v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineKubelet: &v1alpha1.KubeletConfig{
+				KubeletImage: fmt.Sprintf("%s:v%s", constants.KubeletImage, kubernetesVersion),
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			EtcdConfig: &v1alpha1.EtcdConfig{},
+			APIServerConfig: &v1alpha1.APIServerConfig{
+				ContainerImage: fmt.Sprintf("%s:v%s", constants.KubernetesAPIServerImage, kubernetesVersion),
+			},
+			ControllerManagerConfig: &v1alpha1.ControllerManagerConfig{
+				ContainerImage: fmt.Sprintf("%s:v%s", constants.KubernetesControllerManagerImage, kubernetesVersion),
+			},
+			SchedulerConfig: &v1alpha1.SchedulerConfig{
+				ContainerImage: fmt.Sprintf("%s:v%s", constants.KubernetesSchedulerImage, kubernetesVersion),
+			},
+			CoreDNSConfig: &v1alpha1.CoreDNS{},
+			ProxyConfig: &v1alpha1.ProxyConfig{
+				ContainerImage: fmt.Sprintf("%s:v%s", constants.KubeProxyImage, kubernetesVersion),
+			},
+		},
+	}))
+
+	images.Installer = DefaultInstallerImageRepository + ":v" + strings.TrimPrefix(talosVersion, "v")
+
+	return images
+}
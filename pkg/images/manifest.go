@@ -0,0 +1,189 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package images
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+)
+
+// Manifest is the air-gap manifest: the list of the images required to run Talos with their digests.
+//
+// The manifest can be used to mirror the images to the private registry,
+// and to generate the machine configuration with the digest-pinned images.
+type Manifest struct {
+	TalosVersion      string          `yaml:"talosVersion"`
+	KubernetesVersion string          `yaml:"kubernetesVersion"`
+	Images            []ManifestImage `yaml:"images"`
+}
+
+// ManifestImage is an image in the air-gap manifest.
+type ManifestImage struct {
+	// Name of the component, e.g. kube-apiserver.
+	Name string `yaml:"name"`
+	// Image reference with the tag.
+	Image string `yaml:"image"`
+	// Digest of the image (of the multi-platform index, if the image has one).
+	Digest string `yaml:"digest"`
+}
+
+// Pinned returns the image reference pinned to the digest.
+func (i ManifestImage) Pinned() string {
+	return i.Image + "@" + i.Digest
+}
+
+// Manifest component names.
+const (
+	ManifestEtcd                  = "etcd"
+	ManifestFlannel               = "flannel"
+	ManifestCoreDNS               = "coredns"
+	ManifestKubelet               = "kubelet"
+	ManifestKubeAPIServer         = "kube-apiserver"
+	ManifestKubeControllerManager = "kube-controller-manager"
+	ManifestKubeProxy             = "kube-proxy"
+	ManifestKubeScheduler         = "kube-scheduler"
+	ManifestInstaller             = "installer"
+	ManifestPause                 = "pause"
+)
+
+// named returns the images with the component names in a stable order.
+func (v Versions) named() []ManifestImage {
+	return []ManifestImage{
+		{Name: ManifestEtcd, Image: v.Etcd},
+		{Name: ManifestFlannel, Image: v.Flannel},
+		{Name: ManifestCoreDNS, Image: v.CoreDNS},
+		{Name: ManifestKubelet, Image: v.Kubelet},
+		{Name: ManifestKubeAPIServer, Image: v.KubeAPIServer},
+		{Name: ManifestKubeControllerManager, Image: v.KubeControllerManager},
+		{Name: ManifestKubeProxy, Image: v.KubeProxy},
+		{Name: ManifestKubeScheduler, Image: v.KubeScheduler},
+		{Name: ManifestInstaller, Image: v.Installer},
+		{Name: ManifestPause, Image: v.Pause},
+	}
+}
+
+// ResolveDigest returns the digest of the image in the registry.
+//
+// For multi-platform images, the digest of the index is returned, so that the reference pinned
+// to the digest works on all platforms.
+func ResolveDigest(ctx context.Context, image string, opts ...remote.Option) (string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", fmt.Errorf("error parsing image reference %q: %w", image, err)
+	}
+
+	if digest, ok := ref.(name.Digest); ok {
+		return digest.DigestStr(), nil
+	}
+
+	desc, err := remote.Head(ref, append([]remote.Option{remote.WithContext(ctx)}, opts...)...)
+	if err != nil {
+		return "", fmt.Errorf("error resolving image %q: %w", image, err)
+	}
+
+	return desc.Digest.String(), nil
+}
+
+// NewManifest resolves the default images for the Talos and Kubernetes versions to the digests.
+func NewManifest(ctx context.Context, talosVersion, kubernetesVersion string, opts ...remote.Option) (*Manifest, error) {
+	images := ListForVersions(talosVersion, kubernetesVersion).named()
+
+	for i := range images {
+		digest, err := ResolveDigest(ctx, images[i].Image, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		images[i].Digest = digest
+	}
+
+	return &Manifest{
+		TalosVersion:      talosVersion,
+		KubernetesVersion: kubernetesVersion,
+		Images:            images,
+	}, nil
+}
+
+// LoadManifest reads the manifest from the file.
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+
+	if err = yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("error parsing images manifest: %w", err)
+	}
+
+	for _, image := range manifest.Images {
+		if image.Name == "" || image.Image == "" || image.Digest == "" {
+			return nil, fmt.Errorf("images manifest entry %q should have the name, the image and the digest", image.Name)
+		}
+	}
+
+	return &manifest, nil
+}
+
+// Pinned returns the digest-pinned images from the manifest.
+//
+// Images missing in the manifest are left empty.
+func (m *Manifest) Pinned() Versions {
+	var v Versions
+
+	fields := map[string]*string{
+		ManifestEtcd:                  &v.Etcd,
+		ManifestFlannel:               &v.Flannel,
+		ManifestCoreDNS:               &v.CoreDNS,
+		ManifestKubelet:               &v.Kubelet,
+		ManifestKubeAPIServer:         &v.KubeAPIServer,
+		ManifestKubeControllerManager: &v.KubeControllerManager,
+		ManifestKubeProxy:             &v.KubeProxy,
+		ManifestKubeScheduler:         &v.KubeScheduler,
+		ManifestInstaller:             &v.Installer,
+		ManifestPause:                 &v.Pause,
+	}
+
+	for _, image := range m.Images {
+		if field, ok := fields[image.Name]; ok {
+			*field = image.Pinned()
+		}
+	}
+
+	return v
+}
+
+// GenerateOptions returns the config generation options which pin the images to the digests from the manifest.
+//
+// Flannel and pause images are not configurable, so they are only mirrored.
+func (m *Manifest) GenerateOptions() []generate.Option {
+	pinned := m.Pinned()
+
+	opts := []generate.Option{
+		generate.WithImages(generate.Images{
+			Kubelet:               pinned.Kubelet,
+			KubeAPIServer:         pinned.KubeAPIServer,
+			KubeControllerManager: pinned.KubeControllerManager,
+			KubeProxy:             pinned.KubeProxy,
+			KubeScheduler:         pinned.KubeScheduler,
+			Etcd:                  pinned.Etcd,
+			CoreDNS:               pinned.CoreDNS,
+		}),
+	}
+
+	if pinned.Installer != "" {
+		opts = append(opts, generate.WithInstallImage(pinned.Installer))
+	}
+
+	return opts
+}
@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package images_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/images"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
+func TestListForVersions(t *testing.T) {
+	t.Parallel()
+
+	list := images.ListForVersions("1.8.0", "v1.30.2")
+
+	assert.Equal(t, "ghcr.io/siderolabs/kubelet:v1.30.2", list.Kubelet)
+	assert.Equal(t, "registry.k8s.io/kube-apiserver:v1.30.2", list.KubeAPIServer)
+	assert.Equal(t, "registry.k8s.io/kube-proxy:v1.30.2", list.KubeProxy)
+	assert.True(t, strings.HasSuffix(list.Installer, "/installer:v1.8.0"), list.Installer)
+}
+
+func TestResolveDigest(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+
+	image := strings.TrimPrefix(srv.URL, "http://") + "/siderolabs/kubelet:v1.31.1"
+
+	ref, err := name.ParseReference(image)
+	require.NoError(t, err)
+
+	index, err := random.Index(1024, 1, 2)
+	require.NoError(t, err)
+
+	require.NoError(t, remote.WriteIndex(ref, index))
+
+	expected, err := index.Digest()
+	require.NoError(t, err)
+
+	digest, err := images.ResolveDigest(context.Background(), image)
+	require.NoError(t, err)
+
+	assert.Equal(t, expected.String(), digest)
+
+	// pinned references are not resolved
+	digest, err = images.ResolveDigest(context.Background(), "example.com/image@"+expected.String())
+	require.NoError(t, err)
+
+	assert.Equal(t, expected.String(), digest)
+
+	_, err = images.ResolveDigest(context.Background(), strings.TrimPrefix(srv.URL, "http://")+"/siderolabs/kubelet:v1.0.0")
+	require.Error(t, err)
+}
+
+func TestManifest(t *testing.T) {
+	t.Parallel()
+
+	const digest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+	path := filepath.Join(t.TempDir(), "manifest.yaml")
+
+	require.NoError(t, os.WriteFile(path, []byte(`talosVersion: v1.9.0
+kubernetesVersion: 1.31.1
+images:
+  - name: kubelet
+    image: ghcr.io/siderolabs/kubelet:v1.31.1
+    digest: `+digest+`
+  - name: kube-apiserver
+    image: registry.k8s.io/kube-apiserver:v1.31.1
+    digest: `+digest+`
+  - name: installer
+    image: ghcr.io/siderolabs/installer:v1.9.0
+    digest: `+digest+`
+`), 0o644))
+
+	manifest, err := images.LoadManifest(path)
+	require.NoError(t, err)
+
+	pinned := manifest.Pinned()
+
+	assert.Equal(t, "ghcr.io/siderolabs/kubelet:v1.31.1@"+digest, pinned.Kubelet)
+	assert.Empty(t, pinned.Etcd)
+
+	input, err := generate.NewInput("test", "https://10.0.1.5", manifest.KubernetesVersion, manifest.GenerateOptions()...)
+	require.NoError(t, err)
+
+	cfg, err := input.Config(machine.TypeControlPlane)
+	require.NoError(t, err)
+
+	assert.Equal(t, pinned.Kubelet, cfg.Machine().Kubelet().Image())
+	assert.Equal(t, pinned.KubeAPIServer, cfg.Cluster().APIServer().Image())
+	assert.Equal(t, "registry.k8s.io/kube-scheduler:v1.31.1", cfg.Cluster().Scheduler().Image())
+	assert.Equal(t, pinned.Installer, cfg.Machine().Install().Image())
+}
@@ -242,7 +242,8 @@ type Metadata struct {
 	// error is set if request failed to the upstream (rest of response is
 	// undefined)
 	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	// error as gRPC Status
+	// error as gRPC Status (injected by proxy with the node identity and
+	// retry information in the status details)
 	Status *status.Status `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
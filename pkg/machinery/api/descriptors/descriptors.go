@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package descriptors exports the protobuf descriptor set of the Talos API.
+//
+// The descriptor set can be used by the non-Go clients to generate the stubs
+// matching the exact Talos version, or for the dynamic (reflection-based) API access.
+package descriptors
+
+import (
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	_ "github.com/siderolabs/talos/pkg/machinery/api/audit"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/cluster"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/common"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/health"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/inspect"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/config"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/block"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/cluster"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/cri"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/enums"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/etcd"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/extensions"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/files"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/hardware"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/k8s"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/kubeaccess"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/kubespan"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/network"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/perf"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/proto"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/runtime"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/secrets"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/siderolink"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/time"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/v1alpha1"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/resource/network"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/security"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/storage"
+	_ "github.com/siderolabs/talos/pkg/machinery/api/time"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+// goPackagePrefix is the Go package prefix of the Talos API protobuf definitions.
+const goPackagePrefix = "github.com/siderolabs/talos/pkg/machinery/api/"
+
+// VersionedFileDescriptorSet is the Talos API descriptor set along with the Talos version.
+type VersionedFileDescriptorSet struct {
+	// Version is the Talos version the descriptor set was built with.
+	Version string
+	// FileDescriptorSet contains the Talos API files and all their dependencies.
+	FileDescriptorSet *descriptorpb.FileDescriptorSet
+}
+
+// Export returns the descriptor set of the Talos API.
+//
+// The set is self-contained: it includes all dependencies (e.g. well-known types), and the files
+// are sorted in the topological order (dependencies first), as expected by `protoc --descriptor_set_in`.
+func Export() *VersionedFileDescriptorSet {
+	var roots []protoreflect.FileDescriptor
+
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if strings.HasPrefix(fd.Options().(*descriptorpb.FileOptions).GetGoPackage(), goPackagePrefix) {
+			roots = append(roots, fd)
+		}
+
+		return true
+	})
+
+	// sort to make the output stable
+	slices.SortFunc(roots, func(a, b protoreflect.FileDescriptor) int {
+		return strings.Compare(a.Path(), b.Path())
+	})
+
+	set := &descriptorpb.FileDescriptorSet{}
+	visited := map[string]struct{}{}
+
+	var visit func(fd protoreflect.FileDescriptor)
+
+	visit = func(fd protoreflect.FileDescriptor) {
+		if _, ok := visited[fd.Path()]; ok {
+			return
+		}
+
+		visited[fd.Path()] = struct{}{}
+
+		imports := fd.Imports()
+
+		for i := range imports.Len() {
+			visit(imports.Get(i).FileDescriptor)
+		}
+
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+
+	for _, fd := range roots {
+		visit(fd)
+	}
+
+	return &VersionedFileDescriptorSet{
+		Version:           version.Tag,
+		FileDescriptorSet: set,
+	}
+}
+
+// Marshal returns the binary encoded descriptor set.
+func (s *VersionedFileDescriptorSet) Marshal() ([]byte, error) {
+	return proto.MarshalOptions{Deterministic: true}.Marshal(s.FileDescriptorSet)
+}
@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package descriptors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/descriptors"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	set := descriptors.Export()
+
+	assert.Equal(t, version.Tag, set.Version)
+
+	seen := map[string]struct{}{}
+
+	for _, file := range set.FileDescriptorSet.GetFile() {
+		// dependencies go first
+		for _, dep := range file.GetDependency() {
+			assert.Contains(t, seen, dep, "dependency %q of %q", dep, file.GetName())
+		}
+
+		seen[file.GetName()] = struct{}{}
+	}
+
+	for _, name := range []string{
+		"machine/machine.proto",
+		"common/common.proto",
+		"resource/definitions/runtime/runtime.proto",
+		"google/protobuf/empty.proto",
+	} {
+		assert.Contains(t, seen, name)
+	}
+
+	// the set is self-contained
+	files, err := protodesc.NewFiles(set.FileDescriptorSet)
+	require.NoError(t, err)
+
+	_, err = files.FindDescriptorByName("machine.MachineService")
+	require.NoError(t, err)
+
+	data, err := set.Marshal()
+	require.NoError(t, err)
+
+	var decoded descriptorpb.FileDescriptorSet
+
+	require.NoError(t, proto.Unmarshal(data, &decoded))
+	assert.Len(t, decoded.GetFile(), len(set.FileDescriptorSet.GetFile()))
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v5.27.4
+// source: ipam/ipam.proto
+
+package ipam
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	IPAMService_Allocate_FullMethodName = "/ipam.IPAMService/Allocate"
+)
+
+// IPAMServiceClient is the client API for IPAMService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The IPAM plugin service definition.
+//
+// IPAMService is implemented by the external IP address management (IPAM) plugins,
+// Talos calls it in maintenance mode to allocate static addresses while generating the machine configuration.
+type IPAMServiceClient interface {
+	// Allocate allocates a static address for the machine interface.
+	Allocate(ctx context.Context, in *AllocateRequest, opts ...grpc.CallOption) (*AllocateResponse, error)
+}
+
+type iPAMServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIPAMServiceClient(cc grpc.ClientConnInterface) IPAMServiceClient {
+	return &iPAMServiceClient{cc}
+}
+
+func (c *iPAMServiceClient) Allocate(ctx context.Context, in *AllocateRequest, opts ...grpc.CallOption) (*AllocateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AllocateResponse)
+	err := c.cc.Invoke(ctx, IPAMService_Allocate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IPAMServiceServer is the server API for IPAMService service.
+// All implementations must embed UnimplementedIPAMServiceServer
+// for forward compatibility
+//
+// The IPAM plugin service definition.
+//
+// IPAMService is implemented by the external IP address management (IPAM) plugins,
+// Talos calls it in maintenance mode to allocate static addresses while generating the machine configuration.
+type IPAMServiceServer interface {
+	// Allocate allocates a static address for the machine interface.
+	Allocate(context.Context, *AllocateRequest) (*AllocateResponse, error)
+	mustEmbedUnimplementedIPAMServiceServer()
+}
+
+// UnimplementedIPAMServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedIPAMServiceServer struct {
+}
+
+func (UnimplementedIPAMServiceServer) Allocate(context.Context, *AllocateRequest) (*AllocateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allocate not implemented")
+}
+func (UnimplementedIPAMServiceServer) mustEmbedUnimplementedIPAMServiceServer() {}
+
+// UnsafeIPAMServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IPAMServiceServer will
+// result in compilation errors.
+type UnsafeIPAMServiceServer interface {
+	mustEmbedUnimplementedIPAMServiceServer()
+}
+
+func RegisterIPAMServiceServer(s grpc.ServiceRegistrar, srv IPAMServiceServer) {
+	s.RegisterService(&IPAMService_ServiceDesc, srv)
+}
+
+func _IPAMService_Allocate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IPAMServiceServer).Allocate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IPAMService_Allocate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IPAMServiceServer).Allocate(ctx, req.(*AllocateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IPAMService_ServiceDesc is the grpc.ServiceDesc for IPAMService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IPAMService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ipam.IPAMService",
+	HandlerType: (*IPAMServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Allocate",
+			Handler:    _IPAMService_Allocate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ipam/ipam.proto",
+}
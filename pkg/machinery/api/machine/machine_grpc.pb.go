@@ -74,6 +74,33 @@ const (
 	MachineService_MetaDelete_FullMethodName                  = "/machine.MachineService/MetaDelete"
 	MachineService_ImageList_FullMethodName                   = "/machine.MachineService/ImageList"
 	MachineService_ImagePull_FullMethodName                   = "/machine.MachineService/ImagePull"
+	MachineService_ConntrackFlush_FullMethodName              = "/machine.MachineService/ConntrackFlush"
+	MachineService_RootfsIntegrity_FullMethodName             = "/machine.MachineService/RootfsIntegrity"
+	MachineService_ExtensionMetrics_FullMethodName            = "/machine.MachineService/ExtensionMetrics"
+	MachineService_GeneratedFiles_FullMethodName              = "/machine.MachineService/GeneratedFiles"
+	MachineService_EmergencyConsole_FullMethodName            = "/machine.MachineService/EmergencyConsole"
+	MachineService_EtcdConsistencyCheck_FullMethodName        = "/machine.MachineService/EtcdConsistencyCheck"
+	MachineService_ConntrackList_FullMethodName               = "/machine.MachineService/ConntrackList"
+	MachineService_FilesystemTrim_FullMethodName              = "/machine.MachineService/FilesystemTrim"
+	MachineService_UserFileWrite_FullMethodName               = "/machine.MachineService/UserFileWrite"
+	MachineService_UserFileRead_FullMethodName                = "/machine.MachineService/UserFileRead"
+	MachineService_Capabilities_FullMethodName                = "/machine.MachineService/Capabilities"
+	MachineService_KernelModuleParameterSet_FullMethodName    = "/machine.MachineService/KernelModuleParameterSet"
+	MachineService_RenewClientCertificate_FullMethodName      = "/machine.MachineService/RenewClientCertificate"
+	MachineService_EncryptionStatus_FullMethodName            = "/machine.MachineService/EncryptionStatus"
+	MachineService_EncryptionRotateKey_FullMethodName         = "/machine.MachineService/EncryptionRotateKey"
+	MachineService_EncryptionAddKey_FullMethodName            = "/machine.MachineService/EncryptionAddKey"
+	MachineService_EncryptionRemoveKey_FullMethodName         = "/machine.MachineService/EncryptionRemoveKey"
+	MachineService_SocketStatistics_FullMethodName            = "/machine.MachineService/SocketStatistics"
+	MachineService_EtcdMemberLatency_FullMethodName           = "/machine.MachineService/EtcdMemberLatency"
+	MachineService_SequenceList_FullMethodName                = "/machine.MachineService/SequenceList"
+	MachineService_SequenceCancel_FullMethodName              = "/machine.MachineService/SequenceCancel"
+	MachineService_ProcessesStream_FullMethodName             = "/machine.MachineService/ProcessesStream"
+	MachineService_CgroupStats_FullMethodName                 = "/machine.MachineService/CgroupStats"
+	MachineService_BenchmarkDisk_FullMethodName               = "/machine.MachineService/BenchmarkDisk"
+	MachineService_LogRecords_FullMethodName                  = "/machine.MachineService/LogRecords"
+	MachineService_FeatureFlagList_FullMethodName             = "/machine.MachineService/FeatureFlagList"
+	MachineService_FeatureFlagSet_FullMethodName              = "/machine.MachineService/FeatureFlagSet"
 )
 
 // MachineServiceClient is the client API for MachineService service.
@@ -163,6 +190,78 @@ type MachineServiceClient interface {
 	ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (MachineService_ImageListClient, error)
 	// ImagePull pulls an image into the CRI.
 	ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (*ImagePullResponse, error)
+	// ConntrackFlush deletes connection tracking entries matching the filter.
+	ConntrackFlush(ctx context.Context, in *ConntrackFlushRequest, opts ...grpc.CallOption) (*ConntrackFlushResponse, error)
+	// RootfsIntegrity verifies the rootfs image against the checksum manifest shipped with the initramfs.
+	RootfsIntegrity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RootfsIntegrityResponse, error)
+	// ExtensionMetrics returns metrics published by the extension services merged in the Prometheus text format.
+	ExtensionMetrics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExtensionMetricsResponse, error)
+	// GeneratedFiles returns a .tar.gz snapshot of the files generated by Talos:
+	// /etc files (resolv.conf, hosts, CRI configuration, etc.) and the kubelet configuration.
+	GeneratedFiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_GeneratedFilesClient, error)
+	// EmergencyConsole opens an interactive ("break-glass") diagnostic shell in a throwaway restricted container.
+	//
+	// The RPC is only available if enabled in the machine configuration, and every session is recorded to the audit log.
+	EmergencyConsole(ctx context.Context, opts ...grpc.CallOption) (MachineService_EmergencyConsoleClient, error)
+	// EtcdConsistencyCheck compares the key-value store hashes and revisions of all etcd members.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdConsistencyCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdConsistencyCheckResponse, error)
+	// ConntrackList lists connection tracking entries matching the filter.
+	ConntrackList(ctx context.Context, in *ConntrackListRequest, opts ...grpc.CallOption) (*ConntrackListResponse, error)
+	// FilesystemTrim discards the unused blocks of the mounted filesystems (fstrim).
+	FilesystemTrim(ctx context.Context, in *FilesystemTrimRequest, opts ...grpc.CallOption) (*FilesystemTrimResponse, error)
+	// UserFileWrite writes a file under the user files directories (/var/user and the extra mounts under /var/mnt).
+	UserFileWrite(ctx context.Context, opts ...grpc.CallOption) (MachineService_UserFileWriteClient, error)
+	// UserFileRead reads a file under the user files directories.
+	UserFileRead(ctx context.Context, in *UserFileReadRequest, opts ...grpc.CallOption) (MachineService_UserFileReadClient, error)
+	// Capabilities negotiates the API features supported by the client and the node.
+	Capabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error)
+	// KernelModuleParameterSet changes a parameter of the loaded kernel module.
+	// The change is not persisted across reboots, use the machine configuration to make it persistent.
+	KernelModuleParameterSet(ctx context.Context, in *KernelModuleParameterSetRequest, opts ...grpc.CallOption) (*KernelModuleParameterSetResponse, error)
+	// RenewClientCertificate issues a new client certificate with the roles of the caller's certificate.
+	// It is used to keep the short-lived client certificates fresh without access to the CA key.
+	RenewClientCertificate(ctx context.Context, in *RenewClientCertificateRequest, opts ...grpc.CallOption) (*RenewClientCertificateResponse, error)
+	// EncryptionStatus returns the disk encryption status and the key slots of the system volumes.
+	EncryptionStatus(ctx context.Context, in *EncryptionStatusRequest, opts ...grpc.CallOption) (*EncryptionStatusResponse, error)
+	// EncryptionRotateKey replaces the key in the key slot of the encrypted volume with a new key
+	// generated from the encryption configuration of the slot.
+	EncryptionRotateKey(ctx context.Context, in *EncryptionRotateKeyRequest, opts ...grpc.CallOption) (*EncryptionRotateKeyResponse, error)
+	// EncryptionAddKey enrolls a new key in the free key slot of the encrypted volume.
+	// The key slots which are not in the machine configuration are removed on the next boot.
+	EncryptionAddKey(ctx context.Context, in *EncryptionAddKeyRequest, opts ...grpc.CallOption) (*EncryptionAddKeyResponse, error)
+	// EncryptionRemoveKey removes the key slot from the encrypted volume.
+	// The key slots which are in the machine configuration are enrolled again on the next boot.
+	EncryptionRemoveKey(ctx context.Context, in *EncryptionRemoveKeyRequest, opts ...grpc.CallOption) (*EncryptionRemoveKeyResponse, error)
+	// SocketStatistics returns the listening sockets and the summary of the connections
+	// in each network namespace: the host one and the pod network namespaces.
+	SocketStatistics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SocketStatisticsResponse, error)
+	// EtcdMemberLatency measures the latency of the requests from the node to each etcd member.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdMemberLatency(ctx context.Context, in *EtcdMemberLatencyRequest, opts ...grpc.CallOption) (*EtcdMemberLatencyResponse, error)
+	// SequenceList returns the sequences (e.g. upgrade, reboot or reset) currently run by the node.
+	SequenceList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SequenceListResponse, error)
+	// SequenceCancel cancels the running sequence.
+	// The sequence can be canceled only while it runs a cancelable phase, i.e. before the node state is changed
+	// (lifecycle hooks and the node drain).
+	SequenceCancel(ctx context.Context, in *SequenceCancelRequest, opts ...grpc.CallOption) (*SequenceCancelResponse, error)
+	// ProcessesStream streams the snapshots of the running processes taken at the specified interval.
+	ProcessesStream(ctx context.Context, in *ProcessesStreamRequest, opts ...grpc.CallOption) (MachineService_ProcessesStreamClient, error)
+	// CgroupStats streams the resource usage of the cgroups sampled at the specified interval.
+	CgroupStats(ctx context.Context, in *CgroupStatsRequest, opts ...grpc.CallOption) (MachineService_CgroupStatsClient, error)
+	// BenchmarkDisk measures the fsync latency and the sequential and random I/O performance of the filesystem
+	// with a temporary file for a bounded duration, e.g. to verify that the disk meets the etcd latency requirements.
+	// The result is also stored as the DiskBenchmark resource.
+	BenchmarkDisk(ctx context.Context, in *BenchmarkDiskRequest, opts ...grpc.CallOption) (*BenchmarkDiskResponse, error)
+	// LogRecords streams the service or container logs as the parsed log records.
+	// The records are filtered by the level, the message pattern and the time range on the node.
+	LogRecords(ctx context.Context, in *LogRecordsRequest, opts ...grpc.CallOption) (MachineService_LogRecordsClient, error)
+	// FeatureFlagList lists the boolean feature flags of the machine configuration (.machine.features).
+	FeatureFlagList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FeatureFlagListResponse, error)
+	// FeatureFlagSet toggles the feature flag on the node by patching the machine configuration.
+	// Only the flags which can be applied without a reboot can be toggled, the change is persisted
+	// with the machine configuration and recorded as the FeatureFlagChangeEvent.
+	FeatureFlagSet(ctx context.Context, in *FeatureFlagSetRequest, opts ...grpc.CallOption) (*FeatureFlagSetResponse, error)
 }
 
 type machineServiceClient struct {
@@ -961,1221 +1060,2323 @@ func (c *machineServiceClient) ImagePull(ctx context.Context, in *ImagePullReque
 	return out, nil
 }
 
-// MachineServiceServer is the server API for MachineService service.
-// All implementations must embed UnimplementedMachineServiceServer
-// for forward compatibility
-//
-// The machine service definition.
-type MachineServiceServer interface {
-	ApplyConfiguration(context.Context, *ApplyConfigurationRequest) (*ApplyConfigurationResponse, error)
-	// Bootstrap method makes control plane node enter etcd bootstrap mode.
-	// Node aborts etcd join sequence and creates single-node etcd cluster.
-	// If recover_etcd argument is specified, etcd is recovered from a snapshot
-	// uploaded with EtcdRecover.
-	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
-	Containers(context.Context, *ContainersRequest) (*ContainersResponse, error)
-	Copy(*CopyRequest, MachineService_CopyServer) error
-	CPUInfo(context.Context, *emptypb.Empty) (*CPUInfoResponse, error)
-	DiskStats(context.Context, *emptypb.Empty) (*DiskStatsResponse, error)
-	Dmesg(*DmesgRequest, MachineService_DmesgServer) error
-	Events(*EventsRequest, MachineService_EventsServer) error
-	EtcdMemberList(context.Context, *EtcdMemberListRequest) (*EtcdMemberListResponse, error)
-	// EtcdRemoveMemberByID removes a member from the etcd cluster identified by member ID.
-	// This API should be used to remove members which don't have an associated Talos node anymore.
-	// To remove a member with a running Talos node, use EtcdLeaveCluster API on the node to be removed.
-	EtcdRemoveMemberByID(context.Context, *EtcdRemoveMemberByIDRequest) (*EtcdRemoveMemberByIDResponse, error)
-	EtcdLeaveCluster(context.Context, *EtcdLeaveClusterRequest) (*EtcdLeaveClusterResponse, error)
-	EtcdForfeitLeadership(context.Context, *EtcdForfeitLeadershipRequest) (*EtcdForfeitLeadershipResponse, error)
-	// EtcdRecover method uploads etcd data snapshot created with EtcdSnapshot
-	// to the node.
-	// Snapshot can be later used to recover the cluster via Bootstrap method.
-	EtcdRecover(MachineService_EtcdRecoverServer) error
-	// EtcdSnapshot method creates etcd data snapshot (backup) from the local etcd instance
-	// and streams it back to the client.
-	// This method is available only on control plane nodes (which run etcd).
-	EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error
-	// EtcdAlarmList lists etcd alarms for the current node.
-	// This method is available only on control plane nodes (which run etcd).
-	EtcdAlarmList(context.Context, *emptypb.Empty) (*EtcdAlarmListResponse, error)
-	// EtcdAlarmDisarm disarms etcd alarms for the current node.
-	// This method is available only on control plane nodes (which run etcd).
-	EtcdAlarmDisarm(context.Context, *emptypb.Empty) (*EtcdAlarmDisarmResponse, error)
-	// EtcdDefragment defragments etcd data directory for the current node.
-	// Defragmentation is a resource-heavy operation, so it should only run on a specific
-	// node.
-	// This method is available only on control plane nodes (which run etcd).
-	EtcdDefragment(context.Context, *emptypb.Empty) (*EtcdDefragmentResponse, error)
-	// EtcdStatus returns etcd status for the current member.
-	// This method is available only on control plane nodes (which run etcd).
-	EtcdStatus(context.Context, *emptypb.Empty) (*EtcdStatusResponse, error)
-	GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error)
-	Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error)
-	Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error
-	List(*ListRequest, MachineService_ListServer) error
-	DiskUsage(*DiskUsageRequest, MachineService_DiskUsageServer) error
-	LoadAvg(context.Context, *emptypb.Empty) (*LoadAvgResponse, error)
-	Logs(*LogsRequest, MachineService_LogsServer) error
-	LogsContainers(context.Context, *emptypb.Empty) (*LogsContainersResponse, error)
-	Memory(context.Context, *emptypb.Empty) (*MemoryResponse, error)
-	Mounts(context.Context, *emptypb.Empty) (*MountsResponse, error)
-	NetworkDeviceStats(context.Context, *emptypb.Empty) (*NetworkDeviceStatsResponse, error)
-	Processes(context.Context, *emptypb.Empty) (*ProcessesResponse, error)
-	Read(*ReadRequest, MachineService_ReadServer) error
-	Reboot(context.Context, *RebootRequest) (*RebootResponse, error)
-	Restart(context.Context, *RestartRequest) (*RestartResponse, error)
-	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
-	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
-	ServiceList(context.Context, *emptypb.Empty) (*ServiceListResponse, error)
-	ServiceRestart(context.Context, *ServiceRestartRequest) (*ServiceRestartResponse, error)
-	ServiceStart(context.Context, *ServiceStartRequest) (*ServiceStartResponse, error)
-	ServiceStop(context.Context, *ServiceStopRequest) (*ServiceStopResponse, error)
-	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
-	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
-	SystemStat(context.Context, *emptypb.Empty) (*SystemStatResponse, error)
-	Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error)
-	Version(context.Context, *emptypb.Empty) (*VersionResponse, error)
-	// GenerateClientConfiguration generates talosctl client configuration (talosconfig).
-	GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error)
-	// PacketCapture performs packet capture and streams back pcap file.
-	PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error
-	// Netstat provides information about network connections.
-	Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error)
-	// MetaWrite writes a META key-value pair.
-	MetaWrite(context.Context, *MetaWriteRequest) (*MetaWriteResponse, error)
-	// MetaDelete deletes a META key.
-	MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error)
-	// ImageList lists images in the CRI.
-	ImageList(*ImageListRequest, MachineService_ImageListServer) error
-	// ImagePull pulls an image into the CRI.
-	ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error)
-	mustEmbedUnimplementedMachineServiceServer()
+func (c *machineServiceClient) ConntrackFlush(ctx context.Context, in *ConntrackFlushRequest, opts ...grpc.CallOption) (*ConntrackFlushResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConntrackFlushResponse)
+	err := c.cc.Invoke(ctx, MachineService_ConntrackFlush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMachineServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedMachineServiceServer struct {
+func (c *machineServiceClient) RootfsIntegrity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RootfsIntegrityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RootfsIntegrityResponse)
+	err := c.cc.Invoke(ctx, MachineService_RootfsIntegrity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedMachineServiceServer) ApplyConfiguration(context.Context, *ApplyConfigurationRequest) (*ApplyConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfiguration not implemented")
-}
-func (UnimplementedMachineServiceServer) Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bootstrap not implemented")
+func (c *machineServiceClient) ExtensionMetrics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExtensionMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtensionMetricsResponse)
+	err := c.cc.Invoke(ctx, MachineService_ExtensionMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Containers(context.Context, *ContainersRequest) (*ContainersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Containers not implemented")
+
+func (c *machineServiceClient) GeneratedFiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_GeneratedFilesClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[12], MachineService_GeneratedFiles_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceGeneratedFilesClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedMachineServiceServer) Copy(*CopyRequest, MachineService_CopyServer) error {
-	return status.Errorf(codes.Unimplemented, "method Copy not implemented")
+
+type MachineService_GeneratedFilesClient interface {
+	Recv() (*common.Data, error)
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) CPUInfo(context.Context, *emptypb.Empty) (*CPUInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CPUInfo not implemented")
+
+type machineServiceGeneratedFilesClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) DiskStats(context.Context, *emptypb.Empty) (*DiskStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DiskStats not implemented")
+
+func (x *machineServiceGeneratedFilesClient) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedMachineServiceServer) Dmesg(*DmesgRequest, MachineService_DmesgServer) error {
-	return status.Errorf(codes.Unimplemented, "method Dmesg not implemented")
+
+func (c *machineServiceClient) EmergencyConsole(ctx context.Context, opts ...grpc.CallOption) (MachineService_EmergencyConsoleClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[13], MachineService_EmergencyConsole_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceEmergencyConsoleClient{ClientStream: stream}
+	return x, nil
 }
-func (UnimplementedMachineServiceServer) Events(*EventsRequest, MachineService_EventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+
+type MachineService_EmergencyConsoleClient interface {
+	Send(*EmergencyConsoleRequest) error
+	Recv() (*EmergencyConsoleResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) EtcdMemberList(context.Context, *EtcdMemberListRequest) (*EtcdMemberListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdMemberList not implemented")
+
+type machineServiceEmergencyConsoleClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) EtcdRemoveMemberByID(context.Context, *EtcdRemoveMemberByIDRequest) (*EtcdRemoveMemberByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdRemoveMemberByID not implemented")
+
+func (x *machineServiceEmergencyConsoleClient) Send(m *EmergencyConsoleRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (UnimplementedMachineServiceServer) EtcdLeaveCluster(context.Context, *EtcdLeaveClusterRequest) (*EtcdLeaveClusterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdLeaveCluster not implemented")
+
+func (x *machineServiceEmergencyConsoleClient) Recv() (*EmergencyConsoleResponse, error) {
+	m := new(EmergencyConsoleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedMachineServiceServer) EtcdForfeitLeadership(context.Context, *EtcdForfeitLeadershipRequest) (*EtcdForfeitLeadershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdForfeitLeadership not implemented")
+
+func (c *machineServiceClient) EtcdConsistencyCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdConsistencyCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EtcdConsistencyCheckResponse)
+	err := c.cc.Invoke(ctx, MachineService_EtcdConsistencyCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) EtcdRecover(MachineService_EtcdRecoverServer) error {
-	return status.Errorf(codes.Unimplemented, "method EtcdRecover not implemented")
+
+func (c *machineServiceClient) ConntrackList(ctx context.Context, in *ConntrackListRequest, opts ...grpc.CallOption) (*ConntrackListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConntrackListResponse)
+	err := c.cc.Invoke(ctx, MachineService_ConntrackList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error {
-	return status.Errorf(codes.Unimplemented, "method EtcdSnapshot not implemented")
+
+func (c *machineServiceClient) FilesystemTrim(ctx context.Context, in *FilesystemTrimRequest, opts ...grpc.CallOption) (*FilesystemTrimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilesystemTrimResponse)
+	err := c.cc.Invoke(ctx, MachineService_FilesystemTrim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) EtcdAlarmList(context.Context, *emptypb.Empty) (*EtcdAlarmListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmList not implemented")
+
+func (c *machineServiceClient) UserFileWrite(ctx context.Context, opts ...grpc.CallOption) (MachineService_UserFileWriteClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[14], MachineService_UserFileWrite_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceUserFileWriteClient{ClientStream: stream}
+	return x, nil
 }
-func (UnimplementedMachineServiceServer) EtcdAlarmDisarm(context.Context, *emptypb.Empty) (*EtcdAlarmDisarmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmDisarm not implemented")
+
+type MachineService_UserFileWriteClient interface {
+	Send(*UserFileWriteRequest) error
+	CloseAndRecv() (*UserFileWriteResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) EtcdDefragment(context.Context, *emptypb.Empty) (*EtcdDefragmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdDefragment not implemented")
+
+type machineServiceUserFileWriteClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) EtcdStatus(context.Context, *emptypb.Empty) (*EtcdStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdStatus not implemented")
+
+func (x *machineServiceUserFileWriteClient) Send(m *UserFileWriteRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (UnimplementedMachineServiceServer) GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfiguration not implemented")
+
+func (x *machineServiceUserFileWriteClient) CloseAndRecv() (*UserFileWriteResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UserFileWriteResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedMachineServiceServer) Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Hostname not implemented")
+
+func (c *machineServiceClient) UserFileRead(ctx context.Context, in *UserFileReadRequest, opts ...grpc.CallOption) (MachineService_UserFileReadClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[15], MachineService_UserFileRead_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceUserFileReadClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedMachineServiceServer) Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error {
-	return status.Errorf(codes.Unimplemented, "method Kubeconfig not implemented")
+
+type MachineService_UserFileReadClient interface {
+	Recv() (*common.Data, error)
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) List(*ListRequest, MachineService_ListServer) error {
-	return status.Errorf(codes.Unimplemented, "method List not implemented")
+
+type machineServiceUserFileReadClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) DiskUsage(*DiskUsageRequest, MachineService_DiskUsageServer) error {
-	return status.Errorf(codes.Unimplemented, "method DiskUsage not implemented")
+
+func (x *machineServiceUserFileReadClient) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedMachineServiceServer) LoadAvg(context.Context, *emptypb.Empty) (*LoadAvgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoadAvg not implemented")
+
+func (c *machineServiceClient) Capabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CapabilitiesResponse)
+	err := c.cc.Invoke(ctx, MachineService_Capabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Logs(*LogsRequest, MachineService_LogsServer) error {
-	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+
+func (c *machineServiceClient) KernelModuleParameterSet(ctx context.Context, in *KernelModuleParameterSetRequest, opts ...grpc.CallOption) (*KernelModuleParameterSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KernelModuleParameterSetResponse)
+	err := c.cc.Invoke(ctx, MachineService_KernelModuleParameterSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) LogsContainers(context.Context, *emptypb.Empty) (*LogsContainersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LogsContainers not implemented")
+
+func (c *machineServiceClient) RenewClientCertificate(ctx context.Context, in *RenewClientCertificateRequest, opts ...grpc.CallOption) (*RenewClientCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewClientCertificateResponse)
+	err := c.cc.Invoke(ctx, MachineService_RenewClientCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Memory(context.Context, *emptypb.Empty) (*MemoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Memory not implemented")
+
+func (c *machineServiceClient) EncryptionStatus(ctx context.Context, in *EncryptionStatusRequest, opts ...grpc.CallOption) (*EncryptionStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncryptionStatusResponse)
+	err := c.cc.Invoke(ctx, MachineService_EncryptionStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Mounts(context.Context, *emptypb.Empty) (*MountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mounts not implemented")
+
+func (c *machineServiceClient) EncryptionRotateKey(ctx context.Context, in *EncryptionRotateKeyRequest, opts ...grpc.CallOption) (*EncryptionRotateKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncryptionRotateKeyResponse)
+	err := c.cc.Invoke(ctx, MachineService_EncryptionRotateKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) NetworkDeviceStats(context.Context, *emptypb.Empty) (*NetworkDeviceStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NetworkDeviceStats not implemented")
+
+func (c *machineServiceClient) EncryptionAddKey(ctx context.Context, in *EncryptionAddKeyRequest, opts ...grpc.CallOption) (*EncryptionAddKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncryptionAddKeyResponse)
+	err := c.cc.Invoke(ctx, MachineService_EncryptionAddKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Processes(context.Context, *emptypb.Empty) (*ProcessesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Processes not implemented")
+
+func (c *machineServiceClient) EncryptionRemoveKey(ctx context.Context, in *EncryptionRemoveKeyRequest, opts ...grpc.CallOption) (*EncryptionRemoveKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncryptionRemoveKeyResponse)
+	err := c.cc.Invoke(ctx, MachineService_EncryptionRemoveKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Read(*ReadRequest, MachineService_ReadServer) error {
-	return status.Errorf(codes.Unimplemented, "method Read not implemented")
+
+func (c *machineServiceClient) SocketStatistics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SocketStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SocketStatisticsResponse)
+	err := c.cc.Invoke(ctx, MachineService_SocketStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Reboot(context.Context, *RebootRequest) (*RebootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Reboot not implemented")
+
+func (c *machineServiceClient) EtcdMemberLatency(ctx context.Context, in *EtcdMemberLatencyRequest, opts ...grpc.CallOption) (*EtcdMemberLatencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EtcdMemberLatencyResponse)
+	err := c.cc.Invoke(ctx, MachineService_EtcdMemberLatency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Restart(context.Context, *RestartRequest) (*RestartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Restart not implemented")
+
+func (c *machineServiceClient) SequenceList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SequenceListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SequenceListResponse)
+	err := c.cc.Invoke(ctx, MachineService_SequenceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+
+func (c *machineServiceClient) SequenceCancel(ctx context.Context, in *SequenceCancelRequest, opts ...grpc.CallOption) (*SequenceCancelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SequenceCancelResponse)
+	err := c.cc.Invoke(ctx, MachineService_SequenceCancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Reset(context.Context, *ResetRequest) (*ResetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
+
+func (c *machineServiceClient) ProcessesStream(ctx context.Context, in *ProcessesStreamRequest, opts ...grpc.CallOption) (MachineService_ProcessesStreamClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[16], MachineService_ProcessesStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceProcessesStreamClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedMachineServiceServer) ServiceList(context.Context, *emptypb.Empty) (*ServiceListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceList not implemented")
+
+type MachineService_ProcessesStreamClient interface {
+	Recv() (*ProcessesSample, error)
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) ServiceRestart(context.Context, *ServiceRestartRequest) (*ServiceRestartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceRestart not implemented")
+
+type machineServiceProcessesStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) ServiceStart(context.Context, *ServiceStartRequest) (*ServiceStartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceStart not implemented")
+
+func (x *machineServiceProcessesStreamClient) Recv() (*ProcessesSample, error) {
+	m := new(ProcessesSample)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedMachineServiceServer) ServiceStop(context.Context, *ServiceStopRequest) (*ServiceStopResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceStop not implemented")
+
+func (c *machineServiceClient) CgroupStats(ctx context.Context, in *CgroupStatsRequest, opts ...grpc.CallOption) (MachineService_CgroupStatsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[17], MachineService_CgroupStats_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceCgroupStatsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedMachineServiceServer) Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+
+type MachineService_CgroupStatsClient interface {
+	Recv() (*CgroupStats, error)
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+
+type machineServiceCgroupStatsClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) SystemStat(context.Context, *emptypb.Empty) (*SystemStatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemStat not implemented")
+
+func (x *machineServiceCgroupStatsClient) Recv() (*CgroupStats, error) {
+	m := new(CgroupStats)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedMachineServiceServer) Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Upgrade not implemented")
+
+func (c *machineServiceClient) BenchmarkDisk(ctx context.Context, in *BenchmarkDiskRequest, opts ...grpc.CallOption) (*BenchmarkDiskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BenchmarkDiskResponse)
+	err := c.cc.Invoke(ctx, MachineService_BenchmarkDisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Version(context.Context, *emptypb.Empty) (*VersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+
+func (c *machineServiceClient) LogRecords(ctx context.Context, in *LogRecordsRequest, opts ...grpc.CallOption) (MachineService_LogRecordsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[18], MachineService_LogRecords_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceLogRecordsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedMachineServiceServer) GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateClientConfiguration not implemented")
+
+type MachineService_LogRecordsClient interface {
+	Recv() (*LogRecord, error)
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error {
-	return status.Errorf(codes.Unimplemented, "method PacketCapture not implemented")
+
+type machineServiceLogRecordsClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Netstat not implemented")
+
+func (x *machineServiceLogRecordsClient) Recv() (*LogRecord, error) {
+	m := new(LogRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedMachineServiceServer) MetaWrite(context.Context, *MetaWriteRequest) (*MetaWriteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MetaWrite not implemented")
+
+func (c *machineServiceClient) FeatureFlagList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FeatureFlagListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureFlagListResponse)
+	err := c.cc.Invoke(ctx, MachineService_FeatureFlagList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MetaDelete not implemented")
+
+func (c *machineServiceClient) FeatureFlagSet(ctx context.Context, in *FeatureFlagSetRequest, opts ...grpc.CallOption) (*FeatureFlagSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureFlagSetResponse)
+	err := c.cc.Invoke(ctx, MachineService_FeatureFlagSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MachineServiceServer is the server API for MachineService service.
+// All implementations must embed UnimplementedMachineServiceServer
+// for forward compatibility
+//
+// The machine service definition.
+type MachineServiceServer interface {
+	ApplyConfiguration(context.Context, *ApplyConfigurationRequest) (*ApplyConfigurationResponse, error)
+	// Bootstrap method makes control plane node enter etcd bootstrap mode.
+	// Node aborts etcd join sequence and creates single-node etcd cluster.
+	// If recover_etcd argument is specified, etcd is recovered from a snapshot
+	// uploaded with EtcdRecover.
+	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
+	Containers(context.Context, *ContainersRequest) (*ContainersResponse, error)
+	Copy(*CopyRequest, MachineService_CopyServer) error
+	CPUInfo(context.Context, *emptypb.Empty) (*CPUInfoResponse, error)
+	DiskStats(context.Context, *emptypb.Empty) (*DiskStatsResponse, error)
+	Dmesg(*DmesgRequest, MachineService_DmesgServer) error
+	Events(*EventsRequest, MachineService_EventsServer) error
+	EtcdMemberList(context.Context, *EtcdMemberListRequest) (*EtcdMemberListResponse, error)
+	// EtcdRemoveMemberByID removes a member from the etcd cluster identified by member ID.
+	// This API should be used to remove members which don't have an associated Talos node anymore.
+	// To remove a member with a running Talos node, use EtcdLeaveCluster API on the node to be removed.
+	EtcdRemoveMemberByID(context.Context, *EtcdRemoveMemberByIDRequest) (*EtcdRemoveMemberByIDResponse, error)
+	EtcdLeaveCluster(context.Context, *EtcdLeaveClusterRequest) (*EtcdLeaveClusterResponse, error)
+	EtcdForfeitLeadership(context.Context, *EtcdForfeitLeadershipRequest) (*EtcdForfeitLeadershipResponse, error)
+	// EtcdRecover method uploads etcd data snapshot created with EtcdSnapshot
+	// to the node.
+	// Snapshot can be later used to recover the cluster via Bootstrap method.
+	EtcdRecover(MachineService_EtcdRecoverServer) error
+	// EtcdSnapshot method creates etcd data snapshot (backup) from the local etcd instance
+	// and streams it back to the client.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error
+	// EtcdAlarmList lists etcd alarms for the current node.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmList(context.Context, *emptypb.Empty) (*EtcdAlarmListResponse, error)
+	// EtcdAlarmDisarm disarms etcd alarms for the current node.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmDisarm(context.Context, *emptypb.Empty) (*EtcdAlarmDisarmResponse, error)
+	// EtcdDefragment defragments etcd data directory for the current node.
+	// Defragmentation is a resource-heavy operation, so it should only run on a specific
+	// node.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdDefragment(context.Context, *emptypb.Empty) (*EtcdDefragmentResponse, error)
+	// EtcdStatus returns etcd status for the current member.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdStatus(context.Context, *emptypb.Empty) (*EtcdStatusResponse, error)
+	GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error)
+	Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error)
+	Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error
+	List(*ListRequest, MachineService_ListServer) error
+	DiskUsage(*DiskUsageRequest, MachineService_DiskUsageServer) error
+	LoadAvg(context.Context, *emptypb.Empty) (*LoadAvgResponse, error)
+	Logs(*LogsRequest, MachineService_LogsServer) error
+	LogsContainers(context.Context, *emptypb.Empty) (*LogsContainersResponse, error)
+	Memory(context.Context, *emptypb.Empty) (*MemoryResponse, error)
+	Mounts(context.Context, *emptypb.Empty) (*MountsResponse, error)
+	NetworkDeviceStats(context.Context, *emptypb.Empty) (*NetworkDeviceStatsResponse, error)
+	Processes(context.Context, *emptypb.Empty) (*ProcessesResponse, error)
+	Read(*ReadRequest, MachineService_ReadServer) error
+	Reboot(context.Context, *RebootRequest) (*RebootResponse, error)
+	Restart(context.Context, *RestartRequest) (*RestartResponse, error)
+	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
+	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
+	ServiceList(context.Context, *emptypb.Empty) (*ServiceListResponse, error)
+	ServiceRestart(context.Context, *ServiceRestartRequest) (*ServiceRestartResponse, error)
+	ServiceStart(context.Context, *ServiceStartRequest) (*ServiceStartResponse, error)
+	ServiceStop(context.Context, *ServiceStopRequest) (*ServiceStopResponse, error)
+	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	SystemStat(context.Context, *emptypb.Empty) (*SystemStatResponse, error)
+	Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error)
+	Version(context.Context, *emptypb.Empty) (*VersionResponse, error)
+	// GenerateClientConfiguration generates talosctl client configuration (talosconfig).
+	GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error)
+	// PacketCapture performs packet capture and streams back pcap file.
+	PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error
+	// Netstat provides information about network connections.
+	Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error)
+	// MetaWrite writes a META key-value pair.
+	MetaWrite(context.Context, *MetaWriteRequest) (*MetaWriteResponse, error)
+	// MetaDelete deletes a META key.
+	MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error)
+	// ImageList lists images in the CRI.
+	ImageList(*ImageListRequest, MachineService_ImageListServer) error
+	// ImagePull pulls an image into the CRI.
+	ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error)
+	// ConntrackFlush deletes connection tracking entries matching the filter.
+	ConntrackFlush(context.Context, *ConntrackFlushRequest) (*ConntrackFlushResponse, error)
+	// RootfsIntegrity verifies the rootfs image against the checksum manifest shipped with the initramfs.
+	RootfsIntegrity(context.Context, *emptypb.Empty) (*RootfsIntegrityResponse, error)
+	// ExtensionMetrics returns metrics published by the extension services merged in the Prometheus text format.
+	ExtensionMetrics(context.Context, *emptypb.Empty) (*ExtensionMetricsResponse, error)
+	// GeneratedFiles returns a .tar.gz snapshot of the files generated by Talos:
+	// /etc files (resolv.conf, hosts, CRI configuration, etc.) and the kubelet configuration.
+	GeneratedFiles(*emptypb.Empty, MachineService_GeneratedFilesServer) error
+	// EmergencyConsole opens an interactive ("break-glass") diagnostic shell in a throwaway restricted container.
+	//
+	// The RPC is only available if enabled in the machine configuration, and every session is recorded to the audit log.
+	EmergencyConsole(MachineService_EmergencyConsoleServer) error
+	// EtcdConsistencyCheck compares the key-value store hashes and revisions of all etcd members.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdConsistencyCheck(context.Context, *emptypb.Empty) (*EtcdConsistencyCheckResponse, error)
+	// ConntrackList lists connection tracking entries matching the filter.
+	ConntrackList(context.Context, *ConntrackListRequest) (*ConntrackListResponse, error)
+	// FilesystemTrim discards the unused blocks of the mounted filesystems (fstrim).
+	FilesystemTrim(context.Context, *FilesystemTrimRequest) (*FilesystemTrimResponse, error)
+	// UserFileWrite writes a file under the user files directories (/var/user and the extra mounts under /var/mnt).
+	UserFileWrite(MachineService_UserFileWriteServer) error
+	// UserFileRead reads a file under the user files directories.
+	UserFileRead(*UserFileReadRequest, MachineService_UserFileReadServer) error
+	// Capabilities negotiates the API features supported by the client and the node.
+	Capabilities(context.Context, *CapabilitiesRequest) (*CapabilitiesResponse, error)
+	// KernelModuleParameterSet changes a parameter of the loaded kernel module.
+	// The change is not persisted across reboots, use the machine configuration to make it persistent.
+	KernelModuleParameterSet(context.Context, *KernelModuleParameterSetRequest) (*KernelModuleParameterSetResponse, error)
+	// RenewClientCertificate issues a new client certificate with the roles of the caller's certificate.
+	// It is used to keep the short-lived client certificates fresh without access to the CA key.
+	RenewClientCertificate(context.Context, *RenewClientCertificateRequest) (*RenewClientCertificateResponse, error)
+	// EncryptionStatus returns the disk encryption status and the key slots of the system volumes.
+	EncryptionStatus(context.Context, *EncryptionStatusRequest) (*EncryptionStatusResponse, error)
+	// EncryptionRotateKey replaces the key in the key slot of the encrypted volume with a new key
+	// generated from the encryption configuration of the slot.
+	EncryptionRotateKey(context.Context, *EncryptionRotateKeyRequest) (*EncryptionRotateKeyResponse, error)
+	// EncryptionAddKey enrolls a new key in the free key slot of the encrypted volume.
+	// The key slots which are not in the machine configuration are removed on the next boot.
+	EncryptionAddKey(context.Context, *EncryptionAddKeyRequest) (*EncryptionAddKeyResponse, error)
+	// EncryptionRemoveKey removes the key slot from the encrypted volume.
+	// The key slots which are in the machine configuration are enrolled again on the next boot.
+	EncryptionRemoveKey(context.Context, *EncryptionRemoveKeyRequest) (*EncryptionRemoveKeyResponse, error)
+	// SocketStatistics returns the listening sockets and the summary of the connections
+	// in each network namespace: the host one and the pod network namespaces.
+	SocketStatistics(context.Context, *emptypb.Empty) (*SocketStatisticsResponse, error)
+	// EtcdMemberLatency measures the latency of the requests from the node to each etcd member.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdMemberLatency(context.Context, *EtcdMemberLatencyRequest) (*EtcdMemberLatencyResponse, error)
+	// SequenceList returns the sequences (e.g. upgrade, reboot or reset) currently run by the node.
+	SequenceList(context.Context, *emptypb.Empty) (*SequenceListResponse, error)
+	// SequenceCancel cancels the running sequence.
+	// The sequence can be canceled only while it runs a cancelable phase, i.e. before the node state is changed
+	// (lifecycle hooks and the node drain).
+	SequenceCancel(context.Context, *SequenceCancelRequest) (*SequenceCancelResponse, error)
+	// ProcessesStream streams the snapshots of the running processes taken at the specified interval.
+	ProcessesStream(*ProcessesStreamRequest, MachineService_ProcessesStreamServer) error
+	// CgroupStats streams the resource usage of the cgroups sampled at the specified interval.
+	CgroupStats(*CgroupStatsRequest, MachineService_CgroupStatsServer) error
+	// BenchmarkDisk measures the fsync latency and the sequential and random I/O performance of the filesystem
+	// with a temporary file for a bounded duration, e.g. to verify that the disk meets the etcd latency requirements.
+	// The result is also stored as the DiskBenchmark resource.
+	BenchmarkDisk(context.Context, *BenchmarkDiskRequest) (*BenchmarkDiskResponse, error)
+	// LogRecords streams the service or container logs as the parsed log records.
+	// The records are filtered by the level, the message pattern and the time range on the node.
+	LogRecords(*LogRecordsRequest, MachineService_LogRecordsServer) error
+	// FeatureFlagList lists the boolean feature flags of the machine configuration (.machine.features).
+	FeatureFlagList(context.Context, *emptypb.Empty) (*FeatureFlagListResponse, error)
+	// FeatureFlagSet toggles the feature flag on the node by patching the machine configuration.
+	// Only the flags which can be applied without a reboot can be toggled, the change is persisted
+	// with the machine configuration and recorded as the FeatureFlagChangeEvent.
+	FeatureFlagSet(context.Context, *FeatureFlagSetRequest) (*FeatureFlagSetResponse, error)
+	mustEmbedUnimplementedMachineServiceServer()
+}
+
+// UnimplementedMachineServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMachineServiceServer struct {
+}
+
+func (UnimplementedMachineServiceServer) ApplyConfiguration(context.Context, *ApplyConfigurationRequest) (*ApplyConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfiguration not implemented")
+}
+func (UnimplementedMachineServiceServer) Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bootstrap not implemented")
+}
+func (UnimplementedMachineServiceServer) Containers(context.Context, *ContainersRequest) (*ContainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Containers not implemented")
+}
+func (UnimplementedMachineServiceServer) Copy(*CopyRequest, MachineService_CopyServer) error {
+	return status.Errorf(codes.Unimplemented, "method Copy not implemented")
+}
+func (UnimplementedMachineServiceServer) CPUInfo(context.Context, *emptypb.Empty) (*CPUInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CPUInfo not implemented")
+}
+func (UnimplementedMachineServiceServer) DiskStats(context.Context, *emptypb.Empty) (*DiskStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskStats not implemented")
+}
+func (UnimplementedMachineServiceServer) Dmesg(*DmesgRequest, MachineService_DmesgServer) error {
+	return status.Errorf(codes.Unimplemented, "method Dmesg not implemented")
+}
+func (UnimplementedMachineServiceServer) Events(*EventsRequest, MachineService_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdMemberList(context.Context, *EtcdMemberListRequest) (*EtcdMemberListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdMemberList not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdRemoveMemberByID(context.Context, *EtcdRemoveMemberByIDRequest) (*EtcdRemoveMemberByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdRemoveMemberByID not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdLeaveCluster(context.Context, *EtcdLeaveClusterRequest) (*EtcdLeaveClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdLeaveCluster not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdForfeitLeadership(context.Context, *EtcdForfeitLeadershipRequest) (*EtcdForfeitLeadershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdForfeitLeadership not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdRecover(MachineService_EtcdRecoverServer) error {
+	return status.Errorf(codes.Unimplemented, "method EtcdRecover not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method EtcdSnapshot not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdAlarmList(context.Context, *emptypb.Empty) (*EtcdAlarmListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmList not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdAlarmDisarm(context.Context, *emptypb.Empty) (*EtcdAlarmDisarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmDisarm not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdDefragment(context.Context, *emptypb.Empty) (*EtcdDefragmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdDefragment not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdStatus(context.Context, *emptypb.Empty) (*EtcdStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdStatus not implemented")
+}
+func (UnimplementedMachineServiceServer) GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfiguration not implemented")
+}
+func (UnimplementedMachineServiceServer) Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hostname not implemented")
+}
+func (UnimplementedMachineServiceServer) Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error {
+	return status.Errorf(codes.Unimplemented, "method Kubeconfig not implemented")
+}
+func (UnimplementedMachineServiceServer) List(*ListRequest, MachineService_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedMachineServiceServer) DiskUsage(*DiskUsageRequest, MachineService_DiskUsageServer) error {
+	return status.Errorf(codes.Unimplemented, "method DiskUsage not implemented")
+}
+func (UnimplementedMachineServiceServer) LoadAvg(context.Context, *emptypb.Empty) (*LoadAvgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadAvg not implemented")
+}
+func (UnimplementedMachineServiceServer) Logs(*LogsRequest, MachineService_LogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
+func (UnimplementedMachineServiceServer) LogsContainers(context.Context, *emptypb.Empty) (*LogsContainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogsContainers not implemented")
+}
+func (UnimplementedMachineServiceServer) Memory(context.Context, *emptypb.Empty) (*MemoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Memory not implemented")
+}
+func (UnimplementedMachineServiceServer) Mounts(context.Context, *emptypb.Empty) (*MountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mounts not implemented")
+}
+func (UnimplementedMachineServiceServer) NetworkDeviceStats(context.Context, *emptypb.Empty) (*NetworkDeviceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkDeviceStats not implemented")
+}
+func (UnimplementedMachineServiceServer) Processes(context.Context, *emptypb.Empty) (*ProcessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Processes not implemented")
+}
+func (UnimplementedMachineServiceServer) Read(*ReadRequest, MachineService_ReadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedMachineServiceServer) Reboot(context.Context, *RebootRequest) (*RebootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reboot not implemented")
+}
+func (UnimplementedMachineServiceServer) Restart(context.Context, *RestartRequest) (*RestartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restart not implemented")
+}
+func (UnimplementedMachineServiceServer) Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (UnimplementedMachineServiceServer) Reset(context.Context, *ResetRequest) (*ResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
+}
+func (UnimplementedMachineServiceServer) ServiceList(context.Context, *emptypb.Empty) (*ServiceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceList not implemented")
+}
+func (UnimplementedMachineServiceServer) ServiceRestart(context.Context, *ServiceRestartRequest) (*ServiceRestartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceRestart not implemented")
+}
+func (UnimplementedMachineServiceServer) ServiceStart(context.Context, *ServiceStartRequest) (*ServiceStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceStart not implemented")
+}
+func (UnimplementedMachineServiceServer) ServiceStop(context.Context, *ServiceStopRequest) (*ServiceStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceStop not implemented")
+}
+func (UnimplementedMachineServiceServer) Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+}
+func (UnimplementedMachineServiceServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedMachineServiceServer) SystemStat(context.Context, *emptypb.Empty) (*SystemStatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemStat not implemented")
+}
+func (UnimplementedMachineServiceServer) Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upgrade not implemented")
+}
+func (UnimplementedMachineServiceServer) Version(context.Context, *emptypb.Empty) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+func (UnimplementedMachineServiceServer) GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateClientConfiguration not implemented")
+}
+func (UnimplementedMachineServiceServer) PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error {
+	return status.Errorf(codes.Unimplemented, "method PacketCapture not implemented")
+}
+func (UnimplementedMachineServiceServer) Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Netstat not implemented")
+}
+func (UnimplementedMachineServiceServer) MetaWrite(context.Context, *MetaWriteRequest) (*MetaWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetaWrite not implemented")
+}
+func (UnimplementedMachineServiceServer) MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetaDelete not implemented")
+}
+func (UnimplementedMachineServiceServer) ImageList(*ImageListRequest, MachineService_ImageListServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImageList not implemented")
+}
+func (UnimplementedMachineServiceServer) ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImagePull not implemented")
+}
+func (UnimplementedMachineServiceServer) ConntrackFlush(context.Context, *ConntrackFlushRequest) (*ConntrackFlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConntrackFlush not implemented")
+}
+func (UnimplementedMachineServiceServer) RootfsIntegrity(context.Context, *emptypb.Empty) (*RootfsIntegrityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RootfsIntegrity not implemented")
+}
+func (UnimplementedMachineServiceServer) ExtensionMetrics(context.Context, *emptypb.Empty) (*ExtensionMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtensionMetrics not implemented")
+}
+func (UnimplementedMachineServiceServer) GeneratedFiles(*emptypb.Empty, MachineService_GeneratedFilesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GeneratedFiles not implemented")
+}
+func (UnimplementedMachineServiceServer) EmergencyConsole(MachineService_EmergencyConsoleServer) error {
+	return status.Errorf(codes.Unimplemented, "method EmergencyConsole not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdConsistencyCheck(context.Context, *emptypb.Empty) (*EtcdConsistencyCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdConsistencyCheck not implemented")
+}
+func (UnimplementedMachineServiceServer) ConntrackList(context.Context, *ConntrackListRequest) (*ConntrackListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConntrackList not implemented")
+}
+func (UnimplementedMachineServiceServer) FilesystemTrim(context.Context, *FilesystemTrimRequest) (*FilesystemTrimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilesystemTrim not implemented")
+}
+func (UnimplementedMachineServiceServer) UserFileWrite(MachineService_UserFileWriteServer) error {
+	return status.Errorf(codes.Unimplemented, "method UserFileWrite not implemented")
+}
+func (UnimplementedMachineServiceServer) UserFileRead(*UserFileReadRequest, MachineService_UserFileReadServer) error {
+	return status.Errorf(codes.Unimplemented, "method UserFileRead not implemented")
+}
+func (UnimplementedMachineServiceServer) Capabilities(context.Context, *CapabilitiesRequest) (*CapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Capabilities not implemented")
+}
+func (UnimplementedMachineServiceServer) KernelModuleParameterSet(context.Context, *KernelModuleParameterSetRequest) (*KernelModuleParameterSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KernelModuleParameterSet not implemented")
+}
+func (UnimplementedMachineServiceServer) RenewClientCertificate(context.Context, *RenewClientCertificateRequest) (*RenewClientCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewClientCertificate not implemented")
+}
+func (UnimplementedMachineServiceServer) EncryptionStatus(context.Context, *EncryptionStatusRequest) (*EncryptionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptionStatus not implemented")
+}
+func (UnimplementedMachineServiceServer) EncryptionRotateKey(context.Context, *EncryptionRotateKeyRequest) (*EncryptionRotateKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptionRotateKey not implemented")
+}
+func (UnimplementedMachineServiceServer) EncryptionAddKey(context.Context, *EncryptionAddKeyRequest) (*EncryptionAddKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptionAddKey not implemented")
+}
+func (UnimplementedMachineServiceServer) EncryptionRemoveKey(context.Context, *EncryptionRemoveKeyRequest) (*EncryptionRemoveKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptionRemoveKey not implemented")
+}
+func (UnimplementedMachineServiceServer) SocketStatistics(context.Context, *emptypb.Empty) (*SocketStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SocketStatistics not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdMemberLatency(context.Context, *EtcdMemberLatencyRequest) (*EtcdMemberLatencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdMemberLatency not implemented")
+}
+func (UnimplementedMachineServiceServer) SequenceList(context.Context, *emptypb.Empty) (*SequenceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SequenceList not implemented")
+}
+func (UnimplementedMachineServiceServer) SequenceCancel(context.Context, *SequenceCancelRequest) (*SequenceCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SequenceCancel not implemented")
+}
+func (UnimplementedMachineServiceServer) ProcessesStream(*ProcessesStreamRequest, MachineService_ProcessesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProcessesStream not implemented")
+}
+func (UnimplementedMachineServiceServer) CgroupStats(*CgroupStatsRequest, MachineService_CgroupStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method CgroupStats not implemented")
+}
+func (UnimplementedMachineServiceServer) BenchmarkDisk(context.Context, *BenchmarkDiskRequest) (*BenchmarkDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BenchmarkDisk not implemented")
+}
+func (UnimplementedMachineServiceServer) LogRecords(*LogRecordsRequest, MachineService_LogRecordsServer) error {
+	return status.Errorf(codes.Unimplemented, "method LogRecords not implemented")
+}
+func (UnimplementedMachineServiceServer) FeatureFlagList(context.Context, *emptypb.Empty) (*FeatureFlagListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeatureFlagList not implemented")
+}
+func (UnimplementedMachineServiceServer) FeatureFlagSet(context.Context, *FeatureFlagSetRequest) (*FeatureFlagSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeatureFlagSet not implemented")
+}
+func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
+
+// UnsafeMachineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MachineServiceServer will
+// result in compilation errors.
+type UnsafeMachineServiceServer interface {
+	mustEmbedUnimplementedMachineServiceServer()
+}
+
+func RegisterMachineServiceServer(s grpc.ServiceRegistrar, srv MachineServiceServer) {
+	s.RegisterService(&MachineService_ServiceDesc, srv)
+}
+
+func _MachineService_ApplyConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ApplyConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ApplyConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ApplyConfiguration(ctx, req.(*ApplyConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Bootstrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Bootstrap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Bootstrap(ctx, req.(*BootstrapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Containers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Containers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Containers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Containers(ctx, req.(*ContainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Copy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Copy(m, &machineServiceCopyServer{ServerStream: stream})
+}
+
+type MachineService_CopyServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceCopyServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceCopyServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_CPUInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).CPUInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_CPUInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).CPUInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_DiskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).DiskStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_DiskStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).DiskStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Dmesg_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DmesgRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Dmesg(m, &machineServiceDmesgServer{ServerStream: stream})
+}
+
+type MachineService_DmesgServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceDmesgServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceDmesgServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Events(m, &machineServiceEventsServer{ServerStream: stream})
+}
+
+type MachineService_EventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type machineServiceEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_EtcdMemberList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdMemberListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdMemberList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdMemberList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdMemberList(ctx, req.(*EtcdMemberListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdRemoveMemberByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdRemoveMemberByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdRemoveMemberByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdRemoveMemberByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdRemoveMemberByID(ctx, req.(*EtcdRemoveMemberByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdLeaveCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdLeaveClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdLeaveCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdLeaveCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdLeaveCluster(ctx, req.(*EtcdLeaveClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdForfeitLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdForfeitLeadershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdForfeitLeadership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdForfeitLeadership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdForfeitLeadership(ctx, req.(*EtcdForfeitLeadershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdRecover_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MachineServiceServer).EtcdRecover(&machineServiceEtcdRecoverServer{ServerStream: stream})
+}
+
+type MachineService_EtcdRecoverServer interface {
+	SendAndClose(*EtcdRecoverResponse) error
+	Recv() (*common.Data, error)
+	grpc.ServerStream
+}
+
+type machineServiceEtcdRecoverServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceEtcdRecoverServer) SendAndClose(m *EtcdRecoverResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *machineServiceEtcdRecoverServer) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MachineService_EtcdSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EtcdSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).EtcdSnapshot(m, &machineServiceEtcdSnapshotServer{ServerStream: stream})
+}
+
+type MachineService_EtcdSnapshotServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceEtcdSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceEtcdSnapshotServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_EtcdAlarmList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdAlarmList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdAlarmList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdAlarmList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdAlarmDisarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdAlarmDisarm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdDefragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdDefragment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdDefragment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdDefragment(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_GenerateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).GenerateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_GenerateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).GenerateConfiguration(ctx, req.(*GenerateConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Hostname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Hostname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Hostname_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Hostname(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Kubeconfig_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Kubeconfig(m, &machineServiceKubeconfigServer{ServerStream: stream})
+}
+
+type MachineService_KubeconfigServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceKubeconfigServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceKubeconfigServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).List(m, &machineServiceListServer{ServerStream: stream})
+}
+
+type MachineService_ListServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
+}
+
+type machineServiceListServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceListServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedMachineServiceServer) ImageList(*ImageListRequest, MachineService_ImageListServer) error {
-	return status.Errorf(codes.Unimplemented, "method ImageList not implemented")
+
+func _MachineService_DiskUsage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DiskUsageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).DiskUsage(m, &machineServiceDiskUsageServer{ServerStream: stream})
 }
-func (UnimplementedMachineServiceServer) ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImagePull not implemented")
+
+type MachineService_DiskUsageServer interface {
+	Send(*DiskUsageInfo) error
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
 
-// UnsafeMachineServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MachineServiceServer will
-// result in compilation errors.
-type UnsafeMachineServiceServer interface {
-	mustEmbedUnimplementedMachineServiceServer()
+type machineServiceDiskUsageServer struct {
+	grpc.ServerStream
 }
 
-func RegisterMachineServiceServer(s grpc.ServiceRegistrar, srv MachineServiceServer) {
-	s.RegisterService(&MachineService_ServiceDesc, srv)
+func (x *machineServiceDiskUsageServer) Send(m *DiskUsageInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_ApplyConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyConfigurationRequest)
+func _MachineService_LoadAvg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ApplyConfiguration(ctx, in)
+		return srv.(MachineServiceServer).LoadAvg(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ApplyConfiguration_FullMethodName,
+		FullMethod: MachineService_LoadAvg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ApplyConfiguration(ctx, req.(*ApplyConfigurationRequest))
+		return srv.(MachineServiceServer).LoadAvg(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BootstrapRequest)
+func _MachineService_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Logs(m, &machineServiceLogsServer{ServerStream: stream})
+}
+
+type MachineService_LogsServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceLogsServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_LogsContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Bootstrap(ctx, in)
+		return srv.(MachineServiceServer).LogsContainers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Bootstrap_FullMethodName,
+		FullMethod: MachineService_LogsContainers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Bootstrap(ctx, req.(*BootstrapRequest))
+		return srv.(MachineServiceServer).LogsContainers(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Containers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainersRequest)
+func _MachineService_Memory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Containers(ctx, in)
+		return srv.(MachineServiceServer).Memory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Containers_FullMethodName,
+		FullMethod: MachineService_Memory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Containers(ctx, req.(*ContainersRequest))
+		return srv.(MachineServiceServer).Memory(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Copy_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(CopyRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _MachineService_Mounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(MachineServiceServer).Copy(m, &machineServiceCopyServer{ServerStream: stream})
-}
-
-type MachineService_CopyServer interface {
-	Send(*common.Data) error
-	grpc.ServerStream
-}
-
-type machineServiceCopyServer struct {
-	grpc.ServerStream
-}
-
-func (x *machineServiceCopyServer) Send(m *common.Data) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Mounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Mounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Mounts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_CPUInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MachineService_NetworkDeviceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).CPUInfo(ctx, in)
+		return srv.(MachineServiceServer).NetworkDeviceStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_CPUInfo_FullMethodName,
+		FullMethod: MachineService_NetworkDeviceStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).CPUInfo(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).NetworkDeviceStats(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_DiskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MachineService_Processes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).DiskStats(ctx, in)
+		return srv.(MachineServiceServer).Processes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_DiskStats_FullMethodName,
+		FullMethod: MachineService_Processes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).DiskStats(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).Processes(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Dmesg_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DmesgRequest)
+func _MachineService_Read_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(MachineServiceServer).Dmesg(m, &machineServiceDmesgServer{ServerStream: stream})
+	return srv.(MachineServiceServer).Read(m, &machineServiceReadServer{ServerStream: stream})
 }
 
-type MachineService_DmesgServer interface {
+type MachineService_ReadServer interface {
 	Send(*common.Data) error
 	grpc.ServerStream
 }
 
-type machineServiceDmesgServer struct {
-	grpc.ServerStream
-}
-
-func (x *machineServiceDmesgServer) Send(m *common.Data) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _MachineService_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EventsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(MachineServiceServer).Events(m, &machineServiceEventsServer{ServerStream: stream})
-}
-
-type MachineService_EventsServer interface {
-	Send(*Event) error
-	grpc.ServerStream
-}
-
-type machineServiceEventsServer struct {
+type machineServiceReadServer struct {
 	grpc.ServerStream
 }
 
-func (x *machineServiceEventsServer) Send(m *Event) error {
+func (x *machineServiceReadServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_EtcdMemberList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EtcdMemberListRequest)
+func _MachineService_Reboot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdMemberList(ctx, in)
+		return srv.(MachineServiceServer).Reboot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdMemberList_FullMethodName,
+		FullMethod: MachineService_Reboot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdMemberList(ctx, req.(*EtcdMemberListRequest))
+		return srv.(MachineServiceServer).Reboot(ctx, req.(*RebootRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdRemoveMemberByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EtcdRemoveMemberByIDRequest)
+func _MachineService_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdRemoveMemberByID(ctx, in)
+		return srv.(MachineServiceServer).Restart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdRemoveMemberByID_FullMethodName,
+		FullMethod: MachineService_Restart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdRemoveMemberByID(ctx, req.(*EtcdRemoveMemberByIDRequest))
+		return srv.(MachineServiceServer).Restart(ctx, req.(*RestartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdLeaveCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EtcdLeaveClusterRequest)
+func _MachineService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdLeaveCluster(ctx, in)
+		return srv.(MachineServiceServer).Rollback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdLeaveCluster_FullMethodName,
+		FullMethod: MachineService_Rollback_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdLeaveCluster(ctx, req.(*EtcdLeaveClusterRequest))
+		return srv.(MachineServiceServer).Rollback(ctx, req.(*RollbackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdForfeitLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EtcdForfeitLeadershipRequest)
+func _MachineService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdForfeitLeadership(ctx, in)
+		return srv.(MachineServiceServer).Reset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdForfeitLeadership_FullMethodName,
+		FullMethod: MachineService_Reset_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdForfeitLeadership(ctx, req.(*EtcdForfeitLeadershipRequest))
+		return srv.(MachineServiceServer).Reset(ctx, req.(*ResetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdRecover_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(MachineServiceServer).EtcdRecover(&machineServiceEtcdRecoverServer{ServerStream: stream})
-}
-
-type MachineService_EtcdRecoverServer interface {
-	SendAndClose(*EtcdRecoverResponse) error
-	Recv() (*common.Data, error)
-	grpc.ServerStream
-}
-
-type machineServiceEtcdRecoverServer struct {
-	grpc.ServerStream
-}
-
-func (x *machineServiceEtcdRecoverServer) SendAndClose(m *EtcdRecoverResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *machineServiceEtcdRecoverServer) Recv() (*common.Data, error) {
-	m := new(common.Data)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _MachineService_ServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-func _MachineService_EtcdSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EtcdSnapshotRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ServiceList(ctx, in)
 	}
-	return srv.(MachineServiceServer).EtcdSnapshot(m, &machineServiceEtcdSnapshotServer{ServerStream: stream})
-}
-
-type MachineService_EtcdSnapshotServer interface {
-	Send(*common.Data) error
-	grpc.ServerStream
-}
-
-type machineServiceEtcdSnapshotServer struct {
-	grpc.ServerStream
-}
-
-func (x *machineServiceEtcdSnapshotServer) Send(m *common.Data) error {
-	return x.ServerStream.SendMsg(m)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ServiceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ServiceList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdAlarmList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_ServiceRestart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceRestartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdAlarmList(ctx, in)
+		return srv.(MachineServiceServer).ServiceRestart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdAlarmList_FullMethodName,
+		FullMethod: MachineService_ServiceRestart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdAlarmList(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).ServiceRestart(ctx, req.(*ServiceRestartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdAlarmDisarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_ServiceStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceStartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, in)
+		return srv.(MachineServiceServer).ServiceStart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdAlarmDisarm_FullMethodName,
+		FullMethod: MachineService_ServiceStart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).ServiceStart(ctx, req.(*ServiceStartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdDefragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_ServiceStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceStopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdDefragment(ctx, in)
+		return srv.(MachineServiceServer).ServiceStop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdDefragment_FullMethodName,
+		FullMethod: MachineService_ServiceStop_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdDefragment(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).ServiceStop(ctx, req.(*ServiceStopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdStatus(ctx, in)
+		return srv.(MachineServiceServer).Shutdown(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdStatus_FullMethodName,
+		FullMethod: MachineService_Shutdown_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdStatus(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).Shutdown(ctx, req.(*ShutdownRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_GenerateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateConfigurationRequest)
+func _MachineService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).GenerateConfiguration(ctx, in)
+		return srv.(MachineServiceServer).Stats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_GenerateConfiguration_FullMethodName,
+		FullMethod: MachineService_Stats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).GenerateConfiguration(ctx, req.(*GenerateConfigurationRequest))
+		return srv.(MachineServiceServer).Stats(ctx, req.(*StatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Hostname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MachineService_SystemStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Hostname(ctx, in)
+		return srv.(MachineServiceServer).SystemStat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Hostname_FullMethodName,
+		FullMethod: MachineService_SystemStat_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Hostname(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).SystemStat(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Kubeconfig_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _MachineService_Upgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(MachineServiceServer).Kubeconfig(m, &machineServiceKubeconfigServer{ServerStream: stream})
-}
-
-type MachineService_KubeconfigServer interface {
-	Send(*common.Data) error
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Upgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Upgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Upgrade(ctx, req.(*UpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type machineServiceKubeconfigServer struct {
-	grpc.ServerStream
+func _MachineService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Version_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Version(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *machineServiceKubeconfigServer) Send(m *common.Data) error {
-	return x.ServerStream.SendMsg(m)
+func _MachineService_GenerateClientConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateClientConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).GenerateClientConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_GenerateClientConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).GenerateClientConfiguration(ctx, req.(*GenerateClientConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListRequest)
+func _MachineService_PacketCapture_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PacketCaptureRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(MachineServiceServer).List(m, &machineServiceListServer{ServerStream: stream})
+	return srv.(MachineServiceServer).PacketCapture(m, &machineServicePacketCaptureServer{ServerStream: stream})
 }
 
-type MachineService_ListServer interface {
-	Send(*FileInfo) error
+type MachineService_PacketCaptureServer interface {
+	Send(*common.Data) error
 	grpc.ServerStream
 }
 
-type machineServiceListServer struct {
+type machineServicePacketCaptureServer struct {
 	grpc.ServerStream
 }
 
-func (x *machineServiceListServer) Send(m *FileInfo) error {
+func (x *machineServicePacketCaptureServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_DiskUsage_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DiskUsageRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _MachineService_Netstat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetstatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(MachineServiceServer).DiskUsage(m, &machineServiceDiskUsageServer{ServerStream: stream})
-}
-
-type MachineService_DiskUsageServer interface {
-	Send(*DiskUsageInfo) error
-	grpc.ServerStream
-}
-
-type machineServiceDiskUsageServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Netstat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Netstat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Netstat(ctx, req.(*NetstatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *machineServiceDiskUsageServer) Send(m *DiskUsageInfo) error {
-	return x.ServerStream.SendMsg(m)
+func _MachineService_MetaWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetaWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).MetaWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_MetaWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).MetaWrite(ctx, req.(*MetaWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_LoadAvg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_MetaDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetaDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).LoadAvg(ctx, in)
+		return srv.(MachineServiceServer).MetaDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_LoadAvg_FullMethodName,
+		FullMethod: MachineService_MetaDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).LoadAvg(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).MetaDelete(ctx, req.(*MetaDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(LogsRequest)
+func _MachineService_ImageList_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImageListRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(MachineServiceServer).Logs(m, &machineServiceLogsServer{ServerStream: stream})
+	return srv.(MachineServiceServer).ImageList(m, &machineServiceImageListServer{ServerStream: stream})
 }
 
-type MachineService_LogsServer interface {
-	Send(*common.Data) error
+type MachineService_ImageListServer interface {
+	Send(*ImageListResponse) error
 	grpc.ServerStream
 }
 
-type machineServiceLogsServer struct {
+type machineServiceImageListServer struct {
 	grpc.ServerStream
 }
 
-func (x *machineServiceLogsServer) Send(m *common.Data) error {
+func (x *machineServiceImageListServer) Send(m *ImageListResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_LogsContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MachineServiceServer).LogsContainers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: MachineService_LogsContainers_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).LogsContainers(ctx, req.(*emptypb.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _MachineService_Memory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_ImagePull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImagePullRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Memory(ctx, in)
+		return srv.(MachineServiceServer).ImagePull(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Memory_FullMethodName,
+		FullMethod: MachineService_ImagePull_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Memory(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).ImagePull(ctx, req.(*ImagePullRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Mounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_ConntrackFlush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConntrackFlushRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Mounts(ctx, in)
+		return srv.(MachineServiceServer).ConntrackFlush(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Mounts_FullMethodName,
+		FullMethod: MachineService_ConntrackFlush_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Mounts(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).ConntrackFlush(ctx, req.(*ConntrackFlushRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_NetworkDeviceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MachineService_RootfsIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).NetworkDeviceStats(ctx, in)
+		return srv.(MachineServiceServer).RootfsIntegrity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_NetworkDeviceStats_FullMethodName,
+		FullMethod: MachineService_RootfsIntegrity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).NetworkDeviceStats(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).RootfsIntegrity(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Processes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MachineService_ExtensionMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Processes(ctx, in)
+		return srv.(MachineServiceServer).ExtensionMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Processes_FullMethodName,
+		FullMethod: MachineService_ExtensionMetrics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Processes(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).ExtensionMetrics(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Read_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ReadRequest)
+func _MachineService_GeneratedFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(MachineServiceServer).Read(m, &machineServiceReadServer{ServerStream: stream})
+	return srv.(MachineServiceServer).GeneratedFiles(m, &machineServiceGeneratedFilesServer{ServerStream: stream})
+}
+
+type MachineService_GeneratedFilesServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceGeneratedFilesServer struct {
+	grpc.ServerStream
 }
 
-type MachineService_ReadServer interface {
-	Send(*common.Data) error
+func (x *machineServiceGeneratedFilesServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_EmergencyConsole_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MachineServiceServer).EmergencyConsole(&machineServiceEmergencyConsoleServer{ServerStream: stream})
+}
+
+type MachineService_EmergencyConsoleServer interface {
+	Send(*EmergencyConsoleResponse) error
+	Recv() (*EmergencyConsoleRequest, error)
 	grpc.ServerStream
 }
 
-type machineServiceReadServer struct {
+type machineServiceEmergencyConsoleServer struct {
 	grpc.ServerStream
 }
 
-func (x *machineServiceReadServer) Send(m *common.Data) error {
+func (x *machineServiceEmergencyConsoleServer) Send(m *EmergencyConsoleResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_Reboot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RebootRequest)
+func (x *machineServiceEmergencyConsoleServer) Recv() (*EmergencyConsoleRequest, error) {
+	m := new(EmergencyConsoleRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MachineService_EtcdConsistencyCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Reboot(ctx, in)
+		return srv.(MachineServiceServer).EtcdConsistencyCheck(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Reboot_FullMethodName,
+		FullMethod: MachineService_EtcdConsistencyCheck_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Reboot(ctx, req.(*RebootRequest))
+		return srv.(MachineServiceServer).EtcdConsistencyCheck(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestartRequest)
+func _MachineService_ConntrackList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConntrackListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Restart(ctx, in)
+		return srv.(MachineServiceServer).ConntrackList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Restart_FullMethodName,
+		FullMethod: MachineService_ConntrackList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Restart(ctx, req.(*RestartRequest))
+		return srv.(MachineServiceServer).ConntrackList(ctx, req.(*ConntrackListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RollbackRequest)
+func _MachineService_FilesystemTrim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilesystemTrimRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Rollback(ctx, in)
+		return srv.(MachineServiceServer).FilesystemTrim(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Rollback_FullMethodName,
+		FullMethod: MachineService_FilesystemTrim_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Rollback(ctx, req.(*RollbackRequest))
+		return srv.(MachineServiceServer).FilesystemTrim(ctx, req.(*FilesystemTrimRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetRequest)
+func _MachineService_UserFileWrite_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MachineServiceServer).UserFileWrite(&machineServiceUserFileWriteServer{ServerStream: stream})
+}
+
+type MachineService_UserFileWriteServer interface {
+	SendAndClose(*UserFileWriteResponse) error
+	Recv() (*UserFileWriteRequest, error)
+	grpc.ServerStream
+}
+
+type machineServiceUserFileWriteServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceUserFileWriteServer) SendAndClose(m *UserFileWriteResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *machineServiceUserFileWriteServer) Recv() (*UserFileWriteRequest, error) {
+	m := new(UserFileWriteRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MachineService_UserFileRead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UserFileReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).UserFileRead(m, &machineServiceUserFileReadServer{ServerStream: stream})
+}
+
+type MachineService_UserFileReadServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceUserFileReadServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceUserFileReadServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_Capabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapabilitiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Reset(ctx, in)
+		return srv.(MachineServiceServer).Capabilities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Reset_FullMethodName,
+		FullMethod: MachineService_Capabilities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Reset(ctx, req.(*ResetRequest))
+		return srv.(MachineServiceServer).Capabilities(ctx, req.(*CapabilitiesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_ServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_KernelModuleParameterSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KernelModuleParameterSetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ServiceList(ctx, in)
+		return srv.(MachineServiceServer).KernelModuleParameterSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ServiceList_FullMethodName,
+		FullMethod: MachineService_KernelModuleParameterSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ServiceList(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).KernelModuleParameterSet(ctx, req.(*KernelModuleParameterSetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_ServiceRestart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceRestartRequest)
+func _MachineService_RenewClientCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewClientCertificateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ServiceRestart(ctx, in)
+		return srv.(MachineServiceServer).RenewClientCertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ServiceRestart_FullMethodName,
+		FullMethod: MachineService_RenewClientCertificate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ServiceRestart(ctx, req.(*ServiceRestartRequest))
+		return srv.(MachineServiceServer).RenewClientCertificate(ctx, req.(*RenewClientCertificateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_ServiceStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceStartRequest)
+func _MachineService_EncryptionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptionStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ServiceStart(ctx, in)
+		return srv.(MachineServiceServer).EncryptionStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ServiceStart_FullMethodName,
+		FullMethod: MachineService_EncryptionStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ServiceStart(ctx, req.(*ServiceStartRequest))
+		return srv.(MachineServiceServer).EncryptionStatus(ctx, req.(*EncryptionStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_ServiceStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceStopRequest)
+func _MachineService_EncryptionRotateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptionRotateKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ServiceStop(ctx, in)
+		return srv.(MachineServiceServer).EncryptionRotateKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ServiceStop_FullMethodName,
+		FullMethod: MachineService_EncryptionRotateKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ServiceStop(ctx, req.(*ServiceStopRequest))
+		return srv.(MachineServiceServer).EncryptionRotateKey(ctx, req.(*EncryptionRotateKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShutdownRequest)
+func _MachineService_EncryptionAddKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptionAddKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Shutdown(ctx, in)
+		return srv.(MachineServiceServer).EncryptionAddKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Shutdown_FullMethodName,
+		FullMethod: MachineService_EncryptionAddKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Shutdown(ctx, req.(*ShutdownRequest))
+		return srv.(MachineServiceServer).EncryptionAddKey(ctx, req.(*EncryptionAddKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatsRequest)
+func _MachineService_EncryptionRemoveKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptionRemoveKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Stats(ctx, in)
+		return srv.(MachineServiceServer).EncryptionRemoveKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Stats_FullMethodName,
+		FullMethod: MachineService_EncryptionRemoveKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Stats(ctx, req.(*StatsRequest))
+		return srv.(MachineServiceServer).EncryptionRemoveKey(ctx, req.(*EncryptionRemoveKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_SystemStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MachineService_SocketStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).SystemStat(ctx, in)
+		return srv.(MachineServiceServer).SocketStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_SystemStat_FullMethodName,
+		FullMethod: MachineService_SocketStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).SystemStat(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).SocketStatistics(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Upgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpgradeRequest)
+func _MachineService_EtcdMemberLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdMemberLatencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Upgrade(ctx, in)
+		return srv.(MachineServiceServer).EtcdMemberLatency(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Upgrade_FullMethodName,
+		FullMethod: MachineService_EtcdMemberLatency_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Upgrade(ctx, req.(*UpgradeRequest))
+		return srv.(MachineServiceServer).EtcdMemberLatency(ctx, req.(*EtcdMemberLatencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MachineService_SequenceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Version(ctx, in)
+		return srv.(MachineServiceServer).SequenceList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Version_FullMethodName,
+		FullMethod: MachineService_SequenceList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Version(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).SequenceList(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_GenerateClientConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateClientConfigurationRequest)
+func _MachineService_SequenceCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SequenceCancelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).GenerateClientConfiguration(ctx, in)
+		return srv.(MachineServiceServer).SequenceCancel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_GenerateClientConfiguration_FullMethodName,
+		FullMethod: MachineService_SequenceCancel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).GenerateClientConfiguration(ctx, req.(*GenerateClientConfigurationRequest))
+		return srv.(MachineServiceServer).SequenceCancel(ctx, req.(*SequenceCancelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_PacketCapture_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(PacketCaptureRequest)
+func _MachineService_ProcessesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProcessesStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(MachineServiceServer).PacketCapture(m, &machineServicePacketCaptureServer{ServerStream: stream})
+	return srv.(MachineServiceServer).ProcessesStream(m, &machineServiceProcessesStreamServer{ServerStream: stream})
 }
 
-type MachineService_PacketCaptureServer interface {
-	Send(*common.Data) error
+type MachineService_ProcessesStreamServer interface {
+	Send(*ProcessesSample) error
 	grpc.ServerStream
 }
 
-type machineServicePacketCaptureServer struct {
+type machineServiceProcessesStreamServer struct {
 	grpc.ServerStream
 }
 
-func (x *machineServicePacketCaptureServer) Send(m *common.Data) error {
+func (x *machineServiceProcessesStreamServer) Send(m *ProcessesSample) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_Netstat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetstatRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MachineServiceServer).Netstat(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: MachineService_Netstat_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Netstat(ctx, req.(*NetstatRequest))
+func _MachineService_CgroupStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CgroupStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(MachineServiceServer).CgroupStats(m, &machineServiceCgroupStatsServer{ServerStream: stream})
 }
 
-func _MachineService_MetaWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MetaWriteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MachineServiceServer).MetaWrite(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: MachineService_MetaWrite_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).MetaWrite(ctx, req.(*MetaWriteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type MachineService_CgroupStatsServer interface {
+	Send(*CgroupStats) error
+	grpc.ServerStream
 }
 
-func _MachineService_MetaDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MetaDeleteRequest)
+type machineServiceCgroupStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceCgroupStatsServer) Send(m *CgroupStats) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_BenchmarkDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkDiskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).MetaDelete(ctx, in)
+		return srv.(MachineServiceServer).BenchmarkDisk(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_MetaDelete_FullMethodName,
+		FullMethod: MachineService_BenchmarkDisk_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).MetaDelete(ctx, req.(*MetaDeleteRequest))
+		return srv.(MachineServiceServer).BenchmarkDisk(ctx, req.(*BenchmarkDiskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_ImageList_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ImageListRequest)
+func _MachineService_LogRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogRecordsRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(MachineServiceServer).ImageList(m, &machineServiceImageListServer{ServerStream: stream})
+	return srv.(MachineServiceServer).LogRecords(m, &machineServiceLogRecordsServer{ServerStream: stream})
 }
 
-type MachineService_ImageListServer interface {
-	Send(*ImageListResponse) error
+type MachineService_LogRecordsServer interface {
+	Send(*LogRecord) error
 	grpc.ServerStream
 }
 
-type machineServiceImageListServer struct {
+type machineServiceLogRecordsServer struct {
 	grpc.ServerStream
 }
 
-func (x *machineServiceImageListServer) Send(m *ImageListResponse) error {
+func (x *machineServiceLogRecordsServer) Send(m *LogRecord) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_ImagePull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImagePullRequest)
+func _MachineService_FeatureFlagList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ImagePull(ctx, in)
+		return srv.(MachineServiceServer).FeatureFlagList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ImagePull_FullMethodName,
+		FullMethod: MachineService_FeatureFlagList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ImagePull(ctx, req.(*ImagePullRequest))
+		return srv.(MachineServiceServer).FeatureFlagList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_FeatureFlagSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeatureFlagSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).FeatureFlagSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_FeatureFlagSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).FeatureFlagSet(ctx, req.(*FeatureFlagSetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2343,6 +3544,86 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImagePull",
 			Handler:    _MachineService_ImagePull_Handler,
 		},
+		{
+			MethodName: "ConntrackFlush",
+			Handler:    _MachineService_ConntrackFlush_Handler,
+		},
+		{
+			MethodName: "RootfsIntegrity",
+			Handler:    _MachineService_RootfsIntegrity_Handler,
+		},
+		{
+			MethodName: "ExtensionMetrics",
+			Handler:    _MachineService_ExtensionMetrics_Handler,
+		},
+		{
+			MethodName: "EtcdConsistencyCheck",
+			Handler:    _MachineService_EtcdConsistencyCheck_Handler,
+		},
+		{
+			MethodName: "ConntrackList",
+			Handler:    _MachineService_ConntrackList_Handler,
+		},
+		{
+			MethodName: "FilesystemTrim",
+			Handler:    _MachineService_FilesystemTrim_Handler,
+		},
+		{
+			MethodName: "Capabilities",
+			Handler:    _MachineService_Capabilities_Handler,
+		},
+		{
+			MethodName: "KernelModuleParameterSet",
+			Handler:    _MachineService_KernelModuleParameterSet_Handler,
+		},
+		{
+			MethodName: "RenewClientCertificate",
+			Handler:    _MachineService_RenewClientCertificate_Handler,
+		},
+		{
+			MethodName: "EncryptionStatus",
+			Handler:    _MachineService_EncryptionStatus_Handler,
+		},
+		{
+			MethodName: "EncryptionRotateKey",
+			Handler:    _MachineService_EncryptionRotateKey_Handler,
+		},
+		{
+			MethodName: "EncryptionAddKey",
+			Handler:    _MachineService_EncryptionAddKey_Handler,
+		},
+		{
+			MethodName: "EncryptionRemoveKey",
+			Handler:    _MachineService_EncryptionRemoveKey_Handler,
+		},
+		{
+			MethodName: "SocketStatistics",
+			Handler:    _MachineService_SocketStatistics_Handler,
+		},
+		{
+			MethodName: "EtcdMemberLatency",
+			Handler:    _MachineService_EtcdMemberLatency_Handler,
+		},
+		{
+			MethodName: "SequenceList",
+			Handler:    _MachineService_SequenceList_Handler,
+		},
+		{
+			MethodName: "SequenceCancel",
+			Handler:    _MachineService_SequenceCancel_Handler,
+		},
+		{
+			MethodName: "BenchmarkDisk",
+			Handler:    _MachineService_BenchmarkDisk_Handler,
+		},
+		{
+			MethodName: "FeatureFlagList",
+			Handler:    _MachineService_FeatureFlagList_Handler,
+		},
+		{
+			MethodName: "FeatureFlagSet",
+			Handler:    _MachineService_FeatureFlagSet_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2405,6 +3686,42 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MachineService_ImageList_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GeneratedFiles",
+			Handler:       _MachineService_GeneratedFiles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "EmergencyConsole",
+			Handler:       _MachineService_EmergencyConsole_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UserFileWrite",
+			Handler:       _MachineService_UserFileWrite_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UserFileRead",
+			Handler:       _MachineService_UserFileRead_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ProcessesStream",
+			Handler:       _MachineService_ProcessesStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CgroupStats",
+			Handler:       _MachineService_CgroupStats_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LogRecords",
+			Handler:       _MachineService_LogRecords_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "machine/machine.proto",
 }
@@ -566,6 +566,58 @@ func (m *EncryptionKey) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.KmsLockout {
+		i--
+		if m.KmsLockout {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.KmsVaultAuthMount) > 0 {
+		i -= len(m.KmsVaultAuthMount)
+		copy(dAtA[i:], m.KmsVaultAuthMount)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.KmsVaultAuthMount)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.KmsVaultTransitMount) > 0 {
+		i -= len(m.KmsVaultTransitMount)
+		copy(dAtA[i:], m.KmsVaultTransitMount)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.KmsVaultTransitMount)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.KmsVaultTransitKeyName) > 0 {
+		i -= len(m.KmsVaultTransitKeyName)
+		copy(dAtA[i:], m.KmsVaultTransitKeyName)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.KmsVaultTransitKeyName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.KmsCa) > 0 {
+		i -= len(m.KmsCa)
+		copy(dAtA[i:], m.KmsCa)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.KmsCa)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.KmsClientKey) > 0 {
+		i -= len(m.KmsClientKey)
+		copy(dAtA[i:], m.KmsClientKey)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.KmsClientKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.KmsClientCertificate) > 0 {
+		i -= len(m.KmsClientCertificate)
+		copy(dAtA[i:], m.KmsClientCertificate)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.KmsClientCertificate)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.TpmCheckSecurebootStatusOnEnroll {
 		i--
 		if m.TpmCheckSecurebootStatusOnEnroll {
@@ -1491,6 +1543,33 @@ func (m *EncryptionKey) SizeVT() (n int) {
 	if m.TpmCheckSecurebootStatusOnEnroll {
 		n += 2
 	}
+	l = len(m.KmsClientCertificate)
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	l = len(m.KmsClientKey)
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	l = len(m.KmsCa)
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	l = len(m.KmsVaultTransitKeyName)
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	l = len(m.KmsVaultTransitMount)
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	l = len(m.KmsVaultAuthMount)
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	if m.KmsLockout {
+		n += 2
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -3420,6 +3499,224 @@ func (m *EncryptionKey) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 			m.TpmCheckSecurebootStatusOnEnroll = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsClientCertificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsClientCertificate = append(m.KmsClientCertificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.KmsClientCertificate == nil {
+				m.KmsClientCertificate = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsClientKey = append(m.KmsClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.KmsClientKey == nil {
+				m.KmsClientKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsCa", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsCa = append(m.KmsCa[:0], dAtA[iNdEx:postIndex]...)
+			if m.KmsCa == nil {
+				m.KmsCa = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsVaultTransitKeyName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsVaultTransitKeyName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsVaultTransitMount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsVaultTransitMount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsVaultAuthMount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsVaultAuthMount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsLockout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KmsLockout = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
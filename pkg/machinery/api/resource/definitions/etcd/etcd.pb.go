@@ -111,6 +111,94 @@ func (x *ConfigSpec) GetListenExcludeSubnets() []string {
 	return nil
 }
 
+// ConsistencyStatusSpec describes the result of the consistency check of the etcd members.
+type ConsistencyStatusSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HashRevision       int64    `protobuf:"varint,1,opt,name=hash_revision,json=hashRevision,proto3" json:"hash_revision,omitempty"`
+	Divergent          bool     `protobuf:"varint,2,opt,name=divergent,proto3" json:"divergent,omitempty"`
+	DivergentMembers   []string `protobuf:"bytes,3,rep,name=divergent_members,json=divergentMembers,proto3" json:"divergent_members,omitempty"`
+	MaxRevisionLag     int64    `protobuf:"varint,4,opt,name=max_revision_lag,json=maxRevisionLag,proto3" json:"max_revision_lag,omitempty"`
+	LaggingMembers     []string `protobuf:"bytes,5,rep,name=lagging_members,json=laggingMembers,proto3" json:"lagging_members,omitempty"`
+	UnreachableMembers []string `protobuf:"bytes,6,rep,name=unreachable_members,json=unreachableMembers,proto3" json:"unreachable_members,omitempty"`
+}
+
+func (x *ConsistencyStatusSpec) Reset() {
+	*x = ConsistencyStatusSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsistencyStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyStatusSpec) ProtoMessage() {}
+
+func (x *ConsistencyStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyStatusSpec.ProtoReflect.Descriptor instead.
+func (*ConsistencyStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConsistencyStatusSpec) GetHashRevision() int64 {
+	if x != nil {
+		return x.HashRevision
+	}
+	return 0
+}
+
+func (x *ConsistencyStatusSpec) GetDivergent() bool {
+	if x != nil {
+		return x.Divergent
+	}
+	return false
+}
+
+func (x *ConsistencyStatusSpec) GetDivergentMembers() []string {
+	if x != nil {
+		return x.DivergentMembers
+	}
+	return nil
+}
+
+func (x *ConsistencyStatusSpec) GetMaxRevisionLag() int64 {
+	if x != nil {
+		return x.MaxRevisionLag
+	}
+	return 0
+}
+
+func (x *ConsistencyStatusSpec) GetLaggingMembers() []string {
+	if x != nil {
+		return x.LaggingMembers
+	}
+	return nil
+}
+
+func (x *ConsistencyStatusSpec) GetUnreachableMembers() []string {
+	if x != nil {
+		return x.UnreachableMembers
+	}
+	return nil
+}
+
 // MemberSpec holds information about an etcd member.
 type MemberSpec struct {
 	state         protoimpl.MessageState
@@ -123,7 +211,7 @@ type MemberSpec struct {
 func (x *MemberSpec) Reset() {
 	*x = MemberSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[1]
+		mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -136,7 +224,7 @@ func (x *MemberSpec) String() string {
 func (*MemberSpec) ProtoMessage() {}
 
 func (x *MemberSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[1]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,7 +237,7 @@ func (x *MemberSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemberSpec.ProtoReflect.Descriptor instead.
 func (*MemberSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{1}
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *MemberSpec) GetMemberId() string {
@@ -172,7 +260,7 @@ type PKIStatusSpec struct {
 func (x *PKIStatusSpec) Reset() {
 	*x = PKIStatusSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
+		mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -185,7 +273,7 @@ func (x *PKIStatusSpec) String() string {
 func (*PKIStatusSpec) ProtoMessage() {}
 
 func (x *PKIStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +286,7 @@ func (x *PKIStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PKIStatusSpec.ProtoReflect.Descriptor instead.
 func (*PKIStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{2}
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PKIStatusSpec) GetReady() bool {
@@ -232,7 +320,7 @@ type SpecSpec struct {
 func (x *SpecSpec) Reset() {
 	*x = SpecSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
+		mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -245,7 +333,7 @@ func (x *SpecSpec) String() string {
 func (*SpecSpec) ProtoMessage() {}
 
 func (x *SpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +346,7 @@ func (x *SpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpecSpec.ProtoReflect.Descriptor instead.
 func (*SpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SpecSpec) GetName() string {
@@ -337,47 +425,64 @@ var file_resource_definitions_etcd_etcd_proto_rawDesc = []byte{
 	0x61, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x3f, 0x0a, 0x0d, 0x50, 0x4b, 0x49, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x70,
-	0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x97, 0x03, 0x0a, 0x08, 0x53, 0x70, 0x65, 0x63, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x14, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65,
-	0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x61,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x22, 0x29, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x3f, 0x0a, 0x0d, 0x50, 0x4b, 0x49, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x97, 0x03, 0x0a, 0x08, 0x53, 0x70, 0x65, 0x63, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x40, 0x0a, 0x14, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x50, 0x52, 0x13,
+	0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x57, 0x0a, 0x0a, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
+	0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x65, 0x74, 0x63, 0x64, 0x2e,
+	0x53, 0x70, 0x65, 0x63, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x41, 0x72,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x41, 0x72,
+	0x67, 0x73, 0x12, 0x41, 0x0a, 0x15, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x50,
-	0x52, 0x13, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x57, 0x0a, 0x0a, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x38, 0x2e, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x65, 0x74, 0x63,
-	0x64, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x41, 0x72, 0x67, 0x73, 0x12, 0x41, 0x0a, 0x15, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x70,
-	0x65, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74,
-	0x49, 0x50, 0x52, 0x13, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x17, 0x6c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x50, 0x52, 0x15, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x3c,
-	0x0a, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x72, 0x0a, 0x27,
-	0x64, 0x65, 0x76, 0x2e, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x65, 0x74, 0x63, 0x64, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x72, 0x6f, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74,
-	0x61, 0x6c, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f,
-	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x74, 0x63, 0x64,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x13, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x17, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4e, 0x65, 0x74, 0x49, 0x50, 0x52, 0x15, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x72, 0x0a, 0x27, 0x64, 0x65,
+	0x76, 0x2e, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x65, 0x74, 0x63, 0x64, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x72, 0x6f, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x6c,
+	0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x72, 0x79,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x74, 0x63, 0x64, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -392,22 +497,23 @@ func file_resource_definitions_etcd_etcd_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_etcd_etcd_proto_rawDescData
 }
 
-var file_resource_definitions_etcd_etcd_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_resource_definitions_etcd_etcd_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_resource_definitions_etcd_etcd_proto_goTypes = []any{
-	(*ConfigSpec)(nil),    // 0: talos.resource.definitions.etcd.ConfigSpec
-	(*MemberSpec)(nil),    // 1: talos.resource.definitions.etcd.MemberSpec
-	(*PKIStatusSpec)(nil), // 2: talos.resource.definitions.etcd.PKIStatusSpec
-	(*SpecSpec)(nil),      // 3: talos.resource.definitions.etcd.SpecSpec
-	nil,                   // 4: talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
-	nil,                   // 5: talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
-	(*common.NetIP)(nil),  // 6: common.NetIP
+	(*ConfigSpec)(nil),            // 0: talos.resource.definitions.etcd.ConfigSpec
+	(*ConsistencyStatusSpec)(nil), // 1: talos.resource.definitions.etcd.ConsistencyStatusSpec
+	(*MemberSpec)(nil),            // 2: talos.resource.definitions.etcd.MemberSpec
+	(*PKIStatusSpec)(nil),         // 3: talos.resource.definitions.etcd.PKIStatusSpec
+	(*SpecSpec)(nil),              // 4: talos.resource.definitions.etcd.SpecSpec
+	nil,                           // 5: talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
+	nil,                           // 6: talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
+	(*common.NetIP)(nil),          // 7: common.NetIP
 }
 var file_resource_definitions_etcd_etcd_proto_depIdxs = []int32{
-	4, // 0: talos.resource.definitions.etcd.ConfigSpec.extra_args:type_name -> talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
-	6, // 1: talos.resource.definitions.etcd.SpecSpec.advertised_addresses:type_name -> common.NetIP
-	5, // 2: talos.resource.definitions.etcd.SpecSpec.extra_args:type_name -> talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
-	6, // 3: talos.resource.definitions.etcd.SpecSpec.listen_peer_addresses:type_name -> common.NetIP
-	6, // 4: talos.resource.definitions.etcd.SpecSpec.listen_client_addresses:type_name -> common.NetIP
+	5, // 0: talos.resource.definitions.etcd.ConfigSpec.extra_args:type_name -> talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
+	7, // 1: talos.resource.definitions.etcd.SpecSpec.advertised_addresses:type_name -> common.NetIP
+	6, // 2: talos.resource.definitions.etcd.SpecSpec.extra_args:type_name -> talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
+	7, // 3: talos.resource.definitions.etcd.SpecSpec.listen_peer_addresses:type_name -> common.NetIP
+	7, // 4: talos.resource.definitions.etcd.SpecSpec.listen_client_addresses:type_name -> common.NetIP
 	5, // [5:5] is the sub-list for method output_type
 	5, // [5:5] is the sub-list for method input_type
 	5, // [5:5] is the sub-list for extension type_name
@@ -434,7 +540,7 @@ func file_resource_definitions_etcd_etcd_proto_init() {
 			}
 		}
 		file_resource_definitions_etcd_etcd_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*MemberSpec); i {
+			switch v := v.(*ConsistencyStatusSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -446,7 +552,7 @@ func file_resource_definitions_etcd_etcd_proto_init() {
 			}
 		}
 		file_resource_definitions_etcd_etcd_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*PKIStatusSpec); i {
+			switch v := v.(*MemberSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -458,6 +564,18 @@ func file_resource_definitions_etcd_etcd_proto_init() {
 			}
 		}
 		file_resource_definitions_etcd_etcd_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*PKIStatusSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_definitions_etcd_etcd_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*SpecSpec); i {
 			case 0:
 				return &v.state
@@ -476,7 +594,7 @@ func file_resource_definitions_etcd_etcd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_resource_definitions_etcd_etcd_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion8
 
 const (
 	SecurityService_Certificate_FullMethodName = "/securityapi.SecurityService/Certificate"
+	SecurityService_Attest_FullMethodName      = "/securityapi.SecurityService/Attest"
 )
 
 // SecurityServiceClient is the client API for SecurityService service.
@@ -30,6 +31,10 @@ const (
 // The security service definition.
 type SecurityServiceClient interface {
 	Certificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error)
+	// Attest returns the TPM quote of the node PCRs qualified with the nonce.
+	//
+	// The method is served by every node, while Certificate is served by trustd on the control plane nodes.
+	Attest(ctx context.Context, in *AttestRequest, opts ...grpc.CallOption) (*AttestResponse, error)
 }
 
 type securityServiceClient struct {
@@ -50,6 +55,16 @@ func (c *securityServiceClient) Certificate(ctx context.Context, in *Certificate
 	return out, nil
 }
 
+func (c *securityServiceClient) Attest(ctx context.Context, in *AttestRequest, opts ...grpc.CallOption) (*AttestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttestResponse)
+	err := c.cc.Invoke(ctx, SecurityService_Attest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SecurityServiceServer is the server API for SecurityService service.
 // All implementations must embed UnimplementedSecurityServiceServer
 // for forward compatibility
@@ -57,6 +72,10 @@ func (c *securityServiceClient) Certificate(ctx context.Context, in *Certificate
 // The security service definition.
 type SecurityServiceServer interface {
 	Certificate(context.Context, *CertificateRequest) (*CertificateResponse, error)
+	// Attest returns the TPM quote of the node PCRs qualified with the nonce.
+	//
+	// The method is served by every node, while Certificate is served by trustd on the control plane nodes.
+	Attest(context.Context, *AttestRequest) (*AttestResponse, error)
 	mustEmbedUnimplementedSecurityServiceServer()
 }
 
@@ -67,6 +86,9 @@ type UnimplementedSecurityServiceServer struct {
 func (UnimplementedSecurityServiceServer) Certificate(context.Context, *CertificateRequest) (*CertificateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Certificate not implemented")
 }
+func (UnimplementedSecurityServiceServer) Attest(context.Context, *AttestRequest) (*AttestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attest not implemented")
+}
 func (UnimplementedSecurityServiceServer) mustEmbedUnimplementedSecurityServiceServer() {}
 
 // UnsafeSecurityServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -98,6 +120,24 @@ func _SecurityService_Certificate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SecurityService_Attest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).Attest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityService_Attest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).Attest(ctx, req.(*AttestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SecurityService_ServiceDesc is the grpc.ServiceDesc for SecurityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -109,6 +149,10 @@ var SecurityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Certificate",
 			Handler:    _SecurityService_Certificate_Handler,
 		},
+		{
+			MethodName: "Attest",
+			Handler:    _SecurityService_Attest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "security/security.proto",
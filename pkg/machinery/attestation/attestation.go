@@ -0,0 +1,344 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package attestation verifies the TPM quotes published by the nodes in the AttestationStatus resource.
+//
+// The verification doesn't require access to the node TPM, so it can be used by the external attestation services.
+package attestation
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// TPM2 constants used in the quote structures.
+const (
+	tpmGeneratedValue = 0xff544347
+	tpmSTAttestQuote  = 0x8018
+	tpmAlgSHA256      = 0x000b
+	tpmAlgRSASSA      = 0x0014
+	tpmAlgECDSA       = 0x0018
+)
+
+// Quote is the verified contents of the TPM quote.
+type Quote struct {
+	// PCRs maps the quoted PCR index to the hex encoded SHA256 value.
+	PCRs map[int]string
+
+	// Clock information of the TPM at the moment of the quote.
+	Clock        uint64
+	ResetCount   uint32
+	RestartCount uint32
+
+	FirmwareVersion uint64
+}
+
+// VerifyOption configures the verification.
+type VerifyOption func(*verifyOptions)
+
+type verifyOptions struct {
+	attestationKey []byte
+}
+
+// WithAttestationKey requires the quote to be signed with the specific (PKIX DER encoded) attestation key.
+//
+// Attestation services should pin the attestation key of the node, as otherwise the quote is only self-consistent.
+func WithAttestationKey(key []byte) VerifyOption {
+	return func(opts *verifyOptions) {
+		opts.attestationKey = key
+	}
+}
+
+// Verify checks the signature of the quote, and that the quote covers the nonce and the PCR values of the status.
+//
+//nolint:gocyclo,cyclop
+func Verify(status *runtime.AttestationStatusSpec, opts ...VerifyOption) (*Quote, error) {
+	var options verifyOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.attestationKey != nil && !bytes.Equal(options.attestationKey, status.AttestationKey) {
+		return nil, errors.New("quote is signed with an unexpected attestation key")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(status.AttestationKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing attestation key: %w", err)
+	}
+
+	if err = verifySignature(key, status.Quote, status.Signature); err != nil {
+		return nil, err
+	}
+
+	attest, err := parseAttest(status.Quote)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing quote: %w", err)
+	}
+
+	if !bytes.Equal(attest.extraData, status.Nonce) {
+		return nil, errors.New("quote doesn't match the nonce")
+	}
+
+	if len(attest.pcrs) != len(status.PCRValues) {
+		return nil, fmt.Errorf("quote covers %d PCRs, but %d PCR values are reported", len(attest.pcrs), len(status.PCRValues))
+	}
+
+	quote := &Quote{
+		PCRs:            make(map[int]string, len(attest.pcrs)),
+		Clock:           attest.clock,
+		ResetCount:      attest.resetCount,
+		RestartCount:    attest.restartCount,
+		FirmwareVersion: attest.firmwareVersion,
+	}
+
+	h := sha256.New()
+
+	for i, pcr := range attest.pcrs {
+		value, err := hex.DecodeString(status.PCRValues[i])
+		if err != nil || len(value) != sha256.Size {
+			return nil, fmt.Errorf("invalid PCR %d value %q", pcr, status.PCRValues[i])
+		}
+
+		h.Write(value)
+
+		quote.PCRs[pcr] = status.PCRValues[i]
+	}
+
+	if !bytes.Equal(h.Sum(nil), attest.pcrDigest) {
+		return nil, errors.New("quote PCR digest doesn't match the PCR values")
+	}
+
+	return quote, nil
+}
+
+// VerifyEventLog checks that the event log matches the event log digest of the status.
+//
+// The event log should be replayed against the PCR values of the verified quote to establish trust into the event log contents.
+func VerifyEventLog(status *runtime.AttestationStatusSpec, eventLog []byte) error {
+	digest := sha256.Sum256(eventLog)
+
+	if hex.EncodeToString(digest[:]) != status.EventLogDigest {
+		return errors.New("event log doesn't match the event log digest")
+	}
+
+	return nil
+}
+
+// ConfigPCRValue returns the hex encoded SHA256 value of the constants.ConfigPCR after the boot
+// with the kernel command line and the machine configuration.
+//
+// The kernel command line is the contents of /proc/cmdline, the machine configuration is the contents of the config.yaml
+// saved to the STATE partition (constants.ConfigPath on the node).
+func ConfigPCRValue(cmdline, config []byte) string {
+	value := make([]byte, sha256.Size)
+
+	for _, data := range [][]byte{cmdline, config} {
+		digest := sha256.Sum256(data)
+
+		h := sha256.New()
+		h.Write(value)
+		h.Write(digest[:])
+
+		value = h.Sum(nil)
+	}
+
+	return hex.EncodeToString(value)
+}
+
+func verifySignature(key crypto.PublicKey, quote, signature []byte) error {
+	r := bytes.NewReader(signature)
+
+	var sigAlg, hashAlg uint16
+
+	if err := binary.Read(r, binary.BigEndian, &sigAlg); err != nil {
+		return fmt.Errorf("error parsing signature: %w", err)
+	}
+
+	if err := binary.Read(r, binary.BigEndian, &hashAlg); err != nil {
+		return fmt.Errorf("error parsing signature: %w", err)
+	}
+
+	if hashAlg != tpmAlgSHA256 {
+		return fmt.Errorf("unsupported signature hash algorithm 0x%04x", hashAlg)
+	}
+
+	digest := sha256.Sum256(quote)
+
+	switch sigAlg {
+	case tpmAlgECDSA:
+		ecdsaKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.New("ECDSA signature requires an ECDSA attestation key")
+		}
+
+		sigR, err := readSized(r)
+		if err != nil {
+			return fmt.Errorf("error parsing signature: %w", err)
+		}
+
+		sigS, err := readSized(r)
+		if err != nil {
+			return fmt.Errorf("error parsing signature: %w", err)
+		}
+
+		if !ecdsa.Verify(ecdsaKey, digest[:], new(big.Int).SetBytes(sigR), new(big.Int).SetBytes(sigS)) {
+			return errors.New("quote signature is invalid")
+		}
+	case tpmAlgRSASSA:
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("RSASSA signature requires an RSA attestation key")
+		}
+
+		sig, err := readSized(r)
+		if err != nil {
+			return fmt.Errorf("error parsing signature: %w", err)
+		}
+
+		if err = rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest[:], sig); err != nil {
+			return fmt.Errorf("quote signature is invalid: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported signature algorithm 0x%04x", sigAlg)
+	}
+
+	return nil
+}
+
+// attest is the parsed TPMS_ATTEST structure of the quote.
+type attest struct {
+	extraData []byte
+
+	clock        uint64
+	resetCount   uint32
+	restartCount uint32
+
+	firmwareVersion uint64
+
+	pcrs      []int
+	pcrDigest []byte
+}
+
+//nolint:gocyclo
+func parseAttest(data []byte) (*attest, error) {
+	r := bytes.NewReader(data)
+
+	var header struct {
+		Magic uint32
+		Type  uint16
+	}
+
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, err
+	}
+
+	if header.Magic != tpmGeneratedValue {
+		return nil, errors.New("quote is not generated by the TPM")
+	}
+
+	if header.Type != tpmSTAttestQuote {
+		return nil, fmt.Errorf("unexpected attestation type 0x%04x", header.Type)
+	}
+
+	// qualified signer
+	if _, err := readSized(r); err != nil {
+		return nil, err
+	}
+
+	var (
+		result attest
+		err    error
+	)
+
+	if result.extraData, err = readSized(r); err != nil {
+		return nil, err
+	}
+
+	var clockInfo struct {
+		Clock           uint64
+		ResetCount      uint32
+		RestartCount    uint32
+		Safe            uint8
+		FirmwareVersion uint64
+	}
+
+	if err = binary.Read(r, binary.BigEndian, &clockInfo); err != nil {
+		return nil, err
+	}
+
+	result.clock = clockInfo.Clock
+	result.resetCount = clockInfo.ResetCount
+	result.restartCount = clockInfo.RestartCount
+	result.firmwareVersion = clockInfo.FirmwareVersion
+
+	var selectionCount uint32
+
+	if err = binary.Read(r, binary.BigEndian, &selectionCount); err != nil {
+		return nil, err
+	}
+
+	for range selectionCount {
+		var selection struct {
+			Hash uint16
+			Size uint8
+		}
+
+		if err = binary.Read(r, binary.BigEndian, &selection); err != nil {
+			return nil, err
+		}
+
+		mask := make([]byte, selection.Size)
+
+		if _, err = io.ReadFull(r, mask); err != nil {
+			return nil, err
+		}
+
+		if selection.Hash != tpmAlgSHA256 {
+			return nil, fmt.Errorf("unsupported PCR bank 0x%04x", selection.Hash)
+		}
+
+		for i := range len(mask) * 8 {
+			if mask[i/8]&(1<<(i%8)) != 0 {
+				result.pcrs = append(result.pcrs, i)
+			}
+		}
+	}
+
+	if result.pcrDigest, err = readSized(r); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// readSized reads the TPM2B structure.
+func readSized(r io.Reader) ([]byte, error) {
+	var size uint16
+
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, size)
+
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
@@ -0,0 +1,165 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package attestation_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/attestation"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+func writeSized(buf *bytes.Buffer, data []byte) {
+	binary.Write(buf, binary.BigEndian, uint16(len(data))) //nolint:errcheck
+	buf.Write(data)
+}
+
+// buildStatus builds the attestation status the way the TPM would generate the quote.
+func buildStatus(t *testing.T, key *ecdsa.PrivateKey, nonce []byte, pcrs map[int][]byte) *runtime.AttestationStatusSpec {
+	t.Helper()
+
+	mask := make([]byte, 3)
+	digest := sha256.New()
+
+	var values []string
+
+	for i := range 24 {
+		if value, ok := pcrs[i]; ok {
+			mask[i/8] |= 1 << (i % 8)
+
+			digest.Write(value)
+
+			values = append(values, hex.EncodeToString(value))
+		}
+	}
+
+	var quote bytes.Buffer
+
+	binary.Write(&quote, binary.BigEndian, uint32(0xff544347)) //nolint:errcheck
+	binary.Write(&quote, binary.BigEndian, uint16(0x8018))     //nolint:errcheck
+	writeSized(&quote, []byte("signer"))
+	writeSized(&quote, nonce)
+	binary.Write(&quote, binary.BigEndian, uint64(1000))   //nolint:errcheck // clock
+	binary.Write(&quote, binary.BigEndian, uint32(2))      //nolint:errcheck // reset count
+	binary.Write(&quote, binary.BigEndian, uint32(3))      //nolint:errcheck // restart count
+	quote.WriteByte(1)                                     // safe
+	binary.Write(&quote, binary.BigEndian, uint64(42))     //nolint:errcheck // firmware version
+	binary.Write(&quote, binary.BigEndian, uint32(1))      //nolint:errcheck // selection count
+	binary.Write(&quote, binary.BigEndian, uint16(0x000b)) //nolint:errcheck // SHA256
+	quote.WriteByte(byte(len(mask)))
+	quote.Write(mask)
+	writeSized(&quote, digest.Sum(nil))
+
+	quoteDigest := sha256.Sum256(quote.Bytes())
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, quoteDigest[:])
+	require.NoError(t, err)
+
+	var signature bytes.Buffer
+
+	binary.Write(&signature, binary.BigEndian, uint16(0x0018)) //nolint:errcheck // ECDSA
+	binary.Write(&signature, binary.BigEndian, uint16(0x000b)) //nolint:errcheck // SHA256
+	writeSized(&signature, r.Bytes())
+	writeSized(&signature, s.Bytes())
+
+	attestationKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	return &runtime.AttestationStatusSpec{
+		Nonce:          nonce,
+		Quote:          quote.Bytes(),
+		Signature:      signature.Bytes(),
+		AttestationKey: attestationKey,
+		PCRValues:      values,
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	pcr0 := bytes.Repeat([]byte{0x01}, sha256.Size)
+	pcr7 := bytes.Repeat([]byte{0x07}, sha256.Size)
+
+	status := buildStatus(t, key, []byte("nonce"), map[int][]byte{0: pcr0, 7: pcr7})
+
+	quote, err := attestation.Verify(status)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[int]string{
+		0: hex.EncodeToString(pcr0),
+		7: hex.EncodeToString(pcr7),
+	}, quote.PCRs)
+	assert.EqualValues(t, 1000, quote.Clock)
+	assert.EqualValues(t, 2, quote.ResetCount)
+	assert.EqualValues(t, 3, quote.RestartCount)
+	assert.EqualValues(t, 42, quote.FirmwareVersion)
+
+	_, err = attestation.Verify(status, attestation.WithAttestationKey(status.AttestationKey))
+	require.NoError(t, err)
+
+	otherAttestationKey, err := x509.MarshalPKIXPublicKey(&otherKey.PublicKey)
+	require.NoError(t, err)
+
+	_, err = attestation.Verify(status, attestation.WithAttestationKey(otherAttestationKey))
+	assert.EqualError(t, err, "quote is signed with an unexpected attestation key")
+
+	tampered := *status
+	tampered.PCRValues = []string{hex.EncodeToString(pcr0), hex.EncodeToString(pcr0)}
+
+	_, err = attestation.Verify(&tampered)
+	assert.EqualError(t, err, "quote PCR digest doesn't match the PCR values")
+
+	tampered = *status
+	tampered.Nonce = []byte("other")
+
+	_, err = attestation.Verify(&tampered)
+	assert.EqualError(t, err, "quote doesn't match the nonce")
+
+	tampered = *status
+	tampered.AttestationKey = otherAttestationKey
+
+	_, err = attestation.Verify(&tampered)
+	assert.EqualError(t, err, "quote signature is invalid")
+}
+
+func TestVerifyEventLog(t *testing.T) {
+	t.Parallel()
+
+	eventLog := []byte("event log")
+	digest := sha256.Sum256(eventLog)
+
+	status := &runtime.AttestationStatusSpec{
+		EventLogDigest: hex.EncodeToString(digest[:]),
+	}
+
+	assert.NoError(t, attestation.VerifyEventLog(status, eventLog))
+	assert.Error(t, attestation.VerifyEventLog(status, []byte("other event log")))
+}
+
+func TestConfigPCRValue(t *testing.T) {
+	t.Parallel()
+
+	value := attestation.ConfigPCRValue([]byte("talos.platform=metal\n"), []byte("version: v1alpha1\n"))
+
+	assert.Equal(t, "ca9dc4d36d743d6dafc46aa55ad89fceb8239bda731a65d459414ba149db2fb1", value)
+	assert.NotEqual(t, value, attestation.ConfigPCRValue([]byte("talos.platform=metal\n"), []byte("version: v1alpha1\ndebug: true\n")))
+}
@@ -0,0 +1,32 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	auditapi "github.com/siderolabs/talos/pkg/machinery/api/audit"
+)
+
+// AuditClient provides access to audit API.
+type AuditClient struct {
+	client auditapi.AuditServiceClient
+}
+
+// List returns up to tail most recent audit records (all the records kept if tail is not positive).
+func (c *AuditClient) List(ctx context.Context, tail int32, callOptions ...grpc.CallOption) (*auditapi.ListResponse, error) {
+	resp, err := c.client.List(ctx, &auditapi.ListRequest{Tail: tail}, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// Watch streams up to tail most recent audit records and then the new records as they are recorded.
+//
+// If tail is negative, all the records kept are sent first.
+func (c *AuditClient) Watch(ctx context.Context, tail int32, callOptions ...grpc.CallOption) (auditapi.AuditService_WatchClient, error) {
+	return c.client.Watch(ctx, &auditapi.WatchRequest{Tail: tail}, callOptions...)
+}
@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// BearerToken implements the credentials.PerRPCCredentials interface and holds the bearer token (e.g. OIDC ID token).
+type BearerToken struct {
+	token func() (string, error)
+}
+
+// GetRequestMetadata implements credentials.PerGRPCCredentials.
+func (c BearerToken) GetRequestMetadata(ctx context.Context, url ...string) (map[string]string, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		constants.APIAuthorizationMetadataKey: "Bearer " + token,
+	}, nil
+}
+
+// WithGRPCBearerToken returns gRPC credentials for bearer token auth.
+//
+// Bearer tokens are accepted by the nodes with OIDC authentication configured from the clients without a client certificate.
+func WithGRPCBearerToken(token string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(BearerToken{
+		token: func() (string, error) { return token, nil },
+	})
+}
+
+// WithGRPCBearerTokenFile returns gRPC credentials for bearer token auth with the token read from the file.
+//
+// The file is read on each request, so that the token can be refreshed externally.
+func WithGRPCBearerTokenFile(path string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(BearerToken{
+		token: func() (string, error) {
+			contents, err := os.ReadFile(path)
+			if err != nil {
+				return "", fmt.Errorf("error reading bearer token: %w", err)
+			}
+
+			return strings.TrimSpace(string(contents)), nil
+		},
+	})
+}
@@ -0,0 +1,228 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+// Feature is an API feature negotiated between the client and the node.
+//
+// The nodes running older Talos versions might not support the feature.
+type Feature string
+
+// Negotiated API features.
+const (
+	FeatureAttestation          Feature = "attestation"
+	FeatureAuditLog             Feature = "audit-log"
+	FeatureCertificateRenewal   Feature = "certificate-renewal"
+	FeatureConntrackList        Feature = "conntrack-list"
+	FeatureDiskBenchmark        Feature = "disk-benchmark"
+	FeatureDiskEncryption       Feature = "disk-encryption"
+	FeatureEtcdConsistencyCheck Feature = "etcd-consistency-check"
+	FeatureEtcdMemberLatency    Feature = "etcd-member-latency"
+	FeatureEtcdQuorumGuard      Feature = "etcd-quorum-guard"
+	FeatureEventsFilters        Feature = "events-filters"
+	FeatureFeatureFlags         Feature = "feature-flags"
+	FeatureFilesystemTrim       Feature = "filesystem-trim"
+	FeatureGeneratedFiles       Feature = "generated-files"
+	FeatureHealthChecks         Feature = "health-checks"
+	FeatureKernelModuleParams   Feature = "kernel-module-params"
+	FeatureLogRecords           Feature = "log-records"
+	FeatureRootfsIntegrity      Feature = "rootfs-integrity"
+	FeatureSequences            Feature = "sequences"
+	FeatureSocketStatistics     Feature = "socket-statistics"
+	FeatureStatsStreaming       Feature = "stats-streaming"
+	FeatureUserFiles            Feature = "user-files"
+)
+
+// SupportedFeatures returns the list of the API features supported by this version of Talos.
+func SupportedFeatures() []Feature {
+	return []Feature{
+		FeatureAttestation,
+		FeatureAuditLog,
+		FeatureCertificateRenewal,
+		FeatureConntrackList,
+		FeatureDiskBenchmark,
+		FeatureDiskEncryption,
+		FeatureEtcdConsistencyCheck,
+		FeatureEtcdMemberLatency,
+		FeatureEtcdQuorumGuard,
+		FeatureEventsFilters,
+		FeatureFeatureFlags,
+		FeatureFilesystemTrim,
+		FeatureGeneratedFiles,
+		FeatureHealthChecks,
+		FeatureKernelModuleParams,
+		FeatureLogRecords,
+		FeatureRootfsIntegrity,
+		FeatureSequences,
+		FeatureSocketStatistics,
+		FeatureStatsStreaming,
+		FeatureUserFiles,
+	}
+}
+
+// NodeCapabilities describes the API features supported by the node.
+type NodeCapabilities struct {
+	// Node is the node the capabilities were reported by.
+	Node string
+	// Version is the Talos version of the node.
+	//
+	// Version is empty if the node doesn't implement the capabilities handshake.
+	Version string
+	// Features supported by the node.
+	Features []Feature
+}
+
+// Supports returns true if the node supports the feature.
+func (capabilities *NodeCapabilities) Supports(feature Feature) bool {
+	return slices.Contains(capabilities.Features, feature)
+}
+
+// UnsupportedFeatureError is returned when the node doesn't support the API feature.
+//
+// UnsupportedFeatureError matches ErrUnsupportedVersion with errors.Is.
+type UnsupportedFeatureError struct {
+	Feature Feature
+	Node    string
+	Version string
+}
+
+func (e *UnsupportedFeatureError) Error() string {
+	node := "the node"
+	if e.Node != "" {
+		node = fmt.Sprintf("node %q", e.Node)
+	}
+
+	version := "an older Talos version"
+	if e.Version != "" {
+		version = "Talos " + e.Version
+	}
+
+	return fmt.Sprintf("%s running %s doesn't support %s, upgrade the node to use it", node, version, e.Feature)
+}
+
+// Unwrap implements errors.Unwrap interface.
+func (e *UnsupportedFeatureError) Unwrap() error {
+	return ErrUnsupportedVersion
+}
+
+// Capabilities performs the API capabilities handshake with the nodes.
+//
+// The nodes which don't implement the handshake (running older Talos versions) are reported as supporting no features.
+func (c *Client) Capabilities(ctx context.Context, callOptions ...grpc.CallOption) ([]NodeCapabilities, error) {
+	var remotePeer peer.Peer
+
+	resp, err := c.MachineClient.Capabilities(
+		ctx,
+		&machineapi.CapabilitiesRequest{
+			Features:      xslices.Map(SupportedFeatures(), func(feature Feature) string { return string(feature) }),
+			ClientVersion: version.Tag,
+		},
+		append(callOptions, grpc.Peer(&remotePeer))...,
+	)
+
+	defaultNode := ""
+	if remotePeer.Addr != nil {
+		defaultNode = AddrFromPeer(&remotePeer)
+	}
+
+	if StatusCode(err) == codes.Unimplemented {
+		return []NodeCapabilities{{Node: defaultNode}}, nil
+	}
+
+	resp, err = FilterMessages(resp, err)
+	if resp == nil {
+		return nil, err
+	}
+
+	result := make([]NodeCapabilities, 0, len(resp.Messages))
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		result = append(result, NodeCapabilities{
+			Node:     node,
+			Version:  msg.Version,
+			Features: xslices.Map(msg.Features, func(feature string) Feature { return Feature(feature) }),
+		})
+	}
+
+	nodeErrs := NodeErrors(err)
+	if err != nil && len(nodeErrs) == 0 {
+		return result, err
+	}
+
+	var multiErr *multierror.Error
+
+	for _, nodeErr := range nodeErrs {
+		if nodeErr.Code() == codes.Unimplemented {
+			result = append(result, NodeCapabilities{Node: nodeErr.Node})
+
+			continue
+		}
+
+		multiErr = multierror.Append(multiErr, nodeErr)
+	}
+
+	return result, multiErr.ErrorOrNil()
+}
+
+// RequireFeature checks that all the nodes support the API feature.
+//
+// If some node doesn't support the feature, *UnsupportedFeatureError is returned.
+func (c *Client) RequireFeature(ctx context.Context, feature Feature) error {
+	nodes, err := c.Capabilities(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		if !node.Supports(feature) {
+			return &UnsupportedFeatureError{
+				Feature: feature,
+				Node:    node.Node,
+				Version: node.Version,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExplainUnsupported replaces the error returned by the nodes which don't implement the API feature (running older Talos versions)
+// with *UnsupportedFeatureError explaining which node doesn't support the feature.
+//
+// Other errors are returned as is.
+func (c *Client) ExplainUnsupported(ctx context.Context, feature Feature, err error) error {
+	if !errors.Is(err, ErrUnsupportedVersion) {
+		return err
+	}
+
+	var unsupportedErr *UnsupportedFeatureError
+
+	if errors.As(c.RequireFeature(ctx, feature), &unsupportedErr) {
+		return unsupportedErr
+	}
+
+	return err
+}
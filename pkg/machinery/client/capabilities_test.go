@@ -0,0 +1,122 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+type capabilitiesTransport struct {
+	request  *machine.CapabilitiesRequest
+	response *machine.CapabilitiesResponse
+	err      error
+}
+
+func (t *capabilitiesTransport) Invoke(_ context.Context, _ string, args, reply any, _ ...grpc.CallOption) error {
+	t.request = args.(*machine.CapabilitiesRequest) //nolint:forcetypeassert
+
+	if t.err != nil {
+		return t.err
+	}
+
+	reply.(*machine.CapabilitiesResponse).Messages = t.response.Messages //nolint:forcetypeassert
+
+	return nil
+}
+
+func (t *capabilitiesTransport) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (t *capabilitiesTransport) Close() error {
+	return nil
+}
+
+func TestCapabilities(t *testing.T) {
+	transport := &capabilitiesTransport{
+		response: &machine.CapabilitiesResponse{
+			Messages: []*machine.Capabilities{
+				{
+					Metadata: &common.Metadata{Hostname: "new"},
+					Version:  "v1.9.0",
+					Features: []string{string(client.FeatureFilesystemTrim), string(client.FeatureUserFiles)},
+				},
+				{
+					Metadata: &common.Metadata{
+						Hostname: "old",
+						Error:    "method Capabilities not implemented",
+						Status:   status.New(codes.Unimplemented, "method Capabilities not implemented").Proto(),
+					},
+				},
+			},
+		},
+	}
+
+	c, err := client.New(context.Background(), client.WithTransport(transport))
+	require.NoError(t, err)
+
+	nodes, err := c.Capabilities(context.Background())
+	require.NoError(t, err)
+
+	assert.Len(t, transport.request.Features, len(client.SupportedFeatures()))
+
+	require.Len(t, nodes, 2)
+	assert.Equal(t, "new", nodes[0].Node)
+	assert.Equal(t, "v1.9.0", nodes[0].Version)
+	assert.True(t, nodes[0].Supports(client.FeatureUserFiles))
+	assert.False(t, nodes[0].Supports(client.FeatureEventsFilters))
+	assert.Equal(t, "old", nodes[1].Node)
+	assert.Empty(t, nodes[1].Features)
+
+	err = c.RequireFeature(context.Background(), client.FeatureUserFiles)
+	assert.ErrorIs(t, err, client.ErrUnsupportedVersion)
+	assert.EqualError(t, err, `node "old" running an older Talos version doesn't support user-files, upgrade the node to use it`)
+
+	transport.response.Messages = transport.response.Messages[:1]
+
+	err = c.RequireFeature(context.Background(), client.FeatureEventsFilters)
+	assert.EqualError(t, err, `node "new" running Talos v1.9.0 doesn't support events-filters, upgrade the node to use it`)
+
+	assert.EqualError(t,
+		c.ExplainUnsupported(context.Background(), client.FeatureEventsFilters, client.WrapAPIError(status.Error(codes.Unimplemented, "unknown method"))),
+		`node "new" running Talos v1.9.0 doesn't support events-filters, upgrade the node to use it`,
+	)
+	assert.EqualError(t,
+		c.ExplainUnsupported(context.Background(), client.FeatureUserFiles, client.WrapAPIError(status.Error(codes.Unimplemented, "unknown method"))),
+		"rpc error: code = Unimplemented desc = unknown method",
+	)
+	assert.EqualError(t,
+		c.ExplainUnsupported(context.Background(), client.FeatureEventsFilters, errors.New("boom")),
+		"boom",
+	)
+
+	// the node which doesn't implement the handshake at all
+	transport.err = status.Error(codes.Unimplemented, "method Capabilities not implemented")
+
+	nodes, err = c.Capabilities(context.Background())
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Empty(t, nodes[0].Features)
+
+	assert.EqualError(t, c.RequireFeature(context.Background(), client.FeatureFilesystemTrim),
+		"the node running an older Talos version doesn't support filesystem-trim, upgrade the node to use it")
+
+	transport.err = status.Error(codes.Unavailable, "connection refused")
+
+	_, err = c.Capabilities(context.Background())
+	assert.ErrorIs(t, err, client.ErrNodeUnreachable)
+}
@@ -0,0 +1,200 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"sync"
+	"time"
+
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+const (
+	// certificateRenewalRetryInterval is the interval between the attempts to renew the certificate after a failure.
+	certificateRenewalRetryInterval = time.Minute
+
+	// certificateRenewalTimeout limits the duration of a single renewal attempt.
+	certificateRenewalTimeout = 30 * time.Second
+)
+
+// CertificateRenewedFunc is called with the PEM-encoded renewed client certificate and key.
+type CertificateRenewedFunc func(crt, key []byte)
+
+type renewCertificateFunc func(ctx context.Context, ttl time.Duration) (*machineapi.RenewClientCertificateResponse, error)
+
+// certificateRenewer keeps the client certificate fresh by renewing it via the API before it expires.
+//
+// New connections to the endpoints are established with the most recent certificate.
+type certificateRenewer struct {
+	ttl     time.Duration
+	onRenew CertificateRenewedFunc
+	renew   renewCertificateFunc
+
+	mu   sync.Mutex
+	cert *tls.Certificate
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newCertificateRenewer(cert *tls.Certificate, ttl time.Duration, onRenew CertificateRenewedFunc, renew renewCertificateFunc) (*certificateRenewer, error) {
+	if err := parseLeaf(cert); err != nil {
+		return nil, err
+	}
+
+	return &certificateRenewer{
+		ttl:     ttl,
+		onRenew: onRenew,
+		renew:   renew,
+		cert:    cert,
+	}, nil
+}
+
+// apply configures the TLS client to present the current certificate on each handshake.
+func (r *certificateRenewer) apply(tlsConfig *tls.Config) {
+	if r == nil {
+		return
+	}
+
+	tlsConfig.Certificates = nil
+	tlsConfig.GetClientCertificate = r.getClientCertificate
+}
+
+func (r *certificateRenewer) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cert, nil
+}
+
+// renewAt returns the time when the current certificate should be renewed: after two thirds of its lifetime.
+func (r *certificateRenewer) renewAt() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	leaf := r.cert.Leaf
+
+	return leaf.NotBefore.Add(leaf.NotAfter.Sub(leaf.NotBefore) * 2 / 3)
+}
+
+func (r *certificateRenewer) notAfter() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cert.Leaf.NotAfter
+}
+
+func (r *certificateRenewer) start() {
+	if r == nil {
+		return
+	}
+
+	var ctx context.Context
+
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		r.run(ctx)
+	}()
+}
+
+func (r *certificateRenewer) stop() {
+	if r == nil || r.cancel == nil {
+		return
+	}
+
+	r.cancel()
+	<-r.done
+}
+
+func (r *certificateRenewer) run(ctx context.Context) {
+	for {
+		timer := time.NewTimer(time.Until(r.renewAt()))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return
+		case <-timer.C:
+		}
+
+		expiresAt := r.notAfter()
+
+		if err := r.renewOnce(ctx); err == nil {
+			// the renewed certificate never outlives the certificate it was renewed with,
+			// so once the expiration can't be extended, there is nothing left to renew
+			if !r.notAfter().After(expiresAt) {
+				return
+			}
+
+			continue
+		}
+
+		// the current certificate might be still valid, so keep trying
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(certificateRenewalRetryInterval):
+		}
+	}
+}
+
+func (r *certificateRenewer) renewOnce(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, certificateRenewalTimeout)
+	defer cancel()
+
+	resp, err := r.renew(ctx, r.ttl)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Messages) != 1 {
+		return fmt.Errorf("expected 1 message, got %d", len(resp.Messages))
+	}
+
+	msg := resp.Messages[0]
+
+	cert, err := tls.X509KeyPair(msg.Crt, msg.Key)
+	if err != nil {
+		return fmt.Errorf("error loading renewed certificate: %w", err)
+	}
+
+	if err = parseLeaf(&cert); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.mu.Unlock()
+
+	if r.onRenew != nil {
+		r.onRenew(msg.Crt, msg.Key)
+	}
+
+	return nil
+}
+
+func parseLeaf(cert *tls.Certificate) error {
+	if cert.Leaf != nil {
+		return nil
+	}
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return fmt.Errorf("error parsing client certificate: %w", err)
+	}
+
+	cert.Leaf = leaf
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func generateCertificate(t *testing.T, notBefore, notAfter time.Time) (crtPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(notBefore.UnixNano()),
+		Subject:      pkix.Name{Organization: []string{"os:admin"}},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestCertificateRenewer(t *testing.T) {
+	now := time.Now()
+
+	// the certificate is past two thirds of its lifetime, so it should be renewed right away
+	crt, key := generateCertificate(t, now.Add(-2*time.Hour), now.Add(time.Hour))
+
+	cert, err := tls.X509KeyPair(crt, key)
+	require.NoError(t, err)
+
+	renewedCrt, renewedKey := generateCertificate(t, now, now.Add(time.Hour))
+
+	renewedCh := make(chan []byte, 1)
+
+	renewer, err := client.NewCertificateRenewer(&cert, time.Hour,
+		func(crt, _ []byte) {
+			renewedCh <- crt
+		},
+		func(_ context.Context, ttl time.Duration) (*machine.RenewClientCertificateResponse, error) {
+			assert.Equal(t, time.Hour, ttl)
+
+			return &machine.RenewClientCertificateResponse{
+				Messages: []*machine.RenewClientCertificate{
+					{
+						Crt: renewedCrt,
+						Key: renewedKey,
+					},
+				},
+			}, nil
+		},
+	)
+	require.NoError(t, err)
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+
+	renewer.Apply(tlsConfig)
+
+	assert.Empty(t, tlsConfig.Certificates)
+
+	current, err := tlsConfig.GetClientCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, cert.Certificate, current.Certificate)
+
+	renewer.Start()
+	defer renewer.Stop()
+
+	select {
+	case crt := <-renewedCh:
+		assert.Equal(t, renewedCrt, crt)
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "certificate was not renewed")
+	}
+
+	current, err = tlsConfig.GetClientCertificate(nil)
+	require.NoError(t, err)
+
+	renewed, err := tls.X509KeyPair(renewedCrt, renewedKey)
+	require.NoError(t, err)
+
+	assert.Equal(t, renewed.Certificate, current.Certificate)
+}
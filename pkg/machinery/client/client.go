@@ -13,6 +13,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"time"
 
 	cosiv1alpha1 "github.com/cosi-project/runtime/api/v1alpha1"
@@ -24,10 +25,13 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	auditapi "github.com/siderolabs/talos/pkg/machinery/api/audit"
 	clusterapi "github.com/siderolabs/talos/pkg/machinery/api/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	healthapi "github.com/siderolabs/talos/pkg/machinery/api/health"
 	inspectapi "github.com/siderolabs/talos/pkg/machinery/api/inspect"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
 	storageapi "github.com/siderolabs/talos/pkg/machinery/api/storage"
 	timeapi "github.com/siderolabs/talos/pkg/machinery/api/time"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
@@ -36,18 +40,24 @@ import (
 // Client implements the proto.MachineServiceClient interface. It serves as the
 // concrete type with the required methods.
 type Client struct {
-	options *Options
-	conn    *grpcConnectionWrapper
-
-	MachineClient machineapi.MachineServiceClient
-	TimeClient    timeapi.TimeServiceClient
-	ClusterClient clusterapi.ClusterServiceClient
-	StorageClient storageapi.StorageServiceClient
-	InspectClient inspectapi.InspectServiceClient
+	options     *Options
+	conn        *grpcConnectionWrapper
+	certRenewer *certificateRenewer
+
+	MachineClient  machineapi.MachineServiceClient
+	TimeClient     timeapi.TimeServiceClient
+	ClusterClient  clusterapi.ClusterServiceClient
+	StorageClient  storageapi.StorageServiceClient
+	InspectClient  inspectapi.InspectServiceClient
+	AuditClient    auditapi.AuditServiceClient
+	SecurityClient securityapi.SecurityServiceClient
+	HealthClient   healthapi.HealthServiceClient
 
 	COSI state.State
 
 	Inspect *InspectClient
+	Audit   *AuditClient
+	Health  *HealthClient
 }
 
 func (c *Client) resolveConfigContext() error {
@@ -155,13 +165,17 @@ func New(ctx context.Context, opts ...OptionFunc) (c *Client, err error) {
 		}
 	}
 
-	if len(c.GetEndpoints()) < 1 {
-		return nil, errors.New("failed to determine endpoints")
-	}
+	if c.options.transport != nil {
+		c.conn = newTransportConnectionWrapper(c.options.clusterNameOverride, c.options.transport)
+	} else {
+		if len(c.GetEndpoints()) < 1 {
+			return nil, errors.New("failed to determine endpoints")
+		}
 
-	c.conn, err = c.getConn()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create client connection: %w", err)
+		c.conn, err = c.getConn()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client connection: %w", err)
+		}
 	}
 
 	c.MachineClient = machineapi.NewMachineServiceClient(c.conn)
@@ -169,15 +183,24 @@ func New(ctx context.Context, opts ...OptionFunc) (c *Client, err error) {
 	c.ClusterClient = clusterapi.NewClusterServiceClient(c.conn)
 	c.StorageClient = storageapi.NewStorageServiceClient(c.conn)
 	c.InspectClient = inspectapi.NewInspectServiceClient(c.conn)
+	c.AuditClient = auditapi.NewAuditServiceClient(c.conn)
+	c.SecurityClient = securityapi.NewSecurityServiceClient(c.conn)
+	c.HealthClient = healthapi.NewHealthServiceClient(c.conn)
 
 	c.Inspect = &InspectClient{c.InspectClient}
+	c.Audit = &AuditClient{c.AuditClient}
+	c.Health = &HealthClient{c.HealthClient}
 	c.COSI = state.WrapCore(client.NewAdapter(cosiv1alpha1.NewStateClient(c.conn)))
 
+	c.certRenewer.start()
+
 	return c, nil
 }
 
 // Close shuts down client protocol.
 func (c *Client) Close() error {
+	c.certRenewer.stop()
+
 	return c.conn.Close()
 }
 
@@ -440,6 +463,11 @@ func (c *Client) Logs(ctx context.Context, namespace string, driver common.Conta
 	return
 }
 
+// LogRecords streams the parsed log records of the service or the container filtered on the node.
+func (c *Client) LogRecords(ctx context.Context, req *machineapi.LogRecordsRequest, callOptions ...grpc.CallOption) (machineapi.MachineService_LogRecordsClient, error) {
+	return c.MachineClient.LogRecords(ctx, req, callOptions...)
+}
+
 // LogsContainers implements the proto.MachineServiceClient interface.
 func (c *Client) LogsContainers(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.LogsContainersResponse, err error) {
 	resp, err = c.MachineClient.LogsContainers(
@@ -473,6 +501,34 @@ func (c *Client) Processes(ctx context.Context, callOptions ...grpc.CallOption)
 	return FilterMessages(resp, err)
 }
 
+// ProcessesStream streams the snapshots of the running processes taken at the interval.
+//
+// Zero interval means the default one (1 second).
+func (c *Client) ProcessesStream(ctx context.Context, interval time.Duration, callOptions ...grpc.CallOption) (machineapi.MachineService_ProcessesStreamClient, error) {
+	return c.MachineClient.ProcessesStream(ctx, &machineapi.ProcessesStreamRequest{
+		Interval: statsInterval(interval),
+	}, callOptions...)
+}
+
+// CgroupStats streams the resource usage of the cgroup and its descendants up to the depth sampled at the interval.
+//
+// Zero interval means the default one (1 second).
+func (c *Client) CgroupStats(ctx context.Context, path string, depth uint32, interval time.Duration, callOptions ...grpc.CallOption) (machineapi.MachineService_CgroupStatsClient, error) {
+	return c.MachineClient.CgroupStats(ctx, &machineapi.CgroupStatsRequest{
+		Interval: statsInterval(interval),
+		Path:     path,
+		Depth:    depth,
+	}, callOptions...)
+}
+
+func statsInterval(interval time.Duration) *durationpb.Duration {
+	if interval == 0 {
+		return nil
+	}
+
+	return durationpb.New(interval)
+}
+
 // Memory implements the proto.MachineServiceClient interface.
 func (c *Client) Memory(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.MemoryResponse, err error) {
 	resp, err = c.MachineClient.Memory(
@@ -505,13 +561,35 @@ func (c *Client) DiskUsage(ctx context.Context, req *machineapi.DiskUsageRequest
 	return c.MachineClient.DiskUsage(ctx, req)
 }
 
+// FileReadOptions provides Copy and Read API options.
+type FileReadOptions struct {
+	Redact bool
+}
+
+// FileReadOption provides Copy and Read API options.
+type FileReadOption func(*FileReadOptions)
+
+// WithRedact enables redaction of the secrets (private keys, tokens, etc.) in the file contents on the node.
+func WithRedact(redact bool) FileReadOption {
+	return func(opts *FileReadOptions) {
+		opts.Redact = redact
+	}
+}
+
 // Copy implements the proto.MachineServiceClient interface.
 //
 // This method doesn't support multiplexing of the result:
 // * either client.WithNodes is not used, or it contains a single node in the list.
-func (c *Client) Copy(ctx context.Context, rootPath string) (io.ReadCloser, error) {
+func (c *Client) Copy(ctx context.Context, rootPath string, opts ...FileReadOption) (io.ReadCloser, error) {
+	var options FileReadOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	stream, err := c.MachineClient.Copy(ctx, &machineapi.CopyRequest{
 		RootPath: rootPath,
+		Redact:   options.Redact,
 	})
 	if err != nil {
 		return nil, err
@@ -520,6 +598,40 @@ func (c *Client) Copy(ctx context.Context, rootPath string) (io.ReadCloser, erro
 	return ReadStream(stream)
 }
 
+// GeneratedFiles returns a .tar.gz snapshot of the files generated by Talos (/etc files, kubelet configuration).
+//
+// This method doesn't support multiplexing of the result:
+// * either client.WithNodes is not used, or it contains a single node in the list.
+func (c *Client) GeneratedFiles(ctx context.Context) (io.ReadCloser, error) {
+	stream, err := c.MachineClient.GeneratedFiles(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadStream(stream)
+}
+
+// EmergencyConsole opens the emergency console session with the reason recorded to the audit log.
+//
+// The returned stream is used to send the input and receive the output of the shell.
+// This method doesn't support multiplexing, client.WithNode should be used to select the node.
+func (c *Client) EmergencyConsole(ctx context.Context, reason string, rows, cols uint32) (machineapi.MachineService_EmergencyConsoleClient, error) {
+	stream, err := c.MachineClient.EmergencyConsole(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = stream.Send(&machineapi.EmergencyConsoleRequest{
+		Reason: reason,
+		Rows:   rows,
+		Cols:   cols,
+	}); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
 // UpgradeOptions provides upgrade API options.
 type UpgradeOptions struct {
 	Request         machineapi.UpgradeRequest
@@ -564,6 +676,20 @@ func WithUpgradeForce(force bool) UpgradeOption {
 	}
 }
 
+// WithUpgradePullRateLimit sets the installer image pull bandwidth limit (in bytes per second) for the upgrade.
+func WithUpgradePullRateLimit(bytesPerSecond uint64) UpgradeOption {
+	return func(req *UpgradeOptions) {
+		req.Request.PullRateLimit = bytesPerSecond
+	}
+}
+
+// WithUpgradeGracefulPodShutdown enables graceful pod shutdown before the upgrade reboot.
+func WithUpgradeGracefulPodShutdown(graceful bool) UpgradeOption {
+	return func(req *UpgradeOptions) {
+		req.Request.GracefulPodShutdown = graceful
+	}
+}
+
 // WithUpgradeGRPCCallOptions sets the gRPC call options for the upgrade.
 func WithUpgradeGRPCCallOptions(opts ...grpc.CallOption) UpgradeOption {
 	return func(req *UpgradeOptions) {
@@ -707,12 +833,115 @@ func (c *Client) TimeCheck(ctx context.Context, server string, callOptions ...gr
 	return FilterMessages(resp, err)
 }
 
+// Attest returns the TPM quote of the node PCRs qualified with the nonce.
+func (c *Client) Attest(ctx context.Context, nonce []byte, callOptions ...grpc.CallOption) (*securityapi.AttestResponse, error) {
+	resp, err := c.SecurityClient.Attest(
+		ctx,
+		&securityapi.AttestRequest{Nonce: nonce},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
 // Read reads a file.
 //
 // This method doesn't support multiplexing of the result:
 // * either client.WithNodes is not used, or it contains a single node in the list.
-func (c *Client) Read(ctx context.Context, path string) (io.ReadCloser, error) {
-	stream, err := c.MachineClient.Read(ctx, &machineapi.ReadRequest{Path: path})
+func (c *Client) Read(ctx context.Context, path string, opts ...FileReadOption) (io.ReadCloser, error) {
+	var options FileReadOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	stream, err := c.MachineClient.Read(ctx, &machineapi.ReadRequest{Path: path, Redact: options.Redact})
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadStream(stream)
+}
+
+// UserFileWriteOption provides UserFileWrite API options.
+type UserFileWriteOption func(*machineapi.UserFileWriteRequest)
+
+// WithUserFileMode sets the permission bits of the written file.
+func WithUserFileMode(mode fs.FileMode) UserFileWriteOption {
+	return func(req *machineapi.UserFileWriteRequest) {
+		req.Mode = uint32(mode.Perm())
+	}
+}
+
+// WithUserFileOwner sets the owner of the written file.
+func WithUserFileOwner(uid, gid uint32) UserFileWriteOption {
+	return func(req *machineapi.UserFileWriteRequest) {
+		req.Uid = uid
+		req.Gid = gid
+	}
+}
+
+// UserFileWrite uploads the file to the node, the path should be under the user files directories.
+func (c *Client) UserFileWrite(ctx context.Context, path string, r io.Reader, opts ...UserFileWriteOption) (*machineapi.UserFileWriteResponse, error) {
+	cli, err := c.MachineClient.UserFileWrite(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &machineapi.UserFileWriteRequest{
+		Path: path,
+	}
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	buf := make([]byte, 32*1024)
+
+	for {
+		var n int
+
+		n, err = r.Read(buf)
+		if n > 0 {
+			req.Data = buf[:n]
+
+			if sendErr := cli.Send(req); sendErr != nil {
+				if errors.Is(sendErr, io.EOF) {
+					// the server has closed the stream, the error is returned by CloseAndRecv
+					break
+				}
+
+				return nil, sendErr
+			}
+
+			// the path and the file attributes are sent with the first message only
+			req = &machineapi.UserFileWriteRequest{}
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, fmt.Errorf("error reading file: %w", err)
+		}
+	}
+
+	if req.Path != "" {
+		// empty file, send the path and the file attributes
+		if err = cli.Send(req); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+	}
+
+	resp, err := cli.CloseAndRecv()
+
+	return FilterMessages(resp, err)
+}
+
+// UserFileRead reads the file under the user files directories from the node.
+func (c *Client) UserFileRead(ctx context.Context, path string) (io.ReadCloser, error) {
+	stream, err := c.MachineClient.UserFileRead(ctx, &machineapi.UserFileReadRequest{Path: path})
 	if err != nil {
 		return nil, err
 	}
@@ -764,6 +993,20 @@ func (c *Client) EtcdMemberList(ctx context.Context, req *machineapi.EtcdMemberL
 	return FilterMessages(resp, err)
 }
 
+// BenchmarkDisk measures the fsync latency and the I/O performance of the filesystem on the node.
+func (c *Client) BenchmarkDisk(ctx context.Context, req *machineapi.BenchmarkDiskRequest, callOptions ...grpc.CallOption) (*machineapi.BenchmarkDiskResponse, error) {
+	resp, err := c.MachineClient.BenchmarkDisk(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// EtcdMemberLatency measures the latency of the requests from the node to each etcd member.
+func (c *Client) EtcdMemberLatency(ctx context.Context, req *machineapi.EtcdMemberLatencyRequest, callOptions ...grpc.CallOption) (*machineapi.EtcdMemberLatencyResponse, error) {
+	resp, err := c.MachineClient.EtcdMemberLatency(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
 // EtcdSnapshot receives a snapshot of the etcd from the node.
 //
 // This method doesn't support multiplexing of the result:
@@ -850,6 +1093,15 @@ func (c *Client) EtcdDefragment(ctx context.Context, opts ...grpc.CallOption) (*
 	return FilterMessages(resp, err)
 }
 
+// EtcdConsistencyCheck compares the key-value store hashes and revisions of all etcd members.
+//
+// This method is available only on control plane nodes (which run etcd).
+func (c *Client) EtcdConsistencyCheck(ctx context.Context, opts ...grpc.CallOption) (*machineapi.EtcdConsistencyCheckResponse, error) {
+	resp, err := c.MachineClient.EtcdConsistencyCheck(ctx, &emptypb.Empty{}, opts...)
+
+	return FilterMessages(resp, err)
+}
+
 // EtcdStatus returns etcd status for the current member.
 //
 // This method is available only on control plane nodes (which run etcd).
@@ -947,6 +1199,195 @@ func (c *Client) Netstat(ctx context.Context, req *machineapi.NetstatRequest, ca
 	return FilterMessages(resp, err)
 }
 
+// SocketStatistics returns the listening sockets and the summary of the connections per network namespace.
+func (c *Client) SocketStatistics(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.SocketStatisticsResponse, error) {
+	resp, err := c.MachineClient.SocketStatistics(
+		ctx,
+		&emptypb.Empty{},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// SequenceList returns the sequences currently run by the node.
+func (c *Client) SequenceList(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.SequenceListResponse, error) {
+	resp, err := c.MachineClient.SequenceList(
+		ctx,
+		&emptypb.Empty{},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// SequenceCancel cancels the running sequence if it runs a cancelable phase.
+func (c *Client) SequenceCancel(ctx context.Context, sequence string, callOptions ...grpc.CallOption) (*machineapi.SequenceCancelResponse, error) {
+	resp, err := c.MachineClient.SequenceCancel(
+		ctx,
+		&machineapi.SequenceCancelRequest{
+			Sequence: sequence,
+		},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// ConntrackFlush deletes connection tracking entries matching the filter.
+func (c *Client) ConntrackFlush(ctx context.Context, req *machineapi.ConntrackFlushRequest, callOptions ...grpc.CallOption) (*machineapi.ConntrackFlushResponse, error) {
+	resp, err := c.MachineClient.ConntrackFlush(
+		ctx,
+		req,
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// ConntrackList lists connection tracking entries matching the filter.
+func (c *Client) ConntrackList(ctx context.Context, req *machineapi.ConntrackListRequest, callOptions ...grpc.CallOption) (*machineapi.ConntrackListResponse, error) {
+	resp, err := c.MachineClient.ConntrackList(
+		ctx,
+		req,
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// FilesystemTrim discards the unused blocks of the mounted filesystems.
+func (c *Client) FilesystemTrim(ctx context.Context, req *machineapi.FilesystemTrimRequest, callOptions ...grpc.CallOption) (*machineapi.FilesystemTrimResponse, error) {
+	resp, err := c.MachineClient.FilesystemTrim(
+		ctx,
+		req,
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// FeatureFlagList lists the boolean feature flags of the machine configuration.
+func (c *Client) FeatureFlagList(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.FeatureFlagListResponse, error) {
+	resp, err := c.MachineClient.FeatureFlagList(
+		ctx,
+		&emptypb.Empty{},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// FeatureFlagSet toggles the feature flag without a reboot.
+func (c *Client) FeatureFlagSet(ctx context.Context, name string, enabled bool, callOptions ...grpc.CallOption) (*machineapi.FeatureFlagSetResponse, error) {
+	resp, err := c.MachineClient.FeatureFlagSet(
+		ctx,
+		&machineapi.FeatureFlagSetRequest{
+			Name:    name,
+			Enabled: enabled,
+		},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// KernelModuleParameterSet changes a parameter of the loaded kernel module.
+func (c *Client) KernelModuleParameterSet(ctx context.Context, module, parameter, value string, callOptions ...grpc.CallOption) (*machineapi.KernelModuleParameterSetResponse, error) {
+	resp, err := c.MachineClient.KernelModuleParameterSet(
+		ctx,
+		&machineapi.KernelModuleParameterSetRequest{
+			Module:    module,
+			Parameter: parameter,
+			Value:     value,
+		},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// RenewClientCertificate issues a new client certificate with the roles of the current one.
+//
+// The renewed certificate is valid for ttl, the node limits the maximum TTL.
+func (c *Client) RenewClientCertificate(ctx context.Context, ttl time.Duration, callOptions ...grpc.CallOption) (*machineapi.RenewClientCertificateResponse, error) {
+	resp, err := c.MachineClient.RenewClientCertificate(
+		ctx,
+		&machineapi.RenewClientCertificateRequest{
+			CrtTtl: durationpb.New(ttl),
+		},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// EncryptionStatus returns the disk encryption status of the system volumes.
+func (c *Client) EncryptionStatus(ctx context.Context, req *machineapi.EncryptionStatusRequest, callOptions ...grpc.CallOption) (*machineapi.EncryptionStatusResponse, error) {
+	resp, err := c.MachineClient.EncryptionStatus(
+		ctx,
+		req,
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// EncryptionRotateKey replaces the key in the key slot of the encrypted volume.
+func (c *Client) EncryptionRotateKey(ctx context.Context, req *machineapi.EncryptionRotateKeyRequest, callOptions ...grpc.CallOption) (*machineapi.EncryptionRotateKeyResponse, error) {
+	resp, err := c.MachineClient.EncryptionRotateKey(
+		ctx,
+		req,
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// EncryptionAddKey enrolls a new key in the free key slot of the encrypted volume.
+func (c *Client) EncryptionAddKey(ctx context.Context, req *machineapi.EncryptionAddKeyRequest, callOptions ...grpc.CallOption) (*machineapi.EncryptionAddKeyResponse, error) {
+	resp, err := c.MachineClient.EncryptionAddKey(
+		ctx,
+		req,
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// EncryptionRemoveKey removes the key slot from the encrypted volume.
+func (c *Client) EncryptionRemoveKey(ctx context.Context, req *machineapi.EncryptionRemoveKeyRequest, callOptions ...grpc.CallOption) (*machineapi.EncryptionRemoveKeyResponse, error) {
+	resp, err := c.MachineClient.EncryptionRemoveKey(
+		ctx,
+		req,
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// RootfsIntegrity verifies the rootfs image against the checksum manifest.
+func (c *Client) RootfsIntegrity(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.RootfsIntegrityResponse, error) {
+	resp, err := c.MachineClient.RootfsIntegrity(
+		ctx,
+		&emptypb.Empty{},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// ExtensionMetrics returns metrics published by the extension services.
+func (c *Client) ExtensionMetrics(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.ExtensionMetricsResponse, error) {
+	resp, err := c.MachineClient.ExtensionMetrics(
+		ctx,
+		&emptypb.Empty{},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
 // MetaWrite writes a key to META storage.
 func (c *Client) MetaWrite(ctx context.Context, key uint8, value []byte, callOptions ...grpc.CallOption) error {
 	resp, err := c.MachineClient.MetaWrite(
@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ClockSkewErrorDomain is the domain of the error details attached to the clock skew errors.
+const ClockSkewErrorDomain = "talos.dev"
+
+const (
+	clockSkewErrorReason = "CLOCK_SKEW"
+
+	clockSkewServerTimeKey = "server_time"
+	clockSkewNotBeforeKey  = "not_before"
+	clockSkewNotAfterKey   = "not_after"
+)
+
+// ClockSkewError is returned by the Talos API when the client certificate is not valid at the server time.
+//
+// The error carries the server time, so that the clock skew between the client and the server can be estimated.
+type ClockSkewError struct {
+	ServerTime time.Time
+	NotBefore  time.Time
+	NotAfter   time.Time
+}
+
+func (e *ClockSkewError) Error() string {
+	if e.ServerTime.Before(e.NotBefore) {
+		return fmt.Sprintf("client certificate is not valid yet at the server time %s: valid from %s (%s ahead)",
+			e.ServerTime.Format(time.RFC3339), e.NotBefore.Format(time.RFC3339), e.NotBefore.Sub(e.ServerTime).Round(time.Second))
+	}
+
+	return fmt.Sprintf("client certificate has expired at the server time %s: valid until %s (%s behind)",
+		e.ServerTime.Format(time.RFC3339), e.NotAfter.Format(time.RFC3339), e.ServerTime.Sub(e.NotAfter).Round(time.Second))
+}
+
+// Skew returns the difference between the server time and the local time.
+//
+// Positive value means that the server clock is ahead of the local clock.
+// The estimate doesn't account for the network latency, so it should only be used as a hint.
+func (e *ClockSkewError) Skew(localTime time.Time) time.Duration {
+	return e.ServerTime.Sub(localTime)
+}
+
+// GRPCStatus returns the gRPC status with the error details attached.
+func (e *ClockSkewError) GRPCStatus() *status.Status {
+	st := status.New(codes.Unauthenticated, e.Error())
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: clockSkewErrorReason,
+		Domain: ClockSkewErrorDomain,
+		Metadata: map[string]string{
+			clockSkewServerTimeKey: e.ServerTime.Format(time.RFC3339Nano),
+			clockSkewNotBeforeKey:  e.NotBefore.Format(time.RFC3339Nano),
+			clockSkewNotAfterKey:   e.NotAfter.Format(time.RFC3339Nano),
+		},
+	})
+	if err != nil {
+		return st
+	}
+
+	return detailed
+}
+
+// ClockSkew returns the clock skew error details if the error is caused by the clock skew, nil otherwise.
+//
+// If the error contains errors from multiple nodes, the first clock skew error is returned.
+func ClockSkew(err error) *ClockSkewError {
+	var clockSkewErr *ClockSkewError
+
+	if errors.As(err, &clockSkewErr) {
+		return clockSkewErr
+	}
+
+	for _, nodeErr := range NodeErrors(err) {
+		if clockSkewErr = clockSkewFromStatus(Status(nodeErr.Err)); clockSkewErr != nil {
+			return clockSkewErr
+		}
+	}
+
+	return clockSkewFromStatus(Status(err))
+}
+
+func clockSkewFromStatus(st *status.Status) *ClockSkewError {
+	if st == nil || st.Code() != codes.Unauthenticated {
+		return nil
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.GetDomain() != ClockSkewErrorDomain || info.GetReason() != clockSkewErrorReason {
+			continue
+		}
+
+		var (
+			result ClockSkewError
+			err    error
+		)
+
+		for key, dst := range map[string]*time.Time{
+			clockSkewServerTimeKey: &result.ServerTime,
+			clockSkewNotBeforeKey:  &result.NotBefore,
+			clockSkewNotAfterKey:   &result.NotAfter,
+		} {
+			if *dst, err = time.Parse(time.RFC3339Nano, info.GetMetadata()[key]); err != nil {
+				return nil
+			}
+		}
+
+		return &result
+	}
+
+	return nil
+}
@@ -62,6 +62,7 @@ type Context struct {
 type Auth struct {
 	Basic    *Basic    `yaml:"basic,omitempty"`
 	SideroV1 *SideroV1 `yaml:"siderov1,omitempty"`
+	OIDC     *OIDC     `yaml:"oidc,omitempty"`
 }
 
 // Basic holds Basic Auth credentials.
@@ -75,6 +76,13 @@ type SideroV1 struct {
 	Identity string `yaml:"identity"`
 }
 
+// OIDC holds the OIDC ID token used to authenticate without a client certificate.
+type OIDC struct {
+	Token string `yaml:"token,omitempty"`
+	// TokenFile is read on each request, so that the token can be refreshed externally.
+	TokenFile string `yaml:"tokenFile,omitempty"`
+}
+
 func (c *Context) upgrade() {
 	if c.DeprecatedTarget != "" {
 		c.Endpoints = append(c.Endpoints, c.DeprecatedTarget)
@@ -5,6 +5,7 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
@@ -14,6 +15,7 @@ import (
 	"net/url"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-api-signature/pkg/client/interceptor"
@@ -21,12 +23,15 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
 	"github.com/siderolabs/talos/pkg/machinery/client/resolver"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // Conn returns underlying client connection.
+//
+// Conn returns nil if the client was created with a custom transport (see WithTransport).
 func (c *Client) Conn() *grpc.ClientConn {
 	return c.conn.ClientConn
 }
@@ -49,6 +54,8 @@ func (c *Client) getConn(opts ...grpc.DialOption) (*grpcConnectionWrapper, error
 				grpc.MaxCallRecvMsgSize(constants.GRPCMaxMessageSize),
 			),
 			grpc.WithSharedWriteBuffer(true),
+			grpc.WithChainUnaryInterceptor(errorsUnaryInterceptor),
+			grpc.WithChainStreamInterceptor(errorsStreamInterceptor),
 		},
 		c.options.grpcDialOptions,
 		opts,
@@ -75,6 +82,14 @@ func (c *Client) getConn(opts ...grpc.DialOption) (*grpcConnectionWrapper, error
 		dialOpts = append(dialOpts, WithGRPCBasicAuth(basicAuth.Username, basicAuth.Password))
 	}
 
+	if oidc := c.options.configContext.Auth.OIDC; oidc != nil {
+		if oidc.TokenFile != "" {
+			dialOpts = append(dialOpts, WithGRPCBearerTokenFile(oidc.TokenFile))
+		} else {
+			dialOpts = append(dialOpts, WithGRPCBearerToken(oidc.Token))
+		}
+	}
+
 	sideroV1 := c.options.configContext.Auth.SideroV1
 	if sideroV1 != nil {
 		var contextName string
@@ -100,7 +115,25 @@ func (c *Client) getConn(opts ...grpc.DialOption) (*grpcConnectionWrapper, error
 		)
 	}
 
-	creds, err := buildCredentials(c.options.configContext, endpoints)
+	if c.options.certRenewalTTL > 0 {
+		crt, err := CertificateFromConfigContext(c.options.configContext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire credentials: %w", err)
+		}
+
+		if crt != nil {
+			c.certRenewer, err = newCertificateRenewer(crt, c.options.certRenewalTTL, c.options.certRenewalCallback,
+				func(ctx context.Context, ttl time.Duration) (*machineapi.RenewClientCertificateResponse, error) {
+					return c.RenewClientCertificate(ctx, ttl)
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	creds, err := buildCredentials(c.options.configContext, endpoints, c.certRenewer)
 	if err != nil {
 		return nil, err
 	}
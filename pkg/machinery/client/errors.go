@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reason is a machine-readable reason of the API call failure.
+//
+// Reason implements error interface, so that the well-known reasons can be matched with errors.Is.
+type Reason string
+
+// Well-known failure reasons.
+const (
+	ReasonUnknown            Reason = "Unknown"
+	ReasonNodeUnreachable    Reason = "NodeUnreachable"
+	ReasonUnauthorized       Reason = "Unauthorized"
+	ReasonUnsupportedVersion Reason = "UnsupportedVersion"
+	ReasonClockSkew          Reason = "ClockSkew"
+)
+
+// Error implements error interface.
+func (r Reason) Error() string {
+	return string(r)
+}
+
+// Typed errors which can be matched with errors.Is against the errors returned by the client.
+//
+// For fan-out calls, the errors are matched against each node's error, see NodeErrors to get per-node detail.
+var (
+	ErrNodeUnreachable    error = ReasonNodeUnreachable
+	ErrUnauthorized       error = ReasonUnauthorized
+	ErrUnsupportedVersion error = ReasonUnsupportedVersion
+	ErrClockSkew          error = ReasonClockSkew
+)
+
+// ReasonOf returns the failure reason of the error.
+//
+// If the error contains errors from multiple nodes, the reason of the first classified error is returned.
+func ReasonOf(err error) Reason {
+	var reason Reason
+
+	if errors.As(err, &reason) {
+		return reason
+	}
+
+	return ReasonUnknown
+}
+
+// NodeErrors returns per-node errors contained in the error returned from a fan-out call.
+func NodeErrors(err error) []*NodeError {
+	var result []*NodeError
+
+	var multiErr *multierror.Error
+
+	if errors.As(err, &multiErr) {
+		for _, e := range multiErr.Errors {
+			var nodeErr *NodeError
+
+			if errors.As(e, &nodeErr) {
+				result = append(result, nodeErr)
+			}
+		}
+
+		return result
+	}
+
+	var nodeErr *NodeError
+
+	if errors.As(err, &nodeErr) {
+		result = append(result, nodeErr)
+	}
+
+	return result
+}
+
+// APIError wraps the gRPC error returned from the API with the failure reason.
+//
+// APIError preserves the gRPC status of the wrapped error.
+type APIError struct {
+	Err    error
+	Reason Reason
+}
+
+func (e *APIError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap implements errors.Unwrap interface.
+func (e *APIError) Unwrap() []error {
+	return []error{e.Err, e.Reason}
+}
+
+// GRPCStatus returns the gRPC status of the wrapped error.
+func (e *APIError) GRPCStatus() *status.Status {
+	if st := Status(e.Err); st != nil {
+		return st
+	}
+
+	return status.New(codes.Unknown, e.Err.Error())
+}
+
+func reasonFromCode(code codes.Code) Reason {
+	switch code { //nolint:exhaustive
+	case codes.Unavailable:
+		return ReasonNodeUnreachable
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return ReasonUnauthorized
+	case codes.Unimplemented:
+		return ReasonUnsupportedVersion
+	default:
+		return ReasonUnknown
+	}
+}
+
+// wrapAPIError wraps errors with a known failure reason into APIError, other errors are returned as is.
+func wrapAPIError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var apiErr *APIError
+
+	if errors.As(err, &apiErr) {
+		return err
+	}
+
+	st := Status(err)
+	if st == nil {
+		return err
+	}
+
+	reason := reasonFromCode(st.Code())
+	if clockSkewFromStatus(st) != nil {
+		reason = ReasonClockSkew
+	}
+
+	if reason == ReasonUnknown {
+		return err
+	}
+
+	return &APIError{
+		Err:    err,
+		Reason: reason,
+	}
+}
+
+func errorsUnaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return wrapAPIError(invoker(ctx, method, req, reply, cc, opts...))
+}
+
+func errorsStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, wrapAPIError(err)
+	}
+
+	return &errorsClientStream{ClientStream: stream}, nil
+}
+
+type errorsClientStream struct {
+	grpc.ClientStream
+}
+
+func (s *errorsClientStream) SendMsg(m any) error {
+	return wrapAPIError(s.ClientStream.SendMsg(m))
+}
+
+func (s *errorsClientStream) RecvMsg(m any) error {
+	return wrapAPIError(s.ClientStream.RecvMsg(m))
+}
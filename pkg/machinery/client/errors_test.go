@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func TestWrapAPIError(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		err    error
+		target error
+		reason client.Reason
+	}{
+		{
+			name:   "unavailable",
+			err:    status.Error(codes.Unavailable, "connection refused"),
+			target: client.ErrNodeUnreachable,
+			reason: client.ReasonNodeUnreachable,
+		},
+		{
+			name:   "unauthenticated",
+			err:    status.Error(codes.Unauthenticated, "no certificate"),
+			target: client.ErrUnauthorized,
+			reason: client.ReasonUnauthorized,
+		},
+		{
+			name:   "permission denied",
+			err:    status.Error(codes.PermissionDenied, "not authorized"),
+			target: client.ErrUnauthorized,
+			reason: client.ReasonUnauthorized,
+		},
+		{
+			name:   "unimplemented",
+			err:    status.Error(codes.Unimplemented, "unknown method"),
+			target: client.ErrUnsupportedVersion,
+			reason: client.ReasonUnsupportedVersion,
+		},
+		{
+			name:   "other",
+			err:    status.Error(codes.NotFound, "not found"),
+			reason: client.ReasonUnknown,
+		},
+		{
+			name:   "not status",
+			err:    io.EOF,
+			reason: client.ReasonUnknown,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := client.WrapAPIError(tt.err)
+
+			assert.Equal(t, tt.err.Error(), err.Error())
+			assert.Equal(t, tt.reason, client.ReasonOf(err))
+			assert.Equal(t, status.Code(tt.err), status.Code(err))
+			assert.Equal(t, client.StatusCode(tt.err), client.StatusCode(err))
+
+			if tt.target != nil {
+				assert.ErrorIs(t, err, tt.target)
+			} else {
+				assert.Equal(t, tt.err, err)
+			}
+		})
+	}
+}
+
+func TestNodeErrors(t *testing.T) {
+	reply := &common.DataResponse{
+		Messages: []*common.Data{
+			{
+				Metadata: &common.Metadata{
+					Hostname: "host1",
+				},
+			},
+			{
+				Metadata: &common.Metadata{
+					Hostname: "host2",
+					Error:    "connection refused",
+					Status:   &rpcstatus.Status{Code: int32(codes.Unavailable), Message: "connection refused"},
+				},
+			},
+			{
+				Metadata: &common.Metadata{
+					Hostname: "host3",
+					Error:    "something wrong",
+				},
+			},
+		},
+	}
+
+	_, err := client.FilterMessages(reply, nil)
+	require.Error(t, err)
+
+	assert.ErrorIs(t, err, client.ErrNodeUnreachable)
+	assert.NotErrorIs(t, err, client.ErrUnauthorized)
+	assert.Equal(t, client.ReasonNodeUnreachable, client.ReasonOf(err))
+
+	nodeErrors := client.NodeErrors(err)
+	require.Len(t, nodeErrors, 2)
+
+	assert.Equal(t, "host2", nodeErrors[0].Node)
+	assert.Equal(t, client.ReasonNodeUnreachable, client.ReasonOf(nodeErrors[0]))
+	assert.Equal(t, codes.Unavailable, client.StatusCode(nodeErrors[0]))
+
+	assert.Equal(t, "host3", nodeErrors[1].Node)
+	assert.Equal(t, client.ReasonUnknown, client.ReasonOf(nodeErrors[1]))
+
+	assert.Empty(t, client.NodeErrors(io.EOF))
+}
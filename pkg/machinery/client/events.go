@@ -15,6 +15,7 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/proto"
@@ -56,6 +57,24 @@ func WithActorID(actorID string) EventsOptionFunc {
 	}
 }
 
+// WithTypes sets up Watcher to return only the events of the specified types.
+//
+// Event type is either a type URL, or full protobuf message name (e.g. machine.SequenceEvent).
+func WithTypes(types ...string) EventsOptionFunc {
+	return func(opts *machineapi.EventsRequest) {
+		opts.WithTypes = append(opts.WithTypes, types...)
+	}
+}
+
+// WithSince sets up Watcher to return events with timestamp >= since.
+//
+// If none of the tail options is set, the past events starting at since are returned.
+func WithSince(since time.Time) EventsOptionFunc {
+	return func(opts *machineapi.EventsRequest) {
+		opts.Since = timestamppb.New(since)
+	}
+}
+
 // Events implements the proto.OSClient interface.
 func (c *Client) Events(ctx context.Context, opts ...EventsOptionFunc) (stream machineapi.MachineService_EventsClient, err error) {
 	var req machineapi.EventsRequest
@@ -239,6 +258,9 @@ func UnmarshalEvent(event *machineapi.Event) (*Event, error) {
 		&machineapi.ConfigValidationErrorEvent{},
 		&machineapi.AddressEvent{},
 		&machineapi.MachineStatusEvent{},
+		&machineapi.PodShutdownEvent{},
+		&machineapi.FilesystemTrimEvent{},
+		&machineapi.FeatureFlagChangeEvent{},
 	} {
 		if typeURL == "talos/runtime/"+string(eventType.ProtoReflect().Descriptor().FullName()) {
 			msg = eventType
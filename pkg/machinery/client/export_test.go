@@ -5,8 +5,11 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
+	"time"
 
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
 )
 
@@ -17,3 +20,28 @@ func ReduceURLsToAddresses(endpoints []string) []string {
 func BuildTLSConfig(configContext *clientconfig.Context) (*tls.Config, error) {
 	return buildTLSConfig(configContext)
 }
+
+func WrapAPIError(err error) error {
+	return wrapAPIError(err)
+}
+
+type CertificateRenewer = certificateRenewer
+
+func NewCertificateRenewer(
+	cert *tls.Certificate, ttl time.Duration, onRenew CertificateRenewedFunc,
+	renew func(ctx context.Context, ttl time.Duration) (*machineapi.RenewClientCertificateResponse, error),
+) (*CertificateRenewer, error) {
+	return newCertificateRenewer(cert, ttl, onRenew, renew)
+}
+
+func (r *CertificateRenewer) Apply(tlsConfig *tls.Config) {
+	r.apply(tlsConfig)
+}
+
+func (r *CertificateRenewer) Start() {
+	r.start()
+}
+
+func (r *CertificateRenewer) Stop() {
+	r.stop()
+}
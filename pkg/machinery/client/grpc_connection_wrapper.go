@@ -6,20 +6,31 @@ package client
 
 import (
 	"context"
+	"io"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
 type grpcConnectionWrapper struct {
-	*grpc.ClientConn
+	// ClientConn is nil if the custom transport is used.
+	ClientConn *grpc.ClientConn
 
+	transport   grpc.ClientConnInterface
 	clusterName string
 }
 
 func newGRPCConnectionWrapper(clusterName string, conn *grpc.ClientConn) *grpcConnectionWrapper {
 	return &grpcConnectionWrapper{
 		ClientConn:  conn,
+		transport:   conn,
+		clusterName: clusterName,
+	}
+}
+
+func newTransportConnectionWrapper(clusterName string, transport grpc.ClientConnInterface) *grpcConnectionWrapper {
+	return &grpcConnectionWrapper{
+		transport:   transport,
 		clusterName: clusterName,
 	}
 }
@@ -27,12 +38,43 @@ func newGRPCConnectionWrapper(clusterName string, conn *grpc.ClientConn) *grpcCo
 // Invoke performs a unary RPC and returns after the response is received
 // into reply.
 func (c *grpcConnectionWrapper) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
-	return c.ClientConn.Invoke(c.appendMetadata(ctx), method, args, reply, opts...)
+	err := c.transport.Invoke(c.appendMetadata(ctx), method, args, reply, opts...)
+
+	if c.ClientConn == nil {
+		// custom transport doesn't run the interceptors
+		err = wrapAPIError(err)
+	}
+
+	return err
 }
 
 // NewStream begins a streaming RPC.
 func (c *grpcConnectionWrapper) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
-	return c.ClientConn.NewStream(c.appendMetadata(ctx), desc, method, opts...)
+	stream, err := c.transport.NewStream(c.appendMetadata(ctx), desc, method, opts...)
+
+	if c.ClientConn == nil {
+		// custom transport doesn't run the interceptors
+		if err != nil {
+			return nil, wrapAPIError(err)
+		}
+
+		return &errorsClientStream{ClientStream: stream}, nil
+	}
+
+	return stream, err
+}
+
+// Close closes the underlying connection or the custom transport if it implements io.Closer.
+func (c *grpcConnectionWrapper) Close() error {
+	if c.ClientConn != nil {
+		return c.ClientConn.Close()
+	}
+
+	if closer, ok := c.transport.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
 }
 
 func (c *grpcConnectionWrapper) appendMetadata(ctx context.Context) context.Context {
@@ -0,0 +1,32 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	healthapi "github.com/siderolabs/talos/pkg/machinery/api/health"
+)
+
+// HealthClient provides access to health API.
+type HealthClient struct {
+	client healthapi.HealthServiceClient
+}
+
+// List returns the health checks available on the node.
+func (c *HealthClient) List(ctx context.Context, callOptions ...grpc.CallOption) (*healthapi.ListResponse, error) {
+	resp, err := c.client.List(ctx, &healthapi.ListRequest{}, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// Check runs the health checks on the node and streams the results.
+//
+// If no checks are specified, all the checks available on the node are run.
+func (c *HealthClient) Check(ctx context.Context, checks []string, callOptions ...grpc.CallOption) (healthapi.HealthService_CheckClient, error) {
+	return c.client.Check(ctx, &healthapi.CheckRequest{Checks: checks}, callOptions...)
+}
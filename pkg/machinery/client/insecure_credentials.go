@@ -32,7 +32,12 @@ func (c BasicAuth) RequireTransportSecurity() bool {
 	return false
 }
 
-func buildCredentials(configContext *clientconfig.Context, endpoints []string) (credentials.TransportCredentials, error) {
+// RequireTransportSecurity enables bearer token auth with insecure gRPC transport credentials.
+func (c BearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
+func buildCredentials(configContext *clientconfig.Context, endpoints []string, renewer *certificateRenewer) (credentials.TransportCredentials, error) {
 	if shouldInsecureConnectionsBeAllowed(endpoints) {
 		return insecure.NewCredentials(), nil
 	}
@@ -42,5 +47,7 @@ func buildCredentials(configContext *clientconfig.Context, endpoints []string) (
 		return nil, err
 	}
 
+	renewer.apply(tlsConfig)
+
 	return credentials.NewTLS(tlsConfig), nil
 }
@@ -0,0 +1,155 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// NodeErrorDomain is the domain of the error details attached to the errors of the proxied node calls.
+const NodeErrorDomain = "talos.dev"
+
+const (
+	nodeErrorReason = "NODE_ERROR"
+
+	nodeErrorNodeKey = "node"
+
+	// nodeErrorRetryDelay is the suggested delay before retrying a call which failed with a retryable error.
+	nodeErrorRetryDelay = time.Second
+)
+
+// NodeError is RPC error from some node.
+type NodeError struct {
+	Node string
+	Err  error
+}
+
+func (ne *NodeError) Error() string {
+	return fmt.Sprintf("%s: %s", ne.Node, ne.Err)
+}
+
+// Unwrap implements errors.Unwrap interface.
+func (ne *NodeError) Unwrap() error {
+	return ne.Err
+}
+
+// Code returns the gRPC status code of the node error.
+func (ne *NodeError) Code() codes.Code {
+	return StatusCode(ne.Err)
+}
+
+// Retryable returns true if the call to the node can be retried.
+//
+// If the node error carries the retry details, they are used, otherwise the error is classified by the status code.
+func (ne *NodeError) Retryable() bool {
+	_, retryable := ne.RetryDelay()
+
+	return retryable
+}
+
+// RetryDelay returns the suggested delay before retrying the call to the node.
+//
+// The second return value is false if the call should not be retried.
+func (ne *NodeError) RetryDelay() (time.Duration, bool) {
+	st := Status(ne.Err)
+	if st == nil {
+		return 0, false
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return info.GetRetryDelay().AsDuration(), true
+		}
+	}
+
+	if retryableCode(st.Code()) {
+		return nodeErrorRetryDelay, true
+	}
+
+	return 0, false
+}
+
+// IsRetryable returns true if the error returned from the API call can be retried.
+//
+// For fan-out calls, the error is retryable only if the errors from all nodes are retryable.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if nodeErrors := NodeErrors(err); len(nodeErrors) > 0 {
+		for _, nodeErr := range nodeErrors {
+			if !nodeErr.Retryable() {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return retryableCode(StatusCode(err))
+}
+
+// NodeErrorStatus builds the gRPC status for the error returned by the node.
+//
+// The status carries the node identity, and the retry details if the error is retryable,
+// it is used by the API proxy to report per-node errors in the multiplexed responses.
+func NodeErrorStatus(node string, err error) *status.Status {
+	st := status.Convert(err)
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason: nodeErrorReason,
+			Domain: NodeErrorDomain,
+			Metadata: map[string]string{
+				nodeErrorNodeKey: node,
+			},
+		},
+	}
+
+	if retryableCode(st.Code()) {
+		details = append(details, &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(nodeErrorRetryDelay),
+		})
+	}
+
+	detailed, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st
+	}
+
+	return detailed
+}
+
+func nodeFromStatus(st *status.Status) string {
+	if st == nil {
+		return ""
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if ok && info.GetDomain() == NodeErrorDomain && info.GetReason() == nodeErrorReason {
+			return info.GetMetadata()[nodeErrorNodeKey]
+		}
+	}
+
+	return ""
+}
+
+func retryableCode(code codes.Code) bool {
+	switch code { //nolint:exhaustive
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NotModifiedErrorDomain is the domain of the error details attached to the not modified errors.
+const NotModifiedErrorDomain = "talos.dev"
+
+const (
+	notModifiedErrorReason = "NOT_MODIFIED"
+
+	notModifiedVersionKey = "version"
+)
+
+// NotModifiedError is returned by the resource Get when the resource version matches the version submitted by the client.
+type NotModifiedError struct {
+	Version string
+}
+
+func (e *NotModifiedError) Error() string {
+	return fmt.Sprintf("resource not modified: version %s", e.Version)
+}
+
+// GRPCStatus returns the gRPC status with the error details attached.
+func (e *NotModifiedError) GRPCStatus() *status.Status {
+	st := status.New(codes.FailedPrecondition, e.Error())
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: notModifiedErrorReason,
+		Domain: NotModifiedErrorDomain,
+		Metadata: map[string]string{
+			notModifiedVersionKey: e.Version,
+		},
+	})
+	if err != nil {
+		return st
+	}
+
+	return detailed
+}
+
+// IsNotModified returns true if the error is caused by the resource version match on the conditional Get.
+func IsNotModified(err error) bool {
+	var notModifiedErr *NotModifiedError
+
+	if errors.As(err, &notModifiedErr) {
+		return true
+	}
+
+	st := Status(err)
+	if st == nil || st.Code() != codes.FailedPrecondition {
+		return false
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if ok && info.GetDomain() == NotModifiedErrorDomain && info.GetReason() == notModifiedErrorReason {
+			return true
+		}
+	}
+
+	return false
+}
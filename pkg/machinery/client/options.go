@@ -7,6 +7,7 @@ package client
 import (
 	"crypto/tls"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -20,12 +21,16 @@ type Options struct {
 	configContext     *clientconfig.Context
 	tlsConfig         *tls.Config
 	grpcDialOptions   []grpc.DialOption
+	transport         grpc.ClientConnInterface
 
 	contextOverride    string
 	contextOverrideSet bool
 
 	unixSocketPath      string
 	clusterNameOverride string
+
+	certRenewalTTL      time.Duration
+	certRenewalCallback CertificateRenewedFunc
 }
 
 // OptionFunc sets an option for the creation of the Client.
@@ -70,6 +75,19 @@ func WithGRPCDialOptions(opts ...grpc.DialOption) OptionFunc {
 	}
 }
 
+// WithTransport configures the Client to use the provided transport instead of dialing the endpoints.
+//
+// The transport might be used in the environments where native gRPC is not available,
+// e.g. a gRPC-Web transport in the browser (js/wasm).
+// Endpoints, TLS and authentication options are ignored when the custom transport is used.
+func WithTransport(transport grpc.ClientConnInterface) OptionFunc {
+	return func(o *Options) error {
+		o.transport = transport
+
+		return nil
+	}
+}
+
 // WithTLSConfig overrides the default TLS configuration with the one provided.
 func WithTLSConfig(tlsConfig *tls.Config) OptionFunc {
 	return func(o *Options) error {
@@ -133,3 +151,25 @@ func WithCluster(cluster string) OptionFunc {
 		return nil
 	}
 }
+
+// WithCertificateRenewal enables automatic renewal of the client certificate before it expires.
+//
+// The certificate is renewed via the Talos API after two thirds of its lifetime,
+// the renewed certificate has the same roles and is valid for ttl, but it never outlives the current certificate,
+// so the renewal stops once the expiration can't be extended.
+// New connections to the endpoints use the renewed certificate.
+//
+// The optional callback is called with each renewed certificate, e.g. to update the client configuration.
+// The option is ignored if the client certificate is not used (e.g. with WithTLSConfig or WithUnixSocket).
+func WithCertificateRenewal(ttl time.Duration, onRenew CertificateRenewedFunc) OptionFunc {
+	return func(o *Options) error {
+		if ttl <= 0 {
+			return fmt.Errorf("certificate renewal TTL should be positive: %s", ttl)
+		}
+
+		o.certRenewalTTL = ttl
+		o.certRenewalCallback = onRenew
+
+		return nil
+	}
+}
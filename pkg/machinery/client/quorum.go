@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QuorumErrorDomain is the domain of the error details attached to the etcd quorum errors.
+const QuorumErrorDomain = "talos.dev"
+
+const (
+	quorumErrorReason = "ETCD_QUORUM"
+
+	quorumOperationKey = "operation"
+	quorumMembersKey   = "members"
+	quorumHealthyKey   = "healthy"
+	quorumRequiredKey  = "required"
+	quorumRemainingKey = "remaining"
+	quorumUnhealthyKey = "unhealthy"
+)
+
+// QuorumError is returned by the Talos API when the operation on the control plane node would make etcd lose the quorum.
+//
+// The error carries the etcd cluster state, so that the reason of the refusal can be explained.
+type QuorumError struct {
+	// Operation is the name of the refused operation, e.g. "reset".
+	Operation string
+	// Unhealthy is the list of the etcd members which are not healthy.
+	Unhealthy []string
+
+	// Members is the number of the voting etcd members.
+	Members int
+	// Healthy is the number of the healthy voting etcd members.
+	Healthy int
+	// Required is the number of the healthy voting members required for the quorum after the operation.
+	Required int
+	// Remaining is the number of the healthy voting members after the operation.
+	Remaining int
+}
+
+func (e *QuorumError) Error() string {
+	msg := fmt.Sprintf("%s would break etcd quorum: %d of %d members are healthy, %d would remain healthy while %d are required for quorum",
+		e.Operation, e.Healthy, e.Members, e.Remaining, e.Required)
+
+	if len(e.Unhealthy) > 0 {
+		msg += fmt.Sprintf(" (unhealthy members: %s)", strings.Join(e.Unhealthy, ", "))
+	}
+
+	return msg + "; use --force to override"
+}
+
+// GRPCStatus returns the gRPC status with the error details attached.
+func (e *QuorumError) GRPCStatus() *status.Status {
+	st := status.New(codes.FailedPrecondition, e.Error())
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: quorumErrorReason,
+		Domain: QuorumErrorDomain,
+		Metadata: map[string]string{
+			quorumOperationKey: e.Operation,
+			quorumMembersKey:   strconv.Itoa(e.Members),
+			quorumHealthyKey:   strconv.Itoa(e.Healthy),
+			quorumRequiredKey:  strconv.Itoa(e.Required),
+			quorumRemainingKey: strconv.Itoa(e.Remaining),
+			quorumUnhealthyKey: strings.Join(e.Unhealthy, ","),
+		},
+	})
+	if err != nil {
+		return st
+	}
+
+	return detailed
+}
+
+// QuorumLoss returns the etcd quorum error details if the operation was refused to keep the etcd quorum, nil otherwise.
+//
+// If the error contains errors from multiple nodes, the first quorum error is returned.
+func QuorumLoss(err error) *QuorumError {
+	var quorumErr *QuorumError
+
+	if errors.As(err, &quorumErr) {
+		return quorumErr
+	}
+
+	for _, nodeErr := range NodeErrors(err) {
+		if quorumErr = quorumFromStatus(Status(nodeErr.Err)); quorumErr != nil {
+			return quorumErr
+		}
+	}
+
+	return quorumFromStatus(Status(err))
+}
+
+func quorumFromStatus(st *status.Status) *QuorumError {
+	if st == nil || st.Code() != codes.FailedPrecondition {
+		return nil
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.GetDomain() != QuorumErrorDomain || info.GetReason() != quorumErrorReason {
+			continue
+		}
+
+		result := QuorumError{
+			Operation: info.GetMetadata()[quorumOperationKey],
+		}
+
+		if unhealthy := info.GetMetadata()[quorumUnhealthyKey]; unhealthy != "" {
+			result.Unhealthy = strings.Split(unhealthy, ",")
+		}
+
+		var err error
+
+		for key, dst := range map[string]*int{
+			quorumMembersKey:   &result.Members,
+			quorumHealthyKey:   &result.Healthy,
+			quorumRequiredKey:  &result.Required,
+			quorumRemainingKey: &result.Remaining,
+		} {
+			if *dst, err = strconv.Atoi(info.GetMetadata()[key]); err != nil {
+				return nil
+			}
+		}
+
+		return &result
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func TestQuorumLoss(t *testing.T) {
+	t.Parallel()
+
+	quorumErr := &client.QuorumError{
+		Operation: "shutdown",
+		Unhealthy: []string{"cp-3"},
+		Members:   3,
+		Healthy:   2,
+		Required:  2,
+		Remaining: 1,
+	}
+
+	assert.Equal(t,
+		"shutdown would break etcd quorum: 2 of 3 members are healthy, 1 would remain healthy while 2 are required for quorum (unhealthy members: cp-3); use --force to override",
+		quorumErr.Error(),
+	)
+
+	// the error details survive the gRPC round trip
+	err := status.ErrorProto(status.Convert(quorumErr).Proto())
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.NotNil(t, client.QuorumLoss(err))
+	assert.Equal(t, quorumErr, client.QuorumLoss(err))
+
+	assert.Nil(t, client.QuorumLoss(status.Error(codes.FailedPrecondition, "other")))
+	assert.Nil(t, client.QuorumLoss(io.EOF))
+}
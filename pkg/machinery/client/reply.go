@@ -6,7 +6,6 @@ package client
 
 import (
 	"errors"
-	"fmt"
 	"reflect"
 
 	"github.com/hashicorp/go-multierror"
@@ -15,21 +14,6 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-// NodeError is RPC error from some node.
-type NodeError struct {
-	Node string
-	Err  error
-}
-
-func (ne *NodeError) Error() string {
-	return fmt.Sprintf("%s: %s", ne.Node, ne.Err)
-}
-
-// Unwrap implements errors.Unwrap interface.
-func (ne *NodeError) Unwrap() error {
-	return ne.Err
-}
-
 // Message is a generic interface for Messages.
 type Message[T any] interface {
 	*T
@@ -150,7 +134,7 @@ func filterMessages(resp any, err error) (any, error) {
 				panic("metadata.Status should be of type *status.Status")
 			}
 
-			rpcError = status.FromProto(statusValue).Err()
+			rpcError = wrapAPIError(status.FromProto(statusValue).Err())
 		}
 
 		hostnameField := metadata.FieldByName("Hostname")
@@ -168,6 +152,10 @@ func filterMessages(resp any, err error) (any, error) {
 			Err:  rpcError,
 		}
 
+		if nodeError.Node == "" {
+			nodeError.Node = nodeFromStatus(Status(rpcError))
+		}
+
 		multiErr = multierror.Append(multiErr, nodeError)
 
 		// remove ith Messages
@@ -7,10 +7,13 @@ package client_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/client"
@@ -122,3 +125,44 @@ func TestFilterMessagesGRPCStatus(t *testing.T) {
 	assert.EqualError(t, err, "2 errors occurred:\n\t* host2: rpc error: code = Aborted desc = something aborted\n\t* host4: rpc error: code = Unknown desc = something went wrong\n\n")
 	assert.Nil(t, filtered)
 }
+
+func TestFilterMessagesNodeErrorDetails(t *testing.T) {
+	reply := &common.DataResponse{
+		Messages: []*common.Data{
+			{
+				Metadata: &common.Metadata{
+					Error:  "connection refused",
+					Status: client.NodeErrorStatus("10.5.0.2", grpcstatus.Error(codes.Unavailable, "connection refused")).Proto(),
+				},
+			},
+			{
+				Metadata: &common.Metadata{
+					Hostname: "10.5.0.3",
+					Error:    "permission denied",
+					Status:   client.NodeErrorStatus("10.5.0.3", grpcstatus.Error(codes.PermissionDenied, "permission denied")).Proto(),
+				},
+			},
+		},
+	}
+
+	_, err := client.FilterMessages(reply, nil)
+	require.Error(t, err)
+
+	nodeErrors := client.NodeErrors(err)
+	require.Len(t, nodeErrors, 2)
+
+	assert.Equal(t, "10.5.0.2", nodeErrors[0].Node)
+	assert.Equal(t, codes.Unavailable, nodeErrors[0].Code())
+	assert.True(t, nodeErrors[0].Retryable())
+
+	delay, ok := nodeErrors[0].RetryDelay()
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, delay)
+
+	assert.Equal(t, "10.5.0.3", nodeErrors[1].Node)
+	assert.Equal(t, codes.PermissionDenied, nodeErrors[1].Code())
+	assert.False(t, nodeErrors[1].Retryable())
+
+	assert.False(t, client.IsRetryable(err))
+	assert.True(t, client.IsRetryable(nodeErrors[0]))
+}
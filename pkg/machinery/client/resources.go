@@ -5,15 +5,23 @@
 package client
 
 import (
+	"cmp"
 	"context"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/xslices"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // ResolveResourceKind resolves potentially aliased 'resourceType' and replaces empty 'resourceNamespace' with the default namespace for the resource.
@@ -60,3 +68,179 @@ func (c *Client) ResolveResourceKind(ctx context.Context, resourceNamespace *res
 		return nil, status.Errorf(codes.NotFound, "resource %q is not registered", resourceType)
 	}
 }
+
+// NodeResource is a resource tagged with the node it was fetched from.
+type NodeResource struct {
+	Node     string
+	Resource resource.Resource
+}
+
+// ListNodeResources fetches the resources from the nodes concurrently and returns a merged result set.
+//
+// If the pointer has an ID, a single resource is fetched from each node, otherwise all resources of the type are listed.
+// If the label query is not empty, only the resources matching the query are returned.
+// An empty node stands for the node the client is connected to (no node override).
+// At most concurrency nodes are queried at once, if concurrency is not positive, all nodes are queried at once.
+//
+// The result is sorted by node, namespace and ID (or by node only, keeping the order of the server, if the resources
+// are sorted on the server, see WithListSort). The resources fetched successfully are returned even if some nodes failed,
+// per-node failures are returned as *NodeError aggregated into *multierror.Error, see NodeErrors.
+func (c *Client) ListNodeResources(ctx context.Context, nodes []string, ptr resource.Pointer, concurrency int, query []resource.LabelQueryOption, opts ...state.UnmarshalOption) ([]NodeResource, error) {
+	if concurrency <= 0 {
+		concurrency = len(nodes)
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, max(concurrency, 1))
+
+		resources = make([][]resource.Resource, len(nodes))
+		errs      = make([]error, len(nodes))
+	)
+
+	for i, node := range nodes {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			nodeCtx := ctx
+
+			if node != "" {
+				nodeCtx = WithNode(ctx, node)
+			}
+
+			resources[i], errs[i] = c.listResources(nodeCtx, ptr, query, opts...)
+		}()
+	}
+
+	wg.Wait()
+
+	var (
+		result   []NodeResource
+		multiErr *multierror.Error
+	)
+
+	for i, node := range nodes {
+		if errs[i] != nil {
+			multiErr = multierror.Append(multiErr, &NodeError{Node: node, Err: errs[i]})
+
+			continue
+		}
+
+		for _, r := range resources[i] {
+			result = append(result, NodeResource{Node: node, Resource: r})
+		}
+	}
+
+	serverSorted := listSortRequested(ctx)
+
+	slices.SortStableFunc(result, func(a, b NodeResource) int {
+		if serverSorted {
+			return cmp.Compare(a.Node, b.Node)
+		}
+
+		return cmp.Or(
+			cmp.Compare(a.Node, b.Node),
+			cmp.Compare(a.Resource.Metadata().Namespace(), b.Resource.Metadata().Namespace()),
+			cmp.Compare(a.Resource.Metadata().ID(), b.Resource.Metadata().ID()),
+		)
+	})
+
+	return result, multiErr.ErrorOrNil()
+}
+
+// GetIfModified fetches the resource unless its version matches the version already known to the caller.
+//
+// If the version matches, the resource is not transferred, and GetIfModified returns nil resource and false.
+// This is useful for the clients polling the resources which can't keep a watch open.
+func (c *Client) GetIfModified(ctx context.Context, ptr resource.Pointer, version resource.Version, opts ...state.UnmarshalOption) (resource.Resource, bool, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, constants.ResourceIfNoneMatchMetadataKey, version.String())
+
+	r, err := c.COSI.Get(ctx, ptr, state.WithGetUnmarshalOptions(opts...))
+	if err != nil {
+		if IsNotModified(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return r, true, nil
+}
+
+// ListSortField is the resource metadata field to sort the resource List by.
+type ListSortField string
+
+// ListSortField values.
+const (
+	ListSortByID      ListSortField = "id"
+	ListSortByVersion ListSortField = "version"
+	ListSortByUpdated ListSortField = "updated"
+)
+
+// ListSortFields is the list of the supported resource List sort fields.
+var ListSortFields = []ListSortField{ListSortByID, ListSortByVersion, ListSortByUpdated}
+
+// WithListSort returns the context which requests the resource List calls to be sorted on the server side by the metadata field.
+//
+// Resources with equal field values are sorted by the resource ID.
+func WithListSort(ctx context.Context, field ListSortField, descending bool) context.Context {
+	sortBy := string(field)
+
+	if descending {
+		sortBy = "-" + sortBy
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, constants.ResourceListSortMetadataKey, sortBy)
+}
+
+// ListSorted lists the resources sorted on the server side by the metadata field.
+//
+// Resources with equal field values are sorted by the resource ID.
+func (c *Client) ListSorted(ctx context.Context, kind resource.Kind, field ListSortField, descending bool, opts ...state.ListOption) (resource.List, error) {
+	return c.COSI.List(WithListSort(ctx, field, descending), kind, opts...)
+}
+
+func listSortRequested(ctx context.Context) bool {
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	return len(md.Get(constants.ResourceListSortMetadataKey)) > 0
+}
+
+func (c *Client) listResources(ctx context.Context, ptr resource.Pointer, query []resource.LabelQueryOption, opts ...state.UnmarshalOption) ([]resource.Resource, error) {
+	if ptr.ID() != "" {
+		r, err := c.COSI.Get(ctx, ptr, state.WithGetUnmarshalOptions(opts...))
+		if err != nil {
+			return nil, err
+		}
+
+		var labelQuery resource.LabelQuery
+
+		for _, opt := range query {
+			opt(&labelQuery)
+		}
+
+		if !labelQuery.Matches(*r.Metadata().Labels()) {
+			return nil, nil
+		}
+
+		return []resource.Resource{r}, nil
+	}
+
+	listOpts := []state.ListOption{state.WithListUnmarshalOptions(opts...)}
+
+	if len(query) > 0 {
+		listOpts = append(listOpts, state.WithLabelQuery(query...))
+	}
+
+	items, err := c.COSI.List(ctx, ptr, listOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return items.Items, nil
+}
@@ -0,0 +1,145 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// nodeState returns resources depending on the node set in the context.
+type nodeState struct {
+	state.State
+
+	resources map[string][]resource.Resource
+}
+
+func (s *nodeState) node(ctx context.Context) (string, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	var node string
+
+	if nodes := md.Get("node"); len(nodes) > 0 {
+		node = nodes[0]
+	}
+
+	if _, ok := s.resources[node]; !ok {
+		return "", errors.New("connection refused")
+	}
+
+	return node, nil
+}
+
+func (s *nodeState) List(ctx context.Context, _ resource.Kind, _ ...state.ListOption) (resource.List, error) {
+	node, err := s.node(ctx)
+	if err != nil {
+		return resource.List{}, err
+	}
+
+	return resource.List{Items: s.resources[node]}, nil
+}
+
+func (s *nodeState) Get(ctx context.Context, ptr resource.Pointer, _ ...state.GetOption) (resource.Resource, error) {
+	node, err := s.node(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range s.resources[node] {
+		if r.Metadata().ID() == ptr.ID() {
+			return r, nil
+		}
+	}
+
+	return nil, errors.New("not found")
+}
+
+func TestListNodeResources(t *testing.T) {
+	t.Parallel()
+
+	c := &client.Client{
+		COSI: &nodeState{
+			resources: map[string][]resource.Resource{
+				"node-b": {
+					network.NewLinkStatus(network.NamespaceName, "eth1"),
+					network.NewLinkStatus(network.NamespaceName, "eth0"),
+				},
+				"node-a": {
+					network.NewLinkStatus(network.NamespaceName, "eth0"),
+				},
+			},
+		},
+	}
+
+	ptr := resource.NewMetadata(network.NamespaceName, network.LinkStatusType, "", resource.VersionUndefined)
+
+	for _, concurrency := range []int{0, 1, 2} {
+		resources, err := c.ListNodeResources(context.Background(), []string{"node-b", "node-c", "node-a"}, ptr, concurrency, nil)
+		require.Error(t, err)
+
+		nodeErrs := client.NodeErrors(err)
+		require.Len(t, nodeErrs, 1)
+		assert.Equal(t, "node-c", nodeErrs[0].Node)
+
+		assert.Equal(t,
+			[]string{"node-a/eth0", "node-b/eth0", "node-b/eth1"},
+			xslices.Map(resources, func(r client.NodeResource) string { return r.Node + "/" + r.Resource.Metadata().ID() }),
+		)
+	}
+
+	ptr = resource.NewMetadata(network.NamespaceName, network.LinkStatusType, "eth1", resource.VersionUndefined)
+
+	resources, err := c.ListNodeResources(context.Background(), []string{"node-b"}, ptr, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "node-b", resources[0].Node)
+	assert.Equal(t, "eth1", resources[0].Resource.Metadata().ID())
+
+	// the label query filters out the resource fetched by ID
+	resources, err = c.ListNodeResources(context.Background(), []string{"node-b"}, ptr, 0, []resource.LabelQueryOption{resource.LabelExists("foo")})
+	require.NoError(t, err)
+	assert.Empty(t, resources)
+}
+
+func TestListNodeResourcesSorted(t *testing.T) {
+	t.Parallel()
+
+	c := &client.Client{
+		COSI: &nodeState{
+			resources: map[string][]resource.Resource{
+				"node-b": {
+					network.NewLinkStatus(network.NamespaceName, "eth1"),
+					network.NewLinkStatus(network.NamespaceName, "eth0"),
+				},
+				"node-a": {
+					network.NewLinkStatus(network.NamespaceName, "lo"),
+					network.NewLinkStatus(network.NamespaceName, "eth0"),
+				},
+			},
+		},
+	}
+
+	ptr := resource.NewMetadata(network.NamespaceName, network.LinkStatusType, "", resource.VersionUndefined)
+
+	// the order of the server is kept within each node
+	resources, err := c.ListNodeResources(client.WithListSort(context.Background(), client.ListSortByID, true), []string{"node-b", "node-a"}, ptr, 0, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{"node-a/lo", "node-a/eth0", "node-b/eth1", "node-b/eth0"},
+		xslices.Map(resources, func(r client.NodeResource) string { return r.Node + "/" + r.Resource.Metadata().ID() }),
+	)
+}
@@ -17,11 +17,18 @@ func (c BasicAuth) RequireTransportSecurity() bool {
 	return true
 }
 
-func buildCredentials(configContext *clientconfig.Context, endpoints []string) (credentials.TransportCredentials, error) {
+// RequireTransportSecurity implements credentials.PerRPCCredentials.
+func (c BearerToken) RequireTransportSecurity() bool {
+	return true
+}
+
+func buildCredentials(configContext *clientconfig.Context, endpoints []string, renewer *certificateRenewer) (credentials.TransportCredentials, error) {
 	tlsConfig, err := buildTLSConfig(configContext)
 	if err != nil {
 		return nil, err
 	}
 
+	renewer.apply(tlsConfig)
+
 	return credentials.NewTLS(tlsConfig), nil
 }
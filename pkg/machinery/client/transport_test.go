@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+type fakeTransport struct {
+	method string
+	md     metadata.MD
+	err    error
+	closed bool
+}
+
+func (t *fakeTransport) Invoke(ctx context.Context, method string, _, reply any, _ ...grpc.CallOption) error {
+	t.method = method
+	t.md, _ = metadata.FromOutgoingContext(ctx)
+
+	if t.err != nil {
+		return t.err
+	}
+
+	reply.(*machine.VersionResponse).Messages = []*machine.Version{ //nolint:forcetypeassert
+		{
+			Metadata: &common.Metadata{Hostname: "node"},
+			Version:  &machine.VersionInfo{Tag: "v1.9.0"},
+		},
+	}
+
+	return nil
+}
+
+func (t *fakeTransport) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (t *fakeTransport) Close() error {
+	t.closed = true
+
+	return nil
+}
+
+func TestWithTransport(t *testing.T) {
+	transport := &fakeTransport{}
+
+	c, err := client.New(context.Background(), client.WithTransport(transport), client.WithCluster("cluster"))
+	require.NoError(t, err)
+
+	assert.Nil(t, c.Conn())
+
+	resp, err := c.Version(client.WithNode(context.Background(), "node"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "v1.9.0", resp.Messages[0].Version.Tag)
+	assert.Equal(t, "/machine.MachineService/Version", transport.method)
+	assert.Equal(t, []string{"Talos"}, transport.md.Get("runtime"))
+	assert.Equal(t, []string{"cluster"}, transport.md.Get("context"))
+	assert.Equal(t, []string{"node"}, transport.md.Get("node"))
+
+	transport.err = status.Error(codes.Unavailable, "connection refused")
+
+	_, err = c.Version(context.Background())
+	assert.ErrorIs(t, err, client.ErrNodeUnreachable)
+
+	require.NoError(t, c.Close())
+	assert.True(t, transport.closed)
+}
@@ -0,0 +1,279 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bundle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+const (
+	// SpecAPIVersion is the current version of the bundle spec format.
+	SpecAPIVersion = "v1alpha1"
+	// SpecKind is the kind of the bundle spec document.
+	SpecKind = "ConfigBundleSpec"
+)
+
+// Spec is a versioned declarative description of a config bundle.
+//
+// The spec is consumed both by `talosctl gen config --from-spec` and by the bootstrap providers
+// (e.g. Cluster API), so that the machine configuration is generated using identical logic.
+type Spec struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+
+	// ClusterName is the name of the cluster.
+	ClusterName string `yaml:"clusterName"`
+	// Endpoint is the Kubernetes API endpoint URL.
+	Endpoint string `yaml:"endpoint"`
+	// KubernetesVersion is the desired Kubernetes version, defaults to the version bundled with Talos.
+	KubernetesVersion string `yaml:"kubernetesVersion,omitempty"`
+	// TalosVersion is the Talos version to generate the config for (version contract), defaults to the current version.
+	TalosVersion string `yaml:"talosVersion,omitempty"`
+
+	// Secrets is the reference to the secrets bundle, if not set, new secrets are generated.
+	Secrets *SecretsRef `yaml:"secrets,omitempty"`
+
+	// Options are the config generation options.
+	Options SpecOptions `yaml:"options,omitempty"`
+
+	// Patches are applied on top of the generated configs.
+	Patches SpecPatches `yaml:"patches,omitempty"`
+}
+
+// SecretsRef is a reference to the secrets bundle.
+//
+// Exactly one of the fields should be set.
+type SecretsRef struct {
+	// File is the path to the secrets bundle file, relative paths are resolved against the spec file directory.
+	File string `yaml:"file,omitempty"`
+	// Name is the name of the secrets bundle in an external store (e.g. Kubernetes Secret), resolved by the consumer.
+	Name string `yaml:"name,omitempty"`
+}
+
+// SpecOptions are the config generation options of the bundle spec.
+type SpecOptions struct {
+	AdditionalSANs       []string          `yaml:"additionalSANs,omitempty"`
+	AdditionalEndpoints  []string          `yaml:"additionalEndpoints,omitempty"`
+	DNSDomain            string            `yaml:"dnsDomain,omitempty"`
+	InstallDisk          string            `yaml:"installDisk,omitempty"`
+	InstallImage         string            `yaml:"installImage,omitempty"`
+	RegistryMirrors      map[string]string `yaml:"registryMirrors,omitempty"`
+	Persist              *bool             `yaml:"persist,omitempty"`
+	WithClusterDiscovery *bool             `yaml:"withClusterDiscovery,omitempty"`
+	WithKubeSpan         bool              `yaml:"withKubeSpan,omitempty"`
+}
+
+// SpecPatches are the config patches of the bundle spec.
+//
+// Each patch is either inline (strategic merge or JSON patch) or a reference to a file in `@file` format.
+type SpecPatches struct {
+	All          []string `yaml:"all,omitempty"`
+	ControlPlane []string `yaml:"controlPlane,omitempty"`
+	Worker       []string `yaml:"worker,omitempty"`
+}
+
+// SecretsResolver resolves the secrets bundle reference.
+type SecretsResolver func(ref SecretsRef) (*secrets.Bundle, error)
+
+// FileSecretsResolver returns a SecretsResolver which loads secrets bundles from files relative to the base directory.
+func FileSecretsResolver(baseDir string) SecretsResolver {
+	return func(ref SecretsRef) (*secrets.Bundle, error) {
+		if ref.File == "" {
+			return nil, fmt.Errorf("secrets bundle %q can't be resolved from a file", ref.Name)
+		}
+
+		path := ref.File
+
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir, path)
+		}
+
+		return secrets.LoadBundle(path)
+	}
+}
+
+// ParseSpec parses and validates the bundle spec.
+func ParseSpec(data []byte) (*Spec, error) {
+	var spec Spec
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	if err := dec.Decode(&spec); err != nil {
+		return nil, fmt.Errorf("error decoding bundle spec: %w", err)
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+// LoadSpec loads the bundle spec from a file.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseSpec(data)
+}
+
+// Validate the bundle spec.
+func (spec *Spec) Validate() error {
+	if spec.Kind != SpecKind {
+		return fmt.Errorf("unexpected bundle spec kind %q, expected %q", spec.Kind, SpecKind)
+	}
+
+	if spec.APIVersion != SpecAPIVersion {
+		return fmt.Errorf("unsupported bundle spec version %q", spec.APIVersion)
+	}
+
+	var errs error
+
+	if spec.ClusterName == "" {
+		errs = errors.Join(errs, errors.New("clusterName is required"))
+	}
+
+	if spec.Endpoint == "" {
+		errs = errors.Join(errs, errors.New("endpoint is required"))
+	}
+
+	if spec.Secrets != nil && (spec.Secrets.File == "") == (spec.Secrets.Name == "") {
+		errs = errors.Join(errs, errors.New("exactly one of secrets.file and secrets.name should be set"))
+	}
+
+	if spec.TalosVersion != "" {
+		if _, err := config.ParseContractFromVersion(spec.TalosVersion); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid talosVersion: %w", err))
+		}
+	}
+
+	return errs
+}
+
+// BundleOptions converts the bundle spec into the bundle generation options.
+//
+// Default generate options are applied before the options derived from the spec,
+// so that the spec can override them.
+func (spec *Spec) BundleOptions(resolver SecretsResolver, defaults ...generate.Option) ([]Option, error) {
+	specGenOptions := slices.Clone(defaults)
+
+	for host, endpoint := range spec.Options.RegistryMirrors {
+		specGenOptions = append(specGenOptions, generate.WithRegistryMirror(host, endpoint))
+	}
+
+	if spec.TalosVersion != "" {
+		versionContract, err := config.ParseContractFromVersion(spec.TalosVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid talosVersion: %w", err)
+		}
+
+		specGenOptions = append(specGenOptions, generate.WithVersionContract(versionContract))
+	}
+
+	if spec.Options.WithKubeSpan {
+		specGenOptions = append(specGenOptions, generate.WithNetworkOptions(v1alpha1.WithKubeSpan()))
+	}
+
+	if spec.Secrets != nil {
+		if resolver == nil {
+			return nil, errors.New("secrets resolver is not set")
+		}
+
+		secretsBundle, err := resolver(*spec.Secrets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve secrets bundle: %w", err)
+		}
+
+		specGenOptions = append(specGenOptions, generate.WithSecretsBundle(secretsBundle))
+	}
+
+	if spec.Options.InstallDisk != "" {
+		specGenOptions = append(specGenOptions, generate.WithInstallDisk(spec.Options.InstallDisk))
+	}
+
+	if spec.Options.InstallImage != "" {
+		specGenOptions = append(specGenOptions, generate.WithInstallImage(spec.Options.InstallImage))
+	}
+
+	if len(spec.Options.AdditionalSANs) > 0 {
+		specGenOptions = append(specGenOptions, generate.WithAdditionalSubjectAltNames(spec.Options.AdditionalSANs))
+	}
+
+	if len(spec.Options.AdditionalEndpoints) > 0 {
+		specGenOptions = append(specGenOptions, generate.WithAdditionalControlPlaneEndpoints(spec.Options.AdditionalEndpoints))
+	}
+
+	if spec.Options.DNSDomain != "" {
+		specGenOptions = append(specGenOptions, generate.WithDNSDomain(spec.Options.DNSDomain))
+	}
+
+	if spec.Options.Persist != nil {
+		specGenOptions = append(specGenOptions, generate.WithPersist(*spec.Options.Persist))
+	}
+
+	if spec.Options.WithClusterDiscovery != nil {
+		specGenOptions = append(specGenOptions, generate.WithClusterDiscovery(*spec.Options.WithClusterDiscovery))
+	}
+
+	kubernetesVersion := spec.KubernetesVersion
+	if kubernetesVersion == "" {
+		kubernetesVersion = constants.DefaultKubernetesVersion
+	}
+
+	opts := []Option{
+		WithInputOptions(&InputOptions{
+			ClusterName: spec.ClusterName,
+			Endpoint:    spec.Endpoint,
+			KubeVersion: strings.TrimPrefix(kubernetesVersion, "v"),
+			GenOptions:  specGenOptions,
+		}),
+	}
+
+	for _, patches := range []struct {
+		patches []string
+		option  func([]configpatcher.Patch) Option
+	}{
+		{spec.Patches.All, WithPatch},
+		{spec.Patches.ControlPlane, WithPatchControlPlane},
+		{spec.Patches.Worker, WithPatchWorker},
+	} {
+		loaded, err := configpatcher.LoadPatches(patches.patches)
+		if err != nil {
+			return nil, fmt.Errorf("error loading patches: %w", err)
+		}
+
+		opts = append(opts, patches.option(loaded))
+	}
+
+	return opts, nil
+}
+
+// NewBundleFromSpec generates a new bundle from the bundle spec.
+func NewBundleFromSpec(spec *Spec, resolver SecretsResolver, opts ...Option) (*Bundle, error) {
+	specOpts, err := spec.BundleOptions(resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBundle(append(specOpts, opts...)...)
+}
@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bundle_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/bundle"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+)
+
+const testSpec = `apiVersion: v1alpha1
+kind: ConfigBundleSpec
+clusterName: test-cluster
+endpoint: https://10.5.0.1:6443
+kubernetesVersion: v1.31.0
+secrets:
+  file: secrets.yaml
+options:
+  installDisk: /dev/vda
+  additionalSANs:
+    - 10.5.0.1
+  additionalEndpoints:
+    - cp.example.com
+patches:
+  all:
+    - |
+      machine:
+        network:
+          hostname: foo
+  worker:
+    - |
+      machine:
+        kubelet:
+          extraArgs:
+            node-labels: role=worker
+`
+
+func TestNewBundleFromSpec(t *testing.T) {
+	secretsBundle, err := secrets.NewBundle(secrets.NewFixedClock(time.Now()), config.TalosVersionCurrent)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+
+	secretsData, err := yaml.Marshal(secretsBundle)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secrets.yaml"), secretsData, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "spec.yaml"), []byte(testSpec), 0o644))
+
+	spec, err := bundle.LoadSpec(filepath.Join(dir, "spec.yaml"))
+	require.NoError(t, err)
+
+	configBundle, err := bundle.NewBundleFromSpec(spec, bundle.FileSecretsResolver(dir), bundle.WithVerbose(false))
+	require.NoError(t, err)
+
+	for _, cfg := range []config.Provider{configBundle.ControlPlane(), configBundle.Worker()} {
+		assert.Equal(t, "test-cluster", cfg.Cluster().Name())
+		assert.Equal(t, "https://10.5.0.1:6443", cfg.Cluster().Endpoint().String())
+
+		disk, err := cfg.Machine().Install().Disk()
+		require.NoError(t, err)
+
+		assert.Equal(t, "/dev/vda", disk)
+		assert.Equal(t, "foo", cfg.Machine().Network().Hostname())
+		assert.Equal(t, secretsBundle.Certs.OS.Crt, cfg.Machine().Security().IssuingCA().Crt)
+	}
+
+	assert.Equal(t, []string{"10.5.0.1", "cp.example.com"}, configBundle.ControlPlane().Machine().Security().CertSANs())
+	assert.Equal(t, []string{"cp.example.com"}, configBundle.TalosConfig().Contexts[configBundle.TalosConfig().Context].Endpoints)
+	assert.Equal(t, map[string]string{"node-labels": "role=worker"}, configBundle.Worker().Machine().Kubelet().ExtraArgs())
+	assert.Empty(t, configBundle.ControlPlane().Machine().Kubelet().ExtraArgs())
+}
+
+func TestParseSpecInvalid(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		spec string
+
+		expectedError string
+	}{
+		{
+			name:          "kind",
+			spec:          "apiVersion: v1alpha1\nkind: Foo\n",
+			expectedError: `unexpected bundle spec kind "Foo", expected "ConfigBundleSpec"`,
+		},
+		{
+			name:          "version",
+			spec:          "apiVersion: v1alpha2\nkind: ConfigBundleSpec\n",
+			expectedError: `unsupported bundle spec version "v1alpha2"`,
+		},
+		{
+			name:          "unknown field",
+			spec:          "apiVersion: v1alpha1\nkind: ConfigBundleSpec\nfoo: bar\n",
+			expectedError: "error decoding bundle spec: yaml: unmarshal errors:\n  line 3: field foo not found in type bundle.Spec",
+		},
+		{
+			name:          "required",
+			spec:          "apiVersion: v1alpha1\nkind: ConfigBundleSpec\nsecrets:\n  file: a\n  name: b\n",
+			expectedError: "clusterName is required\nendpoint is required\nexactly one of secrets.file and secrets.name should be set",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := bundle.ParseSpec([]byte(test.spec))
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
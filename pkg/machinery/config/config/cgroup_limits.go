@@ -0,0 +1,17 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+// CgroupLimitsConfig defines the interface to access resource limits of a system service cgroup.
+//
+// The document name is the name of the cgroup (e.g. `kubelet`).
+type CgroupLimitsConfig interface {
+	NamedDocument
+	CgroupPath() string
+	// CPULimitMillicores returns the hard CPU limit, 0 means no limit.
+	CPULimitMillicores() uint32
+	// MemoryMax returns the hard memory limit in bytes, 0 means the default limit.
+	MemoryMax() uint64
+}
@@ -66,6 +66,7 @@ type CNI interface {
 	Name() string
 	URLs() []string
 	Flannel() FlannelCNI
+	Readiness() CNIReadiness
 }
 
 // FlannelCNI defines the requirements for a config that pertains to configure Flannel.
@@ -73,6 +74,13 @@ type FlannelCNI interface {
 	ExtraArgs() []string
 }
 
+// CNIReadiness defines the readiness checks for the CNI which is not managed by Talos.
+type CNIReadiness interface {
+	Mode() string
+	DaemonSets() []string
+	Timeout() time.Duration
+}
+
 // APIServer defines the requirements for a config that pertains to apiserver related
 // options.
 type APIServer interface {
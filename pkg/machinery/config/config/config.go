@@ -12,9 +12,15 @@ type Config interface {
 	Cluster() ClusterConfig
 	SideroLink() SideroLinkConfig
 	ExtensionServiceConfigs() []ExtensionServiceConfig
+	LifecycleHooks() []LifecycleHookConfig
+	CgroupLimits() []CgroupLimitsConfig
 	Runtime() RuntimeConfig
 	NetworkRules() NetworkRuleConfig
 	TrustedRoots() TrustedRootsConfig
 	Volumes() VolumesConfig
 	KubespanConfig() KubespanConfig
+	Conntrack() ConntrackConfig
+	RouterAdvertisements() []RouterAdvertisementConfig
+	UdevRules() UdevRulesConfig
+	SecretsReference() SecretsReferenceConfig
 }
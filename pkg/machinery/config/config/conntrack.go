@@ -0,0 +1,16 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import "time"
+
+// ConntrackConfig defines the interface to access netfilter connection tracking configuration.
+//
+// Zero values mean that the kernel default is kept.
+type ConntrackConfig interface {
+	Max() uint32
+	Buckets() uint32
+	TCPEstablishedTimeout() time.Duration
+}
@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+	"text/template"
+)
+
+// FileFacts is the set of node facts available in the file templates.
+type FileFacts struct {
+	Hostname    string
+	NodeIP      string
+	MachineType string
+}
+
+// RenderFileTemplate renders the file contents as a template using node facts.
+func RenderFileTemplate(content string, facts FileFacts) (string, error) {
+	tmpl, err := template.New("file").Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("error parsing file template: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	if err = tmpl.Execute(&buf, facts); err != nil {
+		return "", fmt.Errorf("error rendering file template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// ValidateFileChecksum checks the format of the file checksum.
+func ValidateFileChecksum(checksum string) error {
+	_, _, err := parseFileChecksum(checksum)
+
+	return err
+}
+
+// VerifyFileChecksum verifies the file contents against the checksum.
+func VerifyFileChecksum(data []byte, checksum string) error {
+	h, expected, err := parseFileChecksum(checksum)
+	if err != nil {
+		return err
+	}
+
+	h.Write(data)
+
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != expected {
+		return fmt.Errorf("checksum mismatch: expected %q, got %q", expected, actual)
+	}
+
+	return nil
+}
+
+func parseFileChecksum(checksum string) (hash.Hash, string, error) {
+	algorithm, digest, ok := strings.Cut(checksum, ":")
+	if !ok {
+		return nil, "", fmt.Errorf("invalid checksum %q: expected <algorithm>:<digest>", checksum)
+	}
+
+	var h hash.Hash
+
+	switch algorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return nil, "", fmt.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+
+	digest = strings.ToLower(digest)
+
+	if decoded, err := hex.DecodeString(digest); err != nil || len(decoded) != h.Size() {
+		return nil, "", fmt.Errorf("invalid %s digest %q", algorithm, digest)
+	}
+
+	return h, digest, nil
+}
@@ -0,0 +1,44 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+// LifecycleHookStage is a point in the machine lifecycle where the hooks are fired.
+type LifecycleHookStage string
+
+// Lifecycle hook stages.
+const (
+	// LifecycleHookPreReboot is fired before the machine is rebooted (including reboots caused by the upgrade).
+	LifecycleHookPreReboot LifecycleHookStage = "preReboot"
+	// LifecycleHookPostInstall is fired after Talos is installed to the disk, before the first reboot.
+	LifecycleHookPostInstall LifecycleHookStage = "postInstall"
+	// LifecycleHookPreReset is fired before the machine is reset.
+	LifecycleHookPreReset LifecycleHookStage = "preReset"
+)
+
+// LifecycleHookFailurePolicy defines how the hook failures are handled.
+type LifecycleHookFailurePolicy string
+
+// Lifecycle hook failure policies.
+const (
+	// LifecycleHookFailurePolicyIgnore logs the hook failure and continues the sequence (fail-open).
+	LifecycleHookFailurePolicyIgnore LifecycleHookFailurePolicy = "ignore"
+	// LifecycleHookFailurePolicyFail aborts the sequence on the hook failure (fail-closed).
+	LifecycleHookFailurePolicyFail LifecycleHookFailurePolicy = "fail"
+)
+
+// LifecycleHookConfig defines the interface to access the lifecycle hook configuration.
+type LifecycleHookConfig interface {
+	NamedDocument
+	Stages() []LifecycleHookStage
+	URL() *url.URL
+	Headers() map[string]string
+	Timeout() time.Duration
+	FailurePolicy() LifecycleHookFailurePolicy
+}
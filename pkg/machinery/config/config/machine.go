@@ -84,6 +84,9 @@ type File interface {
 	Permissions() os.FileMode
 	Path() string
 	Op() string
+	URL() string
+	Checksum() string
+	Template() bool
 }
 
 // Install defines the requirements for a config that pertains to install
@@ -96,6 +99,8 @@ type Install interface {
 	Zero() bool
 	LegacyBIOSSupport() bool
 	WithBootloader() bool
+	PullRateLimit() uint64
+	SigningCertificates() []*x509.PEMEncodedCertificate
 }
 
 // Extension defines the system extension.
@@ -174,6 +179,10 @@ type DHCPOptions interface {
 	IPv4() bool
 	IPv6() bool
 	DUIDv6() string
+	ClientIdentifier() string
+	VendorClassIdentifier() string
+	RequestedOptions() []uint8
+	PersistLease() bool
 }
 
 // VIPConfig contains settings for the Virtual (shared) IP setup.
@@ -329,6 +338,13 @@ type Kubelet interface {
 	NodeIP() KubeletNodeIP
 	SkipNodeRegistration() bool
 	DisableManifestsDirectory() bool
+	ShutdownGracePeriodByPodPriority() []KubeletShutdownGracePeriod
+}
+
+// KubeletShutdownGracePeriod defines the kubelet shutdown grace period for the pod priority.
+type KubeletShutdownGracePeriod interface {
+	Priority() int32
+	GracePeriod() time.Duration
 }
 
 // KubeletNodeIP defines the way node IPs are selected for the kubelet.
@@ -390,9 +406,20 @@ type EncryptionKeyStatic interface {
 // EncryptionKeyKMS encryption key sealed by KMS.
 type EncryptionKeyKMS interface {
 	Endpoint() string
+	ClientIdentity() *x509.PEMEncodedCertificateAndKey
+	CA() []byte
+	VaultTransit() EncryptionKeyKMSVaultTransit
+	Lockout() bool
 	String() string
 }
 
+// EncryptionKeyKMSVaultTransit defines the HashiCorp Vault transit secrets engine settings of the KMS key.
+type EncryptionKeyKMSVaultTransit interface {
+	KeyName() string
+	MountPath() string
+	AuthMountPath() string
+}
+
 // EncryptionKeyNodeID deterministically generated encryption key.
 type EncryptionKeyNodeID interface {
 	String() string
@@ -428,6 +455,56 @@ type Features interface {
 	DiskQuotaSupportEnabled() bool
 	HostDNS() HostDNS
 	KubePrism() KubePrism
+	APIDDeadlines() APIDDeadlines
+	KubernetesEventsEnabled() bool
+	EmergencyConsoleEnabled() bool
+	AuditLog() AuditLog
+	APIOIDC() APIOIDC
+	Metrics() Metrics
+	ResourceAccessPolicy() []ResourceAccessRule
+}
+
+// ResourceAccessRule restricts the access of the role to the resource API.
+type ResourceAccessRule interface {
+	Role() string
+	Namespaces() []string
+	Types() []string
+}
+
+// Metrics describes the Prometheus metrics endpoint of machined.
+type Metrics interface {
+	Enabled() bool
+	ListenAddress() string
+}
+
+// APIOIDC describes the authentication of the Talos API clients with OIDC bearer tokens.
+type APIOIDC interface {
+	Enabled() bool
+	IssuerURL() string
+	Audience() string
+	GroupsClaim() string
+	GroupRoles() []APIOIDCGroupRoles
+}
+
+// APIOIDCGroupRoles maps a group of the OIDC tokens to the Talos API roles.
+type APIOIDCGroupRoles interface {
+	Group() string
+	Roles() []string
+}
+
+// AuditLog describes the audit log of the Talos API calls.
+type AuditLog interface {
+	SyslogEndpoint() *url.URL
+}
+
+// APIDDeadlines describes the default and maximum deadlines for Talos API calls.
+//
+// Zero duration means no deadline (or no maximum).
+type APIDDeadlines interface {
+	UnaryDefault() time.Duration
+	UnaryMax() time.Duration
+	StreamingDefault() time.Duration
+	StreamingMax() time.Duration
 }
 
 // KubernetesTalosAPIAccess describes the Kubernetes Talos API access features.
@@ -435,6 +512,14 @@ type KubernetesTalosAPIAccess interface {
 	Enabled() bool
 	AllowedRoles() []string
 	AllowedKubernetesNamespaces() []string
+	ServiceAccounts() []KubernetesTalosAPIAccessServiceAccount
+}
+
+// KubernetesTalosAPIAccessServiceAccount maps a Kubernetes service account to the Talos API roles.
+type KubernetesTalosAPIAccessServiceAccount interface {
+	Namespace() string
+	Name() string
+	Roles() []string
 }
 
 // KubePrism describes the API Server load balancer features.
@@ -465,6 +550,10 @@ type LoggingDestination interface {
 	Endpoint() *url.URL
 	ExtraTags() map[string]string
 	Format() string
+	Sources() []string
+	TLSCA() []byte
+	TLSInsecureSkipVerify() bool
+	SpoolMaxSize() uint64
 }
 
 // Kernel describes Talos Linux kernel configuration.
@@ -0,0 +1,26 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"net/netip"
+	"time"
+)
+
+// RouterAdvertisementConfig defines the interface to access IPv6 router advertisement configuration of a link.
+type RouterAdvertisementConfig interface {
+	NamedDocument
+	Interface() string
+	Interval() time.Duration
+	RouterLifetime() time.Duration
+	Prefixes() []RouterAdvertisementPrefix
+}
+
+// RouterAdvertisementPrefix defines the interface to access a prefix announced in the router advertisements.
+type RouterAdvertisementPrefix interface {
+	Prefix() netip.Prefix
+	ValidLifetime() time.Duration
+	PreferredLifetime() time.Duration
+}
@@ -21,3 +21,11 @@ func (w trustedRootConfigWrapper) ExtraTrustedRootCertificates() []string {
 		return c.ExtraTrustedRootCertificates()
 	})
 }
+
+// SecretsReferenceConfig defines the interface to access the reference to the secrets bundle.
+//
+// The machine configuration referencing the secrets bundle doesn't embed the private keys and shared secrets,
+// they are resolved from the secrets bundle when the configuration is applied.
+type SecretsReferenceConfig interface {
+	SecretsBundleID() string
+}
@@ -0,0 +1,20 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import "github.com/siderolabs/gen/optional"
+
+// UdevRulesConfig defines the interface to access custom udev rules and predictable interface naming configuration.
+type UdevRulesConfig interface {
+	PredictableInterfaceNames() optional.Optional[bool]
+	Rules() []UdevRule
+	DisabledRules() []string
+}
+
+// UdevRule defines a custom udev rules file.
+type UdevRule interface {
+	Name() string
+	Contents() string
+}
@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configdiff
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+)
+
+// Changes is a structured summary of the difference between two machine configurations.
+//
+// Each change is identified by the path of the field, prefixed with the document identifier:
+// `v1alpha1` for the legacy v1alpha1 document, and `<Kind>` or `<Kind>/<name>` for other documents,
+// e.g. `v1alpha1.machine.network.hostname` or `ExtensionServiceConfig/foo.environment`.
+//
+// Lists are compared as a whole.
+type Changes struct {
+	Added   []string
+	Removed []string
+	Changed []string
+}
+
+// Empty returns true if there are no changes.
+func (c Changes) Empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Changed) == 0
+}
+
+// Compute returns the structured diff between two machine configurations.
+//
+// One of the configurations might be nil.
+func Compute(oldCfg, newCfg config.Encoder) (Changes, error) {
+	oldDocs, err := decodeDocuments(oldCfg)
+	if err != nil {
+		return Changes{}, err
+	}
+
+	newDocs, err := decodeDocuments(newCfg)
+	if err != nil {
+		return Changes{}, err
+	}
+
+	var changes Changes
+
+	diffValues(&changes, "", oldDocs, newDocs, true)
+
+	slices.Sort(changes.Added)
+	slices.Sort(changes.Removed)
+	slices.Sort(changes.Changed)
+
+	return changes, nil
+}
+
+func decodeDocuments(cfg config.Encoder) (map[string]any, error) {
+	docs := map[string]any{}
+
+	if cfg == nil {
+		return docs, nil
+	}
+
+	data, err := cfg.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return nil, err
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		var doc map[string]any
+
+		if err = dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return docs, nil
+			}
+
+			return nil, fmt.Errorf("error decoding config document: %w", err)
+		}
+
+		if doc == nil {
+			continue
+		}
+
+		docs[documentID(doc)] = doc
+	}
+}
+
+func documentID(doc map[string]any) string {
+	kind, ok := doc["kind"].(string)
+	if !ok {
+		if version, ok := doc["version"].(string); ok {
+			return version
+		}
+
+		return "unknown"
+	}
+
+	if name, ok := doc["name"].(string); ok && name != "" {
+		return kind + "/" + name
+	}
+
+	return kind
+}
+
+func diffValues(changes *Changes, path string, oldValue, newValue any, root bool) {
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+
+	switch {
+	case oldValue == nil && newValue == nil:
+		return
+	case oldIsMap && newIsMap:
+		for key, oldChild := range oldMap {
+			diffValues(changes, joinPath(path, key, root), oldChild, newMap[key], false)
+		}
+
+		for key, newChild := range newMap {
+			if _, ok := oldMap[key]; !ok {
+				diffValues(changes, joinPath(path, key, root), nil, newChild, false)
+			}
+		}
+	case oldValue == nil:
+		changes.Added = append(changes.Added, path)
+	case newValue == nil:
+		changes.Removed = append(changes.Removed, path)
+	case !reflect.DeepEqual(oldValue, newValue):
+		changes.Changed = append(changes.Changed, path)
+	}
+}
+
+func joinPath(path, key string, root bool) string {
+	if root {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -82,3 +82,76 @@ func TestDiffString(t *testing.T) {
 		})
 	}
 }
+
+func TestCompute(t *testing.T) {
+	t.Parallel()
+
+	v1alpha1Cfg := &v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType:  "controlplane",
+			MachineToken: "foo",
+		},
+	}
+
+	v1alpha1CfgOther := v1alpha1Cfg.DeepCopy()
+	v1alpha1CfgOther.MachineConfig.MachineType = "worker"
+	v1alpha1CfgOther.MachineConfig.MachineNetwork = &v1alpha1.NetworkConfig{
+		NetworkHostname: "node1",
+	}
+
+	siderolinkConfig := siderolink.NewConfigV1Alpha1()
+	siderolinkConfig.APIUrlConfig = meta.URL{
+		URL: must.Value(url.Parse("https://example.com"))(t),
+	}
+
+	for _, test := range []struct {
+		name   string
+		oldCfg []config.Document
+		newCfg []config.Document
+		want   configdiff.Changes
+	}{
+		{
+			name:   "same",
+			oldCfg: []config.Document{v1alpha1Cfg},
+			newCfg: []config.Document{v1alpha1Cfg},
+		},
+		{
+			name:   "new doc",
+			oldCfg: []config.Document{v1alpha1Cfg},
+			newCfg: []config.Document{v1alpha1Cfg, siderolinkConfig},
+			want: configdiff.Changes{
+				Added: []string{"SideroLinkConfig"},
+			},
+		},
+		{
+			name:   "removed doc",
+			oldCfg: []config.Document{v1alpha1Cfg, siderolinkConfig},
+			newCfg: []config.Document{v1alpha1Cfg},
+			want: configdiff.Changes{
+				Removed: []string{"SideroLinkConfig"},
+			},
+		},
+		{
+			name:   "updated fields",
+			oldCfg: []config.Document{v1alpha1Cfg, siderolinkConfig},
+			newCfg: []config.Document{v1alpha1CfgOther, siderolinkConfig},
+			want: configdiff.Changes{
+				Added:   []string{"v1alpha1.machine.network"},
+				Changed: []string{"v1alpha1.machine.type"},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			oldCfg := must.Value(container.New(test.oldCfg...))(t)
+			newCfg := must.Value(container.New(test.newCfg...))(t)
+
+			got, err := configdiff.Compute(oldCfg, newCfg)
+			require.NoError(t, err)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
@@ -51,6 +51,8 @@ func callMethods(t testing.TB, obj reflect.Value, chain ...string) {
 		case "APIUrl":
 			fallthrough
 		case "Endpoint":
+			fallthrough
+		case "SyslogEndpoint":
 			// t.Logf("Skipping %v", nextChain)
 			continue
 		}
@@ -182,6 +182,16 @@ func (container *Container) ExtensionServiceConfigs() []config.ExtensionServiceC
 	return findMatchingDocs[config.ExtensionServiceConfig](container.documents)
 }
 
+// LifecycleHooks implements config.Config interface.
+func (container *Container) LifecycleHooks() []config.LifecycleHookConfig {
+	return findMatchingDocs[config.LifecycleHookConfig](container.documents)
+}
+
+// CgroupLimits implements config.Config interface.
+func (container *Container) CgroupLimits() []config.CgroupLimitsConfig {
+	return findMatchingDocs[config.CgroupLimitsConfig](container.documents)
+}
+
 // Runtime implements config.Config interface.
 func (container *Container) Runtime() config.RuntimeConfig {
 	return config.WrapRuntimeConfigList(findMatchingDocs[config.RuntimeConfig](container.documents)...)
@@ -207,6 +217,41 @@ func (container *Container) KubespanConfig() config.KubespanConfig {
 	return config.WrapKubespanConfig(findMatchingDocs[config.KubespanConfig](container.documents)...)
 }
 
+// Conntrack implements config.Config interface.
+func (container *Container) Conntrack() config.ConntrackConfig {
+	matching := findMatchingDocs[config.ConntrackConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// RouterAdvertisements implements config.Config interface.
+func (container *Container) RouterAdvertisements() []config.RouterAdvertisementConfig {
+	return findMatchingDocs[config.RouterAdvertisementConfig](container.documents)
+}
+
+// UdevRules implements config.Config interface.
+func (container *Container) UdevRules() config.UdevRulesConfig {
+	matching := findMatchingDocs[config.UdevRulesConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// SecretsReference implements config.Config interface.
+func (container *Container) SecretsReference() config.SecretsReferenceConfig {
+	matching := findMatchingDocs[config.SecretsReferenceConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
 // Bytes returns source YAML representation (if available) or does default encoding.
 func (container *Container) Bytes() ([]byte, error) {
 	if !container.readonly {
@@ -283,6 +328,10 @@ func (container *Container) Validate(mode validation.RuntimeMode, opt ...validat
 		err      error
 	)
 
+	if container.SecretsReference() != nil {
+		opt = append(opt, validation.WithSecretsReference())
+	}
+
 	if container.v1alpha1Config != nil {
 		warnings, err = container.v1alpha1Config.Validate(mode, opt...)
 	}
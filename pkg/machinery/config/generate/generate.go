@@ -91,6 +91,12 @@ func NewInput(clustername, endpoint, kubernetesVersion string, opts ...Option) (
 
 	additionalSubjectAltNames := slices.Clone(input.Options.AdditionalSubjectAltNames)
 
+	for _, host := range input.Options.AdditionalControlPlaneEndpoints {
+		if !slices.Contains(additionalSubjectAltNames, host) {
+			additionalSubjectAltNames = append(additionalSubjectAltNames, host)
+		}
+	}
+
 	if input.Options.DiscoveryEnabled == nil {
 		input.Options.DiscoveryEnabled = pointer.To(true)
 	}
@@ -131,10 +137,26 @@ func (in *Input) Config(t machine.Type) (coreconfig.Provider, error) {
 		return nil, err
 	}
 
+	if in.Options.SecretsReference != "" {
+		documents, err = in.referenceSecrets(documents)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return container.New(documents...)
 }
 
 // emptyIf returns empty string if the 2nd argument is empty string, otherwise returns the first argument.
+// overrideIf returns the override if it's not empty, and the str otherwise.
+func overrideIf(override, str string) string {
+	if override != "" {
+		return override
+	}
+
+	return str
+}
+
 func emptyIf(str, check string) string {
 	if check == "" {
 		return ""
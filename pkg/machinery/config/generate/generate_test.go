@@ -7,6 +7,7 @@ package generate_test
 import (
 	"crypto/x509"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -119,6 +120,128 @@ func (suite *GenerateSuite) TestGenerateWorkerSuccess() {
 	suite.True(cfg.Machine().Features().RBACEnabled())
 }
 
+func (suite *GenerateSuite) TestGeneratePoolSuccess() {
+	cfg, err := suite.input.PoolConfig(generate.MachinePool{
+		Name:             "gpu",
+		NodeLabels:       map[string]string{"example.com/gpu": "true"},
+		NodeTaints:       map[string]string{"example.com/gpu": "true:NoSchedule"},
+		KubeletExtraArgs: map[string]string{"max-pods": "50"},
+		InstallDisk:      "/dev/nvme0n1",
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(machine.TypeWorker, cfg.Machine().Type())
+	suite.Assert().Equal(map[string]string{"example.com/gpu": "true"}, map[string]string(cfg.Machine().NodeLabels()))
+	suite.Assert().Equal(map[string]string{"example.com/gpu": "true:NoSchedule"}, map[string]string(cfg.Machine().NodeTaints()))
+	suite.Assert().Equal("50", cfg.Machine().Kubelet().ExtraArgs()["max-pods"])
+
+	disk, err := cfg.Machine().Install().Disk()
+	suite.Require().NoError(err)
+	suite.Assert().Equal("/dev/nvme0n1", disk)
+
+	// the original worker config is not modified
+	workerCfg, err := suite.input.Config(machine.TypeWorker)
+	suite.Require().NoError(err)
+
+	suite.Assert().Empty(workerCfg.Machine().NodeTaints())
+
+	_, err = suite.input.PoolConfig(generate.MachinePool{Name: "Invalid_Name"})
+	suite.Require().Error(err)
+}
+
+func (suite *GenerateSuite) TestGenerateImages() {
+	const digest = "@sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+	images := generate.Images{
+		Kubelet:               "ghcr.io/siderolabs/kubelet:v1.31.1" + digest,
+		KubeAPIServer:         "registry.k8s.io/kube-apiserver:v1.31.1" + digest,
+		KubeControllerManager: "registry.k8s.io/kube-controller-manager:v1.31.1" + digest,
+		KubeProxy:             "registry.k8s.io/kube-proxy:v1.31.1" + digest,
+		KubeScheduler:         "registry.k8s.io/kube-scheduler:v1.31.1" + digest,
+		Etcd:                  "gcr.io/etcd-development/etcd:v3.5.16" + digest,
+		CoreDNS:               "registry.k8s.io/coredns/coredns:v1.11.3" + digest,
+	}
+
+	input, err := generate.NewInput("test", "https://10.0.1.5", constants.DefaultKubernetesVersion, append(suite.genOptions, generate.WithImages(images))...)
+	suite.Require().NoError(err)
+
+	cfg, err := input.Config(machine.TypeControlPlane)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(images.Kubelet, cfg.Machine().Kubelet().Image())
+	suite.Assert().Equal(images.KubeAPIServer, cfg.Cluster().APIServer().Image())
+	suite.Assert().Equal(images.KubeControllerManager, cfg.Cluster().ControllerManager().Image())
+	suite.Assert().Equal(images.KubeProxy, cfg.Cluster().Proxy().Image())
+	suite.Assert().Equal(images.KubeScheduler, cfg.Cluster().Scheduler().Image())
+	suite.Assert().Equal(images.Etcd, cfg.Cluster().Etcd().Image())
+	suite.Assert().Equal(images.CoreDNS, cfg.Cluster().CoreDNS().Image())
+
+	cfg, err = input.Config(machine.TypeWorker)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(images.Kubelet, cfg.Machine().Kubelet().Image())
+}
+
+func (suite *GenerateSuite) TestGenerateSecretsReference() {
+	input, err := generate.NewInput("test", "https://10.0.1.5", constants.DefaultKubernetesVersion,
+		append(suite.genOptions, generate.WithSecretsReference("production"))...)
+	suite.Require().NoError(err)
+
+	for _, machineType := range []machine.Type{machine.TypeControlPlane, machine.TypeWorker} {
+		cfg, err := input.Config(machineType)
+		suite.Require().NoError(err)
+
+		suite.Require().NotNil(cfg.SecretsReference())
+		suite.Assert().Equal("production", cfg.SecretsReference().SecretsBundleID())
+
+		suite.Assert().Empty(cfg.Machine().Security().Token())
+		suite.Assert().Empty(cfg.Machine().Security().IssuingCA().Key)
+		suite.Assert().Equal(input.Options.SecretsBundle.Certs.OS.Crt, cfg.Machine().Security().IssuingCA().Crt)
+		suite.Assert().Empty(cfg.Cluster().Secret())
+		suite.Assert().Empty(cfg.Cluster().IssuingCA().Key)
+
+		_, err = cfg.Validate(runtimeMode{false})
+		suite.Require().NoError(err)
+
+		resolved, err := generate.ResolveSecretsReference(cfg, input.Options.SecretsBundle)
+		suite.Require().NoError(err)
+
+		_, err = resolved.Validate(runtimeMode{false})
+		suite.Require().NoError(err)
+
+		expected, err := suite.input.Config(machineType)
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal(input.Options.SecretsBundle.TrustdInfo.Token, resolved.Machine().Security().Token())
+		suite.Assert().Equal(input.Options.SecretsBundle.Cluster.Secret, resolved.Cluster().Secret())
+		suite.Assert().Equal(expected.Machine().Type(), resolved.Machine().Type())
+
+		if machineType.IsControlPlane() {
+			suite.Assert().Equal(input.Options.SecretsBundle.Certs.OS.Key, resolved.Machine().Security().IssuingCA().Key)
+			suite.Assert().Equal(input.Options.SecretsBundle.Certs.K8s.Key, resolved.Cluster().IssuingCA().Key)
+			suite.Assert().Equal(input.Options.SecretsBundle.Certs.Etcd.Key, resolved.Cluster().Etcd().CA().Key)
+			suite.Assert().Equal(input.Options.SecretsBundle.Certs.K8sServiceAccount.Key, resolved.Cluster().ServiceAccount().Key)
+		}
+
+		// the secrets bundle is not modified
+		suite.Assert().NotEmpty(input.Options.SecretsBundle.Certs.OS.Key)
+	}
+
+	// the secrets bundle should match the configuration
+	cfg, err := input.Config(machine.TypeControlPlane)
+	suite.Require().NoError(err)
+
+	_, err = generate.ResolveSecretsReference(cfg, suite.input.Options.SecretsBundle)
+	suite.Require().Error(err)
+
+	input, err = generate.NewInput("test", "https://10.0.1.5", constants.DefaultKubernetesVersion,
+		append(suite.genOptions, generate.WithSecretsReference("-invalid"))...)
+	suite.Require().NoError(err)
+
+	_, err = input.Config(machine.TypeWorker)
+	suite.Require().Error(err)
+}
+
 func (suite *GenerateSuite) TestGenerateTalosconfigSuccess() {
 	cfg, err := suite.input.Talosconfig()
 	suite.Require().NoError(err)
@@ -133,6 +256,35 @@ func (suite *GenerateSuite) TestGenerateTalosconfigSuccess() {
 	suite.Equal([]string{string(role.Admin)}, cert.Subject.Organization)
 }
 
+func (suite *GenerateSuite) TestGenerateAdditionalControlPlaneEndpoints() {
+	input, err := generate.NewInput("test", "https://cp.example.com:6443", constants.DefaultKubernetesVersion,
+		append(slices.Clone(suite.genOptions),
+			generate.WithAdditionalSubjectAltNames([]string{"10.0.1.5"}),
+			generate.WithEndpointList([]string{"10.0.1.5"}),
+			generate.WithAdditionalControlPlaneEndpoints([]string{"10.0.1.5", "https://cp2.example.com:6443", "[2001:db8::1]:6443", "192.168.0.1"}),
+		)...,
+	)
+	suite.Require().NoError(err)
+
+	expectedSANs := []string{"10.0.1.5", "cp2.example.com", "2001:db8::1", "192.168.0.1"}
+
+	cfg, err := input.Config(machine.TypeControlPlane)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(expectedSANs, cfg.Machine().Security().CertSANs())
+	suite.Assert().Equal(append([]string{"cp.example.com"}, expectedSANs...), cfg.Cluster().CertSANs())
+
+	talosconfig, err := input.Talosconfig()
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(expectedSANs, talosconfig.Contexts[talosconfig.Context].Endpoints)
+
+	_, err = generate.NewInput("test", "https://cp.example.com:6443", constants.DefaultKubernetesVersion,
+		generate.WithAdditionalControlPlaneEndpoints([]string{"https://"}),
+	)
+	suite.Require().ErrorContains(err, "invalid control plane endpoint")
+}
+
 type runtimeMode struct {
 	requiresInstall bool
 }
@@ -35,7 +35,7 @@ func (in *Input) init() ([]config.Document, error) {
 	machine := &v1alpha1.MachineConfig{
 		MachineType: machine.TypeInit.String(),
 		MachineKubelet: &v1alpha1.KubeletConfig{
-			KubeletImage: emptyIf(fmt.Sprintf("%s:v%s", constants.KubeletImage, in.KubernetesVersion), in.KubernetesVersion),
+			KubeletImage: overrideIf(in.Options.Images.Kubelet, emptyIf(fmt.Sprintf("%s:v%s", constants.KubeletImage, in.KubernetesVersion), in.KubernetesVersion)),
 		},
 		MachineNetwork:  networkConfig,
 		MachineCA:       in.Options.SecretsBundle.Certs.OS,
@@ -44,7 +44,7 @@ func (in *Input) init() ([]config.Document, error) {
 		MachineInstall: &v1alpha1.InstallConfig{
 			InstallDisk:            in.Options.InstallDisk,
 			InstallImage:           in.Options.InstallImage,
-			InstallWipe:            pointer.To(false),
+			InstallWipe:            pointer.To(in.Options.InstallWipe),
 			InstallExtraKernelArgs: in.Options.InstallExtraKernelArgs,
 		},
 		MachineRegistries: v1alpha1.RegistriesConfig{
@@ -160,21 +160,22 @@ func (in *Input) init() ([]config.Document, error) {
 		},
 		APIServerConfig: &v1alpha1.APIServerConfig{
 			CertSANs:               certSANs,
-			ContainerImage:         emptyIf(fmt.Sprintf("%s:v%s", constants.KubernetesAPIServerImage, in.KubernetesVersion), in.KubernetesVersion),
+			ContainerImage:         overrideIf(in.Options.Images.KubeAPIServer, emptyIf(fmt.Sprintf("%s:v%s", constants.KubernetesAPIServerImage, in.KubernetesVersion), in.KubernetesVersion)),
 			AdmissionControlConfig: admissionControlConfig,
 			AuditPolicyConfig:      auditPolicyConfig,
 		},
 		ControllerManagerConfig: &v1alpha1.ControllerManagerConfig{
-			ContainerImage: emptyIf(fmt.Sprintf("%s:v%s", constants.KubernetesControllerManagerImage, in.KubernetesVersion), in.KubernetesVersion),
+			ContainerImage: overrideIf(in.Options.Images.KubeControllerManager, emptyIf(fmt.Sprintf("%s:v%s", constants.KubernetesControllerManagerImage, in.KubernetesVersion), in.KubernetesVersion)),
 		},
 		ProxyConfig: &v1alpha1.ProxyConfig{
-			ContainerImage: emptyIf(fmt.Sprintf("%s:v%s", constants.KubeProxyImage, in.KubernetesVersion), in.KubernetesVersion),
+			ContainerImage: overrideIf(in.Options.Images.KubeProxy, emptyIf(fmt.Sprintf("%s:v%s", constants.KubeProxyImage, in.KubernetesVersion), in.KubernetesVersion)),
 		},
 		SchedulerConfig: &v1alpha1.SchedulerConfig{
-			ContainerImage: emptyIf(fmt.Sprintf("%s:v%s", constants.KubernetesSchedulerImage, in.KubernetesVersion), in.KubernetesVersion),
+			ContainerImage: overrideIf(in.Options.Images.KubeScheduler, emptyIf(fmt.Sprintf("%s:v%s", constants.KubernetesSchedulerImage, in.KubernetesVersion), in.KubernetesVersion)),
 		},
 		EtcdConfig: &v1alpha1.EtcdConfig{
-			RootCA: in.Options.SecretsBundle.Certs.Etcd,
+			ContainerImage: in.Options.Images.Etcd,
+			RootCA:         in.Options.SecretsBundle.Certs.Etcd,
 		},
 		ClusterNetwork: &v1alpha1.ClusterNetworkConfig{
 			DNSDomain:     in.Options.DNSDomain,
@@ -190,6 +191,12 @@ func (in *Input) init() ([]config.Document, error) {
 		ClusterInlineManifests: v1alpha1.ClusterInlineManifests{},
 	}
 
+	if in.Options.Images.CoreDNS != "" {
+		cluster.CoreDNSConfig = &v1alpha1.CoreDNS{
+			CoreDNSImage: in.Options.Images.CoreDNS,
+		}
+	}
+
 	if in.Options.AllowSchedulingOnControlPlanes {
 		if in.Options.VersionContract.KubernetesAllowSchedulingOnControlPlanes() {
 			cluster.AllowSchedulingOnControlPlanes = pointer.To(in.Options.AllowSchedulingOnControlPlanes)
@@ -5,6 +5,13 @@
 package generate
 
 import (
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/go-pointer"
 
@@ -56,6 +63,15 @@ func WithInstallDisk(disk string) Option {
 	}
 }
 
+// WithInstallWipe specifies whether the install disk should be wiped before the installation.
+func WithInstallWipe(wipe bool) Option {
+	return func(o *Options) error {
+		o.InstallWipe = wipe
+
+		return nil
+	}
+}
+
 // WithAdditionalSubjectAltNames specifies additional SANs.
 func WithAdditionalSubjectAltNames(sans []string) Option {
 	return func(o *Options) error {
@@ -65,6 +81,27 @@ func WithAdditionalSubjectAltNames(sans []string) Option {
 	}
 }
 
+// WithAdditionalControlPlaneEndpoints specifies additional control plane endpoints (DNS names or IP addresses).
+//
+// The endpoints are added to the certificate SANs of the API server and Talos API, and to the talosconfig endpoints.
+// The endpoint might be specified as a plain host, host:port pair or URL, only the host part is used.
+func WithAdditionalControlPlaneEndpoints(endpoints []string) Option {
+	return func(o *Options) error {
+		for _, endpoint := range endpoints {
+			host, err := endpointHost(endpoint)
+			if err != nil {
+				return err
+			}
+
+			if !slices.Contains(o.AdditionalControlPlaneEndpoints, host) {
+				o.AdditionalControlPlaneEndpoints = append(o.AdditionalControlPlaneEndpoints, host)
+			}
+		}
+
+		return nil
+	}
+}
+
 // WithInstallImage specifies install container image to use in Talos cluster.
 func WithInstallImage(imageRef string) Option {
 	return func(o *Options) error {
@@ -74,6 +111,17 @@ func WithInstallImage(imageRef string) Option {
 	}
 }
 
+// WithImages overrides the default images of the Kubernetes components and etcd (e.g. with digest-pinned references).
+//
+// The installer image is set with WithInstallImage.
+func WithImages(images Images) Option {
+	return func(o *Options) error {
+		o.Images = images
+
+		return nil
+	}
+}
+
 // WithInstallExtraKernelArgs specifies extra kernel arguments to pass to the installer.
 func WithInstallExtraKernelArgs(args []string) Option {
 	return func(o *Options) error {
@@ -261,6 +309,18 @@ func WithSecretsBundle(bundle *secrets.Bundle) Option {
 	}
 }
 
+// WithSecretsReference omits the private keys and the shared secrets from the generated machine configuration.
+//
+// The generated configuration references the secrets bundle by ID instead, and the secrets
+// are resolved from the secrets bundle on the node when the configuration is applied.
+func WithSecretsReference(bundleID string) Option {
+	return func(o *Options) error {
+		o.SecretsReference = bundleID
+
+		return nil
+	}
+}
+
 // WithHostDNSForwardKubeDNSToHost specifies whether to forward kube-dns to host.
 func WithHostDNSForwardKubeDNSToHost(forward bool) Option {
 	return func(o *Options) error {
@@ -277,6 +337,9 @@ type Options struct {
 	// Custom secrets bundle.
 	SecretsBundle *secrets.Bundle
 
+	// Secrets bundle ID to reference instead of embedding the secrets.
+	SecretsReference string
+
 	// Base settings.
 	Debug   bool
 	Persist bool
@@ -284,6 +347,7 @@ type Options struct {
 	// Machine settings: install.
 	InstallDisk            string
 	InstallImage           string
+	InstallWipe            bool
 	InstallExtraKernelArgs []string
 
 	// Machine disks.
@@ -312,9 +376,28 @@ type Options struct {
 
 	HostDNSForwardKubeDNSToHost optional.Optional[bool]
 
+	// Additional control plane endpoints (hosts).
+	AdditionalControlPlaneEndpoints []string
+
 	// Client options.
 	Roles        role.Set
 	EndpointList []string
+
+	// Images overrides.
+	Images Images
+}
+
+// Images overrides the default images of the Kubernetes components and etcd.
+//
+// Empty fields keep the default images.
+type Images struct {
+	Kubelet               string
+	KubeAPIServer         string
+	KubeControllerManager string
+	KubeProxy             string
+	KubeScheduler         string
+	Etcd                  string
+	CoreDNS               string
 }
 
 // DefaultOptions returns default options.
@@ -325,3 +408,31 @@ func DefaultOptions() Options {
 		Roles:     role.MakeSet(role.Admin),
 	}
 }
+
+// endpointHost extracts the host out of the endpoint specified as a plain host, host:port pair or URL.
+func endpointHost(endpoint string) (string, error) {
+	endpoint = strings.TrimSpace(endpoint)
+
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Hostname() == "" {
+			return "", fmt.Errorf("invalid control plane endpoint %q", endpoint)
+		}
+
+		return u.Hostname(), nil
+	}
+
+	if host, _, err := net.SplitHostPort(endpoint); err == nil {
+		endpoint = host
+	}
+
+	if addr, err := netip.ParseAddr(strings.Trim(endpoint, "[]")); err == nil {
+		return addr.String(), nil
+	}
+
+	if endpoint == "" || strings.ContainsAny(endpoint, "/:[] ") {
+		return "", fmt.Errorf("invalid control plane endpoint %q", endpoint)
+	}
+
+	return endpoint, nil
+}
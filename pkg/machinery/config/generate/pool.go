@@ -0,0 +1,141 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package generate
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+
+	coreconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	v1alpha1 "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+var machinePoolNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// MachinePool describes the overrides of the worker machine config for a named pool of machines.
+//
+// Machine pools allow generating distinct worker configs for heterogeneous hardware,
+// e.g. GPU nodes with a different install disk and tainted to run only GPU workloads.
+type MachinePool struct {
+	Name             string            `yaml:"name"`
+	NodeLabels       map[string]string `yaml:"nodeLabels,omitempty"`
+	NodeTaints       map[string]string `yaml:"nodeTaints,omitempty"`
+	KubeletExtraArgs map[string]string `yaml:"kubeletExtraArgs,omitempty"`
+	InstallDisk      string            `yaml:"installDisk,omitempty"`
+}
+
+// Validate the machine pool.
+func (pool *MachinePool) Validate() error {
+	if !machinePoolNameRegexp.MatchString(pool.Name) {
+		return fmt.Errorf("invalid machine pool name %q: should be a lowercase RFC 1123 label", pool.Name)
+	}
+
+	return nil
+}
+
+// Apply returns a copy of the worker machine config with the machine pool overrides applied.
+//
+// The overrides are merged with the node labels, taints and kubelet extra args already present in the config.
+func (pool *MachinePool) Apply(cfg coreconfig.Provider) (coreconfig.Provider, error) {
+	if err := pool.Validate(); err != nil {
+		return nil, err
+	}
+
+	if cfg.Machine().Type() != machine.TypeWorker {
+		return nil, fmt.Errorf("machine pool %q can only be applied to a worker config", pool.Name)
+	}
+
+	return cfg.PatchV1Alpha1(func(v1alpha1Config *v1alpha1.Config) error {
+		if v1alpha1Config.MachineConfig == nil {
+			return errors.New("machine config is missing")
+		}
+
+		machineConfig := v1alpha1Config.MachineConfig
+
+		if len(pool.NodeLabels) > 0 {
+			if machineConfig.MachineNodeLabels == nil {
+				machineConfig.MachineNodeLabels = map[string]string{}
+			}
+
+			maps.Copy(machineConfig.MachineNodeLabels, pool.NodeLabels)
+		}
+
+		if len(pool.NodeTaints) > 0 {
+			if machineConfig.MachineNodeTaints == nil {
+				machineConfig.MachineNodeTaints = map[string]string{}
+			}
+
+			maps.Copy(machineConfig.MachineNodeTaints, pool.NodeTaints)
+		}
+
+		if len(pool.KubeletExtraArgs) > 0 {
+			if machineConfig.MachineKubelet == nil {
+				machineConfig.MachineKubelet = &v1alpha1.KubeletConfig{}
+			}
+
+			if machineConfig.MachineKubelet.KubeletExtraArgs == nil {
+				machineConfig.MachineKubelet.KubeletExtraArgs = map[string]string{}
+			}
+
+			maps.Copy(machineConfig.MachineKubelet.KubeletExtraArgs, pool.KubeletExtraArgs)
+		}
+
+		if pool.InstallDisk != "" {
+			if machineConfig.MachineInstall == nil {
+				machineConfig.MachineInstall = &v1alpha1.InstallConfig{}
+			}
+
+			machineConfig.MachineInstall.InstallDisk = pool.InstallDisk
+		}
+
+		return nil
+	})
+}
+
+// PoolConfig returns the worker machine config for the machine pool.
+func (in *Input) PoolConfig(pool MachinePool) (coreconfig.Provider, error) {
+	cfg, err := in.Config(machine.TypeWorker)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool.Apply(cfg)
+}
+
+// LoadMachinePools loads the list of machine pools from the YAML file.
+func LoadMachinePools(path string) ([]MachinePool, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []MachinePool
+
+	if err = yaml.Unmarshal(contents, &pools); err != nil {
+		return nil, fmt.Errorf("error decoding machine pools %q: %w", path, err)
+	}
+
+	seen := map[string]struct{}{}
+
+	for _, pool := range pools {
+		if err = pool.Validate(); err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[pool.Name]; ok {
+			return nil, fmt.Errorf("duplicate machine pool name %q", pool.Name)
+		}
+
+		seen[pool.Name] = struct{}{}
+	}
+
+	return pools, nil
+}
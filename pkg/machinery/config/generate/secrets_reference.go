@@ -0,0 +1,182 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package generate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/crypto/x509"
+
+	coreconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+	v1alpha1 "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// referenceSecrets removes the secrets from the generated v1alpha1 config and adds the secrets reference document.
+//
+// The certificates are kept, so that the configuration can be verified against the secrets bundle.
+func (in *Input) referenceSecrets(documents []config.Document) ([]config.Document, error) {
+	for _, doc := range documents {
+		v1alpha1Config, ok := doc.(*v1alpha1.Config)
+		if !ok {
+			continue
+		}
+
+		machineConfig := v1alpha1Config.MachineConfig
+		machineConfig.MachineToken = ""
+		machineConfig.MachineCA = withoutKey(machineConfig.MachineCA)
+
+		clusterConfig := v1alpha1Config.ClusterConfig
+		clusterConfig.ClusterSecret = ""
+		clusterConfig.BootstrapToken = ""
+		clusterConfig.ClusterAESCBCEncryptionSecret = ""
+		clusterConfig.ClusterSecretboxEncryptionSecret = ""
+		clusterConfig.ClusterCA = withoutKey(clusterConfig.ClusterCA)
+		clusterConfig.ClusterAggregatorCA = withoutKey(clusterConfig.ClusterAggregatorCA)
+		clusterConfig.ClusterServiceAccount = nil
+
+		if clusterConfig.EtcdConfig != nil {
+			clusterConfig.EtcdConfig.RootCA = withoutKey(clusterConfig.EtcdConfig.RootCA)
+		}
+	}
+
+	secretsReference := security.NewSecretsReferenceConfigV1Alpha1()
+	secretsReference.BundleID = in.Options.SecretsReference
+
+	if _, err := secretsReference.Validate(nil); err != nil {
+		return nil, err
+	}
+
+	return append(documents, secretsReference), nil
+}
+
+// withoutKey returns a copy of the certificate without the key, the original certificate is shared with the secrets bundle.
+func withoutKey(ca *x509.PEMEncodedCertificateAndKey) *x509.PEMEncodedCertificateAndKey {
+	if ca == nil {
+		return nil
+	}
+
+	return &x509.PEMEncodedCertificateAndKey{Crt: ca.Crt}
+}
+
+// ResolveSecretsReference fills the secrets omitted from the machine configuration from the secrets bundle.
+//
+// The secrets which are set in the machine configuration are kept as is.
+// The certificates in the machine configuration should match the certificates in the secrets bundle.
+func ResolveSecretsReference(cfg coreconfig.Provider, bundle *secrets.Bundle) (coreconfig.Provider, error) {
+	if cfg.RawV1Alpha1() == nil {
+		return nil, errors.New("secrets can't be resolved without the v1alpha1 config document")
+	}
+
+	v1alpha1Config := cfg.RawV1Alpha1().DeepCopy()
+
+	if v1alpha1Config.MachineConfig == nil || v1alpha1Config.ClusterConfig == nil {
+		return nil, errors.New("secrets can't be resolved without the machine and cluster configuration")
+	}
+
+	machineConfig := v1alpha1Config.MachineConfig
+	clusterConfig := v1alpha1Config.ClusterConfig
+
+	// Talos API CA is present in all machine types, so it identifies the secrets bundle
+	if err := checkCertificate("Talos API CA", machineConfig.MachineCA, bundle.Certs.OS); err != nil {
+		return nil, err
+	}
+
+	setIfEmpty(&machineConfig.MachineToken, bundle.TrustdInfo.Token)
+	setIfEmpty(&clusterConfig.ClusterSecret, bundle.Cluster.Secret)
+	setIfEmpty(&clusterConfig.BootstrapToken, bundle.Secrets.BootstrapToken)
+
+	if v1alpha1Config.Machine().Type().IsControlPlane() {
+		for _, ca := range []struct {
+			name   string
+			dst    **x509.PEMEncodedCertificateAndKey
+			bundle *x509.PEMEncodedCertificateAndKey
+		}{
+			{"Talos API CA", &machineConfig.MachineCA, bundle.Certs.OS},
+			{"Kubernetes CA", &clusterConfig.ClusterCA, bundle.Certs.K8s},
+			{"Kubernetes aggregator CA", &clusterConfig.ClusterAggregatorCA, bundle.Certs.K8sAggregator},
+		} {
+			if err := fillKey(ca.name, ca.dst, ca.bundle); err != nil {
+				return nil, err
+			}
+		}
+
+		if clusterConfig.EtcdConfig == nil {
+			clusterConfig.EtcdConfig = &v1alpha1.EtcdConfig{}
+		}
+
+		if err := fillKey("etcd CA", &clusterConfig.EtcdConfig.RootCA, bundle.Certs.Etcd); err != nil {
+			return nil, err
+		}
+
+		if clusterConfig.ClusterServiceAccount == nil && bundle.Certs.K8sServiceAccount != nil {
+			clusterConfig.ClusterServiceAccount = &x509.PEMEncodedKey{Key: bundle.Certs.K8sServiceAccount.Key}
+		}
+
+		// both encryption providers are configured for the API server if both secrets are set
+		if clusterConfig.ClusterAESCBCEncryptionSecret == "" && clusterConfig.ClusterSecretboxEncryptionSecret == "" {
+			clusterConfig.ClusterAESCBCEncryptionSecret = bundle.Secrets.AESCBCEncryptionSecret
+			clusterConfig.ClusterSecretboxEncryptionSecret = bundle.Secrets.SecretboxEncryptionSecret
+		}
+	}
+
+	documents := cfg.Documents()
+
+	for i, doc := range documents {
+		if _, ok := doc.(*v1alpha1.Config); ok {
+			documents[i] = v1alpha1Config
+		}
+	}
+
+	return container.New(documents...)
+}
+
+func setIfEmpty(dst *string, value string) {
+	if *dst == "" {
+		*dst = value
+	}
+}
+
+func checkCertificate(name string, ca, bundleCA *x509.PEMEncodedCertificateAndKey) error {
+	if ca == nil || len(ca.Crt) == 0 || bundleCA == nil {
+		return nil
+	}
+
+	if !bytes.Equal(ca.Crt, bundleCA.Crt) {
+		return fmt.Errorf("secrets bundle doesn't match the machine configuration: %s certificate differs", name)
+	}
+
+	return nil
+}
+
+func fillKey(name string, dst **x509.PEMEncodedCertificateAndKey, bundleCA *x509.PEMEncodedCertificateAndKey) error {
+	if bundleCA == nil {
+		return nil
+	}
+
+	if *dst == nil {
+		*dst = &x509.PEMEncodedCertificateAndKey{Crt: bundleCA.Crt, Key: bundleCA.Key}
+
+		return nil
+	}
+
+	if len((*dst).Key) > 0 {
+		return nil
+	}
+
+	if err := checkCertificate(name, *dst, bundleCA); err != nil {
+		return err
+	}
+
+	(*dst).Crt = bundleCA.Crt
+	(*dst).Key = bundleCA.Key
+
+	return nil
+}
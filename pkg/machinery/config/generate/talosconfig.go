@@ -5,6 +5,8 @@
 package generate
 
 import (
+	"slices"
+
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
 )
 
@@ -15,5 +17,20 @@ func (in *Input) Talosconfig() (*clientconfig.Config, error) {
 		return nil, err
 	}
 
-	return clientconfig.NewConfig(in.ClusterName, in.Options.EndpointList, in.Options.SecretsBundle.Certs.OS.Crt, clientcert), nil
+	return clientconfig.NewConfig(in.ClusterName, in.ClientEndpoints(), in.Options.SecretsBundle.Certs.OS.Crt, clientcert), nil
+}
+
+// ClientEndpoints returns the endpoints of the Talos API to be used in the talosconfig.
+//
+// The additional control plane endpoints are appended to the endpoint list.
+func (in *Input) ClientEndpoints() []string {
+	endpoints := slices.Clone(in.Options.EndpointList)
+
+	for _, host := range in.Options.AdditionalControlPlaneEndpoints {
+		if !slices.Contains(endpoints, host) {
+			endpoints = append(endpoints, host)
+		}
+	}
+
+	return endpoints
 }
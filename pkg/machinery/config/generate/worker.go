@@ -38,14 +38,14 @@ func (in *Input) worker() ([]config.Document, error) {
 		MachineToken:    in.Options.SecretsBundle.TrustdInfo.Token,
 		MachineCertSANs: in.AdditionalMachineCertSANs,
 		MachineKubelet: &v1alpha1.KubeletConfig{
-			KubeletImage: emptyIf(fmt.Sprintf("%s:v%s", constants.KubeletImage, in.KubernetesVersion), in.KubernetesVersion),
+			KubeletImage: overrideIf(in.Options.Images.Kubelet, emptyIf(fmt.Sprintf("%s:v%s", constants.KubeletImage, in.KubernetesVersion), in.KubernetesVersion)),
 		},
 		MachineNetwork: networkConfig,
 		MachineCA:      &x509.PEMEncodedCertificateAndKey{Crt: in.Options.SecretsBundle.Certs.OS.Crt},
 		MachineInstall: &v1alpha1.InstallConfig{
 			InstallDisk:            in.Options.InstallDisk,
 			InstallImage:           in.Options.InstallImage,
-			InstallWipe:            pointer.To(false),
+			InstallWipe:            pointer.To(in.Options.InstallWipe),
 			InstallExtraKernelArgs: in.Options.InstallExtraKernelArgs,
 		},
 		MachineRegistries: v1alpha1.RegistriesConfig{
@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// ConntrackConfigKind is a conntrack config document kind.
+const ConntrackConfigKind = "NetworkConntrackConfig"
+
+func init() {
+	registry.Register(ConntrackConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &ConntrackConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.ConntrackConfig = &ConntrackConfigV1Alpha1{}
+	_ config.Validator       = &ConntrackConfigV1Alpha1{}
+)
+
+// ConntrackConfigV1Alpha1 is a netfilter connection tracking configuration document.
+//
+//	examples:
+//	  - value: exampleConntrackConfigV1Alpha1()
+//	alias: NetworkConntrackConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/NetworkConntrackConfig
+type ConntrackConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Maximum number of entries in the connection tracking table (`net.netfilter.nf_conntrack_max`).
+	//
+	//     If not set, the kernel default is used.
+	//   examples:
+	//     - value: 262144
+	ConntrackMax uint32 `yaml:"max,omitempty"`
+	//   description: |
+	//     Size of the connection tracking hash table (`net.netfilter.nf_conntrack_buckets`).
+	//
+	//     If not set, the kernel default is used.
+	//   examples:
+	//     - value: 65536
+	ConntrackBuckets uint32 `yaml:"buckets,omitempty"`
+	//   description: |
+	//     Timeout for established TCP connections in the connection tracking table
+	//     (`net.netfilter.nf_conntrack_tcp_timeout_established`).
+	//
+	//     If not set, the kernel default is used.
+	//   examples:
+	//     - value: >
+	//        "24h"
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ConntrackTCPEstablishedTimeout time.Duration `yaml:"tcpEstablishedTimeout,omitempty"`
+}
+
+// NewConntrackConfigV1Alpha1 creates a new ConntrackConfig config document.
+func NewConntrackConfigV1Alpha1() *ConntrackConfigV1Alpha1 {
+	return &ConntrackConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ConntrackConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleConntrackConfigV1Alpha1() *ConntrackConfigV1Alpha1 {
+	cfg := NewConntrackConfigV1Alpha1()
+	cfg.ConntrackMax = 262144
+	cfg.ConntrackBuckets = 65536
+	cfg.ConntrackTCPEstablishedTimeout = 24 * time.Hour
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *ConntrackConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Max implements config.ConntrackConfig interface.
+func (s *ConntrackConfigV1Alpha1) Max() uint32 {
+	return s.ConntrackMax
+}
+
+// Buckets implements config.ConntrackConfig interface.
+func (s *ConntrackConfigV1Alpha1) Buckets() uint32 {
+	return s.ConntrackBuckets
+}
+
+// TCPEstablishedTimeout implements config.ConntrackConfig interface.
+func (s *ConntrackConfigV1Alpha1) TCPEstablishedTimeout() time.Duration {
+	return s.ConntrackTCPEstablishedTimeout
+}
+
+// Validate implements config.Validator interface.
+func (s *ConntrackConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var warnings []string
+
+	if s.ConntrackTCPEstablishedTimeout < 0 {
+		return nil, errors.New("tcpEstablishedTimeout: negative value")
+	}
+
+	if s.ConntrackTCPEstablishedTimeout != 0 && s.ConntrackTCPEstablishedTimeout < time.Second {
+		return nil, errors.New("tcpEstablishedTimeout: minimum value is 1s")
+	}
+
+	if s.ConntrackMax != 0 && s.ConntrackBuckets > s.ConntrackMax {
+		warnings = append(warnings, "buckets is greater than max, some hash table buckets will never be used")
+	}
+
+	return warnings, nil
+}
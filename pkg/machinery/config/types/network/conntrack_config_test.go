@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/conntrackconfig.yaml
+var expectedConntrackConfigDocument []byte
+
+func TestConntrackConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewConntrackConfigV1Alpha1()
+	cfg.ConntrackMax = 262144
+	cfg.ConntrackBuckets = 65536
+	cfg.ConntrackTCPEstablishedTimeout = 24 * time.Hour
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedConntrackConfigDocument, marshaled)
+}
+
+func TestConntrackConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedConntrackConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.ConntrackConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.ConntrackConfigKind,
+		},
+		ConntrackMax:                   262144,
+		ConntrackBuckets:               65536,
+		ConntrackTCPEstablishedTimeout: 24 * time.Hour,
+	}, docs[0])
+}
+
+func TestConntrackConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.ConntrackConfigV1Alpha1
+
+		expectedWarnings []string
+		expectedError    string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewConntrackConfigV1Alpha1,
+		},
+		{
+			name: "valid",
+			cfg: func() *network.ConntrackConfigV1Alpha1 {
+				cfg := network.NewConntrackConfigV1Alpha1()
+				cfg.ConntrackMax = 1024
+				cfg.ConntrackBuckets = 256
+				cfg.ConntrackTCPEstablishedTimeout = time.Hour
+
+				return cfg
+			},
+		},
+		{
+			name: "short timeout",
+			cfg: func() *network.ConntrackConfigV1Alpha1 {
+				cfg := network.NewConntrackConfigV1Alpha1()
+				cfg.ConntrackTCPEstablishedTimeout = time.Millisecond
+
+				return cfg
+			},
+			expectedError: "tcpEstablishedTimeout: minimum value is 1s",
+		},
+		{
+			name: "buckets over max",
+			cfg: func() *network.ConntrackConfigV1Alpha1 {
+				cfg := network.NewConntrackConfigV1Alpha1()
+				cfg.ConntrackMax = 1024
+				cfg.ConntrackBuckets = 2048
+
+				return cfg
+			},
+			expectedWarnings: []string{"buckets is greater than max, some hash table buckets will never be used"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type DefaultActionConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type RuleConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type ConntrackConfigV1Alpha1 -type DefaultActionConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type RouterAdvertisementConfigV1Alpha1 -type RuleConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -10,6 +10,12 @@ import (
 	"net/netip"
 )
 
+// DeepCopy generates a deep copy of *ConntrackConfigV1Alpha1.
+func (o *ConntrackConfigV1Alpha1) DeepCopy() *ConntrackConfigV1Alpha1 {
+	var cp ConntrackConfigV1Alpha1 = *o
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *DefaultActionConfigV1Alpha1.
 func (o *DefaultActionConfigV1Alpha1) DeepCopy() *DefaultActionConfigV1Alpha1 {
 	var cp DefaultActionConfigV1Alpha1 = *o
@@ -26,6 +32,16 @@ func (o *KubespanEndpointsConfigV1Alpha1) DeepCopy() *KubespanEndpointsConfigV1A
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *RouterAdvertisementConfigV1Alpha1.
+func (o *RouterAdvertisementConfigV1Alpha1) DeepCopy() *RouterAdvertisementConfigV1Alpha1 {
+	var cp RouterAdvertisementConfigV1Alpha1 = *o
+	if o.RAPrefixes != nil {
+		cp.RAPrefixes = make([]RouterAdvertisementPrefix, len(o.RAPrefixes))
+		copy(cp.RAPrefixes, o.RAPrefixes)
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *RuleConfigV1Alpha1.
 func (o *RuleConfigV1Alpha1) DeepCopy() *RuleConfigV1Alpha1 {
 	var cp RuleConfigV1Alpha1 = *o
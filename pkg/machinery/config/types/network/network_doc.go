@@ -12,6 +12,46 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 )
 
+func (ConntrackConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NetworkConntrackConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkConntrackConfig is a netfilter connection tracking configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NetworkConntrackConfig is a netfilter connection tracking configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "max",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Maximum number of entries in the connection tracking table (`net.netfilter.nf_conntrack_max`).\n\nIf not set, the kernel default is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum number of entries in the connection tracking table (`net.netfilter.nf_conntrack_max`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "buckets",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Size of the connection tracking hash table (`net.netfilter.nf_conntrack_buckets`).\n\nIf not set, the kernel default is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Size of the connection tracking hash table (`net.netfilter.nf_conntrack_buckets`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tcpEstablishedTimeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout for established TCP connections in the connection tracking table\n(`net.netfilter.nf_conntrack_tcp_timeout_established`).\n\nIf not set, the kernel default is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout for established TCP connections in the connection tracking table" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleConntrackConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", 262144)
+	doc.Fields[2].AddExample("", 65536)
+	doc.Fields[3].AddExample("", "24h")
+
+	return doc
+}
+
 func (DefaultActionConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "NetworkDefaultActionConfig",
@@ -58,6 +98,93 @@ func (KubespanEndpointsConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (RouterAdvertisementConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "RouterAdvertisementConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "RouterAdvertisementConfig is a config document to send IPv6 router advertisements on a link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "RouterAdvertisementConfig is a config document to send IPv6 router advertisements on a link.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link (interface) to send router advertisements on.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link (interface) to send router advertisements on." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "interval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Maximum interval between the unsolicited router advertisements.\n\nShould be between 4s and 1800s, defaults to 200s.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum interval between the unsolicited router advertisements." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "routerLifetime",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Lifetime of the default route announced in the router advertisements.\n\nShould be at least the interval, and at most 9000s, defaults to three times the interval.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Lifetime of the default route announced in the router advertisements." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "prefixes",
+				Type:        "[]RouterAdvertisementPrefix",
+				Note:        "",
+				Description: "List of the prefixes announced in the router advertisements.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of the prefixes announced in the router advertisements." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleRouterAdvertisementConfigV1Alpha1())
+
+	doc.Fields[2].AddExample("", "200s")
+	doc.Fields[3].AddExample("", "30m")
+
+	return doc
+}
+
+func (RouterAdvertisementPrefix) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "RouterAdvertisementPrefix",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "RouterAdvertisementPrefix is a prefix announced in the router advertisements." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "RouterAdvertisementPrefix is a prefix announced in the router advertisements.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "RouterAdvertisementConfigV1Alpha1",
+				FieldName: "prefixes",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "prefix",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "IPv6 prefix, should be /64 for the stateless address autoconfiguration.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "IPv6 prefix, should be /64 for the stateless address autoconfiguration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "validLifetime",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Valid lifetime of the prefix, defaults to 24h.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Valid lifetime of the prefix, defaults to 24h." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "preferredLifetime",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Preferred lifetime of the prefix, defaults to 4h.\n\nShould not exceed the valid lifetime.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Preferred lifetime of the prefix, defaults to 4h." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", netip.MustParsePrefix("2001:db8:1::/64"))
+
+	return doc
+}
+
 func (RuleConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "NetworkRuleConfig",
@@ -177,8 +304,11 @@ func GetFileDoc() *encoder.FileDoc {
 		Name:        "network",
 		Description: "Package network provides network machine configuration documents.\n",
 		Structs: []*encoder.Doc{
+			ConntrackConfigV1Alpha1{}.Doc(),
 			DefaultActionConfigV1Alpha1{}.Doc(),
 			KubespanEndpointsConfigV1Alpha1{}.Doc(),
+			RouterAdvertisementConfigV1Alpha1{}.Doc(),
+			RouterAdvertisementPrefix{}.Doc(),
 			RuleConfigV1Alpha1{}.Doc(),
 			RulePortSelector{}.Doc(),
 			IngressRule{}.Doc(),
@@ -0,0 +1,252 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// RouterAdvertisementConfigKind is a router advertisement config document kind.
+const RouterAdvertisementConfigKind = "RouterAdvertisementConfig"
+
+func init() {
+	registry.Register(RouterAdvertisementConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &RouterAdvertisementConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.RouterAdvertisementConfig = &RouterAdvertisementConfigV1Alpha1{}
+	_ config.NamedDocument             = &RouterAdvertisementConfigV1Alpha1{}
+	_ config.Validator                 = &RouterAdvertisementConfigV1Alpha1{}
+)
+
+// Router advertisement defaults and limits (RFC 4861).
+const (
+	DefaultRouterAdvertisementInterval          = 200 * time.Second
+	DefaultRouterAdvertisementValidLifetime     = 24 * time.Hour
+	DefaultRouterAdvertisementPreferredLifetime = 4 * time.Hour
+
+	minRouterAdvertisementInterval = 4 * time.Second
+	maxRouterAdvertisementInterval = 1800 * time.Second
+	maxRouterLifetime              = 9000 * time.Second
+)
+
+// RouterAdvertisementConfigV1Alpha1 is a config document to send IPv6 router advertisements on a link.
+//
+//	examples:
+//	  - value: exampleRouterAdvertisementConfigV1Alpha1()
+//	alias: RouterAdvertisementConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/RouterAdvertisementConfig
+type RouterAdvertisementConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the link (interface) to send router advertisements on.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Maximum interval between the unsolicited router advertisements.
+	//
+	//     Should be between 4s and 1800s, defaults to 200s.
+	//   examples:
+	//     - value: >
+	//        "200s"
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	RAInterval time.Duration `yaml:"interval,omitempty"`
+	//   description: |
+	//     Lifetime of the default route announced in the router advertisements.
+	//
+	//     Should be at least the interval, and at most 9000s, defaults to three times the interval.
+	//   examples:
+	//     - value: >
+	//        "30m"
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	RARouterLifetime time.Duration `yaml:"routerLifetime,omitempty"`
+	//   description: |
+	//     List of the prefixes announced in the router advertisements.
+	//   schemaRequired: true
+	RAPrefixes []RouterAdvertisementPrefix `yaml:"prefixes" merge:"replace"`
+}
+
+// RouterAdvertisementPrefix is a prefix announced in the router advertisements.
+type RouterAdvertisementPrefix struct {
+	//   description: |
+	//     IPv6 prefix, should be /64 for the stateless address autoconfiguration.
+	//   examples:
+	//    - value: >
+	//       netip.MustParsePrefix("2001:db8:1::/64")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f:]+/\d{1,3}$
+	PrefixNetwork netip.Prefix `yaml:"prefix"`
+	//   description: |
+	//     Valid lifetime of the prefix, defaults to 24h.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	PrefixValidLifetime time.Duration `yaml:"validLifetime,omitempty"`
+	//   description: |
+	//     Preferred lifetime of the prefix, defaults to 4h.
+	//
+	//     Should not exceed the valid lifetime.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	PrefixPreferredLifetime time.Duration `yaml:"preferredLifetime,omitempty"`
+}
+
+// NewRouterAdvertisementConfigV1Alpha1 creates a new RouterAdvertisementConfig config document.
+func NewRouterAdvertisementConfigV1Alpha1() *RouterAdvertisementConfigV1Alpha1 {
+	return &RouterAdvertisementConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       RouterAdvertisementConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleRouterAdvertisementConfigV1Alpha1() *RouterAdvertisementConfigV1Alpha1 {
+	cfg := NewRouterAdvertisementConfigV1Alpha1()
+	cfg.MetaName = "eth1"
+	cfg.RAInterval = 200 * time.Second
+	cfg.RAPrefixes = []RouterAdvertisementPrefix{
+		{
+			PrefixNetwork:           netip.MustParsePrefix("2001:db8:1::/64"),
+			PrefixValidLifetime:     24 * time.Hour,
+			PrefixPreferredLifetime: 4 * time.Hour,
+		},
+	}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *RouterAdvertisementConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *RouterAdvertisementConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *RouterAdvertisementConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var warnings []string
+
+	if s.MetaName == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if s.RAInterval != 0 && (s.RAInterval < minRouterAdvertisementInterval || s.RAInterval > maxRouterAdvertisementInterval) {
+		return nil, fmt.Errorf("interval should be between %s and %s", minRouterAdvertisementInterval, maxRouterAdvertisementInterval)
+	}
+
+	if s.RARouterLifetime != 0 && (s.RARouterLifetime < s.Interval() || s.RARouterLifetime > maxRouterLifetime) {
+		return nil, fmt.Errorf("routerLifetime should be between the interval and %s", maxRouterLifetime)
+	}
+
+	if len(s.RAPrefixes) == 0 {
+		return nil, errors.New("at least one prefix is required")
+	}
+
+	for _, prefix := range s.RAPrefixes {
+		if !prefix.PrefixNetwork.IsValid() || !prefix.PrefixNetwork.Addr().Is6() || prefix.PrefixNetwork.Addr().Is4In6() {
+			return nil, fmt.Errorf("invalid IPv6 prefix: %s", prefix.PrefixNetwork)
+		}
+
+		if prefix.PrefixNetwork.Bits() != 64 {
+			warnings = append(warnings, fmt.Sprintf("prefix %s is not /64, it can't be used for the stateless address autoconfiguration", prefix.PrefixNetwork))
+		}
+
+		if prefix.PrefixValidLifetime < 0 || prefix.PrefixPreferredLifetime < 0 {
+			return nil, fmt.Errorf("prefix %s: negative lifetime", prefix.PrefixNetwork)
+		}
+
+		if prefix.PreferredLifetime() > prefix.ValidLifetime() {
+			return nil, fmt.Errorf("prefix %s: preferredLifetime should not exceed validLifetime", prefix.PrefixNetwork)
+		}
+	}
+
+	return warnings, nil
+}
+
+// Interface implements config.RouterAdvertisementConfig interface.
+func (s *RouterAdvertisementConfigV1Alpha1) Interface() string {
+	return s.MetaName
+}
+
+// Interval implements config.RouterAdvertisementConfig interface.
+func (s *RouterAdvertisementConfigV1Alpha1) Interval() time.Duration {
+	if s.RAInterval == 0 {
+		return DefaultRouterAdvertisementInterval
+	}
+
+	return s.RAInterval
+}
+
+// RouterLifetime implements config.RouterAdvertisementConfig interface.
+func (s *RouterAdvertisementConfigV1Alpha1) RouterLifetime() time.Duration {
+	if s.RARouterLifetime == 0 {
+		return min(3*s.Interval(), maxRouterLifetime)
+	}
+
+	return s.RARouterLifetime
+}
+
+// Prefixes implements config.RouterAdvertisementConfig interface.
+func (s *RouterAdvertisementConfigV1Alpha1) Prefixes() []config.RouterAdvertisementPrefix {
+	return xslices.Map(s.RAPrefixes, func(prefix RouterAdvertisementPrefix) config.RouterAdvertisementPrefix {
+		return prefix
+	})
+}
+
+// Prefix implements config.RouterAdvertisementPrefix interface.
+func (prefix RouterAdvertisementPrefix) Prefix() netip.Prefix {
+	return prefix.PrefixNetwork
+}
+
+// ValidLifetime implements config.RouterAdvertisementPrefix interface.
+func (prefix RouterAdvertisementPrefix) ValidLifetime() time.Duration {
+	if prefix.PrefixValidLifetime == 0 {
+		return DefaultRouterAdvertisementValidLifetime
+	}
+
+	return prefix.PrefixValidLifetime
+}
+
+// PreferredLifetime implements config.RouterAdvertisementPrefix interface.
+func (prefix RouterAdvertisementPrefix) PreferredLifetime() time.Duration {
+	if prefix.PrefixPreferredLifetime == 0 {
+		return min(DefaultRouterAdvertisementPreferredLifetime, prefix.ValidLifetime())
+	}
+
+	return prefix.PrefixPreferredLifetime
+}
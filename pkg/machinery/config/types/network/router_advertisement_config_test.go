@@ -0,0 +1,234 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/routeradvertisementconfig.yaml
+var expectedRouterAdvertisementConfigDocument []byte
+
+func TestRouterAdvertisementConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewRouterAdvertisementConfigV1Alpha1()
+	cfg.MetaName = "eth1"
+	cfg.RAInterval = 200 * time.Second
+	cfg.RAPrefixes = []network.RouterAdvertisementPrefix{
+		{
+			PrefixNetwork:           netip.MustParsePrefix("2001:db8:1::/64"),
+			PrefixValidLifetime:     24 * time.Hour,
+			PrefixPreferredLifetime: 4 * time.Hour,
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedRouterAdvertisementConfigDocument, marshaled)
+}
+
+func TestRouterAdvertisementConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedRouterAdvertisementConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.RouterAdvertisementConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.RouterAdvertisementConfigKind,
+		},
+		MetaName:   "eth1",
+		RAInterval: 200 * time.Second,
+		RAPrefixes: []network.RouterAdvertisementPrefix{
+			{
+				PrefixNetwork:           netip.MustParsePrefix("2001:db8:1::/64"),
+				PrefixValidLifetime:     24 * time.Hour,
+				PrefixPreferredLifetime: 4 * time.Hour,
+			},
+		},
+	}, docs[0])
+
+	require.Len(t, provider.RouterAdvertisements(), 1)
+
+	raConfig := provider.RouterAdvertisements()[0]
+
+	assert.Equal(t, "eth1", raConfig.Interface())
+	assert.Equal(t, 200*time.Second, raConfig.Interval())
+	assert.Equal(t, 600*time.Second, raConfig.RouterLifetime())
+}
+
+func TestRouterAdvertisementConfigDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewRouterAdvertisementConfigV1Alpha1()
+	cfg.MetaName = "eth1"
+	cfg.RAPrefixes = []network.RouterAdvertisementPrefix{
+		{
+			PrefixNetwork:       netip.MustParsePrefix("2001:db8:1::/64"),
+			PrefixValidLifetime: time.Hour,
+		},
+	}
+
+	assert.Equal(t, network.DefaultRouterAdvertisementInterval, cfg.Interval())
+	assert.Equal(t, 3*network.DefaultRouterAdvertisementInterval, cfg.RouterLifetime())
+
+	prefixes := cfg.Prefixes()
+	require.Len(t, prefixes, 1)
+
+	assert.Equal(t, time.Hour, prefixes[0].ValidLifetime())
+	assert.Equal(t, time.Hour, prefixes[0].PreferredLifetime())
+}
+
+func TestRouterAdvertisementConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	validPrefix := func() []network.RouterAdvertisementPrefix {
+		return []network.RouterAdvertisementPrefix{
+			{
+				PrefixNetwork: netip.MustParsePrefix("2001:db8:1::/64"),
+			},
+		}
+	}
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.RouterAdvertisementConfigV1Alpha1
+
+		expectedWarnings []string
+		expectedError    string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewRouterAdvertisementConfigV1Alpha1,
+
+			expectedError: "name is required",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.RouterAdvertisementConfigV1Alpha1 {
+				cfg := network.NewRouterAdvertisementConfigV1Alpha1()
+				cfg.MetaName = "eth1"
+				cfg.RAPrefixes = validPrefix()
+
+				return cfg
+			},
+		},
+		{
+			name: "no prefixes",
+			cfg: func() *network.RouterAdvertisementConfigV1Alpha1 {
+				cfg := network.NewRouterAdvertisementConfigV1Alpha1()
+				cfg.MetaName = "eth1"
+
+				return cfg
+			},
+			expectedError: "at least one prefix is required",
+		},
+		{
+			name: "short interval",
+			cfg: func() *network.RouterAdvertisementConfigV1Alpha1 {
+				cfg := network.NewRouterAdvertisementConfigV1Alpha1()
+				cfg.MetaName = "eth1"
+				cfg.RAInterval = time.Second
+				cfg.RAPrefixes = validPrefix()
+
+				return cfg
+			},
+			expectedError: "interval should be between 4s and 30m0s",
+		},
+		{
+			name: "router lifetime below interval",
+			cfg: func() *network.RouterAdvertisementConfigV1Alpha1 {
+				cfg := network.NewRouterAdvertisementConfigV1Alpha1()
+				cfg.MetaName = "eth1"
+				cfg.RAInterval = time.Minute
+				cfg.RARouterLifetime = 30 * time.Second
+				cfg.RAPrefixes = validPrefix()
+
+				return cfg
+			},
+			expectedError: "routerLifetime should be between the interval and 2h30m0s",
+		},
+		{
+			name: "IPv4 prefix",
+			cfg: func() *network.RouterAdvertisementConfigV1Alpha1 {
+				cfg := network.NewRouterAdvertisementConfigV1Alpha1()
+				cfg.MetaName = "eth1"
+				cfg.RAPrefixes = []network.RouterAdvertisementPrefix{
+					{
+						PrefixNetwork: netip.MustParsePrefix("10.0.0.0/24"),
+					},
+				}
+
+				return cfg
+			},
+			expectedError: "invalid IPv6 prefix: 10.0.0.0/24",
+		},
+		{
+			name: "preferred over valid",
+			cfg: func() *network.RouterAdvertisementConfigV1Alpha1 {
+				cfg := network.NewRouterAdvertisementConfigV1Alpha1()
+				cfg.MetaName = "eth1"
+				cfg.RAPrefixes = []network.RouterAdvertisementPrefix{
+					{
+						PrefixNetwork:           netip.MustParsePrefix("2001:db8:1::/64"),
+						PrefixValidLifetime:     time.Hour,
+						PrefixPreferredLifetime: 2 * time.Hour,
+					},
+				}
+
+				return cfg
+			},
+			expectedError: "prefix 2001:db8:1::/64: preferredLifetime should not exceed validLifetime",
+		},
+		{
+			name: "not /64",
+			cfg: func() *network.RouterAdvertisementConfigV1Alpha1 {
+				cfg := network.NewRouterAdvertisementConfigV1Alpha1()
+				cfg.MetaName = "eth1"
+				cfg.RAPrefixes = []network.RouterAdvertisementPrefix{
+					{
+						PrefixNetwork: netip.MustParsePrefix("2001:db8:1::/56"),
+					},
+				}
+
+				return cfg
+			},
+			expectedWarnings: []string{"prefix 2001:db8:1::/56 is not /64, it can't be used for the stateless address autoconfiguration"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
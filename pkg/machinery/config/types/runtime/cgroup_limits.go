@@ -0,0 +1,194 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/dustin/go-humanize"
+	"github.com/siderolabs/gen/maps"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// CgroupLimitsKind is a cgroup limits config document kind.
+const CgroupLimitsKind = "CgroupLimitsConfig"
+
+func init() {
+	registry.Register(CgroupLimitsKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &CgroupLimitsV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.CgroupLimitsConfig = &CgroupLimitsV1Alpha1{}
+	_ config.NamedDocument      = &CgroupLimitsV1Alpha1{}
+	_ config.Validator          = &CgroupLimitsV1Alpha1{}
+)
+
+// CgroupLimitsTargets maps the names of the system service cgroups which can be limited to the cgroup paths.
+//
+// The cgroup of machined itself (`/init`) can't be limited.
+var CgroupLimitsTargets = map[string]string{
+	"system":     constants.CgroupSystem,
+	"runtime":    constants.CgroupSystemRuntime,
+	"apid":       constants.CgroupApid,
+	"trustd":     constants.CgroupTrustd,
+	"udevd":      constants.CgroupUdevd,
+	"extensions": constants.CgroupExtensions,
+	"dashboard":  constants.CgroupDashboard,
+	"podruntime": constants.CgroupPodRuntimeRoot,
+	"cri":        constants.CgroupPodRuntime,
+	"kubelet":    constants.CgroupKubelet,
+	"etcd":       constants.CgroupEtcd,
+}
+
+// Minimum limit values, lower limits would make the services unusable.
+const (
+	MinCgroupCPULimitMillicores = 10
+	MinCgroupMemoryMax          = 16 * 1024 * 1024
+)
+
+// CgroupLimitsV1Alpha1 is a cgroup limits config document.
+//
+//	examples:
+//	  - value: exampleCgroupLimitsV1Alpha1()
+//	alias: CgroupLimitsConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/CgroupLimitsConfig
+type CgroupLimitsV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the system service cgroup to limit.
+	//   schema:
+	//     type: string
+	//     enum:
+	//       - system
+	//       - runtime
+	//       - apid
+	//       - trustd
+	//       - udevd
+	//       - extensions
+	//       - dashboard
+	//       - podruntime
+	//       - cri
+	//       - kubelet
+	//       - etcd
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Hard CPU limit in millicores (1000 millicores is one CPU core).
+	//
+	//     If not set, CPU usage is not limited (only the CPU weight is applied).
+	//   examples:
+	//     - value: 2000
+	CPUMillicores uint32 `yaml:"cpuMillicores,omitempty"`
+	//   description: |
+	//     Hard memory limit.
+	//
+	//     Size is specified in bytes, but can be expressed in human readable format, e.g. 512MiB.
+	//     If not set, the default limit is used.
+	//   examples:
+	//     - value: >
+	//        "4GiB"
+	//   schema:
+	//     type: string
+	MemoryMaxSize string `yaml:"memoryMax,omitempty"`
+}
+
+// NewCgroupLimitsV1Alpha1 creates a new cgroup limits config document.
+func NewCgroupLimitsV1Alpha1() *CgroupLimitsV1Alpha1 {
+	return &CgroupLimitsV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       CgroupLimitsKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleCgroupLimitsV1Alpha1() *CgroupLimitsV1Alpha1 {
+	cfg := NewCgroupLimitsV1Alpha1()
+	cfg.MetaName = "kubelet"
+	cfg.CPUMillicores = 2000
+	cfg.MemoryMaxSize = "4GiB"
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *CgroupLimitsV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *CgroupLimitsV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// CgroupPath implements config.CgroupLimitsConfig interface.
+func (s *CgroupLimitsV1Alpha1) CgroupPath() string {
+	return CgroupLimitsTargets[s.MetaName]
+}
+
+// CPULimitMillicores implements config.CgroupLimitsConfig interface.
+func (s *CgroupLimitsV1Alpha1) CPULimitMillicores() uint32 {
+	return s.CPUMillicores
+}
+
+// MemoryMax implements config.CgroupLimitsConfig interface.
+func (s *CgroupLimitsV1Alpha1) MemoryMax() uint64 {
+	if s.MemoryMaxSize == "" {
+		return 0
+	}
+
+	// the value is validated
+	value, _ := humanize.ParseBytes(s.MemoryMaxSize) //nolint:errcheck
+
+	return value
+}
+
+// Validate implements config.Validator interface.
+func (s *CgroupLimitsV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if _, ok := CgroupLimitsTargets[s.MetaName]; !ok {
+		targets := maps.Keys(CgroupLimitsTargets)
+		slices.Sort(targets)
+
+		return nil, fmt.Errorf("invalid cgroup name %q, expected one of %q", s.MetaName, targets)
+	}
+
+	if s.CPUMillicores == 0 && s.MemoryMaxSize == "" {
+		return nil, errors.New("at least one of cpuMillicores or memoryMax should be set")
+	}
+
+	if s.CPUMillicores != 0 && s.CPUMillicores < MinCgroupCPULimitMillicores {
+		return nil, fmt.Errorf("cpuMillicores: minimum value is %d", MinCgroupCPULimitMillicores)
+	}
+
+	if s.MemoryMaxSize != "" {
+		value, err := humanize.ParseBytes(s.MemoryMaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("memoryMax: %w", err)
+		}
+
+		if value < MinCgroupMemoryMax {
+			return nil, fmt.Errorf("memoryMax: minimum value is %s", humanize.IBytes(MinCgroupMemoryMax))
+		}
+	}
+
+	return nil, nil
+}
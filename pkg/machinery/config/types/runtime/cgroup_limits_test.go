@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+//go:embed testdata/cgrouplimits.yaml
+var expectedCgroupLimitsDocument []byte
+
+func TestCgroupLimitsMarshalStability(t *testing.T) {
+	cfg := runtime.NewCgroupLimitsV1Alpha1()
+	cfg.MetaName = "kubelet"
+	cfg.CPUMillicores = 2000
+	cfg.MemoryMaxSize = "4GiB"
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedCgroupLimitsDocument, marshaled)
+
+	assert.Equal(t, constants.CgroupKubelet, cfg.CgroupPath())
+	assert.EqualValues(t, 2000, cfg.CPULimitMillicores())
+	assert.EqualValues(t, 4*1024*1024*1024, cfg.MemoryMax())
+}
+
+func TestCgroupLimitsValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.CgroupLimitsV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "invalid name",
+			cfg: func() *runtime.CgroupLimitsV1Alpha1 {
+				cfg := runtime.NewCgroupLimitsV1Alpha1()
+				cfg.MetaName = "init"
+				cfg.CPUMillicores = 1000
+
+				return cfg
+			},
+
+			expectedError: `invalid cgroup name "init", expected one of ["apid" "cri" "dashboard" "etcd" "extensions" "kubelet" "podruntime" "runtime" "system" "trustd" "udevd"]`,
+		},
+		{
+			name: "no limits",
+			cfg: func() *runtime.CgroupLimitsV1Alpha1 {
+				cfg := runtime.NewCgroupLimitsV1Alpha1()
+				cfg.MetaName = "kubelet"
+
+				return cfg
+			},
+
+			expectedError: "at least one of cpuMillicores or memoryMax should be set",
+		},
+		{
+			name: "cpu too low",
+			cfg: func() *runtime.CgroupLimitsV1Alpha1 {
+				cfg := runtime.NewCgroupLimitsV1Alpha1()
+				cfg.MetaName = "etcd"
+				cfg.CPUMillicores = 5
+
+				return cfg
+			},
+
+			expectedError: "cpuMillicores: minimum value is 10",
+		},
+		{
+			name: "invalid memory",
+			cfg: func() *runtime.CgroupLimitsV1Alpha1 {
+				cfg := runtime.NewCgroupLimitsV1Alpha1()
+				cfg.MetaName = "etcd"
+				cfg.MemoryMaxSize = "lots"
+
+				return cfg
+			},
+
+			expectedError: `memoryMax: strconv.ParseFloat: parsing "": invalid syntax`,
+		},
+		{
+			name: "memory too low",
+			cfg: func() *runtime.CgroupLimitsV1Alpha1 {
+				cfg := runtime.NewCgroupLimitsV1Alpha1()
+				cfg.MetaName = "apid"
+				cfg.MemoryMaxSize = "1MiB"
+
+				return cfg
+			},
+
+			expectedError: "memoryMax: minimum value is 16 MiB",
+		},
+		{
+			name: "valid",
+			cfg: func() *runtime.CgroupLimitsV1Alpha1 {
+				cfg := runtime.NewCgroupLimitsV1Alpha1()
+				cfg.MetaName = "apid"
+				cfg.MemoryMaxSize = "64MiB"
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
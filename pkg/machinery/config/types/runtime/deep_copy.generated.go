@@ -2,14 +2,22 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type WatchdogTimerV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type CgroupLimitsV1Alpha1 -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type LifecycleHookV1Alpha1 -type WatchdogTimerV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
 import (
 	"net/url"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
 )
 
+// DeepCopy generates a deep copy of *CgroupLimitsV1Alpha1.
+func (o *CgroupLimitsV1Alpha1) DeepCopy() *CgroupLimitsV1Alpha1 {
+	var cp CgroupLimitsV1Alpha1 = *o
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *EventSinkV1Alpha1.
 func (o *EventSinkV1Alpha1) DeepCopy() *EventSinkV1Alpha1 {
 	var cp EventSinkV1Alpha1 = *o
@@ -30,6 +38,48 @@ func (o *KmsgLogV1Alpha1) DeepCopy() *KmsgLogV1Alpha1 {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *LifecycleHookV1Alpha1.
+func (o *LifecycleHookV1Alpha1) DeepCopy() *LifecycleHookV1Alpha1 {
+	var cp LifecycleHookV1Alpha1 = *o
+	if o.HookStages != nil {
+		cp.HookStages = make([]config.LifecycleHookStage, len(o.HookStages))
+		copy(cp.HookStages, o.HookStages)
+	}
+	if o.HookURL.URL != nil {
+		cp.HookURL.URL = new(url.URL)
+		*cp.HookURL.URL = *o.HookURL.URL
+		if o.HookURL.URL.User != nil {
+			cp.HookURL.URL.User = new(url.Userinfo)
+			*cp.HookURL.URL.User = *o.HookURL.URL.User
+		}
+	}
+	if o.HookHeaders != nil {
+		cp.HookHeaders = make(map[string]string, len(o.HookHeaders))
+		for k2, v2 := range o.HookHeaders {
+			cp.HookHeaders[k2] = v2
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *UdevV1Alpha1.
+func (o *UdevV1Alpha1) DeepCopy() *UdevV1Alpha1 {
+	var cp UdevV1Alpha1 = *o
+	if o.UdevPredictableInterfaceNames != nil {
+		cp.UdevPredictableInterfaceNames = new(bool)
+		*cp.UdevPredictableInterfaceNames = *o.UdevPredictableInterfaceNames
+	}
+	if o.UdevRules != nil {
+		cp.UdevRules = make([]UdevRuleV1Alpha1, len(o.UdevRules))
+		copy(cp.UdevRules, o.UdevRules)
+	}
+	if o.UdevDisabledRules != nil {
+		cp.UdevDisabledRules = make([]string, len(o.UdevDisabledRules))
+		copy(cp.UdevDisabledRules, o.UdevDisabledRules)
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *WatchdogTimerV1Alpha1.
 func (o *WatchdogTimerV1Alpha1) DeepCopy() *WatchdogTimerV1Alpha1 {
 	var cp WatchdogTimerV1Alpha1 = *o
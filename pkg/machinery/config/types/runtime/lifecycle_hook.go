@@ -0,0 +1,229 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// LifecycleHookKind is a lifecycle hook config document kind.
+const LifecycleHookKind = "LifecycleHookConfig"
+
+func init() {
+	registry.Register(LifecycleHookKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &LifecycleHookV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.LifecycleHookConfig = &LifecycleHookV1Alpha1{}
+	_ config.NamedDocument       = &LifecycleHookV1Alpha1{}
+	_ config.SecretDocument      = &LifecycleHookV1Alpha1{}
+	_ config.Validator           = &LifecycleHookV1Alpha1{}
+)
+
+// Timeout constants.
+const (
+	DefaultLifecycleHookTimeout = 30 * time.Second
+	MaxLifecycleHookTimeout     = 10 * time.Minute
+)
+
+// LifecycleHookV1Alpha1 is a lifecycle hook config document.
+//
+//	examples:
+//	  - value: exampleLifecycleHookV1Alpha1()
+//	alias: LifecycleHookConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/LifecycleHookConfig
+type LifecycleHookV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the config document.
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Lifecycle stages the hook is fired at.
+	//   examples:
+	//     - value: >
+	//        []string{"preReboot", "preReset"}
+	//   schema:
+	//     type: array
+	//     items:
+	//       type: string
+	//       enum:
+	//         - preReboot
+	//         - postInstall
+	//         - preReset
+	HookStages []config.LifecycleHookStage `yaml:"stages"`
+	//   description: |
+	//     The URL of the webhook.
+	//     The scheme must be http:// or https://.
+	//   examples:
+	//     - value: >
+	//        "https://cmdb.example.com/hooks/talos"
+	//   schema:
+	//     type: string
+	//     pattern: "^https?://"
+	HookURL meta.URL `yaml:"url"`
+	//   description: |
+	//     Extra HTTP headers sent with the webhook request (e.g. authorization).
+	HookHeaders map[string]string `yaml:"headers,omitempty"`
+	//   description: |
+	//     Timeout for the webhook call.
+	//
+	//     Default value is 30 seconds, maximum value is 10 minutes.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HookTimeout time.Duration `yaml:"timeout,omitempty"`
+	//   description: |
+	//     Defines how the webhook failures (including timeouts) are handled.
+	//
+	//     `ignore` (default) logs the failure and continues the sequence (fail-open),
+	//     `fail` aborts the sequence (fail-closed).
+	//   values:
+	//     - ignore
+	//     - fail
+	HookFailurePolicy config.LifecycleHookFailurePolicy `yaml:"failurePolicy,omitempty"`
+}
+
+// NewLifecycleHookV1Alpha1 creates a new lifecycle hook config document.
+func NewLifecycleHookV1Alpha1() *LifecycleHookV1Alpha1 {
+	return &LifecycleHookV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       LifecycleHookKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleLifecycleHookV1Alpha1() *LifecycleHookV1Alpha1 {
+	cfg := NewLifecycleHookV1Alpha1()
+	cfg.MetaName = "cmdb"
+	cfg.HookStages = []config.LifecycleHookStage{config.LifecycleHookPreReboot, config.LifecycleHookPreReset}
+	cfg.HookURL.URL = ensure.Value(url.Parse("https://cmdb.example.com/hooks/talos"))
+	cfg.HookTimeout = time.Minute
+	cfg.HookFailurePolicy = config.LifecycleHookFailurePolicyFail
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *LifecycleHookV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *LifecycleHookV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Redact implements config.SecretDocument interface.
+func (s *LifecycleHookV1Alpha1) Redact(replacement string) {
+	for key := range s.HookHeaders {
+		s.HookHeaders[key] = replacement
+	}
+
+	if s.HookURL.URL != nil && s.HookURL.URL.User != nil {
+		s.HookURL.URL.User = url.User(replacement)
+	}
+}
+
+// Stages implements config.LifecycleHookConfig interface.
+func (s *LifecycleHookV1Alpha1) Stages() []config.LifecycleHookStage {
+	return s.HookStages
+}
+
+// URL implements config.LifecycleHookConfig interface.
+func (s *LifecycleHookV1Alpha1) URL() *url.URL {
+	return s.HookURL.URL
+}
+
+// Headers implements config.LifecycleHookConfig interface.
+func (s *LifecycleHookV1Alpha1) Headers() map[string]string {
+	return s.HookHeaders
+}
+
+// Timeout implements config.LifecycleHookConfig interface.
+func (s *LifecycleHookV1Alpha1) Timeout() time.Duration {
+	if s.HookTimeout == 0 {
+		return DefaultLifecycleHookTimeout
+	}
+
+	return s.HookTimeout
+}
+
+// FailurePolicy implements config.LifecycleHookConfig interface.
+func (s *LifecycleHookV1Alpha1) FailurePolicy() config.LifecycleHookFailurePolicy {
+	if s.HookFailurePolicy == "" {
+		return config.LifecycleHookFailurePolicyIgnore
+	}
+
+	return s.HookFailurePolicy
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *LifecycleHookV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if s.MetaName == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if len(s.HookStages) == 0 {
+		return nil, errors.New("at least one stage is required")
+	}
+
+	validStages := []config.LifecycleHookStage{config.LifecycleHookPreReboot, config.LifecycleHookPostInstall, config.LifecycleHookPreReset}
+
+	for _, stage := range s.HookStages {
+		if !slices.Contains(validStages, stage) {
+			return nil, fmt.Errorf("invalid stage %q, expected one of %q", stage, validStages)
+		}
+	}
+
+	if s.HookURL.URL == nil {
+		return nil, errors.New("url is required")
+	}
+
+	switch s.HookURL.URL.Scheme {
+	case "http":
+	case "https":
+	default:
+		return nil, errors.New("url scheme must be http:// or https://")
+	}
+
+	if s.HookTimeout < 0 || s.HookTimeout > MaxLifecycleHookTimeout {
+		return nil, fmt.Errorf("timeout must be between 0 and %s", MaxLifecycleHookTimeout)
+	}
+
+	switch s.HookFailurePolicy {
+	case "":
+	case config.LifecycleHookFailurePolicyIgnore:
+	case config.LifecycleHookFailurePolicyFail:
+	default:
+		return nil, fmt.Errorf("invalid failure policy %q, expected %q or %q", s.HookFailurePolicy, config.LifecycleHookFailurePolicyIgnore, config.LifecycleHookFailurePolicyFail)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/lifecyclehook.yaml
+var expectedLifecycleHookDocument []byte
+
+func TestLifecycleHookMarshalStability(t *testing.T) {
+	cfg := runtime.NewLifecycleHookV1Alpha1()
+	cfg.MetaName = "cmdb"
+	cfg.HookStages = []config.LifecycleHookStage{config.LifecycleHookPreReboot, config.LifecycleHookPostInstall}
+	cfg.HookURL.URL = ensure.Value(url.Parse("https://cmdb.example.com/hooks/talos"))
+	cfg.HookTimeout = time.Minute
+	cfg.HookFailurePolicy = config.LifecycleHookFailurePolicyFail
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedLifecycleHookDocument, marshaled)
+}
+
+func TestLifecycleHookValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := func() *runtime.LifecycleHookV1Alpha1 {
+		cfg := runtime.NewLifecycleHookV1Alpha1()
+		cfg.MetaName = "cmdb"
+		cfg.HookStages = []config.LifecycleHookStage{config.LifecycleHookPreReset}
+		cfg.HookURL.URL = ensure.Value(url.Parse("http://10.5.0.1:8080/hook"))
+
+		return cfg
+	}
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.LifecycleHookV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewLifecycleHookV1Alpha1,
+
+			expectedError: "name is required",
+		},
+		{
+			name: "no stages",
+			cfg: func() *runtime.LifecycleHookV1Alpha1 {
+				cfg := valid()
+				cfg.HookStages = nil
+
+				return cfg
+			},
+
+			expectedError: "at least one stage is required",
+		},
+		{
+			name: "invalid stage",
+			cfg: func() *runtime.LifecycleHookV1Alpha1 {
+				cfg := valid()
+				cfg.HookStages = []config.LifecycleHookStage{"preBoot"}
+
+				return cfg
+			},
+
+			expectedError: `invalid stage "preBoot", expected one of ["preReboot" "postInstall" "preReset"]`,
+		},
+		{
+			name: "invalid scheme",
+			cfg: func() *runtime.LifecycleHookV1Alpha1 {
+				cfg := valid()
+				cfg.HookURL.URL = ensure.Value(url.Parse("tcp://10.5.0.1:8080"))
+
+				return cfg
+			},
+
+			expectedError: "url scheme must be http:// or https://",
+		},
+		{
+			name: "timeout too long",
+			cfg: func() *runtime.LifecycleHookV1Alpha1 {
+				cfg := valid()
+				cfg.HookTimeout = time.Hour
+
+				return cfg
+			},
+
+			expectedError: "timeout must be between 0 and 10m0s",
+		},
+		{
+			name: "invalid failure policy",
+			cfg: func() *runtime.LifecycleHookV1Alpha1 {
+				cfg := valid()
+				cfg.HookFailurePolicy = "retry"
+
+				return cfg
+			},
+
+			expectedError: `invalid failure policy "retry", expected "ignore" or "fail"`,
+		},
+		{
+			name: "valid",
+			cfg:  valid,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,6 @@
 // Package runtime provides runtime machine configuration documents.
 package runtime
 
-//go:generate docgen -output runtime_doc.go runtime.go kmsg_log.go event_sink.go watchdog_timer.go
+//go:generate docgen -output runtime_doc.go runtime.go kmsg_log.go event_sink.go watchdog_timer.go lifecycle_hook.go cgroup_limits.go udev.go
 
-//go:generate deep-copy -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type WatchdogTimerV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type CgroupLimitsV1Alpha1 -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type LifecycleHookV1Alpha1 -type UdevV1Alpha1 -type WatchdogTimerV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
@@ -95,6 +95,181 @@ func (WatchdogTimerV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (LifecycleHookV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LifecycleHookConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LifecycleHookConfig is a lifecycle hook config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LifecycleHookConfig is a lifecycle hook config document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the config document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "stages",
+				Type:        "[]LifecycleHookStage",
+				Note:        "",
+				Description: "Lifecycle stages the hook is fired at.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Lifecycle stages the hook is fired at." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "url",
+				Type:        "URL",
+				Note:        "",
+				Description: "The URL of the webhook.\nThe scheme must be http:// or https://.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The URL of the webhook." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "headers",
+				Type:        "map[string]string",
+				Note:        "",
+				Description: "Extra HTTP headers sent with the webhook request (e.g. authorization).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Extra HTTP headers sent with the webhook request (e.g. authorization)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout for the webhook call.\n\nDefault value is 30 seconds, maximum value is 10 minutes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout for the webhook call." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "failurePolicy",
+				Type:        "LifecycleHookFailurePolicy",
+				Note:        "",
+				Description: "Defines how the webhook failures (including timeouts) are handled.\n\n`ignore` (default) logs the failure and continues the sequence (fail-open),\n`fail` aborts the sequence (fail-closed).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Defines how the webhook failures (including timeouts) are handled." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"ignore",
+					"fail",
+				},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleLifecycleHookV1Alpha1())
+
+	doc.Fields[2].AddExample("", []string{"preReboot", "preReset"})
+	doc.Fields[3].AddExample("", "https://cmdb.example.com/hooks/talos")
+
+	return doc
+}
+
+func (CgroupLimitsV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "CgroupLimitsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "CgroupLimitsConfig is a cgroup limits config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "CgroupLimitsConfig is a cgroup limits config document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the system service cgroup to limit.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the system service cgroup to limit." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cpuMillicores",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Hard CPU limit in millicores (1000 millicores is one CPU core).\n\nIf not set, CPU usage is not limited (only the CPU weight is applied).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Hard CPU limit in millicores (1000 millicores is one CPU core)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "memoryMax",
+				Type:        "string",
+				Note:        "",
+				Description: "Hard memory limit.\n\nSize is specified in bytes, but can be expressed in human readable format, e.g. 512MiB.\nIf not set, the default limit is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Hard memory limit." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleCgroupLimitsV1Alpha1())
+
+	doc.Fields[2].AddExample("", 2000)
+	doc.Fields[3].AddExample("", "4GiB")
+
+	return doc
+}
+
+func (UdevV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "UdevConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "UdevConfig is a udev rules and network interface naming config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "UdevConfig is a udev rules and network interface naming config document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "predictableInterfaceNames",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable or disable predictable network interface names (e.g. `enp0s3`).\n\nIf disabled, the kernel names (`eth0`, `eth1`, ...) are kept.\nIf not set, the naming follows the `net.ifnames` kernel argument.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable or disable predictable network interface names (e.g. `enp0s3`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "rules",
+				Type:        "[]UdevRuleV1Alpha1",
+				Note:        "",
+				Description: "Custom udev rules files.\n\nRules are applied as soon as the configuration is loaded, and the network devices\nevents are replayed, so that the interface names are updated before the links are configured.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Custom udev rules files." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "disabledRules",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of the udev rules files shipped with Talos to disable.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of the udev rules files shipped with Talos to disable." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleUdevV1Alpha1())
+
+	doc.Fields[3].AddExample("", []string{"60-persistent-storage-tape.rules"})
+
+	return doc
+}
+
+func (UdevRuleV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "UdevRuleV1Alpha1",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "UdevRuleV1Alpha1 is a custom udev rules file." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "UdevRuleV1Alpha1 is a custom udev rules file.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "UdevV1Alpha1",
+				FieldName: "rules",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the udev rules file, should have `.rules` suffix.\n\nThe file with the same name shipped with Talos is replaced.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the udev rules file, should have `.rules` suffix." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "contents",
+				Type:        "string",
+				Note:        "",
+				Description: "Contents of the udev rules file.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Contents of the udev rules file." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "90-nic-names.rules")
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file runtime_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
@@ -104,6 +279,10 @@ func GetFileDoc() *encoder.FileDoc {
 			KmsgLogV1Alpha1{}.Doc(),
 			EventSinkV1Alpha1{}.Doc(),
 			WatchdogTimerV1Alpha1{}.Doc(),
+			LifecycleHookV1Alpha1{}.Doc(),
+			CgroupLimitsV1Alpha1{}.Doc(),
+			UdevV1Alpha1{}.Doc(),
+			UdevRuleV1Alpha1{}.Doc(),
 		},
 	}
 }
@@ -0,0 +1,185 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// UdevKind is a udev config document kind.
+const UdevKind = "UdevConfig"
+
+func init() {
+	registry.Register(UdevKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &UdevV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.UdevRulesConfig = &UdevV1Alpha1{}
+	_ config.Validator       = &UdevV1Alpha1{}
+	_ config.UdevRule        = UdevRuleV1Alpha1{}
+)
+
+var udevRuleNameRe = regexp.MustCompile(`^[\w.-]+\.rules$`)
+
+// UdevV1Alpha1 is a udev rules and network interface naming config document.
+//
+//	examples:
+//	  - value: exampleUdevV1Alpha1()
+//	alias: UdevConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/UdevConfig
+type UdevV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Enable or disable predictable network interface names (e.g. `enp0s3`).
+	//
+	//     If disabled, the kernel names (`eth0`, `eth1`, ...) are kept.
+	//     If not set, the naming follows the `net.ifnames` kernel argument.
+	UdevPredictableInterfaceNames *bool `yaml:"predictableInterfaceNames,omitempty"`
+	//   description: |
+	//     Custom udev rules files.
+	//
+	//     Rules are applied as soon as the configuration is loaded, and the network devices
+	//     events are replayed, so that the interface names are updated before the links are configured.
+	UdevRules []UdevRuleV1Alpha1 `yaml:"rules,omitempty"`
+	//   description: |
+	//     List of the udev rules files shipped with Talos to disable.
+	//   examples:
+	//     - value: >
+	//        []string{"60-persistent-storage-tape.rules"}
+	UdevDisabledRules []string `yaml:"disabledRules,omitempty"`
+}
+
+// UdevRuleV1Alpha1 is a custom udev rules file.
+type UdevRuleV1Alpha1 struct {
+	//   description: |
+	//     Name of the udev rules file, should have `.rules` suffix.
+	//
+	//     The file with the same name shipped with Talos is replaced.
+	//   examples:
+	//     - value: >
+	//        "90-nic-names.rules"
+	RuleName string `yaml:"name"`
+	//   description: |
+	//     Contents of the udev rules file.
+	RuleContents string `yaml:"contents"`
+}
+
+// NewUdevV1Alpha1 creates a new udev config document.
+func NewUdevV1Alpha1() *UdevV1Alpha1 {
+	return &UdevV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       UdevKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleUdevV1Alpha1() *UdevV1Alpha1 {
+	cfg := NewUdevV1Alpha1()
+	cfg.UdevPredictableInterfaceNames = pointer.To(true)
+	cfg.UdevRules = []UdevRuleV1Alpha1{
+		{
+			RuleName:     "90-nic-names.rules",
+			RuleContents: `SUBSYSTEM=="net", ACTION=="add", ATTR{address}=="00:11:22:33:44:55", NAME="uplink0"` + "\n",
+		},
+	}
+	cfg.UdevDisabledRules = []string{"60-persistent-storage-tape.rules"}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *UdevV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// PredictableInterfaceNames implements config.UdevRulesConfig interface.
+func (s *UdevV1Alpha1) PredictableInterfaceNames() optional.Optional[bool] {
+	if s.UdevPredictableInterfaceNames == nil {
+		return optional.None[bool]()
+	}
+
+	return optional.Some(*s.UdevPredictableInterfaceNames)
+}
+
+// Rules implements config.UdevRulesConfig interface.
+func (s *UdevV1Alpha1) Rules() []config.UdevRule {
+	return xslices.Map(s.UdevRules, func(r UdevRuleV1Alpha1) config.UdevRule { return r })
+}
+
+// DisabledRules implements config.UdevRulesConfig interface.
+func (s *UdevV1Alpha1) DisabledRules() []string {
+	return s.UdevDisabledRules
+}
+
+// Name implements config.UdevRule interface.
+func (r UdevRuleV1Alpha1) Name() string {
+	return r.RuleName
+}
+
+// Contents implements config.UdevRule interface.
+func (r UdevRuleV1Alpha1) Contents() string {
+	return r.RuleContents
+}
+
+// Validate implements config.Validator interface.
+func (s *UdevV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	seen := map[string]struct{}{}
+
+	checkName := func(field, name string) {
+		if !udevRuleNameRe.MatchString(name) {
+			errs = errors.Join(errs, fmt.Errorf("%s: invalid udev rules file name %q", field, name))
+
+			return
+		}
+
+		if _, ok := seen[name]; ok {
+			errs = errors.Join(errs, fmt.Errorf("%s: duplicate udev rules file name %q", field, name))
+
+			return
+		}
+
+		seen[name] = struct{}{}
+	}
+
+	for _, rule := range s.UdevRules {
+		checkName("rules", rule.RuleName)
+
+		if strings.TrimSpace(rule.RuleContents) == "" {
+			errs = errors.Join(errs, fmt.Errorf("rules: empty contents for %q", rule.RuleName))
+		}
+	}
+
+	for _, name := range s.UdevDisabledRules {
+		checkName("disabledRules", name)
+	}
+
+	return nil, errs
+}
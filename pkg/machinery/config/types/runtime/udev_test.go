@@ -0,0 +1,152 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/udev.yaml
+var expectedUdevDocument []byte
+
+func TestUdevMarshalStability(t *testing.T) {
+	cfg := runtime.NewUdevV1Alpha1()
+	cfg.UdevPredictableInterfaceNames = pointer.To(false)
+	cfg.UdevRules = []runtime.UdevRuleV1Alpha1{
+		{
+			RuleName:     "90-nic-names.rules",
+			RuleContents: "SUBSYSTEM==\"net\", ACTION==\"add\", ATTR{address}==\"00:11:22:33:44:55\", NAME=\"uplink0\"\n",
+		},
+	}
+	cfg.UdevDisabledRules = []string{"60-persistent-storage-tape.rules"}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedUdevDocument, marshaled)
+}
+
+func TestUdevUnmarshal(t *testing.T) {
+	provider, err := configloader.NewFromBytes(expectedUdevDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &runtime.UdevV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       runtime.UdevKind,
+		},
+		UdevPredictableInterfaceNames: pointer.To(false),
+		UdevRules: []runtime.UdevRuleV1Alpha1{
+			{
+				RuleName:     "90-nic-names.rules",
+				RuleContents: "SUBSYSTEM==\"net\", ACTION==\"add\", ATTR{address}==\"00:11:22:33:44:55\", NAME=\"uplink0\"\n",
+			},
+		},
+		UdevDisabledRules: []string{"60-persistent-storage-tape.rules"},
+	}, docs[0])
+
+	udev := provider.UdevRules()
+	require.NotNil(t, udev)
+
+	predictable, ok := udev.PredictableInterfaceNames().Get()
+	assert.True(t, ok)
+	assert.False(t, predictable)
+
+	require.Len(t, udev.Rules(), 1)
+	assert.Equal(t, "90-nic-names.rules", udev.Rules()[0].Name())
+	assert.Equal(t, []string{"60-persistent-storage-tape.rules"}, udev.DisabledRules())
+}
+
+func TestUdevValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.UdevV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewUdevV1Alpha1,
+		},
+		{
+			name: "invalid names",
+			cfg: func() *runtime.UdevV1Alpha1 {
+				cfg := runtime.NewUdevV1Alpha1()
+				cfg.UdevRules = []runtime.UdevRuleV1Alpha1{
+					{
+						RuleName:     "../90-nic-names.rules",
+						RuleContents: "# empty",
+					},
+				}
+				cfg.UdevDisabledRules = []string{"60-persistent-storage-tape"}
+
+				return cfg
+			},
+
+			expectedError: "rules: invalid udev rules file name \"../90-nic-names.rules\"\ndisabledRules: invalid udev rules file name \"60-persistent-storage-tape\"",
+		},
+		{
+			name: "duplicate and empty",
+			cfg: func() *runtime.UdevV1Alpha1 {
+				cfg := runtime.NewUdevV1Alpha1()
+				cfg.UdevRules = []runtime.UdevRuleV1Alpha1{
+					{
+						RuleName: "90-nic-names.rules",
+					},
+				}
+				cfg.UdevDisabledRules = []string{"90-nic-names.rules"}
+
+				return cfg
+			},
+
+			expectedError: "rules: empty contents for \"90-nic-names.rules\"\ndisabledRules: duplicate udev rules file name \"90-nic-names.rules\"",
+		},
+		{
+			name: "valid",
+			cfg: func() *runtime.UdevV1Alpha1 {
+				cfg := runtime.NewUdevV1Alpha1()
+				cfg.UdevPredictableInterfaceNames = pointer.To(true)
+				cfg.UdevRules = []runtime.UdevRuleV1Alpha1{
+					{
+						RuleName:     "90-nic-names.rules",
+						RuleContents: "SUBSYSTEM==\"net\", ACTION==\"add\", ATTR{address}==\"00:11:22:33:44:55\", NAME=\"uplink0\"\n",
+					},
+				}
+				cfg.UdevDisabledRules = []string{"60-persistent-storage-tape.rules"}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,10 +2,16 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type SecretsReferenceConfigV1Alpha1 -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package security
 
+// DeepCopy generates a deep copy of *SecretsReferenceConfigV1Alpha1.
+func (o *SecretsReferenceConfigV1Alpha1) DeepCopy() *SecretsReferenceConfigV1Alpha1 {
+	var cp SecretsReferenceConfigV1Alpha1 = *o
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *TrustedRootsConfigV1Alpha1.
 func (o *TrustedRootsConfigV1Alpha1) DeepCopy() *TrustedRootsConfigV1Alpha1 {
 	var cp TrustedRootsConfigV1Alpha1 = *o
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// SecretsReferenceConfig is a secrets reference config document kind.
+const SecretsReferenceConfig = "SecretsReferenceConfig"
+
+func init() {
+	registry.Register(SecretsReferenceConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &SecretsReferenceConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.SecretsReferenceConfig = &SecretsReferenceConfigV1Alpha1{}
+	_ config.Validator              = &SecretsReferenceConfigV1Alpha1{}
+)
+
+var secretsBundleIDRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+// SecretsReferenceConfigV1Alpha1 references the secrets bundle instead of embedding the secrets into the machine configuration.
+//
+//	examples:
+//	  - value: exampleSecretsReferenceConfigV1Alpha1()
+//	alias: SecretsReferenceConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/SecretsReferenceConfig
+type SecretsReferenceConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     ID of the secrets bundle in the node secrets store.
+	//
+	//     The private keys and the shared secrets omitted from the machine configuration are filled
+	//     from the secrets bundle when the configuration is applied.
+	//     The secrets bundle is either provided along with the configuration (and saved to the node secrets store),
+	//     or it should be already present in the node secrets store.
+	//   schemaRequired: true
+	BundleID string `yaml:"bundleID"`
+}
+
+// NewSecretsReferenceConfigV1Alpha1 creates a new SecretsReferenceConfig config document.
+func NewSecretsReferenceConfigV1Alpha1() *SecretsReferenceConfigV1Alpha1 {
+	return &SecretsReferenceConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       SecretsReferenceConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleSecretsReferenceConfigV1Alpha1() *SecretsReferenceConfigV1Alpha1 {
+	cfg := NewSecretsReferenceConfigV1Alpha1()
+	cfg.BundleID = "production"
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *SecretsReferenceConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// SecretsBundleID implements config.SecretsReferenceConfig interface.
+func (s *SecretsReferenceConfigV1Alpha1) SecretsBundleID() string {
+	return s.BundleID
+}
+
+// Validate implements config.Validator interface.
+func (s *SecretsReferenceConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if !secretsBundleIDRe.MatchString(s.BundleID) {
+		return nil, fmt.Errorf("invalid secrets bundle ID %q", s.BundleID)
+	}
+
+	return nil, nil
+}
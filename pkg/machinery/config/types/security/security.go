@@ -5,6 +5,6 @@
 // Package security provides security-related machine configuration documents.
 package security
 
-//go:generate docgen -output security_doc.go security.go trusted_roots.go
+//go:generate docgen -output security_doc.go security.go secrets_reference.go trusted_roots.go
 
-//go:generate deep-copy -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type SecretsReferenceConfigV1Alpha1 -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
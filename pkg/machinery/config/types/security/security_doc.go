@@ -10,6 +10,27 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 )
 
+func (SecretsReferenceConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SecretsReferenceConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SecretsReferenceConfig references the secrets bundle instead of embedding the secrets into the machine configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SecretsReferenceConfig references the secrets bundle instead of embedding the secrets into the machine configuration.",
+		Fields: []encoder.Doc{
+			{}, {
+				Name:        "bundleID",
+				Type:        "string",
+				Note:        "",
+				Description: "ID of the secrets bundle in the node secrets store.\n\nThe private keys and the shared secrets omitted from the machine configuration are filled\nfrom the secrets bundle when the configuration is applied.\nThe secrets bundle is either provided along with the configuration (and saved to the node secrets store),\nor it should be already present in the node secrets store.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "ID of the secrets bundle in the node secrets store." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleSecretsReferenceConfigV1Alpha1())
+
+	return doc
+}
+
 func (TrustedRootsConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "TrustedRootsConfig",
@@ -45,6 +66,7 @@ func GetFileDoc() *encoder.FileDoc {
 		Name:        "security",
 		Description: "Package security provides security-related machine configuration documents.\n",
 		Structs: []*encoder.Doc{
+			SecretsReferenceConfigV1Alpha1{}.Doc(),
 			TrustedRootsConfigV1Alpha1{}.Doc(),
 		},
 	}
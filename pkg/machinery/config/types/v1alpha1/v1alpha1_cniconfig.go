@@ -4,7 +4,12 @@
 
 package v1alpha1
 
-import "github.com/siderolabs/talos/pkg/machinery/config/config"
+import (
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
 
 // Name implements the config.CNI interface.
 func (c *CNIConfig) Name() string {
@@ -21,6 +26,11 @@ func (c *CNIConfig) Flannel() config.FlannelCNI {
 	return c.CNIFlannel
 }
 
+// Readiness implements the config.CNI interface.
+func (c *CNIConfig) Readiness() config.CNIReadiness {
+	return c.CNIReadiness
+}
+
 // ExtraArgs implements the config.FlannelCNI interface.
 func (c *FlannelCNIConfig) ExtraArgs() []string {
 	if c == nil {
@@ -29,3 +39,30 @@ func (c *FlannelCNIConfig) ExtraArgs() []string {
 
 	return c.FlanneldExtraArgs
 }
+
+// Mode implements the config.CNIReadiness interface.
+func (c *CNIReadinessConfig) Mode() string {
+	if c == nil || c.ReadinessMode == "" {
+		return constants.CNIReadinessDefault
+	}
+
+	return c.ReadinessMode
+}
+
+// DaemonSets implements the config.CNIReadiness interface.
+func (c *CNIReadinessConfig) DaemonSets() []string {
+	if c == nil {
+		return nil
+	}
+
+	return c.ReadinessDaemonSets
+}
+
+// Timeout implements the config.CNIReadiness interface.
+func (c *CNIReadinessConfig) Timeout() time.Duration {
+	if c == nil || c.ReadinessTimeout == 0 {
+		return constants.DefaultCNIReadinessTimeout
+	}
+
+	return c.ReadinessTimeout
+}
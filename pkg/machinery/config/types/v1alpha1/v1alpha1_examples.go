@@ -576,6 +576,14 @@ func clusterCustomCNIExample() *CNIConfig {
 	}
 }
 
+func clusterCNIReadinessExample() *CNIReadinessConfig {
+	return &CNIReadinessConfig{
+		ReadinessMode:       constants.CNIReadinessCustom,
+		ReadinessDaemonSets: []string{"kube-system/cilium"},
+		ReadinessTimeout:    time.Hour,
+	}
+}
+
 func clusterInlineManifestsExample() ClusterInlineManifests {
 	return ClusterInlineManifests{
 		{
@@ -619,6 +627,19 @@ func clusterDiscoveryExample() ClusterDiscoveryConfig {
 	}
 }
 
+func kubeletShutdownGracePeriodByPodPriorityExample() []KubeletShutdownGracePeriodConfig {
+	return []KubeletShutdownGracePeriodConfig{
+		{
+			KubeletShutdownPriority:    100000,
+			KubeletShutdownGracePeriod: 10 * time.Second,
+		},
+		{
+			KubeletShutdownPriority:    0,
+			KubeletShutdownGracePeriod: 60 * time.Second,
+		},
+	}
+}
+
 func kubeletNodeIPExample() *KubeletNodeIPConfig {
 	return &KubeletNodeIPConfig{
 		KubeletNodeIPValidSubnets: []string{
@@ -629,6 +650,13 @@ func kubeletNodeIPExample() *KubeletNodeIPConfig {
 	}
 }
 
+func kubeletNodeIPValidSubnetsExample() []string {
+	return []string{
+		"interface:eth1",
+		"!10.0.0.0/8",
+	}
+}
+
 func kubeletExtraConfigExample() Unstructured {
 	return Unstructured{
 		Object: map[string]any{
@@ -672,6 +700,12 @@ func loggingEndpointExample2() *Endpoint {
 	}
 }
 
+func loggingEndpointExample3() *Endpoint {
+	return &Endpoint{
+		mustParseURL("https://loki.example.com/loki/api/v1/push"),
+	}
+}
+
 func machineLoggingExample() LoggingConfig {
 	return LoggingConfig{
 		LoggingDestinations: []LoggingDestination{
@@ -762,8 +796,78 @@ func kubernetesTalosAPIAccessConfigExample() *KubernetesTalosAPIAccessConfig {
 	}
 }
 
+func kubernetesTalosAPIAccessServiceAccountsExample() []KubernetesTalosAPIAccessServiceAccount {
+	return []KubernetesTalosAPIAccessServiceAccount{
+		{
+			ServiceAccountNamespace: "kube-system",
+			ServiceAccountName:      "upgrade-controller",
+			ServiceAccountRoles: []string{
+				"os:operator",
+			},
+		},
+	}
+}
+
+func apidDeadlinesConfigExample() *APIDDeadlinesConfig {
+	return &APIDDeadlinesConfig{
+		APIDUnaryDefault:     5 * time.Minute,
+		APIDStreamingDefault: 12 * time.Hour,
+		APIDStreamingMax:     24 * time.Hour,
+	}
+}
+
+func auditLogConfigExample() *AuditLogConfig {
+	return &AuditLogConfig{
+		AuditLogSyslogEndpoint: auditLogSyslogEndpointExample(),
+	}
+}
+
+func apiOIDCConfigExample() *APIOIDCConfig {
+	return &APIOIDCConfig{
+		OIDCIssuerURL: "https://login.example.com/realms/talos",
+		OIDCAudience:  "talos",
+		OIDCGroupRoles: []APIOIDCGroupRoles{
+			{
+				OIDCGroup: "talos-admins",
+				OIDCRoles: []string{"os:admin"},
+			},
+			{
+				OIDCGroup: "sre",
+				OIDCRoles: []string{"os:operator"},
+			},
+		},
+	}
+}
+
+func auditLogSyslogEndpointExample() *Endpoint {
+	return &Endpoint{
+		mustParseURL("udp://10.0.0.1:514"),
+	}
+}
+
+func metricsConfigExample() *MetricsConfig {
+	return &MetricsConfig{
+		MetricsEnabled: pointer.To(true),
+	}
+}
+
+func resourceAccessPolicyExample() []ResourceAccessRule {
+	return []ResourceAccessRule{
+		{
+			AccessRole:       "os:reader",
+			AccessNamespaces: []string{"network"},
+		},
+	}
+}
+
 func kmsKeyExample() *EncryptionKeyKMS {
 	return &EncryptionKeyKMS{
 		KMSEndpoint: "https://192.168.88.21:4443",
 	}
 }
+
+func kmsVaultTransitExample() *EncryptionKeyKMSVaultTransit {
+	return &EncryptionKeyKMSVaultTransit{
+		VaultKeyName: "talos",
+	}
+}
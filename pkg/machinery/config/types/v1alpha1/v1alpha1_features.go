@@ -5,9 +5,16 @@
 package v1alpha1
 
 import (
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // RBACEnabled implements config.Features interface.
@@ -57,6 +64,57 @@ func (f *FeaturesConfig) KubePrism() config.KubePrism {
 	return f.KubePrismSupport
 }
 
+// APIDDeadlines implements config.Features interface.
+func (f *FeaturesConfig) APIDDeadlines() config.APIDDeadlines {
+	if f.APIDDeadlinesConfig == nil {
+		return &APIDDeadlinesConfig{}
+	}
+
+	return f.APIDDeadlinesConfig
+}
+
+// KubernetesEventsEnabled implements config.Features interface.
+func (f *FeaturesConfig) KubernetesEventsEnabled() bool {
+	return pointer.SafeDeref(f.KubernetesEvents)
+}
+
+// EmergencyConsoleEnabled implements config.Features interface.
+func (f *FeaturesConfig) EmergencyConsoleEnabled() bool {
+	return pointer.SafeDeref(f.EmergencyConsole)
+}
+
+// AuditLog implements config.Features interface.
+func (f *FeaturesConfig) AuditLog() config.AuditLog {
+	if f.AuditLogConfig == nil {
+		return &AuditLogConfig{}
+	}
+
+	return f.AuditLogConfig
+}
+
+// APIOIDC implements config.Features interface.
+func (f *FeaturesConfig) APIOIDC() config.APIOIDC {
+	if f.APIOIDCConfig == nil {
+		return &APIOIDCConfig{}
+	}
+
+	return f.APIOIDCConfig
+}
+
+// Metrics implements config.Features interface.
+func (f *FeaturesConfig) Metrics() config.Metrics {
+	if f.MetricsConfig == nil {
+		return &MetricsConfig{}
+	}
+
+	return f.MetricsConfig
+}
+
+// ResourceAccessPolicy implements config.Features interface.
+func (f *FeaturesConfig) ResourceAccessPolicy() []config.ResourceAccessRule {
+	return xslices.Map(f.ResourceAccessPolicyRules, func(r ResourceAccessRule) config.ResourceAccessRule { return r })
+}
+
 const defaultKubePrismPort = 7445
 
 // Enabled implements [config.KubePrism].
@@ -87,3 +145,120 @@ func (h *HostDNSConfig) ForwardKubeDNSToHost() bool {
 func (h *HostDNSConfig) ResolveMemberNames() bool {
 	return pointer.SafeDeref(h.HostDNSResolveMemberNames)
 }
+
+const (
+	defaultAPIDUnaryDefaultDeadline     = 10 * time.Minute
+	defaultAPIDUnaryMaxDeadline         = time.Hour
+	defaultAPIDStreamingDefaultDeadline = 24 * time.Hour
+)
+
+// UnaryDefault implements config.APIDDeadlines.
+func (d *APIDDeadlinesConfig) UnaryDefault() time.Duration {
+	if d.APIDUnaryDefault == 0 {
+		return defaultAPIDUnaryDefaultDeadline
+	}
+
+	return d.APIDUnaryDefault
+}
+
+// UnaryMax implements config.APIDDeadlines.
+func (d *APIDDeadlinesConfig) UnaryMax() time.Duration {
+	if d.APIDUnaryMax == 0 {
+		return defaultAPIDUnaryMaxDeadline
+	}
+
+	return d.APIDUnaryMax
+}
+
+// StreamingDefault implements config.APIDDeadlines.
+func (d *APIDDeadlinesConfig) StreamingDefault() time.Duration {
+	if d.APIDStreamingDefault == 0 {
+		return defaultAPIDStreamingDefaultDeadline
+	}
+
+	return d.APIDStreamingDefault
+}
+
+// StreamingMax implements config.APIDDeadlines.
+func (d *APIDDeadlinesConfig) StreamingMax() time.Duration {
+	return d.APIDStreamingMax
+}
+
+// SyslogEndpoint implements config.AuditLog.
+func (a *AuditLogConfig) SyslogEndpoint() *url.URL {
+	if a.AuditLogSyslogEndpoint == nil {
+		return nil
+	}
+
+	return a.AuditLogSyslogEndpoint.URL
+}
+
+const defaultAPIOIDCGroupsClaim = "groups"
+
+// Enabled implements config.APIOIDC.
+func (o *APIOIDCConfig) Enabled() bool {
+	return o.OIDCIssuerURL != ""
+}
+
+// IssuerURL implements config.APIOIDC.
+func (o *APIOIDCConfig) IssuerURL() string {
+	return o.OIDCIssuerURL
+}
+
+// Audience implements config.APIOIDC.
+func (o *APIOIDCConfig) Audience() string {
+	return o.OIDCAudience
+}
+
+// GroupsClaim implements config.APIOIDC.
+func (o *APIOIDCConfig) GroupsClaim() string {
+	if o.OIDCGroupsClaim == "" {
+		return defaultAPIOIDCGroupsClaim
+	}
+
+	return o.OIDCGroupsClaim
+}
+
+// GroupRoles implements config.APIOIDC.
+func (o *APIOIDCConfig) GroupRoles() []config.APIOIDCGroupRoles {
+	return xslices.Map(o.OIDCGroupRoles, func(gr APIOIDCGroupRoles) config.APIOIDCGroupRoles { return gr })
+}
+
+// Group implements config.APIOIDCGroupRoles.
+func (gr APIOIDCGroupRoles) Group() string {
+	return gr.OIDCGroup
+}
+
+// Roles implements config.APIOIDCGroupRoles.
+func (gr APIOIDCGroupRoles) Roles() []string {
+	return gr.OIDCRoles
+}
+
+// Enabled implements config.Metrics.
+func (m *MetricsConfig) Enabled() bool {
+	return pointer.SafeDeref(m.MetricsEnabled)
+}
+
+// ListenAddress implements config.Metrics.
+func (m *MetricsConfig) ListenAddress() string {
+	if m.MetricsListenAddress == "" {
+		return net.JoinHostPort("127.0.0.1", strconv.Itoa(constants.MachinedMetricsPort))
+	}
+
+	return m.MetricsListenAddress
+}
+
+// Role implements config.ResourceAccessRule.
+func (r ResourceAccessRule) Role() string {
+	return r.AccessRole
+}
+
+// Namespaces implements config.ResourceAccessRule.
+func (r ResourceAccessRule) Namespaces() []string {
+	return r.AccessNamespaces
+}
+
+// Types implements config.ResourceAccessRule.
+func (r ResourceAccessRule) Types() []string {
+	return r.AccessTypes
+}
@@ -4,7 +4,12 @@
 
 package v1alpha1
 
-import "github.com/siderolabs/go-pointer"
+import (
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
 
 // Enabled implements config.KubernetesTalosAPIAccess.
 func (c *KubernetesTalosAPIAccessConfig) Enabled() bool {
@@ -32,3 +37,27 @@ func (c *KubernetesTalosAPIAccessConfig) AllowedKubernetesNamespaces() []string
 
 	return c.AccessAllowedKubernetesNamespaces
 }
+
+// ServiceAccounts implements config.KubernetesTalosAPIAccess.
+func (c *KubernetesTalosAPIAccessConfig) ServiceAccounts() []config.KubernetesTalosAPIAccessServiceAccount {
+	if c == nil {
+		return nil
+	}
+
+	return xslices.Map(c.AccessServiceAccounts, func(sa KubernetesTalosAPIAccessServiceAccount) config.KubernetesTalosAPIAccessServiceAccount { return sa })
+}
+
+// Namespace implements config.KubernetesTalosAPIAccessServiceAccount.
+func (sa KubernetesTalosAPIAccessServiceAccount) Namespace() string {
+	return sa.ServiceAccountNamespace
+}
+
+// Name implements config.KubernetesTalosAPIAccessServiceAccount.
+func (sa KubernetesTalosAPIAccessServiceAccount) Name() string {
+	return sa.ServiceAccountName
+}
+
+// Roles implements config.KubernetesTalosAPIAccessServiceAccount.
+func (sa KubernetesTalosAPIAccessServiceAccount) Roles() []string {
+	return sa.ServiceAccountRoles
+}
@@ -5,18 +5,23 @@
 package v1alpha1
 
 import (
+	stdx509 "crypto/x509"
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // Validate checks logging configuration for errors.
+//
+//nolint:gocyclo
 func (lc *LoggingConfig) Validate() error {
 	var errs *multierror.Error
 
@@ -26,6 +31,17 @@ func (lc *LoggingConfig) Validate() error {
 			endpoint = dest.LoggingEndpoint.URL
 		}
 
+		var schemes []string
+
+		switch f := dest.LoggingFormat; f {
+		case constants.LoggingFormatJSONLines, constants.LoggingFormatSyslog:
+			schemes = []string{"tcp", "udp", "tls"}
+		case constants.LoggingFormatLoki:
+			schemes = []string{"http", "https"}
+		default:
+			errs = multierror.Append(errs, fmt.Errorf("unknown logging format %q", f))
+		}
+
 		if endpoint == nil {
 			errs = multierror.Append(errs, errors.New("empty logging endpoint"))
 		} else {
@@ -33,16 +49,28 @@ func (lc *LoggingConfig) Validate() error {
 				errs = multierror.Append(errs, errors.New("empty logging endpoint's host"))
 			}
 
-			if endpoint.Scheme != "tcp" && endpoint.Scheme != "udp" {
-				errs = multierror.Append(errs, fmt.Errorf("unexpected logging endpoint scheme %q", endpoint.Scheme))
+			if schemes != nil && !slices.Contains(schemes, endpoint.Scheme) {
+				errs = multierror.Append(errs, fmt.Errorf("unexpected logging endpoint scheme %q for format %q", endpoint.Scheme, dest.LoggingFormat))
+			}
+
+			if dest.LoggingTLS != nil && endpoint.Scheme != "tls" && endpoint.Scheme != "https" {
+				errs = multierror.Append(errs, fmt.Errorf("logging endpoint %q doesn't use TLS, but TLS configuration is specified", endpoint.Redacted()))
 			}
 		}
 
-		switch f := dest.LoggingFormat; f {
-		case constants.LoggingFormatJSONLines:
-			// nothing
-		default:
-			errs = multierror.Append(errs, fmt.Errorf("unknown logging format %q", f))
+		for _, source := range dest.LoggingSources {
+			switch source {
+			case constants.LoggingSourceService, constants.LoggingSourceKernel, constants.LoggingSourceContainer:
+				// nothing
+			default:
+				errs = multierror.Append(errs, fmt.Errorf("unknown logging source %q", source))
+			}
+		}
+
+		if dest.LoggingTLS != nil && len(dest.LoggingTLS.TLSCA) > 0 {
+			if !stdx509.NewCertPool().AppendCertsFromPEM(dest.LoggingTLS.TLSCA) {
+				errs = multierror.Append(errs, errors.New("failed to parse logging destination CA certificate"))
+			}
 		}
 	}
 
@@ -68,3 +96,35 @@ func (ld LoggingDestination) ExtraTags() map[string]string {
 func (ld LoggingDestination) Format() string {
 	return ld.LoggingFormat
 }
+
+// Sources implements config.LoggingDestination interface.
+func (ld LoggingDestination) Sources() []string {
+	if len(ld.LoggingSources) == 0 {
+		return []string{constants.LoggingSourceService}
+	}
+
+	return ld.LoggingSources
+}
+
+// TLSCA implements config.LoggingDestination interface.
+func (ld LoggingDestination) TLSCA() []byte {
+	if ld.LoggingTLS == nil {
+		return nil
+	}
+
+	return ld.LoggingTLS.TLSCA
+}
+
+// TLSInsecureSkipVerify implements config.LoggingDestination interface.
+func (ld LoggingDestination) TLSInsecureSkipVerify() bool {
+	if ld.LoggingTLS == nil {
+		return false
+	}
+
+	return pointer.SafeDeref(ld.LoggingTLS.TLSInsecureSkipVerify)
+}
+
+// SpoolMaxSize implements config.LoggingDestination interface.
+func (ld LoggingDestination) SpoolMaxSize() uint64 {
+	return uint64(ld.LoggingSpoolMaxSize)
+}
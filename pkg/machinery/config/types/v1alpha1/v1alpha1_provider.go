@@ -14,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/xslices"
@@ -458,6 +459,23 @@ func (k *KubeletConfig) DisableManifestsDirectory() bool {
 	return pointer.SafeDeref(k.KubeletDisableManifestsDirectory)
 }
 
+// ShutdownGracePeriodByPodPriority implements the config.Provider interface.
+func (k *KubeletConfig) ShutdownGracePeriodByPodPriority() []config.KubeletShutdownGracePeriod {
+	return xslices.Map(k.KubeletShutdownGracePeriodByPodPriority, func(c KubeletShutdownGracePeriodConfig) config.KubeletShutdownGracePeriod {
+		return c
+	})
+}
+
+// Priority implements the config.Provider interface.
+func (c KubeletShutdownGracePeriodConfig) Priority() int32 {
+	return c.KubeletShutdownPriority
+}
+
+// GracePeriod implements the config.Provider interface.
+func (c KubeletShutdownGracePeriodConfig) GracePeriod() time.Duration {
+	return c.KubeletShutdownGracePeriod
+}
+
 // ValidSubnets implements the config.Provider interface.
 func (k *KubeletNodeIPConfig) ValidSubnets() []string {
 	return k.KubeletNodeIPValidSubnets
@@ -789,6 +807,26 @@ func (d *DHCPOptions) DUIDv6() string {
 	return d.DHCPDUIDv6
 }
 
+// ClientIdentifier implements the DHCPOptions interface.
+func (d *DHCPOptions) ClientIdentifier() string {
+	return d.DHCPClientIdentifier
+}
+
+// VendorClassIdentifier implements the DHCPOptions interface.
+func (d *DHCPOptions) VendorClassIdentifier() string {
+	return d.DHCPVendorClassIdentifier
+}
+
+// RequestedOptions implements the DHCPOptions interface.
+func (d *DHCPOptions) RequestedOptions() []uint8 {
+	return d.DHCPRequestedOptions
+}
+
+// PersistLease implements the DHCPOptions interface.
+func (d *DHCPOptions) PersistLease() bool {
+	return pointer.SafeDeref(d.DHCPPersistLease)
+}
+
 // PrivateKey implements the MachineNetwork interface.
 func (wc *DeviceWireguardConfig) PrivateKey() string {
 	return wc.WireguardPrivateKey
@@ -1282,6 +1320,25 @@ func (i *InstallConfig) LegacyBIOSSupport() bool {
 	return pointer.SafeDeref(i.InstallLegacyBIOSSupport)
 }
 
+// PullRateLimit implements the config.Provider interface.
+func (i *InstallConfig) PullRateLimit() uint64 {
+	if i.InstallPullRateLimit == "" {
+		return 0
+	}
+
+	limit, err := humanize.ParseBytes(i.InstallPullRateLimit)
+	if err != nil {
+		return 0
+	}
+
+	return limit
+}
+
+// SigningCertificates implements the config.Provider interface.
+func (i *InstallConfig) SigningCertificates() []*x509.PEMEncodedCertificate {
+	return slices.Clone(i.InstallSigningCertificates)
+}
+
 // WithBootloader implements the config.Provider interface.
 func (i *InstallConfig) WithBootloader() bool {
 	if i.InstallBootloader == nil {
@@ -1361,6 +1418,21 @@ func (f *MachineFile) Op() string {
 	return f.FileOp
 }
 
+// URL implements the config.Provider interface.
+func (f *MachineFile) URL() string {
+	return f.FileURL
+}
+
+// Checksum implements the config.Provider interface.
+func (f *MachineFile) Checksum() string {
+	return f.FileChecksum
+}
+
+// Template implements the config.Provider interface.
+func (f *MachineFile) Template() bool {
+	return pointer.SafeDeref(f.FileTemplate)
+}
+
 // Device implements the config.Provider interface.
 func (d *MachineDisk) Device() string {
 	return d.DeviceName
@@ -1490,11 +1562,58 @@ func (e *EncryptionKeyKMS) Endpoint() string {
 	return e.KMSEndpoint
 }
 
+// ClientIdentity implements the config.Provider interface.
+func (e *EncryptionKeyKMS) ClientIdentity() *x509.PEMEncodedCertificateAndKey {
+	return e.KMSClientIdentity
+}
+
+// CA implements the config.Provider interface.
+func (e *EncryptionKeyKMS) CA() []byte {
+	return e.KMSCA
+}
+
+// VaultTransit implements the config.Provider interface.
+func (e *EncryptionKeyKMS) VaultTransit() config.EncryptionKeyKMSVaultTransit {
+	if e.KMSVaultTransit == nil {
+		return nil
+	}
+
+	return e.KMSVaultTransit
+}
+
+// Lockout implements the config.Provider interface.
+func (e *EncryptionKeyKMS) Lockout() bool {
+	return pointer.SafeDeref(e.KMSLockout)
+}
+
 // String implements the config.Provider interface.
 func (e *EncryptionKeyKMS) String() string {
 	return "kms"
 }
 
+// KeyName implements the config.Provider interface.
+func (v *EncryptionKeyKMSVaultTransit) KeyName() string {
+	return v.VaultKeyName
+}
+
+// MountPath implements the config.Provider interface.
+func (v *EncryptionKeyKMSVaultTransit) MountPath() string {
+	if v.VaultMountPath == "" {
+		return "transit"
+	}
+
+	return v.VaultMountPath
+}
+
+// AuthMountPath implements the config.Provider interface.
+func (v *EncryptionKeyKMSVaultTransit) AuthMountPath() string {
+	if v.VaultAuthMountPath == "" {
+		return "cert"
+	}
+
+	return v.VaultAuthMountPath
+}
+
 // Get implements the config.Provider interface.
 func (e *SystemDiskEncryptionConfig) Get(label string) config.Encryption {
 	switch label {
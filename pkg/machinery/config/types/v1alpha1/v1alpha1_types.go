@@ -673,6 +673,28 @@ type KubeletConfig struct {
 	//     - false
 	//     - no
 	KubeletDisableManifestsDirectory *bool `yaml:"disableManifestsDirectory,omitempty"`
+	//   description: |
+	//     The `shutdownGracePeriodByPodPriority` field configures kubelet graceful node shutdown grace periods based on the pod priority.
+	//     Pods with the priority equal or higher than the `priority` (and lower than the next entry) get the `gracePeriod` to terminate.
+	//     If not set, kubelet uses a shutdown grace period of 30s (10s for critical pods).
+	//     The graceful shutdown is triggered on reboot, shutdown and upgrade.
+	//   examples:
+	//     - value: kubeletShutdownGracePeriodByPodPriorityExample()
+	KubeletShutdownGracePeriodByPodPriority []KubeletShutdownGracePeriodConfig `yaml:"shutdownGracePeriodByPodPriority,omitempty"`
+}
+
+// KubeletShutdownGracePeriodConfig represents the kubelet shutdown grace period for the pod priority.
+type KubeletShutdownGracePeriodConfig struct {
+	//  description: |
+	//    The minimum pod priority for the grace period.
+	KubeletShutdownPriority int32 `yaml:"priority"`
+	//  description: |
+	//    The grace period for the pods of the priority.
+	//    Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//  schema:
+	//    type: string
+	//    pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	KubeletShutdownGracePeriod time.Duration `yaml:"gracePeriod"`
 }
 
 // KubeletNodeIPConfig represents the kubelet node IP configuration.
@@ -682,7 +704,11 @@ type KubeletNodeIPConfig struct {
 	//    For dual stack configuration, there should be two subnets: one for IPv4, another for IPv6.
 	//    IPs can be excluded from the list by using negative match with `!`, e.g `!10.0.0.0/8`.
 	//    Negative subnet matches should be specified last to filter out IPs picked by positive matches.
+	//    IPs can be also matched by the name of the link they are assigned to with `interface:` prefix,
+	//    e.g. `interface:eth0` or `!interface:enp*` (shell glob patterns are supported).
 	//    If not specified, node IP is picked based on cluster podCIDRs: IPv4/IPv6 address or both.
+	//  examples:
+	//    - value: kubeletNodeIPValidSubnetsExample()
 	KubeletNodeIPValidSubnets []string `yaml:"validSubnets,omitempty"`
 }
 
@@ -828,6 +854,25 @@ type InstallConfig struct {
 	//     Indicates if MBR partition should be marked as bootable (active).
 	//     Should be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme.
 	InstallLegacyBIOSSupport *bool `yaml:"legacyBIOSSupport,omitempty"`
+	//   description: |
+	//     Limits the download rate of the installer image pulls during install and upgrade (per second).
+	//     Value is a size in bytes, e.g. `10MB` or `50MiB`.
+	//     Can be overridden for a specific upgrade via the API.
+	//   examples:
+	//     - value: '"10MB"'
+	InstallPullRateLimit string `yaml:"pullRateLimit,omitempty"`
+	//   description: |
+	//     The certificates used to verify the signatures of the boot assets in the installer image on upgrade.
+	//     When set, the UKI (or the kernel and initramfs detached signatures) of the new installer image should be signed
+	//     by one of the certificates, otherwise the upgrade is rejected before the boot assets are written to the disk.
+	//     It is composed of a base64 encoded `crt`.
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       crt:
+	//         type: string
+	InstallSigningCertificates []*x509.PEMEncodedCertificate `yaml:"signingCertificates,omitempty"`
 }
 
 // InstallDiskSizeMatcher disk size condition parser.
@@ -1374,6 +1419,8 @@ type EtcdConfig struct {
 	//
 	//    IPs can be excluded from the list by using negative match with `!`, e.g `!10.0.0.0/8`.
 	//    Negative subnet matches should be specified last to filter out IPs picked by positive matches.
+	//    IPs can be also matched by the name of the link they are assigned to with `interface:` prefix,
+	//    e.g. `interface:eth0` or `!interface:enp*` (shell glob patterns are supported).
 	//    If not specified, advertised IP is selected as the first routable address of the node.
 	//
 	//  examples:
@@ -1389,6 +1436,8 @@ type EtcdConfig struct {
 	//
 	//    IPs can be excluded from the list by using negative match with `!`, e.g `!10.0.0.0/8`.
 	//    Negative subnet matches should be specified last to filter out IPs picked by positive matches.
+	//    IPs can be also matched by the name of the link they are assigned to with `interface:` prefix,
+	//    e.g. `interface:eth0` or `!interface:enp*` (shell glob patterns are supported).
 	//    If not specified, advertised IP is selected as the first routable address of the node.
 	EtcdListenSubnets []string `yaml:"listenSubnets,omitempty"`
 }
@@ -1441,6 +1490,41 @@ type CNIConfig struct {
 	//   description: |
 	//		Flannel configuration options.
 	CNIFlannel *FlannelCNIConfig `yaml:"flannel,omitempty"`
+	//   description: |
+	//     Readiness checks for the CNI which is installed outside of Talos.
+	//     Can be set only for "custom" and "none", as Talos doesn't know when such CNI is running.
+	//   examples:
+	//     - value: clusterCNIReadinessExample()
+	CNIReadiness *CNIReadinessConfig `yaml:"readiness,omitempty"`
+}
+
+// CNIReadinessConfig represents the readiness checks for the CNI which is not managed by Talos.
+type CNIReadinessConfig struct {
+	//   description: |
+	//     Readiness checks mode.
+	//     "default" waits for all nodes to be ready, and for kube-proxy and CoreDNS to be running.
+	//     "disabled" skips the checks which depend on the CNI, so that the machine is reported ready,
+	//     and the cluster health checks pass before the CNI is installed (e.g. with Helm after the bootstrap).
+	//     "custom" waits for the DaemonSets in "daemonSets" to be ready before running the default checks.
+	//   values:
+	//     - default
+	//     - disabled
+	//     - custom
+	ReadinessMode string `yaml:"mode,omitempty"`
+	//   description: |
+	//     DaemonSets of the CNI to wait for, in the "namespace/name" format.
+	//     Should be present for "custom", must be empty for "default" and "disabled".
+	//   examples:
+	//     - value: >
+	//         []string{"kube-system/cilium"}
+	ReadinessDaemonSets []string `yaml:"daemonSets,omitempty"`
+	//   description: |
+	//     Timeout to wait for the DaemonSets to be ready (default is 30 minutes).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ReadinessTimeout time.Duration `yaml:"timeout,omitempty"`
 }
 
 // FlannelCNIConfig represents the Flannel CNI configuration options.
@@ -1624,6 +1708,55 @@ type EncryptionKeyKMS struct {
 	//   description: >
 	//     KMS endpoint to Seal/Unseal the key.
 	KMSEndpoint string `yaml:"endpoint"`
+	//   description: |
+	//     Client certificate and key to authenticate to the KMS endpoint (mutual TLS).
+	//     Client certificate and key should be base64-encoded.
+	//   examples:
+	//     - value: pemEncodedCertificateExample()
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       crt:
+	//         type: string
+	//       key:
+	//         type: string
+	KMSClientIdentity *x509.PEMEncodedCertificateAndKey `yaml:"clientIdentity,omitempty"`
+	//   description: |
+	//     CA certificate to verify the KMS endpoint certificate (instead of the system trusted roots).
+	//     Certificate should be base64-encoded.
+	//   schema:
+	//     type: string
+	KMSCA Base64Bytes `yaml:"ca,omitempty"`
+	//   description: |
+	//     Seal/Unseal the key with the HashiCorp Vault transit secrets engine instead of the KMS API.
+	//
+	//     The node authenticates to Vault with the TLS certificate auth method using the client identity.
+	//   examples:
+	//     - value: kmsVaultTransitExample()
+	KMSVaultTransit *EncryptionKeyKMSVaultTransit `yaml:"vaultTransit,omitempty"`
+	//   description: |
+	//     Keep the volume locked while the key can't be unsealed by the KMS.
+	//
+	//     By default, if the KMS endpoint is unreachable or denies the request, the volume is opened with the other key slots.
+	//     With the lockout enabled, the other key slots are not used, and the volume stays locked until the KMS unseals the key,
+	//     so revoking the key in the KMS locks out the node.
+	KMSLockout *bool `yaml:"lockout,omitempty"`
+}
+
+// EncryptionKeyKMSVaultTransit represents the HashiCorp Vault transit secrets engine settings.
+type EncryptionKeyKMSVaultTransit struct {
+	//   description: |
+	//     Name of the transit encryption key.
+	//
+	//     The node UUID is passed as the key derivation context, so a key created with `derived=true` binds the sealed data to the node.
+	VaultKeyName string `yaml:"keyName"`
+	//   description: >
+	//     Mount path of the transit secrets engine (defaults to `transit`).
+	VaultMountPath string `yaml:"mountPath,omitempty"`
+	//   description: >
+	//     Mount path of the TLS certificate auth method (defaults to `cert`).
+	VaultAuthMountPath string `yaml:"authMountPath,omitempty"`
 }
 
 // EncryptionKeyTPM represents a key that is generated and then sealed/unsealed by the TPM.
@@ -1683,8 +1816,27 @@ func (fm FileMode) MarshalYAML() (any, error) {
 
 // MachineFile represents a file to write to disk.
 type MachineFile struct {
-	//   description: The contents of the file.
-	FileContent string `yaml:"content"`
+	//   description: |
+	//     The contents of the file.
+	//     Mutually exclusive with `url`.
+	FileContent string `yaml:"content,omitempty"`
+	//   description: |
+	//     The URL to download the contents of the file from.
+	//     Mutually exclusive with `content`, requires `checksum` to be set.
+	//   examples:
+	//     - value: '"https://example.com/config/node.conf"'
+	FileURL string `yaml:"url,omitempty"`
+	//   description: |
+	//     The checksum of the contents downloaded from the `url`.
+	//     Format is `<algorithm>:<hex digest>`, supported algorithms are `sha256` and `sha512`.
+	//   examples:
+	//     - value: '"sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"'
+	FileChecksum string `yaml:"checksum,omitempty"`
+	//   description: |
+	//     Render the contents of the file as a Go template.
+	//
+	//     Node facts available in the template: `{{ .Hostname }}`, `{{ .NodeIP }}`, `{{ .MachineType }}`.
+	FileTemplate *bool `yaml:"template,omitempty"`
 	//   description: The file's permissions in octal.
 	//   schema:
 	//     type: integer
@@ -1803,6 +1955,31 @@ type DHCPOptions struct {
 	DHCPIPv6 *bool `yaml:"ipv6,omitempty"`
 	//   description: Set client DUID (hex string).
 	DHCPDUIDv6 string `yaml:"duidv6,omitempty"`
+	//   description: |
+	//     Set DHCPv4 client identifier (option 61, hex string).
+	//
+	//     By default, the client identifier is not sent, and the DHCP server uses the hardware address to identify the client.
+	//   examples:
+	//     - value: '"01:00:11:22:33:44:55"'
+	DHCPClientIdentifier string `yaml:"clientIdentifier,omitempty"`
+	//   description: Set DHCPv4 vendor class identifier (option 60).
+	//   examples:
+	//     - value: '"talos-worker"'
+	DHCPVendorClassIdentifier string `yaml:"vendorClassIdentifier,omitempty"`
+	//   description: |
+	//     Additional DHCPv4 options to request from the DHCP server (parameter request list, option 55).
+	//
+	//     Received options are exposed in the `DHCPOptionsStatus` resource.
+	//   examples:
+	//     - value: '[]uint8{119, 43}'
+	DHCPRequestedOptions []uint8 `yaml:"requestedOptions,omitempty"`
+	//   description: |
+	//     Persist the DHCPv4 lease on the STATE partition and attempt to reuse it on boot.
+	//
+	//     On boot, the persisted lease is confirmed with the DHCP server (INIT-REBOOT, RFC 2131),
+	//     if the server doesn't respond, the lease is used for the remainder of its lease time.
+	//     Lease details are exposed in the `DHCPLeaseStatus` resource.
+	DHCPPersistLease *bool `yaml:"persistLease,omitempty"`
 }
 
 // DeviceWireguardConfig contains settings for configuring Wireguard network interface.
@@ -2201,6 +2378,48 @@ type FeaturesConfig struct {
 	//   description: |
 	//     Configures host DNS caching resolver.
 	HostDNSSupport *HostDNSConfig `yaml:"hostDNS,omitempty"`
+	//   description: |
+	//     Configures default and maximum deadlines for Talos API calls handled by apid.
+	//   examples:
+	//     - value: apidDeadlinesConfigExample()
+	APIDDeadlinesConfig *APIDDeadlinesConfig `yaml:"apidDeadlines,omitempty"`
+	//   description: |
+	//     Mirror significant machine events (upgrades, configuration changes, service failures)
+	//     as Kubernetes Events attached to the Node object.
+	KubernetesEvents *bool `yaml:"kubernetesEvents,omitempty"`
+	//   description: |
+	//     Enable the emergency console API which opens a restricted diagnostic shell in a throwaway container.
+	//     Every session is recorded to the audit log.
+	EmergencyConsole *bool `yaml:"emergencyConsole,omitempty"`
+	//   description: |
+	//     Configures the audit log of the Talos API calls.
+	//   examples:
+	//     - value: auditLogConfigExample()
+	AuditLogConfig *AuditLogConfig `yaml:"auditLog,omitempty"`
+	//   description: |
+	//     Configures authentication of the Talos API clients with OIDC bearer tokens.
+	//
+	//     Clients which don't present a client certificate can authenticate with an ID token issued by the OIDC provider,
+	//     the groups of the token are mapped to the Talos API roles.
+	//     Requires RBAC to be enabled.
+	//   examples:
+	//     - value: apiOIDCConfigExample()
+	APIOIDCConfig *APIOIDCConfig `yaml:"apiOIDC,omitempty"`
+	//   description: |
+	//     Configures the Prometheus metrics endpoint of machined.
+	//   examples:
+	//     - value: metricsConfigExample()
+	MetricsConfig *MetricsConfig `yaml:"metrics,omitempty"`
+	//   description: |
+	//     Restricts the access of the roles to the resources via the Talos API.
+	//
+	//     The roles which are listed in the policy can only access the resources matching one of the rules of the role,
+	//     the roles which are not listed are not restricted.
+	//     Each role of the caller is evaluated separately, so the access of the caller is the union of the access granted by its roles.
+	//     The sensitive resources (e.g. secrets) are still accessible only with the `os:admin` role.
+	//   examples:
+	//     - value: resourceAccessPolicyExample()
+	ResourceAccessPolicyRules []ResourceAccessRule `yaml:"resourceAccessPolicy,omitempty"`
 }
 
 // KubePrism describes the configuration for the KubePrism load balancer.
@@ -2226,6 +2445,33 @@ type KubernetesTalosAPIAccessConfig struct {
 	//   description: |
 	//     The list of Kubernetes namespaces Talos API access is available from.
 	AccessAllowedKubernetesNamespaces []string `yaml:"allowedKubernetesNamespaces,omitempty"`
+	//   description: |
+	//     The list of Kubernetes service accounts which can access Talos API with service account tokens.
+	//
+	//     When not empty, the node exposes the service account bridge on the port 50002,
+	//     which accepts Talos API requests authenticated with Kubernetes service account tokens
+	//     (projected with the `talos` audience) and maps them to the Talos API roles.
+	//     Only the requests to the node itself are served, requests are not forwarded to other nodes.
+	//   examples:
+	//     - value: kubernetesTalosAPIAccessServiceAccountsExample()
+	AccessServiceAccounts []KubernetesTalosAPIAccessServiceAccount `yaml:"serviceAccounts,omitempty"`
+}
+
+// KubernetesTalosAPIAccessServiceAccount maps a Kubernetes service account to the Talos API roles.
+type KubernetesTalosAPIAccessServiceAccount struct {
+	//   description: |
+	//     The namespace of the service account.
+	//
+	//     The namespace should be in the list of allowed Kubernetes namespaces.
+	ServiceAccountNamespace string `yaml:"namespace"`
+	//   description: |
+	//     The name of the service account.
+	ServiceAccountName string `yaml:"name"`
+	//   description: |
+	//     The list of Talos API roles granted to the service account.
+	//
+	//     The roles should be in the list of allowed roles.
+	ServiceAccountRoles []string `yaml:"roles"`
 }
 
 // HostDNSConfig describes the configuration for the host DNS resolver.
@@ -2247,6 +2493,128 @@ type HostDNSConfig struct {
 	HostDNSResolveMemberNames *bool `yaml:"resolveMemberNames,omitempty"`
 }
 
+// APIDDeadlinesConfig describes the default and maximum deadlines for Talos API calls.
+//
+// Unary calls are the calls which return a single response, streaming calls are
+// the calls which return a stream of responses (e.g. logs, events, dmesg).
+type APIDDeadlinesConfig struct {
+	//   description: |
+	//     Deadline applied to the unary calls which don't specify a deadline (default is 10 minutes).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	APIDUnaryDefault time.Duration `yaml:"unaryDefault,omitempty"`
+	//   description: |
+	//     Maximum deadline of the unary calls (default is 1 hour).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	APIDUnaryMax time.Duration `yaml:"unaryMax,omitempty"`
+	//   description: |
+	//     Deadline applied to the streaming calls which don't specify a deadline (default is 24 hours).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	APIDStreamingDefault time.Duration `yaml:"streamingDefault,omitempty"`
+	//   description: |
+	//     Maximum deadline of the streaming calls (default is no maximum).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	APIDStreamingMax time.Duration `yaml:"streamingMax,omitempty"`
+}
+
+// AuditLogConfig describes the audit log of the Talos API calls.
+//
+// The most recent records are always kept in memory and are available via the AuditService API.
+type AuditLogConfig struct {
+	//   description: |
+	//     Send the audit records to the remote syslog server.
+	//     Supported protocols are "tcp" and "udp".
+	//   examples:
+	//     - value: auditLogSyslogEndpointExample()
+	AuditLogSyslogEndpoint *Endpoint `yaml:"syslogEndpoint,omitempty"`
+}
+
+// APIOIDCConfig describes the authentication of the Talos API clients with OIDC bearer tokens.
+//
+// The tokens are passed in the `authorization` gRPC metadata as `Bearer <token>`,
+// clients which present a client certificate are authenticated with the certificate.
+type APIOIDCConfig struct {
+	//   description: |
+	//     The URL of the OIDC provider, it should match the `iss` claim of the tokens.
+	//
+	//     The provider configuration is discovered via the `/.well-known/openid-configuration` endpoint.
+	//   examples:
+	//     - value: '"https://login.example.com/realms/talos"'
+	OIDCIssuerURL string `yaml:"issuerURL"`
+	//   description: |
+	//     The audience of the tokens, it should be present in the `aud` claim of the tokens (usually the OIDC client ID).
+	//   examples:
+	//     - value: '"talos"'
+	OIDCAudience string `yaml:"audience"`
+	//   description: |
+	//     The name of the token claim which lists the groups of the user (default is `groups`).
+	OIDCGroupsClaim string `yaml:"groupsClaim,omitempty"`
+	//   description: |
+	//     The mapping of the groups to the Talos API roles.
+	//
+	//     The client is granted the roles of all of its groups, tokens which don't map to any role are rejected.
+	OIDCGroupRoles []APIOIDCGroupRoles `yaml:"groupRoles,omitempty"`
+}
+
+// APIOIDCGroupRoles maps a group of the OIDC tokens to the Talos API roles.
+type APIOIDCGroupRoles struct {
+	//   description: |
+	//     The name of the group.
+	OIDCGroup string `yaml:"group"`
+	//   description: |
+	//     The list of Talos API roles granted to the members of the group.
+	OIDCRoles []string `yaml:"roles"`
+}
+
+// MetricsConfig describes the Prometheus metrics endpoint of machined.
+//
+// The endpoint exposes the controller runtime, Talos API, boot sequence and extension metrics on the `/metrics` path.
+type MetricsConfig struct {
+	//   description: |
+	//     Enable the metrics endpoint.
+	MetricsEnabled *bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     The address the metrics endpoint listens on (default is `127.0.0.1:50003`).
+	//
+	//     If the address is not a loopback address, the endpoint is served over HTTPS and requires
+	//     a client certificate issued by the Talos API CA (e.g. the certificate from the `talosconfig`).
+	//   examples:
+	//     - value: '":50003"'
+	MetricsListenAddress string `yaml:"listenAddress,omitempty"`
+}
+
+// ResourceAccessRule allows the role to access the resources in the namespaces and of the types.
+type ResourceAccessRule struct {
+	//   description: |
+	//     The Talos API role the rule applies to, `os:admin` can't be restricted.
+	//   examples:
+	//     - value: '"os:reader"'
+	AccessRole string `yaml:"role"`
+	//   description: |
+	//     The list of the resource namespaces the role can access, empty list allows all namespaces.
+	//   examples:
+	//     - value: '[]string{"network"}'
+	AccessNamespaces []string `yaml:"namespaces,omitempty"`
+	//   description: |
+	//     The list of the resource types the role can access, empty list allows all types.
+	//
+	//     The type can be specified by the full resource type or by any of its aliases.
+	//   examples:
+	//     - value: '[]string{"AddressStatuses.net.talos.dev", "routes"}'
+	AccessTypes []string `yaml:"types,omitempty"`
+}
+
 // VolumeMountConfig struct describes extra volume mount for the static pods.
 type VolumeMountConfig struct {
 	//   description: |
@@ -2347,10 +2715,14 @@ type KubeSpanFilters struct {
 	//
 	//   By default, all addresses are advertised, and KubeSpan cycles through all endpoints until it finds one that works.
 	//
+	//   Filters use the same syntax as `.machine.kubelet.nodeIP.validSubnets`: subnets, `interface:` link name matches and their negations with `!`.
+	//
 	//   Default value: no filtering.
 	// examples:
 	//   - name: Exclude addresses in 192.168.0.0/16 subnet.
 	//     value: '[]string{"0.0.0.0/0", "!192.168.0.0/16", "::/0"}'
+	//   - name: Advertise only the addresses of the public interface.
+	//     value: '[]string{"interface:eth0"}'
 	KubeSpanFiltersEndpoints []string `yaml:"endpoints,omitempty"`
 }
 
@@ -2426,19 +2798,73 @@ type LoggingConfig struct {
 // LoggingDestination struct configures Talos logging destination.
 type LoggingDestination struct {
 	// description: |
-	//   Where to send logs. Supported protocols are "tcp" and "udp".
+	//   Where to send logs.
+	//   Supported protocols are "tcp", "udp" and "tls" for the "json_lines" and "syslog" formats,
+	//   and "http" and "https" for the "loki" format (the URL of the Loki push API).
 	// examples:
 	//   - value: loggingEndpointExample1()
 	//   - value: loggingEndpointExample2()
+	//   - value: loggingEndpointExample3()
 	LoggingEndpoint *Endpoint `yaml:"endpoint"`
 	// description: |
 	//   Logs format.
+	//
+	//   "syslog" is RFC5424 syslog (octet-counted framing over "tcp" and "tls"),
+	//   and "loki" is the Loki push API.
 	// values:
 	//   - json_lines
+	//   - syslog
+	//   - loki
 	LoggingFormat string `yaml:"format"`
 	// description: |
 	//   Extra tags (key-value) pairs to attach to every log message sent.
+	//
+	//   For the "loki" format, the extra tags are used as the stream labels.
 	LoggingExtraTags map[string]string `yaml:"extraTags,omitempty"`
+	// description: |
+	//   Logs to send to the destination, defaults to "service".
+	//
+	//   "service" is the logs of machined and Talos services, "kernel" is the kernel log,
+	//   and "container" is the logs of the Kubernetes containers.
+	// values:
+	//   - service
+	//   - kernel
+	//   - container
+	// examples:
+	//   - value: '[]string{"service", "kernel", "container"}'
+	LoggingSources []string `yaml:"sources,omitempty"`
+	// description: |
+	//   TLS configuration for the "tls" and "https" endpoints.
+	LoggingTLS *LoggingTLSConfig `yaml:"tls,omitempty"`
+	// description: |
+	//   Maximum size of the on-disk spool of the logs which couldn't be delivered.
+	//
+	//   If set, the logs are spooled to the EPHEMERAL partition while the destination is unavailable,
+	//   and are delivered once it is back.
+	//   Otherwise the logs are queued in memory, and the log delivery is paused once the queue is full.
+	// examples:
+	//   - name: Human readable representation.
+	//     value: DiskSize(64000000)
+	//   - name: Precise value in bytes.
+	//     value: 64 * 1024 * 1024
+	// schema:
+	//   oneOf:
+	//     - type: integer
+	//     - type: string
+	LoggingSpoolMaxSize DiskSize `yaml:"spoolMaxSize,omitempty"`
+}
+
+// LoggingTLSConfig struct configures the TLS connection to the logging destination.
+type LoggingTLSConfig struct {
+	//   description: |
+	//     CA certificate to verify the destination certificate (in addition to the system CA certificates).
+	//     Certificate should be base64-encoded.
+	//   schema:
+	//     type: string
+	TLSCA Base64Bytes `yaml:"ca,omitempty"`
+	//   description: |
+	//     Skip TLS server certificate verification (not recommended).
+	TLSInsecureSkipVerify *bool `yaml:"insecureSkipVerify,omitempty"`
 }
 
 // KernelConfig struct configures Talos Linux kernel.
@@ -2454,6 +2880,6 @@ type KernelModuleConfig struct {
 	//   Module name.
 	ModuleName string `yaml:"name"`
 	// description: |
-	//   Module parameters, changes applied after reboot.
+	//   Module parameters, changes to the parameters writable at runtime are applied immediately, others are applied after reboot.
 	ModuleParameters []string `yaml:"parameters,omitempty"`
 }
@@ -871,6 +871,13 @@ func (KubeletConfig) Doc() *encoder.Doc {
 					"no",
 				},
 			},
+			{
+				Name:        "shutdownGracePeriodByPodPriority",
+				Type:        "[]KubeletShutdownGracePeriodConfig",
+				Note:        "",
+				Description: "The `shutdownGracePeriodByPodPriority` field configures kubelet graceful node shutdown grace periods based on the pod priority.\nPods with the priority equal or higher than the `priority` (and lower than the next entry) get the `gracePeriod` to terminate.\nIf not set, kubelet uses a shutdown grace period of 30s (10s for critical pods).\nThe graceful shutdown is triggered on reboot, shutdown and upgrade.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The `shutdownGracePeriodByPodPriority` field configures kubelet graceful node shutdown grace periods based on the pod priority." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -885,6 +892,41 @@ func (KubeletConfig) Doc() *encoder.Doc {
 	doc.Fields[4].AddExample("", kubeletExtraConfigExample())
 	doc.Fields[5].AddExample("", kubeletCredentialProviderConfigExample())
 	doc.Fields[8].AddExample("", kubeletNodeIPExample())
+	doc.Fields[11].AddExample("", kubeletShutdownGracePeriodByPodPriorityExample())
+
+	return doc
+}
+
+func (KubeletShutdownGracePeriodConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KubeletShutdownGracePeriodConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KubeletShutdownGracePeriodConfig represents the kubelet shutdown grace period for the pod priority." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KubeletShutdownGracePeriodConfig represents the kubelet shutdown grace period for the pod priority.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "KubeletConfig",
+				FieldName: "shutdownGracePeriodByPodPriority",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "priority",
+				Type:        "int32",
+				Note:        "",
+				Description: "The minimum pod priority for the grace period.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The minimum pod priority for the grace period." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "gracePeriod",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The grace period for the pods of the priority.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The grace period for the pods of the priority." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", kubeletShutdownGracePeriodByPodPriorityExample())
 
 	return doc
 }
@@ -905,7 +947,7 @@ func (KubeletNodeIPConfig) Doc() *encoder.Doc {
 				Name:        "validSubnets",
 				Type:        "[]string",
 				Note:        "",
-				Description: "The `validSubnets` field configures the networks to pick kubelet node IP from.\nFor dual stack configuration, there should be two subnets: one for IPv4, another for IPv6.\nIPs can be excluded from the list by using negative match with `!`, e.g `!10.0.0.0/8`.\nNegative subnet matches should be specified last to filter out IPs picked by positive matches.\nIf not specified, node IP is picked based on cluster podCIDRs: IPv4/IPv6 address or both.",
+				Description: "The `validSubnets` field configures the networks to pick kubelet node IP from.\nFor dual stack configuration, there should be two subnets: one for IPv4, another for IPv6.\nIPs can be excluded from the list by using negative match with `!`, e.g `!10.0.0.0/8`.\nNegative subnet matches should be specified last to filter out IPs picked by positive matches.\nIPs can be also matched by the name of the link they are assigned to with `interface:` prefix,\ne.g. `interface:eth0` or `!interface:enp*` (shell glob patterns are supported).\nIf not specified, node IP is picked based on cluster podCIDRs: IPv4/IPv6 address or both.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The `validSubnets` field configures the networks to pick kubelet node IP from." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
@@ -913,6 +955,8 @@ func (KubeletNodeIPConfig) Doc() *encoder.Doc {
 
 	doc.AddExample("", kubeletNodeIPExample())
 
+	doc.Fields[0].AddExample("", kubeletNodeIPValidSubnetsExample())
+
 	return doc
 }
 
@@ -1057,6 +1101,20 @@ func (InstallConfig) Doc() *encoder.Doc {
 				Description: "Indicates if MBR partition should be marked as bootable (active).\nShould be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Indicates if MBR partition should be marked as bootable (active)." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "pullRateLimit",
+				Type:        "string",
+				Note:        "",
+				Description: "Limits the download rate of the installer image pulls during install and upgrade (per second).\nValue is a size in bytes, e.g. `10MB` or `50MiB`.\nCan be overridden for a specific upgrade via the API.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Limits the download rate of the installer image pulls during install and upgrade (per second)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "signingCertificates",
+				Type:        "[]PEMEncodedCertificate",
+				Note:        "",
+				Description: "The certificates used to verify the signatures of the boot assets in the installer image on upgrade.\nWhen set, the UKI (or the kernel and initramfs detached signatures) of the new installer image should be signed\nby one of the certificates, otherwise the upgrade is rejected before the boot assets are written to the disk.\nIt is composed of a base64 encoded `crt`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The certificates used to verify the signatures of the boot assets in the installer image on upgrade." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -1068,6 +1126,7 @@ func (InstallConfig) Doc() *encoder.Doc {
 	doc.Fields[2].AddExample("", []string{"talos.platform=metal", "reboot=k"})
 	doc.Fields[3].AddExample("", "ghcr.io/siderolabs/installer:latest")
 	doc.Fields[4].AddExample("", installExtensionsExample())
+	doc.Fields[8].AddExample("", "10MB")
 
 	return doc
 }
@@ -1316,6 +1375,10 @@ func (Endpoint) Doc() *encoder.Doc {
 				TypeName:  "ControlPlaneConfig",
 				FieldName: "endpoint",
 			},
+			{
+				TypeName:  "AuditLogConfig",
+				FieldName: "syslogEndpoint",
+			},
 			{
 				TypeName:  "LoggingDestination",
 				FieldName: "endpoint",
@@ -1330,10 +1393,14 @@ func (Endpoint) Doc() *encoder.Doc {
 
 	doc.AddExample("", clusterEndpointExample2())
 
+	doc.AddExample("", auditLogSyslogEndpointExample())
+
 	doc.AddExample("", loggingEndpointExample1())
 
 	doc.AddExample("", loggingEndpointExample2())
 
+	doc.AddExample("", loggingEndpointExample3())
+
 	return doc
 }
 
@@ -1705,14 +1772,14 @@ func (EtcdConfig) Doc() *encoder.Doc {
 				Name:        "advertisedSubnets",
 				Type:        "[]string",
 				Note:        "",
-				Description: "The `advertisedSubnets` field configures the networks to pick etcd advertised IP from.\n\nIPs can be excluded from the list by using negative match with `!`, e.g `!10.0.0.0/8`.\nNegative subnet matches should be specified last to filter out IPs picked by positive matches.\nIf not specified, advertised IP is selected as the first routable address of the node.",
+				Description: "The `advertisedSubnets` field configures the networks to pick etcd advertised IP from.\n\nIPs can be excluded from the list by using negative match with `!`, e.g `!10.0.0.0/8`.\nNegative subnet matches should be specified last to filter out IPs picked by positive matches.\nIPs can be also matched by the name of the link they are assigned to with `interface:` prefix,\ne.g. `interface:eth0` or `!interface:enp*` (shell glob patterns are supported).\nIf not specified, advertised IP is selected as the first routable address of the node.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The `advertisedSubnets` field configures the networks to pick etcd advertised IP from." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
 				Name:        "listenSubnets",
 				Type:        "[]string",
 				Note:        "",
-				Description: "The `listenSubnets` field configures the networks for the etcd to listen for peer and client connections.\n\nIf `listenSubnets` is not set, but `advertisedSubnets` is set, `listenSubnets` defaults to\n`advertisedSubnets`.\n\nIf neither `advertisedSubnets` nor `listenSubnets` is set, `listenSubnets` defaults to listen on all addresses.\n\nIPs can be excluded from the list by using negative match with `!`, e.g `!10.0.0.0/8`.\nNegative subnet matches should be specified last to filter out IPs picked by positive matches.\nIf not specified, advertised IP is selected as the first routable address of the node.",
+				Description: "The `listenSubnets` field configures the networks for the etcd to listen for peer and client connections.\n\nIf `listenSubnets` is not set, but `advertisedSubnets` is set, `listenSubnets` defaults to\n`advertisedSubnets`.\n\nIf neither `advertisedSubnets` nor `listenSubnets` is set, `listenSubnets` defaults to listen on all addresses.\n\nIPs can be excluded from the list by using negative match with `!`, e.g `!10.0.0.0/8`.\nNegative subnet matches should be specified last to filter out IPs picked by positive matches.\nIPs can be also matched by the name of the link they are assigned to with `interface:` prefix,\ne.g. `interface:eth0` or `!interface:enp*` (shell glob patterns are supported).\nIf not specified, advertised IP is selected as the first routable address of the node.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The `listenSubnets` field configures the networks for the etcd to listen for peer and client connections." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
@@ -1818,11 +1885,68 @@ func (CNIConfig) Doc() *encoder.Doc {
 				Description: "description: |\nFlannel configuration options.\n",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "description: |" /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "readiness",
+				Type:        "CNIReadinessConfig",
+				Note:        "",
+				Description: "Readiness checks for the CNI which is installed outside of Talos.\nCan be set only for \"custom\" and \"none\", as Talos doesn't know when such CNI is running.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Readiness checks for the CNI which is installed outside of Talos." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", clusterCustomCNIExample())
 
+	doc.Fields[3].AddExample("", clusterCNIReadinessExample())
+
+	return doc
+}
+
+func (CNIReadinessConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "CNIReadinessConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "CNIReadinessConfig represents the readiness checks for the CNI which is not managed by Talos." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "CNIReadinessConfig represents the readiness checks for the CNI which is not managed by Talos.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "CNIConfig",
+				FieldName: "readiness",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "mode",
+				Type:        "string",
+				Note:        "",
+				Description: "Readiness checks mode.\n\"default\" waits for all nodes to be ready, and for kube-proxy and CoreDNS to be running.\n\"disabled\" skips the checks which depend on the CNI, so that the machine is reported ready,\nand the cluster health checks pass before the CNI is installed (e.g. with Helm after the bootstrap).\n\"custom\" waits for the DaemonSets in \"daemonSets\" to be ready before running the default checks.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Readiness checks mode." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"default",
+					"disabled",
+					"custom",
+				},
+			},
+			{
+				Name:        "daemonSets",
+				Type:        "[]string",
+				Note:        "",
+				Description: "DaemonSets of the CNI to wait for, in the \"namespace/name\" format.\nShould be present for \"custom\", must be empty for \"default\" and \"disabled\".",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "DaemonSets of the CNI to wait for, in the \"namespace/name\" format." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout to wait for the DaemonSets to be ready (default is 30 minutes).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout to wait for the DaemonSets to be ready (default is 30 minutes)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", clusterCNIReadinessExample())
+
+	doc.Fields[1].AddExample("", []string{"kube-system/cilium"})
+
 	return doc
 }
 
@@ -2172,11 +2296,83 @@ func (EncryptionKeyKMS) Doc() *encoder.Doc {
 				Description: "KMS endpoint to Seal/Unseal the key.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "KMS endpoint to Seal/Unseal the key." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "clientIdentity",
+				Type:        "PEMEncodedCertificateAndKey",
+				Note:        "",
+				Description: "Client certificate and key to authenticate to the KMS endpoint (mutual TLS).\nClient certificate and key should be base64-encoded.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Client certificate and key to authenticate to the KMS endpoint (mutual TLS)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ca",
+				Type:        "Base64Bytes",
+				Note:        "",
+				Description: "CA certificate to verify the KMS endpoint certificate (instead of the system trusted roots).\nCertificate should be base64-encoded.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "CA certificate to verify the KMS endpoint certificate (instead of the system trusted roots)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vaultTransit",
+				Type:        "EncryptionKeyKMSVaultTransit",
+				Note:        "",
+				Description: "Seal/Unseal the key with the HashiCorp Vault transit secrets engine instead of the KMS API.\n\nThe node authenticates to Vault with the TLS certificate auth method using the client identity.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Seal/Unseal the key with the HashiCorp Vault transit secrets engine instead of the KMS API." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "lockout",
+				Type:        "bool",
+				Note:        "",
+				Description: "Keep the volume locked while the key can't be unsealed by the KMS.\n\nBy default, if the KMS endpoint is unreachable or denies the request, the volume is opened with the other key slots.\nWith the lockout enabled, the other key slots are not used, and the volume stays locked until the KMS unseals the key,\nso revoking the key in the KMS locks out the node.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Keep the volume locked while the key can't be unsealed by the KMS." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", kmsKeyExample())
 
+	doc.Fields[1].AddExample("", pemEncodedCertificateExample())
+	doc.Fields[3].AddExample("", kmsVaultTransitExample())
+
+	return doc
+}
+
+func (EncryptionKeyKMSVaultTransit) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKeyKMSVaultTransit",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKeyKMSVaultTransit represents the HashiCorp Vault transit secrets engine settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKeyKMSVaultTransit represents the HashiCorp Vault transit secrets engine settings.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionKeyKMS",
+				FieldName: "vaultTransit",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "keyName",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the transit encryption key.\n\nThe node UUID is passed as the key derivation context, so a key created with `derived=true` binds the sealed data to the node.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the transit encryption key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mountPath",
+				Type:        "string",
+				Note:        "",
+				Description: "Mount path of the transit secrets engine (defaults to `transit`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mount path of the transit secrets engine (defaults to `transit`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "authMountPath",
+				Type:        "string",
+				Note:        "",
+				Description: "Mount path of the TLS certificate auth method (defaults to `cert`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mount path of the TLS certificate auth method (defaults to `cert`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", kmsVaultTransitExample())
+
 	return doc
 }
 
@@ -2281,9 +2477,30 @@ func (MachineFile) Doc() *encoder.Doc {
 				Name:        "content",
 				Type:        "string",
 				Note:        "",
-				Description: "The contents of the file.",
+				Description: "The contents of the file.\nMutually exclusive with `url`.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The contents of the file." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "url",
+				Type:        "string",
+				Note:        "",
+				Description: "The URL to download the contents of the file from.\nMutually exclusive with `content`, requires `checksum` to be set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The URL to download the contents of the file from." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "checksum",
+				Type:        "string",
+				Note:        "",
+				Description: "The checksum of the contents downloaded from the `url`.\nFormat is `<algorithm>:<hex digest>`, supported algorithms are `sha256` and `sha512`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The checksum of the contents downloaded from the `url`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "template",
+				Type:        "bool",
+				Note:        "",
+				Description: "Render the contents of the file as a Go template.\n\nNode facts available in the template: `{{ .Hostname }}`, `{{ .NodeIP }}`, `{{ .MachineType }}`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Render the contents of the file as a Go template." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 			{
 				Name:        "permissions",
 				Type:        "FileMode",
@@ -2315,6 +2532,9 @@ func (MachineFile) Doc() *encoder.Doc {
 
 	doc.AddExample("MachineFiles usage example.", machineFilesExample())
 
+	doc.Fields[1].AddExample("", "https://example.com/config/node.conf")
+	doc.Fields[2].AddExample("", "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+
 	return doc
 }
 
@@ -2528,11 +2748,43 @@ func (DHCPOptions) Doc() *encoder.Doc {
 				Description: "Set client DUID (hex string).",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Set client DUID (hex string)." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "clientIdentifier",
+				Type:        "string",
+				Note:        "",
+				Description: "Set DHCPv4 client identifier (option 61, hex string).\n\nBy default, the client identifier is not sent, and the DHCP server uses the hardware address to identify the client.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Set DHCPv4 client identifier (option 61, hex string)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vendorClassIdentifier",
+				Type:        "string",
+				Note:        "",
+				Description: "Set DHCPv4 vendor class identifier (option 60).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Set DHCPv4 vendor class identifier (option 60)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "requestedOptions",
+				Type:        "[]uint8",
+				Note:        "",
+				Description: "Additional DHCPv4 options to request from the DHCP server (parameter request list, option 55).\n\nReceived options are exposed in the `DHCPOptionsStatus` resource.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Additional DHCPv4 options to request from the DHCP server (parameter request list, option 55)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "persistLease",
+				Type:        "bool",
+				Note:        "",
+				Description: "Persist the DHCPv4 lease on the STATE partition and attempt to reuse it on boot.\n\nOn boot, the persisted lease is confirmed with the DHCP server (INIT-REBOOT, RFC 2131),\nif the server doesn't respond, the lease is used for the remainder of its lease time.\nLease details are exposed in the `DHCPLeaseStatus` resource.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Persist the DHCPv4 lease on the STATE partition and attempt to reuse it on boot." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", networkConfigDHCPOptionsExample())
 
+	doc.Fields[4].AddExample("", "01:00:11:22:33:44:55")
+	doc.Fields[5].AddExample("", "talos-worker")
+	doc.Fields[6].AddExample("", []uint8{119, 43})
+
 	return doc
 }
 
@@ -3423,12 +3675,66 @@ func (FeaturesConfig) Doc() *encoder.Doc {
 				Description: "Configures host DNS caching resolver.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Configures host DNS caching resolver." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "apidDeadlines",
+				Type:        "APIDDeadlinesConfig",
+				Note:        "",
+				Description: "Configures default and maximum deadlines for Talos API calls handled by apid.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configures default and maximum deadlines for Talos API calls handled by apid." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "kubernetesEvents",
+				Type:        "bool",
+				Note:        "",
+				Description: "Mirror significant machine events (upgrades, configuration changes, service failures)\nas Kubernetes Events attached to the Node object.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mirror significant machine events (upgrades, configuration changes, service failures)" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "emergencyConsole",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable the emergency console API which opens a restricted diagnostic shell in a throwaway container.\nEvery session is recorded to the audit log.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable the emergency console API which opens a restricted diagnostic shell in a throwaway container." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "auditLog",
+				Type:        "AuditLogConfig",
+				Note:        "",
+				Description: "Configures the audit log of the Talos API calls.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configures the audit log of the Talos API calls." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "apiOIDC",
+				Type:        "APIOIDCConfig",
+				Note:        "",
+				Description: "Configures authentication of the Talos API clients with OIDC bearer tokens.\n\nClients which don't present a client certificate can authenticate with an ID token issued by the OIDC provider,\nthe groups of the token are mapped to the Talos API roles.\nRequires RBAC to be enabled.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configures authentication of the Talos API clients with OIDC bearer tokens." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "metrics",
+				Type:        "MetricsConfig",
+				Note:        "",
+				Description: "Configures the Prometheus metrics endpoint of machined.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configures the Prometheus metrics endpoint of machined." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "resourceAccessPolicy",
+				Type:        "[]ResourceAccessRule",
+				Note:        "",
+				Description: "Restricts the access of the roles to the resources via the Talos API.\n\nThe roles which are listed in the policy can only access the resources matching one of the rules of the role,\nthe roles which are not listed are not restricted.\nEach role of the caller is evaluated separately, so the access of the caller is the union of the access granted by its roles.\nThe sensitive resources (e.g. secrets) are still accessible only with the `os:admin` role.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Restricts the access of the roles to the resources via the Talos API." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", machineFeaturesExample())
 
 	doc.Fields[2].AddExample("", kubernetesTalosAPIAccessConfigExample())
+	doc.Fields[7].AddExample("", apidDeadlinesConfigExample())
+	doc.Fields[10].AddExample("", auditLogConfigExample())
+	doc.Fields[11].AddExample("", apiOIDCConfigExample())
+	doc.Fields[12].AddExample("", metricsConfigExample())
+	doc.Fields[13].AddExample("", resourceAccessPolicyExample())
 
 	return doc
 }
@@ -3498,11 +3804,61 @@ func (KubernetesTalosAPIAccessConfig) Doc() *encoder.Doc {
 				Description: "The list of Kubernetes namespaces Talos API access is available from.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The list of Kubernetes namespaces Talos API access is available from." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "serviceAccounts",
+				Type:        "[]KubernetesTalosAPIAccessServiceAccount",
+				Note:        "",
+				Description: "The list of Kubernetes service accounts which can access Talos API with service account tokens.\n\nWhen not empty, the node exposes the service account bridge on the port 50002,\nwhich accepts Talos API requests authenticated with Kubernetes service account tokens\n(projected with the `talos` audience) and maps them to the Talos API roles.\nOnly the requests to the node itself are served, requests are not forwarded to other nodes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The list of Kubernetes service accounts which can access Talos API with service account tokens." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", kubernetesTalosAPIAccessConfigExample())
 
+	doc.Fields[3].AddExample("", kubernetesTalosAPIAccessServiceAccountsExample())
+
+	return doc
+}
+
+func (KubernetesTalosAPIAccessServiceAccount) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KubernetesTalosAPIAccessServiceAccount",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KubernetesTalosAPIAccessServiceAccount maps a Kubernetes service account to the Talos API roles." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KubernetesTalosAPIAccessServiceAccount maps a Kubernetes service account to the Talos API roles.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "KubernetesTalosAPIAccessConfig",
+				FieldName: "serviceAccounts",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "namespace",
+				Type:        "string",
+				Note:        "",
+				Description: "The namespace of the service account.\n\nThe namespace should be in the list of allowed Kubernetes namespaces.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The namespace of the service account." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "The name of the service account.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The name of the service account." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "roles",
+				Type:        "[]string",
+				Note:        "",
+				Description: "The list of Talos API roles granted to the service account.\n\nThe roles should be in the list of allowed roles.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The list of Talos API roles granted to the service account." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", kubernetesTalosAPIAccessServiceAccountsExample())
+
 	return doc
 }
 
@@ -3545,6 +3901,247 @@ func (HostDNSConfig) Doc() *encoder.Doc {
 	return doc
 }
 
+func (APIDDeadlinesConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "APIDDeadlinesConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "APIDDeadlinesConfig describes the default and maximum deadlines for Talos API calls." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "APIDDeadlinesConfig describes the default and maximum deadlines for Talos API calls.\n\nUnary calls are the calls which return a single response, streaming calls are\nthe calls which return a stream of responses (e.g. logs, events, dmesg).\n",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "FeaturesConfig",
+				FieldName: "apidDeadlines",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "unaryDefault",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Deadline applied to the unary calls which don't specify a deadline (default is 10 minutes).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Deadline applied to the unary calls which don't specify a deadline (default is 10 minutes)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "unaryMax",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Maximum deadline of the unary calls (default is 1 hour).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum deadline of the unary calls (default is 1 hour)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "streamingDefault",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Deadline applied to the streaming calls which don't specify a deadline (default is 24 hours).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Deadline applied to the streaming calls which don't specify a deadline (default is 24 hours)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "streamingMax",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Maximum deadline of the streaming calls (default is no maximum).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum deadline of the streaming calls (default is no maximum)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", apidDeadlinesConfigExample())
+
+	return doc
+}
+
+func (AuditLogConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "AuditLogConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "AuditLogConfig describes the audit log of the Talos API calls." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "AuditLogConfig describes the audit log of the Talos API calls.\n\nThe most recent records are always kept in memory and are available via the AuditService API.\n",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "FeaturesConfig",
+				FieldName: "auditLog",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "syslogEndpoint",
+				Type:        "Endpoint",
+				Note:        "",
+				Description: "Send the audit records to the remote syslog server.\nSupported protocols are \"tcp\" and \"udp\".",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Send the audit records to the remote syslog server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", auditLogConfigExample())
+
+	doc.Fields[0].AddExample("", auditLogSyslogEndpointExample())
+
+	return doc
+}
+
+func (APIOIDCConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "APIOIDCConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "APIOIDCConfig describes the authentication of the Talos API clients with OIDC bearer tokens." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "APIOIDCConfig describes the authentication of the Talos API clients with OIDC bearer tokens.\n\nThe tokens are passed in the `authorization` gRPC metadata as `Bearer <token>`,\nclients which present a client certificate are authenticated with the certificate.\n",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "FeaturesConfig",
+				FieldName: "apiOIDC",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "issuerURL",
+				Type:        "string",
+				Note:        "",
+				Description: "The URL of the OIDC provider, it should match the `iss` claim of the tokens.\n\nThe provider configuration is discovered via the `/.well-known/openid-configuration` endpoint.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The URL of the OIDC provider, it should match the `iss` claim of the tokens." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "audience",
+				Type:        "string",
+				Note:        "",
+				Description: "The audience of the tokens, it should be present in the `aud` claim of the tokens (usually the OIDC client ID).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The audience of the tokens, it should be present in the `aud` claim of the tokens (usually the OIDC client ID)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "groupsClaim",
+				Type:        "string",
+				Note:        "",
+				Description: "The name of the token claim which lists the groups of the user (default is `groups`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The name of the token claim which lists the groups of the user (default is `groups`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "groupRoles",
+				Type:        "[]APIOIDCGroupRoles",
+				Note:        "",
+				Description: "The mapping of the groups to the Talos API roles.\n\nThe client is granted the roles of all of its groups, tokens which don't map to any role are rejected.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The mapping of the groups to the Talos API roles." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", apiOIDCConfigExample())
+
+	doc.Fields[0].AddExample("", "https://login.example.com/realms/talos")
+	doc.Fields[1].AddExample("", "talos")
+
+	return doc
+}
+
+func (APIOIDCGroupRoles) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "APIOIDCGroupRoles",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "APIOIDCGroupRoles maps a group of the OIDC tokens to the Talos API roles." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "APIOIDCGroupRoles maps a group of the OIDC tokens to the Talos API roles.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "APIOIDCConfig",
+				FieldName: "groupRoles",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "group",
+				Type:        "string",
+				Note:        "",
+				Description: "The name of the group.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The name of the group." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "roles",
+				Type:        "[]string",
+				Note:        "",
+				Description: "The list of Talos API roles granted to the members of the group.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The list of Talos API roles granted to the members of the group." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (MetricsConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "MetricsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "MetricsConfig describes the Prometheus metrics endpoint of machined." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "MetricsConfig describes the Prometheus metrics endpoint of machined.\n\nThe endpoint exposes the controller runtime, Talos API, boot sequence and extension metrics on the `/metrics` path.\n",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "FeaturesConfig",
+				FieldName: "metrics",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "enabled",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable the metrics endpoint.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable the metrics endpoint." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "listenAddress",
+				Type:        "string",
+				Note:        "",
+				Description: "The address the metrics endpoint listens on (default is `127.0.0.1:50003`).\n\nIf the address is not a loopback address, the endpoint is served over HTTPS and requires\na client certificate issued by the Talos API CA (e.g. the certificate from the `talosconfig`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The address the metrics endpoint listens on (default is `127.0.0.1:50003`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", metricsConfigExample())
+
+	doc.Fields[1].AddExample("", ":50003")
+
+	return doc
+}
+
+func (ResourceAccessRule) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ResourceAccessRule",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ResourceAccessRule allows the role to access the resources in the namespaces and of the types." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ResourceAccessRule allows the role to access the resources in the namespaces and of the types.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "FeaturesConfig",
+				FieldName: "resourceAccessPolicy",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "role",
+				Type:        "string",
+				Note:        "",
+				Description: "The Talos API role the rule applies to, `os:admin` can't be restricted.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The Talos API role the rule applies to, `os:admin` can't be restricted." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "namespaces",
+				Type:        "[]string",
+				Note:        "",
+				Description: "The list of the resource namespaces the role can access, empty list allows all namespaces.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The list of the resource namespaces the role can access, empty list allows all namespaces." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "types",
+				Type:        "[]string",
+				Note:        "",
+				Description: "The list of the resource types the role can access, empty list allows all types.\n\nThe type can be specified by the full resource type or by any of its aliases.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The list of the resource types the role can access, empty list allows all types." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", resourceAccessPolicyExample())
+
+	doc.Fields[0].AddExample("", "os:reader")
+	doc.Fields[1].AddExample("", []string{"network"})
+	doc.Fields[2].AddExample("", []string{"AddressStatuses.net.talos.dev", "routes"})
+
+	return doc
+}
+
 func (VolumeMountConfig) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "VolumeMountConfig",
@@ -3711,13 +4308,14 @@ func (KubeSpanFilters) Doc() *encoder.Doc {
 				Name:        "endpoints",
 				Type:        "[]string",
 				Note:        "",
-				Description: "Filter node addresses which will be advertised as KubeSpan endpoints for peer-to-peer Wireguard connections.\n\nBy default, all addresses are advertised, and KubeSpan cycles through all endpoints until it finds one that works.\n\nDefault value: no filtering.",
+				Description: "Filter node addresses which will be advertised as KubeSpan endpoints for peer-to-peer Wireguard connections.\n\nBy default, all addresses are advertised, and KubeSpan cycles through all endpoints until it finds one that works.\n\nFilters use the same syntax as `.machine.kubelet.nodeIP.validSubnets`: subnets, `interface:` link name matches and their negations with `!`.\n\nDefault value: no filtering.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Filter node addresses which will be advertised as KubeSpan endpoints for peer-to-peer Wireguard connections." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
 	}
 
 	doc.Fields[0].AddExample("Exclude addresses in 192.168.0.0/16 subnet.", []string{"0.0.0.0/0", "!192.168.0.0/16", "::/0"})
+	doc.Fields[0].AddExample("Advertise only the addresses of the public interface.", []string{"interface:eth0"})
 
 	return doc
 }
@@ -3980,31 +4578,95 @@ func (LoggingDestination) Doc() *encoder.Doc {
 				Name:        "endpoint",
 				Type:        "Endpoint",
 				Note:        "",
-				Description: "Where to send logs. Supported protocols are \"tcp\" and \"udp\".",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Where to send logs. Supported protocols are \"tcp\" and \"udp\"." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Where to send logs.\nSupported protocols are \"tcp\", \"udp\" and \"tls\" for the \"json_lines\" and \"syslog\" formats,\nand \"http\" and \"https\" for the \"loki\" format (the URL of the Loki push API).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Where to send logs." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
 				Name:        "format",
 				Type:        "string",
 				Note:        "",
-				Description: "Logs format.",
+				Description: "Logs format.\n\n\"syslog\" is RFC5424 syslog (octet-counted framing over \"tcp\" and \"tls\"),\nand \"loki\" is the Loki push API.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Logs format." /* encoder.LineComment */, "" /* encoder.FootComment */},
 				Values: []string{
 					"json_lines",
+					"syslog",
+					"loki",
 				},
 			},
 			{
 				Name:        "extraTags",
 				Type:        "map[string]string",
 				Note:        "",
-				Description: "Extra tags (key-value) pairs to attach to every log message sent.",
+				Description: "Extra tags (key-value) pairs to attach to every log message sent.\n\nFor the \"loki\" format, the extra tags are used as the stream labels.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Extra tags (key-value) pairs to attach to every log message sent." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "sources",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Logs to send to the destination, defaults to \"service\".\n\n\"service\" is the logs of machined and Talos services, \"kernel\" is the kernel log,\nand \"container\" is the logs of the Kubernetes containers.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Logs to send to the destination, defaults to \"service\"." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"service",
+					"kernel",
+					"container",
+				},
+			},
+			{
+				Name:        "tls",
+				Type:        "LoggingTLSConfig",
+				Note:        "",
+				Description: "TLS configuration for the \"tls\" and \"https\" endpoints.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TLS configuration for the \"tls\" and \"https\" endpoints." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "spoolMaxSize",
+				Type:        "DiskSize",
+				Note:        "",
+				Description: "Maximum size of the on-disk spool of the logs which couldn't be delivered.\n\nIf set, the logs are spooled to the EPHEMERAL partition while the destination is unavailable,\nand are delivered once it is back.\nOtherwise the logs are queued in memory, and the log delivery is paused once the queue is full.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum size of the on-disk spool of the logs which couldn't be delivered." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.Fields[0].AddExample("", loggingEndpointExample1())
 	doc.Fields[0].AddExample("", loggingEndpointExample2())
+	doc.Fields[0].AddExample("", loggingEndpointExample3())
+	doc.Fields[3].AddExample("", []string{"service", "kernel", "container"})
+	doc.Fields[5].AddExample("Human readable representation.", DiskSize(64000000))
+	doc.Fields[5].AddExample("Precise value in bytes.", 64*1024*1024)
+
+	return doc
+}
+
+func (LoggingTLSConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LoggingTLSConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LoggingTLSConfig struct configures the TLS connection to the logging destination." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LoggingTLSConfig struct configures the TLS connection to the logging destination.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "LoggingDestination",
+				FieldName: "tls",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "ca",
+				Type:        "Base64Bytes",
+				Note:        "",
+				Description: "CA certificate to verify the destination certificate (in addition to the system CA certificates).\nCertificate should be base64-encoded.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "CA certificate to verify the destination certificate (in addition to the system CA certificates)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "insecureSkipVerify",
+				Type:        "bool",
+				Note:        "",
+				Description: "Skip TLS server certificate verification (not recommended).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Skip TLS server certificate verification (not recommended)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
 
 	return doc
 }
@@ -4059,8 +4721,8 @@ func (KernelModuleConfig) Doc() *encoder.Doc {
 				Name:        "parameters",
 				Type:        "[]string",
 				Note:        "",
-				Description: "Module parameters, changes applied after reboot.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Module parameters, changes applied after reboot." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Module parameters, changes to the parameters writable at runtime are applied immediately, others are applied after reboot.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Module parameters, changes to the parameters writable at runtime are applied immediately, others are applied after reboot." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
 	}
@@ -4084,6 +4746,7 @@ func GetFileDoc() *encoder.FileDoc {
 			MachineControllerManagerConfig{}.Doc(),
 			MachineSchedulerConfig{}.Doc(),
 			KubeletConfig{}.Doc(),
+			KubeletShutdownGracePeriodConfig{}.Doc(),
 			KubeletNodeIPConfig{}.Doc(),
 			NetworkConfig{}.Doc(),
 			InstallConfig{}.Doc(),
@@ -4102,6 +4765,7 @@ func GetFileDoc() *encoder.FileDoc {
 			EtcdConfig{}.Doc(),
 			ClusterNetworkConfig{}.Doc(),
 			CNIConfig{}.Doc(),
+			CNIReadinessConfig{}.Doc(),
 			FlannelCNIConfig{}.Doc(),
 			ExternalCloudProviderConfig{}.Doc(),
 			AdminKubeconfigConfig{}.Doc(),
@@ -4111,6 +4775,7 @@ func GetFileDoc() *encoder.FileDoc {
 			EncryptionKey{}.Doc(),
 			EncryptionKeyStatic{}.Doc(),
 			EncryptionKeyKMS{}.Doc(),
+			EncryptionKeyKMSVaultTransit{}.Doc(),
 			EncryptionKeyTPM{}.Doc(),
 			EncryptionKeyNodeID{}.Doc(),
 			ResourcesConfig{}.Doc(),
@@ -4137,7 +4802,14 @@ func GetFileDoc() *encoder.FileDoc {
 			FeaturesConfig{}.Doc(),
 			KubePrism{}.Doc(),
 			KubernetesTalosAPIAccessConfig{}.Doc(),
+			KubernetesTalosAPIAccessServiceAccount{}.Doc(),
 			HostDNSConfig{}.Doc(),
+			APIDDeadlinesConfig{}.Doc(),
+			AuditLogConfig{}.Doc(),
+			APIOIDCConfig{}.Doc(),
+			APIOIDCGroupRoles{}.Doc(),
+			MetricsConfig{}.Doc(),
+			ResourceAccessRule{}.Doc(),
 			VolumeMountConfig{}.Doc(),
 			ClusterInlineManifest{}.Doc(),
 			NetworkKubeSpan{}.Doc(),
@@ -4150,6 +4822,7 @@ func GetFileDoc() *encoder.FileDoc {
 			UdevConfig{}.Doc(),
 			LoggingConfig{}.Doc(),
 			LoggingDestination{}.Doc(),
+			LoggingTLSConfig{}.Doc(),
 			KernelConfig{}.Doc(),
 			KernelModuleConfig{}.Doc(),
 		},
@@ -5,18 +5,25 @@
 package v1alpha1
 
 import (
+	"crypto/tls"
+	stdx509 "crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/hashicorp/go-multierror"
 	sideronet "github.com/siderolabs/net"
 
@@ -86,7 +93,7 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		return nil, result.ErrorOrNil()
 	}
 
-	if err := c.ClusterConfig.Validate(c.Machine().Type().IsControlPlane()); err != nil {
+	if err := c.ClusterConfig.Validate(c.Machine().Type().IsControlPlane(), opts); err != nil {
 		result = multierror.Append(result, err)
 	}
 
@@ -143,7 +150,7 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 
 		if c.Machine().Security().IssuingCA() == nil {
 			result = multierror.Append(result, errors.New("issuing CA is required (.machine.ca)"))
-		} else if len(c.Machine().Security().IssuingCA().Key) == 0 {
+		} else if len(c.Machine().Security().IssuingCA().Key) == 0 && !opts.SecretsReference {
 			result = multierror.Append(result, errors.New("issuing CA key is required for controlplane nodes (.machine.ca.key)"))
 		}
 	case machine.TypeWorker:
@@ -268,6 +275,12 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 				if key.NodeID() == nil && key.Static() == nil && key.KMS() == nil && key.TPM() == nil {
 					result = multierror.Append(result, fmt.Errorf("partition %q: encryption key at slot %d doesn't have the configuration parameters", label, key.Slot()))
 				}
+
+				if key.KMS() != nil {
+					if err := validateEncryptionKeyKMS(key.KMS()); err != nil {
+						result = multierror.Append(result, fmt.Errorf("partition %q: encryption key at slot %d: %w", label, key.Slot(), err))
+					}
+				}
 			}
 		}
 	}
@@ -288,7 +301,7 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		for _, cidr := range c.Machine().Network().KubeSpan().Filters().Endpoints() {
 			cidr = strings.TrimPrefix(cidr, "!")
 
-			if _, err := sideronet.ParseSubnetOrAddress(cidr); err != nil {
+			if _, err := nethelpers.ParseAddressSelector(cidr); err != nil {
 				result = multierror.Append(result, fmt.Errorf("KubeSpan endpoint filer is not valid: %q", cidr))
 			}
 		}
@@ -299,6 +312,10 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		result = multierror.Append(result, err)
 	}
 
+	for _, file := range c.MachineConfig.MachineFiles {
+		result = multierror.Append(result, file.Validate())
+	}
+
 	if c.MachineConfig.MachineInstall != nil {
 		extensions := map[string]struct{}{}
 
@@ -313,6 +330,22 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		if len(extensions) > 0 {
 			warnings = append(warnings, ".machine.install.extensions is deprecated, please see https://www.talos.dev/latest/talos-guides/install/boot-assets/")
 		}
+
+		if c.MachineConfig.MachineInstall.InstallPullRateLimit != "" {
+			if _, err := humanize.ParseBytes(c.MachineConfig.MachineInstall.InstallPullRateLimit); err != nil {
+				result = multierror.Append(result, fmt.Errorf("invalid install pull rate limit %q: %w", c.MachineConfig.MachineInstall.InstallPullRateLimit, err))
+			}
+		}
+
+		for i, cert := range c.MachineConfig.MachineInstall.InstallSigningCertificates {
+			if cert == nil {
+				continue
+			}
+
+			if _, err := cert.GetCert(); err != nil {
+				result = multierror.Append(result, fmt.Errorf("invalid install signing certificate %d: %w", i, err))
+			}
+		}
 	}
 
 	if err := labels.Validate(c.MachineConfig.MachineNodeLabels); err != nil {
@@ -341,6 +374,44 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 				result = multierror.Append(result, fmt.Errorf("invalid role %q in allowed roles for Kubernetes Talos API Access", r))
 			}
 		}
+
+		result = multierror.Append(result, validateKubernetesTalosAPIAccessServiceAccounts(c.Machine().Features().KubernetesTalosAPIAccess()))
+	}
+
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.APIDDeadlinesConfig != nil {
+		if err := c.MachineConfig.MachineFeatures.APIDDeadlinesConfig.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.AuditLogConfig != nil {
+		if err := c.MachineConfig.MachineFeatures.AuditLogConfig.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.APIOIDCConfig != nil {
+		if !c.Machine().Features().RBACEnabled() {
+			result = multierror.Append(result, errors.New("feature API RBAC should be enabled when API OIDC authentication is configured"))
+		}
+
+		if err := c.MachineConfig.MachineFeatures.APIOIDCConfig.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.MetricsConfig != nil {
+		if err := c.MachineConfig.MachineFeatures.MetricsConfig.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.MachineConfig.MachineFeatures != nil {
+		for _, rule := range c.MachineConfig.MachineFeatures.ResourceAccessPolicyRules {
+			if err := rule.Validate(); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
 	}
 
 	if c.ConfigPersist != nil && !*c.ConfigPersist {
@@ -358,6 +429,44 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 	return warnings, result.ErrorOrNil()
 }
 
+func validateKubernetesTalosAPIAccessServiceAccounts(access config.KubernetesTalosAPIAccess) error {
+	var result *multierror.Error
+
+	seen := map[string]struct{}{}
+
+	for _, sa := range access.ServiceAccounts() {
+		if sa.Namespace() == "" || sa.Name() == "" {
+			result = multierror.Append(result, errors.New("service account namespace and name are required for Kubernetes Talos API Access"))
+
+			continue
+		}
+
+		id := sa.Namespace() + "/" + sa.Name()
+
+		if _, ok := seen[id]; ok {
+			result = multierror.Append(result, fmt.Errorf("duplicate service account %q for Kubernetes Talos API Access", id))
+		}
+
+		seen[id] = struct{}{}
+
+		if !slices.Contains(access.AllowedKubernetesNamespaces(), sa.Namespace()) {
+			result = multierror.Append(result, fmt.Errorf("service account %q namespace is not in the allowed namespaces for Kubernetes Talos API Access", id))
+		}
+
+		if len(sa.Roles()) == 0 {
+			result = multierror.Append(result, fmt.Errorf("service account %q should have at least one role for Kubernetes Talos API Access", id))
+		}
+
+		for _, r := range sa.Roles() {
+			if !slices.Contains(access.AllowedRoles(), r) {
+				result = multierror.Append(result, fmt.Errorf("service account %q role %q is not in the allowed roles for Kubernetes Talos API Access", id, r))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
 var rxDNSNameRegexp = sync.OnceValue(func() *regexp.Regexp {
 	return regexp.MustCompile(`^([a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62}){1}(\.[a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62})*[\._]?$`)
 })
@@ -373,7 +482,7 @@ func isValidDNSName(name string) bool {
 // Validate validates the config.
 //
 //nolint:gocyclo
-func (c *ClusterConfig) Validate(isControlPlane bool) error {
+func (c *ClusterConfig) Validate(isControlPlane bool, opts *validation.Options) error {
 	var result *multierror.Error
 
 	if c == nil {
@@ -411,7 +520,7 @@ func (c *ClusterConfig) Validate(isControlPlane bool) error {
 	result = multierror.Append(
 		result,
 		c.ClusterInlineManifests.Validate(),
-		c.ClusterDiscoveryConfig.Validate(c),
+		c.ClusterDiscoveryConfig.Validate(c, opts),
 		c.APIServerConfig.Validate(),
 		c.ControllerManagerConfig.Validate(),
 		c.SchedulerConfig.Validate(),
@@ -469,9 +578,55 @@ func ValidateCNI(cni config.CNI) ([]string, error) {
 		result = multierror.Append(result, err)
 	}
 
+	if err := validateCNIReadiness(cni); err != nil {
+		result = multierror.Append(result, err)
+	}
+
 	return warnings, result.ErrorOrNil()
 }
 
+func validateCNIReadiness(cni config.CNI) error {
+	var result *multierror.Error
+
+	readiness := cni.Readiness()
+
+	switch readiness.Mode() {
+	case constants.CNIReadinessDefault, constants.CNIReadinessDisabled:
+		if len(readiness.DaemonSets()) != 0 {
+			err := fmt.Errorf(`"daemonSets" field should be empty for %q CNI readiness mode`, readiness.Mode())
+			result = multierror.Append(result, err)
+		}
+
+	case constants.CNIReadinessCustom:
+		if len(readiness.DaemonSets()) == 0 {
+			err := fmt.Errorf(`"daemonSets" field should not be empty for %q CNI readiness mode`, readiness.Mode())
+			result = multierror.Append(result, err)
+		}
+
+		for _, ds := range readiness.DaemonSets() {
+			if namespace, name, ok := strings.Cut(ds, "/"); !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+				err := fmt.Errorf("invalid CNI readiness DaemonSet %q, expected \"namespace/name\"", ds)
+				result = multierror.Append(result, err)
+			}
+		}
+
+	default:
+		err := fmt.Errorf("cni readiness mode should be one of [%q, %q, %q]", constants.CNIReadinessDefault, constants.CNIReadinessDisabled, constants.CNIReadinessCustom)
+		result = multierror.Append(result, err)
+	}
+
+	if cni.Name() == constants.FlannelCNI && readiness.Mode() != constants.CNIReadinessDefault {
+		err := fmt.Errorf("cni readiness mode %q is not supported for %q CNI", readiness.Mode(), cni.Name())
+		result = multierror.Append(result, err)
+	}
+
+	if readiness.Timeout() < 0 {
+		result = multierror.Append(result, errors.New("cni readiness timeout should be positive"))
+	}
+
+	return result.ErrorOrNil()
+}
+
 // Validate validates external cloud provider configuration.
 func (ecp *ExternalCloudProviderConfig) Validate() error {
 	if !ecp.Enabled() && (len(ecp.ExternalManifests) != 0) {
@@ -512,7 +667,7 @@ func (manifests ClusterInlineManifests) Validate() error {
 }
 
 // Validate the discovery config.
-func (c *ClusterDiscoveryConfig) Validate(clusterCfg *ClusterConfig) error {
+func (c *ClusterDiscoveryConfig) Validate(clusterCfg *ClusterConfig, opts *validation.Options) error {
 	var result *multierror.Error
 
 	if c == nil || !c.Enabled() {
@@ -531,7 +686,8 @@ func (c *ClusterDiscoveryConfig) Validate(clusterCfg *ClusterConfig) error {
 			result = multierror.Append(result, errors.New("cluster discovery service requires .cluster.id"))
 		}
 
-		if clusterCfg.Secret() == "" {
+		// the cluster secret is resolved from the referenced secrets bundle
+		if clusterCfg.Secret() == "" && !opts.SecretsReference {
 			result = multierror.Append(result, errors.New("cluster discovery service requires .cluster.secret"))
 		}
 	}
@@ -595,6 +751,10 @@ func CheckDeviceInterface(d *Device, _ map[string]string) ([]string, error) {
 		result = multierror.Append(result, checkVlans(d))
 	}
 
+	if d.DeviceDHCPOptions != nil {
+		result = multierror.Append(result, checkDHCPOptions(d.DeviceInterface, d.DeviceDHCPOptions))
+	}
+
 	return nil, result.ErrorOrNil()
 }
 
@@ -765,6 +925,29 @@ func checkVlans(d *Device) error {
 				result = multierror.Append(result, fmt.Errorf("[%s] %s.%d: %w", "networking.os.device.vlan.addresses", d.DeviceInterface, vlan.VlanID, err))
 			}
 		}
+
+		if vlan.VlanDHCPOptions != nil {
+			result = multierror.Append(result, checkDHCPOptions(fmt.Sprintf("%s.%d", d.DeviceInterface, vlan.VlanID), vlan.VlanDHCPOptions))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func checkDHCPOptions(name string, opts *DHCPOptions) error {
+	var result *multierror.Error
+
+	if opts.DHCPClientIdentifier != "" {
+		if _, err := hex.DecodeString(strings.ReplaceAll(opts.DHCPClientIdentifier, ":", "")); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %s: invalid client identifier %q: %w", "networking.os.device.dhcpOptions.clientIdentifier", name, opts.DHCPClientIdentifier, err))
+		}
+	}
+
+	for _, code := range opts.DHCPRequestedOptions {
+		// pad and end options can't be requested
+		if code == 0 || code == 255 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %s: invalid option code %d", "networking.os.device.dhcpOptions.requestedOptions", name, code))
+		}
 	}
 
 	return result.ErrorOrNil()
@@ -883,7 +1066,7 @@ func (k *KubeletConfig) Validate() ([]string, error) {
 		for _, cidr := range k.KubeletNodeIP.KubeletNodeIPValidSubnets {
 			cidr = strings.TrimPrefix(cidr, "!")
 
-			if _, err := sideronet.ParseSubnetOrAddress(cidr); err != nil {
+			if _, err := nethelpers.ParseAddressSelector(cidr); err != nil {
 				result = multierror.Append(result, fmt.Errorf("kubelet nodeIP subnet is not valid: %q", cidr))
 			}
 		}
@@ -895,9 +1078,48 @@ func (k *KubeletConfig) Validate() ([]string, error) {
 		}
 	}
 
+	if len(k.KubeletShutdownGracePeriodByPodPriority) > 0 {
+		result = multierror.Append(result, k.validateShutdownGracePeriods())
+	}
+
 	return nil, result.ErrorOrNil()
 }
 
+func (k *KubeletConfig) validateShutdownGracePeriods() error {
+	var result *multierror.Error
+
+	// kubelet doesn't allow mixing the grace periods by pod priority with the plain grace periods
+	for _, field := range []string{"shutdownGracePeriod", "shutdownGracePeriodCriticalPods", "shutdownGracePeriodByPodPriority"} {
+		if _, exists := k.KubeletExtraConfig.Object[field]; exists {
+			result = multierror.Append(result, fmt.Errorf("kubelet configuration field %q can't be set together with shutdownGracePeriodByPodPriority", field))
+		}
+	}
+
+	var total time.Duration
+
+	priorities := map[int32]struct{}{}
+
+	for _, period := range k.KubeletShutdownGracePeriodByPodPriority {
+		if _, exists := priorities[period.KubeletShutdownPriority]; exists {
+			result = multierror.Append(result, fmt.Errorf("kubelet shutdown grace period for priority %d is duplicated", period.KubeletShutdownPriority))
+		}
+
+		priorities[period.KubeletShutdownPriority] = struct{}{}
+
+		if period.KubeletShutdownGracePeriod < 0 {
+			result = multierror.Append(result, fmt.Errorf("kubelet shutdown grace period for priority %d should not be negative", period.KubeletShutdownPriority))
+		}
+
+		total += period.KubeletShutdownGracePeriod
+	}
+
+	if total > constants.KubeletShutdownGracePeriodMax {
+		result = multierror.Append(result, fmt.Errorf("total kubelet shutdown grace period %s exceeds the maximum of %s", total, constants.KubeletShutdownGracePeriodMax))
+	}
+
+	return result.ErrorOrNil()
+}
+
 // Validate etcd configuration.
 func (e *EtcdConfig) Validate() error {
 	var result *multierror.Error
@@ -913,7 +1135,7 @@ func (e *EtcdConfig) Validate() error {
 	for _, cidr := range e.AdvertisedSubnets() {
 		cidr = strings.TrimPrefix(cidr, "!")
 
-		if _, err := sideronet.ParseSubnetOrAddress(cidr); err != nil {
+		if _, err := nethelpers.ParseAddressSelector(cidr); err != nil {
 			result = multierror.Append(result, fmt.Errorf("etcd advertised subnet is not valid: %q", cidr))
 		}
 	}
@@ -921,10 +1143,211 @@ func (e *EtcdConfig) Validate() error {
 	for _, cidr := range e.ListenSubnets() {
 		cidr = strings.TrimPrefix(cidr, "!")
 
-		if _, err := sideronet.ParseSubnetOrAddress(cidr); err != nil {
+		if _, err := nethelpers.ParseAddressSelector(cidr); err != nil {
 			result = multierror.Append(result, fmt.Errorf("etcd listen subnet is not valid: %q", cidr))
 		}
 	}
 
 	return result.ErrorOrNil()
 }
+
+// Validate APIDDeadlinesConfig.
+func (d *APIDDeadlinesConfig) Validate() error {
+	var result *multierror.Error
+
+	for _, field := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"unaryDefault", d.APIDUnaryDefault},
+		{"unaryMax", d.APIDUnaryMax},
+		{"streamingDefault", d.APIDStreamingDefault},
+		{"streamingMax", d.APIDStreamingMax},
+	} {
+		if field.value < 0 {
+			result = multierror.Append(result, fmt.Errorf("apid deadline %s should not be negative", field.name))
+		}
+	}
+
+	if d.UnaryDefault() > d.UnaryMax() {
+		result = multierror.Append(result, fmt.Errorf("apid unary default deadline %s exceeds the maximum %s", d.UnaryDefault(), d.UnaryMax()))
+	}
+
+	if d.StreamingMax() > 0 && d.StreamingDefault() > d.StreamingMax() {
+		result = multierror.Append(result, fmt.Errorf("apid streaming default deadline %s exceeds the maximum %s", d.StreamingDefault(), d.StreamingMax()))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate AuditLogConfig.
+func (a *AuditLogConfig) Validate() error {
+	endpoint := a.SyslogEndpoint()
+	if endpoint == nil {
+		return nil
+	}
+
+	if endpoint.Scheme != "tcp" && endpoint.Scheme != "udp" {
+		return fmt.Errorf("audit log syslog endpoint: unsupported scheme %q", endpoint.Scheme)
+	}
+
+	if endpoint.Host == "" {
+		return errors.New("audit log syslog endpoint: host is required")
+	}
+
+	return nil
+}
+
+// Validate APIOIDCConfig.
+func (o *APIOIDCConfig) Validate() error {
+	var result *multierror.Error
+
+	issuerURL, err := url.Parse(o.OIDCIssuerURL)
+
+	switch {
+	case o.OIDCIssuerURL == "":
+		result = multierror.Append(result, errors.New("api OIDC issuer URL is required"))
+	case err != nil:
+		result = multierror.Append(result, fmt.Errorf("api OIDC issuer URL is invalid: %w", err))
+	case issuerURL.Scheme != "https" || issuerURL.Host == "":
+		result = multierror.Append(result, fmt.Errorf("api OIDC issuer URL %q should be an https URL", o.OIDCIssuerURL))
+	}
+
+	if o.OIDCAudience == "" {
+		result = multierror.Append(result, errors.New("api OIDC audience is required"))
+	}
+
+	if len(o.OIDCGroupRoles) == 0 {
+		result = multierror.Append(result, errors.New("api OIDC should map at least one group to the roles"))
+	}
+
+	for _, gr := range o.OIDCGroupRoles {
+		if gr.OIDCGroup == "" {
+			result = multierror.Append(result, errors.New("api OIDC group name is required"))
+		}
+
+		if len(gr.OIDCRoles) == 0 {
+			result = multierror.Append(result, fmt.Errorf("api OIDC group %q should have at least one role", gr.OIDCGroup))
+		}
+
+		for _, r := range gr.OIDCRoles {
+			switch {
+			case !role.All.Includes(role.Role(r)):
+				result = multierror.Append(result, fmt.Errorf("invalid role %q for api OIDC group %q", r, gr.OIDCGroup))
+			case role.Role(r) == role.Impersonator:
+				result = multierror.Append(result, fmt.Errorf("role %q can't be granted to api OIDC group %q", r, gr.OIDCGroup))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate MetricsConfig.
+func (m *MetricsConfig) Validate() error {
+	host, port, err := net.SplitHostPort(m.ListenAddress())
+	if err != nil {
+		return fmt.Errorf("metrics listen address %q is invalid: %w", m.MetricsListenAddress, err)
+	}
+
+	if host != "" {
+		if _, err = netip.ParseAddr(host); err != nil {
+			return fmt.Errorf("metrics listen address %q should have an IP address as the host", m.MetricsListenAddress)
+		}
+	}
+
+	if p, err := strconv.ParseUint(port, 10, 16); err != nil || p == 0 {
+		return fmt.Errorf("metrics listen address %q has invalid port", m.MetricsListenAddress)
+	}
+
+	return nil
+}
+
+// Validate ResourceAccessRule.
+func (r ResourceAccessRule) Validate() error {
+	switch {
+	case !role.All.Includes(role.Role(r.AccessRole)):
+		return fmt.Errorf("invalid role %q in resource access policy", r.AccessRole)
+	case role.Role(r.AccessRole) == role.Admin:
+		return fmt.Errorf("role %q can't be restricted by resource access policy", r.AccessRole)
+	case len(r.AccessNamespaces) == 0 && len(r.AccessTypes) == 0:
+		return fmt.Errorf("resource access policy rule for role %q should list namespaces or types", r.AccessRole)
+	}
+
+	return nil
+}
+
+// Validate MachineFile.
+func (f *MachineFile) Validate() error {
+	var result *multierror.Error
+
+	if f.FileContent != "" && f.FileURL != "" {
+		result = multierror.Append(result, fmt.Errorf("file %q: content and url are mutually exclusive", f.FilePath))
+	}
+
+	if f.FileURL != "" {
+		u, err := url.Parse(f.FileURL)
+
+		switch {
+		case err != nil:
+			result = multierror.Append(result, fmt.Errorf("file %q: invalid url %q: %w", f.FilePath, f.FileURL, err))
+		case u.Scheme != "http" && u.Scheme != "https":
+			result = multierror.Append(result, fmt.Errorf("file %q: unsupported url scheme %q", f.FilePath, u.Scheme))
+		}
+
+		if f.FileChecksum == "" {
+			result = multierror.Append(result, fmt.Errorf("file %q: checksum is required when url is set", f.FilePath))
+		}
+	}
+
+	if f.FileChecksum != "" {
+		if f.FileURL == "" {
+			result = multierror.Append(result, fmt.Errorf("file %q: checksum can only be set with url", f.FilePath))
+		}
+
+		if err := config.ValidateFileChecksum(f.FileChecksum); err != nil {
+			result = multierror.Append(result, fmt.Errorf("file %q: %w", f.FilePath, err))
+		}
+	}
+
+	// node facts are not known until the file is written, so only check that the template renders
+	if f.Template() && f.FileContent != "" {
+		if _, err := config.RenderFileTemplate(f.FileContent, config.FileFacts{}); err != nil {
+			result = multierror.Append(result, fmt.Errorf("file %q: %w", f.FilePath, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func validateEncryptionKeyKMS(kms config.EncryptionKeyKMS) error {
+	var result *multierror.Error
+
+	if identity := kms.ClientIdentity(); identity != nil {
+		if _, err := tls.X509KeyPair(identity.Crt, identity.Key); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid KMS client identity: %w", err))
+		}
+	}
+
+	if ca := kms.CA(); ca != nil {
+		if !stdx509.NewCertPool().AppendCertsFromPEM(ca) {
+			result = multierror.Append(result, errors.New("invalid KMS CA certificate"))
+		}
+	}
+
+	if vault := kms.VaultTransit(); vault != nil {
+		if vault.KeyName() == "" {
+			result = multierror.Append(result, errors.New("vault transit key name is required"))
+		}
+
+		if kms.ClientIdentity() == nil {
+			result = multierror.Append(result, errors.New("vault transit requires the client identity to authenticate"))
+		}
+
+		if u, err := url.Parse(kms.Endpoint()); err != nil || u.Scheme != "https" || u.Host == "" {
+			result = multierror.Append(result, fmt.Errorf("vault transit requires an https:// endpoint, got %q", kms.Endpoint()))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
@@ -9,8 +9,10 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/ensure"
 	"github.com/siderolabs/go-pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -280,6 +282,60 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* duplicate system extension \"ghcr.io/siderolabs/gvisor:v0.1.0\"\n\n",
 		},
+		{
+			name: "MachineInstallPullRateLimitInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineInstall: &v1alpha1.InstallConfig{
+						InstallDisk:          "/dev/vda",
+						InstallPullRateLimit: "fast",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			requiresInstall: true,
+			expectedError:   "1 error occurred:\n\t* invalid install pull rate limit \"fast\": strconv.ParseFloat: parsing \"\": invalid syntax\n\n",
+		},
+		{
+			name: "MachineInstallSigningCertificateInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineInstall: &v1alpha1.InstallConfig{
+						InstallDisk: "/dev/vda",
+						InstallSigningCertificates: []*x509.PEMEncodedCertificate{
+							{
+								Crt: []byte("foo"),
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			requiresInstall: true,
+			expectedError:   "1 error occurred:\n\t* invalid install signing certificate 0: failed to parse PEM block\n\n",
+		},
 		{
 			name: "ExternalCloudProviderEnabled",
 			config: &v1alpha1.Config{
@@ -624,6 +680,40 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* [networking.os.device.vlan.addresses] eth0.25: invalid CIDR address: 10.3.x/24\n\n",
 		},
+		{
+			name: "DHCPOptionsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceDHCP:      pointer.To(true),
+								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+									DHCPClientIdentifier: "01:zz",
+									DHCPRequestedOptions: []uint8{119, 255},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* [networking.os.device.dhcpOptions.clientIdentifier] eth0: invalid client identifier \"01:zz\": encoding/hex: invalid byte: U+007A 'z'\n" +
+				"\t* [networking.os.device.dhcpOptions.requestedOptions] eth0: invalid option code 255\n\n",
+		},
 		{
 			name: "VlanAddressAndCIDR",
 			config: &v1alpha1.Config{
@@ -1340,6 +1430,8 @@ func TestValidate(t *testing.T) {
 							KubeletNodeIPValidSubnets: []string{
 								"10.0.0.0.3",
 								"[fd00::169:254:2:53]:344",
+								"interface:enp*",
+								"!interface:",
 							},
 						},
 					},
@@ -1352,9 +1444,10 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "2 errors occurred:\n" +
+			expectedError: "3 errors occurred:\n" +
 				"\t* kubelet nodeIP subnet is not valid: \"10.0.0.0.3\"\n" +
 				"\t* kubelet nodeIP subnet is not valid: \"[fd00::169:254:2:53]:344\"\n" +
+				"\t* kubelet nodeIP subnet is not valid: \"interface:\"\n" +
 				"\n",
 		},
 		{
@@ -1386,6 +1479,48 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* kubelet configuration field \"port\" can't be overridden\n\n",
 		},
+		{
+			name: "BadKubeletShutdownGracePeriods",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletExtraConfig: v1alpha1.Unstructured{
+							Object: map[string]any{
+								"shutdownGracePeriod": "30s",
+							},
+						},
+						KubeletShutdownGracePeriodByPodPriority: []v1alpha1.KubeletShutdownGracePeriodConfig{
+							{
+								KubeletShutdownPriority:    0,
+								KubeletShutdownGracePeriod: 15 * time.Minute,
+							},
+							{
+								KubeletShutdownPriority:    0,
+								KubeletShutdownGracePeriod: 15 * time.Minute,
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n" +
+				"\t* kubelet configuration field \"shutdownGracePeriod\" can't be set together with shutdownGracePeriodByPodPriority\n" +
+				"\t* kubelet shutdown grace period for priority 0 is duplicated\n" +
+				"\t* total kubelet shutdown grace period 30m0s exceeds the maximum of 20m0s\n\n",
+		},
 		{
 			name: "DeviceInterfaceInvalid",
 			config: &v1alpha1.Config{
@@ -1558,6 +1693,398 @@ func TestValidate(t *testing.T) {
 				"Kubernetes Talos API Access\n\t* invalid role \"invalid:role2\" in allowed roles for " +
 				"Kubernetes Talos API Access\n\n",
 		},
+		{
+			name: "TalosAPIAccessInvalidServiceAccounts",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						RBAC: pointer.To(true),
+						KubernetesTalosAPIAccessConfig: &v1alpha1.KubernetesTalosAPIAccessConfig{
+							AccessEnabled:                     pointer.To(true),
+							AccessAllowedRoles:                []string{"os:reader"},
+							AccessAllowedKubernetesNamespaces: []string{"kube-system"},
+							AccessServiceAccounts: []v1alpha1.KubernetesTalosAPIAccessServiceAccount{
+								{
+									ServiceAccountNamespace: "kube-system",
+									ServiceAccountName:      "reader",
+									ServiceAccountRoles:     []string{"os:reader"},
+								},
+								{
+									ServiceAccountNamespace: "default",
+									ServiceAccountName:      "admin",
+									ServiceAccountRoles:     []string{"os:admin"},
+								},
+								{
+									ServiceAccountNamespace: "kube-system",
+									ServiceAccountName:      "reader",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n" +
+				"\t* service account \"default/admin\" namespace is not in the allowed namespaces for Kubernetes Talos API Access\n" +
+				"\t* service account \"default/admin\" role \"os:admin\" is not in the allowed roles for Kubernetes Talos API Access\n" +
+				"\t* duplicate service account \"kube-system/reader\" for Kubernetes Talos API Access\n" +
+				"\t* service account \"kube-system/reader\" should have at least one role for Kubernetes Talos API Access\n\n",
+		},
+		{
+			name: "APIDDeadlines",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						APIDDeadlinesConfig: &v1alpha1.APIDDeadlinesConfig{
+							APIDUnaryDefault:     2 * time.Hour,
+							APIDStreamingDefault: 2 * time.Hour,
+							APIDStreamingMax:     -time.Hour,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* apid deadline streamingMax should not be negative\n\t* " +
+				"apid unary default deadline 2h0m0s exceeds the maximum 1h0m0s\n\n",
+		},
+		{
+			name: "AuditLogSyslogEndpoint",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						AuditLogConfig: &v1alpha1.AuditLogConfig{
+							AuditLogSyslogEndpoint: &v1alpha1.Endpoint{
+								endpointURL,
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* audit log syslog endpoint: unsupported scheme \"https\"\n\n",
+		},
+		{
+			name: "APIOIDC",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						RBAC: pointer.To(true),
+						APIOIDCConfig: &v1alpha1.APIOIDCConfig{
+							OIDCIssuerURL: "http://login.example.com",
+							OIDCGroupRoles: []v1alpha1.APIOIDCGroupRoles{
+								{
+									OIDCGroup: "admins",
+									OIDCRoles: []string{"os:admin", "os:impersonator"},
+								},
+								{
+									OIDCGroup: "readers",
+									OIDCRoles: []string{"os:foo"},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* api OIDC issuer URL \"http://login.example.com\" should be an https URL\n\t* api OIDC audience is required\n\t* " +
+				"role \"os:impersonator\" can't be granted to api OIDC group \"admins\"\n\t* invalid role \"os:foo\" for api OIDC group \"readers\"\n\n",
+		},
+		{
+			name: "APIOIDCNoRBAC",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						APIOIDCConfig: &v1alpha1.APIOIDCConfig{
+							OIDCIssuerURL: "https://login.example.com",
+							OIDCAudience:  "talos",
+							OIDCGroupRoles: []v1alpha1.APIOIDCGroupRoles{
+								{
+									OIDCGroup: "admins",
+									OIDCRoles: []string{"os:admin"},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* feature API RBAC should be enabled when API OIDC authentication is configured\n\n",
+		},
+		{
+			name: "MetricsListenAddress",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						MetricsConfig: &v1alpha1.MetricsConfig{
+							MetricsEnabled:       pointer.To(true),
+							MetricsListenAddress: ":50003",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ResourceAccessPolicy",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						ResourceAccessPolicyRules: []v1alpha1.ResourceAccessRule{
+							{
+								AccessRole:       "os:reader",
+								AccessNamespaces: []string{"network"},
+							},
+							{
+								AccessRole:  "os:operator",
+								AccessTypes: []string{"routes"},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ResourceAccessPolicyInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						ResourceAccessPolicyRules: []v1alpha1.ResourceAccessRule{
+							{
+								AccessRole:       "os:admin",
+								AccessNamespaces: []string{"network"},
+							},
+							{
+								AccessRole:       "os:foo",
+								AccessNamespaces: []string{"network"},
+							},
+							{
+								AccessRole: "os:reader",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* role \"os:admin\" can't be restricted by resource access policy\n\t* invalid role \"os:foo\" in resource access policy\n\t* " +
+				"resource access policy rule for role \"os:reader\" should list namespaces or types\n\n",
+		},
+		{
+			name: "LoggingDestinations",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineLogging: &v1alpha1.LoggingConfig{
+						LoggingDestinations: []v1alpha1.LoggingDestination{
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{URL: ensure.Value(url.Parse("tls://10.0.0.1:6514"))},
+								LoggingFormat:   "syslog",
+								LoggingSources:  []string{"service", "kernel"},
+								LoggingTLS: &v1alpha1.LoggingTLSConfig{
+									TLSInsecureSkipVerify: pointer.To(true),
+								},
+								LoggingSpoolMaxSize: 64 * 1024 * 1024,
+							},
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{URL: ensure.Value(url.Parse("https://loki.example.com/loki/api/v1/push"))},
+								LoggingFormat:   "loki",
+								LoggingSources:  []string{"container"},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LoggingDestinationsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineLogging: &v1alpha1.LoggingConfig{
+						LoggingDestinations: []v1alpha1.LoggingDestination{
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{URL: ensure.Value(url.Parse("https://loki.example.com/loki/api/v1/push"))},
+								LoggingFormat:   "syslog",
+								LoggingSources:  []string{"audit"},
+							},
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{URL: ensure.Value(url.Parse("tcp://10.0.0.1:3100"))},
+								LoggingFormat:   "loki",
+								LoggingTLS: &v1alpha1.LoggingTLSConfig{
+									TLSCA: []byte("foo"),
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "5 errors occurred:\n\t* unexpected logging endpoint scheme \"https\" for format \"syslog\"\n\t* unknown logging source \"audit\"\n\t* " +
+				"unexpected logging endpoint scheme \"tcp\" for format \"loki\"\n\t* logging endpoint \"tcp://10.0.0.1:3100\" doesn't use TLS, but TLS configuration is specified\n\t* " +
+				"failed to parse logging destination CA certificate\n\n",
+		},
+		{
+			name: "MetricsListenAddressHostname",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						MetricsConfig: &v1alpha1.MetricsConfig{
+							MetricsEnabled:       pointer.To(true),
+							MetricsListenAddress: "localhost:50003",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* metrics listen address \"localhost:50003\" should have an IP address as the host\n\n",
+		},
+		{
+			name: "MetricsListenAddressNoPort",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						MetricsConfig: &v1alpha1.MetricsConfig{
+							MetricsEnabled:       pointer.To(true),
+							MetricsListenAddress: "127.0.0.1",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* metrics listen address \"127.0.0.1\" is invalid: address 127.0.0.1: missing port in address\n\n",
+		},
 		{
 			name: "NodeLabels",
 			config: &v1alpha1.Config{
@@ -1745,6 +2272,78 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "3 errors occurred:\n\t* apiserver resource validation failed: unsupported pod resource \"invalid1\"\n\t* controller-manager resource validation failed: unsupported pod resource \"invalid2\"\n\t* scheduler resource validation failed: unsupported pod resource \"invalid3\"\n\n", //nolint:lll
 		},
+		{
+			name: "MachineFiles",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFiles: []*v1alpha1.MachineFile{
+						{
+							FileContent:  "hostname={{ .Hostname }}",
+							FileTemplate: pointer.To(true),
+							FilePath:     "/var/templated",
+							FileOp:       "create",
+						},
+						{
+							FileURL:      "https://example.com/file",
+							FileChecksum: "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+							FilePath:     "/var/remote",
+							FileOp:       "create",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MachineFilesInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFiles: []*v1alpha1.MachineFile{
+						{
+							FileContent:  "{{ .Unknown }}",
+							FileTemplate: pointer.To(true),
+							FilePath:     "/var/templated",
+							FileOp:       "create",
+						},
+						{
+							FileURL:  "ftp://example.com/file",
+							FilePath: "/var/remote",
+							FileOp:   "create",
+						},
+						{
+							FileContent:  "foo",
+							FileChecksum: "md5:acbd18db4cc2f85cedef654fccc4a4d8",
+							FilePath:     "/var/checksum",
+							FileOp:       "create",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "5 errors occurred:\n\t* file \"/var/templated\": error rendering file template: template: file:1:3: executing \"file\" at <.Unknown>: can't evaluate field Unknown in type config.FileFacts\n\t* file \"/var/remote\": unsupported url scheme \"ftp\"\n\t* file \"/var/remote\": checksum is required when url is set\n\t* file \"/var/checksum\": checksum can only be set with url\n\t* file \"/var/checksum\": unsupported checksum algorithm \"md5\"\n\n", //nolint:lll
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
@@ -1857,6 +2456,78 @@ func TestValidateCNI(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* \"urls\" field should be empty for \"none\" CNI\n\n",
 		},
+		{
+			name: "NoneReadinessDisabled",
+			config: &v1alpha1.CNIConfig{
+				CNIName: constants.NoneCNI,
+				CNIReadiness: &v1alpha1.CNIReadinessConfig{
+					ReadinessMode: constants.CNIReadinessDisabled,
+				},
+			},
+		},
+		{
+			name: "NoneReadinessCustom",
+			config: &v1alpha1.CNIConfig{
+				CNIName: constants.NoneCNI,
+				CNIReadiness: &v1alpha1.CNIReadinessConfig{
+					ReadinessMode:       constants.CNIReadinessCustom,
+					ReadinessDaemonSets: []string{"kube-system/cilium"},
+					ReadinessTimeout:    time.Hour,
+				},
+			},
+		},
+		{
+			name: "NoneReadinessCustomInvalid",
+			config: &v1alpha1.CNIConfig{
+				CNIName: constants.NoneCNI,
+				CNIReadiness: &v1alpha1.CNIReadinessConfig{
+					ReadinessMode:       constants.CNIReadinessCustom,
+					ReadinessDaemonSets: []string{"cilium"},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* invalid CNI readiness DaemonSet \"cilium\", expected \"namespace/name\"\n\n",
+		},
+		{
+			name: "NoneReadinessCustomNoDaemonSets",
+			config: &v1alpha1.CNIConfig{
+				CNIName: constants.NoneCNI,
+				CNIReadiness: &v1alpha1.CNIReadinessConfig{
+					ReadinessMode: constants.CNIReadinessCustom,
+				},
+			},
+			expectedError: "1 error occurred:\n\t* \"daemonSets\" field should not be empty for \"custom\" CNI readiness mode\n\n",
+		},
+		{
+			name: "NoneReadinessDisabledDaemonSets",
+			config: &v1alpha1.CNIConfig{
+				CNIName: constants.NoneCNI,
+				CNIReadiness: &v1alpha1.CNIReadinessConfig{
+					ReadinessMode:       constants.CNIReadinessDisabled,
+					ReadinessDaemonSets: []string{"kube-system/cilium"},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* \"daemonSets\" field should be empty for \"disabled\" CNI readiness mode\n\n",
+		},
+		{
+			name: "NoneReadinessUnknown",
+			config: &v1alpha1.CNIConfig{
+				CNIName: constants.NoneCNI,
+				CNIReadiness: &v1alpha1.CNIReadinessConfig{
+					ReadinessMode: "foo",
+				},
+			},
+			expectedError: "1 error occurred:\n\t* cni readiness mode should be one of [\"default\", \"disabled\", \"custom\"]\n\n",
+		},
+		{
+			name: "FlannelReadinessDisabled",
+			config: &v1alpha1.CNIConfig{
+				CNIName: constants.FlannelCNI,
+				CNIReadiness: &v1alpha1.CNIReadinessConfig{
+					ReadinessMode: constants.CNIReadinessDisabled,
+				},
+			},
+			expectedError: "1 error occurred:\n\t* cni readiness mode \"disabled\" is not supported for \"flannel\" CNI\n\n",
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
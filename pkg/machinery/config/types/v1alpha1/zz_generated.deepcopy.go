@@ -13,6 +13,22 @@ import (
 	x509 "github.com/siderolabs/crypto/x509"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIDDeadlinesConfig) DeepCopyInto(out *APIDDeadlinesConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIDDeadlinesConfig.
+func (in *APIDDeadlinesConfig) DeepCopy() *APIDDeadlinesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(APIDDeadlinesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerConfig) DeepCopyInto(out *APIServerConfig) {
 	*out = *in
@@ -91,6 +107,50 @@ func (in *AdminKubeconfigConfig) DeepCopy() *AdminKubeconfigConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIOIDCConfig) DeepCopyInto(out *APIOIDCConfig) {
+	*out = *in
+	if in.OIDCGroupRoles != nil {
+		in, out := &in.OIDCGroupRoles, &out.OIDCGroupRoles
+		*out = make([]APIOIDCGroupRoles, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIOIDCConfig.
+func (in *APIOIDCConfig) DeepCopy() *APIOIDCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(APIOIDCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIOIDCGroupRoles) DeepCopyInto(out *APIOIDCGroupRoles) {
+	*out = *in
+	if in.OIDCRoles != nil {
+		in, out := &in.OIDCRoles, &out.OIDCRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIOIDCGroupRoles.
+func (in *APIOIDCGroupRoles) DeepCopy() *APIOIDCGroupRoles {
+	if in == nil {
+		return nil
+	}
+	out := new(APIOIDCGroupRoles)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdmissionPluginConfig) DeepCopyInto(out *AdmissionPluginConfig) {
 	*out = *in
@@ -134,6 +194,26 @@ func (in AdmissionPluginConfigList) DeepCopy() AdmissionPluginConfigList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogConfig) DeepCopyInto(out *AuditLogConfig) {
+	*out = *in
+	if in.AuditLogSyslogEndpoint != nil {
+		in, out := &in.AuditLogSyslogEndpoint, &out.AuditLogSyslogEndpoint
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogConfig.
+func (in *AuditLogConfig) DeepCopy() *AuditLogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Base64Bytes) DeepCopyInto(out *Base64Bytes) {
 	{
@@ -231,6 +311,11 @@ func (in *CNIConfig) DeepCopyInto(out *CNIConfig) {
 		*out = new(FlannelCNIConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CNIReadiness != nil {
+		in, out := &in.CNIReadiness, &out.CNIReadiness
+		*out = new(CNIReadinessConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -244,6 +329,27 @@ func (in *CNIConfig) DeepCopy() *CNIConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CNIReadinessConfig) DeepCopyInto(out *CNIReadinessConfig) {
+	*out = *in
+	if in.ReadinessDaemonSets != nil {
+		in, out := &in.ReadinessDaemonSets, &out.ReadinessDaemonSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIReadinessConfig.
+func (in *CNIReadinessConfig) DeepCopy() *CNIReadinessConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CNIReadinessConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 	*out = *in
@@ -583,6 +689,16 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DHCPRequestedOptions != nil {
+		in, out := &in.DHCPRequestedOptions, &out.DHCPRequestedOptions
+		*out = make([]uint8, len(*in))
+		copy(*out, *in)
+	}
+	if in.DHCPPersistLease != nil {
+		in, out := &in.DHCPPersistLease, &out.DHCPPersistLease
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -840,7 +956,7 @@ func (in *EncryptionKey) DeepCopyInto(out *EncryptionKey) {
 	if in.KeyKMS != nil {
 		in, out := &in.KeyKMS, &out.KeyKMS
 		*out = new(EncryptionKeyKMS)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.KeyTPM != nil {
 		in, out := &in.KeyTPM, &out.KeyTPM
@@ -863,6 +979,25 @@ func (in *EncryptionKey) DeepCopy() *EncryptionKey {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionKeyKMS) DeepCopyInto(out *EncryptionKeyKMS) {
 	*out = *in
+	if in.KMSClientIdentity != nil {
+		in, out := &in.KMSClientIdentity, &out.KMSClientIdentity
+		*out = (*in).DeepCopy()
+	}
+	if in.KMSCA != nil {
+		in, out := &in.KMSCA, &out.KMSCA
+		*out = make(Base64Bytes, len(*in))
+		copy(*out, *in)
+	}
+	if in.KMSVaultTransit != nil {
+		in, out := &in.KMSVaultTransit, &out.KMSVaultTransit
+		*out = new(EncryptionKeyKMSVaultTransit)
+		**out = **in
+	}
+	if in.KMSLockout != nil {
+		in, out := &in.KMSLockout, &out.KMSLockout
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -876,6 +1011,22 @@ func (in *EncryptionKeyKMS) DeepCopy() *EncryptionKeyKMS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyKMSVaultTransit) DeepCopyInto(out *EncryptionKeyKMSVaultTransit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyKMSVaultTransit.
+func (in *EncryptionKeyKMSVaultTransit) DeepCopy() *EncryptionKeyKMSVaultTransit {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyKMSVaultTransit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionKeyNodeID) DeepCopyInto(out *EncryptionKeyNodeID) {
 	*out = *in
@@ -1077,6 +1228,43 @@ func (in *FeaturesConfig) DeepCopyInto(out *FeaturesConfig) {
 		*out = new(HostDNSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.APIDDeadlinesConfig != nil {
+		in, out := &in.APIDDeadlinesConfig, &out.APIDDeadlinesConfig
+		*out = new(APIDDeadlinesConfig)
+		**out = **in
+	}
+	if in.KubernetesEvents != nil {
+		in, out := &in.KubernetesEvents, &out.KubernetesEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EmergencyConsole != nil {
+		in, out := &in.EmergencyConsole, &out.EmergencyConsole
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuditLogConfig != nil {
+		in, out := &in.AuditLogConfig, &out.AuditLogConfig
+		*out = new(AuditLogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIOIDCConfig != nil {
+		in, out := &in.APIOIDCConfig, &out.APIOIDCConfig
+		*out = new(APIOIDCConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricsConfig != nil {
+		in, out := &in.MetricsConfig, &out.MetricsConfig
+		*out = new(MetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceAccessPolicyRules != nil {
+		in, out := &in.ResourceAccessPolicyRules, &out.ResourceAccessPolicyRules
+		*out = make([]ResourceAccessRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1201,6 +1389,16 @@ func (in *InstallConfig) DeepCopyInto(out *InstallConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.InstallSigningCertificates != nil {
+		in, out := &in.InstallSigningCertificates, &out.InstallSigningCertificates
+		*out = make([]*x509.PEMEncodedCertificate, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = (*in).DeepCopy()
+			}
+		}
+	}
 	return
 }
 
@@ -1423,6 +1621,11 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.KubeletShutdownGracePeriodByPodPriority != nil {
+		in, out := &in.KubeletShutdownGracePeriodByPodPriority, &out.KubeletShutdownGracePeriodByPodPriority
+		*out = make([]KubeletShutdownGracePeriodConfig, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1457,6 +1660,22 @@ func (in *KubeletNodeIPConfig) DeepCopy() *KubeletNodeIPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletShutdownGracePeriodConfig) DeepCopyInto(out *KubeletShutdownGracePeriodConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletShutdownGracePeriodConfig.
+func (in *KubeletShutdownGracePeriodConfig) DeepCopy() *KubeletShutdownGracePeriodConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletShutdownGracePeriodConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesTalosAPIAccessConfig) DeepCopyInto(out *KubernetesTalosAPIAccessConfig) {
 	*out = *in
@@ -1475,6 +1694,13 @@ func (in *KubernetesTalosAPIAccessConfig) DeepCopyInto(out *KubernetesTalosAPIAc
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AccessServiceAccounts != nil {
+		in, out := &in.AccessServiceAccounts, &out.AccessServiceAccounts
+		*out = make([]KubernetesTalosAPIAccessServiceAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1488,6 +1714,27 @@ func (in *KubernetesTalosAPIAccessConfig) DeepCopy() *KubernetesTalosAPIAccessCo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesTalosAPIAccessServiceAccount) DeepCopyInto(out *KubernetesTalosAPIAccessServiceAccount) {
+	*out = *in
+	if in.ServiceAccountRoles != nil {
+		in, out := &in.ServiceAccountRoles, &out.ServiceAccountRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesTalosAPIAccessServiceAccount.
+func (in *KubernetesTalosAPIAccessServiceAccount) DeepCopy() *KubernetesTalosAPIAccessServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesTalosAPIAccessServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LinuxIDMapping) DeepCopyInto(out *LinuxIDMapping) {
 	*out = *in
@@ -1541,6 +1788,16 @@ func (in *LoggingDestination) DeepCopyInto(out *LoggingDestination) {
 			(*out)[key] = val
 		}
 	}
+	if in.LoggingSources != nil {
+		in, out := &in.LoggingSources, &out.LoggingSources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoggingTLS != nil {
+		in, out := &in.LoggingTLS, &out.LoggingTLS
+		*out = new(LoggingTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1554,6 +1811,32 @@ func (in *LoggingDestination) DeepCopy() *LoggingDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingTLSConfig) DeepCopyInto(out *LoggingTLSConfig) {
+	*out = *in
+	if in.TLSCA != nil {
+		in, out := &in.TLSCA, &out.TLSCA
+		*out = make(Base64Bytes, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLSInsecureSkipVerify != nil {
+		in, out := &in.TLSInsecureSkipVerify, &out.TLSInsecureSkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTLSConfig.
+func (in *LoggingTLSConfig) DeepCopy() *LoggingTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 	*out = *in
@@ -1621,7 +1904,7 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(MachineFile)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
@@ -1792,6 +2075,11 @@ func (in *MachineDisk) DeepCopy() *MachineDisk {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineFile) DeepCopyInto(out *MachineFile) {
 	*out = *in
+	if in.FileTemplate != nil {
+		in, out := &in.FileTemplate, &out.FileTemplate
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1843,6 +2131,27 @@ func (in *MachineSeccompProfile) DeepCopy() *MachineSeccompProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsConfig) DeepCopyInto(out *MetricsConfig) {
+	*out = *in
+	if in.MetricsEnabled != nil {
+		in, out := &in.MetricsEnabled, &out.MetricsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsConfig.
+func (in *MetricsConfig) DeepCopy() *MetricsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 	*out = *in
@@ -2219,6 +2528,32 @@ func (in *RegistryTLSConfig) DeepCopy() *RegistryTLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAccessRule) DeepCopyInto(out *ResourceAccessRule) {
+	*out = *in
+	if in.AccessNamespaces != nil {
+		in, out := &in.AccessNamespaces, &out.AccessNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessTypes != nil {
+		in, out := &in.AccessTypes, &out.AccessTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAccessRule.
+func (in *ResourceAccessRule) DeepCopy() *ResourceAccessRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAccessRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourcesConfig) DeepCopyInto(out *ResourcesConfig) {
 	*out = *in
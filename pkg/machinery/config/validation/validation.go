@@ -11,6 +11,8 @@ type Options struct {
 	Local bool
 	// Strict mode returns warnings as errors.
 	Strict bool
+	// SecretsReference skips the checks of the secrets resolved from the referenced secrets bundle.
+	SecretsReference bool
 }
 
 // Option represents an additional validation parameter for the config Validate method.
@@ -39,3 +41,10 @@ func WithStrict() Option {
 		opts.Strict = true
 	}
 }
+
+// WithSecretsReference enables secrets reference flag.
+func WithSecretsReference() Option {
+	return func(opts *Options) {
+		opts.SecretsReference = true
+	}
+}
@@ -8,7 +8,6 @@ package constants
 import (
 	"time"
 
-	cni "github.com/containerd/go-cni"
 	"github.com/siderolabs/crypto/x509"
 )
 
@@ -92,6 +91,10 @@ const (
 	// KernelParamHaltIfInstalled is the kernel parameter name to control if Talos should pause if booting from boot media while Talos is already installed.
 	KernelParamHaltIfInstalled = "talos.halt_if_installed"
 
+	// KernelParamIPAMEndpoint is the kernel parameter name for specifying the IPAM plugin endpoint
+	// used to allocate static addresses during config generation in maintenance mode.
+	KernelParamIPAMEndpoint = "talos.ipam.endpoint"
+
 	// BoardNone indicates that the install is not for a specific board.
 	BoardNone = "none"
 
@@ -211,8 +214,11 @@ const (
 	// installer.
 	ISOFilesystemLabel = "TALOS"
 
+	// CNIBinDir is the default directory of the CNI plugins.
+	CNIBinDir = "/opt/cni/bin"
+
 	// PATH defines all locations where executables are stored.
-	PATH = "/sbin:/bin:/usr/sbin:/usr/bin:/usr/local/sbin:/usr/local/bin:" + cni.DefaultCNIDir
+	PATH = "/sbin:/bin:/usr/sbin:/usr/bin:/usr/local/sbin:/usr/local/bin:" + CNIBinDir
 
 	// KubernetesDefaultCertificateValidityDuration specifies default certificate duration for Kubernetes generated certificates.
 	KubernetesDefaultCertificateValidityDuration = time.Hour * 24 * 365
@@ -349,6 +355,11 @@ const (
 	// Should be less than KubeletShutdownGracePeriod.
 	KubeletShutdownGracePeriodCriticalPods = 10 * time.Second
 
+	// KubeletShutdownGracePeriodMax is the maximum total kubelet shutdown grace period.
+	//
+	// It is reported as the maximum inhibit delay to the kubelet.
+	KubeletShutdownGracePeriodMax = 40 * KubeletShutdownGracePeriod
+
 	// SeccompProfilesDirectory is the path to the directory where user provided seccomp profiles are mounted inside Kubelet.
 	SeccompProfilesDirectory = "/var/lib/kubelet/seccomp/profiles"
 
@@ -451,6 +462,20 @@ const (
 	// ConfigTryTimeout is the timeout of the config apply in try mode.
 	ConfigTryTimeout = time.Minute
 
+	// ConfigPreviousPath is the path to the previous machine config, used to roll back the config.
+	ConfigPreviousPath = StateMountPoint + "/config.yaml.prev"
+
+	// ConfigStagedMarkerPath is the path to the marker of the staged config which wasn't confirmed yet.
+	//
+	// The marker holds the timeout for the machine to become ready after the reboot into the staged config.
+	ConfigStagedMarkerPath = StateMountPoint + "/config.yaml.staged"
+
+	// ConfigStagedRollbackTimeout is the default timeout to roll back the staged config if the machine doesn't become ready.
+	ConfigStagedRollbackTimeout = 10 * time.Minute
+
+	// SecretsStorePath is the path to the directory with the secrets bundles referenced by the machine config.
+	SecretsStorePath = StateMountPoint + "/secrets"
+
 	// MetalConfigISOLabel is the volume label for ISO based configuration.
 	MetalConfigISOLabel = "metal-iso"
 
@@ -566,6 +591,12 @@ const (
 	// RootfsAsset defines a well known name for our rootfs filename.
 	RootfsAsset = "rootfs.sqsh"
 
+	// RootfsChecksumAsset defines a well known name for the rootfs checksum manifest filename in the initramfs.
+	RootfsChecksumAsset = "rootfs.sqsh.sha256"
+
+	// RootfsChecksumFile is the path to the rootfs checksum manifest in the rootfs.
+	RootfsChecksumFile = "/etc/rootfs.sha256"
+
 	// UKIAsset defines a well known name for our UKI filename.
 	UKIAsset = "vmlinuz.efi.signed"
 
@@ -638,6 +669,43 @@ const (
 	// https://www.mankier.com/7/systemd-stub#Initrd_Resources
 	PCRPublicKey = SDStubDynamicInitrdPath + "/" + "tpm2-pcr-public-key.pem"
 
+	// TPMEventLogPath is the path to the TPM event log exposed by the kernel.
+	TPMEventLogPath = "/sys/kernel/security/tpm0/binary_bios_measurements"
+
+	// ConfigPCR is the PCR number where the kernel command line and the machine configuration are measured during the boot.
+	//
+	// The expected value of the PCR can be computed with the attestation.ConfigPCRValue.
+	ConfigPCR = 12
+
+	// AttestationRefreshInterval is the interval between TPM quote refreshes in the AttestationStatus resource.
+	AttestationRefreshInterval = 5 * time.Minute
+
+	// EmergencyConsoleImage is the image used for the emergency console sessions.
+	//
+	// The image is pinned by the digest, so that the shell can't be replaced by pushing the tag.
+	EmergencyConsoleImage = "docker.io/library/busybox:1.37.0@sha256:f85340bf132ae937d2c2a763b8335c9bab35d6e8293f70f606b9c6178d84f42b"
+
+	// EmergencyConsoleUserID is the user ID the emergency console shell runs as (nobody).
+	EmergencyConsoleUserID = 65534
+
+	// EmergencyConsoleAuditLogDir is the directory where the emergency console sessions are recorded.
+	EmergencyConsoleAuditLogDir = EphemeralMountPoint + "/" + "log" + "/" + "audit" + "/" + "emergency-console"
+
+	// EmergencyConsoleMaxDuration is the maximum duration of an emergency console session.
+	EmergencyConsoleMaxDuration = time.Hour
+
+	// AuditLogCapacity is the number of the most recent Talos API audit records kept in memory.
+	AuditLogCapacity = 1024
+
+	// MachinedMetricsPort is the default port of the machined Prometheus metrics endpoint.
+	MachinedMetricsPort = 50003
+
+	// FilesystemTrimInterval is the interval between the scheduled trims of the mounted filesystems.
+	FilesystemTrimInterval = 7 * 24 * time.Hour
+
+	// APIClientCertificateMaxTTL is the maximum TTL of the client certificate issued by the certificate renewal API.
+	APIClientCertificateMaxTTL = 7 * 24 * time.Hour
+
 	// DefaultCertificateValidityDuration is the default duration for a certificate.
 	DefaultCertificateValidityDuration = x509.DefaultCertificateValidityDuration
 
@@ -648,6 +716,18 @@ const (
 	// VarSystemOverlaysPath is the path where overlay mounts are created.
 	VarSystemOverlaysPath = "/var/system/overlays"
 
+	// EventsPath is the path to the file the runtime events are persisted to across reboots.
+	EventsPath = "/var/system/events"
+
+	// UserFilesPath is the directory for the files written with the UserFileWrite API.
+	UserFilesPath = "/var/user"
+
+	// UserMountsPath is the directory for the extra mounts, the files under it can be written with the UserFileWrite API as well.
+	UserMountsPath = "/var/mnt"
+
+	// UserFileMaxSize is the maximum size of the file written with the UserFileWrite API.
+	UserFileMaxSize = 64 * 1024 * 1024
+
 	// SystemRunPath is the path to the system run directory.
 	SystemRunPath = SystemPath + "/run"
 
@@ -783,6 +863,18 @@ const (
 	// NoneCNI is the string to indicate that CNI will not be managed by Talos.
 	NoneCNI = "none"
 
+	// CNIReadinessDefault is the CNI readiness mode which waits for the nodes to be ready.
+	CNIReadinessDefault = "default"
+
+	// CNIReadinessDisabled is the CNI readiness mode which skips the checks depending on the CNI.
+	CNIReadinessDisabled = "disabled"
+
+	// CNIReadinessCustom is the CNI readiness mode which waits for the CNI DaemonSets to be ready.
+	CNIReadinessCustom = "custom"
+
+	// DefaultCNIReadinessTimeout is the default timeout to wait for the CNI DaemonSets to be ready.
+	DefaultCNIReadinessTimeout = 30 * time.Minute
+
 	// DefaultIPv4PodNet is the IPv4 network to be used for kubernetes Pods.
 	DefaultIPv4PodNet = "10.244.0.0/16"
 
@@ -815,6 +907,9 @@ const (
 	// BootTimeout should be higher than EtcdJoinTimeout.
 	EtcdJoinTimeout = 30 * time.Minute
 
+	// EtcdConsistencyCheckInterval is the interval between the consistency checks of the etcd members.
+	EtcdConsistencyCheckInterval = 5 * time.Minute
+
 	// NodeReadyTimeout is the timeout to wait for the node to be ready (CNI to be running).
 	// For bootstrap API, this includes time to run bootstrap.
 	NodeReadyTimeout = BootTimeout
@@ -873,6 +968,9 @@ const (
 	// KubeSpanIdentityFilename is the filename to cache KubeSpan identity across reboots.
 	KubeSpanIdentityFilename = "kubespan-identity.yaml"
 
+	// DHCP4LeaseFilenameFormat is the filename format to persist DHCPv4 leases across reboots, the argument is the link name.
+	DHCP4LeaseFilenameFormat = "dhcp4-lease-%s.yaml"
+
 	// KubeSpanDefaultPort is the default Wireguard listening port for incoming connections.
 	KubeSpanDefaultPort = 51820
 
@@ -935,12 +1033,36 @@ const (
 	// UdevDir is the path to the udev directory.
 	UdevDir = "/usr/etc/udev"
 
+	// UdevRulesDir is the path to the udev rules directory.
+	UdevRulesDir = UdevDir + "/" + "rules.d"
+
 	// UdevRulesPath rules file path.
-	UdevRulesPath = UdevDir + "/" + "rules.d/99-talos.rules"
+	UdevRulesPath = UdevRulesDir + "/" + "99-talos.rules"
 
 	// LoggingFormatJSONLines represents "JSON lines" logging format.
 	LoggingFormatJSONLines = "json_lines"
 
+	// LoggingFormatSyslog represents RFC5424 syslog logging format.
+	LoggingFormatSyslog = "syslog"
+
+	// LoggingFormatLoki represents Loki push API logging format.
+	LoggingFormatLoki = "loki"
+
+	// LoggingSourceService represents the logs of machined and Talos services.
+	LoggingSourceService = "service"
+
+	// LoggingSourceKernel represents the kernel log.
+	LoggingSourceKernel = "kernel"
+
+	// LoggingSourceContainer represents the logs of the Kubernetes containers.
+	LoggingSourceContainer = "container"
+
+	// LogSpoolDir is the directory to spool the logs which couldn't be delivered to the logging destinations.
+	LogSpoolDir = EphemeralMountPoint + "/" + "log" + "/" + "spool"
+
+	// PodLogsDir is the directory with the logs of the Kubernetes containers written by the CRI.
+	PodLogsDir = EphemeralMountPoint + "/" + "log" + "/" + "pods"
+
 	// SideroLinkName is the interface name for SideroLink.
 	SideroLinkName = "siderolink"
 
@@ -964,6 +1086,11 @@ const (
 	// ExtensionServiceUserConfigPath is the path to the user provided extension services config directory.
 	ExtensionServiceUserConfigPath = SystemOverlaysPath + "/extensions"
 
+	// ExtensionServiceMetricsPath is the drop-in directory for the metrics published by extension services.
+	//
+	// Extension services write metrics in the Prometheus text format to `<name>.prom` files.
+	ExtensionServiceMetricsPath = SystemRunPath + "/extension-metrics"
+
 	// DBusServiceSocketPath is the path to the D-Bus socket for the logind mock to connect to.
 	DBusServiceSocketPath = SystemRunPath + "/dbus/service.socket"
 
@@ -979,6 +1106,12 @@ const (
 	// KubernetesTalosAPIServiceNamespace is the namespace of the Kubernetes service to access Talos API.
 	KubernetesTalosAPIServiceNamespace = "default"
 
+	// KubernetesTalosAPIServiceAccountBridgePort is the port of the Talos API bridge authenticating Kubernetes service account tokens.
+	KubernetesTalosAPIServiceAccountBridgePort = 50002
+
+	// KubernetesTalosAPIServiceAccountAudience is the audience of the service account tokens accepted by the Talos API bridge.
+	KubernetesTalosAPIServiceAccountAudience = "talos"
+
 	// TalosDir is the default name of the Talos directory under user home.
 	TalosDir = ".talos"
 
@@ -1030,6 +1163,31 @@ const (
 	// APIAuthzRoleMetadataKey is the gRPC metadata key used to submit a role with os:impersonator.
 	APIAuthzRoleMetadataKey = "talos-role"
 
+	// APIAuthzIdentityMetadataKey is the gRPC metadata key used to forward the identity of the API caller with os:impersonator.
+	APIAuthzIdentityMetadataKey = "talos-identity"
+
+	// APIAuthzPeerMetadataKey is the gRPC metadata key used to forward the address of the API caller with os:impersonator.
+	APIAuthzPeerMetadataKey = "talos-peer"
+
+	// APIAuthzAuthSourceMetadataKey is the gRPC metadata key used to forward the way the API caller was authenticated with os:impersonator.
+	APIAuthzAuthSourceMetadataKey = "talos-auth-source"
+
+	// APIAuthzCertificateNotAfterMetadataKey is the gRPC metadata key used to forward the expiration time of the API caller certificate with os:impersonator.
+	APIAuthzCertificateNotAfterMetadataKey = "talos-certificate-not-after"
+
+	// APIAuthorizationMetadataKey is the gRPC metadata key used to submit the bearer token by the API clients without a client certificate.
+	APIAuthorizationMetadataKey = "authorization"
+
+	// ResourceIfNoneMatchMetadataKey is the gRPC metadata key used to submit the resource version known to the client on resource Get.
+	//
+	// If the resource version matches, the resource is not returned.
+	ResourceIfNoneMatchMetadataKey = "talos-if-none-match"
+
+	// ResourceListSortMetadataKey is the gRPC metadata key used to request the resource List sorted by the metadata field.
+	//
+	// Supported fields are "id", "version" and "updated", the "-" prefix sorts in descending order.
+	ResourceListSortMetadataKey = "talos-list-sort"
+
 	// KernelLogsTTY is the number of the TTY device (/dev/ttyN) to redirect Kernel logs to.
 	KernelLogsTTY = 1
 
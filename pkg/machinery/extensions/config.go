@@ -23,6 +23,7 @@ type Config struct {
 type Layer struct {
 	Image    string   `yaml:"image" protobuf:"1"`
 	Metadata Metadata `yaml:"metadata" protobuf:"2"`
+	Hooks    Hooks    `yaml:"hooks,omitempty" protobuf:"3"`
 }
 
 // Read extensions config from a file.
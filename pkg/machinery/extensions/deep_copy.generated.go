@@ -9,5 +9,23 @@ package extensions
 // DeepCopy generates a deep copy of Layer.
 func (o Layer) DeepCopy() Layer {
 	var cp Layer = o
+	if o.Hooks.KernelArgs != nil {
+		cp.Hooks.KernelArgs = make([]string, len(o.Hooks.KernelArgs))
+		copy(cp.Hooks.KernelArgs, o.Hooks.KernelArgs)
+	}
+	if o.Hooks.KernelModules != nil {
+		cp.Hooks.KernelModules = make([]KernelModule, len(o.Hooks.KernelModules))
+		copy(cp.Hooks.KernelModules, o.Hooks.KernelModules)
+		for i2 := range o.Hooks.KernelModules {
+			if o.Hooks.KernelModules[i2].Parameters != nil {
+				cp.Hooks.KernelModules[i2].Parameters = make([]string, len(o.Hooks.KernelModules[i2].Parameters))
+				copy(cp.Hooks.KernelModules[i2].Parameters, o.Hooks.KernelModules[i2].Parameters)
+			}
+		}
+	}
+	if o.Hooks.HealthChecks != nil {
+		cp.Hooks.HealthChecks = make([]HealthCheck, len(o.Hooks.HealthChecks))
+		copy(cp.Hooks.HealthChecks, o.Hooks.HealthChecks)
+	}
 	return cp
 }
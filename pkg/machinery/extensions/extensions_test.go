@@ -7,6 +7,7 @@ package extensions_test
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -32,6 +33,33 @@ func TestLoadValidate(t *testing.T) {
 	assert.NoError(t, ext.Validate())
 }
 
+func TestLoadHooks(t *testing.T) {
+	ext, err := extensions.Load("testdata/good/extension2")
+	require.NoError(t, err)
+
+	assert.Equal(t, extensions.Hooks{
+		KernelArgs: []string{"nvidia-drm.modeset=1"},
+		KernelModules: []extensions.KernelModule{
+			{Name: "nvidia"},
+			{Name: "nvidia_uvm"},
+			{Name: "nvidia_drm", Parameters: []string{"modeset=1"}},
+		},
+		HealthChecks: []extensions.HealthCheck{
+			{Path: "/dev/nvidiactl", Timeout: 30 * time.Second},
+		},
+	}, ext.Manifest.Hooks)
+
+	// override Talos version to make it predictable
+	oldVersion := version.Tag
+	version.Tag = "v1.0.0"
+
+	t.Cleanup(func() {
+		version.Tag = oldVersion
+	})
+
+	assert.NoError(t, ext.Validate())
+}
+
 func TestValidateFailures(t *testing.T) {
 	// override Talos version to make it predictable
 	oldVersion := version.Tag
@@ -58,6 +86,10 @@ func TestValidateFailures(t *testing.T) {
 			name:      "norootfs",
 			loadError: "extension rootfs is missing",
 		},
+		{
+			name:          "badhooks",
+			validateError: "health check path \"dev/nvidiactl\" should be absolute",
+		},
 		{
 			name:          "badpaths",
 			validateError: "path \"/boot/vmlinuz\" is not allowed in extensions",
@@ -39,9 +39,7 @@ func Load(path string) (*Extension, error) {
 		}
 	}
 
-	var zeroManifest Manifest
-
-	if extension.Manifest == zeroManifest {
+	if extension.Manifest.Version == "" {
 		return nil, errors.New("extension manifest is missing")
 	}
 
@@ -4,10 +4,13 @@
 
 package extensions
 
+import "time"
+
 // Manifest is the structure of the extension manifest.yaml file.
 type Manifest struct {
 	Version  string   `yaml:"version"`
 	Metadata Metadata `yaml:"metadata"`
+	Hooks    Hooks    `yaml:"hooks,omitempty"`
 }
 
 // Metadata describes base extension metadata.
@@ -35,3 +38,41 @@ type Compatibility struct {
 type Constraint struct {
 	Version string `yaml:"version" protobuf:"1"`
 }
+
+// Hooks describes extension lifecycle hooks.
+//
+// Hooks are used by the extensions shipping kernel modules (e.g. GPU drivers)
+// to declare the requirements which Talos enforces and verifies on boot.
+//
+//gotagsrewrite:gen
+type Hooks struct {
+	// KernelArgs are the kernel arguments required by the extension.
+	KernelArgs []string `yaml:"kernelArgs,omitempty" protobuf:"1"`
+	// KernelModules are the kernel modules to load, in the specified order.
+	KernelModules []KernelModule `yaml:"kernelModules,omitempty" protobuf:"2"`
+	// HealthChecks are run after the kernel modules are loaded.
+	HealthChecks []HealthCheck `yaml:"healthChecks,omitempty" protobuf:"3"`
+}
+
+// IsZero returns true if no hooks are defined.
+func (hooks Hooks) IsZero() bool {
+	return len(hooks.KernelArgs) == 0 && len(hooks.KernelModules) == 0 && len(hooks.HealthChecks) == 0
+}
+
+// KernelModule describes a kernel module to load.
+//
+//gotagsrewrite:gen
+type KernelModule struct {
+	Name       string   `yaml:"name" protobuf:"1"`
+	Parameters []string `yaml:"parameters,omitempty" protobuf:"2"`
+}
+
+// HealthCheck describes a post-load health check.
+//
+// The health check passes when the path (e.g. device node or sysfs entry) appears within the timeout.
+//
+//gotagsrewrite:gen
+type HealthCheck struct {
+	Path    string        `yaml:"path" protobuf:"1"`
+	Timeout time.Duration `yaml:"timeout,omitempty" protobuf:"2"`
+}
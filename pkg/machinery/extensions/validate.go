@@ -5,6 +5,7 @@
 package extensions
 
 import (
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
@@ -22,6 +23,10 @@ func (ext *Extension) Validate() error {
 		return err
 	}
 
+	if err := ext.validateHooks(); err != nil {
+		return err
+	}
+
 	return ext.validateContents()
 }
 
@@ -47,6 +52,34 @@ func (ext *Extension) validateConstraints() error {
 	return nil
 }
 
+func (ext *Extension) validateHooks() error {
+	hooks := ext.Manifest.Hooks
+
+	for _, arg := range hooks.KernelArgs {
+		if arg == "" || strings.ContainsAny(arg, " \t\n") {
+			return fmt.Errorf("invalid kernel argument %q", arg)
+		}
+	}
+
+	for _, module := range hooks.KernelModules {
+		if module.Name == "" {
+			return errors.New("kernel module name is required")
+		}
+	}
+
+	for _, check := range hooks.HealthChecks {
+		if !filepath.IsAbs(check.Path) {
+			return fmt.Errorf("health check path %q should be absolute", check.Path)
+		}
+
+		if check.Timeout < 0 {
+			return fmt.Errorf("health check timeout for %q should be non-negative", check.Path)
+		}
+	}
+
+	return nil
+}
+
 // trim removes 'v' symbol anywhere in string if it's located before the number.
 func trim(constraint string) string {
 	for i := 0; i < len(constraint); i++ {
@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import (
+	"fmt"
+	"net/netip"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	sideronet "github.com/siderolabs/net"
+)
+
+// InterfaceSelectorPrefix is the prefix of the address selectors which match addresses by the link name.
+const InterfaceSelectorPrefix = "interface:"
+
+// AddressSelector matches node addresses either by the subnet or by the name of the link they are assigned to.
+//
+// Selectors are written as:
+//   - `10.0.0.0/8` or `10.0.0.1`: the address is in the subnet;
+//   - `interface:eth0` or `interface:enp*`: the address is assigned to the link with the matching name (shell glob);
+//   - any of the above prefixed with `!` to remove the matching addresses.
+type AddressSelector struct {
+	Negate      bool
+	Subnet      netip.Prefix
+	LinkPattern string
+}
+
+// ParseAddressSelector parses the address selector.
+func ParseAddressSelector(selector string) (AddressSelector, error) {
+	var result AddressSelector
+
+	selector, result.Negate = strings.CutPrefix(selector, "!")
+
+	if pattern, ok := strings.CutPrefix(selector, InterfaceSelectorPrefix); ok {
+		if pattern == "" {
+			return result, fmt.Errorf("empty interface name in selector %q", selector)
+		}
+
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return result, fmt.Errorf("invalid interface pattern %q: %w", pattern, err)
+		}
+
+		result.LinkPattern = pattern
+
+		return result, nil
+	}
+
+	subnet, err := sideronet.ParseSubnetOrAddress(selector)
+	if err != nil {
+		return result, err
+	}
+
+	result.Subnet = subnet
+
+	return result, nil
+}
+
+// Match the address assigned to the link with the name linkName.
+//
+// The negation is not taken into account.
+func (s AddressSelector) Match(addr netip.Addr, linkName string) bool {
+	if s.LinkPattern != "" {
+		matched, _ := filepath.Match(s.LinkPattern, linkName) //nolint:errcheck // pattern is validated in ParseAddressSelector
+
+		return linkName != "" && matched
+	}
+
+	return s.Subnet.Contains(addr)
+}
+
+// FilterIPs filters the list of IPs with the list of address selectors.
+//
+// Selectors are applied in order: positive selectors add matching IPs to the result, negative selectors remove them.
+// The linkName function returns the name of the link the IP is assigned to, it might return an empty string
+// if the IP is not assigned to any link (such IPs are never matched by the interface selectors).
+func FilterIPs(ips []netip.Addr, selectors []string, linkName func(netip.Addr) string) ([]netip.Addr, error) {
+	var result []netip.Addr
+
+	for _, s := range selectors {
+		selector, err := ParseAddressSelector(s)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ip := range ips {
+			link := ""
+
+			if linkName != nil {
+				link = linkName(ip)
+			}
+
+			if !selector.Match(ip, link) {
+				continue
+			}
+
+			if selector.Negate {
+				result = slices.DeleteFunc(result, func(addr netip.Addr) bool { return addr == ip })
+			} else if !slices.Contains(result, ip) {
+				result = append(result, ip)
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+func TestFilterIPs(t *testing.T) {
+	t.Parallel()
+
+	ips := []netip.Addr{
+		netip.MustParseAddr("10.0.0.2"),
+		netip.MustParseAddr("192.168.1.5"),
+		netip.MustParseAddr("172.20.0.3"),
+		netip.MustParseAddr("fd00::2"),
+		netip.MustParseAddr("203.0.113.7"),
+	}
+
+	links := map[netip.Addr]string{
+		netip.MustParseAddr("10.0.0.2"):    "eth0",
+		netip.MustParseAddr("192.168.1.5"): "enp1s0",
+		netip.MustParseAddr("172.20.0.3"):  "enp2s0",
+		netip.MustParseAddr("fd00::2"):     "eth0",
+	}
+
+	linkName := func(addr netip.Addr) string { return links[addr] }
+
+	for _, test := range []struct {
+		name      string
+		selectors []string
+
+		expected []string
+	}{
+		{
+			name:      "subnets",
+			selectors: []string{"10.0.0.0/8", "172.16.0.0/12"},
+			expected:  []string{"10.0.0.2", "172.20.0.3"},
+		},
+		{
+			name:      "negative subnet",
+			selectors: []string{"0.0.0.0/0", "!192.168.0.0/16"},
+			expected:  []string{"10.0.0.2", "172.20.0.3", "203.0.113.7"},
+		},
+		{
+			name:      "interface",
+			selectors: []string{"interface:eth0"},
+			expected:  []string{"10.0.0.2", "fd00::2"},
+		},
+		{
+			name:      "interface glob",
+			selectors: []string{"interface:enp*"},
+			expected:  []string{"192.168.1.5", "172.20.0.3"},
+		},
+		{
+			name:      "interface and subnet",
+			selectors: []string{"interface:enp*", "!172.16.0.0/12", "::/0"},
+			expected:  []string{"192.168.1.5", "fd00::2"},
+		},
+		{
+			name:      "negative interface",
+			selectors: []string{"0.0.0.0/0", "!interface:enp1s0"},
+			expected:  []string{"10.0.0.2", "172.20.0.3", "203.0.113.7"},
+		},
+		{
+			name:      "no link for an address",
+			selectors: []string{"interface:*"},
+			expected:  []string{"10.0.0.2", "192.168.1.5", "172.20.0.3", "fd00::2"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := nethelpers.FilterIPs(ips, test.selectors, linkName)
+			require.NoError(t, err)
+
+			var expected []netip.Addr
+
+			for _, addr := range test.expected {
+				expected = append(expected, netip.MustParseAddr(addr))
+			}
+
+			assert.Equal(t, expected, result)
+		})
+	}
+}
+
+func TestParseAddressSelector(t *testing.T) {
+	t.Parallel()
+
+	for _, selector := range []string{"10.0.0.0/8", "!fd00::/8", "10.0.0.1", "interface:eth0", "!interface:enp*"} {
+		_, err := nethelpers.ParseAddressSelector(selector)
+		assert.NoError(t, err, selector)
+	}
+
+	for _, selector := range []string{"10.0.0.0/33", "eth0", "interface:", "interface:[eth"} {
+		_, err := nethelpers.ParseAddressSelector(selector)
+		assert.Error(t, err, selector)
+	}
+}
@@ -59,6 +59,18 @@ func (o VolumeConfigSpec) DeepCopy() VolumeConfigSpec {
 				cp.Encryption.Keys[i3].StaticPassphrase = make([]byte, len(o.Encryption.Keys[i3].StaticPassphrase))
 				copy(cp.Encryption.Keys[i3].StaticPassphrase, o.Encryption.Keys[i3].StaticPassphrase)
 			}
+			if o.Encryption.Keys[i3].KMSClientCertificate != nil {
+				cp.Encryption.Keys[i3].KMSClientCertificate = make([]byte, len(o.Encryption.Keys[i3].KMSClientCertificate))
+				copy(cp.Encryption.Keys[i3].KMSClientCertificate, o.Encryption.Keys[i3].KMSClientCertificate)
+			}
+			if o.Encryption.Keys[i3].KMSClientKey != nil {
+				cp.Encryption.Keys[i3].KMSClientKey = make([]byte, len(o.Encryption.Keys[i3].KMSClientKey))
+				copy(cp.Encryption.Keys[i3].KMSClientKey, o.Encryption.Keys[i3].KMSClientKey)
+			}
+			if o.Encryption.Keys[i3].KMSCA != nil {
+				cp.Encryption.Keys[i3].KMSCA = make([]byte, len(o.Encryption.Keys[i3].KMSCA))
+				copy(cp.Encryption.Keys[i3].KMSCA, o.Encryption.Keys[i3].KMSCA)
+			}
 		}
 	}
 	if o.Encryption.PerfOptions != nil {
@@ -135,7 +135,14 @@ type EncryptionKey struct {
 	StaticPassphrase []byte `yaml:"staticPassphrase,omitempty" protobuf:"3"`
 
 	// Only for Type == "kms":
-	KMSEndpoint string `yaml:"kmsEndpoint,omitempty" protobuf:"4"`
+	KMSEndpoint            string `yaml:"kmsEndpoint,omitempty" protobuf:"4"`
+	KMSClientCertificate   []byte `yaml:"kmsClientCertificate,omitempty" protobuf:"6"`
+	KMSClientKey           []byte `yaml:"kmsClientKey,omitempty" protobuf:"7"`
+	KMSCA                  []byte `yaml:"kmsCA,omitempty" protobuf:"8"`
+	KMSVaultTransitKeyName string `yaml:"kmsVaultTransitKeyName,omitempty" protobuf:"9"`
+	KMSVaultTransitMount   string `yaml:"kmsVaultTransitMount,omitempty" protobuf:"10"`
+	KMSVaultAuthMount      string `yaml:"kmsVaultAuthMount,omitempty" protobuf:"11"`
+	KMSLockout             bool   `yaml:"kmsLockout,omitempty" protobuf:"12"`
 
 	// Only for Type == "tpm":
 	TPMCheckSecurebootStatusOnEnroll bool `yaml:"tpmCheckSecurebootStatusOnEnroll,omitempty" protobuf:"5"`
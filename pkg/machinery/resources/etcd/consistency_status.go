@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ConsistencyStatusType is type of ConsistencyStatus resource.
+const ConsistencyStatusType = resource.Type("EtcdConsistencyStatuses.etcd.talos.dev")
+
+// ConsistencyStatusID is resource ID for ConsistencyStatus resource for etcd.
+const ConsistencyStatusID = resource.ID("etcd")
+
+// ConsistencyStatus resource holds the result of the last consistency check of the etcd members.
+type ConsistencyStatus = typed.Resource[ConsistencyStatusSpec, ConsistencyStatusExtension]
+
+// ConsistencyStatusSpec describes the result of the consistency check of the etcd members.
+//
+//gotagsrewrite:gen
+type ConsistencyStatusSpec struct {
+	// HashRevision is the revision the member hashes were compared at.
+	HashRevision int64 `yaml:"hashRevision" protobuf:"1"`
+	// Divergent is set if any member key-value store hash doesn't match the majority of the members.
+	Divergent bool `yaml:"divergent" protobuf:"2"`
+	// DivergentMembers are the names of the members with the diverged data.
+	DivergentMembers []string `yaml:"divergentMembers,omitempty" protobuf:"3"`
+	// MaxRevisionLag is the maximum number of revisions a member is behind the most recent member.
+	MaxRevisionLag int64 `yaml:"maxRevisionLag" protobuf:"4"`
+	// LaggingMembers are the names of the members which are behind the most recent member too much.
+	LaggingMembers []string `yaml:"laggingMembers,omitempty" protobuf:"5"`
+	// UnreachableMembers are the names of the members which failed the check.
+	UnreachableMembers []string `yaml:"unreachableMembers,omitempty" protobuf:"6"`
+}
+
+// NewConsistencyStatus initializes a ConsistencyStatus resource.
+func NewConsistencyStatus(namespace resource.Namespace, id resource.ID) *ConsistencyStatus {
+	return typed.NewResource[ConsistencyStatusSpec, ConsistencyStatusExtension](
+		resource.NewMetadata(namespace, ConsistencyStatusType, id, resource.VersionUndefined),
+		ConsistencyStatusSpec{},
+	)
+}
+
+// ConsistencyStatusExtension provides auxiliary methods for ConsistencyStatus.
+type ConsistencyStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ConsistencyStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConsistencyStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Revision",
+				JSONPath: "{.hashRevision}",
+			},
+			{
+				Name:     "Divergent",
+				JSONPath: "{.divergent}",
+			},
+			{
+				Name:     "Max Lag",
+				JSONPath: "{.maxRevisionLag}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ConsistencyStatusSpec](ConsistencyStatusType, &ConsistencyStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type ConfigSpec -type PKIStatusSpec -type SpecSpec -type MemberSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type ConfigSpec -type ConsistencyStatusSpec -type PKIStatusSpec -type SpecSpec -type MemberSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package etcd
 
@@ -38,6 +38,24 @@ func (o ConfigSpec) DeepCopy() ConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of ConsistencyStatusSpec.
+func (o ConsistencyStatusSpec) DeepCopy() ConsistencyStatusSpec {
+	var cp ConsistencyStatusSpec = o
+	if o.DivergentMembers != nil {
+		cp.DivergentMembers = make([]string, len(o.DivergentMembers))
+		copy(cp.DivergentMembers, o.DivergentMembers)
+	}
+	if o.LaggingMembers != nil {
+		cp.LaggingMembers = make([]string, len(o.LaggingMembers))
+		copy(cp.LaggingMembers, o.LaggingMembers)
+	}
+	if o.UnreachableMembers != nil {
+		cp.UnreachableMembers = make([]string, len(o.UnreachableMembers))
+		copy(cp.UnreachableMembers, o.UnreachableMembers)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of PKIStatusSpec.
 func (o PKIStatusSpec) DeepCopy() PKIStatusSpec {
 	var cp PKIStatusSpec = o
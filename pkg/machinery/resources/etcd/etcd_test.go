@@ -26,6 +26,7 @@ func TestRegisterResource(t *testing.T) {
 	resourceRegistry := registry.NewResourceRegistry(resources)
 
 	for _, resource := range []meta.ResourceWithRD{
+		&etcd.ConsistencyStatus{},
 		&etcd.PKIStatus{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type AuditPolicyConfigSpec -type BootstrapManifestsConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubePrismConfigSpec -type KubePrismEndpointsSpec -type KubePrismStatusesSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type NodeAnnotationSpecSpec -type NodeCordonedSpecSpec -type NodeLabelSpecSpec -type NodeTaintSpecSpec -type KubeletConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodeStatusSpec -type NodenameSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodStatusSpec -type StaticPodServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type AuditPolicyConfigSpec -type BootstrapManifestsConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubePrismConfigSpec -type KubePrismEndpointsSpec -type KubePrismStatusesSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type NodeAnnotationSpecSpec -type NodeCordonedSpecSpec -type NodeLabelSpecSpec -type NodeTaintSpecSpec -type KubeletConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodeStatusSpec -type NodenameSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodStatusSpec -type StaticPodServerStatusSpec -type StaticPodManifestStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package k8s
 
@@ -345,6 +345,10 @@ func (o KubeletConfigSpec) DeepCopy() KubeletConfigSpec {
 			cp.CredentialProviderConfig[k2] = v2
 		}
 	}
+	if o.ShutdownGracePeriodByPodPriority != nil {
+		cp.ShutdownGracePeriodByPodPriority = make([]ShutdownGracePeriodByPodPriority, len(o.ShutdownGracePeriodByPodPriority))
+		copy(cp.ShutdownGracePeriodByPodPriority, o.ShutdownGracePeriodByPodPriority)
+	}
 	return cp
 }
 
@@ -471,3 +475,9 @@ func (o StaticPodServerStatusSpec) DeepCopy() StaticPodServerStatusSpec {
 	var cp StaticPodServerStatusSpec = o
 	return cp
 }
+
+// DeepCopy generates a deep copy of StaticPodManifestStatusSpec.
+func (o StaticPodManifestStatusSpec) DeepCopy() StaticPodManifestStatusSpec {
+	var cp StaticPodManifestStatusSpec = o
+	return cp
+}
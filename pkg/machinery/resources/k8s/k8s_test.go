@@ -53,6 +53,7 @@ func TestRegisterResource(t *testing.T) {
 		&k8s.NodeIPConfig{},
 		&k8s.SchedulerConfig{},
 		&k8s.SecretsStatus{},
+		&k8s.StaticPodManifestStatus{},
 		&k8s.StaticPodStatus{},
 		&k8s.StaticPod{},
 	} {
@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// StaticPodManifestStatusType is type of StaticPodManifestStatus resource.
+const StaticPodManifestStatusType = resource.Type("StaticPodManifestStatuses.kubernetes.talos.dev")
+
+// StaticPodManifestStatus resource holds the rendered static pod manifest as served to the kubelet.
+type StaticPodManifestStatus = typed.Resource[StaticPodManifestStatusSpec, StaticPodManifestStatusExtension]
+
+// StaticPodManifestStatusSpec describes the rendered static pod manifest.
+//
+//gotagsrewrite:gen
+type StaticPodManifestStatusSpec struct {
+	Manifest string `yaml:"manifest" protobuf:"1"`
+	SHA256   string `yaml:"sha256" protobuf:"2"`
+}
+
+// NewStaticPodManifestStatus initializes a StaticPodManifestStatus resource.
+func NewStaticPodManifestStatus(namespace resource.Namespace, id resource.ID) *StaticPodManifestStatus {
+	return typed.NewResource[StaticPodManifestStatusSpec, StaticPodManifestStatusExtension](
+		resource.NewMetadata(namespace, StaticPodManifestStatusType, id, resource.VersionUndefined),
+		StaticPodManifestStatusSpec{},
+	)
+}
+
+// StaticPodManifestStatusExtension provides auxiliary methods for StaticPodManifestStatus.
+type StaticPodManifestStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (StaticPodManifestStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             StaticPodManifestStatusType,
+		Aliases:          []resource.Type{"staticpodmanifest", "staticpodmanifests"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "SHA256",
+				JSONPath: `{.sha256}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[StaticPodManifestStatusSpec](StaticPodManifestStatusType, &StaticPodManifestStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
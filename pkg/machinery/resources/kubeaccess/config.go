@@ -5,10 +5,13 @@
 package kubeaccess
 
 import (
+	"slices"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
 	"github.com/cosi-project/runtime/pkg/resource/typed"
+	"github.com/siderolabs/gen/xslices"
 
 	"github.com/siderolabs/talos/pkg/machinery/proto"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
@@ -27,9 +30,38 @@ type Config = typed.Resource[ConfigSpec, ConfigExtension]
 //
 //gotagsrewrite:gen
 type ConfigSpec struct {
-	Enabled                     bool     `yaml:"enabled" protobuf:"1"`
-	AllowedAPIRoles             []string `yaml:"allowedAPIRoles" protobuf:"2"`
-	AllowedKubernetesNamespaces []string `yaml:"allowedKubernetesNamespaces" protobuf:"3"`
+	Enabled                     bool                  `yaml:"enabled" protobuf:"1"`
+	AllowedAPIRoles             []string              `yaml:"allowedAPIRoles" protobuf:"2"`
+	AllowedKubernetesNamespaces []string              `yaml:"allowedKubernetesNamespaces" protobuf:"3"`
+	ServiceAccounts             []ServiceAccountRoles `yaml:"serviceAccounts,omitempty" protobuf:"4"`
+}
+
+// ServiceAccountRoles describes the Talos API roles granted to the Kubernetes service account.
+//
+//gotagsrewrite:gen
+type ServiceAccountRoles struct {
+	Namespace string   `yaml:"namespace" protobuf:"1"`
+	Name      string   `yaml:"name" protobuf:"2"`
+	Roles     []string `yaml:"roles" protobuf:"3"`
+}
+
+// ServiceAccountRoles returns the Talos API roles granted to the service account.
+//
+// The roles are limited to the allowed API roles, nil is returned if the service account is not granted access.
+func (cs *ConfigSpec) ServiceAccountRoles(namespace, name string) []string {
+	if !cs.Enabled || !slices.Contains(cs.AllowedKubernetesNamespaces, namespace) {
+		return nil
+	}
+
+	for _, sa := range cs.ServiceAccounts {
+		if sa.Namespace != namespace || sa.Name != name {
+			continue
+		}
+
+		return xslices.Filter(sa.Roles, func(r string) bool { return slices.Contains(cs.AllowedAPIRoles, r) })
+	}
+
+	return nil
 }
 
 // DeepCopy generates a deep copy of ConfigSpec.
@@ -46,6 +78,15 @@ func (cs ConfigSpec) DeepCopy() ConfigSpec {
 		copy(cp.AllowedKubernetesNamespaces, cs.AllowedKubernetesNamespaces)
 	}
 
+	if cs.ServiceAccounts != nil {
+		cp.ServiceAccounts = make([]ServiceAccountRoles, len(cs.ServiceAccounts))
+
+		for i, sa := range cs.ServiceAccounts {
+			cp.ServiceAccounts[i] = sa
+			cp.ServiceAccounts[i].Roles = slices.Clone(sa.Roles)
+		}
+	}
+
 	return cp
 }
 
@@ -30,3 +30,31 @@ func TestRegisterResource(t *testing.T) {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
 }
+
+func TestServiceAccountRoles(t *testing.T) {
+	spec := kubeaccess.ConfigSpec{
+		Enabled:                     true,
+		AllowedAPIRoles:             []string{"os:reader", "os:operator"},
+		AllowedKubernetesNamespaces: []string{"kube-system"},
+		ServiceAccounts: []kubeaccess.ServiceAccountRoles{
+			{
+				Namespace: "kube-system",
+				Name:      "upgrader",
+				Roles:     []string{"os:operator", "os:admin"},
+			},
+			{
+				Namespace: "default",
+				Name:      "reader",
+				Roles:     []string{"os:reader"},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"os:operator"}, spec.ServiceAccountRoles("kube-system", "upgrader"))
+	assert.Empty(t, spec.ServiceAccountRoles("kube-system", "reader"))
+	assert.Empty(t, spec.ServiceAccountRoles("default", "reader"))
+
+	spec.Enabled = false
+
+	assert.Empty(t, spec.ServiceAccountRoles("kube-system", "upgrader"))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
 	"github.com/cosi-project/runtime/pkg/resource/typed"
+	"github.com/cosi-project/runtime/pkg/safe"
 
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/proto"
@@ -68,6 +69,21 @@ func (AddressStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 	}
 }
 
+// AddressLinkNames returns a function which maps the IP to the name of the link it is assigned to.
+//
+// The function returns an empty string for the IPs which are not found in the list.
+func AddressLinkNames(addresses safe.List[*AddressStatus]) func(netip.Addr) string {
+	linkNames := make(map[netip.Addr]string, addresses.Len())
+
+	addresses.ForEach(func(addr *AddressStatus) {
+		linkNames[addr.TypedSpec().Address.Addr()] = addr.TypedSpec().LinkName
+	})
+
+	return func(ip netip.Addr) string {
+		return linkNames[ip]
+	}
+}
+
 func init() {
 	proto.RegisterDefaultTypes()
 
@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ConntrackStatusType is type of ConntrackStatus resource.
+const ConntrackStatusType = resource.Type("ConntrackStatuses.net.talos.dev")
+
+// ConntrackStatus resource holds netfilter connection tracking table usage.
+type ConntrackStatus = typed.Resource[ConntrackStatusSpec, ConntrackStatusExtension]
+
+// ConntrackStatusID is the resource ID of the singleton instance.
+const ConntrackStatusID resource.ID = "conntrack"
+
+// ConntrackStatusSpec describes connection tracking table usage and limits.
+//
+//gotagsrewrite:gen
+type ConntrackStatusSpec struct {
+	// Number of entries currently in the connection tracking table.
+	Count uint64 `yaml:"count" protobuf:"1"`
+	// Maximum number of entries in the connection tracking table (nf_conntrack_max).
+	Max uint64 `yaml:"max" protobuf:"2"`
+	// Size of the connection tracking hash table (nf_conntrack_buckets).
+	Buckets uint64 `yaml:"buckets" protobuf:"3"`
+}
+
+// NewConntrackStatus initializes a ConntrackStatus resource.
+func NewConntrackStatus(namespace resource.Namespace, id resource.ID) *ConntrackStatus {
+	return typed.NewResource[ConntrackStatusSpec, ConntrackStatusExtension](
+		resource.NewMetadata(namespace, ConntrackStatusType, id, resource.VersionUndefined),
+		ConntrackStatusSpec{},
+	)
+}
+
+// ConntrackStatusExtension provides auxiliary methods for ConntrackStatus.
+type ConntrackStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ConntrackStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConntrackStatusType,
+		Aliases:          []resource.Type{"conntrack"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Count",
+				JSONPath: "{.count}",
+			},
+			{
+				Name:     "Max",
+				JSONPath: "{.max}",
+			},
+			{
+				Name:     "Buckets",
+				JSONPath: "{.buckets}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ConntrackStatusSpec](ConntrackStatusType, &ConntrackStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type DNSResolveCacheSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type ConntrackStatusSpec -type DHCPLeaseStatusSpec -type DHCPOptionsStatusSpec -type DNSResolveCacheSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type IPAMLeaseSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type PodNetStatsSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -24,6 +24,32 @@ func (o AddressStatusSpec) DeepCopy() AddressStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of ConntrackStatusSpec.
+func (o ConntrackStatusSpec) DeepCopy() ConntrackStatusSpec {
+	var cp ConntrackStatusSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of DHCPLeaseStatusSpec.
+func (o DHCPLeaseStatusSpec) DeepCopy() DHCPLeaseStatusSpec {
+	var cp DHCPLeaseStatusSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of DHCPOptionsStatusSpec.
+func (o DHCPOptionsStatusSpec) DeepCopy() DHCPOptionsStatusSpec {
+	var cp DHCPOptionsStatusSpec = o
+	if o.Options != nil {
+		cp.Options = make([]DHCPOption, len(o.Options))
+		copy(cp.Options, o.Options)
+	}
+	if o.SearchDomains != nil {
+		cp.SearchDomains = make([]string, len(o.SearchDomains))
+		copy(cp.SearchDomains, o.SearchDomains)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of DNSResolveCacheSpec.
 func (o DNSResolveCacheSpec) DeepCopy() DNSResolveCacheSpec {
 	var cp DNSResolveCacheSpec = o
@@ -62,6 +88,12 @@ func (o HostnameStatusSpec) DeepCopy() HostnameStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of IPAMLeaseSpec.
+func (o IPAMLeaseSpec) DeepCopy() IPAMLeaseSpec {
+	var cp IPAMLeaseSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of LinkRefreshSpec.
 func (o LinkRefreshSpec) DeepCopy() LinkRefreshSpec {
 	var cp LinkRefreshSpec = o
@@ -239,6 +271,16 @@ func (o NodeAddressFilterSpec) DeepCopy() NodeAddressFilterSpec {
 // DeepCopy generates a deep copy of OperatorSpecSpec.
 func (o OperatorSpecSpec) DeepCopy() OperatorSpecSpec {
 	var cp OperatorSpecSpec = o
+	if o.DHCP4.RequestedOptions != nil {
+		cp.DHCP4.RequestedOptions = make([]uint8, len(o.DHCP4.RequestedOptions))
+		copy(cp.DHCP4.RequestedOptions, o.DHCP4.RequestedOptions)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of PodNetStatsSpec.
+func (o PodNetStatsSpec) DeepCopy() PodNetStatsSpec {
+	var cp PodNetStatsSpec = o
 	return cp
 }
 
@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DHCPLeaseStatusType is type of DHCPLeaseStatus resource.
+const DHCPLeaseStatusType = resource.Type("DHCPLeaseStatuses.net.talos.dev")
+
+// DHCPLeaseStatus resource holds the details of the current DHCP lease.
+type DHCPLeaseStatus = typed.Resource[DHCPLeaseStatusSpec, DHCPLeaseStatusExtension]
+
+// DHCPLeaseStatusSpec describes the current DHCP lease.
+//
+//gotagsrewrite:gen
+type DHCPLeaseStatusSpec struct {
+	LinkName      string        `yaml:"linkName" protobuf:"1"`
+	Address       netip.Prefix  `yaml:"address" protobuf:"2"`
+	ServerAddress netip.Addr    `yaml:"serverAddress" protobuf:"3"`
+	LeaseTime     time.Duration `yaml:"leaseTime" protobuf:"4"`
+	AcquiredAt    time.Time     `yaml:"acquiredAt" protobuf:"5"`
+	// Persisted is true if the lease is persisted on the STATE partition.
+	Persisted bool `yaml:"persisted" protobuf:"6"`
+	// Reused is true if the lease was restored from the STATE partition on boot.
+	Reused bool `yaml:"reused" protobuf:"7"`
+}
+
+// NewDHCPLeaseStatus initializes a DHCPLeaseStatus resource.
+func NewDHCPLeaseStatus(namespace resource.Namespace, id resource.ID) *DHCPLeaseStatus {
+	return typed.NewResource[DHCPLeaseStatusSpec, DHCPLeaseStatusExtension](
+		resource.NewMetadata(namespace, DHCPLeaseStatusType, id, resource.VersionUndefined),
+		DHCPLeaseStatusSpec{},
+	)
+}
+
+// DHCPLeaseStatusExtension provides auxiliary methods for DHCPLeaseStatus.
+type DHCPLeaseStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DHCPLeaseStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DHCPLeaseStatusType,
+		Aliases:          []resource.Type{"dhcplease", "dhcpleases"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: "{.linkName}",
+			},
+			{
+				Name:     "Address",
+				JSONPath: "{.address}",
+			},
+			{
+				Name:     "Server",
+				JSONPath: "{.serverAddress}",
+			},
+			{
+				Name:     "Lease Time",
+				JSONPath: "{.leaseTime}",
+			},
+			{
+				Name:     "Reused",
+				JSONPath: "{.reused}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DHCPLeaseStatusSpec](DHCPLeaseStatusType, &DHCPLeaseStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DHCPOptionsStatusType is type of DHCPOptionsStatus resource.
+const DHCPOptionsStatusType = resource.Type("DHCPOptionsStatuses.net.talos.dev")
+
+// DHCPOptionsStatus resource holds the options received from the DHCP server.
+type DHCPOptionsStatus = typed.Resource[DHCPOptionsStatusSpec, DHCPOptionsStatusExtension]
+
+// DHCPOptionsStatusSpec describes the options received in the DHCP lease.
+//
+//gotagsrewrite:gen
+type DHCPOptionsStatusSpec struct {
+	LinkName      string       `yaml:"linkName" protobuf:"1"`
+	Options       []DHCPOption `yaml:"options,omitempty" protobuf:"2"`
+	SearchDomains []string     `yaml:"searchDomains,omitempty" protobuf:"3"`
+}
+
+// DHCPOption describes a single raw DHCP option.
+//
+// Value is the hex-encoded option payload.
+//
+//gotagsrewrite:gen
+type DHCPOption struct {
+	Code  uint8  `yaml:"code" protobuf:"1"`
+	Value string `yaml:"value" protobuf:"2"`
+}
+
+// NewDHCPOptionsStatus initializes a DHCPOptionsStatus resource.
+func NewDHCPOptionsStatus(namespace resource.Namespace, id resource.ID) *DHCPOptionsStatus {
+	return typed.NewResource[DHCPOptionsStatusSpec, DHCPOptionsStatusExtension](
+		resource.NewMetadata(namespace, DHCPOptionsStatusType, id, resource.VersionUndefined),
+		DHCPOptionsStatusSpec{},
+	)
+}
+
+// DHCPOptionsStatusExtension provides auxiliary methods for DHCPOptionsStatus.
+type DHCPOptionsStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DHCPOptionsStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DHCPOptionsStatusType,
+		Aliases:          []resource.Type{"dhcpoptions"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: "{.linkName}",
+			},
+			{
+				Name:     "Search Domains",
+				JSONPath: "{.searchDomains}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DHCPOptionsStatusSpec](DHCPOptionsStatusType, &DHCPOptionsStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// IPAMLeaseType is type of IPAMLease resource.
+const IPAMLeaseType = resource.Type("IPAMLeases.net.talos.dev")
+
+// IPAMLease resource records the address allocated by the external IPAM.
+//
+// The resource ID is the name of the link the address was allocated for.
+type IPAMLease = typed.Resource[IPAMLeaseSpec, IPAMLeaseExtension]
+
+// IPAMLeaseSpec describes the address allocated by the external IPAM.
+//
+//gotagsrewrite:gen
+type IPAMLeaseSpec struct {
+	// LeaseID is the IPAM lease (allocation) identifier.
+	LeaseID string `yaml:"leaseID" protobuf:"1"`
+	// Address is the allocated address in CIDR notation.
+	Address string `yaml:"address" protobuf:"2"`
+	// Gateway is the default gateway for the address (optional).
+	Gateway string `yaml:"gateway,omitempty" protobuf:"3"`
+	// Endpoint is the IPAM endpoint the address was allocated by.
+	Endpoint string `yaml:"endpoint" protobuf:"4"`
+}
+
+// NewIPAMLease initializes a IPAMLease resource.
+func NewIPAMLease(namespace resource.Namespace, id resource.ID) *IPAMLease {
+	return typed.NewResource[IPAMLeaseSpec, IPAMLeaseExtension](
+		resource.NewMetadata(namespace, IPAMLeaseType, id, resource.VersionUndefined),
+		IPAMLeaseSpec{},
+	)
+}
+
+// IPAMLeaseExtension provides auxiliary methods for IPAMLease.
+type IPAMLeaseExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (IPAMLeaseExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             IPAMLeaseType,
+		Aliases:          []resource.Type{"ipamlease", "ipamleases"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Address",
+				JSONPath: "{.address}",
+			},
+			{
+				Name:     "Lease ID",
+				JSONPath: "{.leaseID}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[IPAMLeaseSpec](IPAMLeaseType, &IPAMLease{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -27,11 +27,15 @@ func TestRegisterResource(t *testing.T) {
 	for _, resource := range []meta.ResourceWithRD{
 		&network.AddressStatus{},
 		&network.AddressSpec{},
+		&network.ConntrackStatus{},
+		&network.DHCPLeaseStatus{},
+		&network.DHCPOptionsStatus{},
 		&network.HardwareAddr{},
 		&network.DNSUpstream{},
 		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
+		&network.IPAMLease{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
@@ -39,6 +43,7 @@ func TestRegisterResource(t *testing.T) {
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
+		&network.PodNetStats{},
 		&network.ProbeSpec{},
 		&network.ResolverStatus{},
 		&network.ResolverSpec{},
@@ -6,6 +6,7 @@ package network
 
 import (
 	"net/netip"
+	"slices"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -41,15 +42,34 @@ func (spec OperatorSpecSpec) Equal(other OperatorSpecSpec) bool {
 	// config layer is not important for equality check
 	spec.ConfigLayer = other.ConfigLayer
 
-	return spec == other
+	return spec.Operator == other.Operator &&
+		spec.LinkName == other.LinkName &&
+		spec.RequireUp == other.RequireUp &&
+		spec.DHCP4.Equal(other.DHCP4) &&
+		spec.DHCP6 == other.DHCP6 &&
+		spec.VIP == other.VIP
 }
 
 // DHCP4OperatorSpec describes DHCP4 operator options.
 //
 //gotagsrewrite:gen
 type DHCP4OperatorSpec struct {
-	RouteMetric         uint32 `yaml:"routeMetric" protobuf:"1"`
-	SkipHostnameRequest bool   `yaml:"skipHostnameRequest,omitempty" protobuf:"2"`
+	RouteMetric           uint32  `yaml:"routeMetric" protobuf:"1"`
+	SkipHostnameRequest   bool    `yaml:"skipHostnameRequest,omitempty" protobuf:"2"`
+	ClientIdentifier      string  `yaml:"clientIdentifier,omitempty" protobuf:"3"`
+	VendorClassIdentifier string  `yaml:"vendorClassIdentifier,omitempty" protobuf:"4"`
+	RequestedOptions      []uint8 `yaml:"requestedOptions,omitempty" protobuf:"5"`
+	PersistLease          bool    `yaml:"persistLease,omitempty" protobuf:"6"`
+}
+
+// Equal implements equality check for DHCP4OperatorSpec.
+func (spec DHCP4OperatorSpec) Equal(other DHCP4OperatorSpec) bool {
+	return spec.RouteMetric == other.RouteMetric &&
+		spec.SkipHostnameRequest == other.SkipHostnameRequest &&
+		spec.ClientIdentifier == other.ClientIdentifier &&
+		spec.VendorClassIdentifier == other.VendorClassIdentifier &&
+		slices.Equal(spec.RequestedOptions, other.RequestedOptions) &&
+		spec.PersistLease == other.PersistLease
 }
 
 // DHCP6OperatorSpec describes DHCP6 operator options.
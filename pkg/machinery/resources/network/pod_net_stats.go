@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// PodNetStatsType is type of PodNetStats resource.
+const PodNetStatsType = resource.Type("PodNetStats.net.talos.dev")
+
+// PodNetStats resource holds network interface counters of a Kubernetes pod.
+type PodNetStats = typed.Resource[PodNetStatsSpec, PodNetStatsExtension]
+
+// PodNetStatsSpec describes network interface counters of a Kubernetes pod.
+//
+//gotagsrewrite:gen
+type PodNetStatsSpec struct {
+	Namespace string `yaml:"namespace" protobuf:"1"`
+	Pod       string `yaml:"pod" protobuf:"2"`
+	Interface string `yaml:"interface" protobuf:"3"`
+	RxBytes   uint64 `yaml:"rxBytes" protobuf:"4"`
+	TxBytes   uint64 `yaml:"txBytes" protobuf:"5"`
+	RxPackets uint64 `yaml:"rxPackets" protobuf:"6"`
+	TxPackets uint64 `yaml:"txPackets" protobuf:"7"`
+	RxDropped uint64 `yaml:"rxDropped" protobuf:"8"`
+	TxDropped uint64 `yaml:"txDropped" protobuf:"9"`
+}
+
+// PodNetStatsID builds ID (primary key) for the pod network stats.
+func PodNetStatsID(namespace, pod, iface string) string {
+	return namespace + "/" + pod + "/" + iface
+}
+
+// NewPodNetStats initializes a PodNetStats resource.
+func NewPodNetStats(namespace resource.Namespace, id resource.ID) *PodNetStats {
+	return typed.NewResource[PodNetStatsSpec, PodNetStatsExtension](
+		resource.NewMetadata(namespace, PodNetStatsType, id, resource.VersionUndefined),
+		PodNetStatsSpec{},
+	)
+}
+
+// PodNetStatsExtension provides auxiliary methods for PodNetStats.
+type PodNetStatsExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (PodNetStatsExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             PodNetStatsType,
+		Aliases:          []resource.Type{"podnetstats"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Rx Bytes",
+				JSONPath: "{.rxBytes}",
+			},
+			{
+				Name:     "Tx Bytes",
+				JSONPath: "{.txBytes}",
+			},
+			{
+				Name:     "Rx Dropped",
+				JSONPath: "{.rxDropped}",
+			},
+			{
+				Name:     "Tx Dropped",
+				JSONPath: "{.txDropped}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[PodNetStatsSpec](PodNetStatsType, &PodNetStats{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+const (
+	// AttestationStatusType is type of [AttestationStatus] resource.
+	AttestationStatusType = resource.Type("AttestationStatuses.runtime.talos.dev")
+
+	// AttestationStatusID is the ID of [AttestationStatus] resource.
+	AttestationStatusID = resource.ID("attestation")
+)
+
+// AttestationStatus resource holds the latest TPM quote of the node PCRs.
+//
+// The quote is refreshed periodically, see [github.com/siderolabs/talos/pkg/machinery/attestation] for the verification.
+type AttestationStatus = typed.Resource[AttestationStatusSpec, AttestationStatusExtension]
+
+// AttestationStatusSpec describes the TPM quote of the node PCRs.
+//
+//gotagsrewrite:gen
+type AttestationStatusSpec struct {
+	// Nonce is the random qualifying data included into the quote.
+	Nonce []byte `yaml:"nonce" protobuf:"1"`
+	// Quote is the TPM2 marshaled TPMS_ATTEST structure.
+	Quote []byte `yaml:"quote" protobuf:"2"`
+	// Signature is the TPM2 marshaled TPMT_SIGNATURE of the quote.
+	Signature []byte `yaml:"signature" protobuf:"3"`
+	// AttestationKey is the PKIX DER encoded public part of the key used to sign the quote.
+	AttestationKey []byte `yaml:"attestationKey" protobuf:"4"`
+	// PCRValues are the hex encoded SHA256 values of the quoted PCRs in the order of the quote PCR selection.
+	PCRValues []string `yaml:"pcrValues" protobuf:"5"`
+	// EventLogDigest is the hex encoded SHA256 digest of the TPM event log.
+	EventLogDigest string `yaml:"eventLogDigest,omitempty" protobuf:"6"`
+}
+
+// NewAttestationStatus initializes a [AttestationStatus] resource.
+func NewAttestationStatus() *AttestationStatus {
+	return typed.NewResource[AttestationStatusSpec, AttestationStatusExtension](
+		resource.NewMetadata(NamespaceName, AttestationStatusType, AttestationStatusID, resource.VersionUndefined),
+		AttestationStatusSpec{},
+	)
+}
+
+// AttestationStatusExtension is auxiliary resource data for [AttestationStatus].
+type AttestationStatusExtension struct{}
+
+// ResourceDefinition implements [meta.ResourceDefinitionProvider] interface.
+func (AttestationStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             AttestationStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Event Log Digest",
+				JSONPath: `{.eventLogDigest}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[AttestationStatusSpec](AttestationStatusType, &AttestationStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
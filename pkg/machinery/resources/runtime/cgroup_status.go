@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// CgroupStatusType is type of CgroupStatus resource.
+const CgroupStatusType = resource.Type("CgroupStatuses.runtime.talos.dev")
+
+// CgroupStatus resource holds the effective limits and the current usage of a system service cgroup.
+//
+// The resource ID is the name of the cgroup as used in the CgroupLimitsConfig document (e.g. `kubelet`).
+type CgroupStatus = typed.Resource[CgroupStatusSpec, CgroupStatusExtension]
+
+// CgroupStatusSpec describes the limits and the usage of a cgroup.
+//
+//gotagsrewrite:gen
+type CgroupStatusSpec struct {
+	// Path is the cgroup path relative to the cgroup root.
+	Path string `yaml:"path" protobuf:"1"`
+	// CPULimitMillicores is the effective CPU limit (0 if unlimited).
+	CPULimitMillicores uint32 `yaml:"cpuLimitMillicores" protobuf:"2"`
+	// MemoryMax is the effective memory limit in bytes (0 if unlimited).
+	MemoryMax uint64 `yaml:"memoryMax" protobuf:"3"`
+	// MemoryCurrent is the current memory usage in bytes.
+	MemoryCurrent uint64 `yaml:"memoryCurrent" protobuf:"4"`
+	// CPUUsageUsec is the total CPU time consumed in microseconds.
+	CPUUsageUsec uint64 `yaml:"cpuUsageUsec" protobuf:"5"`
+}
+
+// NewCgroupStatus initializes a CgroupStatus resource.
+func NewCgroupStatus(id resource.ID) *CgroupStatus {
+	return typed.NewResource[CgroupStatusSpec, CgroupStatusExtension](
+		resource.NewMetadata(NamespaceName, CgroupStatusType, id, resource.VersionUndefined),
+		CgroupStatusSpec{},
+	)
+}
+
+// CgroupStatusExtension is auxiliary resource data for CgroupStatus.
+type CgroupStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (CgroupStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             CgroupStatusType,
+		Aliases:          []resource.Type{"cgroupstatus"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "CPU Limit",
+				JSONPath: `{.cpuLimitMillicores}`,
+			},
+			{
+				Name:     "Memory Max",
+				JSONPath: `{.memoryMax}`,
+			},
+			{
+				Name:     "Memory Current",
+				JSONPath: `{.memoryCurrent}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[CgroupStatusSpec](CgroupStatusType, &CgroupStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
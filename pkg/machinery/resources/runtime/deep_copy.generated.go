@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type DevicesStatusSpec -type DiagnosticSpec -type EventSinkConfigSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MetaKeySpec -type MountStatusSpec -type PlatformMetadataSpec -type SecurityStateSpec -type MetaLoadedSpec -type UniqueMachineTokenSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AttestationStatusSpec -type CgroupStatusSpec -type DevicesStatusSpec -type DiagnosticSpec -type DiskBenchmarkSpec -type EventSinkConfigSpec -type ExtensionHooksStatusSpec -type ExtensionMetricsSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type KernelModuleSpecSpec -type KernelModuleStatusSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MetaKeySpec -type MountStatusSpec -type PlatformMetadataSpec -type SecurityStateSpec -type MetaLoadedSpec -type StagedConfigStatusSpec -type UdevRuleSpec -type UniqueMachineTokenSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
@@ -11,6 +11,38 @@ import (
 	"net/url"
 )
 
+// DeepCopy generates a deep copy of AttestationStatusSpec.
+func (o AttestationStatusSpec) DeepCopy() AttestationStatusSpec {
+	var cp AttestationStatusSpec = o
+	if o.Nonce != nil {
+		cp.Nonce = make([]byte, len(o.Nonce))
+		copy(cp.Nonce, o.Nonce)
+	}
+	if o.Quote != nil {
+		cp.Quote = make([]byte, len(o.Quote))
+		copy(cp.Quote, o.Quote)
+	}
+	if o.Signature != nil {
+		cp.Signature = make([]byte, len(o.Signature))
+		copy(cp.Signature, o.Signature)
+	}
+	if o.AttestationKey != nil {
+		cp.AttestationKey = make([]byte, len(o.AttestationKey))
+		copy(cp.AttestationKey, o.AttestationKey)
+	}
+	if o.PCRValues != nil {
+		cp.PCRValues = make([]string, len(o.PCRValues))
+		copy(cp.PCRValues, o.PCRValues)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of CgroupStatusSpec.
+func (o CgroupStatusSpec) DeepCopy() CgroupStatusSpec {
+	var cp CgroupStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of DevicesStatusSpec.
 func (o DevicesStatusSpec) DeepCopy() DevicesStatusSpec {
 	var cp DevicesStatusSpec = o
@@ -27,12 +59,30 @@ func (o DiagnosticSpec) DeepCopy() DiagnosticSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of DiskBenchmarkSpec.
+func (o DiskBenchmarkSpec) DeepCopy() DiskBenchmarkSpec {
+	var cp DiskBenchmarkSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of EventSinkConfigSpec.
 func (o EventSinkConfigSpec) DeepCopy() EventSinkConfigSpec {
 	var cp EventSinkConfigSpec = o
 	return cp
 }
 
+// DeepCopy generates a deep copy of ExtensionHooksStatusSpec.
+func (o ExtensionHooksStatusSpec) DeepCopy() ExtensionHooksStatusSpec {
+	var cp ExtensionHooksStatusSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of ExtensionMetricsSpec.
+func (o ExtensionMetricsSpec) DeepCopy() ExtensionMetricsSpec {
+	var cp ExtensionMetricsSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of ExtensionServiceConfigSpec.
 func (o ExtensionServiceConfigSpec) DeepCopy() ExtensionServiceConfigSpec {
 	var cp ExtensionServiceConfigSpec = o
@@ -63,6 +113,16 @@ func (o KernelModuleSpecSpec) DeepCopy() KernelModuleSpecSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of KernelModuleStatusSpec.
+func (o KernelModuleStatusSpec) DeepCopy() KernelModuleStatusSpec {
+	var cp KernelModuleStatusSpec = o
+	if o.Parameters != nil {
+		cp.Parameters = make([]KernelModuleParameter, len(o.Parameters))
+		copy(cp.Parameters, o.Parameters)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of KernelParamSpecSpec.
 func (o KernelParamSpecSpec) DeepCopy() KernelParamSpecSpec {
 	var cp KernelParamSpecSpec = o
@@ -165,6 +225,30 @@ func (o MetaLoadedSpec) DeepCopy() MetaLoadedSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of StagedConfigStatusSpec.
+func (o StagedConfigStatusSpec) DeepCopy() StagedConfigStatusSpec {
+	var cp StagedConfigStatusSpec = o
+	if o.AddedPaths != nil {
+		cp.AddedPaths = make([]string, len(o.AddedPaths))
+		copy(cp.AddedPaths, o.AddedPaths)
+	}
+	if o.RemovedPaths != nil {
+		cp.RemovedPaths = make([]string, len(o.RemovedPaths))
+		copy(cp.RemovedPaths, o.RemovedPaths)
+	}
+	if o.ChangedPaths != nil {
+		cp.ChangedPaths = make([]string, len(o.ChangedPaths))
+		copy(cp.ChangedPaths, o.ChangedPaths)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of UdevRuleSpec.
+func (o UdevRuleSpec) DeepCopy() UdevRuleSpec {
+	var cp UdevRuleSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of UniqueMachineTokenSpec.
 func (o UniqueMachineTokenSpec) DeepCopy() UniqueMachineTokenSpec {
 	var cp UniqueMachineTokenSpec = o
@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DiskBenchmarkType is type of DiskBenchmark resource.
+const DiskBenchmarkType = resource.Type("DiskBenchmarks.runtime.talos.dev")
+
+// DiskBenchmark resource holds the result of the last disk benchmark run in the directory.
+//
+// The resource ID is the path of the directory the benchmark was run in.
+type DiskBenchmark = typed.Resource[DiskBenchmarkSpec, DiskBenchmarkExtension]
+
+// DiskBenchmarkSpec is the result of the disk benchmark.
+//
+// The fsync latency is measured with the small writes each followed by fdatasync, same as etcd writes the WAL.
+//
+//gotagsrewrite:gen
+type DiskBenchmarkSpec struct {
+	Path       string        `yaml:"path" protobuf:"1"`
+	MeasuredAt time.Time     `yaml:"measuredAt" protobuf:"2"`
+	Duration   time.Duration `yaml:"duration" protobuf:"3"`
+	DirectIO   bool          `yaml:"directIO" protobuf:"4"`
+
+	FsyncCount      uint64        `yaml:"fsyncCount" protobuf:"5"`
+	FsyncLatencyP50 time.Duration `yaml:"fsyncLatencyP50" protobuf:"6"`
+	FsyncLatencyP99 time.Duration `yaml:"fsyncLatencyP99" protobuf:"7"`
+	FsyncLatencyMax time.Duration `yaml:"fsyncLatencyMax" protobuf:"8"`
+
+	SequentialWriteBytesPerSecond uint64 `yaml:"sequentialWriteBytesPerSecond" protobuf:"9"`
+	SequentialWriteIOPS           uint64 `yaml:"sequentialWriteIOPS" protobuf:"10"`
+	RandomWriteIOPS               uint64 `yaml:"randomWriteIOPS" protobuf:"11"`
+	RandomReadIOPS                uint64 `yaml:"randomReadIOPS" protobuf:"12"`
+
+	// EtcdSuitable is true if the 99th percentile of the fsync latency meets the etcd recommendations.
+	EtcdSuitable bool `yaml:"etcdSuitable" protobuf:"13"`
+}
+
+// NewDiskBenchmark initializes a DiskBenchmark resource.
+func NewDiskBenchmark(id resource.ID) *DiskBenchmark {
+	return typed.NewResource[DiskBenchmarkSpec, DiskBenchmarkExtension](
+		resource.NewMetadata(NamespaceName, DiskBenchmarkType, id, resource.VersionUndefined),
+		DiskBenchmarkSpec{},
+	)
+}
+
+// DiskBenchmarkExtension is auxiliary resource data for DiskBenchmark.
+type DiskBenchmarkExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (DiskBenchmarkExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiskBenchmarkType,
+		Aliases:          []resource.Type{"diskbench"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Fsync P99",
+				JSONPath: `{.fsyncLatencyP99}`,
+			},
+			{
+				Name:     "Random Write IOPS",
+				JSONPath: `{.randomWriteIOPS}`,
+			},
+			{
+				Name:     "Random Read IOPS",
+				JSONPath: `{.randomReadIOPS}`,
+			},
+			{
+				Name:     "Etcd Suitable",
+				JSONPath: `{.etcdSuitable}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DiskBenchmarkSpec](DiskBenchmarkType, &DiskBenchmark{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ExtensionHooksStatusType is type of ExtensionHooksStatus resource.
+const ExtensionHooksStatusType = resource.Type("ExtensionHooksStatuses.runtime.talos.dev")
+
+// ExtensionHooksStatus resource holds status of the system extension lifecycle hooks.
+//
+// The resource ID matches the ID of the ExtensionStatus resource.
+type ExtensionHooksStatus = typed.Resource[ExtensionHooksStatusSpec, ExtensionHooksStatusExtension]
+
+// ExtensionHooksStatusSpec describes status of the system extension lifecycle hooks.
+//
+//gotagsrewrite:gen
+type ExtensionHooksStatusSpec struct {
+	// Name of the extension.
+	Name string `yaml:"name" protobuf:"1"`
+	// Ready is true when all required kernel arguments are present, all kernel modules are loaded and health checks passed.
+	Ready bool `yaml:"ready" protobuf:"2"`
+	// MissingKernelArgs lists kernel arguments required by the extension which are not present in the kernel command line.
+	MissingKernelArgs []string `yaml:"missingKernelArgs,omitempty" protobuf:"3"`
+	// LoadedModules lists kernel modules which were loaded successfully (in the load order).
+	LoadedModules []string `yaml:"loadedModules,omitempty" protobuf:"4"`
+	// PassedHealthChecks lists paths of the passed health checks.
+	PassedHealthChecks []string `yaml:"passedHealthChecks,omitempty" protobuf:"5"`
+	// Error is the first error encountered while running the hooks.
+	Error string `yaml:"error,omitempty" protobuf:"6"`
+}
+
+// NewExtensionHooksStatus initializes a ExtensionHooksStatus resource.
+func NewExtensionHooksStatus(id resource.ID) *ExtensionHooksStatus {
+	return typed.NewResource[ExtensionHooksStatusSpec, ExtensionHooksStatusExtension](
+		resource.NewMetadata(NamespaceName, ExtensionHooksStatusType, id, resource.VersionUndefined),
+		ExtensionHooksStatusSpec{},
+	)
+}
+
+// ExtensionHooksStatusExtension is auxiliary resource data for ExtensionHooksStatus.
+type ExtensionHooksStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ExtensionHooksStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ExtensionHooksStatusType,
+		Aliases:          []resource.Type{"extensionhooks"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Name",
+				JSONPath: `{.name}`,
+			},
+			{
+				Name:     "Ready",
+				JSONPath: `{.ready}`,
+			},
+			{
+				Name:     "Error",
+				JSONPath: `{.error}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ExtensionHooksStatusSpec](ExtensionHooksStatusType, &ExtensionHooksStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
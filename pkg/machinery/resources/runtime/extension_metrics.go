@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ExtensionMetricsType is type of ExtensionMetrics resource.
+const ExtensionMetricsType = resource.Type("ExtensionMetrics.runtime.talos.dev")
+
+// ExtensionMetrics resource holds metrics published by the extension services.
+//
+// The resource ID is the name of the metrics file in the drop-in directory without the `.prom` extension.
+type ExtensionMetrics = typed.Resource[ExtensionMetricsSpec, ExtensionMetricsExtension]
+
+// ExtensionMetricsSpec describes metrics published by an extension service in the Prometheus text format.
+//
+//gotagsrewrite:gen
+type ExtensionMetricsSpec struct {
+	// Path is the path to the metrics file.
+	Path string `yaml:"path" protobuf:"1"`
+	// Metrics is the normalized contents of the metrics file in the Prometheus text format.
+	Metrics string `yaml:"metrics,omitempty" protobuf:"2"`
+	// Families is the sorted list of metric family names.
+	Families []string `yaml:"families,omitempty" protobuf:"3"`
+	// Error is set if the metrics file can't be parsed, in that case the last valid metrics are kept.
+	Error string `yaml:"error,omitempty" protobuf:"4"`
+}
+
+// NewExtensionMetrics initializes a ExtensionMetrics resource.
+func NewExtensionMetrics(id resource.ID) *ExtensionMetrics {
+	return typed.NewResource[ExtensionMetricsSpec, ExtensionMetricsExtension](
+		resource.NewMetadata(NamespaceName, ExtensionMetricsType, id, resource.VersionUndefined),
+		ExtensionMetricsSpec{},
+	)
+}
+
+// ExtensionMetricsExtension is auxiliary resource data for ExtensionMetrics.
+type ExtensionMetricsExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ExtensionMetricsExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ExtensionMetricsType,
+		Aliases:          []resource.Type{"extensionmetrics"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Path",
+				JSONPath: `{.path}`,
+			},
+			{
+				Name:     "Error",
+				JSONPath: `{.error}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ExtensionMetricsSpec](ExtensionMetricsType, &ExtensionMetrics{})
+	if err != nil {
+		panic(err)
+	}
+}
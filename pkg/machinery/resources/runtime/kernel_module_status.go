@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// KernelModuleStatusType is type of KernelModuleStatus resource.
+const KernelModuleStatusType = resource.Type("KernelModuleStatuses.runtime.talos.dev")
+
+// KernelModuleStatus resource holds the current parameters of a loaded Linux kernel module.
+type KernelModuleStatus = typed.Resource[KernelModuleStatusSpec, KernelModuleStatusExtension]
+
+// KernelModuleStatusSpec describes the current parameters of a loaded Linux kernel module.
+//
+//gotagsrewrite:gen
+type KernelModuleStatusSpec struct {
+	Name       string                  `yaml:"name" protobuf:"1"`
+	Parameters []KernelModuleParameter `yaml:"parameters" protobuf:"2"`
+}
+
+// KernelModuleParameter describes a parameter of the kernel module.
+//
+//gotagsrewrite:gen
+type KernelModuleParameter struct {
+	Name     string `yaml:"name" protobuf:"1"`
+	Value    string `yaml:"value" protobuf:"2"`
+	Writable bool   `yaml:"writable" protobuf:"3"`
+}
+
+// NewKernelModuleStatus initializes a KernelModuleStatus resource.
+func NewKernelModuleStatus(namespace resource.Namespace, id resource.ID) *KernelModuleStatus {
+	return typed.NewResource[KernelModuleStatusSpec, KernelModuleStatusExtension](
+		resource.NewMetadata(namespace, KernelModuleStatusType, id, resource.VersionUndefined),
+		KernelModuleStatusSpec{},
+	)
+}
+
+// KernelModuleStatusExtension is auxiliary resource data for KernelModuleStatus.
+type KernelModuleStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (KernelModuleStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KernelModuleStatusType,
+		Aliases:          []resource.Type{"modparams", "moduleparams"},
+		DefaultNamespace: NamespaceName,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[KernelModuleStatusSpec](KernelModuleStatusType, &KernelModuleStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
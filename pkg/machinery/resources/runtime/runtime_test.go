@@ -25,11 +25,17 @@ func TestRegisterResource(t *testing.T) {
 	resourceRegistry := registry.NewResourceRegistry(resources)
 
 	for _, resource := range []meta.ResourceWithRD{
+		&runtime.AttestationStatus{},
+		&runtime.CgroupStatus{},
 		&runtime.DevicesStatus{},
 		&runtime.Diagnostic{},
+		&runtime.DiskBenchmark{},
 		&runtime.EventSinkConfig{},
+		&runtime.ExtensionHooksStatus{},
+		&runtime.ExtensionMetrics{},
 		&runtime.ExtensionStatus{},
 		&runtime.KernelModuleSpec{},
+		&runtime.KernelModuleStatus{},
 		&runtime.KernelParamSpec{},
 		&runtime.KernelParamStatus{},
 		&runtime.KmsgLogConfig{},
@@ -42,6 +48,8 @@ func TestRegisterResource(t *testing.T) {
 		&runtime.MountStatus{},
 		&runtime.PlatformMetadata{},
 		&runtime.SecurityState{},
+		&runtime.StagedConfigStatus{},
+		&runtime.UdevRule{},
 		&runtime.UniqueMachineToken{},
 		&runtime.WatchdogTimerConfig{},
 		&runtime.WatchdogTimerStatus{},
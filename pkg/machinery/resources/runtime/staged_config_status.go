@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// StagedConfigStatusType is type of StagedConfigStatus resource.
+const StagedConfigStatusType = resource.Type("StagedConfigStatuses.runtime.talos.dev")
+
+// StagedConfigStatusID is the ID of the singleton StagedConfigStatus resource.
+const StagedConfigStatusID = resource.ID("staged")
+
+// StagedConfigStatus resource holds the changes of the machine configuration applied in the staged mode.
+//
+// The resource exists only while the staged configuration is waiting for the reboot to be activated.
+type StagedConfigStatus = typed.Resource[StagedConfigStatusSpec, StagedConfigStatusExtension]
+
+// StagedConfigStatusSpec describes the changes of the staged machine configuration pending the reboot.
+//
+// Each change is identified by the path of the field, see configdiff.Changes.
+//
+//gotagsrewrite:gen
+type StagedConfigStatusSpec struct {
+	AddedPaths   []string `yaml:"addedPaths,omitempty" protobuf:"1"`
+	RemovedPaths []string `yaml:"removedPaths,omitempty" protobuf:"2"`
+	ChangedPaths []string `yaml:"changedPaths,omitempty" protobuf:"3"`
+}
+
+// NewStagedConfigStatus initializes a StagedConfigStatus resource.
+func NewStagedConfigStatus() *StagedConfigStatus {
+	return typed.NewResource[StagedConfigStatusSpec, StagedConfigStatusExtension](
+		resource.NewMetadata(NamespaceName, StagedConfigStatusType, StagedConfigStatusID, resource.VersionUndefined),
+		StagedConfigStatusSpec{},
+	)
+}
+
+// StagedConfigStatusExtension is auxiliary resource data for StagedConfigStatus.
+type StagedConfigStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (StagedConfigStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             StagedConfigStatusType,
+		Aliases:          []resource.Type{"staged"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Added",
+				JSONPath: `{.addedPaths}`,
+			},
+			{
+				Name:     "Removed",
+				JSONPath: `{.removedPaths}`,
+			},
+			{
+				Name:     "Changed",
+				JSONPath: `{.changedPaths}`,
+			},
+		},
+	}
+}
+
+// Count returns the total number of the pending changes.
+func (spec *StagedConfigStatusSpec) Count() int {
+	return len(spec.AddedPaths) + len(spec.RemovedPaths) + len(spec.ChangedPaths)
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[StagedConfigStatusSpec](StagedConfigStatusType, &StagedConfigStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
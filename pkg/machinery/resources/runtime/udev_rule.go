@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// UdevRuleType is type of UdevRule resource.
+const UdevRuleType = resource.Type("UdevRules.runtime.talos.dev")
+
+// UdevRule resource holds the effective udev rules file.
+//
+// The resource ID is the name of the rules file (e.g. `90-nic-names.rules`).
+type UdevRule = typed.Resource[UdevRuleSpec, UdevRuleExtension]
+
+// UdevRuleSpec describes the udev rules file.
+//
+//gotagsrewrite:gen
+type UdevRuleSpec struct {
+	// Rule is the contents of the rules file.
+	Rule string `yaml:"rule,omitempty" protobuf:"1"`
+	// Masked is set if the rules file shipped with Talos is disabled.
+	Masked bool `yaml:"masked" protobuf:"2"`
+}
+
+// NewUdevRule initializes a UdevRule resource.
+func NewUdevRule(id resource.ID) *UdevRule {
+	return typed.NewResource[UdevRuleSpec, UdevRuleExtension](
+		resource.NewMetadata(NamespaceName, UdevRuleType, id, resource.VersionUndefined),
+		UdevRuleSpec{},
+	)
+}
+
+// UdevRuleExtension is auxiliary resource data for UdevRule.
+type UdevRuleExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (UdevRuleExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             UdevRuleType,
+		Aliases:          []resource.Type{"udevrule"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Masked",
+				JSONPath: `{.masked}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[UdevRuleSpec](UdevRuleType, &UdevRule{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AdjtimeStatusSpec -type StatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AdjtimeStatusSpec -type SourceStatusSpec -type StatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package time
 
@@ -12,6 +12,12 @@ func (o AdjtimeStatusSpec) DeepCopy() AdjtimeStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of SourceStatusSpec.
+func (o SourceStatusSpec) DeepCopy() SourceStatusSpec {
+	var cp SourceStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of StatusSpec.
 func (o StatusSpec) DeepCopy() StatusSpec {
 	var cp StatusSpec = o
@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package time
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// SourceStatusType is type of SourceStatus resource.
+const SourceStatusType = resource.Type("TimeSourceStatuses.v1alpha1.talos.dev")
+
+// SourceStatus describes statistics of a single time source (NTP server or PTP device).
+//
+// The resource ID is the resolved address of the time source.
+type SourceStatus = typed.Resource[SourceStatusSpec, SourceStatusExtension]
+
+// SourceStatusSpec describes time source statistics.
+//
+// The fields follow the output of `chronyc sources` and `chronyc sourcestats`.
+//
+//gotagsrewrite:gen
+type SourceStatusSpec struct {
+	// Server is the time source name as configured (resolved address or PTP device path).
+	Server string `yaml:"server" protobuf:"1"`
+	// Selected is true if the source is currently used to synchronize the clock.
+	Selected bool `yaml:"selected" protobuf:"2"`
+	// Stratum of the time source.
+	Stratum uint8 `yaml:"stratum" protobuf:"3"`
+	// Reachability is the 8-bit shift register of the last polls (1 - response received).
+	Reachability uint8 `yaml:"reachability" protobuf:"4"`
+	// PollInterval is the current polling interval of the source.
+	PollInterval time.Duration `yaml:"pollInterval" protobuf:"5"`
+	// LastSample is the time of the last valid response.
+	LastSample time.Time `yaml:"lastSample" protobuf:"6"`
+	// Offset is the clock offset measured in the last valid response.
+	Offset time.Duration `yaml:"offset" protobuf:"7"`
+	// Jitter is the RMS of the offset differences over the recent samples.
+	Jitter time.Duration `yaml:"jitter" protobuf:"8"`
+	// Delay is the round-trip delay to the source measured in the last valid response.
+	Delay time.Duration `yaml:"delay" protobuf:"9"`
+	// RootDelay is the round-trip delay from the source to the reference clock.
+	RootDelay time.Duration `yaml:"rootDelay" protobuf:"10"`
+	// RootDispersion is the dispersion from the source to the reference clock.
+	RootDispersion time.Duration `yaml:"rootDispersion" protobuf:"11"`
+}
+
+// NewSourceStatus initializes a SourceStatus resource.
+func NewSourceStatus(id resource.ID) *SourceStatus {
+	return typed.NewResource[SourceStatusSpec, SourceStatusExtension](
+		resource.NewMetadata(v1alpha1.NamespaceName, SourceStatusType, id, resource.VersionUndefined),
+		SourceStatusSpec{},
+	)
+}
+
+// SourceStatusExtension provides auxiliary methods for SourceStatus.
+type SourceStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (SourceStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SourceStatusType,
+		Aliases:          []resource.Type{"timesources", "timesource"},
+		DefaultNamespace: v1alpha1.NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Selected",
+				JSONPath: "{.selected}",
+			},
+			{
+				Name:     "Stratum",
+				JSONPath: "{.stratum}",
+			},
+			{
+				Name:     "Reach",
+				JSONPath: "{.reachability}",
+			},
+			{
+				Name:     "Offset",
+				JSONPath: "{.offset}",
+			},
+			{
+				Name:     "Jitter",
+				JSONPath: "{.jitter}",
+			},
+			{
+				Name:     "Delay",
+				JSONPath: "{.delay}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SourceStatusSpec](SourceStatusType, &SourceStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -26,6 +26,7 @@ func TestRegisterResource(t *testing.T) {
 
 	for _, resource := range []meta.ResourceWithRD{
 		&time.AdjtimeStatus{},
+		&time.SourceStatus{},
 		&time.Status{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
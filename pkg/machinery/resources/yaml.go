@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package resources
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLSchemaVersion is the version of the resource YAML representation produced by MarshalYAML.
+//
+// Within the same schema version the YAML representation is stable: the same resource always marshals
+// to the same bytes, so that the snapshots of the cluster state can be diffed.
+// New spec fields might be added, but renaming or removing the fields, or changing the order of the keys
+// requires a new schema version.
+const YAMLSchemaVersion = "v1"
+
+// YAMLSchemaHeader is the comment line which starts the resource YAML representation.
+const YAMLSchemaHeader = "# schema-version: " + YAMLSchemaVersion
+
+// yamlIndent is the indentation of the resource YAML representation (yaml.v3 default).
+const yamlIndent = 4
+
+// MarshalYAMLNode marshals the resource to the YAML node with the stable key order.
+//
+// The metadata keys come first in the fixed order, followed by the spec.
+// The spec struct fields keep the declaration order, the map keys are sorted,
+// and the set-like metadata fields (labels, annotations and finalizers) are sorted.
+// Label and annotation values are always marshaled as strings (e.g. "1" is quoted).
+func MarshalYAMLNode(r resource.Resource) (*yaml.Node, error) {
+	out, err := resource.MarshalYAML(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var node yaml.Node
+
+	if err = node.Encode(out); err != nil {
+		return nil, fmt.Errorf("error marshaling resource %s: %w", resource.String(r), err)
+	}
+
+	if metadata := mappingValue(&node, "metadata"); metadata != nil {
+		for _, key := range []string{"labels", "annotations"} {
+			if value := mappingValue(metadata, key); value != nil {
+				sortMapping(value)
+				tagStrings(value)
+			}
+		}
+
+		if finalizers := mappingValue(metadata, "finalizers"); finalizers != nil && finalizers.Kind == yaml.SequenceNode {
+			slices.SortStableFunc(finalizers.Content, func(a, b *yaml.Node) int {
+				return cmp.Compare(a.Value, b.Value)
+			})
+		}
+	}
+
+	return &node, nil
+}
+
+// MarshalYAML marshals the resource to YAML deterministically.
+//
+// The output starts with the YAMLSchemaHeader comment, see MarshalYAMLNode for the key order.
+func MarshalYAML(r resource.Resource) ([]byte, error) {
+	node, err := MarshalYAMLNode(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString(YAMLSchemaHeader + "\n")
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(yamlIndent)
+
+	if err = enc.Encode(node); err != nil {
+		return nil, fmt.Errorf("error marshaling resource %s: %w", resource.String(r), err)
+	}
+
+	if err = enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// mappingValue returns the value of the key in the mapping node, or nil if the key is not found.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind == yaml.DocumentNode && len(node.Content) == 1 {
+		node = node.Content[0]
+	}
+
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// sortMapping sorts the mapping node by the keys.
+func sortMapping(node *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+
+	pairs := make([][2]*yaml.Node, 0, len(node.Content)/2)
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		pairs = append(pairs, [2]*yaml.Node{node.Content[i], node.Content[i+1]})
+	}
+
+	slices.SortStableFunc(pairs, func(a, b [2]*yaml.Node) int {
+		return cmp.Compare(a[0].Value, b[0].Value)
+	})
+
+	node.Content = node.Content[:0]
+
+	for _, pair := range pairs {
+		node.Content = append(node.Content, pair[0], pair[1])
+	}
+}
+
+// tagStrings marks the values of the mapping node as strings, so that they are quoted if needed.
+func tagStrings(node *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+
+	for i := 1; i < len(node.Content); i += 2 {
+		if node.Content[i].Kind == yaml.ScalarNode {
+			node.Content[i].Tag = "!!str"
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package resources_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func newKubeletSpec(finalizers ...string) *k8s.KubeletSpec {
+	ts := time.Date(2024, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	r := k8s.NewKubeletSpec(k8s.NamespaceName, k8s.KubeletID)
+	r.Metadata().SetVersion(resource.VersionUndefined.Next())
+	r.Metadata().SetCreated(ts)
+	r.Metadata().SetUpdated(ts)
+	r.Metadata().Labels().Set("b", "2")
+	r.Metadata().Labels().Set("a", "foo")
+
+	for _, fin := range finalizers {
+		r.Metadata().Finalizers().Add(fin)
+	}
+
+	r.TypedSpec().Image = "ghcr.io/siderolabs/kubelet:v1.31.0"
+	r.TypedSpec().Args = []string{"--b", "--a"}
+	r.TypedSpec().Config = map[string]any{
+		"kind":       "KubeletConfiguration",
+		"apiVersion": "kubelet.config.k8s.io/v1beta1",
+		"featureGates": map[string]any{
+			"Zeta":  true,
+			"Alpha": false,
+		},
+		"clusterDNS": []any{"10.96.0.10"},
+	}
+
+	return r
+}
+
+func TestMarshalYAML(t *testing.T) {
+	t.Parallel()
+
+	out, err := resources.MarshalYAML(newKubeletSpec("b-controller", "a-controller"))
+	require.NoError(t, err)
+
+	assert.Equal(t, `# schema-version: v1
+metadata:
+    namespace: k8s
+    type: KubeletSpecs.kubernetes.talos.dev
+    id: kubelet
+    version: 1
+    owner:
+    phase: running
+    created: 2024-10-19T12:00:00Z
+    updated: 2024-10-19T12:00:00Z
+    labels:
+        a: foo
+        b: "2"
+    finalizers:
+        - a-controller
+        - b-controller
+spec:
+    image: ghcr.io/siderolabs/kubelet:v1.31.0
+    args:
+        - --b
+        - --a
+    config:
+        apiVersion: kubelet.config.k8s.io/v1beta1
+        clusterDNS:
+            - 10.96.0.10
+        featureGates:
+            Alpha: false
+            Zeta: true
+        kind: KubeletConfiguration
+`, string(out))
+}
+
+func TestMarshalYAMLDeterministic(t *testing.T) {
+	t.Parallel()
+
+	expected, err := resources.MarshalYAML(newKubeletSpec("a-controller", "b-controller"))
+	require.NoError(t, err)
+
+	for range 100 {
+		// finalizers are a set, so the order they were added in doesn't matter
+		out, err := resources.MarshalYAML(newKubeletSpec("b-controller", "a-controller"))
+		require.NoError(t, err)
+
+		require.Equal(t, string(expected), string(out))
+	}
+}